@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var aggregateCmd = &cobra.Command{
+	Use:   "aggregate <directory|glob>...",
+	Short: "Aggregate diagnostic result files of several nodes into a cluster report",
+	Long: `Aggregate the JSON result files of the nodes of a cluster into a single cluster health report.
+Each argument is either a directory, of which all *.json files are read, or a glob pattern such
+as "results/*.json". Each node is named after its result file without the .json extension.
+For result files with several appended runs, the latest run is used.`,
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files, err := findResultFiles(args)
+		if err != nil {
+			return err
+		}
+		logger.Infof("Aggregating %d result file(s)", len(files))
+
+		report := reporter.AggregateReports(files)
+
+		// Get output format from configuration
+		outputFormat := viper.GetString("output")
+		if outputFormat == "" {
+			outputFormat = "table" // Default to table format
+		}
+
+		output, err := reporter.FormatClusterReport(report, outputFormat)
+		if err != nil {
+			return fmt.Errorf("failed to format cluster report: %w", err)
+		}
+
+		if outputFile := viper.GetString("output-file"); outputFile != "" {
+			if err := os.WriteFile(outputFile, []byte(output), 0644); err != nil {
+				return fmt.Errorf("failed to write report to file %s: %w", outputFile, err)
+			}
+			logger.Infof("Report written to file: %s", outputFile)
+		} else {
+			fmt.Print(output)
+		}
+
+		failedHosts := report.FailedHosts()
+		if len(failedHosts) > 0 {
+			logger.Error(fmt.Sprintf("Diagnostic tests failed on %d node(s): %v", len(failedHosts), failedHosts))
+			if outputFormat != "json" && outputFormat != "friendly" {
+				fmt.Printf("\n❌ Diagnostic tests failed on %d out of %d nodes\n", len(failedHosts), len(report.HostNames()))
+				fmt.Printf("Failed nodes: %s\n", strings.Join(failedHosts, ", "))
+			}
+			return fmt.Errorf("diagnostic tests failed")
+		}
+		return nil
+	},
+}
+
+// findResultFiles expands directories and glob patterns into a sorted list of result files
+func findResultFiles(args []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var files []string
+	for _, arg := range args {
+		pattern := arg
+		if info, err := os.Stat(arg); err == nil && info.IsDir() {
+			pattern = filepath.Join(arg, "*.json")
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %w", arg, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no result files found for %s", arg)
+		}
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func init() {
+	rootCmd.AddCommand(aggregateCmd)
+}
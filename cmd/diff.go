@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <baseline.json> <current.json>",
+	Short: "Compare two diagnostic result files",
+	Long: `Compare a baseline diagnostic result file against a current one and show which tests
+were added, removed, changed status or changed details between the two runs.
+For result files with several appended runs, the latest run of each file is compared.`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.Infof("Comparing results %s and %s", args[0], args[1])
+
+		baseline, err := reporter.LoadReportFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to load baseline results: %w", err)
+		}
+		current, err := reporter.LoadReportFile(args[1])
+		if err != nil {
+			return fmt.Errorf("failed to load current results: %w", err)
+		}
+
+		// Get output format from configuration
+		outputFormat := viper.GetString("output")
+		if outputFormat == "" {
+			outputFormat = "table" // Default to table format
+		}
+
+		output, err := reporter.FormatDiff(reporter.DiffReports(baseline, current), outputFormat)
+		if err != nil {
+			return fmt.Errorf("failed to format diff: %w", err)
+		}
+		fmt.Print(output)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
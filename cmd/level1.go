@@ -1,21 +1,67 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/level1_tests"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/metrics"
+	"github.com/oracle/oci-dr-hpc-v2/internal/notifier"
+	"github.com/oracle/oci-dr-hpc-v2/internal/oci"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/telemetry"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+	"github.com/oracle/oci-dr-hpc-v2/internal/testrunner"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	testFilter string
-	listTests  bool
+	testFilter      string
+	listTests       bool
+	parallelWorkers int
+	metricsPort     int
+	interval        time.Duration
+	watch           bool
+	watchWebhook    string
+	targets         string
+	sshKeyFile      string
+	ociMonitoring   bool
+	ociLogGroup     string
+	ociLogOCID      string
+	archiveDir      string
+	archiveMaxFiles int
+	archiveMaxAge   int
+	archiveCompress bool
+	uploadToOSS     string
+	generatePAR     bool
+	parExpiry       time.Duration
+	includeTags     bool
+	useCache        bool
+	otelEndpoint    string
+	force           bool
+	webhookURL      string
+	webhookStatuses []string
+	webhookSecret   string
+	outputDir       string
+	keepRuns        int
 )
 
+// defaultWatchInterval is the delay between runs in watch mode when --interval is not set
+const defaultWatchInterval = 60 * time.Second
+
 var level1Cmd = &cobra.Command{
 	Use:          "level1",
 	Short:        "Run Level 1 diagnostic tests",
@@ -31,23 +77,118 @@ var level1Cmd = &cobra.Command{
 		// Set append mode based on CLI flag
 		appendMode := viper.GetBool("append")
 		rep.SetAppendMode(appendMode)
+		rep.SetMaxRuns(viper.GetInt("max-runs"))
+
+		if outputDir != "" {
+			if outputFile != "" {
+				return fmt.Errorf("--output-dir and --output-file cannot be used together")
+			}
+			// Watch mode does not write a report, so every run would leave an empty run directory
+			if watch {
+				return fmt.Errorf("--output-dir cannot be used with --watch")
+			}
+			// Every run writes its own results file, so there is nothing to append to
+			rep.SetAppendMode(false)
+			reporter.SetKeepRuns(keepRuns)
+		}
+
+		if archiveDir != "" {
+			if outputFile == "" && outputDir == "" {
+				return fmt.Errorf("--archive-dir requires --output-file or --output-dir")
+			}
+			reporter.SetArchiveCompression(archiveCompress)
+			rep.SetArchive(archiveDir, archiveMaxFiles, archiveMaxAge)
+		}
 
 		if err := rep.Initialize(outputFile); err != nil {
 			logger.Errorf("Failed to initialize reporter: %v", err)
 			return fmt.Errorf("failed to initialize reporter: %w", err)
 		}
 
+		if includeTags {
+			definedTags, freeformTags, err := executor.GetCurrentInstanceTags()
+			if err != nil {
+				logger.Warnf("Instance tags not included in the report: %v", err)
+			} else {
+				rep.SetInstanceTags(definedTags, freeformTags)
+			}
+		}
+
 		// Check if --list-tests flag was provided
 		if listTests {
 			return runSpecificTests("")
 		}
 
-		// Check if --test flag was provided
-		if cmd.Flags().Changed("test") {
-			return runSpecificTests(testFilter)
+		if (ociLogGroup == "") != (ociLogOCID == "") {
+			return fmt.Errorf("--oci-log-group and --oci-log-ocid must be used together")
 		}
 
-		return runAllLevel1Tests()
+		if generatePAR && uploadToOSS == "" {
+			return fmt.Errorf("--generate-par requires --upload-to-oss")
+		}
+
+		if webhookURL != "" {
+			for i, status := range webhookStatuses {
+				webhookStatuses[i] = strings.ToUpper(strings.TrimSpace(status))
+				switch webhookStatuses[i] {
+				case "PASS", "WARN", "FAIL", "SKIP":
+				default:
+					return fmt.Errorf("invalid --webhook-on-status %q, must be PASS, WARN, FAIL or SKIP", status)
+				}
+			}
+		}
+
+		if targets != "" {
+			return runRemoteTests(cmd)
+		}
+
+		if otelEndpoint != "" {
+			shutdownTracing, err := startTracing()
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing()
+		}
+
+		runTests := func() error {
+			// Check if --test flag was provided
+			if cmd.Flags().Changed("test") {
+				return runSpecificTests(testFilter)
+			}
+			return runAllLevel1Tests()
+		}
+
+		if outputDir != "" {
+			runTests = withOutputDirectory(runTests)
+		}
+
+		if ociMonitoring {
+			runTests = withOCIMonitoring(runTests)
+		}
+
+		if uploadToOSS != "" {
+			runTests = withOSSUpload(runTests)
+		}
+
+		if webhookURL != "" {
+			runTests = withWebhook(runTests)
+		}
+
+		if ociLogOCID != "" {
+			var closeLogging func()
+			runTests, closeLogging = withOCILogging(runTests)
+			defer closeLogging()
+		}
+
+		if watch {
+			return runWatch(runTests)
+		}
+
+		if metricsPort > 0 || interval > 0 {
+			return runWithMetrics(runTests)
+		}
+
+		return runTests()
 	},
 }
 
@@ -55,6 +196,526 @@ func init() {
 	rootCmd.AddCommand(level1Cmd)
 	level1Cmd.Flags().StringVar(&testFilter, "test", "", "comma-separated list of specific tests to run (use --test=\"\" to list available tests)")
 	level1Cmd.Flags().BoolVar(&listTests, "list-tests", false, "list all available tests")
+	level1Cmd.Flags().IntVar(&parallelWorkers, "parallel", 0, fmt.Sprintf("run independent tests concurrently with the given number of workers (--parallel defaults to %d, use --parallel=N to set)", testrunner.DefaultWorkers))
+	level1Cmd.Flags().Lookup("parallel").NoOptDefVal = strconv.Itoa(testrunner.DefaultWorkers)
+	level1Cmd.Flags().IntVar(&metricsPort, "metrics-port", 0, "expose results of the last run as Prometheus metrics at /metrics on this port")
+	level1Cmd.Flags().DurationVar(&interval, "interval", 0, fmt.Sprintf("run diagnostics continuously with this delay between runs (e.g. 10m, defaults to %s with --watch)", defaultWatchInterval))
+	level1Cmd.Flags().BoolVar(&watch, "watch", false, "run diagnostics continuously and only print output when results change")
+	level1Cmd.Flags().StringVar(&watchWebhook, "watch-on-change-webhook", "", "POST the JSON diff to this URL when a test status changes in watch mode")
+	level1Cmd.Flags().StringVar(&targets, "targets", "", "run tests over SSH on a comma-separated list of hosts or the hosts in a hostfile")
+	level1Cmd.Flags().StringVar(&sshKeyFile, "ssh-key", "", "SSH private key for --targets (default: SSH agent and ssh config)")
+	level1Cmd.Flags().StringVar(&ociLogGroup, "oci-log-group", "", "OCID of the OCI Logging log group containing --oci-log-ocid")
+	level1Cmd.Flags().StringVar(&ociLogOCID, "oci-log-ocid", "", "OCID of an OCI Logging custom log to send structured test results to")
+	level1Cmd.Flags().BoolVar(&ociMonitoring, "oci-monitoring", false, fmt.Sprintf("post test results as custom metrics to the OCI Monitoring namespace %s", oci.MonitoringNamespace))
+	level1Cmd.Flags().StringVar(&archiveDir, "archive-dir", "", "copy the output file to this directory as oci-dr-hpc-<timestamp>.json after each run")
+	level1Cmd.Flags().IntVar(&archiveMaxFiles, "archive-max-files", 30, "keep at most this many archived reports in --archive-dir (0 for no limit)")
+	level1Cmd.Flags().IntVar(&archiveMaxAge, "archive-max-age-days", 30, "remove archived reports older than this many days from --archive-dir (0 for no limit)")
+	level1Cmd.Flags().BoolVar(&archiveCompress, "archive-compress", false, "gzip archived reports as oci-dr-hpc-<timestamp>.json.gz")
+	level1Cmd.Flags().BoolVar(&includeTags, "include-tags", false, "include the defined and freeform tags of the instance at the root of the report")
+	level1Cmd.Flags().BoolVar(&useCache, "use-cache", false, fmt.Sprintf("reuse passing results of tests with a cache_ttl_seconds in test limits from %s instead of running them", testrunner.DefaultCachePath))
+	level1Cmd.Flags().BoolVar(&force, "force", false, "skip the gpu_idle_check pre-check and run diagnostics while GPUs are in use")
+	level1Cmd.Flags().StringVar(&otelEndpoint, "otel-endpoint", "", "export OpenTelemetry traces of test executions and IMDS requests to this OTLP/HTTP endpoint (e.g. http://localhost:4318)")
+	level1Cmd.Flags().StringVar(&webhookURL, "webhook-url", "", "POST the results of tests with a --webhook-on-status status to this URL after each run")
+	level1Cmd.Flags().StringSliceVar(&webhookStatuses, "webhook-on-status", notifier.DefaultStatuses, "comma-separated test statuses notified to --webhook-url (PASS, WARN, FAIL, SKIP)")
+	level1Cmd.Flags().StringVar(&webhookSecret, "webhook-secret", "", fmt.Sprintf("sign --webhook-url payloads with HMAC-SHA256 using this secret, sent in the %s header", notifier.SignatureHeader))
+	level1Cmd.Flags().StringVar(&outputDir, "output-dir", "", fmt.Sprintf("write the results of each run to <dir>/run-<timestamp>/%s with a %s", reporter.RunResultsFile, reporter.RunMetadataFile))
+	level1Cmd.Flags().IntVar(&keepRuns, "keep-runs", reporter.DefaultKeepRuns, "keep at most this many run directories in --output-dir (0 for no limit)")
+	level1Cmd.Flags().StringVar(&uploadToOSS, "upload-to-oss", "", "upload the JSON report to this OCI Object Storage bucket as <instance-ocid>/oci-dr-hpc-<timestamp>.json after each run")
+	level1Cmd.Flags().BoolVar(&generatePAR, "generate-par", false, "create a read-only pre-authenticated request for the report uploaded with --upload-to-oss and print its URL")
+	level1Cmd.Flags().DurationVar(&parExpiry, "par-expiry", oci.DefaultPARExpiry, "validity of the pre-authenticated request created with --generate-par (e.g. 72h)")
+}
+
+// runWithMetrics runs the diagnostics and publishes the results on the metrics endpoint.
+// With --interval the diagnostics repeat until interrupted; otherwise the results of the
+// single run are served until interrupted.
+func runWithMetrics(runTests func() error) error {
+	publish, stopMetrics, err := startMetrics()
+	if err != nil {
+		return err
+	}
+	defer stopMetrics()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(stop)
+
+	rep := reporter.GetReporter()
+	for {
+		rep.Clear()
+		runErr := runTests()
+		publish()
+
+		if interval == 0 {
+			logger.Info("Diagnostic run complete, serving metrics until interrupted")
+			<-stop
+			return runErr
+		}
+
+		if runErr != nil {
+			logger.Errorf("Diagnostic run failed: %v", runErr)
+		}
+		logger.Infof("Next diagnostic run in %s", interval)
+
+		select {
+		case <-stop:
+			logger.Info("Stopping continuous diagnostics")
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// withOutputDirectory returns runTests writing the report of every run to a new run directory
+// in --output-dir, together with the metadata of the run
+func withOutputDirectory(runTests func() error) func() error {
+	return func() error {
+		rep := reporter.GetReporter()
+		runDir, err := reporter.PrepareOutputDirectory(outputDir)
+		if err != nil {
+			return err
+		}
+		if err := rep.Initialize(filepath.Join(runDir, reporter.RunResultsFile)); err != nil {
+			return fmt.Errorf("failed to initialize reporter: %w", err)
+		}
+
+		shape, err := executor.GetCurrentShape()
+		if err != nil {
+			logger.Errorf("Failed to get shape for run metadata: %v", err)
+			shape = "unknown"
+		}
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "unknown"
+		}
+		metadata := reporter.RunMetadata{
+			RunID:     filepath.Base(runDir),
+			StartTime: time.Now().UTC().Format(time.RFC3339),
+			Shape:     shape,
+			Hostname:  hostname,
+		}
+		if err := reporter.WriteRunMetadata(runDir, metadata); err != nil {
+			return err
+		}
+
+		return runTests()
+	}
+}
+
+// withOCIMonitoring returns runTests posting the results of every run to OCI Monitoring.
+// When no OCI API credentials are available the integration is disabled with a warning.
+func withOCIMonitoring(runTests func() error) func() error {
+	publisher, err := oci.NewMonitoringPublisher()
+	if err != nil {
+		logger.Warnf("OCI Monitoring disabled: %v", err)
+		return runTests
+	}
+
+	return func() error {
+		runErr := runTests()
+		if err := publisher.Publish(reporter.GetReporter().GetResults()); err != nil {
+			logger.Errorf("Failed to post results to OCI Monitoring: %v", err)
+		}
+		return runErr
+	}
+}
+
+// withOSSUpload returns runTests uploading the JSON report of every run to the --upload-to-oss
+// bucket. The report is uploaded after runTests has written the --output-file, so a failed upload
+// never loses the local report. When no OCI API credentials are available the upload is disabled
+// with a warning.
+func withOSSUpload(runTests func() error) func() error {
+	if generatePAR {
+		return withPARGeneration(runTests)
+	}
+
+	uploader, err := oci.NewReportUploader(uploadToOSS)
+	if err != nil {
+		logger.Warnf("OCI Object Storage upload disabled: %v", err)
+		return runTests
+	}
+
+	return func() error {
+		runErr := runTests()
+		report, err := reporter.GetReporter().JSONReport()
+		if err != nil {
+			logger.Errorf("Failed to generate report for upload: %v", err)
+			return runErr
+		}
+		if _, err := uploader.Upload(report); err != nil {
+			logger.Errorf("Failed to upload report to OCI Object Storage: %v", err)
+		}
+		return runErr
+	}
+}
+
+// withPARGeneration returns runTests uploading the JSON report of every run to the --upload-to-oss
+// bucket and printing the URL of a pre-authenticated request for it to stdout, to share the report
+// with Oracle Support. When no OCI API credentials are available the upload is disabled with a warning.
+func withPARGeneration(runTests func() error) func() error {
+	generator, err := oci.NewPARGenerator(uploadToOSS, parExpiry)
+	if err != nil {
+		logger.Warnf("OCI Object Storage upload disabled: %v", err)
+		return runTests
+	}
+
+	return func() error {
+		runErr := runTests()
+		report, err := reporter.GetReporter().JSONReport()
+		if err != nil {
+			logger.Errorf("Failed to generate report for upload: %v", err)
+			return runErr
+		}
+		url, err := generator.Generate(report)
+		if err != nil {
+			logger.Errorf("Failed to share report through OCI Object Storage: %v", err)
+			return runErr
+		}
+		fmt.Printf("Report PAR URL (expires in %s): %s\n", parExpiry, url)
+		return runErr
+	}
+}
+
+// withWebhook returns runTests notifying --webhook-url of the tests of every run with a
+// --webhook-on-status status. A failed notification is logged and does not fail the run.
+func withWebhook(runTests func() error) func() error {
+	return func() error {
+		runErr := runTests()
+		report, err := reporter.GetReporter().GenerateReport()
+		if err != nil {
+			logger.Errorf("Failed to generate report for webhook: %v", err)
+			return runErr
+		}
+		if err := notifier.NotifySignedWebhook(webhookURL, webhookSecret, report, webhookStatuses); err != nil {
+			logger.Errorf("Failed to notify webhook: %v", err)
+		}
+		return runErr
+	}
+}
+
+// withOCILogging returns runTests sending the results of every run to OCI Logging, and a
+// function flushing the buffered log entries. When no OCI API credentials are available
+// the integration is disabled with a warning.
+func withOCILogging(runTests func() error) (func() error, func()) {
+	client, err := oci.NewLoggingClient(ociLogGroup, ociLogOCID)
+	if err != nil {
+		logger.Warnf("OCI Logging disabled: %v", err)
+		return runTests, func() {}
+	}
+
+	run := func() error {
+		runErr := runTests()
+		client.LogTestResults(reporter.GetReporter().GetResults())
+		return runErr
+	}
+	closeLogging := func() {
+		if err := client.Close(); err != nil {
+			logger.Errorf("Failed to flush OCI Logging entries: %v", err)
+		}
+	}
+	return run, closeLogging
+}
+
+// startTracing sets up OpenTelemetry tracing to the --otel-endpoint and returns a function
+// exporting the remaining spans on exit
+func startTracing() (func(), error) {
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		logger.Errorf("Failed to get shape for tracing: %v", err)
+		shape = "unknown"
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	provider, err := telemetry.NewTracingProvider(context.Background(), otelEndpoint, shape, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	telemetry.SetGlobal(provider)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			logger.Errorf("Failed to export traces: %v", err)
+		}
+	}, nil
+}
+
+// testStatus returns the status a test reported, or the status implied by its error when the
+// test reported no result under its name
+func testStatus(rep *reporter.Reporter, testName string, err error) string {
+	if result, exists := rep.GetResults()[test_limits.ConfigName(testName)]; exists {
+		return result.Status
+	}
+	var disabledErr *testerrors.TestDisabledError
+	switch {
+	case errors.As(err, &disabledErr):
+		return "SKIP"
+	case err != nil:
+		return "FAIL"
+	}
+	return "PASS"
+}
+
+// startMetrics starts the metrics endpoint when --metrics-port is set. It returns a function
+// publishing the current reporter results and a function stopping the endpoint.
+func startMetrics() (func(), func(), error) {
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		logger.Errorf("Failed to get shape for metrics: %v", err)
+		shape = "unknown"
+	}
+
+	m := metrics.NewMetrics()
+	stopMetrics := func() {}
+	if metricsPort > 0 {
+		server, err := m.Serve(metricsPort)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to start metrics endpoint: %w", err)
+		}
+		stopMetrics = func() { server.Close() }
+	}
+
+	publish := func() {
+		m.Update(shape, reporter.GetReporter().GetResults())
+	}
+	return publish, stopMetrics, nil
+}
+
+// executeTests runs the given tests in dependency order, concurrently when --parallel is set,
+// and returns the names of the failed and warned tests in their original order, as reported
+// by the tests. Tests whose dependency failed are skipped; a dependency cycle is returned as
+// an error before any test runs.
+// With --use-cache, tests with a recent cached PASS result are replayed instead of run.
+func executeTests(tests []testrunner.Test) ([]string, []string, error) {
+	rep := reporter.GetReporter()
+
+	// Apply test dependencies, timeouts and retries from test_limits.json; --timeout overrides per-test timeouts
+	timeoutOverride := time.Duration(viper.GetInt("timeout")) * time.Second
+	var shape string
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		logger.Errorf("Failed to load test limits, running tests without dependencies, per-test timeouts or retries: %v", err)
+	} else {
+		shape, err = executor.GetCurrentShape()
+		if err != nil {
+			logger.Errorf("Failed to get shape, running tests without per-test timeouts or retries: %v", err)
+		} else {
+			// An unknown shape uses the limits of its shape family, shown in the report header
+			configShape, _ := limits.ResolveShape(shape)
+			rep.SetShape(shape, configShape)
+			logger.SetField("shape", shape)
+		}
+		for i := range tests {
+			tests[i].DependsOn = limits.GetTestDependencies(tests[i].Name)
+			if shape == "" {
+				continue
+			}
+			if timeoutOverride == 0 {
+				if testTimeout, err := limits.GetTimeoutForTest(shape, tests[i].Name); err == nil {
+					tests[i].Timeout = testTimeout
+				}
+			}
+			if retries, delay, err := limits.GetRetryPolicyForTest(shape, tests[i].Name); err == nil {
+				tests[i].Retries, tests[i].RetryDelay = retries, delay
+			}
+		}
+	}
+
+	var cache *testrunner.ResultCache
+	if useCache {
+		if shape == "" {
+			logger.Error("Test limits or shape unavailable, running tests without the result cache")
+		} else {
+			cache, tests = replayCachedResults(tests, limits, shape)
+		}
+	}
+
+	// With --otel-endpoint every test execution is traced in a span of the run
+	tracing := telemetry.Global()
+	endRun := tracing.StartRun("level1")
+	defer endRun()
+	if tracing.Enabled() {
+		for i := range tests {
+			name, fn := tests[i].Name, tests[i].Fn
+			tests[i].Fn = func() error {
+				return tracing.TraceTest(name, fn, func(err error) string { return testStatus(rep, name, err) })
+			}
+		}
+	}
+
+	// Commands are bounded by the longest test timeout so a stuck nvidia-smi or mlxlink gets killed
+	var commandTimeout time.Duration
+	for i := range tests {
+		if timeoutOverride > 0 {
+			tests[i].Timeout = timeoutOverride
+		}
+		if tests[i].Timeout > commandTimeout {
+			commandTimeout = tests[i].Timeout
+		}
+	}
+	executor.SetCommandTimeout(commandTimeout)
+
+	// A GPU workload skews clock, ECC and thermal results, so check GPUs are idle first
+	tests, results := runGPUIdlePreCheck(tests, rep)
+
+	if limits == nil {
+		logger.Error("Test dependencies unavailable, running tests sequentially in their listed order")
+		results = append(results, testrunner.RunSequential(tests)...)
+	} else {
+		scheduler, err := testrunner.NewScheduler(tests)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid test dependencies in test limits: %w", err)
+		}
+		workers := 1
+		if parallelWorkers > 0 {
+			workers = parallelWorkers
+			logger.Info(fmt.Sprintf("Running tests in parallel with %d workers", parallelWorkers))
+		}
+		results = append(results, scheduler.Run(workers)...)
+	}
+
+	failed := make(map[string]bool)
+	for _, name := range rep.GetFailedTests() {
+		failed[name] = true
+	}
+	warned := make(map[string]bool)
+	for _, name := range rep.GetWarnedTests() {
+		warned[name] = true
+	}
+
+	var failedTests, warnedTests []string
+	for _, result := range results {
+		rep.SetRetryCount(result.Name, result.Retries)
+
+		var disabledErr *testerrors.TestDisabledError
+		if errors.As(result.Err, &disabledErr) {
+			logger.Info(fmt.Sprintf("Test %s skipped: %v", result.Name, result.Err))
+			continue
+		}
+		var skippedErr *testerrors.TestSkippedError
+		if errors.As(result.Err, &skippedErr) {
+			logger.Info(fmt.Sprintf("Test %s skipped: %v", result.Name, result.Err))
+			rep.AddSkippedResult(test_limits.ConfigName(result.Name), skippedErr.Dependency, result.Err)
+			continue
+		}
+
+		// A test returning an error without reporting a result, e.g. when its config can't be loaded, failed
+		name := test_limits.ConfigName(result.Name)
+		_, reported := rep.GetResults()[name]
+		switch {
+		case failed[name] || (!reported && result.Err != nil):
+			logger.Error(fmt.Sprintf("Test %s failed: %v", result.Name, result.Err))
+			failedTests = append(failedTests, result.Name)
+		case warned[name]:
+			logger.Warnf("Test %s passed with warnings: %v", result.Name, result.Err)
+			warnedTests = append(warnedTests, result.Name)
+		}
+	}
+
+	if cache != nil {
+		updateResultCache(cache, tests, limits, shape)
+	}
+	return failedTests, warnedTests, nil
+}
+
+// runGPUIdlePreCheck removes gpu_idle_check from tests and, unless --force is set, runs it
+// before the remaining tests. When GPUs are in use the report gets a diagnostic note, since
+// the results of the remaining tests may not reflect the health of the node.
+func runGPUIdlePreCheck(tests []testrunner.Test, rep *reporter.Reporter) ([]testrunner.Test, []testrunner.Result) {
+	for i, test := range tests {
+		if test.Name != "gpu_idle_check" {
+			continue
+		}
+		remaining := append(append([]testrunner.Test{}, tests[:i]...), tests[i+1:]...)
+		if force {
+			logger.Info("Skipping GPU idle pre-check (--force)")
+			return remaining, nil
+		}
+
+		logger.Info("Running GPU idle pre-check before the remaining tests")
+		results := testrunner.RunSequential([]testrunner.Test{test})
+		if testStatus(rep, test.Name, results[0].Err) == "WARN" {
+			rep.SetDiagnosticNote(fmt.Sprintf("Results may be inaccurate due to active GPU workload during diagnostics: %v", results[0].Err))
+		}
+		return remaining, results
+	}
+	return tests, nil
+}
+
+// replayCachedResults loads the result cache, adds the cached results of tests still within
+// their cache TTL to the report and returns the cache with the tests that still need to run.
+// The cache is invalidated when the GPU driver version changed since it was written.
+func replayCachedResults(tests []testrunner.Test, limits *test_limits.TestLimits, shape string) (*testrunner.ResultCache, []testrunner.Test) {
+	rep := reporter.GetReporter()
+
+	driverVersion, err := executor.GetNvidiaSMIFullDriverVersion()
+	if err != nil {
+		logger.Warnf("Failed to get driver version for the result cache: %v", err)
+	}
+	cache := testrunner.LoadResultCache(testrunner.DefaultCachePath, driverVersion)
+
+	remaining := make([]testrunner.Test, 0, len(tests))
+	for _, test := range tests {
+		ttl, err := limits.GetCacheTTLForTest(shape, test.Name)
+		if err == nil {
+			if result, ok := cache.Get(test.Name, ttl); ok {
+				logger.Infof("Using cached result for test %s", test.Name)
+				rep.AddCachedResult(test_limits.ConfigName(test.Name), result)
+				continue
+			}
+		}
+		remaining = append(remaining, test)
+	}
+	return cache, remaining
+}
+
+// updateResultCache caches the passing results of the tests that ran and have a cache TTL,
+// and saves the cache. Any failed test invalidates the whole cache, since a failure may
+// come from a hardware change the cached tests did not see.
+func updateResultCache(cache *testrunner.ResultCache, tests []testrunner.Test, limits *test_limits.TestLimits, shape string) {
+	rep := reporter.GetReporter()
+
+	if failed := rep.GetFailedTests(); len(failed) > 0 {
+		logger.Infof("Invalidating result cache after failed tests: %s", strings.Join(failed, ", "))
+		cache.Invalidate()
+	} else {
+		sections, err := rep.ReportSections()
+		if err != nil {
+			logger.Errorf("Failed to update result cache: %v", err)
+			return
+		}
+		for _, test := range tests {
+			if ttl, err := limits.GetCacheTTLForTest(shape, test.Name); err != nil || ttl <= 0 {
+				continue
+			}
+			if section, ok := sections[test_limits.ConfigName(test.Name)]; ok && sectionPassed(section) {
+				cache.Put(test.Name, section)
+			}
+		}
+	}
+
+	if err := cache.Save(); err != nil {
+		logger.Errorf("Failed to save result cache: %v", err)
+	}
+}
+
+// sectionPassed reports whether every entry of a report section has status PASS
+func sectionPassed(section json.RawMessage) bool {
+	var entries []struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(section, &entries); err != nil || len(entries) == 0 {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.Status != "PASS" {
+			return false
+		}
+	}
+	return true
 }
 
 func runAllLevel1Tests() error {
@@ -73,7 +734,6 @@ func runAllLevel1Tests() error {
 		{"gid_index_check", level1_tests.RunGIDIndexCheck},
 		{"link_check", level1_tests.RunLinkCheck},
 		{"eth_link_check", level1_tests.RunEthLinkCheck},
-		{"auth_check", level1_tests.RunAuthCheck},
 		{"sram_error_check", level1_tests.RunSRAMCheck},
 		{"gpu_mode_check", level1_tests.RunGPUModeCheck},
 		{"gpu_driver_check", level1_tests.RunGPUDriverCheck},
@@ -88,16 +748,74 @@ func runAllLevel1Tests() error {
 		{"gpu_xid_check", level1_tests.RunGPUXIDCheck},
 		{"max_acc_check", level1_tests.RunMaxAccCheck},
 		{"row_remap_error_check", level1_tests.RunRowRemapErrorCheck},
+		{"gpu_vbios_check", level1_tests.RunGPUVBIOSCheck},
+		{"hugepages_check", level1_tests.RunHugepagesCheck},
+		{"numa_affinity_check", level1_tests.RunNUMAAffinityCheck},
+		{"ib_sm_check", level1_tests.RunIBSMCheck},
+		{"time_sync_check", level1_tests.RunTimeSyncCheck},
+		{"kernel_module_check", level1_tests.RunKernelModuleCheck},
+		{"systemd_service_check", level1_tests.RunSystemdServiceCheck},
+		{"iommu_check", level1_tests.RunIOMMUCheck},
+		{"nvlink_topology_check", level1_tests.RunNVLinkTopologyCheck},
+		{"pcie_replay_check", level1_tests.RunPCIeReplayCheck},
+		{"rdma_credit_check", level1_tests.RunRDMACreditCheck},
+		{"rdma_mtu_check", level1_tests.RunRDMAMTUCheck},
+		{"rdma_topology_check", level1_tests.RunRDMATopologyCheck},
+		{"bios_settings_check", level1_tests.RunBIOSSettingsCheck},
+		{"gpu_inforom_check", level1_tests.RunGPUInfoROMCheck},
+		{"gpu_row_remap_check", level1_tests.RunGPURowRemapCheck},
+		{"cpu_governor_check", level1_tests.RunCPUGovernorCheck},
+		{"gpu_firmware_check", level1_tests.RunGPUFirmwareCheck},
+		{"gpu_p2p_bw_check", level1_tests.RunGPUP2PBWCheck},
+		{"cpu_isolation_check", level1_tests.RunCPUIsolationCheck},
+		{"pcie_gen_check", level1_tests.RunPCIeGenCheck},
+		{"gpu_cstate_check", level1_tests.RunGPUCStateCheck},
+		{"nfs_mount_check", level1_tests.RunNFSMountCheck},
+		{"ber_trend_check", level1_tests.RunBERTrendCheck},
+		{"ib_cable_check", level1_tests.RunIBCableCheck},
+		{"pcie_rebar_check", level1_tests.RunPCIeReBARCheck},
+		{"gpu_idle_check", level1_tests.RunGPUIdleCheck},
+		{"pcie_device_count_check", level1_tests.RunPCIeDeviceCountCheck},
+		{"gpu_firmware_update_check", level1_tests.RunGPUFirmwareUpdateCheck},
+		{"gpu_reset_check", level1_tests.RunGPUResetCheck},
+		{"rdma_interface_speed_check", level1_tests.RunRDMAInterfaceSpeedCheck},
+		{"ib_port_state_check", level1_tests.RunIBPortStateCheck},
+		{"nic_firmware_check", level1_tests.RunNICFirmwareCheck},
+		{"rdma_pci_mapping_check", level1_tests.RunRDMAPCIMappingCheck},
+		{"gpu_pcie_topo_check", level1_tests.RunGPUPCIeTopoCheck},
+		{"mlxconfig_check", level1_tests.RunMLXConfigCheck},
+		{"gpu_power_rail_check", level1_tests.RunGPUPowerRailCheck},
+		{"gpu_temperature_check", level1_tests.RunGPUTemperatureCheck},
+		{"roce_vlan_check", level1_tests.RunRoCEVLANCheck},
+		{"ib_switch_port_check", level1_tests.RunIBSwitchPortCheck},
+		{"gpu_cpu_bw_check", level1_tests.RunGPUCPUBWCheck},
+		{"fabricmanager_log_check", level1_tests.RunFabricManagerLogCheck},
+		{"gpu_mem_temperature_check", level1_tests.RunGPUMemTemperatureCheck},
+		{"pcie_vendor_check", level1_tests.RunPCIeVendorCheck},
+		{"rdma_retry_counter_check", level1_tests.RunRDMARetryCounterCheck},
+		{"nvlink_bw_check", level1_tests.RunNVLinkBWCheck},
+		{"gpu_mig_profile_check", level1_tests.RunGPUMIGProfileCheck},
+		{"network_auth_check", level1_tests.RunNetworkAuthCheck},
+		{"dcgm_field_check", level1_tests.RunDCGMFieldCheck},
+		{"numa_distance_check", level1_tests.RunNUMADistanceCheck},
+		{"cpu_topology_check", level1_tests.RunCPUTopologyCheck},
+		{"memory_check", level1_tests.RunMemoryCheck},
+		{"gpu_firmware_consistency_check", level1_tests.RunGPUFirmwareConsistencyCheck},
 	}
 
-	var failedTests []string
-
+	runnerTests := make([]testrunner.Test, 0, len(tests))
 	for _, test := range tests {
-		logger.Info(fmt.Sprintf("Running test: %s", test.name))
-		if err := test.fn(); err != nil {
-			logger.Error(fmt.Sprintf("Test %s failed: %v", test.name, err))
-			failedTests = append(failedTests, test.name)
-		}
+		runnerTests = append(runnerTests, testrunner.Test{Name: test.name, Fn: test.fn})
+	}
+
+	failedTests, warnedTests, err := executeTests(runnerTests)
+	if err != nil {
+		return err
+	}
+
+	// Watch mode prints the changes between runs instead of the full report
+	if watch {
+		return nil
 	}
 
 	// Get output format from configuration
@@ -112,15 +830,19 @@ func runAllLevel1Tests() error {
 		return fmt.Errorf("failed to write report: %w", err)
 	}
 
-	// Print summary only if not using friendly or json format (which should have clean output)
-	if outputFormat != "friendly" && outputFormat != "json" {
+	// Print summary only if not using friendly, json, csv or yaml format (which should have clean output)
+	if outputFormat != "friendly" && outputFormat != "json" && outputFormat != "csv" && outputFormat != "yaml" {
 		rep.PrintSummary()
 	}
 
+	if len(warnedTests) > 0 && outputFormat != "json" && outputFormat != "friendly" && outputFormat != "csv" && outputFormat != "yaml" {
+		fmt.Printf("\n⚠️  Tests passed with warnings: %s\n", strings.Join(warnedTests, ", "))
+	}
+
 	if len(failedTests) > 0 {
 		logger.Error(fmt.Sprintf("Level 1 tests completed with %d failures: %v", len(failedTests), failedTests))
-		// Don't print additional failure messages for JSON or friendly format (keep output clean)
-		if outputFormat != "json" && outputFormat != "friendly" {
+		// Don't print additional failure messages for JSON, friendly, CSV or YAML format (keep output clean)
+		if outputFormat != "json" && outputFormat != "friendly" && outputFormat != "csv" && outputFormat != "yaml" {
 			fmt.Printf("\n❌ Level 1 diagnostic tests failed: %d out of %d tests failed\n", len(failedTests), len(tests))
 			fmt.Printf("Failed tests: %s\n", strings.Join(failedTests, ", "))
 		}
@@ -128,8 +850,8 @@ func runAllLevel1Tests() error {
 	}
 
 	logger.Info("All Level 1 tests completed successfully")
-	// Don't print additional success messages for JSON or friendly format (keep output clean)
-	if outputFormat != "json" && outputFormat != "friendly" {
+	// Don't print additional success messages for JSON, friendly, CSV or YAML format (keep output clean)
+	if outputFormat != "json" && outputFormat != "friendly" && outputFormat != "csv" && outputFormat != "yaml" {
 		fmt.Println("\n✅ All Level 1 diagnostic tests passed successfully!")
 	}
 	return nil
@@ -151,7 +873,6 @@ func runSpecificTests(testFilter string) error {
 		{"gid_index_check", "Check device GID Index are in range ", level1_tests.RunGIDIndexCheck},
 		{"link_check", "Check RDMA link state and parameters", level1_tests.RunLinkCheck},
 		{"eth_link_check", "Check Ethernet link state and parameters for 100GbE RoCE interfaces", level1_tests.RunEthLinkCheck},
-		{"auth_check", "Check authentication status of RDMA interfaces using wpa_cli", level1_tests.RunAuthCheck},
 		{"sram_error_check", "Check SRAM correctable and uncorrectable errors", level1_tests.RunSRAMCheck},
 		{"gpu_mode_check", "Check if GPU is in Multi-Instance GPU (MIG) mode", level1_tests.RunGPUModeCheck},
 		{"gpu_driver_check", "Check GPU driver version compatibility", level1_tests.RunGPUDriverCheck},
@@ -162,10 +883,63 @@ func runSpecificTests(testFilter string) error {
 		{"cdfp_cable_check", "Check CDFP cable connections between GPUs", level1_tests.RunCDFPCableCheck},
 		{"fabricmanager_check", "Check if nvidia-fabricmanager service is running", level1_tests.RunFabricManagerCheck},
 		{"hca_error_check", "Check for MLX5 HCA fatal errors in system logs", level1_tests.RunHCAErrorCheck},
-		{"missing_interface_check", "Check for missing PCIe interfaces (revision ff)", level1_tests.RunMissingInterfaceCheck},
+		{"missing_interface_check", "Check for missing PCIe interfaces (revision ff) and RDMA/VCN interfaces expected for the shape", level1_tests.RunMissingInterfaceCheck},
 		{"gpu_xid_check", "Check for NVIDIA GPU XID errors in system logs", level1_tests.RunGPUXIDCheck},
 		{"max_acc_check", "Check MAX_ACC_OUT_READ and ADVANCED_PCI_SETTINGS configuration", level1_tests.RunMaxAccCheck},
 		{"row_remap_error_check", "Check for GPU row remap errors using nvidia-smi", level1_tests.RunRowRemapErrorCheck},
+		{"gpu_vbios_check", "Check GPU VBIOS versions against approved and blacklisted versions", level1_tests.RunGPUVBIOSCheck},
+		{"hugepages_check", "Check hugepage counts and persistent reservation against shape limits", level1_tests.RunHugepagesCheck},
+		{"numa_affinity_check", "Check GPU NUMA affinity against the expected CPU-GPU NUMA mapping", level1_tests.RunNUMAAffinityCheck},
+		{"ib_sm_check", "Check InfiniBand ports are registered with a reachable master subnet manager", level1_tests.RunIBSMCheck},
+		{"time_sync_check", "Check NTP time synchronization status and clock offset", level1_tests.RunTimeSyncCheck},
+		{"kernel_module_check", "Check nvidia and mlx5_core kernel module versions match userspace driver and OFED", level1_tests.RunKernelModuleCheck},
+		{"systemd_service_check", "Check required HPC services are active and mutually exclusive services are inactive", level1_tests.RunSystemdServiceCheck},
+		{"iommu_check", "Check IOMMU mode from the kernel command line and GPU IOMMU groups", level1_tests.RunIOMMUCheck},
+		{"nvlink_topology_check", "Check that all GPUs are fully connected over NVLink in nvidia-smi topo -m", level1_tests.RunNVLinkTopologyCheck},
+		{"pcie_replay_check", "Check the rate of GPU PCIe replays over a 5 second sample", level1_tests.RunPCIeReplayCheck},
+		{"rdma_credit_check", "Check RDMA ports for credit errors and receive buffer overflows", level1_tests.RunRDMACreditCheck},
+		{"rdma_mtu_check", "Check RDMA network interfaces for the expected MTU", level1_tests.RunRDMAMTUCheck},
+		{"rdma_topology_check", "Check the RDMA devices found match the devices expected for the shape", level1_tests.RunRDMATopologyCheck},
+		{"bios_settings_check", "Check the BIOS version and hyperthreading state meet the shape requirements", level1_tests.RunBIOSSettingsCheck},
+		{"gpu_inforom_check", "Check every GPU InfoROM is readable and not corrupted", level1_tests.RunGPUInfoROMCheck},
+		{"gpu_row_remap_check", "Check for GPU row remap failures and remaps pending reboot", level1_tests.RunGPURowRemapCheck},
+		{"cpu_governor_check", "Check every CPU uses the expected cpufreq scaling governor", level1_tests.RunCPUGovernorCheck},
+		{"gpu_firmware_check", "Check GPU GSP firmware versions are supported and consistent across GPUs", level1_tests.RunGPUFirmwareCheck},
+		{"gpu_p2p_bw_check", "Check P2P bandwidth between NVLink-connected GPU pairs", level1_tests.RunGPUP2PBWCheck},
+		{"cpu_isolation_check", "Check isolated CPUs and nohz_full match the expected CPU isolation", level1_tests.RunCPUIsolationCheck},
+		{"pcie_gen_check", "Check GPU and NIC PCIe links run at their capable and expected generation and width", level1_tests.RunPCIeGenCheck},
+		{"gpu_cstate_check", "Check GPUs are in P0 with kernel runtime power management disabled", level1_tests.RunGPUCStateCheck},
+		{"nfs_mount_check", "Check expected NFS mounts are mounted and responsive", level1_tests.RunNFSMountCheck},
+		{"ber_trend_check", "Check the RDMA link BER trend over past runs", level1_tests.RunBERTrendCheck},
+		{"ib_cable_check", "Check optical cable signal quality of RDMA ports", level1_tests.RunIBCableCheck},
+		{"pcie_rebar_check", "Check GPU BAR1 is mapped at full size with resizable BAR", level1_tests.RunPCIeReBARCheck},
+		{"gpu_idle_check", "Check GPUs are idle before running diagnostics (pre-check, skipped with --force)", level1_tests.RunGPUIdleCheck},
+		{"pcie_device_count_check", "Check all expected GPU and RDMA NIC BDFs are enumerated by lspci", level1_tests.RunPCIeDeviceCountCheck},
+		{"gpu_firmware_update_check", "Check GPU GSP and VBIOS firmware against the latest recommended versions", level1_tests.RunGPUFirmwareUpdateCheck},
+		{"gpu_reset_check", "Check GPUs have not been reset more than the threshold since boot", level1_tests.RunGPUResetCheck},
+		{"rdma_interface_speed_check", "Check RDMA network interfaces run at the expected ethtool speed", level1_tests.RunRDMAInterfaceSpeedCheck},
+		{"ib_port_state_check", "Check every ibstat port is in the expected State and Physical state", level1_tests.RunIBPortStateCheck},
+		{"nic_firmware_check", "Check RDMA NIC firmware is consistent and not below the minimum supported version", level1_tests.RunNICFirmwareCheck},
+		{"rdma_pci_mapping_check", "Check every RDMA NIC BDF belongs to the expected RDMA device", level1_tests.RunRDMAPCIMappingCheck},
+		{"gpu_pcie_topo_check", "Check every GPU pair has P2P read access and is connected over NVLink where required", level1_tests.RunGPUPCIeTopoCheck},
+		{"mlxconfig_check", "Check mlxconfig parameters of every NIC against expected values", level1_tests.RunMLXConfigCheck},
+		{"gpu_power_rail_check", "Check GPU power rail readings are within bounds of TDP", level1_tests.RunGPUPowerRailCheck},
+		{"gpu_temperature_check", "Check GPU core and memory temperatures against warning and critical thresholds", level1_tests.RunGPUTemperatureCheck},
+		{"roce_vlan_check", "Check VLAN tagging of RoCE network interfaces", level1_tests.RunRoCEVLANCheck},
+		{"ib_switch_port_check", "Check switch port state and error counters of InfiniBand uplinks", level1_tests.RunIBSwitchPortCheck},
+		{"gpu_cpu_bw_check", "Measure host-to-device and device-to-host bandwidth of each GPU", level1_tests.RunGPUCPUBWCheck},
+		{"fabricmanager_log_check", "Scan the fabric manager log for warnings and errors", level1_tests.RunFabricManagerLogCheck},
+		{"gpu_mem_temperature_check", "Check GPU memory (HBM) temperature against thresholds", level1_tests.RunGPUMemTemperatureCheck},
+		{"pcie_vendor_check", "Validate vendor:device IDs of GPUs and RDMA NICs at their expected BDFs", level1_tests.RunPCIeVendorCheck},
+		{"rdma_retry_counter_check", "Check the RDMA retry and retransmission counter rate of every active port", level1_tests.RunRDMARetryCounterCheck},
+		{"nvlink_bw_check", "Measure NVLink all-reduce bus bandwidth across all GPUs", level1_tests.RunNVLinkBWCheck},
+		{"gpu_mig_profile_check", "Validate MIG GPU instance profiles on shapes that require MIG", level1_tests.RunGPUMIGProfileCheck},
+		{"network_auth_check", "Check authentication status of InfiniBand (saquery) and Ethernet (wpa_cli) RDMA interfaces", level1_tests.RunNetworkAuthCheck},
+		{"dcgm_field_check", "Validate DCGM GPU temperature, memory temperature, SM clock and PCIe throughput against thresholds", level1_tests.RunDCGMFieldCheck},
+		{"numa_distance_check", "Validate the NUMA node distance matrix against the expected matrix of the shape", level1_tests.RunNUMADistanceCheck},
+		{"cpu_topology_check", "Validate the number of CPU sockets, cores per socket and logical CPUs", level1_tests.RunCPUTopologyCheck},
+		{"memory_check", "Validate the memory capacity, DIMM count and memory speed", level1_tests.RunMemoryCheck},
+		{"gpu_firmware_consistency_check", "Check that all GPUs run the same VBIOS version", level1_tests.RunGPUFirmwareConsistencyCheck},
 	}
 
 	// If testFilter is empty, show available tests
@@ -186,16 +960,12 @@ func runSpecificTests(testFilter string) error {
 	testNames := strings.Split(testFilter, ",")
 	logger.Info(fmt.Sprintf("Running specific tests: %v", testNames))
 
-	var failedTests []string
+	var selectedTests []testrunner.Test
 
 	for _, testName := range testNames {
 		testName = strings.TrimSpace(testName)
 		if testFn, exists := testMap[testName]; exists {
-			logger.Info(fmt.Sprintf("Running test: %s", testName))
-			if err := testFn(); err != nil {
-				logger.Error(fmt.Sprintf("Test %s failed: %v", testName, err))
-				failedTests = append(failedTests, testName)
-			}
+			selectedTests = append(selectedTests, testrunner.Test{Name: testName, Fn: testFn})
 		} else {
 			fmt.Printf("❌ Unknown test: %s\n\n", testName)
 			fmt.Printf("Available Level 1 tests:\n")
@@ -205,11 +975,22 @@ func runSpecificTests(testFilter string) error {
 			fmt.Printf("\nUsage examples:\n")
 			fmt.Printf("  oci-dr-hpc level1 --test=gpu_count_check\n")
 			fmt.Printf("  oci-dr-hpc level1 --test=gpu_count_check,rdma_nics_count\n")
+			fmt.Printf("  oci-dr-hpc level1 --test=rdma_nics_count,link_check --parallel\n")
 			fmt.Printf("  oci-dr-hpc level1 --list-tests\n")
 			return fmt.Errorf("unknown test: %s", testName)
 		}
 	}
 
+	failedTests, warnedTests, err := executeTests(selectedTests)
+	if err != nil {
+		return err
+	}
+
+	// Watch mode prints the changes between runs instead of the full report
+	if watch {
+		return nil
+	}
+
 	// Get output format from configuration
 	outputFormat := viper.GetString("output")
 	if outputFormat == "" {
@@ -222,15 +1003,19 @@ func runSpecificTests(testFilter string) error {
 		return fmt.Errorf("failed to write report: %w", err)
 	}
 
-	// Print summary only if not using friendly or json format (which should have clean output)
-	if outputFormat != "friendly" && outputFormat != "json" {
+	// Print summary only if not using friendly, json, csv or yaml format (which should have clean output)
+	if outputFormat != "friendly" && outputFormat != "json" && outputFormat != "csv" && outputFormat != "yaml" {
 		rep.PrintSummary()
 	}
 
+	if len(warnedTests) > 0 && outputFormat != "json" && outputFormat != "friendly" && outputFormat != "csv" && outputFormat != "yaml" {
+		fmt.Printf("\n⚠️  Tests passed with warnings: %s\n", strings.Join(warnedTests, ", "))
+	}
+
 	if len(failedTests) > 0 {
 		logger.Error(fmt.Sprintf("Selected Level 1 tests completed with %d failures: %v", len(failedTests), failedTests))
-		// Don't print additional failure messages for JSON or friendly format (keep output clean)
-		if outputFormat != "json" && outputFormat != "friendly" {
+		// Don't print additional failure messages for JSON, friendly, CSV or YAML format (keep output clean)
+		if outputFormat != "json" && outputFormat != "friendly" && outputFormat != "csv" && outputFormat != "yaml" {
 			fmt.Printf("\n❌ Level 1 diagnostic tests failed: %d out of %d tests failed\n", len(failedTests), len(testNames))
 			fmt.Printf("Failed tests: %s\n", strings.Join(failedTests, ", "))
 		}
@@ -238,8 +1023,8 @@ func runSpecificTests(testFilter string) error {
 	}
 
 	logger.Info("Selected Level 1 tests completed successfully")
-	// Don't print additional success messages for JSON or friendly format (keep output clean)
-	if outputFormat != "json" && outputFormat != "friendly" {
+	// Don't print additional success messages for JSON, friendly, CSV or YAML format (keep output clean)
+	if outputFormat != "json" && outputFormat != "friendly" && outputFormat != "csv" && outputFormat != "yaml" {
 		fmt.Println("\n✅ All selected Level 1 diagnostic tests passed successfully!")
 	}
 	return nil
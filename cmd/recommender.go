@@ -10,7 +10,8 @@ import (
 )
 
 var (
-	resultsFile string
+	resultsFile  string
+	analyzeRunID string
 )
 
 var recommenderCmd = &cobra.Command{
@@ -34,7 +35,7 @@ The recommender reads test results generated by the reporter and suggests action
 		}
 
 		// Run the recommender with specified output format
-		if err := recommender.AnalyzeResults(resultsFile, outputFormat); err != nil {
+		if err := recommender.AnalyzeResults(resultsFile, analyzeRunID, outputFormat); err != nil {
 			logger.Errorf("Failed to analyze results: %v", err)
 			return fmt.Errorf("failed to analyze results: %w", err)
 		}
@@ -44,8 +45,28 @@ The recommender reads test results generated by the reporter and suggests action
 	},
 }
 
+var listRunsCmd = &cobra.Command{
+	Use:   "list-runs",
+	Short: "List the test runs of a results file",
+	Long: `List the test runs of a results file with their run IDs, timestamps and pass, warn and fail counts.
+The index or run ID of a run can be passed to the recommender with --analyze-run-id.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		runs, err := recommender.ListRuns(resultsFile)
+		if err != nil {
+			logger.Errorf("Failed to list runs: %v", err)
+			return fmt.Errorf("failed to list runs: %w", err)
+		}
+
+		fmt.Print(recommender.FormatRunsTable(runs))
+		return nil
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(recommenderCmd)
-	recommenderCmd.Flags().StringVarP(&resultsFile, "results-file", "r", "", "results file to analyze (required)")
-	recommenderCmd.MarkFlagRequired("results-file")
+	recommenderCmd.AddCommand(listRunsCmd)
+	recommenderCmd.PersistentFlags().StringVarP(&resultsFile, "results-file", "r", "", "results file to analyze (required)")
+	recommenderCmd.MarkPersistentFlagRequired("results-file")
+	recommenderCmd.Flags().StringVar(&analyzeRunID, "analyze-run-id", "", "run of an appended results file to analyze, a run ID or an index (0 for the oldest, -1 for the latest run)")
 }
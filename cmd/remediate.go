@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/recommender"
+	"github.com/spf13/cobra"
+)
+
+var (
+	remediateResultsFile string
+	remediateDryRun      bool
+	remediateExecute     bool
+)
+
+var remediateCmd = &cobra.Command{
+	Use:   "remediate",
+	Short: "Run the fix commands of recommendations",
+	Long: `Generate recommendations from a results file and run their commands.
+Only recommendations marked safe_to_autorun in recommendations.json are remediated.
+Use --remediate-dry-run to print the commands without running them, or --remediate to run them.
+Executed commands and their output are logged to /var/log/oci-dr-hpc/remediation.log.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if remediateDryRun == remediateExecute {
+			return fmt.Errorf("exactly one of --remediate-dry-run or --remediate is required")
+		}
+
+		if err := recommender.RemediateResults(remediateResultsFile, remediateDryRun); err != nil {
+			logger.Errorf("Failed to remediate results: %v", err)
+			return fmt.Errorf("failed to remediate results: %w", err)
+		}
+
+		logger.Info("Remediation completed successfully")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(remediateCmd)
+	remediateCmd.Flags().StringVarP(&remediateResultsFile, "results-file", "r", "", "results file to remediate (required)")
+	remediateCmd.Flags().BoolVar(&remediateDryRun, "remediate-dry-run", false, "print the commands that would be run without running them")
+	remediateCmd.Flags().BoolVar(&remediateExecute, "remediate", false, "run the commands of recommendations marked safe_to_autorun")
+	remediateCmd.MarkFlagRequired("results-file")
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/remote"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// runRemoteTests runs the Level 1 diagnostics on the --targets hosts over SSH and writes the combined report
+func runRemoteTests(cmd *cobra.Command) error {
+	if watch || metricsPort > 0 || interval > 0 {
+		return fmt.Errorf("--targets cannot be combined with --watch, --metrics-port or --interval")
+	}
+
+	// Get output format from configuration, and reject unsupported formats before any host runs
+	outputFormat := viper.GetString("output")
+	if outputFormat == "" {
+		outputFormat = "table" // Default to table format
+	}
+	if err := reporter.ValidateMultiHostFormat(outputFormat); err != nil {
+		return err
+	}
+
+	hosts, err := remote.ParseTargets(targets)
+	if err != nil {
+		return fmt.Errorf("failed to parse targets: %w", err)
+	}
+
+	runner, err := remote.NewSSHRunner(sshKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to create SSH runner: %w", err)
+	}
+
+	// Forward the test selection and execution flags to the remote runs
+	var args []string
+	if cmd.Flags().Changed("test") {
+		args = append(args, "--test="+testFilter)
+	}
+	if timeoutSeconds := viper.GetInt("timeout"); timeoutSeconds > 0 {
+		args = append(args, fmt.Sprintf("--timeout=%d", timeoutSeconds))
+	}
+	if parallelWorkers > 0 {
+		args = append(args, fmt.Sprintf("--parallel=%d", parallelWorkers))
+	}
+	if force {
+		args = append(args, "--force")
+	}
+	if ociMonitoring {
+		args = append(args, "--oci-monitoring")
+	}
+	if ociLogOCID != "" {
+		args = append(args, "--oci-log-group="+ociLogGroup, "--oci-log-ocid="+ociLogOCID)
+	}
+
+	logger.Infof("Running Level 1 tests on %d host(s)", len(hosts))
+	report := runner.Run(hosts, args)
+
+	output, err := reporter.FormatMultiHostReport(report, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to format report: %w", err)
+	}
+
+	if outputFile := viper.GetString("output-file"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(output), 0644); err != nil {
+			return fmt.Errorf("failed to write report to file %s: %w", outputFile, err)
+		}
+		logger.Infof("Report written to file: %s", outputFile)
+
+		if archiveDir != "" {
+			if err := reporter.ArchiveReport(outputFile, archiveDir, archiveMaxFiles, archiveMaxAge); err != nil {
+				logger.Errorf("Failed to archive report: %v", err)
+			}
+		}
+	} else {
+		fmt.Print(output)
+	}
+
+	failedHosts := report.FailedHosts()
+	if len(failedHosts) > 0 {
+		logger.Error(fmt.Sprintf("Level 1 tests failed on %d host(s): %v", len(failedHosts), failedHosts))
+		// Don't print additional failure messages for JSON, YAML, friendly or CSV format (keep output clean)
+		if outputFormat != "json" && outputFormat != "yaml" && outputFormat != "friendly" && outputFormat != "csv" {
+			fmt.Printf("\n❌ Level 1 diagnostic tests failed on %d out of %d hosts\n", len(failedHosts), len(hosts))
+			fmt.Printf("Failed hosts: %s\n", strings.Join(failedHosts, ", "))
+		}
+		return fmt.Errorf("diagnostic tests failed")
+	}
+
+	logger.Info("Level 1 tests completed successfully on all hosts")
+	if outputFormat != "json" && outputFormat != "yaml" && outputFormat != "friendly" && outputFormat != "csv" {
+		fmt.Printf("\n✅ All Level 1 diagnostic tests passed on %d hosts!\n", len(hosts))
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -33,6 +35,11 @@ var (
 	showVersion  bool
 	outputFile   string
 	appendMode   bool
+	maxRuns      int
+	timeoutSecs  int
+	noIMDSCache  bool
+	logFormat    string
+	logLevel     string
 )
 
 var rootCmd = &cobra.Command{
@@ -46,6 +53,17 @@ var rootCmd = &cobra.Command{
 		}
 		return cmd.Help()
 	},
+	// Log flags override the logging settings of the config file
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("log-level") {
+			level, err := logger.ParseLogLevel(logLevel)
+			if err != nil {
+				return err
+			}
+			logger.SetLogLevel(level)
+		}
+		return logger.SetLogFormat(logFormat)
+	},
 }
 
 func Execute() {
@@ -60,10 +78,15 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.oci-dr-hpc.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "output format (json|table|friendly)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "output format (json|table|friendly|csv|yaml)")
 	rootCmd.PersistentFlags().StringVarP(&testLevel, "level", "l", "L1", "test level (L1|L2|L3)")
 	rootCmd.PersistentFlags().StringVarP(&outputFile, "output-file", "f", "", "output file for JSON report (default: console output)")
 	rootCmd.PersistentFlags().BoolVar(&appendMode, "append", true, "append to existing file instead of overwriting (default: true)")
+	rootCmd.PersistentFlags().IntVar(&maxRuns, "max-runs", 0, "keep at most this many runs in the output file in append mode, dropping the oldest (default: keep all runs)")
+	rootCmd.PersistentFlags().IntVar(&timeoutSecs, "timeout", 0, "timeout in seconds for each test, overrides timeout_seconds in test_limits.json (default: per-test limits)")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", fmt.Sprintf("log line format (%s), json writes one object per line with timestamp, level, message and structured fields", strings.Join(logger.LogFormats, "|")))
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", fmt.Sprintf("log level (%s), overrides logging.level of the config file", strings.Join(logger.LogLevels, "|")))
+	rootCmd.PersistentFlags().BoolVar(&noIMDSCache, "no-imds-cache", false, "query IMDS on every metadata lookup instead of caching responses for 5 minutes")
 	rootCmd.Flags().BoolVar(&showVersion, "version", false, "show version information")
 
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
@@ -71,6 +94,9 @@ func init() {
 	viper.BindPFlag("level", rootCmd.PersistentFlags().Lookup("level"))
 	viper.BindPFlag("output-file", rootCmd.PersistentFlags().Lookup("output-file"))
 	viper.BindPFlag("append", rootCmd.PersistentFlags().Lookup("append"))
+	viper.BindPFlag("max-runs", rootCmd.PersistentFlags().Lookup("max-runs"))
+	viper.BindPFlag("timeout", rootCmd.PersistentFlags().Lookup("timeout"))
+	viper.BindPFlag("no-imds-cache", rootCmd.PersistentFlags().Lookup("no-imds-cache"))
 }
 
 func initConfig() {
@@ -100,6 +126,8 @@ func initConfig() {
 	viper.BindEnv("logging.file", "OCI_DR_HPC_LOGGING_FILE")
 	viper.BindEnv("shapes_file", "OCI_DR_HPC_SHAPES_FILE")
 
+	executor.SetIMDSCacheEnabled(!viper.GetBool("no-imds-cache"))
+
 	var configFileUsed string
 	if err := viper.ReadInConfig(); err == nil {
 		configFileUsed = viper.ConfigFileUsed()
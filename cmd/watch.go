@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/spf13/viper"
+)
+
+// runWatch re-runs the diagnostics every --interval until interrupted. The first run prints
+// the full report; later runs only print the diff against the previous run when results change.
+func runWatch(runTests func() error) error {
+	watchInterval := interval
+	if watchInterval == 0 {
+		watchInterval = defaultWatchInterval
+	}
+
+	// Get output format from configuration; CSV and YAML have no diff format so changes are shown as a table
+	outputFormat := viper.GetString("output")
+	if outputFormat == "" {
+		outputFormat = "table" // Default to table format
+	}
+	diffFormat := outputFormat
+	if diffFormat == "csv" || diffFormat == "yaml" {
+		diffFormat = "table"
+	}
+
+	publish, stopMetrics, err := startMetrics()
+	if err != nil {
+		return err
+	}
+	defer stopMetrics()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(stop)
+
+	rep := reporter.GetReporter()
+	var previous *reporter.ReportOutput
+	runs, changedRuns := 0, 0
+	started := time.Now()
+
+	for {
+		rep.Clear()
+		if err := runTests(); err != nil {
+			logger.Errorf("Diagnostic run failed: %v", err)
+		}
+		publish()
+		runs++
+
+		current, err := rep.GenerateReport()
+		if err != nil {
+			logger.Errorf("Failed to generate report: %v", err)
+		} else if previous == nil {
+			if err := rep.WriteReportWithFormat(outputFormat); err != nil {
+				logger.Errorf("Failed to write report: %v", err)
+			}
+			previous = current
+		} else if diff := reporter.DiffReports(previous, current); diff.HasChanges() {
+			changedRuns++
+			if err := printWatchChange(diff, diffFormat, outputFormat); err != nil {
+				logger.Errorf("Failed to report changes: %v", err)
+			}
+			if watchWebhook != "" && diff.HasStatusChanges() {
+				if err := reporter.PostDiff(watchWebhook, diff); err != nil {
+					logger.Errorf("Failed to notify webhook: %v", err)
+				}
+			}
+			previous = current
+		} else {
+			logger.Info("No changes since previous run")
+		}
+
+		logger.Infof("Next diagnostic run in %s", watchInterval)
+		select {
+		case <-stop:
+			logger.Info("Stopping watch mode")
+			printWatchSummary(runs, changedRuns, time.Since(started))
+			return nil
+		case <-time.After(watchInterval):
+		}
+	}
+}
+
+// printWatchChange prints the diff and rewrites the report file, if one is configured
+func printWatchChange(diff *reporter.DiffReport, diffFormat, outputFormat string) error {
+	output, err := reporter.FormatDiff(diff, diffFormat)
+	if err != nil {
+		return fmt.Errorf("failed to format diff: %w", err)
+	}
+	fmt.Printf("\n[%s] Results changed since previous run\n", time.Now().UTC().Format(time.RFC3339))
+	fmt.Print(output)
+
+	if viper.GetString("output-file") != "" {
+		return reporter.GetReporter().WriteReportWithFormat(outputFormat)
+	}
+	return nil
+}
+
+// printWatchSummary prints a summary of the watch session and the results of the last run
+func printWatchSummary(runs, changedRuns int, elapsed time.Duration) {
+	rep := reporter.GetReporter()
+	fmt.Printf("\n=== Watch Summary ===\n")
+	fmt.Printf("Duration: %s\n", elapsed.Round(time.Second))
+	fmt.Printf("Runs: %d\n", runs)
+	fmt.Printf("Runs with changes: %d\n", changedRuns)
+	fmt.Printf("Last run: %d passed, %d warned, %d failed\n", len(rep.GetPassedTests()), len(rep.GetWarnedTests()), len(rep.GetFailedTests()))
+}
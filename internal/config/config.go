@@ -3,6 +3,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -82,3 +83,32 @@ func GetShapesFilePath() string {
 	// This allows proper error messages pointing to the expected location
 	return productionPath
 }
+
+// ConfigFilePaths returns the locations searched for the config file name, in order of precedence:
+// local override > user > system > development. legacyPaths are searched after the system locations.
+func ConfigFilePaths(name string, legacyPaths ...string) []string {
+	paths := []string{"./" + name}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "oci-dr-hpc", name))
+	}
+	paths = append(paths,
+		filepath.Join("/etc/oci-dr-hpc", name),
+		filepath.Join("/usr/share/oci-dr-hpc", name),
+	)
+	paths = append(paths, legacyPaths...)
+	return append(paths, filepath.Join("configs", name))
+}
+
+// FindConfigFile reads the first config file name found in ConfigFilePaths and returns its
+// contents and path. The error wraps os.ErrNotExist when no config file is found.
+func FindConfigFile(name string, legacyPaths ...string) ([]byte, string, error) {
+	paths := ConfigFilePaths(name, legacyPaths...)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		return data, path, nil
+	}
+	return nil, "", fmt.Errorf("%s not found in any of %v: %w", name, paths, os.ErrNotExist)
+}
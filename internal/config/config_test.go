@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindConfigFile(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	dir := t.TempDir()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Failed to change directory: %v", err)
+	}
+	defer os.Chdir(wd)
+
+	// The development location is used when no other location has the file
+	if err := os.MkdirAll("configs", 0755); err != nil {
+		t.Fatalf("Failed to create configs directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join("configs", "test_config.json"), []byte("development"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	data, path, err := FindConfigFile("test_config.json")
+	if err != nil || string(data) != "development" || path != filepath.Join("configs", "test_config.json") {
+		t.Errorf("FindConfigFile() = %q, %q, %v, want the development config", data, path, err)
+	}
+
+	// A config in the current directory overrides every other location
+	if err := os.WriteFile("test_config.json", []byte("local"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	data, path, err = FindConfigFile("test_config.json")
+	if err != nil || string(data) != "local" || path != "./test_config.json" {
+		t.Errorf("FindConfigFile() = %q, %q, %v, want the local config", data, path, err)
+	}
+
+	if _, _, err := FindConfigFile("missing_config.json"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("FindConfigFile() error = %v, want os.ErrNotExist", err)
+	}
+}
+
+func TestConfigFilePathsLegacy(t *testing.T) {
+	paths := ConfigFilePaths("test_config.json", "/etc/legacy.json")
+	if len(paths) < 3 || paths[len(paths)-2] != "/etc/legacy.json" || paths[len(paths)-1] != filepath.Join("configs", "test_config.json") {
+		t.Errorf("ConfigFilePaths() = %v, want the legacy path before the development location", paths)
+	}
+}
@@ -48,7 +48,7 @@ func ExecuteScript(scriptPath, limitsFile, recommendationsFile, outputFormat str
 	// Load test limits if specified
 	if limitsFile != "" {
 		if err := loadTestLimits(limitsFile); err != nil {
-			logger.Infof("Warning: Failed to load test limits from %s: %v", limitsFile, err)
+			logger.Warnf("Failed to load test limits from %s: %v", limitsFile, err)
 		} else {
 			configInfo.LimitsFile = limitsFile
 			logger.Infof("Loaded test limits from: %s", limitsFile)
@@ -58,7 +58,7 @@ func ExecuteScript(scriptPath, limitsFile, recommendationsFile, outputFormat str
 	// Load recommendations if specified
 	if recommendationsFile != "" {
 		if err := loadRecommendations(recommendationsFile); err != nil {
-			logger.Infof("Warning: Failed to load recommendations from %s: %v", recommendationsFile, err)
+			logger.Warnf("Failed to load recommendations from %s: %v", recommendationsFile, err)
 		} else {
 			configInfo.RecommendationsFile = recommendationsFile
 			logger.Infof("Loaded recommendations from: %s", recommendationsFile)
@@ -95,7 +95,7 @@ func executeScript(scriptPath string, configInfo ConfigInfo) (*ScriptResult, err
 	default:
 		// Try to make it executable and run directly
 		if err := os.Chmod(scriptPath, 0755); err != nil {
-			logger.Infof("Warning: Failed to make script executable: %v", err)
+			logger.Warnf("Failed to make script executable: %v", err)
 		}
 		cmd = exec.Command(scriptPath)
 	}
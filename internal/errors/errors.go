@@ -0,0 +1,182 @@
+// Package errors defines the typed errors returned by diagnostic tests, so callers such as
+// the reporter and the recommender can tell failure modes apart with errors.As.
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ErrorTypeToolNotFound is the error type of TestToolNotFoundError
+	ErrorTypeToolNotFound = "tool_not_found"
+	// ErrorTypeExecution is the error type of TestExecutionError
+	ErrorTypeExecution = "execution_error"
+	// ErrorTypeThresholdExceeded is the error type of TestThresholdExceededError
+	ErrorTypeThresholdExceeded = "threshold_exceeded"
+	// ErrorTypeTimeout is the error type of TestTimeoutError
+	ErrorTypeTimeout = "timeout"
+	// ErrorTypeDisabled is the error type of TestDisabledError
+	ErrorTypeDisabled = "disabled"
+	// ErrorTypeSkipped is the error type of TestSkippedError
+	ErrorTypeSkipped = "skipped"
+)
+
+// ToolPackages maps the external tools run by diagnostic tests to the package providing them
+var ToolPackages = map[string]string{
+	"nvidia-smi":   "nvidia-utils",
+	"dmesg":        "util-linux",
+	"lspci":        "pciutils",
+	"lscpu":        "util-linux",
+	"dmidecode":    "dmidecode",
+	"ip":           "iproute2",
+	"rdma":         "iproute2",
+	"ethtool":      "ethtool",
+	"show_gids":    "mlnx-ofed-kernel-utils",
+	"ibdev2netdev": "mlnx-ofed-kernel-utils",
+	"ofed_info":    "ofed-scripts",
+	"mst":          "mft",
+	"mlxlink":      "mft",
+	"mlxconfig":    "mft",
+	"ibstat":       "infiniband-diags",
+	"sminfo":       "infiniband-diags",
+	"perfquery":    "infiniband-diags",
+	"chronyc":      "chrony",
+	"timedatectl":  "systemd",
+	"systemctl":    "systemd",
+	"lsmod":        "kmod",
+	"modinfo":      "kmod",
+	"wpa_cli":      "wpa_supplicant",
+	"dcgmi":        "datacenter-gpu-manager",
+}
+
+// TestDisabledError is returned by a test that is not enabled for the current shape
+type TestDisabledError struct {
+	TestName string
+	Shape    string
+}
+
+func (e *TestDisabledError) Error() string {
+	return fmt.Sprintf("Test not applicable for this shape %s", e.Shape)
+}
+
+// TestSkippedError is returned for a test that was not run because a test it depends on failed
+type TestSkippedError struct {
+	TestName   string
+	Dependency string
+}
+
+func (e *TestSkippedError) Error() string {
+	return fmt.Sprintf("test %s skipped because its dependency %s failed", e.TestName, e.Dependency)
+}
+
+// TestTimeoutError is returned for a test that did not finish within its timeout.
+// It wraps context.DeadlineExceeded so callers can match it with errors.Is or errors.As.
+type TestTimeoutError struct {
+	TestName string
+	Timeout  time.Duration
+}
+
+func (e *TestTimeoutError) Error() string {
+	return fmt.Sprintf("test %s timed out after %s", e.TestName, e.Timeout)
+}
+
+func (e *TestTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// TestToolNotFoundError is returned when a tool required by a test is not installed
+type TestToolNotFoundError struct {
+	TestName string
+	Tool     string
+	Package  string
+	Err      error
+}
+
+// NewToolNotFoundError creates a TestToolNotFoundError, looking up the package providing tool
+func NewToolNotFoundError(testName, tool string, err error) *TestToolNotFoundError {
+	return &TestToolNotFoundError{
+		TestName: testName,
+		Tool:     tool,
+		Package:  ToolPackages[tool],
+		Err:      err,
+	}
+}
+
+func (e *TestToolNotFoundError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s not found: %v", e.Tool, e.Err)
+	}
+	return fmt.Sprintf("%s not found", e.Tool)
+}
+
+func (e *TestToolNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// TestThresholdExceededError is returned when a measured value is outside its expected limit
+type TestThresholdExceededError struct {
+	TestName string
+	Metric   string
+	Actual   interface{}
+	Expected interface{}
+}
+
+func (e *TestThresholdExceededError) Error() string {
+	return fmt.Sprintf("%s threshold exceeded: actual %v, expected %v", e.Metric, e.Actual, e.Expected)
+}
+
+// TestExecutionError is returned when a command run by a test fails. ExitCode is -1
+// when the command did not report one.
+type TestExecutionError struct {
+	TestName string
+	Cmd      string
+	ExitCode int
+	Stderr   string
+	Err      error
+}
+
+func (e *TestExecutionError) Error() string {
+	message := fmt.Sprintf("command %q failed", e.Cmd)
+	if e.ExitCode >= 0 {
+		message += fmt.Sprintf(" with exit code %d", e.ExitCode)
+	}
+	if e.Err != nil {
+		message += fmt.Sprintf(": %v", e.Err)
+	}
+	return message
+}
+
+func (e *TestExecutionError) Unwrap() error {
+	return e.Err
+}
+
+// Type returns the error type of the first typed test error in err's chain, or an empty
+// string when err is not a typed test error
+func Type(err error) string {
+	var (
+		toolErr      *TestToolNotFoundError
+		executionErr *TestExecutionError
+		thresholdErr *TestThresholdExceededError
+		timeoutErr   *TestTimeoutError
+		disabledErr  *TestDisabledError
+		skippedErr   *TestSkippedError
+	)
+	switch {
+	case stderrors.As(err, &toolErr):
+		return ErrorTypeToolNotFound
+	case stderrors.As(err, &executionErr):
+		return ErrorTypeExecution
+	case stderrors.As(err, &thresholdErr):
+		return ErrorTypeThresholdExceeded
+	case stderrors.As(err, &timeoutErr):
+		return ErrorTypeTimeout
+	case stderrors.As(err, &disabledErr):
+		return ErrorTypeDisabled
+	case stderrors.As(err, &skippedErr):
+		return ErrorTypeSkipped
+	}
+	return ""
+}
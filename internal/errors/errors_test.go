@@ -0,0 +1,114 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestErrorMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "Disabled",
+			err:      &TestDisabledError{TestName: "gpu_count_check", Shape: "VM.Standard.E4.Flex"},
+			expected: "Test not applicable for this shape VM.Standard.E4.Flex",
+		},
+		{
+			name:     "Skipped",
+			err:      &TestSkippedError{TestName: "link_check", Dependency: "rdma_nics_count"},
+			expected: "test link_check skipped because its dependency rdma_nics_count failed",
+		},
+		{
+			name:     "Timeout",
+			err:      &TestTimeoutError{TestName: "link_check", Timeout: 2 * time.Minute},
+			expected: "test link_check timed out after 2m0s",
+		},
+		{
+			name:     "Tool not found",
+			err:      &TestToolNotFoundError{TestName: "max_acc_check", Tool: "mlxconfig"},
+			expected: "mlxconfig not found",
+		},
+		{
+			name:     "Tool not found with cause",
+			err:      &TestToolNotFoundError{TestName: "max_acc_check", Tool: "mlxconfig", Err: exec.ErrNotFound},
+			expected: "mlxconfig not found: executable file not found in $PATH",
+		},
+		{
+			name:     "Threshold exceeded",
+			err:      &TestThresholdExceededError{TestName: "gpu_count_check", Metric: "gpu_count", Actual: 7, Expected: 8},
+			expected: "gpu_count threshold exceeded: actual 7, expected 8",
+		},
+		{
+			name:     "Execution",
+			err:      &TestExecutionError{TestName: "pcie_error_check", Cmd: "sudo dmesg", ExitCode: 1, Err: fmt.Errorf("exit status 1")},
+			expected: `command "sudo dmesg" failed with exit code 1: exit status 1`,
+		},
+		{
+			name:     "Execution without exit code",
+			err:      &TestExecutionError{TestName: "gpu_count_check", Cmd: "nvidia-smi -q", ExitCode: -1},
+			expected: `command "nvidia-smi -q" failed`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.expected {
+				t.Errorf("Error() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	if !stderrors.Is(&TestTimeoutError{}, context.DeadlineExceeded) {
+		t.Error("TestTimeoutError should match context.DeadlineExceeded")
+	}
+	if !stderrors.Is(NewToolNotFoundError("max_acc_check", "mlxconfig", exec.ErrNotFound), exec.ErrNotFound) {
+		t.Error("TestToolNotFoundError should wrap its cause")
+	}
+	cause := fmt.Errorf("exit status 2")
+	if !stderrors.Is(&TestExecutionError{Err: cause}, cause) {
+		t.Error("TestExecutionError should wrap its cause")
+	}
+}
+
+func TestNewToolNotFoundError(t *testing.T) {
+	err := NewToolNotFoundError("ib_sm_check", "ibstat", nil)
+	if err.TestName != "ib_sm_check" || err.Tool != "ibstat" || err.Package != "infiniband-diags" {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+
+	if err := NewToolNotFoundError("custom_check", "unknown-tool", nil); err.Package != "" {
+		t.Errorf("Expected no package for unknown tool, got %s", err.Package)
+	}
+}
+
+func TestType(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{&TestToolNotFoundError{}, ErrorTypeToolNotFound},
+		{&TestExecutionError{}, ErrorTypeExecution},
+		{&TestThresholdExceededError{}, ErrorTypeThresholdExceeded},
+		{&TestTimeoutError{}, ErrorTypeTimeout},
+		{&TestDisabledError{}, ErrorTypeDisabled},
+		{&TestSkippedError{}, ErrorTypeSkipped},
+		{fmt.Errorf("failed to run lsmod: %w", &TestExecutionError{}), ErrorTypeExecution},
+		{fmt.Errorf("untyped"), ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Type(tt.err); got != tt.expected {
+			t.Errorf("Type(%v) = %q, want %q", tt.err, got, tt.expected)
+		}
+	}
+}
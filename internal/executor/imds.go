@@ -1,13 +1,18 @@
 package executor
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"time"
 
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 const (
@@ -15,12 +20,54 @@ const (
 	IMDSBaseURL = "http://169.254.169.254/opc/v2"
 	// IMDSTimeout is the default timeout for IMDS requests
 	IMDSTimeout = 5 * time.Second
+	// IMDSMaxRetries is the default number of retries of a failed IMDS request
+	IMDSMaxRetries = 3
+	// IMDSInitialRetryDelay is the default delay before the first retry
+	IMDSInitialRetryDelay = 500 * time.Millisecond
+	// IMDSMaxRetryDelay is the default upper bound of the delay between retries
+	IMDSMaxRetryDelay = 5 * time.Second
 )
 
+// RetryConfig controls how failed IMDS requests are retried. The delay doubles after
+// every attempt up to MaxDelay; a MaxRetries of 0 disables retries.
+type RetryConfig struct {
+	MaxRetries   int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// DefaultRetryConfig returns the retry configuration used by NewIMDSClient
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:   IMDSMaxRetries,
+		InitialDelay: IMDSInitialRetryDelay,
+		MaxDelay:     IMDSMaxRetryDelay,
+	}
+}
+
+// delay returns the backoff before retry number attempt (starting at 1), with jitter
+// so concurrent callers do not retry in lockstep
+func (r RetryConfig) delay(attempt int) time.Duration {
+	delay := r.InitialDelay
+	for i := 1; i < attempt && delay < r.MaxDelay; i++ {
+		delay *= 2
+	}
+	if r.MaxDelay > 0 && delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Wait between half and the full backoff
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
 // IMDSClient represents a client for OCI Instance Metadata Service
 type IMDSClient struct {
 	httpClient *http.Client
 	baseURL    string
+	cache      *imdsCache
+	retry      RetryConfig
 }
 
 // InstanceMetadata represents the instance metadata structure according to OCI IMDS v2 spec
@@ -107,33 +154,77 @@ type HostMetadata struct {
 
 // NewIMDSClient creates a new IMDS client
 func NewIMDSClient() *IMDSClient {
-	return &IMDSClient{
-		httpClient: &http.Client{
-			Timeout: IMDSTimeout,
-		},
-		baseURL: IMDSBaseURL,
-	}
+	return NewIMDSClientWithRetry(IMDSTimeout, DefaultRetryConfig())
 }
 
 // NewIMDSClientWithTimeout creates a new IMDS client with custom timeout
 func NewIMDSClientWithTimeout(timeout time.Duration) *IMDSClient {
+	return NewIMDSClientWithRetry(timeout, DefaultRetryConfig())
+}
+
+// NewIMDSClientWithRetry creates a new IMDS client with custom timeout and retry configuration
+func NewIMDSClientWithRetry(timeout time.Duration, retry RetryConfig) *IMDSClient {
 	return &IMDSClient{
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
 		baseURL: IMDSBaseURL,
+		retry:   retry,
 	}
 }
 
-// makeRequest makes an HTTP request to the IMDS endpoint
+// makeRequest makes an HTTP request to the IMDS endpoint. With tracing enabled the request
+// is traced in an imds span with a child span for every HTTP attempt.
 func (c *IMDSClient) makeRequest(endpoint string) ([]byte, error) {
+	ctx, span := telemetry.Global().StartSpan(context.Background(), "imds "+endpoint, attribute.String("imds.endpoint", endpoint))
+	defer span.End()
+
 	url := fmt.Sprintf("%s/%s", c.baseURL, endpoint)
+	if c.cache != nil {
+		if body, ok := c.cache.get(url); ok {
+			logger.Debugf("Using cached IMDS response for: %s", url)
+			span.SetAttributes(attribute.Bool("imds.cached", true))
+			return body, nil
+		}
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.retry.MaxRetries; attempt++ {
+		if attempt > 0 {
+			delay := c.retry.delay(attempt)
+			logger.Debugf("Retrying IMDS request to %s in %v (attempt %d/%d): %v", url, delay, attempt, c.retry.MaxRetries, lastErr)
+			time.Sleep(delay)
+		}
+
+		body, retryable, err := c.doRequest(ctx, url)
+		if err == nil {
+			if c.cache != nil {
+				c.cache.set(url, body)
+			}
+			return body, nil
+		}
+		lastErr = err
+		if !retryable {
+			break
+		}
+	}
+
+	logger.Errorf("IMDS request to %s failed: %v", url, lastErr)
+	span.SetStatus(codes.Error, lastErr.Error())
+	return nil, lastErr
+}
+
+// doRequest performs a single IMDS request and reports whether a failure is transient:
+// connection errors, throttling and server errors are retried, other statuses are not
+func (c *IMDSClient) doRequest(ctx context.Context, url string) ([]byte, bool, error) {
 	logger.Debugf("Making IMDS request to: %s", url)
 
-	req, err := http.NewRequest("GET", url, nil)
+	ctx, span := telemetry.Global().StartSpan(ctx, "HTTP GET", attribute.String("http.url", url))
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		logger.Errorf("Failed to create IMDS request: %v", err)
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set the required Authorization header for OCI IMDS v2
@@ -142,24 +233,24 @@ func (c *IMDSClient) makeRequest(endpoint string) ([]byte, error) {
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logger.Errorf("IMDS request failed: %v", err)
-		return nil, fmt.Errorf("request failed: %w", err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, true, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
 
 	if resp.StatusCode != http.StatusOK {
-		logger.Errorf("IMDS request returned status %d", resp.StatusCode)
-		return nil, fmt.Errorf("request failed with status %d", resp.StatusCode)
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		return nil, retryable, fmt.Errorf("request failed with status %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		logger.Errorf("Failed to read IMDS response: %v", err)
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, true, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	logger.Debugf("IMDS response received: %d bytes", len(body))
-	return body, nil
+	return body, false, nil
 }
 
 // GetInstanceMetadata retrieves instance metadata from IMDS
@@ -432,6 +523,18 @@ func (c *IMDSClient) GetInstanceState() (string, error) {
 	return metadata.State, nil
 }
 
+// GetInstanceTags retrieves the defined tags, keyed by tag namespace, and the freeform tags from instance metadata
+func (c *IMDSClient) GetInstanceTags() (map[string]interface{}, map[string]interface{}, error) {
+	logger.Info("Retrieving instance tags from IMDS")
+
+	metadata, err := c.GetInstanceMetadata()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get instance tags: %w", err)
+	}
+
+	return metadata.DefinedTags, metadata.FreeformTags, nil
+}
+
 // GetRegionInfo retrieves the region information from instance metadata
 func (c *IMDSClient) GetRegionInfo() (*RegionInfo, error) {
 	logger.Info("Retrieving region info from IMDS")
@@ -454,7 +557,7 @@ func (c *IMDSClient) GetRawMetadata(endpoint string) ([]byte, error) {
 func (c *IMDSClient) IsRunningOnOCI() bool {
 	logger.Debug("Checking if running on OCI instance")
 
-	// Try to get instance metadata with a short timeout
+	// Try to get instance metadata with a short timeout and no retries
 	client := &IMDSClient{
 		httpClient: &http.Client{
 			Timeout: 2 * time.Second,
@@ -497,37 +600,37 @@ func (c *IMDSClient) GetInstanceInfo() (map[string]interface{}, error) {
 
 // GetCurrentShape is a convenience function to get the current instance shape
 func GetCurrentShape() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetShape()
 }
 
 // GetCurrentRegion is a convenience function to get the current region
 func GetCurrentRegion() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetRegion()
 }
 
 // GetCurrentInstanceMetadata is a convenience function to get current instance metadata
 func GetCurrentInstanceMetadata() (*InstanceMetadata, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetInstanceMetadata()
 }
 
 // GetCurrentIdentityMetadata is a convenience function to get current identity metadata
 func GetCurrentIdentityMetadata() (*IdentityMetadata, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetIdentityMetadata()
 }
 
 // GetCurrentVnicMetadata is a convenience function to get current VNIC metadata
 func GetCurrentVnicMetadata() ([]VnicMetadata, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetVnicMetadata()
 }
 
 // GetCurrentPrimaryVnic is a convenience function to get the primary VNIC metadata
 func GetCurrentPrimaryVnic() (*VnicMetadata, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetPrimaryVnic()
 }
 
@@ -541,55 +644,55 @@ func IsRunningOnOCIInstance() bool {
 
 // GetCurrentHostname is a convenience function to get the current hostname
 func GetCurrentHostname() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetHostname()
 }
 
 // GetCurrentCanonicalRegionName is a convenience function to get the full region name
 func GetCurrentCanonicalRegionName() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetCanonicalRegionName()
 }
 
 // GetCurrentOciAdName is a convenience function to get the OCI AD name
 func GetCurrentOciAdName() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetOciAdName()
 }
 
 // GetCurrentImageOCID is a convenience function to get the image OCID
 func GetCurrentImageOCID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetImageOCID()
 }
 
 // GetCurrentInstanceOCID is a convenience function to get the instance OCID
 func GetCurrentInstanceOCID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetInstanceOCID()
 }
 
 // GetCurrentCompartmentOCID is a convenience function to get the compartment OCID
 func GetCurrentCompartmentOCID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetCompartmentOCID()
 }
 
 // GetCurrentInstanceState is a convenience function to get the instance state
 func GetCurrentInstanceState() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetInstanceState()
 }
 
 // GetCurrentRegionInfo is a convenience function to get the region info
 func GetCurrentRegionInfo() (*RegionInfo, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetRegionInfo()
 }
 
 // GetCurrentTenantID is a convenience function to get the tenant ID
 func GetCurrentTenantID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	metadata, err := client.GetInstanceMetadata()
 	if err != nil {
 		return "", fmt.Errorf("failed to get tenant ID: %w", err)
@@ -599,7 +702,7 @@ func GetCurrentTenantID() (string, error) {
 
 // GetCurrentShapeConfig is a convenience function to get the shape configuration
 func GetCurrentShapeConfig() (*ShapeConfig, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	metadata, err := client.GetInstanceMetadata()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shape config: %w", err)
@@ -609,7 +712,7 @@ func GetCurrentShapeConfig() (*ShapeConfig, error) {
 
 // GetCurrentAgentConfig is a convenience function to get the agent configuration
 func GetCurrentAgentConfig() (*AgentConfig, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	metadata, err := client.GetInstanceMetadata()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get agent config: %w", err)
@@ -617,34 +720,40 @@ func GetCurrentAgentConfig() (*AgentConfig, error) {
 	return metadata.AgentConfig, nil
 }
 
+// GetCurrentInstanceTags is a convenience function to get the defined and freeform tags of the instance
+func GetCurrentInstanceTags() (map[string]interface{}, map[string]interface{}, error) {
+	client := currentIMDSClient()
+	return client.GetInstanceTags()
+}
+
 // Convenience functions for host metadata
 
 // GetCurrentHostMetadata is a convenience function to get current host metadata
 func GetCurrentHostMetadata() (*HostMetadata, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetHostMetadata()
 }
 
 // GetCurrentRackID is a convenience function to get the current rack ID
 func GetCurrentRackID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetRackID()
 }
 
 // GetCurrentBuildingID is a convenience function to get the current building ID
 func GetCurrentBuildingID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetBuildingID()
 }
 
 // GetCurrentHostID is a convenience function to get the current host ID
 func GetCurrentHostID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetHostID()
 }
 
 // GetCurrentNetworkBlockID is a convenience function to get the current network block ID
 func GetCurrentNetworkBlockID() (string, error) {
-	client := NewIMDSClient()
+	client := currentIMDSClient()
 	return client.GetNetworkBlockID()
 }
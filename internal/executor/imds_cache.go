@@ -0,0 +1,105 @@
+package executor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// DefaultIMDSCacheTTL is how long IMDS responses are cached by default
+const DefaultIMDSCacheTTL = 300 * time.Second
+
+// imdsCacheEntry represents a cached IMDS response
+type imdsCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// imdsCache stores successful IMDS responses keyed by endpoint URL
+type imdsCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[string]imdsCacheEntry
+}
+
+// get returns the cached response for url if it has not expired
+func (c *imdsCache) get(url string) ([]byte, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, exists := c.entries[url]
+	if !exists {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, url)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// set caches the response for url
+func (c *imdsCache) set(url string, body []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[url] = imdsCacheEntry{body: body, expires: time.Now().Add(c.ttl)}
+}
+
+// clear removes all cached responses
+func (c *imdsCache) clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries = make(map[string]imdsCacheEntry)
+}
+
+// CachedIMDSClient is an IMDSClient that keeps successful responses in memory for a TTL,
+// so tests asking for the same metadata share a single request. Failed requests are not cached.
+type CachedIMDSClient struct {
+	*IMDSClient
+}
+
+// NewCachedIMDSClient creates an IMDS client caching responses for ttl
+func NewCachedIMDSClient(ttl time.Duration) *CachedIMDSClient {
+	client := NewIMDSClient()
+	client.cache = &imdsCache{ttl: ttl, entries: make(map[string]imdsCacheEntry)}
+	return &CachedIMDSClient{IMDSClient: client}
+}
+
+// Clear removes all cached responses
+func (c *CachedIMDSClient) Clear() {
+	c.cache.clear()
+}
+
+var (
+	sharedIMDSMutex  sync.Mutex
+	sharedIMDSClient *CachedIMDSClient
+	imdsCacheEnabled = true
+)
+
+// SetIMDSCacheEnabled enables or disables caching for the GetCurrent* convenience functions
+func SetIMDSCacheEnabled(enabled bool) {
+	sharedIMDSMutex.Lock()
+	defer sharedIMDSMutex.Unlock()
+
+	imdsCacheEnabled = enabled
+	if !enabled {
+		logger.Debug("IMDS cache disabled")
+		sharedIMDSClient = nil
+	}
+}
+
+// currentIMDSClient returns the client used by the GetCurrent* convenience functions:
+// a process-wide cached client, or a fresh uncached client when caching is disabled
+func currentIMDSClient() *IMDSClient {
+	sharedIMDSMutex.Lock()
+	defer sharedIMDSMutex.Unlock()
+
+	if !imdsCacheEnabled {
+		return NewIMDSClient()
+	}
+	if sharedIMDSClient == nil {
+		sharedIMDSClient = NewCachedIMDSClient(DefaultIMDSCacheTTL)
+	}
+	return sharedIMDSClient.IMDSClient
+}
@@ -0,0 +1,103 @@
+package executor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestIMDSServer returns an IMDS server counting the requests it receives
+func newTestIMDSServer(t *testing.T, status *int32) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		w.Write([]byte(`{"shape":"BM.GPU.H100.8","hostname":"gpu-node-1"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestCachedIMDSClient(t *testing.T) {
+	status := int32(http.StatusOK)
+	server, requests := newTestIMDSServer(t, &status)
+
+	client := NewCachedIMDSClient(time.Minute)
+	client.baseURL = server.URL
+
+	for i := 0; i < 3; i++ {
+		shape, err := client.GetShape()
+		if err != nil || shape != "BM.GPU.H100.8" {
+			t.Fatalf("GetShape() = %s, %v", shape, err)
+		}
+	}
+	if hostname, err := client.GetHostname(); err != nil || hostname != "gpu-node-1" {
+		t.Fatalf("GetHostname() = %s, %v", hostname, err)
+	}
+	if count := atomic.LoadInt32(requests); count != 1 {
+		t.Errorf("Expected 1 IMDS request for the same endpoint, got %d", count)
+	}
+
+	client.Clear()
+	if _, err := client.GetShape(); err != nil {
+		t.Fatalf("GetShape() error = %v", err)
+	}
+	if count := atomic.LoadInt32(requests); count != 2 {
+		t.Errorf("Expected a new IMDS request after Clear, got %d requests", count)
+	}
+}
+
+func TestCachedIMDSClientExpiry(t *testing.T) {
+	status := int32(http.StatusOK)
+	server, requests := newTestIMDSServer(t, &status)
+
+	client := NewCachedIMDSClient(10 * time.Millisecond)
+	client.baseURL = server.URL
+
+	client.GetShape()
+	time.Sleep(20 * time.Millisecond)
+	client.GetShape()
+
+	if count := atomic.LoadInt32(requests); count != 2 {
+		t.Errorf("Expected expired entry to be refetched, got %d requests", count)
+	}
+}
+
+func TestCachedIMDSClientDoesNotCacheErrors(t *testing.T) {
+	status := int32(http.StatusInternalServerError)
+	server, requests := newTestIMDSServer(t, &status)
+
+	client := NewCachedIMDSClient(time.Minute)
+	client.baseURL = server.URL
+	client.retry = RetryConfig{}
+
+	if _, err := client.GetShape(); err == nil {
+		t.Fatal("Expected error for failed IMDS request")
+	}
+	atomic.StoreInt32(&status, http.StatusOK)
+	if shape, err := client.GetShape(); err != nil || shape != "BM.GPU.H100.8" {
+		t.Fatalf("GetShape() = %s, %v", shape, err)
+	}
+	if count := atomic.LoadInt32(requests); count != 2 {
+		t.Errorf("Expected failed response not to be cached, got %d requests", count)
+	}
+}
+
+func TestSetIMDSCacheEnabled(t *testing.T) {
+	t.Cleanup(func() { SetIMDSCacheEnabled(true) })
+
+	SetIMDSCacheEnabled(true)
+	if first, second := currentIMDSClient(), currentIMDSClient(); first != second || first.cache == nil {
+		t.Error("Expected the shared cached client when caching is enabled")
+	}
+
+	SetIMDSCacheEnabled(false)
+	if client := currentIMDSClient(); client.cache != nil {
+		t.Error("Expected an uncached client when caching is disabled")
+	}
+}
@@ -0,0 +1,134 @@
+package executor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/telemetry"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newFlakyIMDSServer returns an IMDS server failing the first failures requests with status
+func newFlakyIMDSServer(t *testing.T, failures int32, status int) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{"shape":"BM.GPU.H100.8"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func testRetryConfig(maxRetries int) RetryConfig {
+	return RetryConfig{MaxRetries: maxRetries, InitialDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond}
+}
+
+func TestIMDSClientRetriesTransientFailures(t *testing.T) {
+	server, requests := newFlakyIMDSServer(t, 2, http.StatusServiceUnavailable)
+
+	client := NewIMDSClientWithRetry(time.Second, testRetryConfig(3))
+	client.baseURL = server.URL
+
+	shape, err := client.GetShape()
+	if err != nil || shape != "BM.GPU.H100.8" {
+		t.Fatalf("GetShape() = %s, %v", shape, err)
+	}
+	if count := atomic.LoadInt32(requests); count != 3 {
+		t.Errorf("Expected 3 requests, got %d", count)
+	}
+}
+
+func TestIMDSClientRetriesExhausted(t *testing.T) {
+	server, requests := newFlakyIMDSServer(t, 2, http.StatusServiceUnavailable)
+
+	client := NewIMDSClientWithRetry(time.Second, testRetryConfig(1))
+	client.baseURL = server.URL
+
+	if _, err := client.GetShape(); err == nil {
+		t.Fatal("Expected error after retries are exhausted")
+	}
+	if count := atomic.LoadInt32(requests); count != 2 {
+		t.Errorf("Expected 2 requests, got %d", count)
+	}
+}
+
+func TestIMDSClientDoesNotRetryClientErrors(t *testing.T) {
+	server, requests := newFlakyIMDSServer(t, 2, http.StatusNotFound)
+
+	client := NewIMDSClientWithRetry(time.Second, testRetryConfig(3))
+	client.baseURL = server.URL
+
+	if _, err := client.GetShape(); err == nil {
+		t.Fatal("Expected error for 404 response")
+	}
+	if count := atomic.LoadInt32(requests); count != 1 {
+		t.Errorf("Expected 404 not to be retried, got %d requests", count)
+	}
+}
+
+func TestRetryConfigDelay(t *testing.T) {
+	retry := RetryConfig{MaxRetries: 5, InitialDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 300 * time.Millisecond},
+		{5, 300 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 10; i++ {
+			if delay := retry.delay(tt.attempt); delay < tt.max/2 || delay > tt.max {
+				t.Errorf("delay(%d) = %v, want between %v and %v", tt.attempt, delay, tt.max/2, tt.max)
+			}
+		}
+	}
+
+	if delay := (RetryConfig{}).delay(1); delay != 0 {
+		t.Errorf("Expected no delay for zero config, got %v", delay)
+	}
+}
+
+func TestDefaultRetryConfig(t *testing.T) {
+	retry := NewIMDSClient().retry
+	if retry.MaxRetries != 3 || retry.InitialDelay != 500*time.Millisecond {
+		t.Errorf("Unexpected default retry config: %+v", retry)
+	}
+}
+
+func TestIMDSClientTracesRequests(t *testing.T) {
+	server, _ := newFlakyIMDSServer(t, 1, http.StatusServiceUnavailable)
+
+	exporter := tracetest.NewInMemoryExporter()
+	telemetry.SetGlobal(telemetry.NewTracingProviderWithExporter(exporter, "BM.GPU.H100.8", "gpu-node-1"))
+	defer telemetry.SetGlobal(telemetry.NewNoopTracingProvider())
+
+	client := NewIMDSClientWithRetry(time.Second, testRetryConfig(3))
+	client.baseURL = server.URL
+	if _, err := client.GetShape(); err != nil {
+		t.Fatalf("GetShape() error = %v", err)
+	}
+
+	// One child span per HTTP attempt, ended before the IMDS request span
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+	requestSpan := spans[2]
+	if requestSpan.Name != "imds instance" {
+		t.Errorf("Expected the IMDS request span last, got %s", requestSpan.Name)
+	}
+	for _, span := range spans[:2] {
+		if span.Name != "HTTP GET" || span.Parent.SpanID() != requestSpan.SpanContext.SpanID() {
+			t.Errorf("Expected an HTTP GET child span of the IMDS request, got %s", span.Name)
+		}
+	}
+}
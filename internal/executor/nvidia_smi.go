@@ -26,6 +26,9 @@ type GPUInfo struct {
 
 // CheckNvidiaSMI checks if nvidia-smi is available and functional
 func CheckNvidiaSMI() *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	result := &NvidiaSMIResult{
 		Available: false,
 		Output:    "",
@@ -45,11 +48,11 @@ func CheckNvidiaSMI() *NvidiaSMIResult {
 	logger.Info("nvidia-smi found in PATH, executing command...")
 
 	// Execute nvidia-smi command
-	cmd := exec.Command("nvidia-smi")
+	cmd := newCommandContext(ctx, "nvidia-smi")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
-		result.Error = err.Error()
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
 		result.Output = string(output)
 		logger.Error("nvidia-smi execution failed:", err)
 		logger.Error("nvidia-smi output:", string(output))
@@ -67,6 +70,9 @@ func CheckNvidiaSMI() *NvidiaSMIResult {
 
 // RunNvidiaSMIQuery runs nvidia-smi with specific query parameters
 func RunNvidiaSMIQuery(query string) *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	result := &NvidiaSMIResult{
 		Available: false,
 		Output:    "",
@@ -84,11 +90,11 @@ func RunNvidiaSMIQuery(query string) *NvidiaSMIResult {
 	}
 
 	// Execute nvidia-smi with query
-	cmd := exec.Command("nvidia-smi", "--query-gpu="+query, "--format=csv,noheader,nounits")
+	cmd := newCommandContext(ctx, "nvidia-smi", "--query-gpu="+query, "--format=csv,noheader,nounits")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
-		result.Error = err.Error()
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
 		result.Output = string(output)
 		logger.Error("nvidia-smi query failed:", err)
 		logger.Error("Query output:", string(output))
@@ -108,6 +114,9 @@ func RunNvidiaSMIQuery(query string) *NvidiaSMIResult {
 
 // RunNvidiaSMIErrorQuery executes nvidia-smi -q command and greps for error information
 func RunNvidiaSMIErrorQuery(errorType string) (*OSCommandResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	logger.Infof("Running nvidia-smi error query for: %s", errorType)
 
 	// Check if nvidia-smi exists
@@ -131,8 +140,9 @@ func RunNvidiaSMIErrorQuery(errorType string) (*OSCommandResult, error) {
 	}
 
 	// Execute using shell since we need pipe operations
-	cmd = exec.Command("bash", "-c", cmdStr)
+	cmd = newCommandContext(ctx, "bash", "-c", cmdStr)
 	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "nvidia-smi", err)
 
 	result := &OSCommandResult{
 		Command: cmdStr,
@@ -312,6 +322,9 @@ func formatPCIAddress(nvidiaPCI string) string {
 
 // RunNvidiaSMINvlink runs nvidia-smi nvlink -s command to check NVLink status
 func RunNvidiaSMINvlink() *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	result := &NvidiaSMIResult{
 		Available: false,
 		Output:    "",
@@ -329,11 +342,11 @@ func RunNvidiaSMINvlink() *NvidiaSMIResult {
 	}
 
 	// Execute nvidia-smi nvlink -s
-	cmd := exec.Command("nvidia-smi", "nvlink", "-s")
+	cmd := newCommandContext(ctx, "nvidia-smi", "nvlink", "-s")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
-		result.Error = err.Error()
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
 		result.Output = string(output)
 		logger.Error("nvidia-smi nvlink -s failed:", err)
 		logger.Error("NVLink output:", string(output))
@@ -351,6 +364,9 @@ func RunNvidiaSMINvlink() *NvidiaSMIResult {
 
 // RunNvidiaSMIQueryDetailed runs nvidia-smi -q for detailed GPU information
 func RunNvidiaSMIQueryDetailed() *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	result := &NvidiaSMIResult{
 		Available: false,
 		Output:    "",
@@ -368,11 +384,11 @@ func RunNvidiaSMIQueryDetailed() *NvidiaSMIResult {
 	}
 
 	// Execute nvidia-smi -q
-	cmd := exec.Command("nvidia-smi", "-q")
+	cmd := newCommandContext(ctx, "nvidia-smi", "-q")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
-		result.Error = err.Error()
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
 		result.Output = string(output)
 		logger.Error("nvidia-smi -q execution failed:", err)
 		logger.Error("nvidia-smi -q output:", string(output))
@@ -388,8 +404,54 @@ func RunNvidiaSMIQueryDetailed() *NvidiaSMIResult {
 	return result
 }
 
+// RunNvidiaSMIQueryDisplay runs nvidia-smi -q -d for detailed GPU information of a single display type,
+// e.g. "POWER" or "TEMPERATURE"
+func RunNvidiaSMIQueryDisplay(display string) *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	result := &NvidiaSMIResult{
+		Available: false,
+		Output:    "",
+		Error:     "",
+	}
+
+	logger.Infof("Running nvidia-smi -q -d %s", display)
+
+	// Check if nvidia-smi exists
+	_, err := exec.LookPath("nvidia-smi")
+	if err != nil {
+		result.Error = "nvidia-smi not found in PATH"
+		logger.Error("nvidia-smi not available for detailed query:", result.Error)
+		return result
+	}
+
+	// Execute nvidia-smi -q -d <display>
+	cmd := newCommandContext(ctx, "nvidia-smi", "-q", "-d", display)
+	output, err := cmd.CombinedOutput()
+
+	if err != nil {
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
+		result.Output = string(output)
+		logger.Error("nvidia-smi -q -d execution failed:", err)
+		logger.Error("nvidia-smi -q -d output:", string(output))
+		return result
+	}
+
+	result.Available = true
+	result.Output = string(output)
+
+	logger.Infof("nvidia-smi -q -d %s executed successfully", display)
+	logger.Debug("nvidia-smi -q -d output (first 200 chars):", truncateString(result.Output, 200))
+
+	return result
+}
+
 // RunNvidiaSMIRemappedRowsQuery runs nvidia-smi command to query remapped rows
 func RunNvidiaSMIRemappedRowsQuery() *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	result := &NvidiaSMIResult{
 		Available: false,
 		Output:    "",
@@ -407,11 +469,11 @@ func RunNvidiaSMIRemappedRowsQuery() *NvidiaSMIResult {
 	}
 
 	// Execute nvidia-smi --query-remapped-rows=gpu_bus_id,remapped_rows.failure --format=csv,noheader
-	cmd := exec.Command("nvidia-smi", "--query-remapped-rows=gpu_bus_id,remapped_rows.failure", "--format=csv,noheader")
+	cmd := newCommandContext(ctx, "nvidia-smi", "--query-remapped-rows=gpu_bus_id,remapped_rows.failure", "--format=csv,noheader")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
-		result.Error = err.Error()
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
 		result.Output = string(output)
 		logger.Error("nvidia-smi remapped rows query failed:", err)
 		logger.Error("Remapped rows output:", string(output))
@@ -427,6 +489,133 @@ func RunNvidiaSMIRemappedRowsQuery() *NvidiaSMIResult {
 	return result
 }
 
+// RunNvidiaSMITopo runs nvidia-smi topo -m to get the GPU topology matrix and CPU/NUMA affinity
+func RunNvidiaSMITopo() *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	result := &NvidiaSMIResult{
+		Available: false,
+		Output:    "",
+		Error:     "",
+	}
+
+	logger.Info("Running nvidia-smi topo -m command")
+
+	// Check if nvidia-smi exists
+	_, err := exec.LookPath("nvidia-smi")
+	if err != nil {
+		result.Error = "nvidia-smi not found in PATH"
+		logger.Error("nvidia-smi not available for topo query:", result.Error)
+		return result
+	}
+
+	// Execute nvidia-smi topo -m
+	cmd := newCommandContext(ctx, "nvidia-smi", "topo", "-m")
+	output, err := cmd.CombinedOutput()
+
+	if err != nil {
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
+		result.Output = string(output)
+		logger.Error("nvidia-smi topo -m failed:", err)
+		logger.Error("Topo output:", string(output))
+		return result
+	}
+
+	result.Available = true
+	result.Output = string(output)
+
+	logger.Info("nvidia-smi topo -m completed successfully")
+	logger.Debug("Topo result:", result.Output)
+
+	return result
+}
+
+// RunNvidiaSMITopoP2P runs nvidia-smi topo -p2p to get the GPU peer-to-peer status matrix for a capability,
+// e.g. "r" for read or "w" for write
+func RunNvidiaSMITopoP2P(capability string) *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	result := &NvidiaSMIResult{
+		Available: false,
+		Output:    "",
+		Error:     "",
+	}
+
+	logger.Infof("Running nvidia-smi topo -p2p %s command", capability)
+
+	// Check if nvidia-smi exists
+	_, err := exec.LookPath("nvidia-smi")
+	if err != nil {
+		result.Error = "nvidia-smi not found in PATH"
+		logger.Error("nvidia-smi not available for topo p2p query:", result.Error)
+		return result
+	}
+
+	// Execute nvidia-smi topo -p2p <capability>
+	cmd := newCommandContext(ctx, "nvidia-smi", "topo", "-p2p", capability)
+	output, err := cmd.CombinedOutput()
+
+	if err != nil {
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
+		result.Output = string(output)
+		logger.Error("nvidia-smi topo -p2p failed:", err)
+		logger.Error("Topo p2p output:", string(output))
+		return result
+	}
+
+	result.Available = true
+	result.Output = string(output)
+
+	logger.Info("nvidia-smi topo -p2p completed successfully")
+	logger.Debug("Topo p2p result:", result.Output)
+
+	return result
+}
+
+// RunNvidiaSMIMIGGPUInstances runs nvidia-smi mig -lgi to list the MIG GPU instances created on each GPU
+func RunNvidiaSMIMIGGPUInstances() *NvidiaSMIResult {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	result := &NvidiaSMIResult{
+		Available: false,
+		Output:    "",
+		Error:     "",
+	}
+
+	logger.Info("Running nvidia-smi mig -lgi command")
+
+	// Check if nvidia-smi exists
+	_, err := exec.LookPath("nvidia-smi")
+	if err != nil {
+		result.Error = "nvidia-smi not found in PATH"
+		logger.Error("nvidia-smi not available for MIG GPU instance query:", result.Error)
+		return result
+	}
+
+	// Execute nvidia-smi mig -lgi
+	cmd := newCommandContext(ctx, "nvidia-smi", "mig", "-lgi")
+	output, err := cmd.CombinedOutput()
+
+	if err != nil {
+		result.Error = contextError(ctx, "nvidia-smi", err).Error()
+		result.Output = string(output)
+		logger.Error("nvidia-smi mig -lgi failed:", err)
+		logger.Error("MIG GPU instances output:", string(output))
+		return result
+	}
+
+	result.Available = true
+	result.Output = string(output)
+
+	logger.Info("nvidia-smi mig -lgi completed successfully")
+	logger.Debug("MIG GPU instances result:", result.Output)
+
+	return result
+}
+
 // GetNvidiaSMIDriverVersion gets the major version number of nvidia-smi driver
 func GetNvidiaSMIDriverVersion() (int, error) {
 	logger.Info("Getting nvidia-smi driver version")
@@ -463,6 +652,21 @@ func GetNvidiaSMIDriverVersion() (int, error) {
 	return majorVersion, nil
 }
 
+// GetNvidiaSMIFullDriverVersion gets the full driver version reported by nvidia-smi (e.g. "550.54.15")
+func GetNvidiaSMIFullDriverVersion() (string, error) {
+	result := RunNvidiaSMIQuery("driver_version")
+	if !result.Available {
+		return "", fmt.Errorf("nvidia-smi not available: %s", result.Error)
+	}
+
+	// All GPUs report the same driver version, so the first line is enough
+	version := strings.TrimSpace(strings.Split(result.Output, "\n")[0])
+	if version == "" {
+		return "", fmt.Errorf("no driver version output received")
+	}
+	return version, nil
+}
+
 // truncateString truncates a string to a maximum length for logging
 func truncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
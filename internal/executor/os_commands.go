@@ -1,11 +1,15 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 )
@@ -18,6 +22,42 @@ type OSCommandResult struct {
 	ExitCode int
 }
 
+// commandWaitDelay bounds how long Wait blocks on output pipes after a command is killed,
+// since children of sudo or bash can keep the pipes open
+const commandWaitDelay = 5 * time.Second
+
+// commandTimeout bounds commands that can hang, such as nvidia-smi, mlxlink and mlxcable,
+// so a command left running by a timed out test is eventually killed. Zero disables the timeout.
+var commandTimeout time.Duration
+
+// SetCommandTimeout sets the timeout applied to commands that can hang, such as nvidia-smi and mlxlink
+func SetCommandTimeout(timeout time.Duration) {
+	commandTimeout = timeout
+}
+
+// commandContext returns a context bounded by the configured command timeout
+func commandContext() (context.Context, context.CancelFunc) {
+	if commandTimeout > 0 {
+		return context.WithTimeout(context.Background(), commandTimeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// newCommandContext creates a command that is killed when ctx is done
+func newCommandContext(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.WaitDelay = commandWaitDelay
+	return cmd
+}
+
+// contextError wraps a command error with the context error when the command was stopped by ctx
+func contextError(ctx context.Context, command string, err error) error {
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("%s did not complete: %w", command, ctx.Err())
+	}
+	return err
+}
+
 // RunLspci executes lspci command with specified options
 func RunLspci(options ...string) (*OSCommandResult, error) {
 	logger.Info("Running lspci command...")
@@ -164,6 +204,37 @@ func GetSerialNumber() (*OSCommandResult, error) {
 	return result, nil
 }
 
+// RunDmidecode executes dmidecode for a DMI type such as "bios" or "processor"
+func RunDmidecode(dmiType string) (*OSCommandResult, error) {
+	logger.Infof("Running dmidecode -t %s...", dmiType)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", "dmidecode", "-t", dmiType)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "dmidecode", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo dmidecode -t %s", dmiType),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("dmidecode command failed: %v", err)
+		logger.Debugf("dmidecode output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Infof("dmidecode -t %s completed successfully", dmiType)
+	logger.Debugf("dmidecode output: %s", result.Output)
+
+	return result, nil
+}
+
 // RunIPAddr executes ip addr command to get network interface information
 func RunIPAddr(options ...string) (*OSCommandResult, error) {
 	logger.Info("Running ip addr command...")
@@ -195,6 +266,100 @@ func RunIPAddr(options ...string) (*OSCommandResult, error) {
 	return result, nil
 }
 
+// RunIPLinkShow executes ip link show command to get the link settings of a network interface
+func RunIPLinkShow(interfaceName string) (*OSCommandResult, error) {
+	logger.Infof("Running ip link show for %s...", interfaceName)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "ip", "link", "show", interfaceName)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "ip", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("ip link show %s", interfaceName),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("ip link show command failed: %v", err)
+		logger.Debugf("ip link show output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("ip link show command completed successfully")
+	logger.Debugf("ip link show output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunIPLinkShowDetails executes ip -d link show command to get the detailed link settings of a network
+// interface, including the VLAN protocol and ID of VLAN interfaces
+func RunIPLinkShowDetails(interfaceName string) (*OSCommandResult, error) {
+	logger.Infof("Running ip -d link show for %s...", interfaceName)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "ip", "-d", "link", "show", interfaceName)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "ip", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("ip -d link show %s", interfaceName),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("ip -d link show command failed: %v", err)
+		logger.Debugf("ip -d link show output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("ip -d link show command completed successfully")
+	logger.Debugf("ip -d link show output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunIPLinkList executes ip -o link show to list all network interfaces, one per line
+func RunIPLinkList() (*OSCommandResult, error) {
+	logger.Info("Running ip -o link show...")
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "ip", "-o", "link", "show")
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "ip", err)
+
+	result := &OSCommandResult{
+		Command: "ip -o link show",
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("ip -o link show command failed: %v", err)
+		logger.Debugf("ip -o link show output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("ip -o link show command completed successfully")
+	logger.Debugf("ip -o link show output: %s", result.Output)
+
+	return result, nil
+}
+
 // RunRdmaLink executes rdma link command to get RDMA device information
 func RunRdmaLink(options ...string) (*OSCommandResult, error) {
 	logger.Info("Running rdma link command...")
@@ -489,6 +654,39 @@ func RunEthtoolStats(interfaceName string, grepPattern string) (*OSCommandResult
 	return result, nil
 }
 
+// RunEthtool executes ethtool command with specified options for a network interface.
+// Without options it gets the link settings, e.g. "-i" gets the driver and firmware information.
+func RunEthtool(interfaceName string, options ...string) (*OSCommandResult, error) {
+	logger.Infof("Running ethtool %s for interface: %s", strings.Join(options, " "), interfaceName)
+
+	args := append(append([]string{"ethtool"}, options...), interfaceName)
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "ethtool", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo %s", strings.Join(args, " ")),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("ethtool command failed: %v", err)
+		logger.Debugf("ethtool output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("ethtool command completed successfully")
+	logger.Debugf("ethtool output for %s: %s", interfaceName, result.Output)
+
+	return result, nil
+}
+
 // GetIbdevToNetdevMap retrieves a mapping of InfiniBand devices to network interfaces
 func GetIbdevToNetdevMap() (map[string]string, error) {
 	logger.Info("Running ibdev2netdev command...")
@@ -526,10 +724,14 @@ func GetIbdevToNetdevMap() (map[string]string, error) {
 
 // RunMlxlink executes mlxlink command for a specific interface with detailed options
 func RunMlxlink(interfaceName string) (*OSCommandResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
 	logger.Infof("Running mlxlink for interface: %s", interfaceName)
 
-	cmd := exec.Command("sudo", "mlxlink", "-d", interfaceName, "--json", "--show_module", "--show_counters", "--show_eye")
+	cmd := newCommandContext(ctx, "sudo", "mlxlink", "-d", interfaceName, "--json", "--show_module", "--show_counters", "--show_eye")
 	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "mlxlink", err)
 
 	result := &OSCommandResult{
 		Command: fmt.Sprintf("sudo mlxlink -d %s --json --show_module --show_counters --show_eye", interfaceName),
@@ -552,6 +754,39 @@ func RunMlxlink(interfaceName string) (*OSCommandResult, error) {
 	return result, nil
 }
 
+// RunMlxcable executes mlxcable command to read the cable DDM (digital diagnostics monitoring)
+// values, such as module temperature and optical power, of an mst device
+func RunMlxcable(mstDevice string) (*OSCommandResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	logger.Infof("Running mlxcable for device: %s", mstDevice)
+
+	cmd := newCommandContext(ctx, "sudo", "mlxcable", "-d", mstDevice, "--ddm")
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "mlxcable", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo mlxcable -d %s --ddm", mstDevice),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("mlxcable command failed: %v", err)
+		logger.Debugf("mlxcable output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("mlxcable command completed successfully")
+	logger.Debugf("mlxcable output: %s", result.Output)
+
+	return result, nil
+}
+
 // RunMstStatus executes mst status command to get Mellanox device status and PCI mapping
 func RunMstStatus() (*OSCommandResult, error) {
 	logger.Info("Running mst status command...")
@@ -639,3 +874,788 @@ func RunWpaCliStatus(interfaceName string) (*OSCommandResult, error) {
 	return result, nil
 }
 
+// RunCat reads the contents of a file such as /proc or /sys entries using cat
+func RunCat(filePath string) (*OSCommandResult, error) {
+	logger.Info("Reading file:", filePath)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "cat", filePath)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "cat", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("cat %s", filePath),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("Failed to read %s: %v", filePath, err)
+		return result, err
+	}
+
+	logger.Debugf("%s contents: %s", filePath, result.Output)
+
+	return result, nil
+}
+
+// RunTail reads the last lines of a log file such as /var/log/fabricmanager.log using tail
+func RunTail(filePath string, lines int) (*OSCommandResult, error) {
+	logger.Infof("Reading last %d lines of %s", lines, filePath)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", "tail", "-n", fmt.Sprintf("%d", lines), filePath)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "tail", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo tail -n %d %s", lines, filePath),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("Failed to read %s: %v", filePath, err)
+		return result, err
+	}
+
+	logger.Debugf("Last %d lines of %s: %s", lines, filePath, result.Output)
+
+	return result, nil
+}
+
+// cpuGovernorGlob matches the cpufreq scaling governor file of every CPU
+const cpuGovernorGlob = "/sys/devices/system/cpu/cpu[0-9]*/cpufreq/scaling_governor"
+
+// GetCPUGovernors reads the cpufreq scaling governor of every CPU, keyed by CPU name such as "cpu0"
+func GetCPUGovernors() (map[string]string, error) {
+	logger.Info("Reading CPU scaling governors...")
+
+	paths, err := filepath.Glob(cpuGovernorGlob)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		logger.Errorf("No cpufreq scaling governors found matching %s", cpuGovernorGlob)
+		return nil, fmt.Errorf("cpufreq scaling governor not available: no files match %s", cpuGovernorGlob)
+	}
+
+	governors := make(map[string]string)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			logger.Errorf("Failed to read %s: %v", path, err)
+			return nil, err
+		}
+		// Path is /sys/devices/system/cpu/<cpu>/cpufreq/scaling_governor
+		cpu := filepath.Base(filepath.Dir(filepath.Dir(path)))
+		governors[cpu] = strings.TrimSpace(string(data))
+	}
+
+	logger.Debugf("Read scaling governors for %d CPUs", len(governors))
+	return governors, nil
+}
+
+// numaDistanceGlob matches the distance file of every NUMA node
+const numaDistanceGlob = "/sys/devices/system/node/node[0-9]*/distance"
+
+// GetNUMANodeDistances reads the distance file of every NUMA node, keyed by node name such as "node0".
+// Each file lists the distances from the node to every node in node order, e.g. "10 21".
+func GetNUMANodeDistances() (map[string]string, error) {
+	logger.Info("Reading NUMA node distances...")
+
+	paths, err := filepath.Glob(numaDistanceGlob)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		logger.Errorf("No NUMA node distances found matching %s", numaDistanceGlob)
+		return nil, fmt.Errorf("NUMA node distances not available: no files match %s", numaDistanceGlob)
+	}
+
+	distances := make(map[string]string)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			logger.Errorf("Failed to read %s: %v", path, err)
+			return nil, err
+		}
+		// Path is /sys/devices/system/node/<node>/distance
+		node := filepath.Base(filepath.Dir(path))
+		distances[node] = strings.TrimSpace(string(data))
+	}
+
+	logger.Debugf("Read distances of %d NUMA nodes", len(distances))
+	return distances, nil
+}
+
+// P2PBandwidthTestBinary is the precompiled CUDA peer-to-peer bandwidth test
+const P2PBandwidthTestBinary = "/opt/oci-hpc/bin/p2p_bw_test"
+
+// RunP2PBandwidthTest measures the peer-to-peer bandwidth from srcGPU to dstGPU for the given duration, e.g. "2s"
+func RunP2PBandwidthTest(srcGPU, dstGPU int, duration string) (*OSCommandResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	logger.Infof("Running P2P bandwidth test from GPU %d to GPU %d...", srcGPU, dstGPU)
+
+	args := []string{"--src-gpu", fmt.Sprint(srcGPU), "--dst-gpu", fmt.Sprint(dstGPU), "--duration", duration}
+	result := &OSCommandResult{
+		Command: P2PBandwidthTestBinary + " " + strings.Join(args, " "),
+	}
+
+	if _, err := os.Stat(P2PBandwidthTestBinary); err != nil {
+		result.Error = fmt.Errorf("%s: %w", P2PBandwidthTestBinary, exec.ErrNotFound)
+		logger.Errorf("P2P bandwidth test binary not available: %v", err)
+		return result, result.Error
+	}
+
+	cmd := newCommandContext(ctx, P2PBandwidthTestBinary, args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "p2p_bw_test", err)
+	result.Output = string(output)
+	result.Error = err
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("P2P bandwidth test command failed: %v", err)
+		logger.Debugf("P2P bandwidth test output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("P2P bandwidth test command completed successfully")
+	logger.Debugf("P2P bandwidth test output: %s", result.Output)
+
+	return result, nil
+}
+
+// GPUCPUBandwidthTestBinary is the precompiled CUDA host-to-device and device-to-host bandwidth test
+const GPUCPUBandwidthTestBinary = "/opt/oci-hpc/bin/gpu_cpu_bw"
+
+// RunGPUCPUBandwidthTest measures the bandwidth between host memory and gpuIndex for the given duration, e.g. "3s"
+func RunGPUCPUBandwidthTest(gpuIndex int, duration string) (*OSCommandResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	logger.Infof("Running GPU-CPU bandwidth test on GPU %d...", gpuIndex)
+
+	args := []string{"--duration", duration, "--gpu-index", fmt.Sprint(gpuIndex)}
+	result := &OSCommandResult{
+		Command: GPUCPUBandwidthTestBinary + " " + strings.Join(args, " "),
+	}
+
+	if _, err := os.Stat(GPUCPUBandwidthTestBinary); err != nil {
+		result.Error = fmt.Errorf("%s: %w", GPUCPUBandwidthTestBinary, exec.ErrNotFound)
+		logger.Errorf("GPU-CPU bandwidth test binary not available: %v", err)
+		return result, result.Error
+	}
+
+	cmd := newCommandContext(ctx, GPUCPUBandwidthTestBinary, args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "gpu_cpu_bw", err)
+	result.Output = string(output)
+	result.Error = err
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("GPU-CPU bandwidth test command failed: %v", err)
+		logger.Debugf("GPU-CPU bandwidth test output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("GPU-CPU bandwidth test command completed successfully")
+	logger.Debugf("GPU-CPU bandwidth test output: %s", result.Output)
+
+	return result, nil
+}
+
+// NVLinkBandwidthTestBinary is the precompiled NCCL all-reduce bandwidth test across all local GPUs
+const NVLinkBandwidthTestBinary = "/opt/oci-hpc/bin/nvlink_bw_test"
+
+// RunNVLinkBandwidthTest runs the NCCL all-reduce bandwidth test with messageSize bytes (e.g. "8G")
+// for the given iterations, stopping it when ctx is done. A non-empty algorithm such as "Ring" or
+// "Tree" is passed to NCCL through NCCL_ALGO, otherwise NCCL picks the algorithm.
+func RunNVLinkBandwidthTest(ctx context.Context, messageSize string, iterations int, algorithm string) (*OSCommandResult, error) {
+	logger.Infof("Running NVLink all-reduce bandwidth test with %s messages...", messageSize)
+
+	args := []string{"-b", messageSize, "-e", messageSize, "-n", fmt.Sprint(iterations)}
+	result := &OSCommandResult{
+		Command: NVLinkBandwidthTestBinary + " " + strings.Join(args, " "),
+	}
+
+	if _, err := os.Stat(NVLinkBandwidthTestBinary); err != nil {
+		result.Error = fmt.Errorf("%s: %w", NVLinkBandwidthTestBinary, exec.ErrNotFound)
+		logger.Errorf("NVLink bandwidth test binary not available: %v", err)
+		return result, result.Error
+	}
+
+	cmd := newCommandContext(ctx, NVLinkBandwidthTestBinary, args...)
+	if algorithm != "" {
+		logger.Infof("Forcing NCCL algorithm %s", algorithm)
+		cmd.Env = append(os.Environ(), "NCCL_ALGO="+algorithm)
+	}
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "nvlink_bw_test", err)
+	result.Output = string(output)
+	result.Error = err
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("NVLink bandwidth test command failed: %v", err)
+		logger.Debugf("NVLink bandwidth test output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("NVLink bandwidth test command completed successfully")
+	logger.Debugf("NVLink bandwidth test output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunIbstat executes ibstat command to get InfiniBand port state
+func RunIbstat(options ...string) (*OSCommandResult, error) {
+	logger.Info("Running ibstat command...")
+
+	// Build command arguments - prepend ibstat to sudo args
+	args := append([]string{"ibstat"}, options...)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "ibstat", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo ibstat %s", strings.Join(options, " ")),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("ibstat command failed: %v", err)
+		logger.Debugf("ibstat output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("ibstat command completed successfully")
+	logger.Debugf("ibstat output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunSminfo executes sminfo command to query the subnet manager seen by a device port
+func RunSminfo(deviceName string, port int) (*OSCommandResult, error) {
+	logger.Infof("Running sminfo command for %s port %d", deviceName, port)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", "sminfo", "-C", deviceName, "-P", fmt.Sprintf("%d", port))
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "sminfo", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo sminfo -C %s -P %d", deviceName, port),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("sminfo command failed for %s port %d: %v", deviceName, port, err)
+		logger.Debugf("sminfo output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Infof("sminfo command completed successfully for %s port %d", deviceName, port)
+	logger.Debugf("sminfo output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunPerfquery executes perfquery command to read the performance counters of a device port
+func RunPerfquery(deviceName string, port int, options ...string) (*OSCommandResult, error) {
+	logger.Infof("Running perfquery command for %s port %d", deviceName, port)
+
+	args := append([]string{"perfquery"}, options...)
+	args = append(args, "-C", deviceName, "-P", fmt.Sprintf("%d", port))
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "perfquery", err)
+
+	result := &OSCommandResult{
+		Command: "sudo " + strings.Join(args, " "),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("perfquery command failed for %s port %d: %v", deviceName, port, err)
+		logger.Debugf("perfquery output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Infof("perfquery command completed successfully for %s port %d", deviceName, port)
+	logger.Debugf("perfquery output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunSmpquery executes smpquery command to send a subnet management query out of a device port,
+// e.g. RunSmpquery("mlx5_0", 1, "-D", "portinfo", "0,1", "17") for port 17 of the neighbor switch
+func RunSmpquery(deviceName string, port int, options ...string) (*OSCommandResult, error) {
+	logger.Infof("Running smpquery command for %s port %d", deviceName, port)
+
+	args := []string{"smpquery", "-C", deviceName, "-P", fmt.Sprintf("%d", port)}
+	args = append(args, options...)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "smpquery", err)
+
+	result := &OSCommandResult{
+		Command: "sudo " + strings.Join(args, " "),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("smpquery command failed for %s port %d: %v", deviceName, port, err)
+		logger.Debugf("smpquery output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Infof("smpquery command completed successfully for %s port %d", deviceName, port)
+	logger.Debugf("smpquery output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunSaquery executes saquery command to query the subnet administrator through a device port,
+// e.g. RunSaquery("mlx5_0", 1, "NR", "12") for the node record of LID 12
+func RunSaquery(deviceName string, port int, options ...string) (*OSCommandResult, error) {
+	logger.Infof("Running saquery command for %s port %d", deviceName, port)
+
+	args := []string{"saquery", "-C", deviceName, "-P", fmt.Sprintf("%d", port)}
+	args = append(args, options...)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "saquery", err)
+
+	result := &OSCommandResult{
+		Command: "sudo " + strings.Join(args, " "),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("saquery command failed for %s port %d: %v", deviceName, port, err)
+		logger.Debugf("saquery output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Infof("saquery command completed successfully for %s port %d", deviceName, port)
+	logger.Debugf("saquery output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunDcgmi executes dcgmi with the given options, e.g. RunDcgmi("group", "-l") to list the DCGM GPU groups
+func RunDcgmi(options ...string) (*OSCommandResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	logger.Infof("Running dcgmi %s", strings.Join(options, " "))
+
+	cmd := newCommandContext(ctx, "dcgmi", options...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "dcgmi", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("dcgmi %s", strings.Join(options, " ")),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("dcgmi command failed: %v", err)
+		logger.Debugf("dcgmi output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("dcgmi command completed successfully")
+	logger.Debugf("dcgmi output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunLscpu executes lscpu command to get the CPU architecture, such as the number of sockets and cores
+func RunLscpu() (*OSCommandResult, error) {
+	logger.Info("Running lscpu command...")
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "lscpu")
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "lscpu", err)
+
+	result := &OSCommandResult{
+		Command: "lscpu",
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("lscpu command failed: %v", err)
+		logger.Debugf("lscpu output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("lscpu command completed successfully")
+	logger.Debugf("lscpu output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunChronycTracking executes chronyc tracking command to get clock synchronization state
+func RunChronycTracking() (*OSCommandResult, error) {
+	logger.Info("Running chronyc tracking command...")
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", "chronyc", "tracking")
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "chronyc", err)
+
+	result := &OSCommandResult{
+		Command: "sudo chronyc tracking",
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("chronyc tracking command failed: %v", err)
+		logger.Debugf("chronyc tracking output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("chronyc tracking command completed successfully")
+	logger.Debugf("chronyc tracking output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunTimedatectlShow executes timedatectl show command to get time synchronization properties
+func RunTimedatectlShow() (*OSCommandResult, error) {
+	logger.Info("Running timedatectl show command...")
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", "timedatectl", "show")
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "timedatectl", err)
+
+	result := &OSCommandResult{
+		Command: "sudo timedatectl show",
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("timedatectl show command failed: %v", err)
+		logger.Debugf("timedatectl show output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("timedatectl show command completed successfully")
+	logger.Debugf("timedatectl show output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunModinfo executes modinfo command for a kernel module with specified options
+func RunModinfo(moduleName string, options ...string) (*OSCommandResult, error) {
+	logger.Info("Running modinfo command for module:", moduleName)
+
+	// Build command arguments - modinfo [options] module
+	args := append([]string{"modinfo"}, options...)
+	args = append(args, moduleName)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "modinfo", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("sudo %s", strings.Join(args, " ")),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("modinfo command failed for module %s: %v", moduleName, err)
+		logger.Debugf("modinfo output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("modinfo command completed successfully for module:", moduleName)
+	logger.Debugf("modinfo output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunOfedInfo executes ofed_info -s command to get the installed MLNX_OFED version
+func RunOfedInfo() (*OSCommandResult, error) {
+	logger.Info("Running ofed_info command...")
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "sudo", "ofed_info", "-s")
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "ofed_info", err)
+
+	result := &OSCommandResult{
+		Command: "sudo ofed_info -s",
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("ofed_info command failed: %v", err)
+		logger.Debugf("ofed_info output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Info("ofed_info command completed successfully")
+	logger.Debugf("ofed_info output: %s", result.Output)
+
+	return result, nil
+}
+
+// RunSystemctlIsActive executes systemctl is-active --quiet for a service.
+// The exit code is 0 when the service is active and non-zero otherwise.
+func RunSystemctlIsActive(serviceName string) (*OSCommandResult, error) {
+	logger.Info("Running systemctl is-active for service:", serviceName)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "systemctl", "is-active", "--quiet", serviceName)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "systemctl", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("systemctl is-active --quiet %s", serviceName),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Debugf("systemctl is-active for %s returned exit code %d", serviceName, result.ExitCode)
+		return result, err
+	}
+
+	logger.Info("Service is active:", serviceName)
+
+	return result, nil
+}
+
+// RunSystemctlShow executes systemctl show for a service with the given properties
+func RunSystemctlShow(serviceName string, properties ...string) (*OSCommandResult, error) {
+	logger.Info("Running systemctl show for service:", serviceName)
+
+	args := []string{"show", serviceName}
+	if len(properties) > 0 {
+		args = append(args, "-p", strings.Join(properties, ","))
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+	cmd := newCommandContext(ctx, "systemctl", args...)
+	output, err := cmd.CombinedOutput()
+	err = contextError(ctx, "systemctl", err)
+
+	result := &OSCommandResult{
+		Command: fmt.Sprintf("systemctl %s", strings.Join(args, " ")),
+		Output:  string(output),
+		Error:   err,
+	}
+
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitError.ExitCode()
+		}
+		logger.Errorf("systemctl show command failed for service %s: %v", serviceName, err)
+		logger.Debugf("systemctl show output: %s", result.Output)
+		return result, err
+	}
+
+	logger.Debugf("systemctl show output: %s", result.Output)
+
+	return result, nil
+}
+
+// GetIOMMUGroups returns the PCI devices of every IOMMU group keyed by group number
+func GetIOMMUGroups() (map[string][]string, error) {
+	iommuGroupsPath := "/sys/kernel/iommu_groups"
+	logger.Info("Reading IOMMU groups from", iommuGroupsPath)
+
+	groupEntries, err := os.ReadDir(iommuGroupsPath)
+	if err != nil {
+		logger.Errorf("Failed to read %s: %v", iommuGroupsPath, err)
+		return nil, err
+	}
+
+	groups := make(map[string][]string)
+	for _, groupEntry := range groupEntries {
+		devicesPath := fmt.Sprintf("%s/%s/devices", iommuGroupsPath, groupEntry.Name())
+		deviceEntries, err := os.ReadDir(devicesPath)
+		if err != nil {
+			logger.Debugf("Failed to read %s: %v", devicesPath, err)
+			continue
+		}
+		for _, deviceEntry := range deviceEntries {
+			groups[groupEntry.Name()] = append(groups[groupEntry.Name()], deviceEntry.Name())
+		}
+	}
+
+	logger.Infof("Found %d IOMMU groups", len(groups))
+	return groups, nil
+}
+
+// GetRDMADevicePCIAddresses returns the PCI address of every RDMA device keyed by device name,
+// resolved from the /sys/class/infiniband/<device>/device symlink
+func GetRDMADevicePCIAddresses() (map[string]string, error) {
+	infinibandPath := "/sys/class/infiniband"
+	logger.Info("Reading RDMA device PCI addresses from", infinibandPath)
+
+	deviceEntries, err := os.ReadDir(infinibandPath)
+	if err != nil {
+		logger.Errorf("Failed to read %s: %v", infinibandPath, err)
+		return nil, err
+	}
+
+	addresses := make(map[string]string)
+	for _, deviceEntry := range deviceEntries {
+		devicePath := fmt.Sprintf("%s/%s/device", infinibandPath, deviceEntry.Name())
+		target, err := filepath.EvalSymlinks(devicePath)
+		if err != nil {
+			logger.Errorf("Failed to resolve %s: %v", devicePath, err)
+			return nil, err
+		}
+		addresses[deviceEntry.Name()] = strings.ToLower(filepath.Base(target))
+	}
+
+	logger.Infof("Found PCI addresses of %d RDMA devices", len(addresses))
+	return addresses, nil
+}
+
+// GetRDMAPortCounters reads the named counters of an RDMA device port from
+// /sys/class/infiniband/<device>/ports/<port>/counters, falling back to hw_counters for
+// driver specific counters. Counters the device does not expose are left out of the result.
+func GetRDMAPortCounters(deviceName string, port int, counters []string) (map[string]int64, error) {
+	portPath := fmt.Sprintf("/sys/class/infiniband/%s/ports/%d", deviceName, port)
+	logger.Debugf("Reading RDMA port counters from %s", portPath)
+
+	if _, err := os.Stat(portPath); err != nil {
+		logger.Errorf("Failed to read %s: %v", portPath, err)
+		return nil, err
+	}
+
+	values := make(map[string]int64)
+	for _, counter := range counters {
+		for _, dir := range []string{"counters", "hw_counters"} {
+			data, err := os.ReadFile(fmt.Sprintf("%s/%s/%s", portPath, dir, counter))
+			if err != nil {
+				continue
+			}
+			value, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+			if err != nil {
+				logger.Debugf("Invalid value for counter %s of %s port %d: %q", counter, deviceName, port, string(data))
+				break
+			}
+			values[counter] = value
+			break
+		}
+	}
+
+	return values, nil
+}
+
+// StatWithTimeout stats path and returns how long the stat took. A stat that has not returned
+// within timeout, as on a hung NFS mount, returns an error wrapping context.DeadlineExceeded.
+// The stat cannot be interrupted, so on timeout it is left to finish in the background.
+func StatWithTimeout(path string, timeout time.Duration) (time.Duration, error) {
+	logger.Debugf("Checking %s responds within %s", path, timeout)
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		_, err := os.Stat(path)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return time.Since(start), err
+	case <-time.After(timeout):
+		logger.Errorf("stat %s did not complete within %s", path, timeout)
+		return timeout, fmt.Errorf("stat %s did not complete: %w", path, context.DeadlineExceeded)
+	}
+}
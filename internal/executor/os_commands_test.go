@@ -1,8 +1,11 @@
 package executor
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestOSCommandResult(t *testing.T) {
@@ -434,3 +437,63 @@ func TestMlxlinkCommandGeneration(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandContextTimeout(t *testing.T) {
+	defer SetCommandTimeout(0)
+
+	SetCommandTimeout(50 * time.Millisecond)
+	ctx, cancel := commandContext()
+	defer cancel()
+	if _, ok := ctx.Deadline(); !ok {
+		t.Error("Expected command context to have a deadline")
+	}
+
+	SetCommandTimeout(0)
+	ctx, cancel = commandContext()
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("Expected command context without a deadline when timeout is disabled")
+	}
+}
+
+func TestNewCommandContextKilledOnDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := newCommandContext(ctx, "sleep", "5").CombinedOutput()
+	err = contextError(ctx, "sleep", err)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected command to be killed at the deadline, took %v", elapsed)
+	}
+}
+
+func TestContextError(t *testing.T) {
+	commandErr := errors.New("exit status 1")
+
+	if err := contextError(context.Background(), "nvidia-smi", commandErr); err != commandErr {
+		t.Errorf("Expected original error when context is not done, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := contextError(ctx, "nvidia-smi", nil); err != nil {
+		t.Errorf("Expected nil error for a completed command, got %v", err)
+	}
+	if err := contextError(ctx, "nvidia-smi", commandErr); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context error, got %v", err)
+	}
+}
+
+func TestStatWithTimeout(t *testing.T) {
+	if _, err := StatWithTimeout(t.TempDir(), time.Second); err != nil {
+		t.Errorf("Expected stat of a temporary directory to succeed, got %v", err)
+	}
+	if _, err := StatWithTimeout("/nonexistent/path", time.Second); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected not-exist error for a missing path, got %v", err)
+	}
+}
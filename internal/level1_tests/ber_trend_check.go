@@ -0,0 +1,268 @@
+package level1_tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+const (
+	defaultBERTrendResultsFile        = "/var/log/oci-dr-hpc/results.json"
+	defaultBERTrendHistoryRuns        = 10
+	defaultBERTrendMaxIncreasePercent = 10.0
+	// berTrendMinRuns is the number of BER measurements needed before a trend is computed
+	berTrendMinRuns = 3
+	// berTrendLastValues is the number of most recent BER measurements kept in the report
+	berTrendLastValues = 5
+)
+
+// BERTrendCheckTestConfig represents the config needed to run this test
+type BERTrendCheckTestConfig struct {
+	IsEnabled          bool    `json:"enabled"`
+	Shape              string  `json:"shape"`
+	ResultsFile        string  `json:"results_file"`
+	HistoryRuns        int     `json:"history_runs"`
+	MaxIncreasePercent float64 `json:"max_increase_percent"`
+}
+
+// BERTrend represents the effective physical BER trend of an RDMA interface over past runs
+type BERTrend struct {
+	Device string `json:"device"`
+	// Slope is the BER change per run of the linear regression over the past runs
+	Slope float64 `json:"slope"`
+	// SlopePercent is the slope relative to the mean BER of the past runs
+	SlopePercent  float64   `json:"slope_percent"`
+	LastBERValues []float64 `json:"last_ber_values"`
+	Trend         string    `json:"trend"`
+}
+
+// getBERTrendCheckTestConfig gets test config needed to run this test
+func getBERTrendCheckTestConfig() (*BERTrendCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	berTrendCheckTestConfig := &BERTrendCheckTestConfig{
+		IsEnabled:          false,
+		Shape:              shape,
+		ResultsFile:        defaultBERTrendResultsFile,
+		HistoryRuns:        defaultBERTrendHistoryRuns,
+		MaxIncreasePercent: defaultBERTrendMaxIncreasePercent,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "ber_trend_check")
+	if err != nil {
+		return nil, err
+	}
+	berTrendCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "ber_trend_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if resultsFile, ok := thresholdMap["results_file"].(string); ok {
+				berTrendCheckTestConfig.ResultsFile = resultsFile
+			}
+			if historyRuns, ok := thresholdMap["history_runs"].(float64); ok {
+				berTrendCheckTestConfig.HistoryRuns = int(historyRuns)
+			}
+			if maxIncrease, ok := thresholdMap["max_increase_percent"].(float64); ok {
+				berTrendCheckTestConfig.MaxIncreasePercent = maxIncrease
+			}
+		}
+	}
+
+	return berTrendCheckTestConfig, nil
+}
+
+// parseBERHistory returns the effective physical BER measured by link_check on each RDMA
+// interface over the last historyRuns runs of an appended report, oldest first
+func parseBERHistory(data []byte, historyRuns int) (map[string][]float64, error) {
+	var appended reporter.AppendedReport
+	if err := json.Unmarshal(data, &appended); err != nil {
+		return nil, fmt.Errorf("failed to parse report history: %w", err)
+	}
+
+	runs := appended.TestRuns
+	if historyRuns > 0 && len(runs) > historyRuns {
+		runs = runs[len(runs)-historyRuns:]
+	}
+
+	history := make(map[string][]float64)
+	for _, run := range runs {
+		for _, linkResult := range run.TestResults.LinkCheck {
+			// Links were decoded generically, convert them back to link check results
+			linksJSON, err := json.Marshal(linkResult.Links)
+			if err != nil {
+				continue
+			}
+			var links []LinkCheckResult
+			if err := json.Unmarshal(linksJSON, &links); err != nil {
+				continue
+			}
+			for _, link := range links {
+				if link.EffectivePhysicalBERValue != nil {
+					history[link.Device] = append(history[link.Device], *link.EffectivePhysicalBERValue)
+				}
+			}
+		}
+	}
+	return history, nil
+}
+
+// linearRegressionSlope returns the least squares slope of values measured one run apart
+func linearRegressionSlope(values []float64) float64 {
+	n := float64(len(values))
+	if n < 2 {
+		return 0
+	}
+	var sumX, sumY, sumXY, sumXX float64
+	for i, y := range values {
+		x := float64(i)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	return (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+}
+
+// computeBERTrend computes the BER trend of an interface from its BER history. The trend is
+// increasing or decreasing when the slope exceeds maxChangePercent of the mean BER per run.
+func computeBERTrend(device string, values []float64, maxChangePercent float64) BERTrend {
+	trend := BERTrend{Device: device, Trend: "stable"}
+
+	lastValues := values
+	if len(lastValues) > berTrendLastValues {
+		lastValues = lastValues[len(lastValues)-berTrendLastValues:]
+	}
+	trend.LastBERValues = append([]float64(nil), lastValues...)
+
+	trend.Slope = linearRegressionSlope(values)
+	var mean float64
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(len(values))
+	if mean > 0 {
+		trend.SlopePercent = trend.Slope / mean * 100
+	}
+
+	switch {
+	case trend.SlopePercent > maxChangePercent:
+		trend.Trend = "increasing"
+	case trend.SlopePercent < -maxChangePercent:
+		trend.Trend = "decreasing"
+	}
+	return trend
+}
+
+// computeBERTrends computes the BER trend of every interface with enough history, sorted by device
+func computeBERTrends(history map[string][]float64, maxIncreasePercent float64) []BERTrend {
+	devices := make([]string, 0, len(history))
+	for device := range history {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+
+	trends := make([]BERTrend, 0, len(devices))
+	for _, device := range devices {
+		if len(history[device]) < berTrendMinRuns {
+			logger.Infof("%s: only %d BER measurements, need %d to compute a trend", device, len(history[device]), berTrendMinRuns)
+			continue
+		}
+		trends = append(trends, computeBERTrend(device, history[device], maxIncreasePercent))
+	}
+	return trends
+}
+
+// validateBERTrends returns WARN when the BER of any interface is increasing, since a degrading
+// link usually precedes link flaps and retransmits
+func validateBERTrends(trends []BERTrend, maxIncreasePercent float64) (string, error) {
+	var increasing []string
+	for _, trend := range trends {
+		if trend.Trend == "increasing" {
+			increasing = append(increasing, fmt.Sprintf("%s (+%.1f%%/run)", trend.Device, trend.SlopePercent))
+		}
+	}
+	if len(increasing) > 0 {
+		return "WARN", fmt.Errorf("effective physical BER increasing by more than %.0f%% per run on: %s", maxIncreasePercent, strings.Join(increasing, ", "))
+	}
+	return "PASS", nil
+}
+
+func RunBERTrendCheck() error {
+	logger.Info("=== BER Trend Check ===")
+	testConfig, err := getBERTrendCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "ber_trend_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting BER trend check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the BER history of past runs
+	logger.Info("Step 1: Reading BER history from", testConfig.ResultsFile)
+	data, err := os.ReadFile(testConfig.ResultsFile)
+	if errors.Is(err, os.ErrNotExist) {
+		logger.Info("BER Trend Check: PASS - No report history yet in", testConfig.ResultsFile)
+		rep.AddBERTrendResult("PASS", []BERTrend{}, nil)
+		return nil
+	}
+	if err != nil {
+		logger.Error("BER Trend Check: FAIL - Could not read report history:", err)
+		rep.AddBERTrendResult("FAIL", nil, err)
+		return fmt.Errorf("could not read report history: %w", err)
+	}
+	history, err := parseBERHistory(data, testConfig.HistoryRuns)
+	if err != nil {
+		logger.Error("BER Trend Check: FAIL -", err)
+		rep.AddBERTrendResult("FAIL", nil, err)
+		return err
+	}
+
+	// Step 2: Compute the BER trend of every interface
+	logger.Info("Step 2: Computing BER trends over the last", testConfig.HistoryRuns, "runs...")
+	trends := computeBERTrends(history, testConfig.MaxIncreasePercent)
+	for _, trend := range trends {
+		logger.Infof("%s: slope %.3g per run (%.1f%%), last BER values %v - %s", trend.Device, trend.Slope, trend.SlopePercent, trend.LastBERValues, trend.Trend)
+	}
+
+	// Step 3: Validate the BER trends
+	logger.Info("Step 3: Validating BER trends...")
+	status, validationErr := validateBERTrends(trends, testConfig.MaxIncreasePercent)
+	rep.AddBERTrendResult(status, trends, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("BER Trend Check: PASS - BER is not increasing on any of %d interfaces", len(trends))
+		return nil
+	default: // WARN
+		logger.Info("BER Trend Check: WARN -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,102 @@
+package level1_tests
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// appendedReportWithBER builds an appended report with one run per BER value of rdma0
+func appendedReportWithBER(values ...float64) []byte {
+	runs := make([]string, 0, len(values))
+	for i, value := range values {
+		runs = append(runs, fmt.Sprintf(`{"run_id": "run_%d", "timestamp": "2026-01-01T00:00:00Z", "test_results": {"link_check": [{"status": "PASS", "links": [
+			{"device": "rdma0", "effective_physical_ber": "PASS", "effective_physical_ber_value": %g},
+			{"device": "rdma1", "effective_physical_ber": "FAIL - Unable to get data"}
+		]}]}}`, i, value))
+	}
+	return []byte(`{"test_runs": [` + strings.Join(runs, ",") + `]}`)
+}
+
+// Test parseBERHistory function
+func TestParseBERHistory(t *testing.T) {
+	data := appendedReportWithBER(1e-13, 2e-13, 3e-13, 4e-13)
+
+	history, err := parseBERHistory(data, 3)
+	if err != nil {
+		t.Fatalf("parseBERHistory() error = %v", err)
+	}
+	expected := map[string][]float64{"rdma0": {2e-13, 3e-13, 4e-13}}
+	if !reflect.DeepEqual(history, expected) {
+		t.Errorf("parseBERHistory() = %v, want %v", history, expected)
+	}
+
+	if _, err := parseBERHistory([]byte("{not json"), 3); err == nil {
+		t.Error("parseBERHistory() expected error for malformed report")
+	}
+}
+
+// Test linearRegressionSlope function
+func TestLinearRegressionSlope(t *testing.T) {
+	tests := []struct {
+		values   []float64
+		expected float64
+	}{
+		{[]float64{1, 2, 3, 4}, 1},
+		{[]float64{4, 3, 2, 1}, -1},
+		{[]float64{2, 2, 2}, 0},
+		{[]float64{5}, 0},
+	}
+
+	for _, tt := range tests {
+		if slope := linearRegressionSlope(tt.values); math.Abs(slope-tt.expected) > 1e-9 {
+			t.Errorf("linearRegressionSlope(%v) = %v, want %v", tt.values, slope, tt.expected)
+		}
+	}
+}
+
+// Test computeBERTrends function
+func TestComputeBERTrends(t *testing.T) {
+	history := map[string][]float64{
+		"rdma0": {1e-13, 1e-13, 1e-13, 1e-13, 1e-13, 1e-13},
+		"rdma1": {1e-13, 2e-13, 3e-13, 4e-13},
+		"rdma2": {4e-13, 3e-13, 2e-13, 1e-13},
+		"rdma3": {1e-13, 2e-13},
+	}
+
+	trends := computeBERTrends(history, 10)
+	if len(trends) != 3 {
+		t.Fatalf("computeBERTrends() returned %d trends, want 3 (rdma3 has too little history)", len(trends))
+	}
+
+	expectedTrends := map[string]string{"rdma0": "stable", "rdma1": "increasing", "rdma2": "decreasing"}
+	for _, trend := range trends {
+		if trend.Trend != expectedTrends[trend.Device] {
+			t.Errorf("%s trend = %s, want %s", trend.Device, trend.Trend, expectedTrends[trend.Device])
+		}
+	}
+
+	// Only the last 5 BER values are kept
+	if len(trends[0].LastBERValues) != berTrendLastValues {
+		t.Errorf("rdma0 last BER values = %v, want %d values", trends[0].LastBERValues, berTrendLastValues)
+	}
+	// rdma1 increases by 1e-13 per run around a mean of 2.5e-13
+	if math.Abs(trends[1].SlopePercent-40) > 1e-6 {
+		t.Errorf("rdma1 slope percent = %v, want 40", trends[1].SlopePercent)
+	}
+}
+
+// Test validateBERTrends function
+func TestValidateBERTrends(t *testing.T) {
+	status, err := validateBERTrends([]BERTrend{{Device: "rdma0", Trend: "stable"}, {Device: "rdma1", Trend: "decreasing"}}, 10)
+	if status != "PASS" || err != nil {
+		t.Errorf("validateBERTrends() = %s, %v, want PASS", status, err)
+	}
+
+	status, err = validateBERTrends([]BERTrend{{Device: "rdma0", Trend: "stable"}, {Device: "rdma1", Trend: "increasing", SlopePercent: 25}}, 10)
+	if status != "WARN" || err == nil || !strings.Contains(err.Error(), "rdma1 (+25.0%/run)") {
+		t.Errorf("validateBERTrends() = %s, %v, want WARN for rdma1", status, err)
+	}
+}
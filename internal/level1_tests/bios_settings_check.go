@@ -0,0 +1,258 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// firmwareVersionSeparatorRegex splits firmware versions such as "2.1.3" or "SE5C7411.86B.9535" into segments
+var firmwareVersionSeparatorRegex = regexp.MustCompile(`[.\-_ ]+`)
+
+// BIOSSettingsCheckTestConfig represents the config needed to run this test.
+// ExpectedHyperthreading is nil when the hyperthreading state is not checked.
+type BIOSSettingsCheckTestConfig struct {
+	IsEnabled              bool   `json:"enabled"`
+	Shape                  string `json:"shape"`
+	MinBIOSVersion         string `json:"min_bios_version"`
+	ExpectedHyperthreading *bool  `json:"hyperthreading_enabled"`
+}
+
+// BIOSSettings represents the BIOS version and CPU settings read from dmidecode
+type BIOSSettings struct {
+	BIOSVersion           string `json:"bios_version"`
+	CPUModel              string `json:"cpu_model"`
+	CoreCount             int    `json:"core_count"`
+	ThreadCount           int    `json:"thread_count"`
+	HyperthreadingEnabled bool   `json:"hyperthreading_enabled"`
+}
+
+// getBIOSSettingsCheckTestConfig gets test config needed to run this test
+func getBIOSSettingsCheckTestConfig() (*BIOSSettingsCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	biosSettingsCheckTestConfig := &BIOSSettingsCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "bios_settings_check")
+	if err != nil {
+		return nil, err
+	}
+	biosSettingsCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "bios_settings_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if version, ok := thresholdMap["min_bios_version"].(string); ok {
+				biosSettingsCheckTestConfig.MinBIOSVersion = version
+			}
+			if hyperthreading, ok := thresholdMap["hyperthreading_enabled"].(bool); ok {
+				biosSettingsCheckTestConfig.ExpectedHyperthreading = &hyperthreading
+			}
+		}
+	}
+
+	return biosSettingsCheckTestConfig, nil
+}
+
+// dmidecodeField returns the value of the first "Key: value" line with the given key in dmidecode output
+func dmidecodeField(output string, key string) string {
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), ":", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == key {
+			return strings.TrimSpace(parts[1])
+		}
+	}
+	return ""
+}
+
+// parseDmidecodeBIOS parses the BIOS version from dmidecode -t bios output
+func parseDmidecodeBIOS(output string) (string, error) {
+	version := dmidecodeField(output, "Version")
+	if version == "" {
+		return "", fmt.Errorf("BIOS version not found in dmidecode output")
+	}
+	return version, nil
+}
+
+// parseDmidecodeProcessor parses the CPU model and the core and thread counts summed over
+// all populated sockets from dmidecode -t processor output
+func parseDmidecodeProcessor(output string) (*BIOSSettings, error) {
+	settings := &BIOSSettings{}
+	sockets := 0
+
+	// Each "Processor Information" section describes one socket
+	for _, section := range strings.Split(output, "Processor Information")[1:] {
+		if status := dmidecodeField(section, "Status"); strings.Contains(status, "Unpopulated") {
+			continue
+		}
+		sockets++
+
+		if settings.CPUModel == "" {
+			settings.CPUModel = dmidecodeField(section, "Version")
+		}
+		cores, err := strconv.Atoi(dmidecodeField(section, "Core Count"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid core count in dmidecode output: %w", err)
+		}
+		threads, err := strconv.Atoi(dmidecodeField(section, "Thread Count"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid thread count in dmidecode output: %w", err)
+		}
+		settings.CoreCount += cores
+		settings.ThreadCount += threads
+	}
+
+	if sockets == 0 {
+		return nil, fmt.Errorf("no processors found in dmidecode output")
+	}
+	settings.HyperthreadingEnabled = settings.ThreadCount > settings.CoreCount
+	return settings, nil
+}
+
+// compareFirmwareVersions compares two firmware versions segment by segment, numerically when both
+// segments are numbers. It returns -1, 0 or 1 when a is older, equal or newer than b.
+func compareFirmwareVersions(a, b string) int {
+	aParts := firmwareVersionSeparatorRegex.Split(strings.TrimSpace(a), -1)
+	bParts := firmwareVersionSeparatorRegex.Split(strings.TrimSpace(b), -1)
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aPart, bPart := "0", "0"
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+
+		aNum, aErr := strconv.Atoi(aPart)
+		bNum, bErr := strconv.Atoi(bPart)
+		if aErr == nil && bErr == nil {
+			if aNum != bNum {
+				if aNum < bNum {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+		if cmp := strings.Compare(aPart, bPart); cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+// validateBIOSSettings returns the overall status of the BIOS settings and whether the BIOS version is supported.
+// A BIOS older than the minimum version FAILs, an unexpected hyperthreading state WARNs.
+func validateBIOSSettings(settings *BIOSSettings, testConfig *BIOSSettingsCheckTestConfig) (string, bool, error) {
+	versionSupported := testConfig.MinBIOSVersion == "" || compareFirmwareVersions(settings.BIOSVersion, testConfig.MinBIOSVersion) >= 0
+	if !versionSupported {
+		return "FAIL", false, fmt.Errorf("BIOS version %s is older than the minimum supported version %s",
+			settings.BIOSVersion, testConfig.MinBIOSVersion)
+	}
+
+	if testConfig.ExpectedHyperthreading != nil && settings.HyperthreadingEnabled != *testConfig.ExpectedHyperthreading {
+		return "WARN", true, fmt.Errorf("hyperthreading is %s, expected %s",
+			enabledState(settings.HyperthreadingEnabled), enabledState(*testConfig.ExpectedHyperthreading))
+	}
+
+	return "PASS", true, nil
+}
+
+// enabledState returns "enabled" or "disabled"
+func enabledState(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// getBIOSSettings reads the BIOS version and CPU settings with dmidecode
+func getBIOSSettings() (*BIOSSettings, error) {
+	processorResult, err := executor.RunDmidecode("processor")
+	if err != nil {
+		return nil, fmt.Errorf("dmidecode failed: %w", commandError("bios_settings_check", processorResult, err))
+	}
+	settings, err := parseDmidecodeProcessor(processorResult.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	biosResult, err := executor.RunDmidecode("bios")
+	if err != nil {
+		return nil, fmt.Errorf("dmidecode failed: %w", commandError("bios_settings_check", biosResult, err))
+	}
+	settings.BIOSVersion, err = parseDmidecodeBIOS(biosResult.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func RunBIOSSettingsCheck() error {
+	logger.Info("=== BIOS Settings Check ===")
+	testConfig, err := getBIOSSettingsCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "bios_settings_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting BIOS settings check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read BIOS version and CPU settings
+	logger.Info("Step 1: Reading BIOS and processor information with dmidecode...")
+	settings, err := getBIOSSettings()
+	if err != nil {
+		logger.Error("BIOS Settings Check: FAIL - Could not read BIOS settings:", err)
+		rep.AddBIOSSettingsResult("FAIL", "", "", false, false, err)
+		return fmt.Errorf("could not read BIOS settings: %w", err)
+	}
+	logger.Infof("BIOS version: %s, CPU: %s, %d cores, %d threads, hyperthreading %s",
+		settings.BIOSVersion, settings.CPUModel, settings.CoreCount, settings.ThreadCount, enabledState(settings.HyperthreadingEnabled))
+
+	// Step 2: Validate against the shape requirements
+	logger.Info("Step 2: Validating BIOS settings...")
+	status, versionSupported, validationErr := validateBIOSSettings(settings, testConfig)
+	rep.AddBIOSSettingsResult(status, settings.BIOSVersion, settings.CPUModel, settings.HyperthreadingEnabled, versionSupported, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("BIOS Settings Check: PASS - BIOS version and settings meet the shape requirements")
+		return nil
+	case "WARN":
+		logger.Info("BIOS Settings Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("BIOS Settings Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,121 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const sampleDmidecodeProcessor = `# dmidecode 3.3
+Getting SMBIOS data from sysfs.
+SMBIOS 3.3.0 present.
+
+Handle 0x0054, DMI type 4, 48 bytes
+Processor Information
+	Socket Designation: CPU0
+	Type: Central Processor
+	Family: Xeon
+	Version: Intel(R) Xeon(R) Platinum 8480+
+	Status: Populated, Enabled
+	Core Count: 56
+	Core Enabled: 56
+	Thread Count: 56
+
+Handle 0x0055, DMI type 4, 48 bytes
+Processor Information
+	Socket Designation: CPU1
+	Type: Central Processor
+	Family: Xeon
+	Version: Intel(R) Xeon(R) Platinum 8480+
+	Status: Populated, Enabled
+	Core Count: 56
+	Core Enabled: 56
+	Thread Count: 56
+`
+
+const sampleDmidecodeBIOS = `# dmidecode 3.3
+Getting SMBIOS data from sysfs.
+SMBIOS 3.3.0 present.
+
+Handle 0x0000, DMI type 0, 26 bytes
+BIOS Information
+	Vendor: American Megatrends International, LLC.
+	Version: 2.1.3
+	Release Date: 03/14/2024
+`
+
+// Test parseDmidecodeProcessor function
+func TestParseDmidecodeProcessor(t *testing.T) {
+	settings, err := parseDmidecodeProcessor(sampleDmidecodeProcessor)
+	if err != nil {
+		t.Fatalf("parseDmidecodeProcessor() error = %v", err)
+	}
+	if settings.CPUModel != "Intel(R) Xeon(R) Platinum 8480+" {
+		t.Errorf("parseDmidecodeProcessor() CPUModel = %q", settings.CPUModel)
+	}
+	if settings.CoreCount != 112 || settings.ThreadCount != 112 {
+		t.Errorf("parseDmidecodeProcessor() cores = %d, threads = %d, want 112, 112", settings.CoreCount, settings.ThreadCount)
+	}
+	if settings.HyperthreadingEnabled {
+		t.Error("parseDmidecodeProcessor() HyperthreadingEnabled = true, want false")
+	}
+
+	if _, err := parseDmidecodeProcessor("# dmidecode 3.3\n"); err == nil {
+		t.Error("parseDmidecodeProcessor() expected error for output without processors")
+	}
+}
+
+// Test parseDmidecodeBIOS function
+func TestParseDmidecodeBIOS(t *testing.T) {
+	version, err := parseDmidecodeBIOS(sampleDmidecodeBIOS)
+	if err != nil || version != "2.1.3" {
+		t.Errorf("parseDmidecodeBIOS() = %q, %v, want 2.1.3", version, err)
+	}
+}
+
+// Test compareFirmwareVersions function
+func TestCompareFirmwareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.1.3", "2.1.3", 0},
+		{"2.10.0", "2.9.1", 1},
+		{"1.0", "1.0.1", -1},
+		{"1.0.0", "1", 0},
+		{"U46-2.1", "U46-1.8", 1},
+	}
+
+	for _, tt := range tests {
+		if got := compareFirmwareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareFirmwareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// Test validateBIOSSettings function
+func TestValidateBIOSSettings(t *testing.T) {
+	htDisabled := false
+	testConfig := &BIOSSettingsCheckTestConfig{MinBIOSVersion: "2.0", ExpectedHyperthreading: &htDisabled}
+
+	tests := []struct {
+		name             string
+		settings         *BIOSSettings
+		expectedStatus   string
+		versionSupported bool
+	}{
+		{"Supported version and hyperthreading disabled", &BIOSSettings{BIOSVersion: "2.1.3"}, "PASS", true},
+		{"Hyperthreading enabled", &BIOSSettings{BIOSVersion: "2.1.3", HyperthreadingEnabled: true}, "WARN", true},
+		{"BIOS older than minimum", &BIOSSettings{BIOSVersion: "1.8"}, "FAIL", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, versionSupported, err := validateBIOSSettings(tt.settings, testConfig)
+			if status != tt.expectedStatus || versionSupported != tt.versionSupported {
+				t.Errorf("validateBIOSSettings() = %s, %t, want %s, %t", status, versionSupported, tt.expectedStatus, tt.versionSupported)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateBIOSSettings() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
@@ -1,10 +1,10 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -125,7 +125,7 @@ func parseGPUInfo() ([]string, []string, error) {
 	// Use nvidia-smi -q to get detailed GPU information
 	queryResult := executor.RunNvidiaSMIQueryDetailed()
 	if !queryResult.Available || queryResult.Error != "" {
-		return nil, nil, fmt.Errorf("failed to get detailed GPU information: %s", queryResult.Error)
+		return nil, nil, nvidiaSMIError("cdfp_cable_check", "nvidia-smi -q", queryResult)
 	}
 
 	var pciAddresses []string
@@ -271,7 +271,7 @@ func RunCDFPCableCheck() error {
 	if !cdfpTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "cdfp_cable_check", Shape: shape}
 	}
 
 	// Step 3: Validate expected configuration
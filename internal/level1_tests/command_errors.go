@@ -0,0 +1,67 @@
+package level1_tests
+
+import (
+	"errors"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+)
+
+// commandNotFoundExitCode is the exit code of a shell or sudo that could not find a command
+const commandNotFoundExitCode = 127
+
+// commandNotFoundPattern matches the messages of shells and sudo for missing commands,
+// e.g. "sudo: mlxlink: command not found"
+var commandNotFoundPattern = regexp.MustCompile(`([\w.-]+): command not found`)
+
+// commandError converts the failure of an OS command run by testName into a
+// TestToolNotFoundError when the command is not installed, or a TestExecutionError otherwise
+func commandError(testName string, result *executor.OSCommandResult, err error) error {
+	if result == nil {
+		return err
+	}
+
+	if match := commandNotFoundPattern.FindStringSubmatch(result.Output); match != nil {
+		return testerrors.NewToolNotFoundError(testName, match[1], err)
+	}
+	if errors.Is(err, exec.ErrNotFound) || result.ExitCode == commandNotFoundExitCode {
+		return testerrors.NewToolNotFoundError(testName, commandTool(result.Command), err)
+	}
+
+	return &testerrors.TestExecutionError{
+		TestName: testName,
+		Cmd:      strings.TrimSpace(result.Command),
+		ExitCode: result.ExitCode,
+		Stderr:   strings.TrimSpace(result.Output),
+		Err:      err,
+	}
+}
+
+// nvidiaSMIError converts a failed nvidia-smi command run by testName into a typed error.
+// nvidia-smi results do not carry an exit code.
+func nvidiaSMIError(testName, command string, result *executor.NvidiaSMIResult) error {
+	if strings.Contains(result.Error, "not found in PATH") {
+		return testerrors.NewToolNotFoundError(testName, "nvidia-smi", nil)
+	}
+
+	return &testerrors.TestExecutionError{
+		TestName: testName,
+		Cmd:      command,
+		ExitCode: -1,
+		Stderr:   strings.TrimSpace(result.Output),
+		Err:      errors.New(result.Error),
+	}
+}
+
+// commandTool returns the tool run by command, skipping sudo
+func commandTool(command string) string {
+	for _, field := range strings.Fields(command) {
+		if field != "sudo" {
+			return field
+		}
+	}
+	return command
+}
@@ -0,0 +1,82 @@
+package level1_tests
+
+import (
+	"errors"
+	"testing"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+)
+
+func TestCommandError(t *testing.T) {
+	exitErr := errors.New("exit status 1")
+
+	tests := []struct {
+		name            string
+		result          *executor.OSCommandResult
+		expectedTool    string
+		expectedPackage string
+		expectedCmd     string
+	}{
+		{
+			name:            "sudo reports missing command",
+			result:          &executor.OSCommandResult{Command: "sudo ibstat ", Output: "sudo: ibstat: command not found\n", ExitCode: 1},
+			expectedTool:    "ibstat",
+			expectedPackage: "infiniband-diags",
+		},
+		{
+			name:            "Shell exit code for missing command",
+			result:          &executor.OSCommandResult{Command: "rdma link", ExitCode: commandNotFoundExitCode},
+			expectedTool:    "rdma",
+			expectedPackage: "iproute2",
+		},
+		{
+			name:        "Command failed",
+			result:      &executor.OSCommandResult{Command: "sudo dmesg ", Output: "dmesg: read kernel buffer failed: Operation not permitted", ExitCode: 1},
+			expectedCmd: "sudo dmesg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := commandError("test_check", tt.result, exitErr)
+			if !errors.Is(err, exitErr) {
+				t.Errorf("Expected error to wrap the command error, got %v", err)
+			}
+
+			var toolErr *testerrors.TestToolNotFoundError
+			var executionErr *testerrors.TestExecutionError
+			switch {
+			case tt.expectedTool != "":
+				if !errors.As(err, &toolErr) || toolErr.Tool != tt.expectedTool || toolErr.Package != tt.expectedPackage {
+					t.Errorf("Expected missing %s from %s, got %#v", tt.expectedTool, tt.expectedPackage, err)
+				}
+			case !errors.As(err, &executionErr):
+				t.Errorf("Expected TestExecutionError, got %#v", err)
+			case executionErr.Cmd != tt.expectedCmd || executionErr.ExitCode != 1 || executionErr.Stderr != tt.result.Output:
+				t.Errorf("Unexpected execution error: %+v", executionErr)
+			}
+		})
+	}
+
+	if err := commandError("test_check", nil, exitErr); err != exitErr {
+		t.Errorf("Expected error without result to be returned unchanged, got %v", err)
+	}
+}
+
+func TestNvidiaSMIError(t *testing.T) {
+	err := nvidiaSMIError("gpu_count_check", "nvidia-smi --query-gpu=name", &executor.NvidiaSMIResult{Error: "nvidia-smi not found in PATH"})
+	var toolErr *testerrors.TestToolNotFoundError
+	if !errors.As(err, &toolErr) || toolErr.Tool != "nvidia-smi" || toolErr.Package != "nvidia-utils" {
+		t.Errorf("Expected missing nvidia-smi, got %#v", err)
+	}
+
+	err = nvidiaSMIError("gpu_count_check", "nvidia-smi --query-gpu=name", &executor.NvidiaSMIResult{
+		Error:  "exit status 9",
+		Output: "NVIDIA-SMI has failed because it couldn't communicate with the NVIDIA driver.",
+	})
+	var executionErr *testerrors.TestExecutionError
+	if !errors.As(err, &executionErr) || executionErr.Cmd != "nvidia-smi --query-gpu=name" || executionErr.ExitCode != -1 {
+		t.Errorf("Expected TestExecutionError, got %#v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// defaultCPUGovernor is the scaling governor expected for HPC workloads
+const defaultCPUGovernor = "performance"
+
+// CPUGovernorCheckTestConfig represents the config needed to run this test
+type CPUGovernorCheckTestConfig struct {
+	IsEnabled        bool   `json:"enabled"`
+	Shape            string `json:"shape"`
+	ExpectedGovernor string `json:"expected_governor"`
+}
+
+// getCPUGovernorCheckTestConfig gets test config needed to run this test
+func getCPUGovernorCheckTestConfig() (*CPUGovernorCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	cpuGovernorCheckTestConfig := &CPUGovernorCheckTestConfig{
+		IsEnabled:        false,
+		Shape:            shape,
+		ExpectedGovernor: defaultCPUGovernor,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "cpu_governor_check")
+	if err != nil {
+		return nil, err
+	}
+	cpuGovernorCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "cpu_governor_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if governor, ok := thresholdMap["expected_governor"].(string); ok && governor != "" {
+				cpuGovernorCheckTestConfig.ExpectedGovernor = governor
+			}
+		}
+	}
+
+	return cpuGovernorCheckTestConfig, nil
+}
+
+// sortCPUNames sorts CPU names such as "cpu10" and "cpu2" by CPU number
+func sortCPUNames(cpus []string) {
+	sort.Slice(cpus, func(i, j int) bool {
+		a, aErr := strconv.Atoi(strings.TrimPrefix(cpus[i], "cpu"))
+		b, bErr := strconv.Atoi(strings.TrimPrefix(cpus[j], "cpu"))
+		if aErr != nil || bErr != nil {
+			return cpus[i] < cpus[j]
+		}
+		return a < b
+	})
+}
+
+// validateCPUGovernors checks the cpu0 governor against the expected governor and returns
+// the overall status, the cpu0 governor and the CPUs whose governor differs from the expected one.
+// A wrong cpu0 governor FAILs, other CPUs with a different governor WARN.
+func validateCPUGovernors(governors map[string]string, expected string) (string, string, []string, error) {
+	detected, ok := governors["cpu0"]
+	if !ok {
+		return "FAIL", "", nil, fmt.Errorf("scaling governor of cpu0 not found")
+	}
+
+	differing := []string{}
+	for cpu, governor := range governors {
+		if governor != expected {
+			differing = append(differing, cpu)
+		}
+	}
+	sortCPUNames(differing)
+
+	if detected != expected {
+		return "FAIL", detected, differing, fmt.Errorf("CPU scaling governor is %s, expected %s on %d of %d CPUs",
+			detected, expected, len(differing), len(governors))
+	}
+	if len(differing) > 0 {
+		return "WARN", detected, differing, fmt.Errorf("mixed CPU scaling governors, %d of %d CPUs are not set to %s: %s",
+			len(differing), len(governors), expected, strings.Join(differing, ","))
+	}
+	return "PASS", detected, differing, nil
+}
+
+func RunCPUGovernorCheck() error {
+	logger.Info("=== CPU Governor Check ===")
+	testConfig, err := getCPUGovernorCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "cpu_governor_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting CPU governor check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the scaling governor of every CPU
+	logger.Info("Step 1: Reading CPU scaling governors...")
+	governors, err := executor.GetCPUGovernors()
+	if err != nil {
+		logger.Error("CPU Governor Check: FAIL - Could not read CPU scaling governors:", err)
+		rep.AddCPUGovernorResult("FAIL", "", testConfig.ExpectedGovernor, nil, err)
+		return fmt.Errorf("could not read CPU scaling governors: %w", err)
+	}
+
+	// Step 2: Validate the governors
+	logger.Info("Step 2: Validating CPU scaling governors...")
+	logger.Info("Expected governor:", testConfig.ExpectedGovernor)
+	status, detected, differing, validationErr := validateCPUGovernors(governors, testConfig.ExpectedGovernor)
+	rep.AddCPUGovernorResult(status, detected, testConfig.ExpectedGovernor, differing, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("CPU Governor Check: PASS - All %d CPUs use the %s governor", len(governors), detected)
+		return nil
+	case "WARN":
+		logger.Info("CPU Governor Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("CPU Governor Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,59 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test validateCPUGovernors function
+func TestValidateCPUGovernors(t *testing.T) {
+	tests := []struct {
+		name              string
+		governors         map[string]string
+		expectedStatus    string
+		expectedDetected  string
+		expectedDiffering []string
+	}{
+		{
+			name:              "All CPUs performance",
+			governors:         map[string]string{"cpu0": "performance", "cpu1": "performance"},
+			expectedStatus:    "PASS",
+			expectedDetected:  "performance",
+			expectedDiffering: []string{},
+		},
+		{
+			name:              "Mixed governors",
+			governors:         map[string]string{"cpu0": "performance", "cpu2": "powersave", "cpu10": "powersave", "cpu1": "performance"},
+			expectedStatus:    "WARN",
+			expectedDetected:  "performance",
+			expectedDiffering: []string{"cpu2", "cpu10"},
+		},
+		{
+			name:              "Wrong governor",
+			governors:         map[string]string{"cpu0": "powersave", "cpu1": "powersave"},
+			expectedStatus:    "FAIL",
+			expectedDetected:  "powersave",
+			expectedDiffering: []string{"cpu0", "cpu1"},
+		},
+		{
+			name:           "cpu0 missing",
+			governors:      map[string]string{"cpu1": "performance"},
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, detected, differing, err := validateCPUGovernors(tt.governors, "performance")
+			if status != tt.expectedStatus || detected != tt.expectedDetected {
+				t.Errorf("validateCPUGovernors() = %s, %s, want %s, %s", status, detected, tt.expectedStatus, tt.expectedDetected)
+			}
+			if tt.expectedDiffering != nil && !reflect.DeepEqual(differing, tt.expectedDiffering) {
+				t.Errorf("validateCPUGovernors() differing = %v, want %v", differing, tt.expectedDiffering)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateCPUGovernors() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// isolatedCPUsPath lists the CPUs isolated from the general scheduler
+const isolatedCPUsPath = "/sys/devices/system/cpu/isolated"
+
+// CPUIsolationCheckTestConfig represents the config needed to run this test
+type CPUIsolationCheckTestConfig struct {
+	IsEnabled            bool   `json:"enabled"`
+	Shape                string `json:"shape"`
+	ExpectedIsolatedCPUs int    `json:"expected_isolated_cpus"`
+	RequireNohzFull      bool   `json:"require_nohz_full"`
+}
+
+// CPUIsolation represents the CPU isolation configuration of the running kernel
+type CPUIsolation struct {
+	IsolatedCPUs []int  `json:"isolated_cpus"`
+	IsolCPUsArg  string `json:"isolcpus"`
+	NohzFullCPUs []int  `json:"nohz_full_cpus"`
+}
+
+// getCPUIsolationCheckTestConfig gets test config needed to run this test
+func getCPUIsolationCheckTestConfig() (*CPUIsolationCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	cpuIsolationCheckTestConfig := &CPUIsolationCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "cpu_isolation_check")
+	if err != nil {
+		return nil, err
+	}
+	cpuIsolationCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "cpu_isolation_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if count, ok := thresholdMap["expected_isolated_cpus"].(float64); ok {
+				cpuIsolationCheckTestConfig.ExpectedIsolatedCPUs = int(count)
+			}
+			if requireNohzFull, ok := thresholdMap["require_nohz_full"].(bool); ok {
+				cpuIsolationCheckTestConfig.RequireNohzFull = requireNohzFull
+			}
+		}
+	}
+
+	return cpuIsolationCheckTestConfig, nil
+}
+
+// parseCPUList parses a kernel CPU list such as "2-5,8,10-11" into sorted CPU numbers
+func parseCPUList(list string) ([]int, error) {
+	cpus := []int{}
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return cpus, nil
+	}
+
+	for _, part := range strings.Split(list, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %q: %w", list, err)
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid CPU list %q: %w", list, err)
+			}
+		}
+		if last < first {
+			return nil, fmt.Errorf("invalid CPU range %q", part)
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// kernelCmdlineParam returns the value of a key=value parameter in the kernel command line
+func kernelCmdlineParam(cmdline string, key string) (string, bool) {
+	for _, field := range strings.Fields(cmdline) {
+		if value, found := strings.CutPrefix(field, key+"="); found {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// parseCPUIsolation builds the CPU isolation configuration from /sys/devices/system/cpu/isolated
+// and the isolcpus= and nohz_full= parameters of /proc/cmdline
+func parseCPUIsolation(isolated string, cmdline string) (*CPUIsolation, error) {
+	isolatedCPUs, err := parseCPUList(isolated)
+	if err != nil {
+		return nil, err
+	}
+
+	isolation := &CPUIsolation{
+		IsolatedCPUs: isolatedCPUs,
+		NohzFullCPUs: []int{},
+	}
+	isolation.IsolCPUsArg, _ = kernelCmdlineParam(cmdline, "isolcpus")
+
+	if nohzFull, found := kernelCmdlineParam(cmdline, "nohz_full"); found {
+		if isolation.NohzFullCPUs, err = parseCPUList(nohzFull); err != nil {
+			return nil, err
+		}
+	}
+
+	return isolation, nil
+}
+
+// validateCPUIsolation returns the overall status of the CPU isolation and whether nohz_full
+// covers exactly the isolated CPUs. Fewer isolated CPUs than expected or a missing or different
+// nohz_full list only WARN, since most workloads still run without CPU isolation.
+func validateCPUIsolation(isolation *CPUIsolation, testConfig *CPUIsolationCheckTestConfig) (string, bool, error) {
+	nohzFullMatches := len(isolation.IsolatedCPUs) > 0 && equalInts(isolation.IsolatedCPUs, isolation.NohzFullCPUs)
+
+	var issues []string
+	if len(isolation.IsolatedCPUs) < testConfig.ExpectedIsolatedCPUs {
+		issues = append(issues, fmt.Sprintf("%d CPUs isolated, expected %d",
+			len(isolation.IsolatedCPUs), testConfig.ExpectedIsolatedCPUs))
+	}
+	if testConfig.RequireNohzFull && !nohzFullMatches {
+		issues = append(issues, "nohz_full does not match the isolated CPUs")
+	}
+
+	if len(issues) > 0 {
+		return "WARN", nohzFullMatches, fmt.Errorf("suboptimal CPU isolation: %s", strings.Join(issues, "; "))
+	}
+	return "PASS", nohzFullMatches, nil
+}
+
+// equalInts reports whether two sorted int slices are equal
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func RunCPUIsolationCheck() error {
+	logger.Info("=== CPU Isolation Check ===")
+	testConfig, err := getCPUIsolationCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "cpu_isolation_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting CPU isolation check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the isolated CPUs and the kernel command line
+	logger.Info("Step 1: Reading isolated CPUs and kernel command line...")
+	isolatedResult, err := executor.RunCat(isolatedCPUsPath)
+	if err != nil {
+		err = commandError("cpu_isolation_check", isolatedResult, err)
+		logger.Error("CPU Isolation Check: FAIL - Could not read isolated CPUs:", err)
+		rep.AddCPUIsolationResult("FAIL", 0, testConfig.ExpectedIsolatedCPUs, false, err)
+		return fmt.Errorf("could not read isolated CPUs: %w", err)
+	}
+	cmdlineResult, err := executor.RunCat(kernelCmdlinePath)
+	if err != nil {
+		err = commandError("cpu_isolation_check", cmdlineResult, err)
+		logger.Error("CPU Isolation Check: FAIL - Could not read kernel command line:", err)
+		rep.AddCPUIsolationResult("FAIL", 0, testConfig.ExpectedIsolatedCPUs, false, err)
+		return fmt.Errorf("could not read kernel command line: %w", err)
+	}
+
+	isolation, err := parseCPUIsolation(isolatedResult.Output, cmdlineResult.Output)
+	if err != nil {
+		logger.Error("CPU Isolation Check: FAIL - Could not parse CPU isolation:", err)
+		rep.AddCPUIsolationResult("FAIL", 0, testConfig.ExpectedIsolatedCPUs, false, err)
+		return fmt.Errorf("could not parse CPU isolation: %w", err)
+	}
+	logger.Infof("Isolated CPUs: %d, isolcpus=%s, nohz_full CPUs: %d",
+		len(isolation.IsolatedCPUs), isolation.IsolCPUsArg, len(isolation.NohzFullCPUs))
+
+	// Step 2: Validate against the expected isolation
+	logger.Info("Step 2: Validating CPU isolation...")
+	logger.Infof("Expected isolated CPUs: %d, nohz_full required: %t", testConfig.ExpectedIsolatedCPUs, testConfig.RequireNohzFull)
+	status, nohzFullMatches, validationErr := validateCPUIsolation(isolation, testConfig)
+	rep.AddCPUIsolationResult(status, len(isolation.IsolatedCPUs), testConfig.ExpectedIsolatedCPUs, nohzFullMatches, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("CPU Isolation Check: PASS - %d CPUs isolated as expected", len(isolation.IsolatedCPUs))
+		return nil
+	default: // WARN
+		logger.Info("CPU Isolation Check: WARN -", validationErr)
+		return validationErr
+	}
+}
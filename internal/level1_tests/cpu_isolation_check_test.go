@@ -0,0 +1,92 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseCPUList function
+func TestParseCPUList(t *testing.T) {
+	tests := []struct {
+		name        string
+		list        string
+		expected    []int
+		expectError bool
+	}{
+		{"Empty list", "\n", []int{}, false},
+		{"Ranges and single CPUs", "8-9,2-4,6\n", []int{2, 3, 4, 6, 8, 9}, false},
+		{"Invalid CPU", "2-x", nil, true},
+		{"Reversed range", "5-2", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpus, err := parseCPUList(tt.list)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseCPUList() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError && !reflect.DeepEqual(cpus, tt.expected) {
+				t.Errorf("parseCPUList() = %v, want %v", cpus, tt.expected)
+			}
+		})
+	}
+}
+
+// Test parseCPUIsolation function
+func TestParseCPUIsolation(t *testing.T) {
+	cmdline := "BOOT_IMAGE=/vmlinuz-5.15.0 root=UUID=abcd ro isolcpus=managed_irq,domain,2-5 nohz_full=2-5 quiet\n"
+	isolation, err := parseCPUIsolation("2-5\n", cmdline)
+	if err != nil {
+		t.Fatalf("parseCPUIsolation() error = %v", err)
+	}
+	if !reflect.DeepEqual(isolation.IsolatedCPUs, []int{2, 3, 4, 5}) {
+		t.Errorf("parseCPUIsolation() IsolatedCPUs = %v", isolation.IsolatedCPUs)
+	}
+	if isolation.IsolCPUsArg != "managed_irq,domain,2-5" {
+		t.Errorf("parseCPUIsolation() IsolCPUsArg = %q", isolation.IsolCPUsArg)
+	}
+	if !reflect.DeepEqual(isolation.NohzFullCPUs, []int{2, 3, 4, 5}) {
+		t.Errorf("parseCPUIsolation() NohzFullCPUs = %v", isolation.NohzFullCPUs)
+	}
+}
+
+// Test validateCPUIsolation function
+func TestValidateCPUIsolation(t *testing.T) {
+	testConfig := &CPUIsolationCheckTestConfig{ExpectedIsolatedCPUs: 4, RequireNohzFull: true}
+
+	tests := []struct {
+		name            string
+		isolation       *CPUIsolation
+		expectedStatus  string
+		nohzFullMatches bool
+	}{
+		{
+			name:            "Expected isolation",
+			isolation:       &CPUIsolation{IsolatedCPUs: []int{2, 3, 4, 5}, NohzFullCPUs: []int{2, 3, 4, 5}},
+			expectedStatus:  "PASS",
+			nohzFullMatches: true,
+		},
+		{
+			name:           "No isolation",
+			isolation:      &CPUIsolation{IsolatedCPUs: []int{}, NohzFullCPUs: []int{}},
+			expectedStatus: "WARN",
+		},
+		{
+			name:           "nohz_full differs from isolated CPUs",
+			isolation:      &CPUIsolation{IsolatedCPUs: []int{2, 3, 4, 5}, NohzFullCPUs: []int{2, 3}},
+			expectedStatus: "WARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, nohzFullMatches, err := validateCPUIsolation(tt.isolation, testConfig)
+			if status != tt.expectedStatus || nohzFullMatches != tt.nohzFullMatches {
+				t.Errorf("validateCPUIsolation() = %s, %t, want %s, %t", status, nohzFullMatches, tt.expectedStatus, tt.nohzFullMatches)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateCPUIsolation() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
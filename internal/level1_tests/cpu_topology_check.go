@@ -0,0 +1,222 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// cpuInfoPath lists every logical CPU with its socket (physical id) and core (core id)
+const cpuInfoPath = "/proc/cpuinfo"
+
+// CPUTopologyCheckTestConfig represents the config needed to run this test.
+// Expected counts of zero are not checked.
+type CPUTopologyCheckTestConfig struct {
+	IsEnabled              bool   `json:"enabled"`
+	Shape                  string `json:"shape"`
+	ExpectedSockets        int    `json:"sockets"`
+	ExpectedCoresPerSocket int    `json:"cores_per_socket"`
+	ExpectedLogicalCPUs    int    `json:"logical_cpus"`
+}
+
+// CPUTopology represents the CPU sockets, cores and logical CPUs of the node
+type CPUTopology struct {
+	Sockets        int  `json:"sockets"`
+	CoresPerSocket int  `json:"cores_per_socket"`
+	ThreadsPerCore int  `json:"threads_per_core"`
+	LogicalCPUs    int  `json:"logical_cpus"`
+	HyperThreading bool `json:"hyper_threading"`
+}
+
+// getCPUTopologyCheckTestConfig gets test config needed to run this test
+func getCPUTopologyCheckTestConfig() (*CPUTopologyCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	cpuTopologyCheckTestConfig := &CPUTopologyCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "cpu_topology_check")
+	if err != nil {
+		return nil, err
+	}
+	cpuTopologyCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "cpu_topology_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if sockets, ok := thresholdMap["sockets"].(float64); ok {
+				cpuTopologyCheckTestConfig.ExpectedSockets = int(sockets)
+			}
+			if coresPerSocket, ok := thresholdMap["cores_per_socket"].(float64); ok {
+				cpuTopologyCheckTestConfig.ExpectedCoresPerSocket = int(coresPerSocket)
+			}
+			if logicalCPUs, ok := thresholdMap["logical_cpus"].(float64); ok {
+				cpuTopologyCheckTestConfig.ExpectedLogicalCPUs = int(logicalCPUs)
+			}
+		}
+	}
+
+	return cpuTopologyCheckTestConfig, nil
+}
+
+// parseLscpuTopology parses the socket, core and thread counts of lscpu output
+func parseLscpuTopology(output string) (*CPUTopology, error) {
+	topology := &CPUTopology{}
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			continue
+		}
+		switch strings.TrimSpace(parts[0]) {
+		case "CPU(s)":
+			topology.LogicalCPUs = value
+		case "Socket(s)":
+			topology.Sockets = value
+		case "Core(s) per socket":
+			topology.CoresPerSocket = value
+		case "Thread(s) per core":
+			topology.ThreadsPerCore = value
+		}
+	}
+
+	if topology.LogicalCPUs == 0 || topology.Sockets == 0 || topology.CoresPerSocket == 0 || topology.ThreadsPerCore == 0 {
+		return nil, fmt.Errorf("could not parse CPU topology from lscpu output")
+	}
+	topology.HyperThreading = topology.ThreadsPerCore > 1
+	return topology, nil
+}
+
+// parseCPUInfoTopology derives the CPU topology from the processor, physical id and core id
+// entries of /proc/cpuinfo
+func parseCPUInfoTopology(output string) (*CPUTopology, error) {
+	topology := &CPUTopology{}
+	sockets := make(map[string]bool)
+	cores := make(map[string]bool)
+	physicalID := ""
+
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		switch strings.TrimSpace(parts[0]) {
+		case "processor":
+			topology.LogicalCPUs++
+		case "physical id":
+			physicalID = value
+			sockets[value] = true
+		case "core id":
+			cores[physicalID+"/"+value] = true
+		}
+	}
+
+	if topology.LogicalCPUs == 0 || len(sockets) == 0 || len(cores) == 0 {
+		return nil, fmt.Errorf("could not parse CPU topology from %s", cpuInfoPath)
+	}
+	topology.Sockets = len(sockets)
+	topology.CoresPerSocket = len(cores) / len(sockets)
+	topology.ThreadsPerCore = topology.LogicalCPUs / len(cores)
+	topology.HyperThreading = topology.ThreadsPerCore > 1
+	return topology, nil
+}
+
+// validateCPUTopology compares the CPU topology with the expected socket, core and logical CPU counts
+func validateCPUTopology(topology *CPUTopology, testConfig *CPUTopologyCheckTestConfig) error {
+	var mismatches []string
+	if testConfig.ExpectedSockets > 0 && topology.Sockets != testConfig.ExpectedSockets {
+		mismatches = append(mismatches, fmt.Sprintf("%d sockets, expected %d", topology.Sockets, testConfig.ExpectedSockets))
+	}
+	if testConfig.ExpectedCoresPerSocket > 0 && topology.CoresPerSocket != testConfig.ExpectedCoresPerSocket {
+		mismatches = append(mismatches, fmt.Sprintf("%d cores per socket, expected %d", topology.CoresPerSocket, testConfig.ExpectedCoresPerSocket))
+	}
+	if testConfig.ExpectedLogicalCPUs > 0 && topology.LogicalCPUs != testConfig.ExpectedLogicalCPUs {
+		mismatches = append(mismatches, fmt.Sprintf("%d logical CPUs, expected %d", topology.LogicalCPUs, testConfig.ExpectedLogicalCPUs))
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("CPU topology does not match the expected topology: %s", strings.Join(mismatches, "; "))
+	}
+	return nil
+}
+
+// getCPUTopology gets the CPU topology from lscpu, falling back to /proc/cpuinfo when lscpu is not available
+func getCPUTopology() (*CPUTopology, error) {
+	result, err := executor.RunLscpu()
+	if err == nil {
+		return parseLscpuTopology(result.Output)
+	}
+	logger.Info("lscpu not available, reading", cpuInfoPath, ":", commandError("cpu_topology_check", result, err))
+
+	result, err = executor.RunCat(cpuInfoPath)
+	if err != nil {
+		return nil, commandError("cpu_topology_check", result, err)
+	}
+	return parseCPUInfoTopology(result.Output)
+}
+
+// RunCPUTopologyCheck validates the number of CPU sockets, cores per socket and logical CPUs,
+// which are lower than expected when a socket is missing or cores are disabled
+func RunCPUTopologyCheck() error {
+	logger.Info("=== CPU Topology Check ===")
+	testConfig, err := getCPUTopologyCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "cpu_topology_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting CPU topology check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get the CPU topology
+	logger.Info("Step 1: Getting CPU topology...")
+	topology, err := getCPUTopology()
+	if err != nil {
+		logger.Error("CPU Topology Check: FAIL - Could not get CPU topology:", err)
+		rep.AddCPUTopologyResult("FAIL", 0, 0, 0, false, err)
+		return err
+	}
+	logger.Infof("Found %d sockets x %d cores per socket, %d threads per core, %d logical CPUs",
+		topology.Sockets, topology.CoresPerSocket, topology.ThreadsPerCore, topology.LogicalCPUs)
+
+	// Step 2: Compare the topology with the expected topology
+	logger.Infof("Step 2: Validating CPU topology (expected %d sockets x %d cores per socket, %d logical CPUs)...",
+		testConfig.ExpectedSockets, testConfig.ExpectedCoresPerSocket, testConfig.ExpectedLogicalCPUs)
+	if err := validateCPUTopology(topology, testConfig); err != nil {
+		logger.Error("CPU Topology Check: FAIL -", err)
+		rep.AddCPUTopologyResult("FAIL", topology.Sockets, topology.CoresPerSocket, topology.LogicalCPUs, topology.HyperThreading, err)
+		return err
+	}
+
+	logger.Info("CPU Topology Check: PASS - CPU topology matches the expected topology")
+	rep.AddCPUTopologyResult("PASS", topology.Sockets, topology.CoresPerSocket, topology.LogicalCPUs, topology.HyperThreading, nil)
+	return nil
+}
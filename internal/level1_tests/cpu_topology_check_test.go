@@ -0,0 +1,109 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseLscpuTopology function
+func TestParseLscpuTopology(t *testing.T) {
+	output := `Architecture:                    x86_64
+CPU op-mode(s):                  32-bit, 64-bit
+CPU(s):                          192
+On-line CPU(s) list:             0-191
+Thread(s) per core:              2
+Core(s) per socket:              48
+Socket(s):                       2
+NUMA node(s):                    2
+NUMA node0 CPU(s):               0-47,96-143`
+
+	topology, err := parseLscpuTopology(output)
+	if err != nil {
+		t.Fatalf("parseLscpuTopology() error = %v", err)
+	}
+	expected := &CPUTopology{Sockets: 2, CoresPerSocket: 48, ThreadsPerCore: 2, LogicalCPUs: 192, HyperThreading: true}
+	if !reflect.DeepEqual(topology, expected) {
+		t.Errorf("parseLscpuTopology() = %+v, want %+v", topology, expected)
+	}
+
+	if _, err := parseLscpuTopology("Architecture: x86_64"); err == nil {
+		t.Error("parseLscpuTopology() expected error for output without topology")
+	}
+}
+
+// Test parseCPUInfoTopology function
+func TestParseCPUInfoTopology(t *testing.T) {
+	output := `processor	: 0
+physical id	: 0
+core id		: 0
+
+processor	: 1
+physical id	: 0
+core id		: 1
+
+processor	: 2
+physical id	: 1
+core id		: 0
+
+processor	: 3
+physical id	: 1
+core id		: 1
+`
+
+	topology, err := parseCPUInfoTopology(output)
+	if err != nil {
+		t.Fatalf("parseCPUInfoTopology() error = %v", err)
+	}
+	expected := &CPUTopology{Sockets: 2, CoresPerSocket: 2, ThreadsPerCore: 1, LogicalCPUs: 4, HyperThreading: false}
+	if !reflect.DeepEqual(topology, expected) {
+		t.Errorf("parseCPUInfoTopology() = %+v, want %+v", topology, expected)
+	}
+
+	if _, err := parseCPUInfoTopology(""); err == nil {
+		t.Error("parseCPUInfoTopology() expected error for empty output")
+	}
+}
+
+// Test validateCPUTopology function
+func TestValidateCPUTopology(t *testing.T) {
+	testConfig := &CPUTopologyCheckTestConfig{ExpectedSockets: 2, ExpectedCoresPerSocket: 48, ExpectedLogicalCPUs: 192}
+
+	tests := []struct {
+		name        string
+		topology    *CPUTopology
+		testConfig  *CPUTopologyCheckTestConfig
+		expectError bool
+	}{
+		{
+			name:       "Matching topology",
+			topology:   &CPUTopology{Sockets: 2, CoresPerSocket: 48, ThreadsPerCore: 2, LogicalCPUs: 192},
+			testConfig: testConfig,
+		},
+		{
+			name:        "Missing socket",
+			topology:    &CPUTopology{Sockets: 1, CoresPerSocket: 48, ThreadsPerCore: 2, LogicalCPUs: 96},
+			testConfig:  testConfig,
+			expectError: true,
+		},
+		{
+			name:        "Hyper-threading disabled",
+			topology:    &CPUTopology{Sockets: 2, CoresPerSocket: 48, ThreadsPerCore: 1, LogicalCPUs: 96},
+			testConfig:  testConfig,
+			expectError: true,
+		},
+		{
+			name:       "No expected counts",
+			topology:   &CPUTopology{Sockets: 1, CoresPerSocket: 4, ThreadsPerCore: 1, LogicalCPUs: 4},
+			testConfig: &CPUTopologyCheckTestConfig{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCPUTopology(tt.topology, tt.testConfig)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateCPUTopology() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// defaultDCGMSampleCount is the number of dcgmi dmon samples taken when test_limits.json does not configure it
+const defaultDCGMSampleCount = 5
+
+// dcgmAllGPUsGroupName is the name of the DCGM group created when no GPU group is configured
+const dcgmAllGPUsGroupName = "oci_dr_hpc_all_gpus"
+
+// Matches the group IDs of dcgmi group -l, e.g. "| Group ID          | 0                |"
+var dcgmGroupIDRegex = regexp.MustCompile(`\|\s*Group ID\s*\|\s*(\d+)\s*\|`)
+
+// Matches the group ID of dcgmi group -c, e.g. `Successfully created group "all_gpus" with a group ID of 2`
+var dcgmCreatedGroupIDRegex = regexp.MustCompile(`group ID of (\d+)`)
+
+// Matches a sample row of dcgmi dmon, e.g. "GPU 0     35     41     1980   123456"
+var dcgmDmonRowRegex = regexp.MustCompile(`^GPU\s+(\d+)\s+(.*)$`)
+
+// dcgmField represents a DCGM field sampled by dcgm_field_check
+type dcgmField struct {
+	ID   int
+	Name string
+}
+
+// dcgmFields are the DCGM fields sampled with dcgmi dmon, in the column order of its output
+var dcgmFields = []dcgmField{
+	{ID: 150, Name: "gpu_temperature"},    // DCGM_FI_DEV_GPU_TEMP, C
+	{ID: 140, Name: "memory_temperature"}, // DCGM_FI_DEV_MEMORY_TEMP, C
+	{ID: 100, Name: "sm_clock"},           // DCGM_FI_DEV_SM_CLOCK, MHz
+	{ID: 200, Name: "pcie_throughput"},    // DCGM_FI_DEV_PCIE_TX_THROUGHPUT, KB/s
+}
+
+// DCGMFieldLimit represents the allowed range of a DCGM field; a nil bound is not checked
+type DCGMFieldLimit struct {
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+// DCGMFieldCheckTestConfig represents the config needed to run this test.
+// FieldLimits maps DCGM field names, e.g. gpu_temperature, to their allowed range.
+type DCGMFieldCheckTestConfig struct {
+	IsEnabled   bool                      `json:"enabled"`
+	Shape       string                    `json:"shape"`
+	SampleCount int                       `json:"sample_count"`
+	FieldLimits map[string]DCGMFieldLimit `json:"fields"`
+}
+
+// DCGMSample represents the field values of a single GPU in one dcgmi dmon sample.
+// Fields reported as N/A are left out of Values.
+type DCGMSample struct {
+	GPU    string
+	Values map[string]float64
+}
+
+// DCGMFieldValue represents the range of values sampled for a DCGM field and its validation status
+type DCGMFieldValue struct {
+	FieldID    int      `json:"field_id"`
+	Min        float64  `json:"min"`
+	Max        float64  `json:"max"`
+	Samples    int      `json:"samples"`
+	FailedGPUs []string `json:"failed_gpus,omitempty"`
+	Status     string   `json:"status"`
+}
+
+// getDCGMFieldCheckTestConfig gets test config needed to run this test
+func getDCGMFieldCheckTestConfig() (*DCGMFieldCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	dcgmFieldCheckTestConfig := &DCGMFieldCheckTestConfig{
+		IsEnabled:   false,
+		Shape:       shape,
+		SampleCount: defaultDCGMSampleCount,
+		FieldLimits: map[string]DCGMFieldLimit{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "dcgm_field_check")
+	if err != nil {
+		return nil, err
+	}
+	dcgmFieldCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "dcgm_field_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if sampleCount, ok := thresholdMap["sample_count"].(float64); ok && sampleCount > 0 {
+				dcgmFieldCheckTestConfig.SampleCount = int(sampleCount)
+			}
+			if fields, ok := thresholdMap["fields"].(map[string]interface{}); ok {
+				for name, value := range fields {
+					bounds, ok := value.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					var fieldLimit DCGMFieldLimit
+					if minValue, ok := bounds["min"].(float64); ok {
+						fieldLimit.Min = &minValue
+					}
+					if maxValue, ok := bounds["max"].(float64); ok {
+						fieldLimit.Max = &maxValue
+					}
+					dcgmFieldCheckTestConfig.FieldLimits[name] = fieldLimit
+				}
+			}
+		}
+	}
+
+	return dcgmFieldCheckTestConfig, nil
+}
+
+// dcgmFieldIDs returns the comma-separated IDs of the sampled DCGM fields for dcgmi dmon -e
+func dcgmFieldIDs() string {
+	ids := make([]string, 0, len(dcgmFields))
+	for _, field := range dcgmFields {
+		ids = append(ids, strconv.Itoa(field.ID))
+	}
+	return strings.Join(ids, ",")
+}
+
+// parseDCGMGroupIDs parses the IDs of the GPU groups listed by dcgmi group -l
+func parseDCGMGroupIDs(output string) []int {
+	var groupIDs []int
+	for _, match := range dcgmGroupIDRegex.FindAllStringSubmatch(output, -1) {
+		if groupID, err := strconv.Atoi(match[1]); err == nil {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	return groupIDs
+}
+
+// parseDCGMCreatedGroupID parses the ID of the group created by dcgmi group -c
+func parseDCGMCreatedGroupID(output string) (int, error) {
+	match := dcgmCreatedGroupIDRegex.FindStringSubmatch(output)
+	if match == nil {
+		return 0, fmt.Errorf("could not parse dcgmi group -c output: %s", strings.TrimSpace(output))
+	}
+	return strconv.Atoi(match[1])
+}
+
+// parseDCGMDmon parses the GPU rows of dcgmi dmon output into samples, mapping the value
+// columns to fields in order
+func parseDCGMDmon(output string, fields []dcgmField) []DCGMSample {
+	var samples []DCGMSample
+	for _, line := range strings.Split(output, "\n") {
+		match := dcgmDmonRowRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+
+		sample := DCGMSample{GPU: match[1], Values: make(map[string]float64)}
+		columns := strings.Fields(match[2])
+		for i, field := range fields {
+			if i >= len(columns) {
+				break
+			}
+			if value, err := strconv.ParseFloat(columns[i], 64); err == nil {
+				sample.Values[field.Name] = value
+			}
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// validateDCGMFields summarizes the sampled values of each field and validates them against
+// the field limits. Fields with limits FAIL when a GPU sampled a value outside the limits or
+// when no values were sampled.
+func validateDCGMFields(samples []DCGMSample, fieldLimits map[string]DCGMFieldLimit) (map[string]DCGMFieldValue, []string, string, error) {
+	fieldValues := make(map[string]DCGMFieldValue)
+	var failedFields []string
+	var failures []string
+
+	for _, field := range dcgmFields {
+		fieldValue := DCGMFieldValue{FieldID: field.ID, Min: math.Inf(1), Max: math.Inf(-1), Status: "PASS"}
+		fieldLimit, hasLimit := fieldLimits[field.Name]
+		failedGPUs := make(map[string]bool)
+
+		for _, sample := range samples {
+			value, ok := sample.Values[field.Name]
+			if !ok {
+				continue
+			}
+			fieldValue.Samples++
+			fieldValue.Min = math.Min(fieldValue.Min, value)
+			fieldValue.Max = math.Max(fieldValue.Max, value)
+			if (fieldLimit.Min != nil && value < *fieldLimit.Min) || (fieldLimit.Max != nil && value > *fieldLimit.Max) {
+				if !failedGPUs[sample.GPU] {
+					failedGPUs[sample.GPU] = true
+					fieldValue.FailedGPUs = append(fieldValue.FailedGPUs, sample.GPU)
+				}
+			}
+		}
+
+		switch {
+		case fieldValue.Samples == 0:
+			fieldValue.Min, fieldValue.Max = 0, 0
+			fieldValue.Status = "N/A"
+			if hasLimit {
+				fieldValue.Status = "FAIL"
+				failures = append(failures, fmt.Sprintf("%s has no samples", field.Name))
+			}
+		case len(fieldValue.FailedGPUs) > 0:
+			fieldValue.Status = "FAIL"
+			sort.Strings(fieldValue.FailedGPUs)
+			failures = append(failures, fmt.Sprintf("%s outside %s on GPU %s (sampled %g-%g)",
+				field.Name, formatDCGMFieldLimit(fieldLimit), strings.Join(fieldValue.FailedGPUs, ", "), fieldValue.Min, fieldValue.Max))
+		}
+		if fieldValue.Status == "FAIL" {
+			failedFields = append(failedFields, field.Name)
+		}
+		fieldValues[field.Name] = fieldValue
+	}
+
+	if len(failures) > 0 {
+		return fieldValues, failedFields, "FAIL", fmt.Errorf("DCGM field values outside thresholds: %s", strings.Join(failures, "; "))
+	}
+	return fieldValues, nil, "PASS", nil
+}
+
+// formatDCGMFieldLimit formats the allowed range of a field, e.g. "[0, 87]" or "[-, 87]"
+func formatDCGMFieldLimit(fieldLimit DCGMFieldLimit) string {
+	minValue, maxValue := "-", "-"
+	if fieldLimit.Min != nil {
+		minValue = strconv.FormatFloat(*fieldLimit.Min, 'g', -1, 64)
+	}
+	if fieldLimit.Max != nil {
+		maxValue = strconv.FormatFloat(*fieldLimit.Max, 'g', -1, 64)
+	}
+	return fmt.Sprintf("[%s, %s]", minValue, maxValue)
+}
+
+// dcgmFieldDetails converts the field values into the field name to value map reported for the test
+func dcgmFieldDetails(fieldValues map[string]DCGMFieldValue) map[string]interface{} {
+	details := make(map[string]interface{}, len(fieldValues))
+	for name, value := range fieldValues {
+		details[name] = value
+	}
+	return details
+}
+
+// getDCGMGroupID returns the ID of DCGM group 0, creating a group of all GPUs when no group is configured
+func getDCGMGroupID() (int, error) {
+	result, err := executor.RunDcgmi("group", "-l")
+	if err != nil {
+		return 0, commandError("dcgm_field_check", result, err)
+	}
+	for _, groupID := range parseDCGMGroupIDs(result.Output) {
+		if groupID == 0 {
+			return 0, nil
+		}
+	}
+
+	logger.Info("DCGM GPU group 0 is not configured, creating a group of all GPUs")
+	result, err = executor.RunDcgmi("group", "-c", dcgmAllGPUsGroupName, "--default")
+	if err != nil {
+		return 0, commandError("dcgm_field_check", result, err)
+	}
+	return parseDCGMCreatedGroupID(result.Output)
+}
+
+// RunDCGMFieldCheck samples GPU temperature, memory temperature, SM clock and PCIe throughput with
+// dcgmi dmon and validates them against the test_limits.json field thresholds.
+// The check is skipped when DCGM is not installed.
+func RunDCGMFieldCheck() error {
+	logger.Info("=== DCGM Field Check ===")
+	testConfig, err := getDCGMFieldCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "dcgm_field_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting DCGM field check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get the DCGM GPU group, creating it when it is not configured
+	logger.Info("Step 1: Getting DCGM GPU group...")
+	groupID, err := getDCGMGroupID()
+	if err != nil {
+		var toolErr *testerrors.TestToolNotFoundError
+		if errors.As(err, &toolErr) {
+			logger.Info("DCGM Field Check: SKIP - DCGM is not installed")
+			rep.AddDCGMFieldResult("SKIP", nil, nil, nil)
+			return nil
+		}
+		logger.Error("DCGM Field Check: FAIL - Could not get DCGM GPU group:", err)
+		rep.AddDCGMFieldResult("FAIL", nil, nil, err)
+		return err
+	}
+	logger.Info("Using DCGM GPU group", groupID)
+
+	// Step 2: Sample the DCGM fields
+	logger.Infof("Step 2: Sampling DCGM fields %s (%d samples)...", dcgmFieldIDs(), testConfig.SampleCount)
+	result, err := executor.RunDcgmi("dmon", "-g", strconv.Itoa(groupID), "-e", dcgmFieldIDs(), "-c", strconv.Itoa(testConfig.SampleCount))
+	if err != nil {
+		err = commandError("dcgm_field_check", result, err)
+		logger.Error("DCGM Field Check: FAIL - Could not sample DCGM fields:", err)
+		rep.AddDCGMFieldResult("FAIL", nil, nil, err)
+		return err
+	}
+	samples := parseDCGMDmon(result.Output, dcgmFields)
+	if len(samples) == 0 {
+		err = fmt.Errorf("no GPU samples in dcgmi dmon output")
+		logger.Error("DCGM Field Check: FAIL -", err)
+		rep.AddDCGMFieldResult("FAIL", nil, nil, err)
+		return err
+	}
+
+	// Step 3: Validate the sampled values against the field thresholds
+	logger.Info("Step 3: Validating DCGM field values...")
+	fieldValues, failedFields, status, validationErr := validateDCGMFields(samples, testConfig.FieldLimits)
+	for _, field := range dcgmFields {
+		value := fieldValues[field.Name]
+		logger.Infof("%s (field %d): %g-%g over %d samples - %s", field.Name, field.ID, value.Min, value.Max, value.Samples, value.Status)
+	}
+	rep.AddDCGMFieldResult(status, dcgmFieldDetails(fieldValues), failedFields, validationErr)
+
+	if status == "PASS" {
+		logger.Info("DCGM Field Check: PASS - All DCGM field values are within thresholds")
+		return nil
+	}
+	logger.Error("DCGM Field Check: FAIL -", validationErr)
+	return validationErr
+}
@@ -0,0 +1,85 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testDCGMDmonOutput = `#Entity   TMPTR  MMTMP  SMCLK  PCITX
+ID
+GPU 0     35     41     1980   123456
+GPU 1     88     43     1980   N/A
+GPU 0     36     42     1965   120000
+GPU 1     89     44     1980   N/A
+`
+
+const testDCGMGroupListOutput = `+-------------------+----------------------------------------------------------+
+| GROUPS                                                                       |
+| 2 groups found.                                                              |
++===================+==========================================================+
+| Group ID          | 0                                                        |
+| Group Name        | DCGM_ALL_SUPPORTED_GPUS                                  |
+| Entities          | GPU 0, GPU 1                                             |
++-------------------+----------------------------------------------------------+
+| Group ID          | 3                                                        |
+| Group Name        | DCGM_ALL_SUPPORTED_NVSWITCHES                            |
+| Entities          | None                                                     |
++-------------------+----------------------------------------------------------+
+`
+
+// Test parseDCGMGroupIDs and parseDCGMCreatedGroupID functions
+func TestParseDCGMGroups(t *testing.T) {
+	if groupIDs := parseDCGMGroupIDs(testDCGMGroupListOutput); !reflect.DeepEqual(groupIDs, []int{0, 3}) {
+		t.Errorf("parseDCGMGroupIDs() = %v, want [0 3]", groupIDs)
+	}
+
+	groupID, err := parseDCGMCreatedGroupID(`Successfully created group "oci_dr_hpc_all_gpus" with a group ID of 2`)
+	if err != nil || groupID != 2 {
+		t.Errorf("parseDCGMCreatedGroupID() = %d, %v, want 2", groupID, err)
+	}
+	if _, err := parseDCGMCreatedGroupID("Error: Unable to create group"); err == nil {
+		t.Error("parseDCGMCreatedGroupID() expected error for failed group creation")
+	}
+}
+
+// Test parseDCGMDmon function
+func TestParseDCGMDmon(t *testing.T) {
+	samples := parseDCGMDmon(testDCGMDmonOutput, dcgmFields)
+	if len(samples) != 4 {
+		t.Fatalf("parseDCGMDmon() returned %d samples, want 4", len(samples))
+	}
+
+	expected := map[string]float64{"gpu_temperature": 88, "memory_temperature": 43, "sm_clock": 1980}
+	if samples[1].GPU != "1" || !reflect.DeepEqual(samples[1].Values, expected) {
+		t.Errorf("parseDCGMDmon() sample 1 = %+v, want GPU 1 with %v", samples[1], expected)
+	}
+}
+
+// Test validateDCGMFields function
+func TestValidateDCGMFields(t *testing.T) {
+	samples := parseDCGMDmon(testDCGMDmonOutput, dcgmFields)
+	maxTemperature := 87.0
+	minClock := 1900.0
+
+	fieldValues, failedFields, status, err := validateDCGMFields(samples, map[string]DCGMFieldLimit{"sm_clock": {Min: &minClock}})
+	if status != "PASS" || err != nil || failedFields != nil {
+		t.Errorf("validateDCGMFields() = %s, %v, %v, want PASS", status, failedFields, err)
+	}
+	if value := fieldValues["pcie_throughput"]; value.Samples != 2 || value.Min != 120000 || value.Max != 123456 || value.Status != "PASS" {
+		t.Errorf("pcie_throughput = %+v, want 2 samples between 120000 and 123456", value)
+	}
+
+	fieldValues, failedFields, status, err = validateDCGMFields(samples, map[string]DCGMFieldLimit{"gpu_temperature": {Max: &maxTemperature}})
+	if status != "FAIL" || err == nil || !reflect.DeepEqual(failedFields, []string{"gpu_temperature"}) {
+		t.Fatalf("validateDCGMFields() = %s, %v, %v, want FAIL on gpu_temperature", status, failedFields, err)
+	}
+	if value := fieldValues["gpu_temperature"]; !reflect.DeepEqual(value.FailedGPUs, []string{"1"}) || value.Max != 89 {
+		t.Errorf("gpu_temperature = %+v, want GPU 1 failed with max 89", value)
+	}
+
+	// Fields with thresholds fail without samples
+	_, failedFields, status, _ = validateDCGMFields(nil, map[string]DCGMFieldLimit{"memory_temperature": {Max: &maxTemperature}})
+	if status != "FAIL" || !reflect.DeepEqual(failedFields, []string{"memory_temperature"}) {
+		t.Errorf("validateDCGMFields() without samples = %s, %v, want FAIL on memory_temperature", status, failedFields)
+	}
+}
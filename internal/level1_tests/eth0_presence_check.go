@@ -1,10 +1,10 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -42,7 +42,7 @@ func checkEth0Present() (bool, error) {
 	// Run ip addr command to get network interface information
 	result, err := executor.RunIPAddr()
 	if err != nil {
-		return false, fmt.Errorf("failed to run ip addr: %w", err)
+		return false, fmt.Errorf("failed to run ip addr: %w", commandError("eth0_presence_check", result, err))
 	}
 
 	// Check if eth0 interface is in the output
@@ -109,7 +109,7 @@ func RunEth0PresenceCheck() error {
 	if !eth0PresenceCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Error(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "eth0_presence_check", Shape: shape}
 	}
 
 	// Step 3: Check if eth0 interface is present
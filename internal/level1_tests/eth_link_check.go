@@ -2,11 +2,11 @@ package level1_tests
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -28,15 +28,16 @@ type EthLinkCheckResult struct {
 	RawPhysicalBER             string `json:"raw_physical_ber"`
 }
 
-// EthLinkCheckTestConfig represents the test configuration for Ethernet link check
+// EthLinkCheckTestConfig represents the test configuration for Ethernet link check.
+// InterfaceType selects the BER thresholds of the VCN interfaces from InterfaceTypeBERThresholds.
 type EthLinkCheckTestConfig struct {
-	IsEnabled                           bool    `json:"enabled"`
-	ExpectedSpeed                       string  `json:"speed"`
-	ExpectedWidth                       string  `json:"width"`
-	EffectivePhysicalErrorsThreshold    int     `json:"effective_physical_errors"`
-	RawPhysicalErrorsPerLaneThreshold   int     `json:"raw_physical_errors_per_lane"`
-	EffectivePhysicalBERThreshold       float64 `json:"effective_physical_ber"`
-	RawPhysicalBERThreshold             float64 `json:"raw_physical_ber"`
+	IsEnabled                         bool                     `json:"enabled"`
+	ExpectedSpeed                     string                   `json:"speed"`
+	ExpectedWidth                     string                   `json:"width"`
+	EffectivePhysicalErrorsThreshold  int                      `json:"effective_physical_errors"`
+	RawPhysicalErrorsPerLaneThreshold int                      `json:"raw_physical_errors_per_lane"`
+	InterfaceType                     string                   `json:"interface_type"`
+	InterfaceTypeBERThresholds        map[string]BERThresholds `json:"interface_type_ber_thresholds"`
 }
 
 // getEthLinkCheckTestConfig gets test config needed to run this test
@@ -99,18 +100,23 @@ func getEthLinkCheckTestConfig(shape string) (*EthLinkCheckTestConfig, error) {
 			logger.Info("Using configured raw physical errors per lane threshold:", int(rawErrors), "for shape", shape)
 		}
 		
-		// Update effective physical BER threshold if specified
-		if effBER, ok := v["effective_physical_ber"].(float64); ok {
-			ethLinkCheckTestConfig.EffectivePhysicalBERThreshold = effBER
-			logger.Info("Using configured effective physical BER threshold:", effBER, "for shape", shape)
-		}
-		
-		// Update raw physical BER threshold if specified
-		if rawBER, ok := v["raw_physical_ber"].(float64); ok {
-			ethLinkCheckTestConfig.RawPhysicalBERThreshold = rawBER
-			logger.Info("Using configured raw physical BER threshold:", rawBER, "for shape", shape)
+		// Determine the interface type from the VCN interfaces of the shape and the link speed
+		var interfaceNames []string
+		if shapeManager, err := shapes.NewShapeManager("internal/shapes/shapes.json"); err == nil {
+			if vcnNics, err := shapeManager.GetVCNNics(shape); err == nil {
+				for _, nic := range vcnNics {
+					interfaceNames = append(interfaceNames, nic.Interface)
+				}
+			}
 		}
-		
+		ethLinkCheckTestConfig.InterfaceType = linkInterfaceType(shapeInterfaceName(interfaceNames), ethLinkCheckTestConfig.ExpectedSpeed)
+
+		// Update BER thresholds per interface type
+		ethLinkCheckTestConfig.InterfaceTypeBERThresholds = parseInterfaceTypeBERThresholds(v, ethLinkCheckTestConfig.InterfaceType)
+		berThresholds := berThresholdsFor(ethLinkCheckTestConfig.InterfaceType, ethLinkCheckTestConfig.InterfaceTypeBERThresholds)
+		logger.Infof("Using BER thresholds of interface type %s: effective physical BER %g, raw physical BER %g for shape %s",
+			ethLinkCheckTestConfig.InterfaceType, berThresholds.EffectivePhysicalBER, berThresholds.RawPhysicalBER, shape)
+
 		logger.Info("Successfully loaded eth_link_check configuration for shape", shape)
 	default:
 		return nil, fmt.Errorf("unexpected threshold format for eth_link_check on shape %s", shape)
@@ -120,9 +126,10 @@ func getEthLinkCheckTestConfig(shape string) (*EthLinkCheckTestConfig, error) {
 }
 
 // parseEthLinkResults parses the output from mlxlink command and validates Ethernet link parameters
+// using the BER thresholds of interfaceType
 func parseEthLinkResults(interfaceName string, mlxlinkOutput string, expectedSpeed string, expectedWidth string,
 	rawPhysicalErrorsPerLaneThreshold int, effectivePhysicalErrorsThreshold int,
-	effectivePhysicalBERThreshold float64, rawPhysicalBERThreshold float64) (*EthLinkCheckResult, error) {
+	interfaceType string, berThresholds map[string]BERThresholds) (*EthLinkCheckResult, error) {
 
 	result := &EthLinkCheckResult{
 		Device: interfaceName,
@@ -297,13 +304,14 @@ func parseEthLinkResults(interfaceName string, mlxlinkOutput string, expectedSpe
 	if statusOpcode == "0" {
 		result.EthLinkStatus = "PASS"
 	}
+	typeBERThresholds := berThresholdsFor(interfaceType, berThresholds)
 	if isFloat(effectivePhysicalBER) {
-		if berFloat, err := strconv.ParseFloat(effectivePhysicalBER, 64); err == nil && berFloat < effectivePhysicalBERThreshold {
+		if berFloat, err := strconv.ParseFloat(effectivePhysicalBER, 64); err == nil && berFloat < typeBERThresholds.EffectivePhysicalBER {
 			result.EffectivePhysicalBER = "PASS"
 		}
 	}
 	if isFloat(rawPhysicalBER) {
-		if berFloat, err := strconv.ParseFloat(rawPhysicalBER, 64); err == nil && berFloat < rawPhysicalBERThreshold {
+		if berFloat, err := strconv.ParseFloat(rawPhysicalBER, 64); err == nil && berFloat < typeBERThresholds.RawPhysicalBER {
 			result.RawPhysicalBER = "PASS"
 		}
 	}
@@ -354,7 +362,7 @@ func RunEthLinkCheck() error {
 	if !ethLinkCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "eth_link_check", Shape: shape}
 	}
 
 	// Step 3: Get device mapping using ibdev2netdev
@@ -371,6 +379,7 @@ func RunEthLinkCheck() error {
 	mstResult, err := executor.RunMstStatus()
 	if err != nil {
 		logger.Error("Ethernet Link Check: FAIL - Could not get MST status:", err)
+		err = commandError("eth_link_check", mstResult, err)
 		rep.AddEthLinkResult("FAIL", []EthLinkCheckResult{}, err)
 		return fmt.Errorf("failed to get MST status: %w", err)
 	}
@@ -479,8 +488,8 @@ func RunEthLinkCheck() error {
 			ethLinkCheckTestConfig.ExpectedWidth,
 			ethLinkCheckTestConfig.RawPhysicalErrorsPerLaneThreshold,
 			ethLinkCheckTestConfig.EffectivePhysicalErrorsThreshold,
-			ethLinkCheckTestConfig.EffectivePhysicalBERThreshold,
-			ethLinkCheckTestConfig.RawPhysicalBERThreshold,
+			ethLinkCheckTestConfig.InterfaceType,
+			ethLinkCheckTestConfig.InterfaceTypeBERThresholds,
 		)
 		if err != nil {
 			logger.Errorf("Failed to parse Ethernet link results for %s: %v", interfaceName, err)
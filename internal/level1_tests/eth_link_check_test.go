@@ -207,8 +207,8 @@ func TestParseEthLinkResults(t *testing.T) {
 				tt.expectedWidth,
 				tt.rawThreshold,
 				tt.effThreshold,
-				tt.effBERThreshold,
-				tt.rawBERThreshold,
+				"RoCE_100GbE",
+				map[string]BERThresholds{"RoCE_100GbE": {EffectivePhysicalBER: tt.effBERThreshold, RawPhysicalBER: tt.rawBERThreshold}},
 			)
 
 			if err != nil {
@@ -288,8 +288,8 @@ func TestParseEthLinkResultsETHANFSMEnable(t *testing.T) {
 		"4x",
 		10000,
 		0,
-		1e-12,
-		1e-5,
+		"RoCE_100GbE",
+		map[string]BERThresholds{"RoCE_100GbE": {EffectivePhysicalBER: 1e-12, RawPhysicalBER: 1e-5}},
 	)
 
 	if err != nil {
@@ -334,8 +334,8 @@ func TestParseEthLinkResultsSpeedContains(t *testing.T) {
 		"4x",
 		10000,
 		0,
-		1e-12,
-		1e-5,
+		"RoCE_100GbE",
+		map[string]BERThresholds{"RoCE_100GbE": {EffectivePhysicalBER: 1e-12, RawPhysicalBER: 1e-5}},
 	)
 
 	if err != nil {
@@ -356,8 +356,10 @@ func TestEthLinkCheckTestConfig(t *testing.T) {
 		ExpectedWidth:                       "4x",
 		EffectivePhysicalErrorsThreshold:    0,
 		RawPhysicalErrorsPerLaneThreshold:   10000,
-		EffectivePhysicalBERThreshold:       1e-12,
-		RawPhysicalBERThreshold:             1e-5,
+		InterfaceType:                       "RoCE_100GbE",
+		InterfaceTypeBERThresholds: map[string]BERThresholds{
+			"RoCE_100GbE": {EffectivePhysicalBER: 1e-12, RawPhysicalBER: 1e-5},
+		},
 	}
 
 	if !config.IsEnabled {
@@ -375,10 +377,11 @@ func TestEthLinkCheckTestConfig(t *testing.T) {
 	if config.RawPhysicalErrorsPerLaneThreshold != 10000 {
 		t.Error("Expected raw physical errors per lane threshold to be 10000")
 	}
-	if config.EffectivePhysicalBERThreshold != 1e-12 {
+	berThresholds := berThresholdsFor(config.InterfaceType, config.InterfaceTypeBERThresholds)
+	if berThresholds.EffectivePhysicalBER != 1e-12 {
 		t.Error("Expected effective physical BER threshold to be 1e-12")
 	}
-	if config.RawPhysicalBERThreshold != 1e-5 {
+	if berThresholds.RawPhysicalBER != 1e-5 {
 		t.Error("Expected raw physical BER threshold to be 1e-5")
 	}
 }
@@ -545,8 +548,8 @@ func BenchmarkParseEthLinkResults(b *testing.B) {
 			"4x",
 			10000,
 			0,
-			1e-12,
-			1e-5,
+			"RoCE_100GbE",
+			map[string]BERThresholds{"RoCE_100GbE": {EffectivePhysicalBER: 1e-12, RawPhysicalBER: 1e-5}},
 		)
 	}
 }
\ No newline at end of file
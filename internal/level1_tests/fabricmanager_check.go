@@ -1,11 +1,11 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -131,7 +131,7 @@ func RunFabricManagerCheck() error {
 	if !fabricManagerTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "fabricmanager_check", Shape: shape}
 	}
 
 	// Step 3: Check nvidia-fabricmanager service
@@ -0,0 +1,300 @@
+package level1_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// Fabric manager log severities used by the pattern table
+const (
+	FabricManagerSeverityError   = "error"
+	FabricManagerSeverityWarning = "warning"
+)
+
+// defaultFabricManagerLogPath is the log file written by nvidia-fabricmanager
+const defaultFabricManagerLogPath = "/var/log/fabricmanager.log"
+
+// defaultFabricManagerLogLines is the number of most recent log lines scanned
+const defaultFabricManagerLogLines = 1000
+
+// defaultMaxRecentFabricManagerMessages is the number of most recent warning and error messages kept in the report
+const defaultMaxRecentFabricManagerMessages = 5
+
+// FabricManagerLogCheckTestConfig represents the config needed to run this test
+type FabricManagerLogCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+	LogPath   string `json:"log_path"`
+	LogLines  int    `json:"log_lines"`
+}
+
+// FabricManagerLogPattern maps fabric manager log lines matching Pattern to a severity.
+// Transient patterns are acceptable while the fabric manager starts up.
+type FabricManagerLogPattern struct {
+	Pattern     string `json:"pattern"`
+	Severity    string `json:"severity"`
+	Transient   bool   `json:"transient"`
+	Description string `json:"description"`
+
+	regex *regexp.Regexp
+}
+
+// FabricManagerLogConfig represents the fabric manager log pattern table in fabricmanager_patterns.json.
+// The startup phase of the fabric manager runs from a line matching StartupPattern to the next
+// line matching StartupCompletePattern.
+type FabricManagerLogConfig struct {
+	MaxRecentMessages      int                       `json:"max_recent_messages"`
+	StartupPattern         string                    `json:"startup_pattern"`
+	StartupCompletePattern string                    `json:"startup_complete_pattern"`
+	Patterns               []FabricManagerLogPattern `json:"patterns"`
+
+	startupRegex         *regexp.Regexp
+	startupCompleteRegex *regexp.Regexp
+}
+
+// FabricManagerLogSummary represents the warning and error messages found in the fabric manager log.
+// Transient warnings logged during startup are only counted in StartupWarningCount.
+type FabricManagerLogSummary struct {
+	WarningCount        int      `json:"warning_count"`
+	ErrorCount          int      `json:"error_count"`
+	StartupWarningCount int      `json:"startup_warning_count"`
+	LastError           string   `json:"last_error,omitempty"`
+	RecentMessages      []string `json:"recent_messages"`
+}
+
+// getFabricManagerLogCheckTestConfig gets test config needed to run this test
+func getFabricManagerLogCheckTestConfig() (*FabricManagerLogCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	fabricManagerLogCheckTestConfig := &FabricManagerLogCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+		LogPath:   defaultFabricManagerLogPath,
+		LogLines:  defaultFabricManagerLogLines,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "fabricmanager_log_check")
+	if err != nil {
+		return nil, err
+	}
+	fabricManagerLogCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "fabricmanager_log_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if logPath, ok := thresholdMap["log_path"].(string); ok && logPath != "" {
+				fabricManagerLogCheckTestConfig.LogPath = logPath
+			}
+			if logLines, ok := thresholdMap["log_lines"].(float64); ok && logLines > 0 {
+				fabricManagerLogCheckTestConfig.LogLines = int(logLines)
+			}
+		}
+	}
+
+	return fabricManagerLogCheckTestConfig, nil
+}
+
+// defaultFabricManagerLogConfig is used when fabricmanager_patterns.json cannot be found.
+// Without a pattern table the log level of each line decides its severity.
+func defaultFabricManagerLogConfig() *FabricManagerLogConfig {
+	config := &FabricManagerLogConfig{
+		MaxRecentMessages: defaultMaxRecentFabricManagerMessages,
+		Patterns: []FabricManagerLogPattern{
+			{Pattern: `\[ERROR\]`, Severity: FabricManagerSeverityError, Description: "Fabric manager error"},
+			{Pattern: `\[WARN(ING)?\]`, Severity: FabricManagerSeverityWarning, Transient: true, Description: "Fabric manager warning"},
+		},
+	}
+	for i := range config.Patterns {
+		config.Patterns[i].regex = regexp.MustCompile("(?i)" + config.Patterns[i].Pattern)
+	}
+	return config
+}
+
+// parseFabricManagerLogConfig parses and validates a fabric manager log pattern table
+func parseFabricManagerLogConfig(data []byte) (*FabricManagerLogConfig, error) {
+	config := &FabricManagerLogConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse fabric manager log pattern config: %w", err)
+	}
+
+	if config.MaxRecentMessages <= 0 {
+		config.MaxRecentMessages = defaultMaxRecentFabricManagerMessages
+	}
+
+	var err error
+	if config.StartupPattern != "" {
+		if config.startupRegex, err = regexp.Compile("(?i)" + config.StartupPattern); err != nil {
+			return nil, fmt.Errorf("invalid fabric manager startup pattern %q: %w", config.StartupPattern, err)
+		}
+	}
+	if config.StartupCompletePattern != "" {
+		if config.startupCompleteRegex, err = regexp.Compile("(?i)" + config.StartupCompletePattern); err != nil {
+			return nil, fmt.Errorf("invalid fabric manager startup complete pattern %q: %w", config.StartupCompletePattern, err)
+		}
+	}
+
+	for i := range config.Patterns {
+		pattern := &config.Patterns[i]
+		pattern.Severity = strings.ToLower(pattern.Severity)
+		if pattern.Severity != FabricManagerSeverityError && pattern.Severity != FabricManagerSeverityWarning {
+			return nil, fmt.Errorf("invalid severity %q for fabric manager log pattern %q", pattern.Severity, pattern.Pattern)
+		}
+		if pattern.regex, err = regexp.Compile("(?i)" + pattern.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid fabric manager log pattern %q: %w", pattern.Pattern, err)
+		}
+	}
+
+	return config, nil
+}
+
+// loadFabricManagerLogConfig loads the fabric manager log pattern table, falling back to the default
+// table when no fabricmanager_patterns.json is installed
+func loadFabricManagerLogConfig() (*FabricManagerLogConfig, error) {
+	data, path, err := config.FindConfigFile("fabricmanager_patterns.json")
+	if err != nil {
+		logger.Debugf("Fabric manager log pattern config not found, classifying lines by log level: %v", err)
+		return defaultFabricManagerLogConfig(), nil
+	}
+
+	logger.Infof("Loading fabric manager log pattern config from: %s", path)
+	return parseFabricManagerLogConfig(data)
+}
+
+// classifyFabricManagerLogLine returns the first pattern matching a fabric manager log line, or nil
+func classifyFabricManagerLogLine(line string, config *FabricManagerLogConfig) *FabricManagerLogPattern {
+	for i := range config.Patterns {
+		if config.Patterns[i].regex != nil && config.Patterns[i].regex.MatchString(line) {
+			return &config.Patterns[i]
+		}
+	}
+	return nil
+}
+
+// scanFabricManagerLog counts the warning and error messages of the fabric manager log and keeps
+// the most recent ones. Transient warnings between a startup line and the end of that startup are
+// counted as startup warnings; every other match is a runtime warning or error.
+func scanFabricManagerLog(output string, config *FabricManagerLogConfig) *FabricManagerLogSummary {
+	summary := &FabricManagerLogSummary{RecentMessages: []string{}}
+
+	inStartup := false
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if config.startupRegex != nil && config.startupRegex.MatchString(line) {
+			inStartup = true
+		}
+		if config.startupCompleteRegex != nil && config.startupCompleteRegex.MatchString(line) {
+			inStartup = false
+			continue
+		}
+
+		pattern := classifyFabricManagerLogLine(line, config)
+		if pattern == nil {
+			continue
+		}
+
+		switch {
+		case pattern.Transient && inStartup:
+			summary.StartupWarningCount++
+			continue
+		case pattern.Severity == FabricManagerSeverityError:
+			summary.ErrorCount++
+			summary.LastError = line
+		default:
+			summary.WarningCount++
+		}
+		summary.RecentMessages = append(summary.RecentMessages, line)
+	}
+
+	if len(summary.RecentMessages) > config.MaxRecentMessages {
+		summary.RecentMessages = summary.RecentMessages[len(summary.RecentMessages)-config.MaxRecentMessages:]
+	}
+	return summary
+}
+
+// RunFabricManagerLogCheck scans the most recent fabric manager log lines for warnings and errors
+func RunFabricManagerLogCheck() error {
+	logger.Info("=== Fabric Manager Log Check ===")
+	testConfig, err := getFabricManagerLogCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "fabricmanager_log_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting fabric manager log check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Load the log pattern table
+	logger.Info("Step 1: Loading fabric manager log patterns...")
+	config, err := loadFabricManagerLogConfig()
+	if err != nil {
+		logger.Error("Fabric Manager Log Check: FAIL - Could not load log patterns:", err)
+		rep.AddFabricManagerLogResult("FAIL", 0, 0, 0, "", nil, err)
+		return fmt.Errorf("could not load fabric manager log patterns: %w", err)
+	}
+
+	// Step 2: Read the most recent log lines
+	logger.Infof("Step 2: Reading the last %d lines of %s...", testConfig.LogLines, testConfig.LogPath)
+	result, err := executor.RunTail(testConfig.LogPath, testConfig.LogLines)
+	if err != nil {
+		err = commandError("fabricmanager_log_check", result, err)
+		logger.Error("Fabric Manager Log Check: FAIL - Could not read fabric manager log:", err)
+		rep.AddFabricManagerLogResult("FAIL", 0, 0, 0, "", nil, err)
+		return fmt.Errorf("could not read fabric manager log: %w", err)
+	}
+
+	// Step 3: Scan for warnings and errors
+	logger.Info("Step 3: Scanning fabric manager log for warnings and errors...")
+	summary := scanFabricManagerLog(result.Output, config)
+	logger.Infof("Found %d error(s), %d runtime warning(s) and %d startup warning(s)",
+		summary.ErrorCount, summary.WarningCount, summary.StartupWarningCount)
+	for _, message := range summary.RecentMessages {
+		logger.Info("Recent fabric manager message:", message)
+	}
+
+	switch {
+	case summary.ErrorCount > 0:
+		err = fmt.Errorf("%d fabric manager error(s) in the last %d log lines, last: %s", summary.ErrorCount, testConfig.LogLines, summary.LastError)
+		logger.Error("Fabric Manager Log Check: FAIL -", err)
+		rep.AddFabricManagerLogResult("FAIL", summary.WarningCount, summary.ErrorCount, summary.StartupWarningCount, summary.LastError, summary.RecentMessages, err)
+		return err
+	case summary.WarningCount > 0:
+		err = fmt.Errorf("%d fabric manager runtime warning(s) in the last %d log lines", summary.WarningCount, testConfig.LogLines)
+		logger.Info("Fabric Manager Log Check: WARN -", err)
+		rep.AddFabricManagerLogResult("WARN", summary.WarningCount, summary.ErrorCount, summary.StartupWarningCount, summary.LastError, summary.RecentMessages, err)
+		return err
+	}
+
+	logger.Info("Fabric Manager Log Check: PASS - No fabric manager runtime warnings or errors")
+	rep.AddFabricManagerLogResult("PASS", summary.WarningCount, summary.ErrorCount, summary.StartupWarningCount, summary.LastError, summary.RecentMessages, nil)
+	return nil
+}
@@ -0,0 +1,89 @@
+package level1_tests
+
+import (
+	"os"
+	"testing"
+)
+
+const testFabricManagerLog = `[Jul 21 2024 09:12:45] [INFO] [tid 1500] Fabric Manager version 535.183.01 is running with the following configuration options
+[Jul 21 2024 09:12:46] [WARNING] [tid 1500] waiting for GPU driver to be ready
+[Jul 21 2024 09:12:47] [WARNING] [tid 1500] NVSwitch device not yet available, retrying
+[Jul 21 2024 09:12:49] [INFO] [tid 1500] Successfully configured all the available GPUs and NVSwitches to route NVLink traffic.
+[Jul 21 2024 11:40:02] [WARNING] [tid 1510] detected partial link activation for GPU physical id 3
+[Jul 21 2024 11:40:05] [WARNING] [tid 1510] waiting for GPU 3 to be ready
+[Jul 21 2024 11:41:17] [ERROR] [tid 1510] detected NVSwitch fatal error 12028 on NVSwitch pci bus id 00000000:86:00.0
+`
+
+// Test parseFabricManagerLogConfig function with the shipped pattern table
+func TestFabricManagerPatternsConfig(t *testing.T) {
+	data, err := os.ReadFile("../../configs/fabricmanager_patterns.json")
+	if err != nil {
+		t.Skipf("configs/fabricmanager_patterns.json not available: %v", err)
+	}
+	if _, err := parseFabricManagerLogConfig(data); err != nil {
+		t.Errorf("configs/fabricmanager_patterns.json is invalid: %v", err)
+	}
+}
+
+// Test parseFabricManagerLogConfig function with invalid pattern tables
+func TestParseFabricManagerLogConfigErrors(t *testing.T) {
+	invalid := []string{
+		`not json`,
+		`{"patterns": [{"pattern": "fatal", "severity": "critical"}]}`,
+		`{"patterns": [{"pattern": "([", "severity": "error"}]}`,
+		`{"startup_pattern": "([", "patterns": []}`,
+	}
+	for _, data := range invalid {
+		if _, err := parseFabricManagerLogConfig([]byte(data)); err == nil {
+			t.Errorf("parseFabricManagerLogConfig(%s) expected error", data)
+		}
+	}
+}
+
+// Test scanFabricManagerLog function separating startup warnings from runtime messages
+func TestScanFabricManagerLog(t *testing.T) {
+	data, err := os.ReadFile("../../configs/fabricmanager_patterns.json")
+	if err != nil {
+		t.Skipf("configs/fabricmanager_patterns.json not available: %v", err)
+	}
+	config, err := parseFabricManagerLogConfig(data)
+	if err != nil {
+		t.Fatalf("parseFabricManagerLogConfig() error = %v", err)
+	}
+
+	summary := scanFabricManagerLog(testFabricManagerLog, config)
+	if summary.StartupWarningCount != 2 {
+		t.Errorf("StartupWarningCount = %d, want 2", summary.StartupWarningCount)
+	}
+	if summary.WarningCount != 2 {
+		t.Errorf("WarningCount = %d, want 2", summary.WarningCount)
+	}
+	if summary.ErrorCount != 1 {
+		t.Errorf("ErrorCount = %d, want 1", summary.ErrorCount)
+	}
+	if summary.LastError != "[Jul 21 2024 11:41:17] [ERROR] [tid 1510] detected NVSwitch fatal error 12028 on NVSwitch pci bus id 00000000:86:00.0" {
+		t.Errorf("LastError = %q", summary.LastError)
+	}
+	if len(summary.RecentMessages) != 3 {
+		t.Errorf("RecentMessages = %v, want 3 runtime messages", summary.RecentMessages)
+	}
+
+	config.MaxRecentMessages = 1
+	if summary := scanFabricManagerLog(testFabricManagerLog, config); len(summary.RecentMessages) != 1 || summary.RecentMessages[0] != summary.LastError {
+		t.Errorf("RecentMessages = %v, want only the last error", summary.RecentMessages)
+	}
+}
+
+// Test scanFabricManagerLog function with the default pattern table
+func TestScanFabricManagerLogDefaultConfig(t *testing.T) {
+	summary := scanFabricManagerLog(testFabricManagerLog, defaultFabricManagerLogConfig())
+
+	// Without startup patterns every warning is a runtime warning
+	if summary.WarningCount != 4 || summary.ErrorCount != 1 || summary.StartupWarningCount != 0 {
+		t.Errorf("scanFabricManagerLog() = %+v, want 4 warnings and 1 error", summary)
+	}
+
+	if summary := scanFabricManagerLog("[Jul 21 2024 09:12:45] [INFO] [tid 1500] Fabric Manager version 535.183.01\n", defaultFabricManagerLogConfig()); summary.WarningCount != 0 || summary.ErrorCount != 0 {
+		t.Errorf("scanFabricManagerLog() = %+v, want no messages", summary)
+	}
+}
@@ -3,27 +3,40 @@ package level1_tests
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 )
 
-// GIDIndexResult represents the result of GID index parsing
+// gidVersionRegex matches the RoCE version column of show_gids output, e.g. "v2"
+var gidVersionRegex = regexp.MustCompile(`^v(\d)$`)
+
+// GIDIndexResult represents the result of GID index parsing.
+// GIDType is "RoCE v1" or "RoCE v2", empty when show_gids does not report the version.
 type GIDIndexResult struct {
 	Device   string `json:"interface"`
 	Port     string `json:"port"`
 	GIDIndex int    `json:"gid_index"`
 	GIDValue string `json:"gid_value"`
+	GIDType  string `json:"gid_type"`
 }
 
-// GIDIndexCheckTestConfig represents the test configuration for GID index check
+// GIDIndexCheckTestConfig represents the test configuration for GID index check.
+// ExpectedGIDCount, ExpectedGIDCountPerPort and ExpectedTypes are zero or empty when the GID table size,
+// the RoCE v2 entries per port and the GID types are not checked.
 type GIDIndexCheckTestConfig struct {
-	IsEnabled          bool  `json:"enabled"`
-	ExpectedGIDIndexes []int `json:"expected_gid_indexes"`
+	IsEnabled               bool     `json:"enabled"`
+	ExpectedGIDIndexes      []int    `json:"expected_gid_indexes"`
+	ExpectedGIDCount        int      `json:"expected_gid_count"`
+	ExpectedGIDCountPerPort int      `json:"expected_gid_count_per_port"`
+	ExpectedTypes           []string `json:"expected_types"`
 }
 
 // getGIDIndexCheckTestConfig gets test config needed to run this test
@@ -53,17 +66,27 @@ func getGIDIndexCheckTestConfig(shape string) (*GIDIndexCheckTestConfig, error)
 
 	// Handle different threshold formats
 	switch v := threshold.(type) {
-	case []interface{}:
-		// Convert []interface{} to []int
-		var indexes []int
-		for _, item := range v {
-			if val, ok := item.(float64); ok {
-				indexes = append(indexes, int(val))
-			} else if val, ok := item.(int); ok {
-				indexes = append(indexes, val)
+	case map[string]interface{}:
+		if items, ok := v["expected_gid_indexes"].([]interface{}); ok {
+			if indexes := parseGIDIndexList(items); len(indexes) > 0 {
+				gidIndexCheckTestConfig.ExpectedGIDIndexes = indexes
+			}
+		}
+		if count, ok := v["expected_gid_count"].(float64); ok {
+			gidIndexCheckTestConfig.ExpectedGIDCount = int(count)
+		}
+		if count, ok := v["expected_gid_count_per_port"].(float64); ok {
+			gidIndexCheckTestConfig.ExpectedGIDCountPerPort = int(count)
+		}
+		if types, ok := v["expected_types"].([]interface{}); ok {
+			for _, gidType := range types {
+				if typeStr, ok := gidType.(string); ok {
+					gidIndexCheckTestConfig.ExpectedTypes = append(gidIndexCheckTestConfig.ExpectedTypes, typeStr)
+				}
 			}
 		}
-		if len(indexes) > 0 {
+	case []interface{}:
+		if indexes := parseGIDIndexList(v); len(indexes) > 0 {
 			gidIndexCheckTestConfig.ExpectedGIDIndexes = indexes
 		}
 	case []int:
@@ -76,6 +99,19 @@ func getGIDIndexCheckTestConfig(shape string) (*GIDIndexCheckTestConfig, error)
 	return gidIndexCheckTestConfig, nil
 }
 
+// parseGIDIndexList converts a JSON array of GID indexes to []int
+func parseGIDIndexList(items []interface{}) []int {
+	var indexes []int
+	for _, item := range items {
+		if val, ok := item.(float64); ok {
+			indexes = append(indexes, int(val))
+		} else if val, ok := item.(int); ok {
+			indexes = append(indexes, val)
+		}
+	}
+	return indexes
+}
+
 // parseGIDIndexResults parses the output from show_gids command
 func parseGIDIndexResults(output string) ([]GIDIndexResult, error) {
 	var results []GIDIndexResult
@@ -110,6 +146,14 @@ func parseGIDIndexResults(output string) ([]GIDIndexResult, error) {
 			GIDValue: fields[3],
 		}
 
+		// The IPv4 column is empty for IPv6 GIDs, so the version column has no fixed position
+		for _, field := range fields[4:] {
+			if match := gidVersionRegex.FindStringSubmatch(field); match != nil {
+				result.GIDType = "RoCE v" + match[1]
+				break
+			}
+		}
+
 		results = append(results, result)
 	}
 
@@ -151,6 +195,97 @@ func checkGIDIndexes(results []GIDIndexResult, expectedIndexes []int) (bool, []i
 	return allValid, invalidIndexes, nil
 }
 
+// checkGIDTypes returns the GID indexes whose RoCE version is not one of the expected types.
+// An empty expectedTypes disables the check and entries without a reported type are skipped.
+func checkGIDTypes(results []GIDIndexResult, expectedTypes []string) []int {
+	var wrongTypeIndexes []int
+	if len(expectedTypes) == 0 {
+		return wrongTypeIndexes
+	}
+
+	for _, result := range results {
+		if result.GIDType == "" || containsString(expectedTypes, result.GIDType) {
+			continue
+		}
+		logger.Errorf("%s port %s GID index %d has type %s, expected one of %v",
+			result.Device, result.Port, result.GIDIndex, result.GIDType, expectedTypes)
+		found := false
+		for _, index := range wrongTypeIndexes {
+			if index == result.GIDIndex {
+				found = true
+				break
+			}
+		}
+		if !found {
+			wrongTypeIndexes = append(wrongTypeIndexes, result.GIDIndex)
+		}
+	}
+
+	return wrongTypeIndexes
+}
+
+// checkGIDCount validates that the GID table has at least the expected number of entries.
+// An expected count of 0 disables the check.
+func checkGIDCount(results []GIDIndexResult, expectedCount int) error {
+	if expectedCount > 0 && len(results) < expectedCount {
+		return fmt.Errorf("GID table has %d entries, expected at least %d", len(results), expectedCount)
+	}
+	return nil
+}
+
+// checkGIDCountPerPort validates that every RDMA port has exactly the expected number of RoCE v2 GID entries,
+// one per routable IP. An expected count of 0 disables the check.
+func checkGIDCountPerPort(results []GIDIndexResult, expectedCount int) error {
+	if expectedCount <= 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	var ports []string
+	for _, result := range results {
+		port := fmt.Sprintf("%s port %s", result.Device, result.Port)
+		if _, ok := counts[port]; !ok {
+			ports = append(ports, port)
+			counts[port] = 0
+		}
+		if result.GIDType == "RoCE v2" {
+			counts[port]++
+		}
+	}
+
+	var mismatches []string
+	for _, port := range ports {
+		if counts[port] != expectedCount {
+			mismatches = append(mismatches, fmt.Sprintf("%s has %d", port, counts[port]))
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("RoCE v2 GID entries per port do not match expected %d: %s", expectedCount, strings.Join(mismatches, ", "))
+	}
+	return nil
+}
+
+// findDuplicateGIDs returns the GID values that appear on more than one RDMA port.
+// RoCE v1 and v2 entries of the same IP share a GID value on one port, so only duplicates across ports count.
+func findDuplicateGIDs(results []GIDIndexResult) []string {
+	portsByGID := make(map[string]map[string]bool)
+	for _, result := range results {
+		if portsByGID[result.GIDValue] == nil {
+			portsByGID[result.GIDValue] = make(map[string]bool)
+		}
+		portsByGID[result.GIDValue][result.Device+"/"+result.Port] = true
+	}
+
+	var duplicates []string
+	for gid, ports := range portsByGID {
+		if len(ports) > 1 {
+			duplicates = append(duplicates, gid)
+		}
+	}
+	sort.Strings(duplicates)
+	return duplicates
+}
+
 // RunGIDIndexCheck performs the GID index check
 func RunGIDIndexCheck() error {
 	logger.Info("=== GID Index Check ===")
@@ -177,13 +312,25 @@ func RunGIDIndexCheck() error {
 	if !gidIndexCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gid_index_check", Shape: shape}
 	}
 
 	// Step 3: Get expected GID indexes from configuration
 	logger.Info("Step 2: Getting expected GID indexes from configuration...")
 	expectedIndexes := gidIndexCheckTestConfig.ExpectedGIDIndexes
 	logger.Info("Expected GID indexes:", expectedIndexes)
+	expectedCount := gidIndexCheckTestConfig.ExpectedGIDCount
+	if expectedCount > 0 {
+		logger.Info("Expected GID entries:", expectedCount)
+	}
+	expectedCountPerPort := gidIndexCheckTestConfig.ExpectedGIDCountPerPort
+	if expectedCountPerPort > 0 {
+		logger.Info("Expected RoCE v2 GID entries per port:", expectedCountPerPort)
+	}
+	expectedTypes := gidIndexCheckTestConfig.ExpectedTypes
+	if len(expectedTypes) > 0 {
+		logger.Info("Expected GID types:", expectedTypes)
+	}
 
 	// Step 4: Execute show_gids command and parse output
 	// https://enterprise-support.nvidia.com/s/article/understanding-show-gids-script#jive_content_id_References
@@ -192,6 +339,7 @@ func RunGIDIndexCheck() error {
 
 	if err != nil {
 		logger.Error("GID Index Check: FAIL - Could not get GID index output:", err)
+		err = commandError("gid_index_check", gidOutput, err)
 		rep.AddGIDIndexResult("FAIL", []int{}, err)
 		return fmt.Errorf("failed to get GID index output: %w", err)
 	}
@@ -211,22 +359,50 @@ func RunGIDIndexCheck() error {
 	allValid, invalidIndexes, err := checkGIDIndexes(gidResults, expectedIndexes)
 	if err != nil {
 		logger.Error("GID Index Check: FAIL - Could not validate GID indexes:", err)
-		rep.AddGIDIndexResult("FAIL", invalidIndexes, err)
+		rep.AddGIDIndexDetailsResult("FAIL", invalidIndexes, nil, nil, expectedCount, len(gidResults), err)
 		return fmt.Errorf("failed to validate GID indexes: %w", err)
 	}
 
-	// Step 7: Report results
-	if allValid {
-		logger.Info("GID Index Check: PASS - All GID indexes are within expected values:", expectedIndexes)
-		rep.AddGIDIndexResult("PASS", []int{}, nil)
-		return nil
-	} else {
+	// Step 7: Validate the GID table size, the GID types and the GID values
+	logger.Info("Step 6: Validating GID entry counts, types and duplicates...")
+	countErr := checkGIDCount(gidResults, expectedCount)
+	portCountErr := checkGIDCountPerPort(gidResults, expectedCountPerPort)
+	wrongTypeIndexes := checkGIDTypes(gidResults, expectedTypes)
+	duplicateGIDs := findDuplicateGIDs(gidResults)
+
+	// Step 8: Report results
+	var failures []string
+	if !allValid {
 		logger.Error("GID Index Check: FAIL - Found invalid GID indexes:", invalidIndexes)
 		logger.Error("Expected GID indexes:", expectedIndexes)
-		err = fmt.Errorf("invalid GID indexes found: %v, expected: %v", invalidIndexes, expectedIndexes)
-		rep.AddGIDIndexResult("FAIL", invalidIndexes, err)
+		failures = append(failures, fmt.Sprintf("invalid GID indexes found: %v, expected: %v", invalidIndexes, expectedIndexes))
+	}
+	if countErr != nil {
+		logger.Error("GID Index Check: FAIL -", countErr)
+		failures = append(failures, countErr.Error())
+	}
+	if portCountErr != nil {
+		logger.Error("GID Index Check: FAIL -", portCountErr)
+		failures = append(failures, portCountErr.Error())
+	}
+	if len(wrongTypeIndexes) > 0 {
+		logger.Error("GID Index Check: FAIL - Found GID indexes with unexpected type:", wrongTypeIndexes)
+		failures = append(failures, fmt.Sprintf("GID indexes with unexpected type found: %v, expected types: %v", wrongTypeIndexes, expectedTypes))
+	}
+	if len(duplicateGIDs) > 0 {
+		// Duplicate GIDs make RoCE routing ambiguous
+		logger.Error("GID Index Check: FAIL - Found GID values on more than one port:", duplicateGIDs)
+		failures = append(failures, fmt.Sprintf("duplicate GID values found across ports: %v", duplicateGIDs))
+	}
+	if len(failures) > 0 {
+		err = errors.New(strings.Join(failures, "; "))
+		rep.AddGIDIndexDetailsResult("FAIL", invalidIndexes, wrongTypeIndexes, duplicateGIDs, expectedCount, len(gidResults), err)
 		return err
 	}
+
+	logger.Info("GID Index Check: PASS - All GID indexes are within expected values:", expectedIndexes)
+	rep.AddGIDIndexDetailsResult("PASS", []int{}, nil, nil, expectedCount, len(gidResults), nil)
+	return nil
 }
 
 // PrintGIDIndexCheck prints a placeholder message for GID index check
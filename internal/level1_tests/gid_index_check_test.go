@@ -120,6 +120,149 @@ func TestCheckGIDIndexes(t *testing.T) {
 	}
 }
 
+// Test checkGIDCount function
+func TestCheckGIDCount(t *testing.T) {
+	results := []GIDIndexResult{
+		{Device: "mlx5_0", Port: "1", GIDIndex: 0, GIDValue: "fe80::1"},
+		{Device: "mlx5_0", Port: "1", GIDIndex: 1, GIDValue: "fe80::2"},
+	}
+
+	tests := []struct {
+		name          string
+		expectedCount int
+		expectError   bool
+	}{
+		{"Count not checked", 0, false},
+		{"Count matches", 2, false},
+		{"Fewer entries than expected", 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkGIDCount(results, tt.expectedCount)
+			if (err != nil) != tt.expectError {
+				t.Errorf("checkGIDCount() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// Test checkGIDCountPerPort function
+func TestCheckGIDCountPerPort(t *testing.T) {
+	results := []GIDIndexResult{
+		{Device: "mlx5_0", Port: "1", GIDIndex: 0, GIDType: "RoCE v1"},
+		{Device: "mlx5_0", Port: "1", GIDIndex: 1, GIDType: "RoCE v2"},
+		{Device: "mlx5_0", Port: "1", GIDIndex: 3, GIDType: "RoCE v2"},
+		{Device: "mlx5_1", Port: "1", GIDIndex: 0, GIDType: "RoCE v1"},
+		{Device: "mlx5_1", Port: "1", GIDIndex: 1, GIDType: "RoCE v2"},
+	}
+
+	tests := []struct {
+		name          string
+		expectedCount int
+		wantErr       string
+	}{
+		{"Count per port not checked", 0, ""},
+		{"One port short", 2, "mlx5_1 port 1 has 1"},
+		{"One port over", 1, "mlx5_0 port 1 has 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkGIDCountPerPort(results, tt.expectedCount)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("checkGIDCountPerPort() unexpected error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("checkGIDCountPerPort() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Test findDuplicateGIDs function
+func TestFindDuplicateGIDs(t *testing.T) {
+	results := []GIDIndexResult{
+		// RoCE v1 and v2 entries of one IP share a GID value on the same port
+		{Device: "mlx5_0", Port: "1", GIDIndex: 2, GIDValue: "::ffff:10.0.0.1"},
+		{Device: "mlx5_0", Port: "1", GIDIndex: 3, GIDValue: "::ffff:10.0.0.1"},
+		{Device: "mlx5_1", Port: "1", GIDIndex: 2, GIDValue: "::ffff:10.0.0.2"},
+		{Device: "mlx5_2", Port: "1", GIDIndex: 2, GIDValue: "::ffff:10.0.0.2"},
+	}
+
+	duplicates := findDuplicateGIDs(results)
+	if !reflect.DeepEqual(duplicates, []string{"::ffff:10.0.0.2"}) {
+		t.Errorf("findDuplicateGIDs() = %v, want [::ffff:10.0.0.2]", duplicates)
+	}
+	if duplicates := findDuplicateGIDs(results[:2]); len(duplicates) != 0 {
+		t.Errorf("findDuplicateGIDs() = %v, want none", duplicates)
+	}
+}
+
+// Test that parseGIDIndexResults reads the RoCE version column
+func TestParseGIDIndexResultsGIDType(t *testing.T) {
+	output := strings.Join([]string{
+		"DEV	PORT	INDEX	GID					IPv4  		VER	DEV",
+		"---	----	-----	---					------------  	---	---",
+		"mlx5_0	1	0	fe80:0000:0000:0000:0202:c9ff:fe00:0000			v1	rdma0",
+		"mlx5_0	1	1	fe80:0000:0000:0000:0202:c9ff:fe00:0000			v2	rdma0",
+		"mlx5_0	1	3	0000:0000:0000:0000:0000:ffff:0a00:0001	10.0.0.1  	v2	rdma0",
+		"n_gids_found=3",
+		"",
+	}, "\n")
+
+	results, err := parseGIDIndexResults(output)
+	if err != nil {
+		t.Fatalf("parseGIDIndexResults() error = %v", err)
+	}
+	expected := []string{"RoCE v1", "RoCE v2", "RoCE v2"}
+	if len(results) != len(expected) {
+		t.Fatalf("parseGIDIndexResults() returned %d results, want %d", len(results), len(expected))
+	}
+	for i, result := range results {
+		if result.GIDType != expected[i] {
+			t.Errorf("parseGIDIndexResults() result %d GIDType = %q, want %q", i, result.GIDType, expected[i])
+		}
+	}
+}
+
+// Test checkGIDTypes function
+func TestCheckGIDTypes(t *testing.T) {
+	results := []GIDIndexResult{
+		{Device: "mlx5_0", Port: "1", GIDIndex: 0, GIDType: "RoCE v1"},
+		{Device: "mlx5_0", Port: "1", GIDIndex: 1, GIDType: "RoCE v2"},
+		{Device: "mlx5_1", Port: "1", GIDIndex: 0, GIDType: "RoCE v1"},
+		{Device: "mlx5_1", Port: "1", GIDIndex: 2},
+	}
+
+	tests := []struct {
+		name          string
+		expectedTypes []string
+		expected      []int
+	}{
+		{"Types not checked", nil, nil},
+		{"RoCE v2 only", []string{"RoCE v2"}, []int{0}},
+		{"RoCE v1 and v2", []string{"RoCE v1", "RoCE v2"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrongTypeIndexes := checkGIDTypes(results, tt.expectedTypes)
+			if !reflect.DeepEqual(wrongTypeIndexes, tt.expected) {
+				t.Errorf("checkGIDTypes() = %v, want %v", wrongTypeIndexes, tt.expected)
+			}
+		})
+	}
+}
+
+// Test parseGIDIndexList function
+func TestParseGIDIndexList(t *testing.T) {
+	indexes := parseGIDIndexList([]interface{}{float64(0), float64(1), "x", 3})
+	if !reflect.DeepEqual(indexes, []int{0, 1, 3}) {
+		t.Errorf("parseGIDIndexList() = %v, want [0 1 3]", indexes)
+	}
+}
+
 // Test GIDIndexResult structure
 func TestGIDIndexResult(t *testing.T) {
 	result := GIDIndexResult{
@@ -1,22 +1,25 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 )
 
-// GPUClkCheckTestConfig represents the config needed to run this test
+// GPUClkCheckTestConfig represents the config needed to run this test.
+// With BoostClockEnabled every GPU must also reach BoostClockSpeed MHz as its maximum SM clock.
 type GPUClkCheckTestConfig struct {
-	IsEnabled        bool   `json:"enabled"`
-	Shape            string `json:"shape"`
-	ExpectedClkSpeed int    `json:"clock_speed"`
+	IsEnabled         bool   `json:"enabled"`
+	Shape             string `json:"shape"`
+	ExpectedClkSpeed  int    `json:"clock_speed"`
+	BoostClockSpeed   int    `json:"boost_clock_speed"`
+	BoostClockEnabled bool   `json:"boost_clock_enabled"`
 }
 
 // getGpuClkCheckTestConfig gets test config needed to run this test
@@ -58,6 +61,12 @@ func getGpuClkCheckTestConfig() (*GPUClkCheckTestConfig, error) {
 			if clockSpeed, ok := v["clock_speed"].(float64); ok {
 				gpuClkCheckTestConfig.ExpectedClkSpeed = int(clockSpeed)
 			}
+			if boostClockSpeed, ok := v["boost_clock_speed"].(float64); ok {
+				gpuClkCheckTestConfig.BoostClockSpeed = int(boostClockSpeed)
+			}
+			if boostClockEnabled, ok := v["boost_clock_enabled"].(bool); ok {
+				gpuClkCheckTestConfig.BoostClockEnabled = boostClockEnabled
+			}
 		}
 	}
 
@@ -67,9 +76,20 @@ func getGpuClkCheckTestConfig() (*GPUClkCheckTestConfig, error) {
 // getGPUClockSpeeds uses nvidia-smi to get current GPU clock speeds
 func getGPUClockSpeeds() ([]string, error) {
 	// Use nvidia-smi to query current graphics clock speeds
-	result := executor.RunNvidiaSMIQuery("clocks.current.graphics")
+	return queryGPUClocks("clocks.current.graphics")
+}
+
+// getGPUMaxSMClocks uses nvidia-smi to get the maximum SM clock speed of each GPU, which is
+// the boost clock when the clocks are not locked below it
+func getGPUMaxSMClocks() ([]string, error) {
+	return queryGPUClocks("clocks.max.sm")
+}
+
+// queryGPUClocks uses nvidia-smi to get one clock speed per GPU for the clock query
+func queryGPUClocks(query string) ([]string, error) {
+	result := executor.RunNvidiaSMIQuery(query)
 	if !result.Available {
-		return nil, fmt.Errorf("nvidia-smi not available: %s", result.Error)
+		return nil, nvidiaSMIError("gpu_clk_check", "nvidia-smi --query-gpu="+query, result)
 	}
 
 	// Check for driver communication issues
@@ -155,6 +175,29 @@ func validateGPUClockSpeeds(clockSpeeds []string, expectedSpeed int) (string, st
 	return "PASS", statusMsg, nil
 }
 
+// validateGPUBoostClocks checks that the maximum SM clock of every GPU reaches boostClockSpeed.
+// It returns whether all GPUs can boost and the indices of the GPUs that cannot.
+func validateGPUBoostClocks(maxClocks []string, boostClockSpeed int) (bool, []string) {
+	if len(maxClocks) == 0 {
+		return false, nil
+	}
+
+	var belowBoost []string
+	for gpuIndex, clockStr := range maxClocks {
+		fields := strings.Fields(clockStr)
+		if len(fields) == 0 {
+			belowBoost = append(belowBoost, strconv.Itoa(gpuIndex))
+			continue
+		}
+		maxClock, err := strconv.Atoi(fields[0])
+		if err != nil || maxClock < boostClockSpeed {
+			belowBoost = append(belowBoost, strconv.Itoa(gpuIndex))
+		}
+	}
+
+	return len(belowBoost) == 0, belowBoost
+}
+
 func RunGPUClkCheck() error {
 	logger.Info("=== GPU Clock Speed Check ===")
 	testConfig, err := getGpuClkCheckTestConfig()
@@ -165,7 +208,7 @@ func RunGPUClkCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_clk_check", Shape: testConfig.Shape}
 	}
 
 	logger.Info("Starting GPU clock speed check...")
@@ -176,7 +219,7 @@ func RunGPUClkCheck() error {
 	clockSpeeds, err := getGPUClockSpeeds()
 	if err != nil {
 		logger.Error("GPU Clock Check: FAIL - Could not get GPU clock speeds:", err)
-		rep.AddGPUClockResult("FAIL", "", err)
+		rep.AddGPUClockResult("FAIL", "", 0, false, err)
 		return fmt.Errorf("could not get GPU clock speeds: %w", err)
 	}
 
@@ -188,14 +231,44 @@ func RunGPUClkCheck() error {
 
 	status, statusMsg, validationErr := validateGPUClockSpeeds(clockSpeeds, testConfig.ExpectedClkSpeed)
 
+	// Step 3: Validate boost clock state
+	boostClockSpeed, boostEnabled := 0, false
+	if testConfig.BoostClockEnabled {
+		logger.Info("Step 3: Validating boost clock state...")
+		logger.Info("Expected boost clock speed (MHz):", testConfig.BoostClockSpeed)
+		boostClockSpeed = testConfig.BoostClockSpeed
+
+		maxClocks, err := getGPUMaxSMClocks()
+		if err != nil {
+			logger.Error("GPU Clock Check: FAIL - Could not get GPU maximum SM clock speeds:", err)
+			rep.AddGPUClockResult("FAIL", "", boostClockSpeed, false, err)
+			return fmt.Errorf("could not get GPU maximum SM clock speeds: %w", err)
+		}
+		logger.Info("Found GPU maximum SM clock speeds:", maxClocks)
+
+		var belowBoost []string
+		boostEnabled, belowBoost = validateGPUBoostClocks(maxClocks, testConfig.BoostClockSpeed)
+		if !boostEnabled && status == "PASS" {
+			status = "FAIL"
+			if len(belowBoost) == 0 {
+				statusMsg = "check GPU"
+				validationErr = fmt.Errorf("no GPU maximum SM clock speeds found")
+			} else {
+				statusMsg = "check GPU boost " + strings.Join(belowBoost, ",")
+				validationErr = fmt.Errorf("GPU maximum SM clock speeds below boost clock speed %d MHz for GPUs: %s",
+					testConfig.BoostClockSpeed, strings.Join(belowBoost, ","))
+			}
+		}
+	}
+
 	switch status {
 	case "PASS":
 		logger.Info("GPU Clock Check: PASS -", statusMsg)
-		rep.AddGPUClockResult("PASS", statusMsg, nil)
+		rep.AddGPUClockResult("PASS", statusMsg, boostClockSpeed, boostEnabled, nil)
 		return nil
 	default: // FAIL
 		logger.Error("GPU Clock Check: FAIL -", statusMsg)
-		rep.AddGPUClockResult("FAIL", statusMsg, validationErr)
+		rep.AddGPUClockResult("FAIL", statusMsg, boostClockSpeed, boostEnabled, validationErr)
 		return validationErr
 	}
 }
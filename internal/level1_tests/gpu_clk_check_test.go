@@ -1,6 +1,7 @@
 package level1_tests
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -168,6 +169,34 @@ func TestGetGpuClkCheckTestConfig(t *testing.T) {
 	}
 }
 
+// Test validateGPUBoostClocks function
+func TestValidateGPUBoostClocks(t *testing.T) {
+	tests := []struct {
+		name               string
+		maxClocks          []string
+		boostClockSpeed    int
+		expectedBoost      bool
+		expectedBelowBoost []string
+	}{
+		{"all GPUs boost", []string{"1980", "1980 MHz", "2010"}, 1980, true, nil},
+		{"locked GPU", []string{"1980", "1590", "1980"}, 1980, false, []string{"1"}},
+		{"invalid clock", []string{"1980", "[N/A]", ""}, 1980, false, []string{"1", "2"}},
+		{"no GPUs", nil, 1980, false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boostEnabled, belowBoost := validateGPUBoostClocks(tt.maxClocks, tt.boostClockSpeed)
+			if boostEnabled != tt.expectedBoost {
+				t.Errorf("validateGPUBoostClocks() boostEnabled = %v, want %v", boostEnabled, tt.expectedBoost)
+			}
+			if strings.Join(belowBoost, ",") != strings.Join(tt.expectedBelowBoost, ",") {
+				t.Errorf("validateGPUBoostClocks() belowBoost = %v, want %v", belowBoost, tt.expectedBelowBoost)
+			}
+		})
+	}
+}
+
 // Test PrintGPUClkCheck function
 func TestPrintGPUClkCheck(t *testing.T) {
 	// This is mainly to ensure the function doesn't panic
@@ -2,11 +2,11 @@ package level1_tests
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -150,7 +150,7 @@ func getActualGPUCount() (int, error) {
 	// Use nvidia-smi to query GPU names and count them
 	result := executor.RunNvidiaSMIQuery("name")
 	if !result.Available {
-		return 0, fmt.Errorf("nvidia-smi not available: %s", result.Error)
+		return 0, nvidiaSMIError("gpu_count_check", "nvidia-smi --query-gpu=name", result)
 	}
 
 	// Count the number of lines in the output (each line is a GPU)
@@ -188,7 +188,7 @@ func RunGPUCountCheck() error {
 	if !gpuCountCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Error(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_count_check", Shape: shape}
 	}
 
 	// Step 3: Look up expected GPU count from shapes.json
@@ -221,7 +221,7 @@ func RunGPUCountCheck() error {
 			logger.Error("GPU Count Check: FAIL - Found", extraCount, "extra GPUs")
 		}
 		logger.Error("GPU Count Check: FAIL - Expected:", expectedCount, "Actual:", actualCount)
-		err = fmt.Errorf("GPU count mismatch: expected %d, actual %d", expectedCount, actualCount)
+		err = &testerrors.TestThresholdExceededError{TestName: "gpu_count_check", Metric: "gpu_count", Actual: actualCount, Expected: expectedCount}
 		rep.AddGPUResult("FAIL", actualCount, err)
 		return err
 	}
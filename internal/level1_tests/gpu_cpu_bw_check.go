@@ -0,0 +1,249 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// gpuCPUBandwidthRegex matches a direction and bandwidth reported by gpu_cpu_bw,
+// e.g. "H2D: 55.21 GB/s", "D2H bandwidth: 54.90 GB/s" or "Bidirectional: 101.37 GB/s"
+var gpuCPUBandwidthRegex = regexp.MustCompile(`(?i)\b(H2D|D2H|Bidirectional|BiDir)\b[^0-9\n]*([0-9]+(?:\.[0-9]+)?)\s*GB/s`)
+
+// gpuCPUTestDuration is how long each GPU is measured
+const gpuCPUTestDuration = "3s"
+
+// Bandwidth below these fractions of the minimum threshold WARNs and FAILs
+const (
+	gpuCPUBandwidthWarnRatio = 0.9
+	gpuCPUBandwidthFailRatio = 0.8
+)
+
+// GPUCPUBWCheckTestConfig represents the config needed to run this test.
+// Both the H2D and the D2H bandwidth of every GPU must reach MinBandwidthGBps.
+type GPUCPUBWCheckTestConfig struct {
+	IsEnabled        bool    `json:"enabled"`
+	Shape            string  `json:"shape"`
+	MinBandwidthGBps float64 `json:"min_bandwidth_gbps"`
+}
+
+// GPUCPUBandwidth represents the measured bandwidth between host memory and a single GPU.
+// BidirectionalGBps is 0 when the benchmark does not report a bidirectional measurement.
+type GPUCPUBandwidth struct {
+	GPU               int     `json:"gpu"`
+	H2DGBps           float64 `json:"h2d_gbps"`
+	D2HGBps           float64 `json:"d2h_gbps"`
+	BidirectionalGBps float64 `json:"bidirectional_gbps,omitempty"`
+	Status            string  `json:"status"`
+}
+
+// getGPUCPUBWCheckTestConfig gets test config needed to run this test
+func getGPUCPUBWCheckTestConfig() (*GPUCPUBWCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuCPUBWCheckTestConfig := &GPUCPUBWCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_cpu_bw_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuCPUBWCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_cpu_bw_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if minBandwidth, ok := thresholdMap["min_bandwidth_gbps"].(float64); ok {
+				gpuCPUBWCheckTestConfig.MinBandwidthGBps = minBandwidth
+			}
+		}
+	}
+
+	return gpuCPUBWCheckTestConfig, nil
+}
+
+// parseGPUIndices parses nvidia-smi "index" CSV output
+func parseGPUIndices(output string) ([]int, error) {
+	var indices []int
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		index, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GPU index %q", line)
+		}
+		indices = append(indices, index)
+	}
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("no GPUs found")
+	}
+	return indices, nil
+}
+
+// parseGPUCPUBandwidth parses the H2D, D2H and bidirectional bandwidth in GB/s from gpu_cpu_bw output.
+// The last reported value of each direction is used when the binary prints intermediate results.
+func parseGPUCPUBandwidth(output string) (float64, float64, float64, error) {
+	var h2d, d2h, bidirectional float64
+	var foundH2D, foundD2H bool
+	for _, match := range gpuCPUBandwidthRegex.FindAllStringSubmatch(output, -1) {
+		bandwidth, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		switch strings.ToUpper(match[1]) {
+		case "H2D":
+			h2d, foundH2D = bandwidth, true
+		case "D2H":
+			d2h, foundD2H = bandwidth, true
+		default:
+			bidirectional = bandwidth
+		}
+	}
+
+	if !foundH2D || !foundD2H {
+		return 0, 0, 0, fmt.Errorf("H2D and D2H bandwidth not found in gpu_cpu_bw output")
+	}
+	return h2d, d2h, bidirectional, nil
+}
+
+// validateGPUCPUBandwidth sets the per-GPU status and returns the overall status.
+// GPUs with an H2D or D2H bandwidth more than 20% below the minimum or without a measurement FAIL,
+// GPUs more than 10% below the minimum WARN.
+func validateGPUCPUBandwidth(gpus []GPUCPUBandwidth, minBandwidth float64) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPUs found")
+	}
+
+	var failedGPUs, warnedGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		lowest := gpu.H2DGBps
+		if gpu.D2HGBps < lowest {
+			lowest = gpu.D2HGBps
+		}
+		name := fmt.Sprintf("GPU%d (H2D %.2f GB/s, D2H %.2f GB/s)", gpu.GPU, gpu.H2DGBps, gpu.D2HGBps)
+		switch {
+		case gpu.Status == "FAIL" || lowest < minBandwidth*gpuCPUBandwidthFailRatio:
+			gpu.Status = "FAIL"
+			failedGPUs = append(failedGPUs, name)
+		case lowest < minBandwidth*gpuCPUBandwidthWarnRatio:
+			gpu.Status = "WARN"
+			warnedGPUs = append(warnedGPUs, name)
+		default:
+			gpu.Status = "PASS"
+		}
+	}
+
+	if len(failedGPUs) > 0 {
+		return "FAIL", fmt.Errorf("GPU-CPU bandwidth more than 20%% below %.0f GB/s on: %s",
+			minBandwidth, strings.Join(failedGPUs, ", "))
+	}
+	if len(warnedGPUs) > 0 {
+		return "WARN", fmt.Errorf("GPU-CPU bandwidth more than 10%% below %.0f GB/s on: %s",
+			minBandwidth, strings.Join(warnedGPUs, ", "))
+	}
+	return "PASS", nil
+}
+
+// RunGPUCPUBWCheck measures the host-to-device and device-to-host bandwidth of every GPU
+func RunGPUCPUBWCheck() error {
+	logger.Info("=== GPU-CPU Bandwidth Check ===")
+	testConfig, err := getGPUCPUBWCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_cpu_bw_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU-CPU bandwidth check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU indices
+	logger.Info("Step 1: Getting GPU indices...")
+	result := executor.RunNvidiaSMIQuery("index")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_cpu_bw_check", "nvidia-smi --query-gpu=index", result)
+		logger.Error("GPU-CPU Bandwidth Check: FAIL - Could not get GPU indices:", err)
+		rep.AddGPUCPUBWResult("FAIL", nil, testConfig.MinBandwidthGBps, err)
+		return fmt.Errorf("could not get GPU indices: %w", err)
+	}
+	indices, err := parseGPUIndices(result.Output)
+	if err != nil {
+		logger.Error("GPU-CPU Bandwidth Check: FAIL - Could not parse GPU indices:", err)
+		rep.AddGPUCPUBWResult("FAIL", nil, testConfig.MinBandwidthGBps, err)
+		return fmt.Errorf("could not parse GPU indices: %w", err)
+	}
+
+	// Step 2: Measure the bandwidth of every GPU
+	logger.Info("Step 2: Measuring GPU-CPU bandwidth...")
+	gpus := make([]GPUCPUBandwidth, 0, len(indices))
+	for _, index := range indices {
+		gpu := GPUCPUBandwidth{GPU: index}
+		bwResult, err := executor.RunGPUCPUBandwidthTest(index, gpuCPUTestDuration)
+		if err != nil {
+			err = commandError("gpu_cpu_bw_check", bwResult, err)
+			var toolErr *testerrors.TestToolNotFoundError
+			if errors.As(err, &toolErr) {
+				logger.Info("GPU-CPU Bandwidth Check: SKIP - GPU-CPU bandwidth test binary not installed:", err)
+				rep.AddGPUCPUBWResult("SKIP", nil, testConfig.MinBandwidthGBps, err)
+				return nil
+			}
+			logger.Errorf("GPU%d: %v", index, err)
+			gpu.Status = "FAIL"
+		} else if gpu.H2DGBps, gpu.D2HGBps, gpu.BidirectionalGBps, err = parseGPUCPUBandwidth(bwResult.Output); err != nil {
+			logger.Errorf("GPU%d: %v", index, err)
+			gpu.Status = "FAIL"
+		}
+		gpus = append(gpus, gpu)
+	}
+
+	// Step 3: Validate against the minimum bandwidth
+	logger.Info("Step 3: Validating GPU-CPU bandwidth...")
+	logger.Infof("Minimum GPU-CPU bandwidth: %.0f GB/s", testConfig.MinBandwidthGBps)
+	status, validationErr := validateGPUCPUBandwidth(gpus, testConfig.MinBandwidthGBps)
+	for _, gpu := range gpus {
+		logger.Infof("GPU%d: H2D %.2f GB/s, D2H %.2f GB/s, bidirectional %.2f GB/s - %s",
+			gpu.GPU, gpu.H2DGBps, gpu.D2HGBps, gpu.BidirectionalGBps, gpu.Status)
+	}
+	rep.AddGPUCPUBWResult(status, gpus, testConfig.MinBandwidthGBps, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("GPU-CPU Bandwidth Check: PASS - All %d GPUs meet the minimum GPU-CPU bandwidth", len(gpus))
+		return nil
+	case "WARN":
+		logger.Info("GPU-CPU Bandwidth Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU-CPU Bandwidth Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,76 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseGPUIndices function with nvidia-smi CSV output
+func TestParseGPUIndices(t *testing.T) {
+	indices, err := parseGPUIndices("0\n1\n2\n3\n")
+	if err != nil {
+		t.Fatalf("parseGPUIndices() error = %v", err)
+	}
+	if !reflect.DeepEqual(indices, []int{0, 1, 2, 3}) {
+		t.Errorf("parseGPUIndices() = %v, want [0 1 2 3]", indices)
+	}
+
+	for _, invalid := range []string{"", "No devices were found"} {
+		if _, err := parseGPUIndices(invalid); err == nil {
+			t.Errorf("parseGPUIndices(%q) expected error", invalid)
+		}
+	}
+}
+
+// Test parseGPUCPUBandwidth function with gpu_cpu_bw output
+func TestParseGPUCPUBandwidth(t *testing.T) {
+	output := "GPU 0 (00000000:0F:00.0), pinned memory, 3s per direction\n" +
+		"H2D: 48.02 GB/s\n" +
+		"H2D: 55.21 GB/s\n" +
+		"D2H bandwidth: 54.90 GB/s\n" +
+		"Bidirectional: 101.37 GB/s\n"
+
+	h2d, d2h, bidirectional, err := parseGPUCPUBandwidth(output)
+	if err != nil {
+		t.Fatalf("parseGPUCPUBandwidth() error = %v", err)
+	}
+	if h2d != 55.21 || d2h != 54.90 || bidirectional != 101.37 {
+		t.Errorf("parseGPUCPUBandwidth() = %v, %v, %v, want 55.21, 54.9, 101.37", h2d, d2h, bidirectional)
+	}
+
+	if _, _, bidirectional, err := parseGPUCPUBandwidth("H2D: 55.21 GB/s\nD2H: 54.90 GB/s\n"); err != nil || bidirectional != 0 {
+		t.Errorf("parseGPUCPUBandwidth() without bidirectional = %v, %v", bidirectional, err)
+	}
+
+	if _, _, _, err := parseGPUCPUBandwidth("H2D: 55.21 GB/s\nCUDA error: out of memory\n"); err == nil {
+		t.Error("parseGPUCPUBandwidth() expected error without D2H bandwidth")
+	}
+}
+
+// Test validateGPUCPUBandwidth function
+func TestValidateGPUCPUBandwidth(t *testing.T) {
+	tests := []struct {
+		name           string
+		gpus           []GPUCPUBandwidth
+		expectedStatus string
+	}{
+		{"all above minimum", []GPUCPUBandwidth{{GPU: 0, H2DGBps: 65, D2HGBps: 66}, {GPU: 1, H2DGBps: 64, D2HGBps: 64}}, "PASS"},
+		{"within 10%", []GPUCPUBandwidth{{GPU: 0, H2DGBps: 58, D2HGBps: 60}}, "PASS"},
+		{"D2H more than 10% below", []GPUCPUBandwidth{{GPU: 0, H2DGBps: 65, D2HGBps: 57}}, "WARN"},
+		{"H2D more than 20% below", []GPUCPUBandwidth{{GPU: 0, H2DGBps: 50, D2HGBps: 65}, {GPU: 1, H2DGBps: 57, D2HGBps: 65}}, "FAIL"},
+		{"measurement failed", []GPUCPUBandwidth{{GPU: 0, H2DGBps: 65, D2HGBps: 65}, {GPU: 1, Status: "FAIL"}}, "FAIL"},
+		{"no GPUs", nil, "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPUCPUBandwidth(tt.gpus, 64)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUCPUBandwidth() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateGPUCPUBandwidth() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// gpuPowerControlPathFormat is the sysfs runtime power management control file of a PCI device.
+// "on" keeps the device powered, "auto" lets the kernel suspend it when idle.
+const gpuPowerControlPathFormat = "/sys/bus/pci/devices/%s/power/control"
+
+// GPUCStateCheckTestConfig represents the config needed to run this test
+type GPUCStateCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// GPUCStateInfo represents the performance state and runtime power management state of a single GPU
+type GPUCStateInfo struct {
+	Index      string `json:"index"`
+	BusID      string `json:"bus_id"`
+	PowerState string `json:"power_state"`
+	RuntimePM  string `json:"runtime_pm"`
+	Status     string `json:"status"`
+}
+
+// getGPUCStateCheckTestConfig gets test config needed to run this test
+func getGPUCStateCheckTestConfig() (*GPUCStateCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuCStateCheckTestConfig := &GPUCStateCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_cstate_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuCStateCheckTestConfig.IsEnabled = enabled
+
+	return gpuCStateCheckTestConfig, nil
+}
+
+// parseGPUPowerStates parses nvidia-smi "index, pci.bus_id, pstate" CSV output
+func parseGPUPowerStates(output string) ([]GPUCStateInfo, error) {
+	var gpus []GPUCStateInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 3 {
+			logger.Errorf("Invalid GPU power state line: %s", line)
+			return nil, fmt.Errorf("invalid GPU power state line: %s", line)
+		}
+
+		gpus = append(gpus, GPUCStateInfo{
+			Index:      strings.TrimSpace(parts[0]),
+			BusID:      sysfsPCIAddress(strings.TrimSpace(parts[1])),
+			PowerState: strings.TrimSpace(parts[2]),
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU power states found")
+	}
+
+	return gpus, nil
+}
+
+// sysfsPCIAddress converts an nvidia-smi bus id such as "00000000:0F:00.0"
+// to the sysfs PCI address "0000:0f:00.0"
+func sysfsPCIAddress(busID string) string {
+	busID = strings.ToLower(busID)
+	if domain, rest, found := strings.Cut(busID, ":"); found && len(domain) > 4 {
+		return domain[len(domain)-4:] + ":" + rest
+	}
+	return busID
+}
+
+// validateGPUCStates sets the per-GPU status and returns the overall status.
+// Runtime power management left enabled by the kernel FAILs, since suspending the GPU adds
+// wake-up latency to every access. A GPU outside P0 only WARNs as it may be changing state.
+func validateGPUCStates(gpus []GPUCStateInfo) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU power states found")
+	}
+
+	var pmEnabledGPUs, lowPowerGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.Status = "PASS"
+
+		if gpu.RuntimePM != "on" {
+			gpu.Status = "FAIL"
+			pmEnabledGPUs = append(pmEnabledGPUs, fmt.Sprintf("%s (%s)", gpu.Index, gpu.RuntimePM))
+			continue
+		}
+		if gpu.PowerState != "P0" {
+			gpu.Status = "WARN"
+			lowPowerGPUs = append(lowPowerGPUs, fmt.Sprintf("%s (%s)", gpu.Index, gpu.PowerState))
+		}
+	}
+
+	if len(pmEnabledGPUs) > 0 {
+		return "FAIL", fmt.Errorf("runtime power management is enabled on GPU(s): %s", strings.Join(pmEnabledGPUs, ", "))
+	}
+	if len(lowPowerGPUs) > 0 {
+		return "WARN", fmt.Errorf("GPU(s) not in power state P0: %s", strings.Join(lowPowerGPUs, ", "))
+	}
+	return "PASS", nil
+}
+
+func RunGPUCStateCheck() error {
+	logger.Info("=== GPU C-State Check ===")
+	testConfig, err := getGPUCStateCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_cstate_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU C-state and power management check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU power states
+	logger.Info("Step 1: Getting GPU power states...")
+	result := executor.RunNvidiaSMIQuery("index,pci.bus_id,pstate")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_cstate_check", "nvidia-smi --query-gpu=index,pci.bus_id,pstate", result)
+		logger.Error("GPU C-State Check: FAIL - Could not get GPU power states:", err)
+		rep.AddGPUCStateResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU power states: %w", err)
+	}
+	gpus, err := parseGPUPowerStates(result.Output)
+	if err != nil {
+		logger.Error("GPU C-State Check: FAIL - Could not parse GPU power states:", err)
+		rep.AddGPUCStateResult("FAIL", nil, err)
+		return fmt.Errorf("could not parse GPU power states: %w", err)
+	}
+
+	// Step 2: Read the runtime power management state of every GPU
+	logger.Info("Step 2: Reading GPU runtime power management state...")
+	for i := range gpus {
+		path := fmt.Sprintf(gpuPowerControlPathFormat, gpus[i].BusID)
+		controlResult, err := executor.RunCat(path)
+		if err != nil {
+			err = commandError("gpu_cstate_check", controlResult, err)
+			logger.Error("GPU C-State Check: FAIL - Could not read runtime power management state:", err)
+			rep.AddGPUCStateResult("FAIL", gpus, err)
+			return fmt.Errorf("could not read runtime power management state of GPU %s: %w", gpus[i].Index, err)
+		}
+		gpus[i].RuntimePM = strings.TrimSpace(controlResult.Output)
+	}
+
+	// Step 3: Validate power states
+	logger.Info("Step 3: Validating GPU power states...")
+	status, validationErr := validateGPUCStates(gpus)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s (%s): power state %s, runtime PM %s - %s", gpu.Index, gpu.BusID, gpu.PowerState, gpu.RuntimePM, gpu.Status)
+	}
+	rep.AddGPUCStateResult(status, gpus, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("GPU C-State Check: PASS - All GPUs are in P0 with runtime power management disabled")
+		return nil
+	case "WARN":
+		logger.Info("GPU C-State Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU C-State Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,72 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPUPowerStates function
+func TestParseGPUPowerStates(t *testing.T) {
+	gpus, err := parseGPUPowerStates("0, 00000000:0F:00.0, P0\n1, 00000000:2D:00.0, P8\n")
+	if err != nil {
+		t.Fatalf("parseGPUPowerStates() error = %v", err)
+	}
+
+	expected := []GPUCStateInfo{
+		{Index: "0", BusID: "0000:0f:00.0", PowerState: "P0"},
+		{Index: "1", BusID: "0000:2d:00.0", PowerState: "P8"},
+	}
+	if len(gpus) != len(expected) {
+		t.Fatalf("parseGPUPowerStates() returned %d GPUs, want %d", len(gpus), len(expected))
+	}
+	for i, gpu := range gpus {
+		if gpu != expected[i] {
+			t.Errorf("parseGPUPowerStates() GPU %d = %+v, want %+v", i, gpu, expected[i])
+		}
+	}
+
+	if _, err := parseGPUPowerStates("0, 00000000:0F:00.0"); err == nil {
+		t.Error("parseGPUPowerStates() expected error for line without power state")
+	}
+}
+
+// Test validateGPUCStates function
+func TestValidateGPUCStates(t *testing.T) {
+	tests := []struct {
+		name           string
+		gpus           []GPUCStateInfo
+		expectedStatus string
+	}{
+		{
+			name:           "All GPUs in P0 with runtime PM disabled",
+			gpus:           []GPUCStateInfo{{Index: "0", PowerState: "P0", RuntimePM: "on"}, {Index: "1", PowerState: "P0", RuntimePM: "on"}},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "GPU not in P0",
+			gpus:           []GPUCStateInfo{{Index: "0", PowerState: "P0", RuntimePM: "on"}, {Index: "1", PowerState: "P8", RuntimePM: "on"}},
+			expectedStatus: "WARN",
+		},
+		{
+			name:           "Runtime PM enabled",
+			gpus:           []GPUCStateInfo{{Index: "0", PowerState: "P8", RuntimePM: "on"}, {Index: "1", PowerState: "P0", RuntimePM: "auto"}},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "No GPUs",
+			gpus:           []GPUCStateInfo{},
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPUCStates(tt.gpus)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUCStates() = %s, want %s", status, tt.expectedStatus)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateGPUCStates() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
@@ -1,22 +1,26 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/utils"
 )
 
-// GPUDriverCheckTestConfig represents the config needed to run this test
+// GPUDriverCheckTestConfig represents the config needed to run this test.
+// An empty MinDriverVersion or MaxDriverVersion leaves that side of the version range unbounded.
 type GPUDriverCheckTestConfig struct {
 	IsEnabled           bool     `json:"enabled"`
 	Shape               string   `json:"shape"`
 	BlacklistedVersions []string `json:"blacklisted_versions"`
 	SupportedVersions   []string `json:"supported_versions"`
+	MinDriverVersion    string   `json:"min_driver_version"`
+	MaxDriverVersion    string   `json:"max_driver_version"`
 }
 
 // getGpuDriverCheckTestConfig gets test config needed to run this test
@@ -79,6 +83,14 @@ func getGpuDriverCheckTestConfig() (*GPUDriverCheckTestConfig, error) {
 					gpuDriverCheckTestConfig.SupportedVersions = supportedVersions
 				}
 			}
+
+			// Update the supported version range if specified
+			if minVersion, ok := v["min_driver_version"].(string); ok {
+				gpuDriverCheckTestConfig.MinDriverVersion = minVersion
+			}
+			if maxVersion, ok := v["max_driver_version"].(string); ok {
+				gpuDriverCheckTestConfig.MaxDriverVersion = maxVersion
+			}
 		}
 	}
 
@@ -90,7 +102,7 @@ func getGPUDriverVersions() ([]string, error) {
 	// Use nvidia-smi to query driver versions
 	result := executor.RunNvidiaSMIQuery("driver_version")
 	if !result.Available {
-		return nil, fmt.Errorf("nvidia-smi not available: %s", result.Error)
+		return nil, nvidiaSMIError("gpu_driver_check", "nvidia-smi --query-gpu=driver_version", result)
 	}
 
 	// Parse the output
@@ -112,8 +124,32 @@ func getGPUDriverVersions() ([]string, error) {
 	return versions, nil
 }
 
-// validateDriverVersions validates driver versions against blacklist and supported list
-func validateDriverVersions(versions []string, blacklisted []string, supported []string) (string, error) {
+// compareDriverVersions compares two NVIDIA driver versions, returning -1, 0 or 1 when a is
+// lower than, equal to or higher than b
+func compareDriverVersions(a, b string) (int, error) {
+	aMajor, aMinor, aPatch, err := utils.ParseNvidiaDriverVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bMajor, bMinor, bPatch, err := utils.ParseNvidiaDriverVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, diff := range []int{aMajor - bMajor, aMinor - bMinor, aPatch - bPatch} {
+		if diff < 0 {
+			return -1, nil
+		}
+		if diff > 0 {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// validateDriverVersions validates driver versions against blacklist, supported list and supported range.
+// Versions below minVersion FAIL, versions above maxVersion are untested and WARN unless listed as supported.
+func validateDriverVersions(versions []string, blacklisted []string, supported []string, minVersion, maxVersion string) (string, error) {
 	if len(versions) == 0 {
 		return "FAIL", fmt.Errorf("no GPU driver versions found")
 	}
@@ -133,6 +169,17 @@ func validateDriverVersions(versions []string, blacklisted []string, supported [
 		}
 	}
 
+	// Check if version is below the minimum supported version
+	if minVersion != "" {
+		comparison, err := compareDriverVersions(currentVersion, minVersion)
+		if err != nil {
+			return "FAIL", fmt.Errorf("could not compare driver version %s with minimum version %s: %w", currentVersion, minVersion, err)
+		}
+		if comparison < 0 {
+			return "FAIL", fmt.Errorf("driver version %s is below the minimum supported version %s", currentVersion, minVersion)
+		}
+	}
+
 	// Check if version is supported
 	for _, supportedVersion := range supported {
 		if currentVersion == supportedVersion {
@@ -140,6 +187,17 @@ func validateDriverVersions(versions []string, blacklisted []string, supported [
 		}
 	}
 
+	// Check if version is newer than the maximum tested version
+	if maxVersion != "" {
+		comparison, err := compareDriverVersions(currentVersion, maxVersion)
+		if err != nil {
+			return "FAIL", fmt.Errorf("could not compare driver version %s with maximum version %s: %w", currentVersion, maxVersion, err)
+		}
+		if comparison > 0 {
+			return "WARN", fmt.Errorf("driver version %s is above the maximum tested version %s", currentVersion, maxVersion)
+		}
+	}
+
 	// Version is not blacklisted but also not in supported list
 	return "WARN", fmt.Errorf("driver version %s is unsupported but not blacklisted", currentVersion)
 }
@@ -154,7 +212,7 @@ func RunGPUDriverCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_driver_check", Shape: testConfig.Shape}
 	}
 
 	logger.Info("Starting GPU driver version check...")
@@ -175,8 +233,11 @@ func RunGPUDriverCheck() error {
 	logger.Info("Step 2: Validating driver versions...")
 	logger.Info("Blacklisted versions:", testConfig.BlacklistedVersions)
 	logger.Info("Supported versions:", testConfig.SupportedVersions)
+	if testConfig.MinDriverVersion != "" || testConfig.MaxDriverVersion != "" {
+		logger.Infof("Supported version range: %s - %s", testConfig.MinDriverVersion, testConfig.MaxDriverVersion)
+	}
 
-	status, validationErr := validateDriverVersions(versions, testConfig.BlacklistedVersions, testConfig.SupportedVersions)
+	status, validationErr := validateDriverVersions(versions, testConfig.BlacklistedVersions, testConfig.SupportedVersions, testConfig.MinDriverVersion, testConfig.MaxDriverVersion)
 
 	var driverVersion string
 	if len(versions) > 0 {
@@ -189,7 +250,7 @@ func RunGPUDriverCheck() error {
 		rep.AddGPUDriverResult("PASS", driverVersion, nil)
 		return nil
 	case "WARN":
-		logger.Info("GPU Driver Check: WARN - Driver version", driverVersion, "is unsupported but not blacklisted")
+		logger.Info("GPU Driver Check: WARN -", validationErr)
 		rep.AddGPUDriverResult("WARN", driverVersion, validationErr)
 		return validationErr
 	default: // FAIL
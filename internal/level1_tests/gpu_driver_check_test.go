@@ -62,7 +62,7 @@ func TestValidateDriverVersions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			status, err := validateDriverVersions(tt.versions, blacklisted, supported)
+			status, err := validateDriverVersions(tt.versions, blacklisted, supported, "", "")
 
 			if status != tt.expectedStatus {
 				t.Errorf("validateDriverVersions() status = %v, want %v", status, tt.expectedStatus)
@@ -75,6 +75,63 @@ func TestValidateDriverVersions(t *testing.T) {
 	}
 }
 
+// Test validateDriverVersions function with a supported version range
+func TestValidateDriverVersionsRange(t *testing.T) {
+	blacklisted := []string{"470.57.02"}
+	supported := []string{"535.104.12", "560.35.03"}
+
+	tests := []struct {
+		name           string
+		version        string
+		minVersion     string
+		maxVersion     string
+		expectedStatus string
+	}{
+		{name: "Supported version within range", version: "535.104.12", minVersion: "535.54.03", maxVersion: "550.90.12", expectedStatus: "PASS"},
+		{name: "Below minimum version", version: "525.147.05", minVersion: "535.54.03", maxVersion: "550.90.12", expectedStatus: "FAIL"},
+		{name: "Below minimum minor version", version: "535.54.02", minVersion: "535.54.03", expectedStatus: "FAIL"},
+		{name: "Above maximum version", version: "570.86.15", minVersion: "535.54.03", maxVersion: "550.90.12", expectedStatus: "WARN"},
+		{name: "Supported version above maximum", version: "560.35.03", maxVersion: "550.90.12", expectedStatus: "PASS"},
+		{name: "Unlisted version within range", version: "545.23.08", minVersion: "535.54.03", maxVersion: "550.90.12", expectedStatus: "WARN"},
+		{name: "Blacklisted version within range", version: "470.57.02", minVersion: "450.80.02", expectedStatus: "FAIL"},
+		{name: "Unparseable driver version", version: "unknown", minVersion: "535.54.03", expectedStatus: "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateDriverVersions([]string{tt.version}, blacklisted, supported, tt.minVersion, tt.maxVersion)
+			if status != tt.expectedStatus {
+				t.Errorf("validateDriverVersions() status = %v, want %v (error %v)", status, tt.expectedStatus, err)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateDriverVersions() error = %v for status %v", err, status)
+			}
+		})
+	}
+}
+
+// Test compareDriverVersions function
+func TestCompareDriverVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"535.104.12", "535.104.12", 0},
+		{"535.104.12", "550.90.12", -1},
+		{"535.104.12", "535.54.03", 1},
+		{"535.54", "535.54.0", 0},
+	}
+	for _, tt := range tests {
+		got, err := compareDriverVersions(tt.a, tt.b)
+		if err != nil || got != tt.want {
+			t.Errorf("compareDriverVersions(%q, %q) = %d, %v, want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+	if _, err := compareDriverVersions("535.104.12", "latest"); err == nil {
+		t.Error("compareDriverVersions() expected error for an invalid version")
+	}
+}
+
 // Test getGpuDriverCheckTestConfig function (basic validation)
 func TestGetGpuDriverCheckTestConfig(t *testing.T) {
 	// This test will only work if we're in a test environment
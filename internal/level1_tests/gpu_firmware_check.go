@@ -0,0 +1,203 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUFirmwareCheckTestConfig represents the config needed to run this test
+type GPUFirmwareCheckTestConfig struct {
+	IsEnabled             bool   `json:"enabled"`
+	Shape                 string `json:"shape"`
+	MinGSPFirmwareVersion string `json:"min_gsp_firmware_version"`
+}
+
+// GPUFirmwareInfo represents the GSP firmware version and validation status of a single GPU
+type GPUFirmwareInfo struct {
+	Index              string `json:"index"`
+	GSPFirmwareVersion string `json:"gsp_firmware_version"`
+	Status             string `json:"status"`
+}
+
+// getGPUFirmwareCheckTestConfig gets test config needed to run this test
+func getGPUFirmwareCheckTestConfig() (*GPUFirmwareCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuFirmwareCheckTestConfig := &GPUFirmwareCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_firmware_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuFirmwareCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_firmware_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if version, ok := thresholdMap["min_gsp_firmware_version"].(string); ok {
+				gpuFirmwareCheckTestConfig.MinGSPFirmwareVersion = version
+			}
+		}
+	}
+
+	return gpuFirmwareCheckTestConfig, nil
+}
+
+// getGPUFirmwareInfo uses nvidia-smi to get the GSP firmware version of every GPU
+func getGPUFirmwareInfo() ([]GPUFirmwareInfo, error) {
+	result := executor.RunNvidiaSMIQuery("index,gsp.firmware.version")
+	if !result.Available {
+		return nil, nvidiaSMIError("gpu_firmware_check", "nvidia-smi --query-gpu=index,gsp.firmware.version", result)
+	}
+
+	output := strings.TrimSpace(result.Output)
+	if output == "" {
+		return nil, fmt.Errorf("no GSP firmware information returned from nvidia-smi")
+	}
+
+	return parseGPUFirmwareInfo(output)
+}
+
+// parseGPUFirmwareInfo parses nvidia-smi "index, gsp.firmware.version" CSV output
+func parseGPUFirmwareInfo(output string) ([]GPUFirmwareInfo, error) {
+	var gpus []GPUFirmwareInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 2 {
+			logger.Errorf("Invalid GPU firmware info line: %s", line)
+			return nil, fmt.Errorf("invalid GPU firmware info line: %s", line)
+		}
+
+		gpus = append(gpus, GPUFirmwareInfo{
+			Index:              strings.TrimSpace(parts[0]),
+			GSPFirmwareVersion: strings.TrimSpace(parts[1]),
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU GSP firmware versions found")
+	}
+
+	return gpus, nil
+}
+
+// isValidGSPFirmwareVersion reports whether nvidia-smi returned a usable GSP firmware version.
+// GPUs without GSP firmware report "N/A".
+func isValidGSPFirmwareVersion(version string) bool {
+	return version != "" && version != "N/A" && !strings.HasPrefix(version, "[")
+}
+
+// validateGPUFirmwareVersions sets the per-GPU status and returns the overall status.
+// Unreadable versions, versions below the minimum and versions that differ between GPUs FAIL,
+// since a mix of firmware versions on one node indicates a partially applied update.
+func validateGPUFirmwareVersions(gpus []GPUFirmwareInfo, minVersion string) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU GSP firmware versions found")
+	}
+
+	var invalidGPUs, outdatedGPUs []string
+	versions := []string{}
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.Status = "PASS"
+
+		if !isValidGSPFirmwareVersion(gpu.GSPFirmwareVersion) {
+			gpu.Status = "FAIL"
+			invalidGPUs = append(invalidGPUs, gpu.Index)
+			continue
+		}
+		if !containsString(versions, gpu.GSPFirmwareVersion) {
+			versions = append(versions, gpu.GSPFirmwareVersion)
+		}
+
+		if minVersion != "" && compareFirmwareVersions(gpu.GSPFirmwareVersion, minVersion) < 0 {
+			gpu.Status = "FAIL"
+			outdatedGPUs = append(outdatedGPUs, gpu.Index)
+		}
+	}
+
+	if len(invalidGPUs) > 0 {
+		return "FAIL", fmt.Errorf("GSP firmware version could not be read on GPU(s): %s", strings.Join(invalidGPUs, ","))
+	}
+	if len(versions) > 1 {
+		return "FAIL", fmt.Errorf("inconsistent GSP firmware versions across GPUs: %s", strings.Join(versions, ","))
+	}
+	if len(outdatedGPUs) > 0 {
+		return "FAIL", fmt.Errorf("GSP firmware version %s is older than the minimum supported version %s on GPU(s): %s",
+			versions[0], minVersion, strings.Join(outdatedGPUs, ","))
+	}
+	return "PASS", nil
+}
+
+func RunGPUFirmwareCheck() error {
+	logger.Info("=== GPU Firmware Check ===")
+	testConfig, err := getGPUFirmwareCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_firmware_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU GSP firmware version check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU GSP firmware versions
+	logger.Info("Step 1: Getting GPU GSP firmware versions...")
+	gpus, err := getGPUFirmwareInfo()
+	if err != nil {
+		logger.Error("GPU Firmware Check: FAIL - Could not get GPU GSP firmware versions:", err)
+		rep.AddGPUFirmwareResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU GSP firmware versions: %w", err)
+	}
+
+	// Step 2: Validate GSP firmware versions
+	logger.Info("Step 2: Validating GSP firmware versions...")
+	logger.Info("Minimum GSP firmware version:", testConfig.MinGSPFirmwareVersion)
+
+	status, validationErr := validateGPUFirmwareVersions(gpus, testConfig.MinGSPFirmwareVersion)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s: GSP firmware %s - %s", gpu.Index, gpu.GSPFirmwareVersion, gpu.Status)
+	}
+	rep.AddGPUFirmwareResult(status, gpus, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("GPU Firmware Check: PASS - All GPUs run the same supported GSP firmware version")
+		return nil
+	default: // FAIL
+		logger.Error("GPU Firmware Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
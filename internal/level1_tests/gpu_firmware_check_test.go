@@ -0,0 +1,59 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPUFirmwareInfo function
+func TestParseGPUFirmwareInfo(t *testing.T) {
+	output := "0, 550.54.15\n1, 550.54.15\n"
+	gpus, err := parseGPUFirmwareInfo(output)
+	if err != nil {
+		t.Fatalf("parseGPUFirmwareInfo() error = %v", err)
+	}
+	if len(gpus) != 2 {
+		t.Fatalf("parseGPUFirmwareInfo() returned %d GPUs, want 2", len(gpus))
+	}
+	if gpus[1].Index != "1" || gpus[1].GSPFirmwareVersion != "550.54.15" {
+		t.Errorf("parseGPUFirmwareInfo() GPU 1 = %+v", gpus[1])
+	}
+
+	if _, err := parseGPUFirmwareInfo("0"); err == nil {
+		t.Error("parseGPUFirmwareInfo() expected error for line without firmware version")
+	}
+}
+
+// Test validateGPUFirmwareVersions function
+func TestValidateGPUFirmwareVersions(t *testing.T) {
+	tests := []struct {
+		name           string
+		versions       []string
+		expectedStatus string
+		expectedGPU0   string
+	}{
+		{"Same supported version", []string{"550.54.15", "550.54.15"}, "PASS", "PASS"},
+		{"All GPUs below minimum", []string{"525.85.12", "525.85.12"}, "FAIL", "FAIL"},
+		{"Inconsistent versions", []string{"550.54.15", "535.104.05"}, "FAIL", "PASS"},
+		{"GSP firmware not reported", []string{"N/A", "550.54.15"}, "FAIL", "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gpus []GPUFirmwareInfo
+			for i, version := range tt.versions {
+				gpus = append(gpus, GPUFirmwareInfo{Index: string(rune('0' + i)), GSPFirmwareVersion: version})
+			}
+
+			status, err := validateGPUFirmwareVersions(gpus, "535.104.05")
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUFirmwareVersions() = %s, want %s", status, tt.expectedStatus)
+			}
+			if gpus[0].Status != tt.expectedGPU0 {
+				t.Errorf("validateGPUFirmwareVersions() GPU 0 status = %s, want %s", gpus[0].Status, tt.expectedGPU0)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateGPUFirmwareVersions() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
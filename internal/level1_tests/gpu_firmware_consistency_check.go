@@ -0,0 +1,122 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUFirmwareConsistencyCheckTestConfig represents the config needed to run this test
+type GPUFirmwareConsistencyCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// getGPUFirmwareConsistencyCheckTestConfig gets test config needed to run this test
+func getGPUFirmwareConsistencyCheckTestConfig() (*GPUFirmwareConsistencyCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	gpuFirmwareConsistencyCheckTestConfig := &GPUFirmwareConsistencyCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_firmware_consistency_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuFirmwareConsistencyCheckTestConfig.IsEnabled = enabled
+
+	return gpuFirmwareConsistencyCheckTestConfig, nil
+}
+
+// groupGPUsByVBIOSVersion maps every VBIOS version to the indexes of the GPUs running it
+func groupGPUsByVBIOSVersion(gpus []GPUVBIOSInfo) map[string][]string {
+	firmwareVersions := make(map[string][]string)
+	for _, gpu := range gpus {
+		firmwareVersions[gpu.VBIOSVersion] = append(firmwareVersions[gpu.VBIOSVersion], gpu.Index)
+	}
+	return firmwareVersions
+}
+
+// validateGPUFirmwareConsistency returns an error naming the GPUs of every version when the GPUs run
+// more than one VBIOS version
+func validateGPUFirmwareConsistency(firmwareVersions map[string][]string) error {
+	if len(firmwareVersions) <= 1 {
+		return nil
+	}
+
+	versions := make([]string, 0, len(firmwareVersions))
+	for version := range firmwareVersions {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	var groups []string
+	for _, version := range versions {
+		groups = append(groups, fmt.Sprintf("%s on GPU(s) %s", version, strings.Join(firmwareVersions[version], ",")))
+	}
+	return fmt.Errorf("found %d different VBIOS versions: %s", len(versions), strings.Join(groups, "; "))
+}
+
+// RunGPUFirmwareConsistencyCheck validates that all GPUs of the node run the same VBIOS version.
+// Unlike gpu_vbios_check it does not compare against approved versions but looks for divergence
+// within the node, which is left behind by partially applied firmware updates.
+func RunGPUFirmwareConsistencyCheck() error {
+	logger.Info("=== GPU Firmware Consistency Check ===")
+	testConfig, err := getGPUFirmwareConsistencyCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_firmware_consistency_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU firmware consistency check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU VBIOS versions
+	logger.Info("Step 1: Getting GPU VBIOS versions...")
+	gpus, err := getGPUVBIOSInfo("gpu_firmware_consistency_check")
+	if err != nil {
+		logger.Error("GPU Firmware Consistency Check: FAIL - Could not get GPU VBIOS versions:", err)
+		rep.AddGPUFirmwareConsistencyResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU VBIOS versions: %w", err)
+	}
+
+	// Step 2: Compare the VBIOS versions of all GPUs
+	logger.Info("Step 2: Comparing VBIOS versions of", len(gpus), "GPUs...")
+	firmwareVersions := groupGPUsByVBIOSVersion(gpus)
+	for version, indexes := range firmwareVersions {
+		logger.Infof("VBIOS %s: GPU(s) %s", version, strings.Join(indexes, ","))
+	}
+
+	if err := validateGPUFirmwareConsistency(firmwareVersions); err != nil {
+		logger.Error("GPU Firmware Consistency Check: FAIL -", err)
+		rep.AddGPUFirmwareConsistencyResult("FAIL", firmwareVersions, err)
+		return err
+	}
+
+	logger.Info("GPU Firmware Consistency Check: PASS - All GPUs run the same VBIOS version")
+	rep.AddGPUFirmwareConsistencyResult("PASS", firmwareVersions, nil)
+	return nil
+}
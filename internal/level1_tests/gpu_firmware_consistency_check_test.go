@@ -0,0 +1,48 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test groupGPUsByVBIOSVersion and validateGPUFirmwareConsistency functions
+func TestValidateGPUFirmwareConsistency(t *testing.T) {
+	tests := []struct {
+		name                     string
+		gpus                     []GPUVBIOSInfo
+		expectedFirmwareVersions map[string][]string
+		expectError              bool
+	}{
+		{
+			name: "Same VBIOS version",
+			gpus: []GPUVBIOSInfo{
+				{Index: "0", VBIOSVersion: "96.00.89.00.01"},
+				{Index: "1", VBIOSVersion: "96.00.89.00.01"},
+			},
+			expectedFirmwareVersions: map[string][]string{"96.00.89.00.01": {"0", "1"}},
+		},
+		{
+			name: "Mixed VBIOS versions",
+			gpus: []GPUVBIOSInfo{
+				{Index: "0", VBIOSVersion: "96.00.89.00.01"},
+				{Index: "1", VBIOSVersion: "96.00.74.00.01"},
+				{Index: "2", VBIOSVersion: "96.00.89.00.01"},
+			},
+			expectedFirmwareVersions: map[string][]string{"96.00.89.00.01": {"0", "2"}, "96.00.74.00.01": {"1"}},
+			expectError:              true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			firmwareVersions := groupGPUsByVBIOSVersion(tt.gpus)
+			if !reflect.DeepEqual(firmwareVersions, tt.expectedFirmwareVersions) {
+				t.Errorf("groupGPUsByVBIOSVersion() = %v, want %v", firmwareVersions, tt.expectedFirmwareVersions)
+			}
+			err := validateGPUFirmwareConsistency(firmwareVersions)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateGPUFirmwareConsistency() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package level1_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUFirmwareUpdateCheckTestConfig represents the config needed to run this test
+type GPUFirmwareUpdateCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// LatestGPUFirmware represents the latest recommended firmware of a GPU model on a driver branch
+type LatestGPUFirmware struct {
+	GSPFirmwareVersion string `json:"gsp_firmware_version"`
+	VBIOSVersion       string `json:"vbios_version"`
+}
+
+// GPUFirmwareVersions represents the latest recommended firmware in gpu_firmware_versions.json,
+// keyed by GPU model and then by driver version or driver branch such as "550"
+type GPUFirmwareVersions struct {
+	Models map[string]map[string]LatestGPUFirmware `json:"models"`
+}
+
+// GPUFirmwareUpdateInfo represents the driver and firmware versions of a single GPU
+type GPUFirmwareUpdateInfo struct {
+	Index              string `json:"index"`
+	Model              string `json:"model"`
+	DriverVersion      string `json:"driver_version"`
+	VBIOSVersion       string `json:"vbios_version"`
+	GSPFirmwareVersion string `json:"gsp_firmware_version"`
+}
+
+// getGPUFirmwareUpdateCheckTestConfig gets test config needed to run this test
+func getGPUFirmwareUpdateCheckTestConfig() (*GPUFirmwareUpdateCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_firmware_update_check")
+	if err != nil {
+		return nil, err
+	}
+
+	return &GPUFirmwareUpdateCheckTestConfig{
+		IsEnabled: enabled,
+		Shape:     shape,
+	}, nil
+}
+
+// parseGPUFirmwareVersions parses the latest recommended GPU firmware table
+func parseGPUFirmwareVersions(data []byte) (*GPUFirmwareVersions, error) {
+	var versions GPUFirmwareVersions
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("failed to parse GPU firmware versions config: %w", err)
+	}
+	return &versions, nil
+}
+
+// loadGPUFirmwareVersions loads the latest recommended GPU firmware table. An empty table is
+// returned when no gpu_firmware_versions.json is installed, so no update is recommended.
+func loadGPUFirmwareVersions() (*GPUFirmwareVersions, error) {
+	data, path, err := config.FindConfigFile("gpu_firmware_versions.json")
+	if err != nil {
+		logger.Debugf("GPU firmware versions config not found, no firmware update will be recommended: %v", err)
+		return &GPUFirmwareVersions{}, nil
+	}
+
+	logger.Infof("Loading GPU firmware versions from: %s", path)
+	return parseGPUFirmwareVersions(data)
+}
+
+// latestFor returns the latest recommended firmware of a GPU model on a driver version.
+// An exact driver version entry takes precedence over the driver branch entry.
+func (v *GPUFirmwareVersions) latestFor(model, driverVersion string) (LatestGPUFirmware, bool) {
+	drivers, ok := v.Models[model]
+	if !ok {
+		return LatestGPUFirmware{}, false
+	}
+	if latest, ok := drivers[driverVersion]; ok {
+		return latest, true
+	}
+	branch := strings.SplitN(driverVersion, ".", 2)[0]
+	latest, ok := drivers[branch]
+	return latest, ok
+}
+
+// getGPUFirmwareUpdateInfo uses nvidia-smi to get the driver and firmware versions of every GPU
+func getGPUFirmwareUpdateInfo() ([]GPUFirmwareUpdateInfo, error) {
+	query := "index,name,driver_version,vbios_version,gsp.firmware.version"
+	result := executor.RunNvidiaSMIQuery(query)
+	if !result.Available {
+		return nil, nvidiaSMIError("gpu_firmware_update_check", "nvidia-smi --query-gpu="+query, result)
+	}
+	return parseGPUFirmwareUpdateInfo(result.Output)
+}
+
+// parseGPUFirmwareUpdateInfo parses nvidia-smi
+// "index, name, driver_version, vbios_version, gsp.firmware.version" CSV output
+func parseGPUFirmwareUpdateInfo(output string) ([]GPUFirmwareUpdateInfo, error) {
+	var gpus []GPUFirmwareUpdateInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 5 {
+			logger.Errorf("Invalid GPU firmware info line: %s", line)
+			return nil, fmt.Errorf("invalid GPU firmware info line: %s", line)
+		}
+
+		gpus = append(gpus, GPUFirmwareUpdateInfo{
+			Index:              strings.TrimSpace(parts[0]),
+			Model:              strings.TrimSpace(parts[1]),
+			DriverVersion:      strings.TrimSpace(parts[2]),
+			VBIOSVersion:       strings.TrimSpace(parts[3]),
+			GSPFirmwareVersion: strings.TrimSpace(parts[4]),
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU firmware versions found")
+	}
+
+	return gpus, nil
+}
+
+// formatGPUFirmwareVersions formats a GSP firmware and a VBIOS version for the report
+func formatGPUFirmwareVersions(gspVersion, vbiosVersion string) string {
+	var versions []string
+	if isValidGSPFirmwareVersion(gspVersion) {
+		versions = append(versions, "gsp "+gspVersion)
+	}
+	if vbiosVersion != "" && vbiosVersion != "N/A" {
+		versions = append(versions, "vbios "+vbiosVersion)
+	}
+	return strings.Join(versions, ", ")
+}
+
+// isOlderFirmware reports whether a readable current version is older than the latest version
+func isOlderFirmware(current, latest string) bool {
+	return latest != "" && isValidGSPFirmwareVersion(current) && compareFirmwareVersions(current, latest) < 0
+}
+
+// evaluateGPUFirmwareUpdates returns the overall status, the current and latest firmware versions
+// and whether an update is recommended. A newer version only WARNs: the firmware works, but
+// known bugs may be fixed in the latest version. GPUs without a known latest version PASS.
+func evaluateGPUFirmwareUpdates(gpus []GPUFirmwareUpdateInfo, versions *GPUFirmwareVersions) (string, string, string, bool, error) {
+	if len(gpus) == 0 {
+		return "FAIL", "", "", false, fmt.Errorf("no GPU firmware versions found")
+	}
+
+	current := formatGPUFirmwareVersions(gpus[0].GSPFirmwareVersion, gpus[0].VBIOSVersion)
+	latest := ""
+	var outdatedGPUs []string
+	for _, gpu := range gpus {
+		latestFirmware, ok := versions.latestFor(gpu.Model, gpu.DriverVersion)
+		if !ok {
+			continue
+		}
+		if latest == "" {
+			latest = formatGPUFirmwareVersions(latestFirmware.GSPFirmwareVersion, latestFirmware.VBIOSVersion)
+		}
+
+		if isOlderFirmware(gpu.GSPFirmwareVersion, latestFirmware.GSPFirmwareVersion) ||
+			isOlderFirmware(gpu.VBIOSVersion, latestFirmware.VBIOSVersion) {
+			if len(outdatedGPUs) == 0 {
+				current = formatGPUFirmwareVersions(gpu.GSPFirmwareVersion, gpu.VBIOSVersion)
+				latest = formatGPUFirmwareVersions(latestFirmware.GSPFirmwareVersion, latestFirmware.VBIOSVersion)
+			}
+			outdatedGPUs = append(outdatedGPUs, gpu.Index)
+		}
+	}
+
+	if len(outdatedGPUs) > 0 {
+		return "WARN", current, latest, true, fmt.Errorf("newer GPU firmware available on GPU(s) %s: current %s, latest %s",
+			strings.Join(outdatedGPUs, ","), current, latest)
+	}
+	return "PASS", current, latest, false, nil
+}
+
+func RunGPUFirmwareUpdateCheck() error {
+	logger.Info("=== GPU Firmware Update Check ===")
+	testConfig, err := getGPUFirmwareUpdateCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_firmware_update_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU firmware update check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU firmware versions
+	logger.Info("Step 1: Getting GPU GSP firmware and VBIOS versions...")
+	gpus, err := getGPUFirmwareUpdateInfo()
+	if err != nil {
+		logger.Error("GPU Firmware Update Check: FAIL - Could not get GPU firmware versions:", err)
+		rep.AddGPUFirmwareUpdateResult("FAIL", "", "", false, err)
+		return fmt.Errorf("could not get GPU firmware versions: %w", err)
+	}
+
+	// Step 2: Load the latest recommended firmware versions
+	logger.Info("Step 2: Loading latest recommended GPU firmware versions...")
+	versions, err := loadGPUFirmwareVersions()
+	if err != nil {
+		logger.Error("GPU Firmware Update Check: FAIL - Could not load GPU firmware versions:", err)
+		rep.AddGPUFirmwareUpdateResult("FAIL", "", "", false, err)
+		return err
+	}
+
+	// Step 3: Compare against the latest recommended firmware versions
+	logger.Info("Step 3: Comparing GPU firmware against the latest recommended versions...")
+	status, current, latest, updateRecommended, validationErr := evaluateGPUFirmwareUpdates(gpus, versions)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s (%s, driver %s): GSP firmware %s, VBIOS %s", gpu.Index, gpu.Model, gpu.DriverVersion,
+			gpu.GSPFirmwareVersion, gpu.VBIOSVersion)
+	}
+	if latest == "" {
+		logger.Infof("No recommended firmware version known for %s with driver %s", gpus[0].Model, gpus[0].DriverVersion)
+	}
+	rep.AddGPUFirmwareUpdateResult(status, current, latest, updateRecommended, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("GPU Firmware Update Check: PASS - GPU firmware is up to date")
+		return nil
+	case "WARN":
+		logger.Info("GPU Firmware Update Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU Firmware Update Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,84 @@
+package level1_tests
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// Test parseGPUFirmwareUpdateInfo function
+func TestParseGPUFirmwareUpdateInfo(t *testing.T) {
+	gpus, err := parseGPUFirmwareUpdateInfo("0, NVIDIA H100 80GB HBM3, 550.90.07, 96.00.89.00.01, 550.90.07\n")
+	if err != nil {
+		t.Fatalf("parseGPUFirmwareUpdateInfo() error = %v", err)
+	}
+	if len(gpus) != 1 || gpus[0].Model != "NVIDIA H100 80GB HBM3" || gpus[0].DriverVersion != "550.90.07" ||
+		gpus[0].VBIOSVersion != "96.00.89.00.01" || gpus[0].GSPFirmwareVersion != "550.90.07" {
+		t.Errorf("parseGPUFirmwareUpdateInfo() = %+v", gpus)
+	}
+
+	for _, output := range []string{"", "0, NVIDIA H100 80GB HBM3, 550.90.07"} {
+		if _, err := parseGPUFirmwareUpdateInfo(output); err == nil {
+			t.Errorf("parseGPUFirmwareUpdateInfo(%q) expected an error", output)
+		}
+	}
+}
+
+// Test evaluateGPUFirmwareUpdates function
+func TestEvaluateGPUFirmwareUpdates(t *testing.T) {
+	versions, err := parseGPUFirmwareVersions([]byte(`{"models": {"NVIDIA H100 80GB HBM3": {
+		"550": {"gsp_firmware_version": "550.127.05", "vbios_version": "96.00.99.00.01"},
+		"550.54.15": {"gsp_firmware_version": "550.54.15", "vbios_version": "96.00.89.00.01"}}}}`))
+	if err != nil {
+		t.Fatalf("parseGPUFirmwareVersions() error = %v", err)
+	}
+
+	upToDate := GPUFirmwareUpdateInfo{Index: "0", Model: "NVIDIA H100 80GB HBM3", DriverVersion: "550.127.05",
+		VBIOSVersion: "96.00.99.00.01", GSPFirmwareVersion: "550.127.05"}
+	status, current, latest, update, err := evaluateGPUFirmwareUpdates([]GPUFirmwareUpdateInfo{upToDate}, versions)
+	if status != "PASS" || update || err != nil || current != latest {
+		t.Errorf("evaluateGPUFirmwareUpdates() = %s, %s, %s, %v, %v, want PASS", status, current, latest, update, err)
+	}
+
+	// An exact driver version entry takes precedence over the driver branch
+	pinned := GPUFirmwareUpdateInfo{Index: "0", Model: "NVIDIA H100 80GB HBM3", DriverVersion: "550.54.15",
+		VBIOSVersion: "96.00.89.00.01", GSPFirmwareVersion: "550.54.15"}
+	if status, _, _, _, _ := evaluateGPUFirmwareUpdates([]GPUFirmwareUpdateInfo{pinned}, versions); status != "PASS" {
+		t.Errorf("evaluateGPUFirmwareUpdates() = %s, want PASS for the pinned driver version", status)
+	}
+
+	outdated := GPUFirmwareUpdateInfo{Index: "1", Model: "NVIDIA H100 80GB HBM3", DriverVersion: "550.90.07",
+		VBIOSVersion: "96.00.89.00.01", GSPFirmwareVersion: "550.90.07"}
+	status, current, latest, update, err = evaluateGPUFirmwareUpdates([]GPUFirmwareUpdateInfo{upToDate, outdated}, versions)
+	if status != "WARN" || !update || err == nil || !strings.Contains(err.Error(), "GPU(s) 1") {
+		t.Errorf("evaluateGPUFirmwareUpdates() = %s, %v, %v, want WARN for GPU 1", status, update, err)
+	}
+	if current != "gsp 550.90.07, vbios 96.00.89.00.01" || latest != "gsp 550.127.05, vbios 96.00.99.00.01" {
+		t.Errorf("Unexpected current %q and latest %q versions", current, latest)
+	}
+
+	unknown := GPUFirmwareUpdateInfo{Index: "0", Model: "NVIDIA B200", DriverVersion: "570.86.15", GSPFirmwareVersion: "570.86.15"}
+	status, _, latest, update, err = evaluateGPUFirmwareUpdates([]GPUFirmwareUpdateInfo{unknown}, versions)
+	if status != "PASS" || latest != "" || update || err != nil {
+		t.Errorf("evaluateGPUFirmwareUpdates() = %s, %q, %v, %v, want PASS without a known latest version", status, latest, update, err)
+	}
+
+	if status, _, _, _, err := evaluateGPUFirmwareUpdates(nil, versions); status != "FAIL" || err == nil {
+		t.Errorf("evaluateGPUFirmwareUpdates(nil) = %s, %v, want FAIL", status, err)
+	}
+}
+
+// Test the installed gpu_firmware_versions.json parses
+func TestGPUFirmwareVersionsConfig(t *testing.T) {
+	data, err := os.ReadFile("../../configs/gpu_firmware_versions.json")
+	if err != nil {
+		t.Skipf("configs/gpu_firmware_versions.json not available: %v", err)
+	}
+	versions, err := parseGPUFirmwareVersions(data)
+	if err != nil {
+		t.Fatalf("parseGPUFirmwareVersions() error = %v", err)
+	}
+	if _, ok := versions.latestFor("NVIDIA H100 80GB HBM3", "550.90.07"); !ok {
+		t.Error("Expected a latest firmware entry for H100 on the 550 driver branch")
+	}
+}
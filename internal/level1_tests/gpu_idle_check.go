@@ -0,0 +1,197 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// defaultMaxGPUUtilizationPercent is the GPU or memory utilization above which a GPU is considered in use
+const defaultMaxGPUUtilizationPercent = 5
+
+// GPUIdleCheckTestConfig represents the config needed to run this test
+type GPUIdleCheckTestConfig struct {
+	IsEnabled             bool    `json:"enabled"`
+	Shape                 string  `json:"shape"`
+	MaxUtilizationPercent float64 `json:"max_utilization_percent"`
+}
+
+// GPUUtilization represents the GPU and memory utilization of a single GPU
+type GPUUtilization struct {
+	Index                    string  `json:"index"`
+	GPUUtilizationPercent    float64 `json:"gpu_utilization_percent"`
+	MemoryUtilizationPercent float64 `json:"memory_utilization_percent"`
+	Status                   string  `json:"status"`
+}
+
+// getGPUIdleCheckTestConfig gets test config needed to run this test
+func getGPUIdleCheckTestConfig() (*GPUIdleCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuIdleCheckTestConfig := &GPUIdleCheckTestConfig{
+		IsEnabled:             false,
+		Shape:                 shape,
+		MaxUtilizationPercent: defaultMaxGPUUtilizationPercent,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_idle_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuIdleCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_idle_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if maxUtilization, ok := thresholdMap["max_utilization_percent"].(float64); ok {
+				gpuIdleCheckTestConfig.MaxUtilizationPercent = maxUtilization
+			}
+		}
+	}
+
+	return gpuIdleCheckTestConfig, nil
+}
+
+// parseUtilizationPercent parses an nvidia-smi utilization value such as "87" or "87 %"
+func parseUtilizationPercent(value string) (float64, error) {
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "%"))
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseGPUUtilizations parses nvidia-smi "index, utilization.gpu, utilization.memory" CSV output
+func parseGPUUtilizations(output string) ([]GPUUtilization, error) {
+	var gpus []GPUUtilization
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 3 {
+			logger.Errorf("Invalid GPU utilization line: %s", line)
+			return nil, fmt.Errorf("invalid GPU utilization line: %s", line)
+		}
+
+		gpuUtilization, err := parseUtilizationPercent(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid GPU utilization %q for GPU %s", strings.TrimSpace(parts[1]), strings.TrimSpace(parts[0]))
+		}
+		memoryUtilization, err := parseUtilizationPercent(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory utilization %q for GPU %s", strings.TrimSpace(parts[2]), strings.TrimSpace(parts[0]))
+		}
+
+		gpus = append(gpus, GPUUtilization{
+			Index:                    strings.TrimSpace(parts[0]),
+			GPUUtilizationPercent:    gpuUtilization,
+			MemoryUtilizationPercent: memoryUtilization,
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU utilization found")
+	}
+
+	return gpus, nil
+}
+
+// validateGPUUtilizations sets the per-GPU status and returns the overall status.
+// A GPU above the utilization limit only WARNs: the GPU is healthy but busy, and checks
+// such as clocks, ECC and thermals may give misleading results while it is in use.
+func validateGPUUtilizations(gpus []GPUUtilization, testConfig *GPUIdleCheckTestConfig) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU utilization found")
+	}
+
+	var busyGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.Status = "PASS"
+		if gpu.GPUUtilizationPercent > testConfig.MaxUtilizationPercent || gpu.MemoryUtilizationPercent > testConfig.MaxUtilizationPercent {
+			gpu.Status = "WARN"
+			busyGPUs = append(busyGPUs, fmt.Sprintf("%s (gpu %g%%, memory %g%%)", gpu.Index, gpu.GPUUtilizationPercent, gpu.MemoryUtilizationPercent))
+		}
+	}
+
+	if len(busyGPUs) > 0 {
+		return "WARN", fmt.Errorf("GPU(s) in use above %g%% utilization: %s", testConfig.MaxUtilizationPercent, strings.Join(busyGPUs, ", "))
+	}
+	return "PASS", nil
+}
+
+// RunGPUIdleCheck checks no GPU workload is running. It is run as a pre-check before the
+// other tests, see the --force flag of the level1 command.
+func RunGPUIdleCheck() error {
+	logger.Info("=== GPU Idle Check ===")
+	testConfig, err := getGPUIdleCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_idle_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU idle check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU utilization
+	logger.Info("Step 1: Getting GPU utilization...")
+	result := executor.RunNvidiaSMIQuery("index,utilization.gpu,utilization.memory")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_idle_check", "nvidia-smi --query-gpu=index,utilization.gpu,utilization.memory", result)
+		logger.Error("GPU Idle Check: FAIL - Could not get GPU utilization:", err)
+		rep.AddGPUIdleResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU utilization: %w", err)
+	}
+	gpus, err := parseGPUUtilizations(result.Output)
+	if err != nil {
+		logger.Error("GPU Idle Check: FAIL - Could not parse GPU utilization:", err)
+		rep.AddGPUIdleResult("FAIL", nil, err)
+		return fmt.Errorf("could not parse GPU utilization: %w", err)
+	}
+
+	// Step 2: Validate GPU utilization
+	logger.Info("Step 2: Validating GPU utilization...")
+	logger.Infof("Maximum utilization: %g%%", testConfig.MaxUtilizationPercent)
+	status, validationErr := validateGPUUtilizations(gpus, testConfig)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s: gpu %g%%, memory %g%% - %s", gpu.Index, gpu.GPUUtilizationPercent, gpu.MemoryUtilizationPercent, gpu.Status)
+	}
+	rep.AddGPUIdleResult(status, gpus, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("GPU Idle Check: PASS - All %d GPUs are idle", len(gpus))
+		return nil
+	case "WARN":
+		logger.Info("GPU Idle Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU Idle Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
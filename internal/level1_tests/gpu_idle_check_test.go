@@ -0,0 +1,53 @@
+package level1_tests
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test parseGPUUtilizations function
+func TestParseGPUUtilizations(t *testing.T) {
+	gpus, err := parseGPUUtilizations("0, 0, 0\n1, 87, 45 %\n")
+	if err != nil {
+		t.Fatalf("parseGPUUtilizations() error = %v", err)
+	}
+	if len(gpus) != 2 {
+		t.Fatalf("Expected 2 GPUs, got %d", len(gpus))
+	}
+	if gpus[1].Index != "1" || gpus[1].GPUUtilizationPercent != 87 || gpus[1].MemoryUtilizationPercent != 45 {
+		t.Errorf("gpus[1] = %+v, want index 1 at 87%% gpu and 45%% memory", gpus[1])
+	}
+
+	for _, output := range []string{"", "0, 12", "0, [N/A], 0"} {
+		if _, err := parseGPUUtilizations(output); err == nil {
+			t.Errorf("parseGPUUtilizations(%q) expected an error", output)
+		}
+	}
+}
+
+// Test validateGPUUtilizations function
+func TestValidateGPUUtilizations(t *testing.T) {
+	testConfig := &GPUIdleCheckTestConfig{MaxUtilizationPercent: 5}
+
+	gpus := []GPUUtilization{{Index: "0"}, {Index: "1", GPUUtilizationPercent: 5, MemoryUtilizationPercent: 2}}
+	status, err := validateGPUUtilizations(gpus, testConfig)
+	if status != "PASS" || err != nil {
+		t.Errorf("validateGPUUtilizations() = %s, %v, want PASS", status, err)
+	}
+	if gpus[1].Status != "PASS" {
+		t.Errorf("GPU 1 status = %s, want PASS at the utilization limit", gpus[1].Status)
+	}
+
+	gpus = []GPUUtilization{{Index: "0", GPUUtilizationPercent: 87, MemoryUtilizationPercent: 45}, {Index: "1", MemoryUtilizationPercent: 30}, {Index: "2"}}
+	status, err = validateGPUUtilizations(gpus, testConfig)
+	if status != "WARN" || err == nil || !strings.Contains(err.Error(), "above 5% utilization: 0 (gpu 87%, memory 45%), 1 (gpu 0%, memory 30%)") {
+		t.Errorf("validateGPUUtilizations() = %s, %v, want WARN for GPUs 0 and 1", status, err)
+	}
+	if gpus[2].Status != "PASS" {
+		t.Errorf("GPU 2 status = %s, want PASS", gpus[2].Status)
+	}
+
+	if status, err := validateGPUUtilizations(nil, testConfig); status != "FAIL" || err == nil {
+		t.Errorf("validateGPUUtilizations(nil) = %s, %v, want FAIL", status, err)
+	}
+}
@@ -0,0 +1,182 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUInfoROMCheckTestConfig represents the config needed to run this test
+type GPUInfoROMCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// GPUInfoROMInfo represents the InfoROM versions and validation status of a single GPU
+type GPUInfoROMInfo struct {
+	Index        string `json:"index"`
+	ImageVersion string `json:"image_version"`
+	OEMVersion   string `json:"oem_version"`
+	ECCVersion   string `json:"ecc_version"`
+	PowerVersion string `json:"power_version"`
+	Status       string `json:"status"`
+}
+
+// getGPUInfoROMCheckTestConfig gets test config needed to run this test
+func getGPUInfoROMCheckTestConfig() (*GPUInfoROMCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	gpuInfoROMCheckTestConfig := &GPUInfoROMCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_inforom_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuInfoROMCheckTestConfig.IsEnabled = enabled
+
+	return gpuInfoROMCheckTestConfig, nil
+}
+
+// getGPUInfoROMInfo uses nvidia-smi to get the InfoROM versions of every GPU
+func getGPUInfoROMInfo() ([]GPUInfoROMInfo, error) {
+	result := executor.RunNvidiaSMIQuery("index,inforom.img,inforom.oem,inforom.ecc,inforom.pwr")
+	if !result.Available {
+		return nil, nvidiaSMIError("gpu_inforom_check", "nvidia-smi --query-gpu=index,inforom.img,inforom.oem,inforom.ecc,inforom.pwr", result)
+	}
+
+	output := strings.TrimSpace(result.Output)
+	if output == "" {
+		return nil, fmt.Errorf("no InfoROM information returned from nvidia-smi")
+	}
+
+	return parseGPUInfoROMInfo(output)
+}
+
+// parseGPUInfoROMInfo parses nvidia-smi "index, inforom.img, inforom.oem, inforom.ecc, inforom.pwr" CSV output
+func parseGPUInfoROMInfo(output string) ([]GPUInfoROMInfo, error) {
+	var gpus []GPUInfoROMInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 5 {
+			logger.Errorf("Invalid GPU InfoROM info line: %s", line)
+			return nil, fmt.Errorf("invalid GPU InfoROM info line: %s", line)
+		}
+
+		gpus = append(gpus, GPUInfoROMInfo{
+			Index:        strings.TrimSpace(parts[0]),
+			ImageVersion: strings.TrimSpace(parts[1]),
+			OEMVersion:   strings.TrimSpace(parts[2]),
+			ECCVersion:   strings.TrimSpace(parts[3]),
+			PowerVersion: strings.TrimSpace(parts[4]),
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU InfoROM versions found")
+	}
+
+	return gpus, nil
+}
+
+// isValidInfoROMVersion reports whether an InfoROM version was read successfully.
+// A corrupted InfoROM is reported by nvidia-smi as an empty value, "None" or an error marker.
+func isValidInfoROMVersion(version string) bool {
+	version = strings.TrimSpace(version)
+	return version != "" && !strings.EqualFold(version, "None") && !strings.HasPrefix(version, "[")
+}
+
+// validateGPUInfoROMs sets the per-GPU status and returns the overall status.
+// Any GPU with a missing or unreadable InfoROM version FAILs.
+func validateGPUInfoROMs(gpus []GPUInfoROMInfo) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU InfoROM versions found")
+	}
+
+	var corruptedGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.Status = "PASS"
+
+		for _, version := range []string{gpu.ImageVersion, gpu.OEMVersion, gpu.ECCVersion, gpu.PowerVersion} {
+			if !isValidInfoROMVersion(version) {
+				gpu.Status = "FAIL"
+				corruptedGPUs = append(corruptedGPUs, gpu.Index)
+				break
+			}
+		}
+	}
+
+	if len(corruptedGPUs) > 0 {
+		return "FAIL", fmt.Errorf("corrupted or missing InfoROM found on GPU(s): %s", strings.Join(corruptedGPUs, ","))
+	}
+	return "PASS", nil
+}
+
+func RunGPUInfoROMCheck() error {
+	logger.Info("=== GPU InfoROM Check ===")
+	testConfig, err := getGPUInfoROMCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_inforom_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU InfoROM check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU InfoROM versions
+	logger.Info("Step 1: Getting GPU InfoROM versions...")
+	gpus, err := getGPUInfoROMInfo()
+	if err != nil {
+		logger.Error("GPU InfoROM Check: FAIL - Could not get GPU InfoROM versions:", err)
+		rep.AddGPUInfoROMResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU InfoROM versions: %w", err)
+	}
+
+	// Step 2: Validate InfoROM versions
+	logger.Info("Step 2: Validating InfoROM versions...")
+	status, validationErr := validateGPUInfoROMs(gpus)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s: InfoROM image %s, OEM %s, ECC %s, power %s - %s",
+			gpu.Index, gpu.ImageVersion, gpu.OEMVersion, gpu.ECCVersion, gpu.PowerVersion, gpu.Status)
+	}
+
+	if status == "PASS" {
+		logger.Info("GPU InfoROM Check: PASS - All GPU InfoROMs are readable")
+		rep.AddGPUInfoROMResult("PASS", gpus, nil)
+		return nil
+	}
+
+	logger.Error("GPU InfoROM Check: FAIL -", validationErr)
+	rep.AddGPUInfoROMResult("FAIL", gpus, validationErr)
+	return validationErr
+}
@@ -0,0 +1,75 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPUInfoROMInfo function
+func TestParseGPUInfoROMInfo(t *testing.T) {
+	output := `0, G001.0000.03.03, 2.1, 7.0, N/A
+1, G001.0000.03.03, 2.1, 7.0, N/A`
+
+	gpus, err := parseGPUInfoROMInfo(output)
+	if err != nil {
+		t.Fatalf("parseGPUInfoROMInfo() error = %v", err)
+	}
+	if len(gpus) != 2 {
+		t.Fatalf("parseGPUInfoROMInfo() returned %d GPUs, want 2", len(gpus))
+	}
+	if gpus[1].Index != "1" || gpus[1].ImageVersion != "G001.0000.03.03" || gpus[1].ECCVersion != "7.0" {
+		t.Errorf("parseGPUInfoROMInfo() GPU 1 = %+v", gpus[1])
+	}
+
+	if _, err := parseGPUInfoROMInfo("0, G001.0000.03.03"); err == nil {
+		t.Error("parseGPUInfoROMInfo() expected error for short line")
+	}
+}
+
+// Test validateGPUInfoROMs function
+func TestValidateGPUInfoROMs(t *testing.T) {
+	tests := []struct {
+		name           string
+		gpus           []GPUInfoROMInfo
+		expectedStatus string
+	}{
+		{
+			name: "All InfoROMs readable",
+			gpus: []GPUInfoROMInfo{
+				{Index: "0", ImageVersion: "G001.0000.03.03", OEMVersion: "2.1", ECCVersion: "7.0", PowerVersion: "N/A"},
+			},
+			expectedStatus: "PASS",
+		},
+		{
+			name: "InfoROM version None",
+			gpus: []GPUInfoROMInfo{
+				{Index: "0", ImageVersion: "G001.0000.03.03", OEMVersion: "2.1", ECCVersion: "7.0", PowerVersion: "N/A"},
+				{Index: "1", ImageVersion: "None", OEMVersion: "2.1", ECCVersion: "7.0", PowerVersion: "N/A"},
+			},
+			expectedStatus: "FAIL",
+		},
+		{
+			name: "InfoROM version unreadable",
+			gpus: []GPUInfoROMInfo{
+				{Index: "0", ImageVersion: "G001.0000.03.03", OEMVersion: "", ECCVersion: "[Unknown Error]", PowerVersion: "N/A"},
+			},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "No GPUs",
+			gpus:           []GPUInfoROMInfo{},
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPUInfoROMs(tt.gpus)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUInfoROMs() status = %s, want %s", status, tt.expectedStatus)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateGPUInfoROMs() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
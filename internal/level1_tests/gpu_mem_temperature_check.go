@@ -0,0 +1,215 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUMemTemperatureCheckTestConfig represents the config needed to run this test.
+// The HBM thresholds are separate from the GPU core thresholds of gpu_temperature_check.
+type GPUMemTemperatureCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+	WarningC  int    `json:"warning_c"`
+	CriticalC int    `json:"critical_c"`
+}
+
+// GPUMemTemperature represents the memory temperature of a single GPU.
+// MemoryTempC is nil and Status is SKIP when the GPU does not report its memory temperature.
+type GPUMemTemperature struct {
+	Index       string `json:"index"`
+	BusID       string `json:"bus_id"`
+	MemoryTempC *int   `json:"memory_temp_c,omitempty"`
+	Status      string `json:"status"`
+}
+
+// getGPUMemTemperatureCheckTestConfig gets test config needed to run this test
+func getGPUMemTemperatureCheckTestConfig() (*GPUMemTemperatureCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults (H100 HBM3 values)
+	gpuMemTemperatureCheckTestConfig := &GPUMemTemperatureCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+		WarningC:  90,
+		CriticalC: 95,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_mem_temperature_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuMemTemperatureCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_mem_temperature_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if warning, ok := thresholdMap["warning_c"].(float64); ok {
+				gpuMemTemperatureCheckTestConfig.WarningC = int(warning)
+			}
+			if critical, ok := thresholdMap["critical_c"].(float64); ok {
+				gpuMemTemperatureCheckTestConfig.CriticalC = int(critical)
+			}
+		}
+	}
+
+	return gpuMemTemperatureCheckTestConfig, nil
+}
+
+// parseGPUMemTemperatures parses nvidia-smi "index, pci.bus_id, temperature.memory" CSV output.
+// A memory temperature reported as [N/A] leaves MemoryTempC nil.
+func parseGPUMemTemperatures(output string) ([]GPUMemTemperature, error) {
+	var gpus []GPUMemTemperature
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid GPU memory temperature line: %s", line)
+		}
+
+		gpu := GPUMemTemperature{
+			Index: strings.TrimSpace(parts[0]),
+			BusID: sysfsPCIAddress(strings.TrimSpace(parts[1])),
+		}
+		value := strings.TrimSpace(parts[2])
+		if memoryTemp, err := strconv.Atoi(value); err == nil {
+			gpu.MemoryTempC = &memoryTemp
+		} else if !strings.Contains(value, "N/A") {
+			return nil, fmt.Errorf("invalid memory temperature %q for GPU %s", value, gpu.Index)
+		}
+
+		gpus = append(gpus, gpu)
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU memory temperatures found")
+	}
+
+	return gpus, nil
+}
+
+// validateGPUMemTemperatures sets the per-GPU status and returns the overall status.
+// A memory temperature at or above criticalC FAILs, at or above warningC WARNs. GPUs without a
+// memory temperature are SKIPped, and the test is SKIPped when no GPU reports one.
+func validateGPUMemTemperatures(gpus []GPUMemTemperature, warningC, criticalC int) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU memory temperatures found")
+	}
+
+	var criticalGPUs, hotGPUs []string
+	reported := 0
+	for i := range gpus {
+		gpu := &gpus[i]
+		switch {
+		case gpu.MemoryTempC == nil:
+			gpu.Status = "SKIP"
+			continue
+		case *gpu.MemoryTempC >= criticalC:
+			gpu.Status = "FAIL"
+			criticalGPUs = append(criticalGPUs, fmt.Sprintf("%s (%d°C)", gpu.Index, *gpu.MemoryTempC))
+		case *gpu.MemoryTempC >= warningC:
+			gpu.Status = "WARN"
+			hotGPUs = append(hotGPUs, fmt.Sprintf("%s (%d°C)", gpu.Index, *gpu.MemoryTempC))
+		default:
+			gpu.Status = "PASS"
+		}
+		reported++
+	}
+
+	if reported == 0 {
+		return "SKIP", nil
+	}
+	if len(criticalGPUs) > 0 {
+		return "FAIL", &testerrors.TestThresholdExceededError{TestName: "gpu_mem_temperature_check", Metric: "memory_temperature_c",
+			Actual: strings.Join(criticalGPUs, ", "), Expected: fmt.Sprintf("below %d°C", criticalC)}
+	}
+	if len(hotGPUs) > 0 {
+		return "WARN", &testerrors.TestThresholdExceededError{TestName: "gpu_mem_temperature_check", Metric: "memory_temperature_c",
+			Actual: strings.Join(hotGPUs, ", "), Expected: fmt.Sprintf("below %d°C", warningC)}
+	}
+	return "PASS", nil
+}
+
+// RunGPUMemTemperatureCheck checks the HBM temperature of every GPU
+func RunGPUMemTemperatureCheck() error {
+	logger.Info("=== GPU Memory Temperature Check ===")
+	testConfig, err := getGPUMemTemperatureCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_mem_temperature_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU memory temperature check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU memory temperatures
+	logger.Info("Step 1: Getting GPU memory temperatures...")
+	result := executor.RunNvidiaSMIQuery("index,pci.bus_id,temperature.memory")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_mem_temperature_check", "nvidia-smi --query-gpu=index,pci.bus_id,temperature.memory", result)
+		logger.Error("GPU Memory Temperature Check: FAIL - Could not get GPU memory temperatures:", err)
+		rep.AddGPUMemTemperatureResult("FAIL", nil, testConfig.WarningC, testConfig.CriticalC, err)
+		return fmt.Errorf("could not get GPU memory temperatures: %w", err)
+	}
+	gpus, err := parseGPUMemTemperatures(result.Output)
+	if err != nil {
+		logger.Error("GPU Memory Temperature Check: FAIL - Could not parse GPU memory temperatures:", err)
+		rep.AddGPUMemTemperatureResult("FAIL", nil, testConfig.WarningC, testConfig.CriticalC, err)
+		return fmt.Errorf("could not parse GPU memory temperatures: %w", err)
+	}
+
+	// Step 2: Validate memory temperatures
+	logger.Infof("Step 2: Validating GPU memory temperatures (warning %d°C, critical %d°C)...", testConfig.WarningC, testConfig.CriticalC)
+	status, validationErr := validateGPUMemTemperatures(gpus, testConfig.WarningC, testConfig.CriticalC)
+	for _, gpu := range gpus {
+		memoryTemp := "N/A"
+		if gpu.MemoryTempC != nil {
+			memoryTemp = fmt.Sprintf("%d°C", *gpu.MemoryTempC)
+		}
+		logger.Infof("GPU %s (%s): memory %s - %s", gpu.Index, gpu.BusID, memoryTemp, gpu.Status)
+	}
+	rep.AddGPUMemTemperatureResult(status, gpus, testConfig.WarningC, testConfig.CriticalC, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("GPU Memory Temperature Check: PASS - All GPU memory below %d°C", testConfig.WarningC)
+		return nil
+	case "SKIP":
+		logger.Info("GPU Memory Temperature Check: SKIP - No GPU reports its memory temperature")
+		return nil
+	case "WARN":
+		logger.Info("GPU Memory Temperature Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU Memory Temperature Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,66 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPUMemTemperatures function with nvidia-smi CSV output
+func TestParseGPUMemTemperatures(t *testing.T) {
+	output := "0, 00000000:0F:00.0, 62\n" +
+		"1, 00000000:2D:00.0, [N/A]\n"
+
+	gpus, err := parseGPUMemTemperatures(output)
+	if err != nil {
+		t.Fatalf("parseGPUMemTemperatures() error = %v", err)
+	}
+	if len(gpus) != 2 {
+		t.Fatalf("parseGPUMemTemperatures() returned %d GPUs, want 2", len(gpus))
+	}
+	if gpus[0].BusID != "0000:0f:00.0" || gpus[0].MemoryTempC == nil || *gpus[0].MemoryTempC != 62 {
+		t.Errorf("parseGPUMemTemperatures() GPU 0 = %+v, want 0000:0f:00.0 at 62°C", gpus[0])
+	}
+	if gpus[1].MemoryTempC != nil {
+		t.Errorf("parseGPUMemTemperatures() GPU 1 memory temperature = %d, want nil for [N/A]", *gpus[1].MemoryTempC)
+	}
+
+	for _, invalid := range []string{"", "0, 00000000:0F:00.0", "0, 00000000:0F:00.0, hot"} {
+		if _, err := parseGPUMemTemperatures(invalid); err == nil {
+			t.Errorf("parseGPUMemTemperatures(%q) expected error", invalid)
+		}
+	}
+}
+
+// Test validateGPUMemTemperatures function
+func TestValidateGPUMemTemperatures(t *testing.T) {
+	temp := func(c int) *int { return &c }
+
+	tests := []struct {
+		name           string
+		gpus           []GPUMemTemperature
+		expectedStatus string
+	}{
+		{"all below warning", []GPUMemTemperature{{Index: "0", MemoryTempC: temp(62)}, {Index: "1", MemoryTempC: temp(89)}}, "PASS"},
+		{"at warning", []GPUMemTemperature{{Index: "0", MemoryTempC: temp(62)}, {Index: "1", MemoryTempC: temp(90)}}, "WARN"},
+		{"at critical", []GPUMemTemperature{{Index: "0", MemoryTempC: temp(91)}, {Index: "1", MemoryTempC: temp(95)}}, "FAIL"},
+		{"some not reported", []GPUMemTemperature{{Index: "0", MemoryTempC: temp(62)}, {Index: "1"}}, "PASS"},
+		{"none reported", []GPUMemTemperature{{Index: "0"}, {Index: "1"}}, "SKIP"},
+		{"no GPUs", nil, "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPUMemTemperatures(tt.gpus, 90, 95)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUMemTemperatures() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus == "WARN" || tt.expectedStatus == "FAIL") {
+				t.Errorf("validateGPUMemTemperatures() error = %v", err)
+			}
+			for _, gpu := range tt.gpus {
+				if gpu.MemoryTempC == nil && gpu.Status != "SKIP" {
+					t.Errorf("GPU %s without memory temperature has status %v, want SKIP", gpu.Index, gpu.Status)
+				}
+			}
+		})
+	}
+}
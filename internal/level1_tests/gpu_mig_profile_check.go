@@ -0,0 +1,291 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// migGPUInstanceRegex matches a GPU instance row of nvidia-smi mig -lgi, e.g.
+// "|   0  MIG 1g.10gb          19        7          0:1     |"
+var migGPUInstanceRegex = regexp.MustCompile(`^\|\s*(\d+)\s+MIG\s+(\S+)\s+\d+\s+\d+\s+\d+:\d+\s*\|`)
+
+// GPUMIGProfileCheckTestConfig represents the config needed to run this test.
+// ExpectedProfiles maps each MIG GPU instance profile to the number of instances expected on every GPU.
+// MIGRequired is true when the gpu_mode_check allowed_modes only allow MIG to be enabled.
+type GPUMIGProfileCheckTestConfig struct {
+	IsEnabled        bool           `json:"enabled"`
+	Shape            string         `json:"shape"`
+	ExpectedProfiles map[string]int `json:"expected_profiles"`
+	MIGRequired      bool           `json:"mig_required"`
+}
+
+// GPUMIGProfiles represents the MIG GPU instance profiles configured on a single GPU
+type GPUMIGProfiles struct {
+	Index           string   `json:"index"`
+	MIGMode         string   `json:"mig_mode"`
+	Profiles        []string `json:"profiles"`
+	MissingProfiles []string `json:"missing_profiles,omitempty"`
+	ExtraProfiles   []string `json:"extra_profiles,omitempty"`
+	Status          string   `json:"status"`
+}
+
+// getGPUMIGProfileCheckTestConfig gets test config needed to run this test
+func getGPUMIGProfileCheckTestConfig() (*GPUMIGProfileCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	gpuMIGProfileCheckTestConfig := &GPUMIGProfileCheckTestConfig{
+		IsEnabled:        false,
+		Shape:            shape,
+		ExpectedProfiles: map[string]int{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_mig_profile_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuMIGProfileCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_mig_profile_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if profiles, ok := thresholdMap["expected_profiles"].(map[string]interface{}); ok {
+				for profile, count := range profiles {
+					if countFloat, ok := count.(float64); ok && countFloat > 0 {
+						gpuMIGProfileCheckTestConfig.ExpectedProfiles[profile] = int(countFloat)
+					}
+				}
+			}
+		}
+	}
+
+	// MIG is only expected when gpu_mode_check does not allow it to be disabled
+	threshold, err = limits.GetThresholdForTest(shape, "gpu_mode_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if allowedModes, ok := thresholdMap["allowed_modes"].([]interface{}); ok {
+				var modes []string
+				for _, mode := range allowedModes {
+					if modeStr, ok := mode.(string); ok {
+						modes = append(modes, modeStr)
+					}
+				}
+				gpuMIGProfileCheckTestConfig.MIGRequired = migRequired(modes)
+			}
+		}
+	}
+
+	return gpuMIGProfileCheckTestConfig, nil
+}
+
+// migRequired reports whether the allowed GPU modes only allow MIG to be enabled
+func migRequired(allowedModes []string) bool {
+	if len(allowedModes) == 0 {
+		return false
+	}
+	for _, mode := range allowedModes {
+		if !strings.EqualFold(strings.TrimSpace(mode), "Enabled") {
+			return false
+		}
+	}
+	return true
+}
+
+// expandMIGProfiles returns the sorted list of profiles with one entry per expected instance
+func expandMIGProfiles(expected map[string]int) []string {
+	profiles := []string{}
+	for profile, count := range expected {
+		for i := 0; i < count; i++ {
+			profiles = append(profiles, profile)
+		}
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
+// parseMIGGPUInstances parses nvidia-smi mig -lgi output into the sorted GPU instance profiles of each GPU index
+func parseMIGGPUInstances(output string) map[string][]string {
+	instances := make(map[string][]string)
+	for _, line := range strings.Split(output, "\n") {
+		match := migGPUInstanceRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		instances[match[1]] = append(instances[match[1]], match[2])
+	}
+	for index := range instances {
+		sort.Strings(instances[index])
+	}
+	return instances
+}
+
+// compareMIGProfiles returns the expected profiles missing from actual and the actual profiles
+// that are not expected, counting every instance of a profile
+func compareMIGProfiles(expected map[string]int, actual []string) ([]string, []string) {
+	remaining := make(map[string]int)
+	for profile, count := range expected {
+		remaining[profile] = count
+	}
+
+	extra := []string{}
+	for _, profile := range actual {
+		if remaining[profile] > 0 {
+			remaining[profile]--
+		} else {
+			extra = append(extra, profile)
+		}
+	}
+	missing := expandMIGProfiles(remaining)
+	sort.Strings(extra)
+	return missing, extra
+}
+
+// validateGPUMIGProfiles compares the configured MIG profiles of every GPU with the expected profiles.
+// GPUs without MIG enabled, with missing or with unexpected profiles FAIL.
+func validateGPUMIGProfiles(gpuModes []GPUModeInfo, instances map[string][]string, expected map[string]int) ([]GPUMIGProfiles, string, error) {
+	var gpus []GPUMIGProfiles
+	var failed []string
+	for _, gpuMode := range gpuModes {
+		gpu := GPUMIGProfiles{
+			Index:    gpuMode.Index,
+			MIGMode:  gpuMode.Mode,
+			Profiles: instances[gpuMode.Index],
+			Status:   "PASS",
+		}
+		if gpu.Profiles == nil {
+			gpu.Profiles = []string{}
+		}
+
+		if !strings.Contains(gpuMode.Mode, "Enabled") {
+			gpu.Status = "FAIL"
+			failed = append(failed, fmt.Sprintf("GPU %s MIG mode %s", gpu.Index, gpu.MIGMode))
+			gpus = append(gpus, gpu)
+			continue
+		}
+
+		gpu.MissingProfiles, gpu.ExtraProfiles = compareMIGProfiles(expected, gpu.Profiles)
+		if len(gpu.MissingProfiles) > 0 || len(gpu.ExtraProfiles) > 0 {
+			gpu.Status = "FAIL"
+			failed = append(failed, fmt.Sprintf("GPU %s missing [%s] extra [%s]", gpu.Index, strings.Join(gpu.MissingProfiles, ", "), strings.Join(gpu.ExtraProfiles, ", ")))
+		}
+		if len(gpu.MissingProfiles) == 0 {
+			gpu.MissingProfiles = nil
+		}
+		if len(gpu.ExtraProfiles) == 0 {
+			gpu.ExtraProfiles = nil
+		}
+		gpus = append(gpus, gpu)
+	}
+
+	if len(failed) > 0 {
+		return gpus, "FAIL", fmt.Errorf("MIG profiles do not match the expected profiles: %s", strings.Join(failed, "; "))
+	}
+	return gpus, "PASS", nil
+}
+
+// migProfileDifferences lists the missing and extra profiles of all GPUs as "GPU <index>: <profile>"
+func migProfileDifferences(gpus []GPUMIGProfiles) ([]string, []string) {
+	var missing, extra []string
+	for _, gpu := range gpus {
+		for _, profile := range gpu.MissingProfiles {
+			missing = append(missing, fmt.Sprintf("GPU %s: %s", gpu.Index, profile))
+		}
+		for _, profile := range gpu.ExtraProfiles {
+			extra = append(extra, fmt.Sprintf("GPU %s: %s", gpu.Index, profile))
+		}
+	}
+	return missing, extra
+}
+
+// RunGPUMIGProfileCheck validates the MIG GPU instance profiles on shapes that require MIG.
+// nvidia-smi mig -lgip only lists the profiles a GPU supports, so the configured instances come from nvidia-smi mig -lgi.
+func RunGPUMIGProfileCheck() error {
+	logger.Info("=== GPU MIG Profile Check ===")
+	testConfig, err := getGPUMIGProfileCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_mig_profile_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU MIG profile check...")
+	rep := reporter.GetReporter()
+	expectedProfiles := expandMIGProfiles(testConfig.ExpectedProfiles)
+
+	// Shapes that allow MIG to be disabled are covered by gpu_mode_check
+	if !testConfig.MIGRequired {
+		logger.Info("GPU MIG Profile Check: SKIP - MIG is not required for shape", testConfig.Shape)
+		rep.AddGPUMIGProfileResult("SKIP", expectedProfiles, nil, nil, nil, nil)
+		return nil
+	}
+	if len(expectedProfiles) == 0 {
+		logger.Info("GPU MIG Profile Check: SKIP - No expected MIG profiles configured for shape", testConfig.Shape)
+		rep.AddGPUMIGProfileResult("SKIP", expectedProfiles, nil, nil, nil, nil)
+		return nil
+	}
+
+	// Step 1: Get the MIG mode of each GPU
+	logger.Info("Step 1: Getting GPU MIG modes...")
+	result := executor.RunNvidiaSMIQuery("index,mig.mode.current")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_mig_profile_check", "nvidia-smi --query-gpu=index,mig.mode.current", result)
+		logger.Error("GPU MIG Profile Check: FAIL - Could not get GPU MIG modes:", err)
+		rep.AddGPUMIGProfileResult("FAIL", expectedProfiles, nil, nil, nil, err)
+		return err
+	}
+	gpuModes, err := parseGPUModeInfo(result.Output)
+	if err != nil {
+		logger.Error("GPU MIG Profile Check: FAIL - Could not parse GPU MIG modes:", err)
+		rep.AddGPUMIGProfileResult("FAIL", expectedProfiles, nil, nil, nil, err)
+		return err
+	}
+
+	// Step 2: List the configured MIG GPU instances
+	logger.Info("Step 2: Listing MIG GPU instances...")
+	result = executor.RunNvidiaSMIMIGGPUInstances()
+	if !result.Available && !strings.Contains(result.Output, "No GPU instances found") {
+		err = nvidiaSMIError("gpu_mig_profile_check", "nvidia-smi mig -lgi", result)
+		logger.Error("GPU MIG Profile Check: FAIL - Could not list MIG GPU instances:", err)
+		rep.AddGPUMIGProfileResult("FAIL", expectedProfiles, nil, nil, nil, err)
+		return err
+	}
+	instances := parseMIGGPUInstances(result.Output)
+
+	// Step 3: Compare the configured profiles with the expected profiles
+	logger.Infof("Step 3: Validating MIG profiles against %s...", strings.Join(expectedProfiles, ", "))
+	gpus, status, validationErr := validateGPUMIGProfiles(gpuModes, instances, testConfig.ExpectedProfiles)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s: MIG %s, profiles [%s] - %s", gpu.Index, gpu.MIGMode, strings.Join(gpu.Profiles, ", "), gpu.Status)
+	}
+	missing, extra := migProfileDifferences(gpus)
+	rep.AddGPUMIGProfileResult(status, expectedProfiles, gpus, missing, extra, validationErr)
+
+	if status == "PASS" {
+		logger.Infof("GPU MIG Profile Check: PASS - All %d GPUs have the expected MIG profiles", len(gpus))
+		return nil
+	}
+	logger.Error("GPU MIG Profile Check: FAIL -", validationErr)
+	return validationErr
+}
@@ -0,0 +1,90 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testMIGGPUInstancesOutput = `+-------------------------------------------------------+
+| GPU instances:                                        |
+| GPU   Name             Profile  Instance   Placement  |
+|                          ID       ID       Start:Size |
+|=======================================================|
+|   0  MIG 1g.10gb          19        7          0:1     |
++-------------------------------------------------------+
+|   0  MIG 1g.10gb          19        8          1:1     |
++-------------------------------------------------------+
+|   1  MIG 2g.20gb          14        3          0:2     |
++-------------------------------------------------------+
+|   1  MIG 1g.10gb          19        9          2:1     |
++-------------------------------------------------------+
+`
+
+// Test migRequired function
+func TestMIGRequired(t *testing.T) {
+	tests := []struct {
+		name         string
+		allowedModes []string
+		want         bool
+	}{
+		{name: "Only enabled", allowedModes: []string{"ENABLED"}, want: true},
+		{name: "Enabled or disabled", allowedModes: []string{"N/A", "DISABLED", "ENABLED"}, want: false},
+		{name: "Only disabled", allowedModes: []string{"Disabled"}, want: false},
+		{name: "No allowed modes", allowedModes: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := migRequired(tt.allowedModes); got != tt.want {
+				t.Errorf("migRequired(%v) = %v, want %v", tt.allowedModes, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test parseMIGGPUInstances function
+func TestParseMIGGPUInstances(t *testing.T) {
+	instances := parseMIGGPUInstances(testMIGGPUInstancesOutput)
+	expected := map[string][]string{
+		"0": {"1g.10gb", "1g.10gb"},
+		"1": {"1g.10gb", "2g.20gb"},
+	}
+	if !reflect.DeepEqual(instances, expected) {
+		t.Errorf("parseMIGGPUInstances() = %v, want %v", instances, expected)
+	}
+
+	if instances := parseMIGGPUInstances("No GPU instances found: Not Found\n"); len(instances) != 0 {
+		t.Errorf("parseMIGGPUInstances() without instances = %v, want none", instances)
+	}
+}
+
+// Test validateGPUMIGProfiles function
+func TestValidateGPUMIGProfiles(t *testing.T) {
+	expected := map[string]int{"1g.10gb": 2}
+	instances := parseMIGGPUInstances(testMIGGPUInstancesOutput)
+
+	gpus, status, err := validateGPUMIGProfiles([]GPUModeInfo{{Index: "0", Mode: "Enabled"}}, instances, expected)
+	if status != "PASS" || err != nil {
+		t.Errorf("validateGPUMIGProfiles() = %s, %v, want PASS", status, err)
+	}
+	if len(gpus) != 1 || gpus[0].MissingProfiles != nil || gpus[0].ExtraProfiles != nil {
+		t.Errorf("validateGPUMIGProfiles() gpus = %+v, want no missing or extra profiles", gpus)
+	}
+
+	gpuModes := []GPUModeInfo{{Index: "0", Mode: "Enabled"}, {Index: "1", Mode: "Enabled"}, {Index: "2", Mode: "Disabled"}}
+	gpus, status, err = validateGPUMIGProfiles(gpuModes, instances, expected)
+	if status != "FAIL" || err == nil {
+		t.Fatalf("validateGPUMIGProfiles() = %s, %v, want FAIL", status, err)
+	}
+	if !reflect.DeepEqual(gpus[1].MissingProfiles, []string{"1g.10gb"}) || !reflect.DeepEqual(gpus[1].ExtraProfiles, []string{"2g.20gb"}) {
+		t.Errorf("validateGPUMIGProfiles() GPU 1 = %+v, want missing 1g.10gb and extra 2g.20gb", gpus[1])
+	}
+	if gpus[2].Status != "FAIL" || len(gpus[2].Profiles) != 0 {
+		t.Errorf("validateGPUMIGProfiles() GPU 2 = %+v, want FAIL without profiles", gpus[2])
+	}
+
+	missing, extra := migProfileDifferences(gpus)
+	if !reflect.DeepEqual(missing, []string{"GPU 1: 1g.10gb"}) || !reflect.DeepEqual(extra, []string{"GPU 1: 2g.20gb"}) {
+		t.Errorf("migProfileDifferences() = %v, %v", missing, extra)
+	}
+}
@@ -1,11 +1,11 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -80,7 +80,7 @@ func getGPUModeInfo() ([]GPUModeInfo, error) {
 	// Use nvidia-smi to query GPU index and MIG mode
 	result := executor.RunNvidiaSMIQuery("index,mig.mode.current")
 	if !result.Available {
-		return nil, fmt.Errorf("nvidia-smi not available: %s", result.Error)
+		return nil, nvidiaSMIError("gpu_mode_check", "nvidia-smi --query-gpu=index,mig.mode.current", result)
 	}
 
 	// Parse the output
@@ -208,7 +208,7 @@ func RunGPUModeCheck() error {
 	if !gpuModeCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_mode_check", Shape: shape}
 	}
 
 	logger.Infof("Allowed GPU modes for shape %s: %v", shape, gpuModeCheckTestConfig.AllowedModes)
@@ -0,0 +1,242 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// p2pBandwidthRegex matches the bandwidth reported by p2p_bw_test, e.g. "Bandwidth: 482.31 GB/s"
+var p2pBandwidthRegex = regexp.MustCompile(`([0-9]+(?:\.[0-9]+)?)\s*GB/s`)
+
+// p2pTestDuration is how long each GPU pair is measured
+const p2pTestDuration = "2s"
+
+// Bandwidth below these fractions of the minimum threshold WARNs and FAILs
+const (
+	p2pBandwidthWarnRatio = 0.9
+	p2pBandwidthFailRatio = 0.8
+)
+
+// GPUP2PBWCheckTestConfig represents the config needed to run this test
+type GPUP2PBWCheckTestConfig struct {
+	IsEnabled        bool    `json:"enabled"`
+	Shape            string  `json:"shape"`
+	MinBandwidthGBps float64 `json:"min_bandwidth_gbps"`
+}
+
+// GPUP2PPair represents an NVLink-connected GPU pair and its measured P2P bandwidth
+type GPUP2PPair struct {
+	SrcGPU        int     `json:"src_gpu"`
+	DstGPU        int     `json:"dst_gpu"`
+	BandwidthGBps float64 `json:"bandwidth_gbps"`
+	Status        string  `json:"status"`
+}
+
+// getGPUP2PBWCheckTestConfig gets test config needed to run this test
+func getGPUP2PBWCheckTestConfig() (*GPUP2PBWCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuP2PBWCheckTestConfig := &GPUP2PBWCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_p2p_bw_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuP2PBWCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_p2p_bw_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if minBandwidth, ok := thresholdMap["min_bandwidth_gbps"].(float64); ok {
+				gpuP2PBWCheckTestConfig.MinBandwidthGBps = minBandwidth
+			}
+		}
+	}
+
+	return gpuP2PBWCheckTestConfig, nil
+}
+
+// findNVLinkPairs returns every pair of GPUs connected by at least one NVLink, with SrcGPU < DstGPU
+func findNVLinkPairs(topology *NVLinkTopology) []GPUP2PPair {
+	var pairs []GPUP2PPair
+	for i, gpu := range topology.GPUs {
+		for j := i + 1; j < len(topology.GPUs); j++ {
+			if nvlinkCount(topology.Matrix[i][j]) > 0 {
+				pairs = append(pairs, GPUP2PPair{SrcGPU: gpu, DstGPU: topology.GPUs[j]})
+			}
+		}
+	}
+	return pairs
+}
+
+// parseP2PBandwidth parses the bandwidth in GB/s from p2p_bw_test output.
+// The last reported value is used when the binary prints intermediate results.
+func parseP2PBandwidth(output string) (float64, error) {
+	matches := p2pBandwidthRegex.FindAllStringSubmatch(output, -1)
+	if len(matches) == 0 {
+		return 0, fmt.Errorf("bandwidth not found in p2p_bw_test output")
+	}
+	return strconv.ParseFloat(matches[len(matches)-1][1], 64)
+}
+
+// validateP2PBandwidth sets the per-pair status and returns the overall status.
+// Pairs more than 20% below the minimum bandwidth or without a measurement FAIL,
+// pairs more than 10% below the minimum WARN.
+func validateP2PBandwidth(pairs []GPUP2PPair, minBandwidth float64) (string, []string, error) {
+	if len(pairs) == 0 {
+		return "FAIL", nil, fmt.Errorf("no NVLink-connected GPU pairs found")
+	}
+
+	var failedPairs, warnedPairs []string
+	for i := range pairs {
+		pair := &pairs[i]
+		name := fmt.Sprintf("GPU%d-GPU%d", pair.SrcGPU, pair.DstGPU)
+		switch {
+		case pair.Status == "FAIL" || pair.BandwidthGBps < minBandwidth*p2pBandwidthFailRatio:
+			pair.Status = "FAIL"
+			failedPairs = append(failedPairs, name)
+		case pair.BandwidthGBps < minBandwidth*p2pBandwidthWarnRatio:
+			pair.Status = "WARN"
+			warnedPairs = append(warnedPairs, name)
+		default:
+			pair.Status = "PASS"
+		}
+	}
+
+	lowPairs := append(failedPairs, warnedPairs...)
+	if len(failedPairs) > 0 {
+		return "FAIL", lowPairs, fmt.Errorf("P2P bandwidth more than 20%% below %.0f GB/s on GPU pair(s): %s",
+			minBandwidth, strings.Join(failedPairs, ","))
+	}
+	if len(warnedPairs) > 0 {
+		return "WARN", lowPairs, fmt.Errorf("P2P bandwidth more than 10%% below %.0f GB/s on GPU pair(s): %s",
+			minBandwidth, strings.Join(warnedPairs, ","))
+	}
+	return "PASS", lowPairs, nil
+}
+
+// buildP2PBandwidthMatrix returns the measured bandwidth between the topology GPUs,
+// ordered by GPU index, with 0 for pairs that were not measured
+func buildP2PBandwidthMatrix(topology *NVLinkTopology, pairs []GPUP2PPair) [][]float64 {
+	positions := map[int]int{}
+	for i, gpu := range topology.GPUs {
+		positions[gpu] = i
+	}
+
+	matrix := make([][]float64, len(topology.GPUs))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(topology.GPUs))
+	}
+	for _, pair := range pairs {
+		i, j := positions[pair.SrcGPU], positions[pair.DstGPU]
+		matrix[i][j] = pair.BandwidthGBps
+		matrix[j][i] = pair.BandwidthGBps
+	}
+	return matrix
+}
+
+func RunGPUP2PBWCheck() error {
+	logger.Info("=== GPU P2P Bandwidth Check ===")
+	testConfig, err := getGPUP2PBWCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_p2p_bw_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU P2P bandwidth check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Find NVLink-connected GPU pairs
+	logger.Info("Step 1: Getting NVLink topology from nvidia-smi topo -m...")
+	topoResult := executor.RunNvidiaSMITopo()
+	if !topoResult.Available {
+		err = nvidiaSMIError("gpu_p2p_bw_check", "nvidia-smi topo -m", topoResult)
+		logger.Error("GPU P2P Bandwidth Check: FAIL - Could not get NVLink topology:", err)
+		rep.AddGPUP2PBWResult("FAIL", nil, nil, testConfig.MinBandwidthGBps, err)
+		return err
+	}
+	topology, err := parseNVLinkTopology(topoResult.Output)
+	if err != nil {
+		logger.Error("GPU P2P Bandwidth Check: FAIL - Failed to parse nvidia-smi topo output:", err)
+		rep.AddGPUP2PBWResult("FAIL", nil, nil, testConfig.MinBandwidthGBps, err)
+		return fmt.Errorf("failed to parse nvidia-smi topo output: %w", err)
+	}
+	pairs := findNVLinkPairs(topology)
+	logger.Infof("Found %d NVLink-connected GPU pairs", len(pairs))
+
+	// Step 2: Measure the P2P bandwidth of every pair
+	logger.Info("Step 2: Measuring P2P bandwidth...")
+	for i := range pairs {
+		pair := &pairs[i]
+		result, err := executor.RunP2PBandwidthTest(pair.SrcGPU, pair.DstGPU, p2pTestDuration)
+		if err != nil {
+			err = commandError("gpu_p2p_bw_check", result, err)
+			var toolErr *testerrors.TestToolNotFoundError
+			if errors.As(err, &toolErr) {
+				logger.Info("GPU P2P Bandwidth Check: SKIP - P2P bandwidth test binary not installed:", err)
+				rep.AddGPUP2PBWResult("SKIP", nil, nil, testConfig.MinBandwidthGBps, err)
+				return nil
+			}
+			logger.Errorf("GPU%d-GPU%d: %v", pair.SrcGPU, pair.DstGPU, err)
+			pair.Status = "FAIL"
+			continue
+		}
+		bandwidth, err := parseP2PBandwidth(result.Output)
+		if err != nil {
+			logger.Errorf("GPU%d-GPU%d: %v", pair.SrcGPU, pair.DstGPU, err)
+			pair.Status = "FAIL"
+			continue
+		}
+		pair.BandwidthGBps = bandwidth
+	}
+
+	// Step 3: Validate against the minimum bandwidth
+	logger.Info("Step 3: Validating P2P bandwidth...")
+	logger.Infof("Minimum P2P bandwidth: %.0f GB/s", testConfig.MinBandwidthGBps)
+	status, lowPairs, validationErr := validateP2PBandwidth(pairs, testConfig.MinBandwidthGBps)
+	for _, pair := range pairs {
+		logger.Infof("GPU%d-GPU%d: %.2f GB/s - %s", pair.SrcGPU, pair.DstGPU, pair.BandwidthGBps, pair.Status)
+	}
+	rep.AddGPUP2PBWResult(status, buildP2PBandwidthMatrix(topology, pairs), lowPairs, testConfig.MinBandwidthGBps, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("GPU P2P Bandwidth Check: PASS - All %d GPU pairs meet the minimum P2P bandwidth", len(pairs))
+		return nil
+	case "WARN":
+		logger.Info("GPU P2P Bandwidth Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU P2P Bandwidth Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
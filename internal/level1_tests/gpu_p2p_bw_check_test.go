@@ -0,0 +1,102 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test findNVLinkPairs function
+func TestFindNVLinkPairs(t *testing.T) {
+	topology := &NVLinkTopology{
+		GPUs: []int{0, 1, 2},
+		Matrix: [][]string{
+			{"X", "NV18", "SYS"},
+			{"NV18", "X", "NV18"},
+			{"SYS", "NV18", "X"},
+		},
+	}
+
+	pairs := findNVLinkPairs(topology)
+	expected := []GPUP2PPair{{SrcGPU: 0, DstGPU: 1}, {SrcGPU: 1, DstGPU: 2}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("findNVLinkPairs() = %v, want %v", pairs, expected)
+	}
+}
+
+// Test parseP2PBandwidth function
+func TestParseP2PBandwidth(t *testing.T) {
+	bandwidth, err := parseP2PBandwidth("Warmup: 470.12 GB/s\nBandwidth: 482.31 GB/s\n")
+	if err != nil || bandwidth != 482.31 {
+		t.Errorf("parseP2PBandwidth() = %v, %v, want 482.31", bandwidth, err)
+	}
+
+	if _, err := parseP2PBandwidth("cudaErrorPeerAccessUnsupported"); err == nil {
+		t.Error("parseP2PBandwidth() expected error for output without bandwidth")
+	}
+}
+
+// Test validateP2PBandwidth function
+func TestValidateP2PBandwidth(t *testing.T) {
+	tests := []struct {
+		name             string
+		pairs            []GPUP2PPair
+		expectedStatus   string
+		expectedLowPairs []string
+	}{
+		{
+			name:           "All pairs above minimum",
+			pairs:          []GPUP2PPair{{SrcGPU: 0, DstGPU: 1, BandwidthGBps: 480}, {SrcGPU: 1, DstGPU: 2, BandwidthGBps: 430}},
+			expectedStatus: "PASS",
+		},
+		{
+			name:             "Pair more than 10% below minimum",
+			pairs:            []GPUP2PPair{{SrcGPU: 0, DstGPU: 1, BandwidthGBps: 480}, {SrcGPU: 1, DstGPU: 2, BandwidthGBps: 400}},
+			expectedStatus:   "WARN",
+			expectedLowPairs: []string{"GPU1-GPU2"},
+		},
+		{
+			name:             "Pair more than 20% below minimum",
+			pairs:            []GPUP2PPair{{SrcGPU: 0, DstGPU: 1, BandwidthGBps: 300}, {SrcGPU: 1, DstGPU: 2, BandwidthGBps: 400}},
+			expectedStatus:   "FAIL",
+			expectedLowPairs: []string{"GPU0-GPU1", "GPU1-GPU2"},
+		},
+		{
+			name:             "Measurement failed",
+			pairs:            []GPUP2PPair{{SrcGPU: 0, DstGPU: 1, Status: "FAIL"}},
+			expectedStatus:   "FAIL",
+			expectedLowPairs: []string{"GPU0-GPU1"},
+		},
+		{
+			name:           "No NVLink pairs",
+			pairs:          []GPUP2PPair{},
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, lowPairs, err := validateP2PBandwidth(tt.pairs, 450)
+			if status != tt.expectedStatus {
+				t.Errorf("validateP2PBandwidth() = %s, want %s", status, tt.expectedStatus)
+			}
+			if len(lowPairs) != len(tt.expectedLowPairs) || (len(lowPairs) > 0 && !reflect.DeepEqual(lowPairs, tt.expectedLowPairs)) {
+				t.Errorf("validateP2PBandwidth() low pairs = %v, want %v", lowPairs, tt.expectedLowPairs)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateP2PBandwidth() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
+
+// Test buildP2PBandwidthMatrix function
+func TestBuildP2PBandwidthMatrix(t *testing.T) {
+	topology := &NVLinkTopology{GPUs: []int{0, 1, 2}}
+	pairs := []GPUP2PPair{{SrcGPU: 0, DstGPU: 1, BandwidthGBps: 480}, {SrcGPU: 1, DstGPU: 2, BandwidthGBps: 470}}
+
+	matrix := buildP2PBandwidthMatrix(topology, pairs)
+	expected := [][]float64{{0, 480, 0}, {480, 0, 470}, {0, 470, 0}}
+	if !reflect.DeepEqual(matrix, expected) {
+		t.Errorf("buildP2PBandwidthMatrix() = %v, want %v", matrix, expected)
+	}
+}
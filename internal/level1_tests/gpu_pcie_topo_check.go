@@ -0,0 +1,324 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUPCIeTopoCheckTestConfig represents the config needed to run this test.
+// Every pair of the first GPUCount GPUs is expected to report the P2P read status of
+// ExpectedP2PMatrix, or ExpectedStatus when no matrix is configured. The pairs of
+// NVLinkRequiredPairs, or every pair when NVLinkRequired is set, must be connected over NVLink
+// rather than a PCIe path such as PIX. GPUCount defaults to the size of ExpectedP2PMatrix, then
+// to the number of GPUs in the P2P matrix.
+type GPUPCIeTopoCheckTestConfig struct {
+	IsEnabled           bool       `json:"enabled"`
+	Shape               string     `json:"shape"`
+	GPUCount            int        `json:"gpu_count"`
+	ExpectedStatus      string     `json:"expected_status"`
+	ExpectedP2PMatrix   [][]string `json:"expected_p2p_matrix"`
+	NVLinkRequired      bool       `json:"nvlink_required"`
+	NVLinkRequiredPairs [][2]int   `json:"nvlink_required_pairs"`
+}
+
+// getGPUPCIeTopoCheckTestConfig gets test config needed to run this test
+func getGPUPCIeTopoCheckTestConfig() (*GPUPCIeTopoCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuPCIeTopoCheckTestConfig := &GPUPCIeTopoCheckTestConfig{
+		IsEnabled:      false,
+		Shape:          shape,
+		ExpectedStatus: "OK",
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_pcie_topo_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuPCIeTopoCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_pcie_topo_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if count, ok := thresholdMap["gpu_count"].(float64); ok {
+				gpuPCIeTopoCheckTestConfig.GPUCount = int(count)
+			}
+			if status, ok := thresholdMap["expected_status"].(string); ok && status != "" {
+				gpuPCIeTopoCheckTestConfig.ExpectedStatus = status
+			}
+			if expectedMatrix, exists := thresholdMap["expected_p2p_matrix"]; exists {
+				if gpuPCIeTopoCheckTestConfig.ExpectedP2PMatrix, err = parseExpectedP2PMatrix(expectedMatrix); err != nil {
+					return nil, err
+				}
+			}
+			if required, ok := thresholdMap["nvlink_required"].(bool); ok {
+				gpuPCIeTopoCheckTestConfig.NVLinkRequired = required
+			}
+			if pairs, exists := thresholdMap["nvlink_required_pairs"]; exists {
+				if gpuPCIeTopoCheckTestConfig.NVLinkRequiredPairs, err = parseGPUPairs(pairs); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	expectedSize := len(gpuPCIeTopoCheckTestConfig.ExpectedP2PMatrix)
+	if expectedSize > 0 && gpuPCIeTopoCheckTestConfig.GPUCount > expectedSize {
+		return nil, fmt.Errorf("expected_p2p_matrix has %d GPUs, gpu_count is %d", expectedSize, gpuPCIeTopoCheckTestConfig.GPUCount)
+	}
+
+	return gpuPCIeTopoCheckTestConfig, nil
+}
+
+// parseExpectedP2PMatrix parses the expected_p2p_matrix threshold, a square matrix of the
+// expected P2P read status of every GPU pair such as [["X", "OK"], ["OK", "X"]]
+func parseExpectedP2PMatrix(value interface{}) ([][]string, error) {
+	rows, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected_p2p_matrix must be a list of rows")
+	}
+
+	matrix := make([][]string, 0, len(rows))
+	for i, row := range rows {
+		cells, ok := row.([]interface{})
+		if !ok || len(cells) != len(rows) {
+			return nil, fmt.Errorf("expected_p2p_matrix row %d must have %d entries", i, len(rows))
+		}
+		statuses := make([]string, 0, len(cells))
+		for j, cell := range cells {
+			status, ok := cell.(string)
+			if !ok || status == "" {
+				return nil, fmt.Errorf("expected_p2p_matrix entry [%d][%d] must be a P2P status", i, j)
+			}
+			statuses = append(statuses, status)
+		}
+		matrix = append(matrix, statuses)
+	}
+
+	return matrix, nil
+}
+
+// parseGPUPairs parses the nvlink_required_pairs threshold, a list of GPU index pairs such as [[0, 1], [2, 3]]
+func parseGPUPairs(value interface{}) ([][2]int, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("nvlink_required_pairs must be a list of GPU pairs")
+	}
+
+	pairs := make([][2]int, 0, len(list))
+	for i, item := range list {
+		gpus, ok := item.([]interface{})
+		if !ok || len(gpus) != 2 {
+			return nil, fmt.Errorf("nvlink_required_pairs entry %d must be a pair of GPU indices", i)
+		}
+		var pair [2]int
+		for j, gpu := range gpus {
+			index, ok := gpu.(float64)
+			if !ok || index < 0 || index != float64(int(index)) {
+				return nil, fmt.Errorf("nvlink_required_pairs entry %d must be a pair of GPU indices", i)
+			}
+			pair[j] = int(index)
+		}
+		if pair[0] == pair[1] {
+			return nil, fmt.Errorf("nvlink_required_pairs entry %d pairs GPU%d with itself", i, pair[0])
+		}
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, nil
+}
+
+// allGPUPairs returns every pair of the first gpuCount GPUs
+func allGPUPairs(gpuCount int) [][2]int {
+	var pairs [][2]int
+	for gpu := 0; gpu < gpuCount; gpu++ {
+		for peer := gpu + 1; peer < gpuCount; peer++ {
+			pairs = append(pairs, [2]int{gpu, peer})
+		}
+	}
+	return pairs
+}
+
+// findUnavailableP2PPairs returns the GPU pairs among the first gpuCount GPUs whose P2P status
+// in either direction is not the one of expectedMatrix, or expectedStatus when expectedMatrix is
+// nil, e.g. "GPU0-GPU3 (CNS)"
+func findUnavailableP2PPairs(matrix *NVLinkTopology, gpuCount int, expectedStatus string, expectedMatrix [][]string) []string {
+	positions := map[int]int{}
+	for i, gpu := range matrix.GPUs {
+		positions[gpu] = i
+	}
+
+	unavailable := []string{}
+	for gpu := 0; gpu < gpuCount; gpu++ {
+		for peer := gpu + 1; peer < gpuCount; peer++ {
+			pair := fmt.Sprintf("GPU%d-GPU%d", gpu, peer)
+			i, gpuFound := positions[gpu]
+			j, peerFound := positions[peer]
+			if !gpuFound || !peerFound {
+				logger.Errorf("%s: GPU not found in P2P matrix", pair)
+				unavailable = append(unavailable, pair+" (missing)")
+				continue
+			}
+
+			expected := [2]string{expectedStatus, expectedStatus}
+			if expectedMatrix != nil {
+				expected = [2]string{expectedMatrix[gpu][peer], expectedMatrix[peer][gpu]}
+			}
+			for k, status := range []string{matrix.Matrix[i][j], matrix.Matrix[j][i]} {
+				if status != expected[k] {
+					logger.Errorf("%s: P2P status %s, expected %s", pair, status, expected[k])
+					unavailable = append(unavailable, fmt.Sprintf("%s (%s)", pair, status))
+					break
+				}
+			}
+		}
+	}
+
+	return unavailable
+}
+
+// findNonNVLinkPairs returns the GPU pairs that are not connected over NVLink in the
+// nvidia-smi topo -m matrix, e.g. "GPU0-GPU3"
+func findNonNVLinkPairs(topology *NVLinkTopology, pairs [][2]int) []string {
+	positions := map[int]int{}
+	for i, gpu := range topology.GPUs {
+		positions[gpu] = i
+	}
+
+	nonNVLink := []string{}
+	for _, pair := range pairs {
+		connection := fmt.Sprintf("GPU%d-GPU%d", pair[0], pair[1])
+		i, gpuFound := positions[pair[0]]
+		j, peerFound := positions[pair[1]]
+		if !gpuFound || !peerFound {
+			logger.Errorf("%s: GPU not found in topology", connection)
+			nonNVLink = append(nonNVLink, connection)
+			continue
+		}
+
+		if nvlinkCount(topology.Matrix[i][j]) < 1 {
+			logger.Errorf("%s: connected by %s, expected NVLink", connection, topology.Matrix[i][j])
+			nonNVLink = append(nonNVLink, connection)
+		}
+	}
+
+	return nonNVLink
+}
+
+// RunGPUPCIeTopoCheck checks every GPU pair is P2P accessible and, where required, connected over NVLink
+func RunGPUPCIeTopoCheck() error {
+	logger.Info("=== GPU PCIe Topology Check ===")
+	testConfig, err := getGPUPCIeTopoCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_pcie_topo_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU peer-to-peer accessibility check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get the P2P read status matrix
+	logger.Info("Step 1: Running nvidia-smi topo -p2p r...")
+	p2pResult := executor.RunNvidiaSMITopoP2P("r")
+	if !p2pResult.Available {
+		logger.Error("GPU PCIe Topology Check: FAIL - nvidia-smi topo -p2p command failed:", p2pResult.Error)
+		err = nvidiaSMIError("gpu_pcie_topo_check", "nvidia-smi topo -p2p r", p2pResult)
+		rep.AddGPUPCIeTopoResult("FAIL", nil, nil, nil, err)
+		return err
+	}
+
+	// The P2P matrix has the same layout as the topo -m connection matrix
+	matrix, err := parseNVLinkTopology(p2pResult.Output)
+	if err != nil {
+		logger.Error("GPU PCIe Topology Check: FAIL - Failed to parse nvidia-smi topo -p2p output:", err)
+		err = fmt.Errorf("failed to parse nvidia-smi topo -p2p output: %w", err)
+		rep.AddGPUPCIeTopoResult("FAIL", nil, nil, nil, err)
+		return err
+	}
+	logger.Infof("Found %d GPUs in P2P matrix", len(matrix.GPUs))
+
+	gpuCount := testConfig.GPUCount
+	if gpuCount == 0 {
+		gpuCount = len(testConfig.ExpectedP2PMatrix)
+	}
+	if gpuCount == 0 {
+		gpuCount = len(matrix.GPUs)
+		logger.Infof("gpu_count not configured, checking the %d GPUs found", gpuCount)
+	}
+
+	// Step 2: Validate the P2P status of every GPU pair
+	logger.Info("Step 2: Validating P2P read status...")
+	if testConfig.ExpectedP2PMatrix != nil {
+		logger.Infof("Expected P2P read status of every pair of %d GPUs: %v", gpuCount, testConfig.ExpectedP2PMatrix)
+	} else {
+		logger.Infof("Expected P2P read status %s between all %d GPUs", testConfig.ExpectedStatus, gpuCount)
+	}
+	unavailablePairs := findUnavailableP2PPairs(matrix, gpuCount, testConfig.ExpectedStatus, testConfig.ExpectedP2PMatrix)
+
+	// Step 3: Check required pairs are connected over NVLink rather than PCIe
+	nvlinkPairs := testConfig.NVLinkRequiredPairs
+	if testConfig.NVLinkRequired {
+		nvlinkPairs = allGPUPairs(gpuCount)
+	}
+	var nonNVLinkPairs []string
+	if len(nvlinkPairs) > 0 {
+		logger.Info("Step 3: Checking GPU pairs are connected over NVLink...")
+		topoResult := executor.RunNvidiaSMITopo()
+		if !topoResult.Available {
+			logger.Error("GPU PCIe Topology Check: FAIL - nvidia-smi topo command failed:", topoResult.Error)
+			err = nvidiaSMIError("gpu_pcie_topo_check", "nvidia-smi topo -m", topoResult)
+			rep.AddGPUPCIeTopoResult("FAIL", matrix.Matrix, unavailablePairs, nil, err)
+			return err
+		}
+		topology, err := parseNVLinkTopology(topoResult.Output)
+		if err != nil {
+			logger.Error("GPU PCIe Topology Check: FAIL - Failed to parse nvidia-smi topo output:", err)
+			err = fmt.Errorf("failed to parse nvidia-smi topo output: %w", err)
+			rep.AddGPUPCIeTopoResult("FAIL", matrix.Matrix, unavailablePairs, nil, err)
+			return err
+		}
+		nonNVLinkPairs = findNonNVLinkPairs(topology, nvlinkPairs)
+	}
+
+	var failures []string
+	if len(unavailablePairs) > 0 {
+		failures = append(failures, fmt.Sprintf("GPU pairs without P2P read access: %s", strings.Join(unavailablePairs, ", ")))
+	}
+	if len(nonNVLinkPairs) > 0 {
+		failures = append(failures, fmt.Sprintf("GPU pairs connected over PCIe instead of NVLink: %s", strings.Join(nonNVLinkPairs, ", ")))
+	}
+	if len(failures) > 0 {
+		err = errors.New(strings.Join(failures, "; "))
+		logger.Error("GPU PCIe Topology Check: FAIL -", err)
+		rep.AddGPUPCIeTopoResult("FAIL", matrix.Matrix, unavailablePairs, nonNVLinkPairs, err)
+		return err
+	}
+
+	logger.Info("GPU PCIe Topology Check: PASS - All GPU pairs are P2P accessible")
+	rep.AddGPUPCIeTopoResult("PASS", matrix.Matrix, unavailablePairs, nonNVLinkPairs, nil)
+	return nil
+}
@@ -0,0 +1,138 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test findUnavailableP2PPairs function with nvidia-smi topo -p2p r output
+func TestFindUnavailableP2PPairs(t *testing.T) {
+	output := " \tGPU0\tGPU1\tGPU2\t\n" +
+		" GPU0\tX\tOK\tOK\t\n" +
+		" GPU1\tOK\tX\tCNS\t\n" +
+		" GPU2\tOK\tOK\tX\t\n" +
+		"\n" +
+		"Legend:\n" +
+		"\n" +
+		"  X    = Self\n" +
+		"  OK   = Status Ok\n" +
+		"  CNS  = Chipset not supported\n"
+
+	matrix, err := parseNVLinkTopology(output)
+	if err != nil {
+		t.Fatalf("parseNVLinkTopology() error = %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		gpuCount       int
+		expectedMatrix [][]string
+		expected       []string
+	}{
+		{"all pairs", 3, nil, []string{"GPU1-GPU2 (CNS)"}},
+		{"first two GPUs", 2, nil, []string{}},
+		{"missing GPU", 4, nil, []string{"GPU0-GPU3 (missing)", "GPU1-GPU2 (CNS)", "GPU1-GPU3 (missing)", "GPU2-GPU3 (missing)"}},
+		{"expected matrix", 3, [][]string{{"X", "OK", "OK"}, {"OK", "X", "CNS"}, {"OK", "OK", "X"}}, []string{}},
+		{"expected matrix mismatch", 3, [][]string{{"X", "CNS", "OK"}, {"CNS", "X", "CNS"}, {"OK", "OK", "X"}}, []string{"GPU0-GPU1 (OK)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unavailable := findUnavailableP2PPairs(matrix, tt.gpuCount, "OK", tt.expectedMatrix)
+			if !reflect.DeepEqual(unavailable, tt.expected) {
+				t.Errorf("findUnavailableP2PPairs() = %v, want %v", unavailable, tt.expected)
+			}
+		})
+	}
+}
+
+// Test parseExpectedP2PMatrix function with expected_p2p_matrix thresholds as decoded from JSON
+func TestParseExpectedP2PMatrix(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		expected    [][]string
+		expectError bool
+	}{
+		{
+			name:     "Square matrix",
+			value:    []interface{}{[]interface{}{"X", "OK"}, []interface{}{"CNS", "X"}},
+			expected: [][]string{{"X", "OK"}, {"CNS", "X"}},
+		},
+		{name: "Not a list", value: "OK", expectError: true},
+		{name: "Row too short", value: []interface{}{[]interface{}{"X", "OK"}, []interface{}{"OK"}}, expectError: true},
+		{name: "Non string status", value: []interface{}{[]interface{}{"X", 1.0}, []interface{}{"OK", "X"}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matrix, err := parseExpectedP2PMatrix(tt.value)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseExpectedP2PMatrix() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError && !reflect.DeepEqual(matrix, tt.expected) {
+				t.Errorf("parseExpectedP2PMatrix() = %v, want %v", matrix, tt.expected)
+			}
+		})
+	}
+}
+
+// Test parseGPUPairs function with nvlink_required_pairs thresholds as decoded from JSON
+func TestParseGPUPairs(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		expected    [][2]int
+		expectError bool
+	}{
+		{
+			name:     "Pairs",
+			value:    []interface{}{[]interface{}{0.0, 1.0}, []interface{}{2.0, 3.0}},
+			expected: [][2]int{{0, 1}, {2, 3}},
+		},
+		{name: "Not a list", value: true, expectError: true},
+		{name: "Three GPUs", value: []interface{}{[]interface{}{0.0, 1.0, 2.0}}, expectError: true},
+		{name: "Fractional index", value: []interface{}{[]interface{}{0.0, 1.5}}, expectError: true},
+		{name: "Same GPU", value: []interface{}{[]interface{}{1.0, 1.0}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, err := parseGPUPairs(tt.value)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseGPUPairs() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError && !reflect.DeepEqual(pairs, tt.expected) {
+				t.Errorf("parseGPUPairs() = %v, want %v", pairs, tt.expected)
+			}
+		})
+	}
+}
+
+// Test findNonNVLinkPairs function with a GPU pair connected over PCIe
+func TestFindNonNVLinkPairs(t *testing.T) {
+	topology, err := parseNVLinkTopology(fullyConnectedTopo(4, "PIX"))
+	if err != nil {
+		t.Fatalf("parseNVLinkTopology() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		pairs    [][2]int
+		expected []string
+	}{
+		{"all pairs", allGPUPairs(4), []string{"GPU0-GPU1"}},
+		{"NVLink pairs only", [][2]int{{0, 2}, {1, 3}}, []string{}},
+		{"PCIe pair required", [][2]int{{1, 0}, {2, 3}}, []string{"GPU1-GPU0"}},
+		{"missing GPU", [][2]int{{0, 5}}, []string{"GPU0-GPU5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nonNVLink := findNonNVLinkPairs(topology, tt.pairs)
+			if !reflect.DeepEqual(nonNVLink, tt.expected) {
+				t.Errorf("findNonNVLinkPairs() = %v, want %v", nonNVLink, tt.expected)
+			}
+		})
+	}
+}
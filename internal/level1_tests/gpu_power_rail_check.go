@@ -0,0 +1,249 @@
+package level1_tests
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUPowerRailCheckTestConfig represents the config needed to run this test
+type GPUPowerRailCheckTestConfig struct {
+	IsEnabled        bool    `json:"enabled"`
+	Shape            string  `json:"shape"`
+	TDPWatts         float64 `json:"tdp_watts"`
+	TolerancePercent float64 `json:"tolerance_percent"`
+}
+
+// GPUPowerRailReading represents a single power reading of a GPU power rail from nvidia-smi -q -d POWER,
+// e.g. rail "GPU Power Readings" and reading "Current Power Limit"
+type GPUPowerRailReading struct {
+	GPU     string  `json:"gpu"`
+	Rail    string  `json:"rail"`
+	Reading string  `json:"reading"`
+	PowerW  float64 `json:"power_w"`
+	Status  string  `json:"status"`
+}
+
+// getGPUPowerRailCheckTestConfig gets test config needed to run this test
+func getGPUPowerRailCheckTestConfig() (*GPUPowerRailCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults (H100 SXM TDP)
+	gpuPowerRailCheckTestConfig := &GPUPowerRailCheckTestConfig{
+		IsEnabled:        false,
+		Shape:            shape,
+		TDPWatts:         700,
+		TolerancePercent: 10,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_power_rail_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuPowerRailCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_power_rail_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if tdp, ok := thresholdMap["tdp_watts"].(float64); ok {
+				gpuPowerRailCheckTestConfig.TDPWatts = tdp
+			}
+			if tolerance, ok := thresholdMap["tolerance_percent"].(float64); ok {
+				gpuPowerRailCheckTestConfig.TolerancePercent = tolerance
+			}
+		}
+	}
+
+	return gpuPowerRailCheckTestConfig, nil
+}
+
+// parseGPUPowerRails parses the power readings in watts of every GPU rail from nvidia-smi -q -d POWER output.
+// Readings reported as N/A, non-power readings such as the sample duration and power limits that are not
+// expected to be set to TDP are skipped.
+func parseGPUPowerRails(output string) ([]GPUPowerRailReading, error) {
+	var readings []GPUPowerRailReading
+	gpu := ""
+	rail := ""
+
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		// A GPU section starts with its bus ID, e.g. "GPU 00000000:0F:00.0"
+		if strings.HasPrefix(line, "GPU ") {
+			gpu = strings.TrimSpace(strings.TrimPrefix(line, "GPU "))
+			rail = ""
+			continue
+		}
+		if gpu == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(trimmed, ":")
+		if !found {
+			// Rail headers, e.g. "GPU Power Readings", "Power Samples" or "Module Power Readings"
+			rail = trimmed
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if !strings.HasSuffix(value, " W") {
+			continue
+		}
+		power, err := strconv.ParseFloat(strings.TrimSuffix(value, " W"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid power reading %q for GPU %s: %w", trimmed, gpu, err)
+		}
+		reading := GPUPowerRailReading{
+			GPU:     gpu,
+			Rail:    rail,
+			Reading: strings.TrimSpace(name),
+			PowerW:  power,
+		}
+		if isPowerLimitReading(reading) && !isGPUPowerLimitReading(reading) {
+			continue
+		}
+		readings = append(readings, reading)
+	}
+
+	if len(readings) == 0 {
+		return nil, fmt.Errorf("no GPU power readings found in nvidia-smi output")
+	}
+	return readings, nil
+}
+
+// isPowerLimitReading returns whether a reading is a power limit rather than a power draw
+func isPowerLimitReading(reading GPUPowerRailReading) bool {
+	return strings.Contains(reading.Reading, "Limit")
+}
+
+// isGPUPowerLimitReading returns whether a reading is a power limit of the GPU rail that is expected to be set to TDP.
+// The minimum power limit and the limits of other rails such as the module are not.
+func isGPUPowerLimitReading(reading GPUPowerRailReading) bool {
+	if !isPowerLimitReading(reading) || strings.HasPrefix(reading.Reading, "Min") {
+		return false
+	}
+	return reading.Rail == "GPU Power Readings" || reading.Rail == "Power Readings"
+}
+
+// validateGPUPowerRails sets the status of every reading and returns the overall status.
+// Power limits of the GPU rail FAIL when more than tolerancePercent from TDP and WARN when more than half of it.
+// Power draws FAIL above TDP plus tolerancePercent and WARN within tolerancePercent of TDP, as an idle GPU
+// should draw far below it.
+func validateGPUPowerRails(readings []GPUPowerRailReading, tdpWatts, tolerancePercent float64) (string, error) {
+	if len(readings) == 0 {
+		return "FAIL", fmt.Errorf("no GPU power readings found")
+	}
+
+	tolerance := tdpWatts * tolerancePercent / 100
+	var failures, warnings []string
+	for i := range readings {
+		reading := &readings[i]
+		deviation := reading.PowerW - tdpWatts
+
+		reading.Status = "PASS"
+		if isPowerLimitReading(*reading) {
+			if math.Abs(deviation) > tolerance {
+				reading.Status = "FAIL"
+			} else if math.Abs(deviation) > tolerance/2 {
+				reading.Status = "WARN"
+			}
+		} else {
+			if deviation > tolerance {
+				reading.Status = "FAIL"
+			} else if deviation >= -tolerance {
+				reading.Status = "WARN"
+			}
+		}
+
+		description := fmt.Sprintf("GPU %s %s %s %.2f W", reading.GPU, reading.Rail, reading.Reading, reading.PowerW)
+		switch reading.Status {
+		case "FAIL":
+			failures = append(failures, description)
+		case "WARN":
+			warnings = append(warnings, description)
+		}
+	}
+
+	if len(failures) > 0 {
+		return "FAIL", fmt.Errorf("GPU power readings out of bounds of %.0f W TDP ±%.0f%%: %s", tdpWatts, tolerancePercent, strings.Join(failures, ", "))
+	}
+	if len(warnings) > 0 {
+		return "WARN", fmt.Errorf("GPU power readings near %.0f W TDP ±%.0f%% bounds: %s", tdpWatts, tolerancePercent, strings.Join(warnings, ", "))
+	}
+	return "PASS", nil
+}
+
+// RunGPUPowerRailCheck checks the power readings of every GPU rail against TDP
+func RunGPUPowerRailCheck() error {
+	logger.Info("=== GPU Power Rail Check ===")
+	testConfig, err := getGPUPowerRailCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_power_rail_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU power rail check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU power readings
+	logger.Info("Step 1: Getting GPU power readings...")
+	result := executor.RunNvidiaSMIQueryDisplay("POWER")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_power_rail_check", "nvidia-smi -q -d POWER", result)
+		logger.Error("GPU Power Rail Check: FAIL - Could not get GPU power readings:", err)
+		rep.AddGPUPowerRailResult("FAIL", testConfig.TDPWatts, nil, err)
+		return fmt.Errorf("could not get GPU power readings: %w", err)
+	}
+	readings, err := parseGPUPowerRails(result.Output)
+	if err != nil {
+		logger.Error("GPU Power Rail Check: FAIL - Could not parse GPU power readings:", err)
+		rep.AddGPUPowerRailResult("FAIL", testConfig.TDPWatts, nil, err)
+		return fmt.Errorf("could not parse GPU power readings: %w", err)
+	}
+
+	// Step 2: Validate power readings
+	logger.Info("Step 2: Validating GPU power readings...")
+	status, validationErr := validateGPUPowerRails(readings, testConfig.TDPWatts, testConfig.TolerancePercent)
+	for _, reading := range readings {
+		logger.Debugf("GPU %s %s %s: %.2f W - %s", reading.GPU, reading.Rail, reading.Reading, reading.PowerW, reading.Status)
+	}
+	rep.AddGPUPowerRailResult(status, testConfig.TDPWatts, readings, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("GPU Power Rail Check: PASS - All GPU power readings within %.0f W TDP ±%.0f%%", testConfig.TDPWatts, testConfig.TolerancePercent)
+		return nil
+	case "WARN":
+		logger.Info("GPU Power Rail Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU Power Rail Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,144 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const testGPUPowerRailOutput = `
+==============NVSMI LOG==============
+
+Timestamp                                 : Mon Jun  2 10:00:00 2025
+Driver Version                            : 550.90.07
+CUDA Version                              : 12.4
+
+Attached GPUs                             : 2
+GPU 00000000:0F:00.0
+    GPU Power Readings
+        Power Draw                        : 71.23 W
+        Current Power Limit               : 700.00 W
+        Requested Power Limit             : 700.00 W
+        Default Power Limit               : 700.00 W
+        Min Power Limit                   : 200.00 W
+        Max Power Limit                   : 700.00 W
+    Power Samples
+        Duration                          : 2.36 sec
+        Number of Samples                 : 119
+        Max                               : 72.05 W
+        Min                               : 71.05 W
+        Avg                               : 71.48 W
+    GPU Memory Power Readings
+        Power Draw                        : N/A
+    Module Power Readings
+        Power Draw                        : N/A
+        Current Power Limit               : 3500.00 W
+
+GPU 00000000:2D:00.0
+    GPU Power Readings
+        Power Draw                        : 70.11 W
+        Current Power Limit               : 650.00 W
+        Requested Power Limit             : 650.00 W
+        Default Power Limit               : 700.00 W
+        Min Power Limit                   : 200.00 W
+        Max Power Limit                   : 700.00 W
+    Power Samples
+        Duration                          : 2.36 sec
+        Number of Samples                 : 119
+        Max                               : 790.40 W
+        Min                               : 69.87 W
+        Avg                               : 70.51 W
+`
+
+// Test parseGPUPowerRails function with nvidia-smi -q -d POWER output
+func TestParseGPUPowerRails(t *testing.T) {
+	readings, err := parseGPUPowerRails(testGPUPowerRailOutput)
+	if err != nil {
+		t.Fatalf("parseGPUPowerRails() error = %v", err)
+	}
+
+	// Per GPU: power draw, 4 power limits except the minimum and 3 power samples
+	if len(readings) != 16 {
+		t.Fatalf("parseGPUPowerRails() returned %d readings, want 16", len(readings))
+	}
+
+	first := readings[0]
+	if first.GPU != "00000000:0F:00.0" || first.Rail != "GPU Power Readings" || first.Reading != "Power Draw" || first.PowerW != 71.23 {
+		t.Errorf("parseGPUPowerRails() first reading = %+v", first)
+	}
+	for _, reading := range readings {
+		if reading.Reading == "Min Power Limit" || reading.Rail == "Module Power Readings" {
+			t.Errorf("parseGPUPowerRails() included reading %+v", reading)
+		}
+	}
+
+	if _, err := parseGPUPowerRails("No devices were found"); err == nil {
+		t.Error("parseGPUPowerRails() expected error for output without power readings")
+	}
+}
+
+// Test validateGPUPowerRails function
+func TestValidateGPUPowerRails(t *testing.T) {
+	tests := []struct {
+		name           string
+		readings       []GPUPowerRailReading
+		expectedStatus string
+	}{
+		{
+			name: "idle GPU with power limit at TDP",
+			readings: []GPUPowerRailReading{
+				{GPU: "0", Rail: "GPU Power Readings", Reading: "Power Draw", PowerW: 71.23},
+				{GPU: "0", Rail: "GPU Power Readings", Reading: "Current Power Limit", PowerW: 700},
+			},
+			expectedStatus: "PASS",
+		},
+		{
+			name: "power limit near tolerance bound",
+			readings: []GPUPowerRailReading{
+				{GPU: "0", Rail: "GPU Power Readings", Reading: "Current Power Limit", PowerW: 650},
+			},
+			expectedStatus: "WARN",
+		},
+		{
+			name: "power draw near TDP",
+			readings: []GPUPowerRailReading{
+				{GPU: "0", Rail: "Power Samples", Reading: "Max", PowerW: 690},
+			},
+			expectedStatus: "WARN",
+		},
+		{
+			name: "power limit out of bounds",
+			readings: []GPUPowerRailReading{
+				{GPU: "0", Rail: "GPU Power Readings", Reading: "Current Power Limit", PowerW: 500},
+			},
+			expectedStatus: "FAIL",
+		},
+		{
+			name: "power draw above TDP",
+			readings: []GPUPowerRailReading{
+				{GPU: "0", Rail: "Power Samples", Reading: "Max", PowerW: 790.4},
+			},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "no readings",
+			readings:       nil,
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPUPowerRails(tt.readings, 700, 10)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUPowerRails() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateGPUPowerRails() error = %v", err)
+			}
+			for _, reading := range tt.readings {
+				if reading.Status == "" {
+					t.Errorf("validateGPUPowerRails() did not set status of %+v", reading)
+				}
+			}
+		})
+	}
+}
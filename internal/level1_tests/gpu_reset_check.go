@@ -0,0 +1,303 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// defaultMaxGPUResets is the number of resets of a GPU since boot above which the test fails
+const defaultMaxGPUResets = 2
+
+// nvidiaGPUInformationPath is the driver information file of a GPU, by PCI domain:bus:device.function
+const nvidiaGPUInformationPath = "/proc/driver/nvidia/gpus/%s/information"
+
+// gpuResetEventPattern matches GPU reset events in `dmesg --time-format iso` output, such as
+// 2025-01-15T10:30:00,123456+00:00 NVRM: GPU Board at PCI:0000:0f:00: GPU reset
+var gpuResetEventPattern = regexp.MustCompile(`(?i)^(\S+)?\s*.*NVRM:.*?(?:PCI:)?([0-9a-f]{4}:[0-9a-f]{2}:[0-9a-f]{2})(?:\.[0-7])?\b.*\breset\b`)
+
+// gpuResetCounterPattern matches a reset counter line in the driver information file of a GPU
+var gpuResetCounterPattern = regexp.MustCompile(`(?im)^\s*reset count:\s*(\d+)\s*$`)
+
+// GPUResetCheckTestConfig represents the config needed to run this test
+type GPUResetCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+	MaxResets int    `json:"max_resets"`
+}
+
+// GPUResetInfo represents the resets of a single GPU since boot
+type GPUResetInfo struct {
+	Index        string `json:"index"`
+	BusID        string `json:"bus_id"`
+	ResetCount   int    `json:"reset_count"`
+	LastResetUTC string `json:"last_reset_utc,omitempty"`
+	Status       string `json:"status"`
+}
+
+// gpuResetEvents represents the resets of a GPU found in dmesg
+type gpuResetEvents struct {
+	count    int
+	lastSeen time.Time
+}
+
+// getGPUResetCheckTestConfig gets test config needed to run this test
+func getGPUResetCheckTestConfig() (*GPUResetCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuResetCheckTestConfig := &GPUResetCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+		MaxResets: defaultMaxGPUResets,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_reset_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuResetCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_reset_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if maxResets, ok := thresholdMap["max_resets"].(float64); ok {
+				gpuResetCheckTestConfig.MaxResets = int(maxResets)
+			}
+		}
+	}
+
+	return gpuResetCheckTestConfig, nil
+}
+
+// normalizeGPUBusID converts an nvidia-smi bus ID such as 00000000:0F:00.0 to the
+// domain:bus:device form used by the driver, 0000:0f:00
+func normalizeGPUBusID(busID string) string {
+	busID = strings.ToLower(strings.TrimSpace(busID))
+	if i := strings.LastIndex(busID, "."); i >= 0 {
+		busID = busID[:i]
+	}
+	if parts := strings.SplitN(busID, ":", 2); len(parts) == 2 && len(parts[0]) > 4 {
+		busID = parts[0][len(parts[0])-4:] + ":" + parts[1]
+	}
+	return busID
+}
+
+// parseGPUResetEvents counts the GPU reset events in dmesg output by GPU bus ID and keeps
+// the time of the most recent reset when dmesg printed ISO timestamps
+func parseGPUResetEvents(dmesgOutput string) map[string]*gpuResetEvents {
+	events := make(map[string]*gpuResetEvents)
+	for _, line := range strings.Split(dmesgOutput, "\n") {
+		match := gpuResetEventPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		busID := strings.ToLower(match[2])
+		event, ok := events[busID]
+		if !ok {
+			event = &gpuResetEvents{}
+			events[busID] = event
+		}
+		event.count++
+
+		timestamp := strings.Replace(match[1], ",", ".", 1)
+		if seen, err := time.Parse("2006-01-02T15:04:05.999999999-07:00", timestamp); err == nil && seen.After(event.lastSeen) {
+			event.lastSeen = seen
+		}
+	}
+	return events
+}
+
+// parseGPUResetCounter returns the reset counter of a driver information file, if it has one
+func parseGPUResetCounter(information string) (int, bool) {
+	match := gpuResetCounterPattern.FindStringSubmatch(information)
+	if match == nil {
+		return 0, false
+	}
+	count, err := strconv.Atoi(match[1])
+	return count, err == nil
+}
+
+// parseGPUResetInfo parses nvidia-smi "index, pci.bus_id, pcie.link.gen.gpucurrent" CSV output.
+// A GPU that needs a reset reports "[GPU requires reset]" instead of its link generation.
+func parseGPUResetInfo(output string) ([]GPUResetInfo, []string, error) {
+	var gpus []GPUResetInfo
+	var resetRequired []string
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 3 {
+			logger.Errorf("Invalid GPU reset info line: %s", line)
+			return nil, nil, fmt.Errorf("invalid GPU reset info line: %s", line)
+		}
+
+		gpu := GPUResetInfo{
+			Index: strings.TrimSpace(parts[0]),
+			BusID: normalizeGPUBusID(parts[1]),
+		}
+		if strings.Contains(strings.ToLower(parts[2]), "requires reset") {
+			resetRequired = append(resetRequired, gpu.Index)
+		}
+		gpus = append(gpus, gpu)
+	}
+
+	if len(gpus) == 0 {
+		return nil, nil, fmt.Errorf("no GPUs found")
+	}
+
+	return gpus, resetRequired, nil
+}
+
+// applyGPUResetEvents sets the reset count and most recent reset of every GPU from the dmesg
+// events, using the driver reset counter of a GPU instead when it is higher
+func applyGPUResetEvents(gpus []GPUResetInfo, events map[string]*gpuResetEvents, counters map[string]int) {
+	for i := range gpus {
+		gpu := &gpus[i]
+		if event, ok := events[gpu.BusID]; ok {
+			gpu.ResetCount = event.count
+			if !event.lastSeen.IsZero() {
+				gpu.LastResetUTC = event.lastSeen.UTC().Format(time.RFC3339)
+			}
+		}
+		if count, ok := counters[gpu.BusID]; ok && count > gpu.ResetCount {
+			gpu.ResetCount = count
+		}
+	}
+}
+
+// validateGPUResets sets the per-GPU status and returns the overall status.
+// GPUs reset more than maxResets times since boot or waiting for a reset FAIL.
+func validateGPUResets(gpus []GPUResetInfo, resetRequired []string, maxResets int) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPUs found")
+	}
+
+	var unstableGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.Status = "PASS"
+		if gpu.ResetCount > maxResets || containsString(resetRequired, gpu.Index) {
+			gpu.Status = "FAIL"
+		}
+		if gpu.ResetCount > maxResets {
+			unstableGPUs = append(unstableGPUs, fmt.Sprintf("%s (%d resets)", gpu.Index, gpu.ResetCount))
+		}
+	}
+
+	var problems []string
+	if len(unstableGPUs) > 0 {
+		problems = append(problems, fmt.Sprintf("GPU(s) reset more than %d times since boot: %s", maxResets, strings.Join(unstableGPUs, ", ")))
+	}
+	if len(resetRequired) > 0 {
+		problems = append(problems, fmt.Sprintf("GPU(s) require a reset: %s", strings.Join(resetRequired, ",")))
+	}
+	if len(problems) > 0 {
+		return "FAIL", fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return "PASS", nil
+}
+
+// readGPUResetCounters reads the reset counters of the GPUs that expose one in their driver
+// information file. Most drivers do not, so missing files and counters are not errors.
+func readGPUResetCounters(gpus []GPUResetInfo) map[string]int {
+	counters := make(map[string]int)
+	for _, gpu := range gpus {
+		result, err := executor.RunCat(fmt.Sprintf(nvidiaGPUInformationPath, gpu.BusID+".0"))
+		if err != nil {
+			logger.Debugf("No driver information for GPU %s: %v", gpu.Index, err)
+			continue
+		}
+		if count, ok := parseGPUResetCounter(result.Output); ok {
+			counters[gpu.BusID] = count
+		}
+	}
+	return counters
+}
+
+func RunGPUResetCheck() error {
+	logger.Info("=== GPU Reset Check ===")
+	testConfig, err := getGPUResetCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_reset_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU reset check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPUs and whether they require a reset
+	logger.Info("Step 1: Getting GPU bus IDs and reset status...")
+	query := "index,pci.bus_id,pcie.link.gen.gpucurrent"
+	smiResult := executor.RunNvidiaSMIQuery(query)
+	if !smiResult.Available {
+		err = nvidiaSMIError("gpu_reset_check", "nvidia-smi --query-gpu="+query, smiResult)
+		logger.Error("GPU Reset Check: FAIL - Could not get GPUs:", err)
+		rep.AddGPUResetResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPUs: %w", err)
+	}
+	gpus, resetRequired, err := parseGPUResetInfo(smiResult.Output)
+	if err != nil {
+		logger.Error("GPU Reset Check: FAIL - Could not parse GPUs:", err)
+		rep.AddGPUResetResult("FAIL", nil, err)
+		return fmt.Errorf("could not parse GPUs: %w", err)
+	}
+
+	// Step 2: Count GPU resets since boot
+	logger.Info("Step 2: Counting GPU resets in dmesg...")
+	dmesgResult, err := executor.RunDmesg("--time-format", "iso")
+	if err != nil {
+		logger.Error("Failed to run dmesg command:", err)
+		logger.Info("GPU Reset Check: FAIL - Could not run dmesg command")
+		err = commandError("gpu_reset_check", dmesgResult, err)
+		rep.AddGPUResetResult("FAIL", nil, err)
+		return err
+	}
+	applyGPUResetEvents(gpus, parseGPUResetEvents(dmesgResult.Output), readGPUResetCounters(gpus))
+
+	// Step 3: Validate GPU resets
+	logger.Info("Step 3: Validating GPU resets...")
+	logger.Infof("Maximum resets since boot: %d", testConfig.MaxResets)
+	status, validationErr := validateGPUResets(gpus, resetRequired, testConfig.MaxResets)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s (%s): %d resets since boot, last reset %s - %s", gpu.Index, gpu.BusID, gpu.ResetCount, gpu.LastResetUTC, gpu.Status)
+	}
+	rep.AddGPUResetResult(status, gpus, validationErr)
+
+	if status == "PASS" {
+		logger.Info("GPU Reset Check: PASS - No GPU was reset more often than the threshold since boot")
+		return nil
+	}
+	logger.Error("GPU Reset Check: FAIL -", validationErr)
+	return validationErr
+}
@@ -0,0 +1,86 @@
+package level1_tests
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test normalizeGPUBusID function
+func TestNormalizeGPUBusID(t *testing.T) {
+	for busID, want := range map[string]string{
+		"00000000:0F:00.0": "0000:0f:00",
+		"0000:d8:00.0":     "0000:d8:00",
+		"0000:2d:00":       "0000:2d:00",
+	} {
+		if got := normalizeGPUBusID(busID); got != want {
+			t.Errorf("normalizeGPUBusID(%q) = %q, want %q", busID, got, want)
+		}
+	}
+}
+
+// Test parseGPUResetEvents function
+func TestParseGPUResetEvents(t *testing.T) {
+	output := `2025-01-15T10:30:00,123456+00:00 NVRM: GPU Board at PCI:0000:0f:00: GPU reset
+2025-01-15T11:45:10,000000+00:00 NVRM: GPU at PCI:0000:0F:00: GPU reset
+2025-01-15T11:46:00,000000+00:00 NVRM: loading NVIDIA UNIX x86_64 Kernel Module
+[  120.123456] NVRM: GPU 0000:d8:00.0: GPU reset initiated
+`
+	events := parseGPUResetEvents(output)
+	if len(events) != 2 {
+		t.Fatalf("Expected reset events for 2 GPUs, got %d", len(events))
+	}
+	if events["0000:0f:00"].count != 2 || events["0000:0f:00"].lastSeen.Format("15:04:05") != "11:45:10" {
+		t.Errorf("GPU 0000:0f:00 events = %+v, want 2 resets with the last at 11:45:10", events["0000:0f:00"])
+	}
+	if events["0000:d8:00"].count != 1 || !events["0000:d8:00"].lastSeen.IsZero() {
+		t.Errorf("GPU 0000:d8:00 events = %+v, want 1 reset without a timestamp", events["0000:d8:00"])
+	}
+}
+
+// Test parseGPUResetCounter function
+func TestParseGPUResetCounter(t *testing.T) {
+	if count, ok := parseGPUResetCounter("Model: \t\t NVIDIA H100 80GB HBM3\nReset Count:\t 4\n"); !ok || count != 4 {
+		t.Errorf("parseGPUResetCounter() = %d, %v, want 4", count, ok)
+	}
+	if _, ok := parseGPUResetCounter("Model: \t\t NVIDIA H100 80GB HBM3\n"); ok {
+		t.Error("Expected no reset counter")
+	}
+}
+
+// Test parseGPUResetInfo, applyGPUResetEvents and validateGPUResets functions
+func TestValidateGPUResets(t *testing.T) {
+	gpus, resetRequired, err := parseGPUResetInfo("0, 00000000:0F:00.0, 5\n1, 00000000:2D:00.0, 5\n2, 00000000:44:00.0, [GPU requires reset]\n")
+	if err != nil {
+		t.Fatalf("parseGPUResetInfo() error = %v", err)
+	}
+	if len(gpus) != 3 || gpus[1].BusID != "0000:2d:00" || len(resetRequired) != 1 || resetRequired[0] != "2" {
+		t.Fatalf("parseGPUResetInfo() = %+v, %v", gpus, resetRequired)
+	}
+
+	events := parseGPUResetEvents("2025-01-15T10:30:00,000000+00:00 NVRM: GPU Board at PCI:0000:0f:00: GPU reset\n")
+	applyGPUResetEvents(gpus, events, map[string]int{"0000:2d:00": 3})
+	if gpus[0].ResetCount != 1 || gpus[0].LastResetUTC != "2025-01-15T10:30:00Z" {
+		t.Errorf("GPU 0 = %+v, want 1 reset at 2025-01-15T10:30:00Z", gpus[0])
+	}
+	if gpus[1].ResetCount != 3 {
+		t.Errorf("GPU 1 reset count = %d, want the driver counter 3", gpus[1].ResetCount)
+	}
+
+	status, err := validateGPUResets(gpus, nil, 2)
+	if status != "FAIL" || err == nil || !strings.Contains(err.Error(), "reset more than 2 times since boot: 1 (3 resets)") {
+		t.Errorf("validateGPUResets() = %s, %v, want FAIL for GPU 1", status, err)
+	}
+	if gpus[0].Status != "PASS" || gpus[1].Status != "FAIL" {
+		t.Errorf("GPU statuses = %s, %s, want PASS, FAIL", gpus[0].Status, gpus[1].Status)
+	}
+
+	if status, err := validateGPUResets(gpus, nil, 3); status != "PASS" || err != nil {
+		t.Errorf("validateGPUResets() = %s, %v, want PASS at the threshold", status, err)
+	}
+	if status, err := validateGPUResets(gpus, resetRequired, 3); status != "FAIL" || err == nil || !strings.Contains(err.Error(), "require a reset: 2") {
+		t.Errorf("validateGPUResets() = %s, %v, want FAIL for the GPU requiring a reset", status, err)
+	}
+	if status, err := validateGPUResets(nil, nil, 2); status != "FAIL" || err == nil {
+		t.Errorf("validateGPUResets(nil) = %s, %v, want FAIL", status, err)
+	}
+}
@@ -0,0 +1,217 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPURowRemapCheckTestConfig represents the config needed to run this test.
+// MaxPendingGPUs and MaxFailedGPUs are the number of GPUs allowed to report a pending
+// remap or a remap failure before the check WARNs or FAILs.
+type GPURowRemapCheckTestConfig struct {
+	IsEnabled      bool   `json:"enabled"`
+	Shape          string `json:"shape"`
+	MaxPendingGPUs int    `json:"max_pending_gpus"`
+	MaxFailedGPUs  int    `json:"max_failed_gpus"`
+}
+
+// GPURowRemapInfo represents the row remapper state of a single GPU
+type GPURowRemapInfo struct {
+	Index     string `json:"index"`
+	BusID     string `json:"bus_id"`
+	Pending   bool   `json:"pending"`
+	Failure   bool   `json:"failure"`
+	Supported bool   `json:"supported"`
+	Status    string `json:"status"`
+}
+
+// getGPURowRemapCheckTestConfig gets test config needed to run this test
+func getGPURowRemapCheckTestConfig() (*GPURowRemapCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuRowRemapCheckTestConfig := &GPURowRemapCheckTestConfig{
+		IsEnabled:      false,
+		Shape:          shape,
+		MaxPendingGPUs: 0,
+		MaxFailedGPUs:  0,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_row_remap_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuRowRemapCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_row_remap_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if maxPending, ok := thresholdMap["max_pending_gpus"].(float64); ok {
+				gpuRowRemapCheckTestConfig.MaxPendingGPUs = int(maxPending)
+			}
+			if maxFailed, ok := thresholdMap["max_failed_gpus"].(float64); ok {
+				gpuRowRemapCheckTestConfig.MaxFailedGPUs = int(maxFailed)
+			}
+		}
+	}
+
+	return gpuRowRemapCheckTestConfig, nil
+}
+
+// getGPURowRemapInfo uses nvidia-smi to get the row remapper state of every GPU
+func getGPURowRemapInfo() ([]GPURowRemapInfo, error) {
+	result := executor.RunNvidiaSMIQuery("index,pci.bus_id,row_remapper.pending,row_remapper.failure")
+	if !result.Available {
+		return nil, nvidiaSMIError("gpu_row_remap_check", "nvidia-smi --query-gpu=index,pci.bus_id,row_remapper.pending,row_remapper.failure", result)
+	}
+
+	output := strings.TrimSpace(result.Output)
+	if output == "" {
+		return nil, fmt.Errorf("no row remapper information returned from nvidia-smi")
+	}
+
+	return parseGPURowRemapInfo(output)
+}
+
+// parseGPURowRemapInfo parses nvidia-smi "index, pci.bus_id, row_remapper.pending, row_remapper.failure" CSV output.
+// GPUs without row remapping report "[N/A]" and are marked as not supported.
+func parseGPURowRemapInfo(output string) ([]GPURowRemapInfo, error) {
+	var gpus []GPURowRemapInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 4 {
+			logger.Errorf("Invalid GPU row remap info line: %s", line)
+			return nil, fmt.Errorf("invalid GPU row remap info line: %s", line)
+		}
+
+		pending := strings.TrimSpace(parts[2])
+		failure := strings.TrimSpace(parts[3])
+		gpus = append(gpus, GPURowRemapInfo{
+			Index:     strings.TrimSpace(parts[0]),
+			BusID:     strings.TrimSpace(parts[1]),
+			Pending:   strings.EqualFold(pending, "Yes"),
+			Failure:   strings.EqualFold(failure, "Yes"),
+			Supported: isRowRemapValue(pending) && isRowRemapValue(failure),
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU row remapper information found")
+	}
+
+	return gpus, nil
+}
+
+// isRowRemapValue reports whether a row remapper field holds a Yes/No value
+func isRowRemapValue(value string) bool {
+	return strings.EqualFold(value, "Yes") || strings.EqualFold(value, "No")
+}
+
+// validateGPURowRemaps sets the per-GPU status and returns the overall status.
+// More remap failures than MaxFailedGPUs FAIL, more pending remaps than MaxPendingGPUs WARN.
+func validateGPURowRemaps(gpus []GPURowRemapInfo, testConfig *GPURowRemapCheckTestConfig) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU row remapper information found")
+	}
+
+	var failedGPUs, pendingGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		switch {
+		case gpu.Failure:
+			gpu.Status = "FAIL"
+			failedGPUs = append(failedGPUs, gpu.Index)
+		case gpu.Pending:
+			gpu.Status = "WARN"
+			pendingGPUs = append(pendingGPUs, gpu.Index)
+		default:
+			gpu.Status = "PASS"
+		}
+	}
+
+	if len(failedGPUs) > testConfig.MaxFailedGPUs {
+		return "FAIL", fmt.Errorf("row remap failure on GPU(s): %s", strings.Join(failedGPUs, ","))
+	}
+	if len(pendingGPUs) > testConfig.MaxPendingGPUs {
+		return "WARN", fmt.Errorf("row remap pending reboot on GPU(s): %s", strings.Join(pendingGPUs, ","))
+	}
+	return "PASS", nil
+}
+
+func RunGPURowRemapCheck() error {
+	logger.Info("=== GPU Row Remap Check ===")
+	testConfig, err := getGPURowRemapCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_row_remap_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU row remap check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU row remapper state
+	logger.Info("Step 1: Getting GPU row remapper state...")
+	gpus, err := getGPURowRemapInfo()
+	if err != nil {
+		logger.Error("GPU Row Remap Check: FAIL - Could not get GPU row remapper state:", err)
+		rep.AddGPURowRemapResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU row remapper state: %w", err)
+	}
+
+	// Step 2: Validate row remapper state against the shape thresholds
+	logger.Info("Step 2: Validating row remapper state...")
+	logger.Infof("Allowed GPUs with pending remaps: %d, with remap failures: %d", testConfig.MaxPendingGPUs, testConfig.MaxFailedGPUs)
+
+	status, validationErr := validateGPURowRemaps(gpus, testConfig)
+	for _, gpu := range gpus {
+		if !gpu.Supported {
+			logger.Infof("GPU %s (%s): row remapping not supported", gpu.Index, gpu.BusID)
+			continue
+		}
+		logger.Infof("GPU %s (%s): pending %t, failure %t - %s", gpu.Index, gpu.BusID, gpu.Pending, gpu.Failure, gpu.Status)
+	}
+
+	switch status {
+	case "PASS":
+		logger.Info("GPU Row Remap Check: PASS - No GPU row remap failures or pending remaps above threshold")
+		rep.AddGPURowRemapResult("PASS", gpus, nil)
+		return nil
+	case "WARN":
+		logger.Info("GPU Row Remap Check: WARN -", validationErr)
+		rep.AddGPURowRemapResult("WARN", gpus, validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU Row Remap Check: FAIL -", validationErr)
+		rep.AddGPURowRemapResult("FAIL", gpus, validationErr)
+		return validationErr
+	}
+}
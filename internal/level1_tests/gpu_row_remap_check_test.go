@@ -0,0 +1,83 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPURowRemapInfo function
+func TestParseGPURowRemapInfo(t *testing.T) {
+	output := `0, 00000000:0F:00.0, No, No
+1, 00000000:2D:00.0, Yes, No
+2, 00000000:44:00.0, [N/A], [N/A]`
+
+	gpus, err := parseGPURowRemapInfo(output)
+	if err != nil {
+		t.Fatalf("parseGPURowRemapInfo() error = %v", err)
+	}
+	if len(gpus) != 3 {
+		t.Fatalf("parseGPURowRemapInfo() returned %d GPUs, want 3", len(gpus))
+	}
+	if !gpus[1].Pending || gpus[1].Failure || gpus[1].BusID != "00000000:2D:00.0" {
+		t.Errorf("parseGPURowRemapInfo() GPU 1 = %+v", gpus[1])
+	}
+	if gpus[2].Supported || gpus[2].Pending || gpus[2].Failure {
+		t.Errorf("parseGPURowRemapInfo() GPU 2 = %+v, want unsupported", gpus[2])
+	}
+
+	if _, err := parseGPURowRemapInfo("0, 00000000:0F:00.0, No"); err == nil {
+		t.Error("parseGPURowRemapInfo() expected error for short line")
+	}
+}
+
+// Test validateGPURowRemaps function
+func TestValidateGPURowRemaps(t *testing.T) {
+	tests := []struct {
+		name           string
+		gpus           []GPURowRemapInfo
+		config         *GPURowRemapCheckTestConfig
+		expectedStatus string
+	}{
+		{
+			name:           "No pending remaps or failures",
+			gpus:           []GPURowRemapInfo{{Index: "0"}, {Index: "1"}},
+			config:         &GPURowRemapCheckTestConfig{},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "Pending remap",
+			gpus:           []GPURowRemapInfo{{Index: "0"}, {Index: "1", Pending: true}},
+			config:         &GPURowRemapCheckTestConfig{},
+			expectedStatus: "WARN",
+		},
+		{
+			name:           "Pending remap within threshold",
+			gpus:           []GPURowRemapInfo{{Index: "0"}, {Index: "1", Pending: true}},
+			config:         &GPURowRemapCheckTestConfig{MaxPendingGPUs: 1},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "Remap failure",
+			gpus:           []GPURowRemapInfo{{Index: "0", Pending: true}, {Index: "1", Pending: true, Failure: true}},
+			config:         &GPURowRemapCheckTestConfig{},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "No GPUs",
+			gpus:           []GPURowRemapInfo{},
+			config:         &GPURowRemapCheckTestConfig{},
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPURowRemaps(tt.gpus, tt.config)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPURowRemaps() status = %s, want %s", status, tt.expectedStatus)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateGPURowRemaps() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUTemperatureThresholds represents the warning and critical temperatures in degrees Celsius
+// of the GPU core. GPU memory temperatures are checked by gpu_mem_temperature_check.
+type GPUTemperatureThresholds struct {
+	GPUWarningC  int `json:"gpu_warning_c"`
+	GPUCriticalC int `json:"gpu_critical_c"`
+}
+
+// GPUTemperatureCheckTestConfig represents the config needed to run this test
+type GPUTemperatureCheckTestConfig struct {
+	IsEnabled  bool                     `json:"enabled"`
+	Shape      string                   `json:"shape"`
+	Thresholds GPUTemperatureThresholds `json:"thresholds"`
+}
+
+// GPUTemperatureInfo represents the core temperature of a single GPU
+type GPUTemperatureInfo struct {
+	Index    string `json:"index"`
+	BusID    string `json:"bus_id"`
+	GPUTempC int    `json:"gpu_temp_c"`
+	Status   string `json:"status"`
+}
+
+// getGPUTemperatureCheckTestConfig gets test config needed to run this test
+func getGPUTemperatureCheckTestConfig() (*GPUTemperatureCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults (H100 values)
+	gpuTemperatureCheckTestConfig := &GPUTemperatureCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+		Thresholds: GPUTemperatureThresholds{
+			GPUWarningC:  80,
+			GPUCriticalC: 90,
+		},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_temperature_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuTemperatureCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_temperature_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			thresholds := &gpuTemperatureCheckTestConfig.Thresholds
+			for key, value := range map[string]*int{
+				"gpu_warning_c":  &thresholds.GPUWarningC,
+				"gpu_critical_c": &thresholds.GPUCriticalC,
+			} {
+				if v, ok := thresholdMap[key].(float64); ok {
+					*value = int(v)
+				}
+			}
+		}
+	}
+
+	return gpuTemperatureCheckTestConfig, nil
+}
+
+// parseGPUTemperatures parses nvidia-smi "index, pci.bus_id, temperature.gpu" CSV output
+func parseGPUTemperatures(output string) ([]GPUTemperatureInfo, error) {
+	var gpus []GPUTemperatureInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 3 {
+			logger.Errorf("Invalid GPU temperature line: %s", line)
+			return nil, fmt.Errorf("invalid GPU temperature line: %s", line)
+		}
+
+		gpu := GPUTemperatureInfo{
+			Index: strings.TrimSpace(parts[0]),
+			BusID: sysfsPCIAddress(strings.TrimSpace(parts[1])),
+		}
+		gpuTemp, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid temperature %q for GPU %s: %w", strings.TrimSpace(parts[2]), gpu.Index, err)
+		}
+		gpu.GPUTempC = gpuTemp
+
+		gpus = append(gpus, gpu)
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU temperatures found")
+	}
+
+	return gpus, nil
+}
+
+// validateGPUTemperatures sets the per-GPU status and returns the overall status.
+// A GPU core temperature at or above the critical threshold FAILs, at or above the warning threshold WARNs.
+func validateGPUTemperatures(gpus []GPUTemperatureInfo, thresholds GPUTemperatureThresholds) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU temperatures found")
+	}
+
+	var criticalGPUs, hotGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		switch {
+		case gpu.GPUTempC >= thresholds.GPUCriticalC:
+			gpu.Status = "FAIL"
+			criticalGPUs = append(criticalGPUs, fmt.Sprintf("%s (%d°C)", gpu.Index, gpu.GPUTempC))
+		case gpu.GPUTempC >= thresholds.GPUWarningC:
+			gpu.Status = "WARN"
+			hotGPUs = append(hotGPUs, fmt.Sprintf("%s (%d°C)", gpu.Index, gpu.GPUTempC))
+		default:
+			gpu.Status = "PASS"
+		}
+	}
+
+	if len(criticalGPUs) > 0 {
+		return "FAIL", &testerrors.TestThresholdExceededError{TestName: "gpu_temperature_check", Metric: "temperature_c",
+			Actual: strings.Join(criticalGPUs, ", "), Expected: fmt.Sprintf("below %d°C", thresholds.GPUCriticalC)}
+	}
+	if len(hotGPUs) > 0 {
+		return "WARN", &testerrors.TestThresholdExceededError{TestName: "gpu_temperature_check", Metric: "temperature_c",
+			Actual: strings.Join(hotGPUs, ", "), Expected: fmt.Sprintf("below %d°C", thresholds.GPUWarningC)}
+	}
+	return "PASS", nil
+}
+
+// RunGPUTemperatureCheck checks the core temperature of every GPU
+func RunGPUTemperatureCheck() error {
+	logger.Info("=== GPU Temperature Check ===")
+	testConfig, err := getGPUTemperatureCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_temperature_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU temperature check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU temperatures
+	logger.Info("Step 1: Getting GPU temperatures...")
+	result := executor.RunNvidiaSMIQuery("index,pci.bus_id,temperature.gpu")
+	if !result.Available {
+		err = nvidiaSMIError("gpu_temperature_check", "nvidia-smi --query-gpu=index,pci.bus_id,temperature.gpu", result)
+		logger.Error("GPU Temperature Check: FAIL - Could not get GPU temperatures:", err)
+		rep.AddGPUTemperatureResult("FAIL", nil, testConfig.Thresholds, err)
+		return fmt.Errorf("could not get GPU temperatures: %w", err)
+	}
+	gpus, err := parseGPUTemperatures(result.Output)
+	if err != nil {
+		logger.Error("GPU Temperature Check: FAIL - Could not parse GPU temperatures:", err)
+		rep.AddGPUTemperatureResult("FAIL", nil, testConfig.Thresholds, err)
+		return fmt.Errorf("could not parse GPU temperatures: %w", err)
+	}
+
+	// Step 2: Validate temperatures
+	logger.Info("Step 2: Validating GPU temperatures...")
+	status, validationErr := validateGPUTemperatures(gpus, testConfig.Thresholds)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s (%s): %d°C - %s", gpu.Index, gpu.BusID, gpu.GPUTempC, gpu.Status)
+	}
+	rep.AddGPUTemperatureResult(status, gpus, testConfig.Thresholds, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("GPU Temperature Check: PASS - All GPUs below %d°C", testConfig.Thresholds.GPUWarningC)
+		return nil
+	case "WARN":
+		logger.Info("GPU Temperature Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU Temperature Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
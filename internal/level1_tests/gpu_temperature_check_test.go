@@ -0,0 +1,58 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPUTemperatures function with nvidia-smi CSV output
+func TestParseGPUTemperatures(t *testing.T) {
+	output := "0, 00000000:0F:00.0, 34\n1, 00000000:2D:00.0, 36\n"
+
+	gpus, err := parseGPUTemperatures(output)
+	if err != nil {
+		t.Fatalf("parseGPUTemperatures() error = %v", err)
+	}
+	if len(gpus) != 2 {
+		t.Fatalf("parseGPUTemperatures() returned %d GPUs, want 2", len(gpus))
+	}
+	if gpus[0].BusID != "0000:0f:00.0" || gpus[0].GPUTempC != 34 {
+		t.Errorf("parseGPUTemperatures() GPU 0 = %+v", gpus[0])
+	}
+	if gpus[1].GPUTempC != 36 {
+		t.Errorf("parseGPUTemperatures() GPU 1 = %+v", gpus[1])
+	}
+
+	for _, invalid := range []string{"", "0, 00000000:0F:00.0", "0, 00000000:0F:00.0, [N/A]"} {
+		if _, err := parseGPUTemperatures(invalid); err == nil {
+			t.Errorf("parseGPUTemperatures(%q) expected error", invalid)
+		}
+	}
+}
+
+// Test validateGPUTemperatures function
+func TestValidateGPUTemperatures(t *testing.T) {
+	thresholds := GPUTemperatureThresholds{GPUWarningC: 80, GPUCriticalC: 90}
+
+	tests := []struct {
+		name           string
+		gpus           []GPUTemperatureInfo
+		expectedStatus string
+	}{
+		{"all cool", []GPUTemperatureInfo{{Index: "0", GPUTempC: 34}, {Index: "1", GPUTempC: 79}}, "PASS"},
+		{"GPU above warning", []GPUTemperatureInfo{{Index: "0", GPUTempC: 80}}, "WARN"},
+		{"GPU above critical", []GPUTemperatureInfo{{Index: "0", GPUTempC: 92}, {Index: "1", GPUTempC: 85}}, "FAIL"},
+		{"no GPUs", nil, "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateGPUTemperatures(tt.gpus, thresholds)
+			if status != tt.expectedStatus {
+				t.Errorf("validateGPUTemperatures() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateGPUTemperatures() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// GPUVBIOSCheckTestConfig represents the config needed to run this test
+type GPUVBIOSCheckTestConfig struct {
+	IsEnabled           bool                `json:"enabled"`
+	Shape               string              `json:"shape"`
+	BlacklistedVersions []string            `json:"blacklisted_versions"`
+	SupportedVersions   map[string][]string `json:"supported_versions"`
+}
+
+// GPUVBIOSInfo represents the VBIOS version and validation status of a single GPU
+type GPUVBIOSInfo struct {
+	Index        string `json:"index"`
+	Model        string `json:"model"`
+	VBIOSVersion string `json:"vbios_version"`
+	Status       string `json:"status"`
+}
+
+// getGPUVBIOSCheckTestConfig gets test config needed to run this test
+func getGPUVBIOSCheckTestConfig() (*GPUVBIOSCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	gpuVBIOSCheckTestConfig := &GPUVBIOSCheckTestConfig{
+		IsEnabled:           false,
+		Shape:               shape,
+		BlacklistedVersions: []string{},
+		SupportedVersions:   map[string][]string{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "gpu_vbios_check")
+	if err != nil {
+		return nil, err
+	}
+	gpuVBIOSCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "gpu_vbios_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			// Update blacklisted versions if specified
+			if blacklisted, ok := thresholdMap["blacklisted_versions"].([]interface{}); ok {
+				for _, version := range blacklisted {
+					if versionStr, ok := version.(string); ok {
+						gpuVBIOSCheckTestConfig.BlacklistedVersions = append(gpuVBIOSCheckTestConfig.BlacklistedVersions, versionStr)
+					}
+				}
+			}
+
+			// Supported versions are keyed by GPU model name
+			if supported, ok := thresholdMap["supported_versions"].(map[string]interface{}); ok {
+				for model, versions := range supported {
+					versionList, ok := versions.([]interface{})
+					if !ok {
+						continue
+					}
+					for _, version := range versionList {
+						if versionStr, ok := version.(string); ok {
+							gpuVBIOSCheckTestConfig.SupportedVersions[model] = append(gpuVBIOSCheckTestConfig.SupportedVersions[model], versionStr)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return gpuVBIOSCheckTestConfig, nil
+}
+
+// getGPUVBIOSInfo uses nvidia-smi to get the VBIOS version of every GPU for the given test
+func getGPUVBIOSInfo(testName string) ([]GPUVBIOSInfo, error) {
+	result := executor.RunNvidiaSMIQuery("index,name,vbios_version")
+	if !result.Available {
+		return nil, nvidiaSMIError(testName, "nvidia-smi --query-gpu=index,name,vbios_version", result)
+	}
+
+	output := strings.TrimSpace(result.Output)
+	if output == "" {
+		return nil, fmt.Errorf("no VBIOS information returned from nvidia-smi")
+	}
+
+	return parseGPUVBIOSInfo(output)
+}
+
+// parseGPUVBIOSInfo parses nvidia-smi "index, name, vbios_version" CSV output
+func parseGPUVBIOSInfo(output string) ([]GPUVBIOSInfo, error) {
+	var gpus []GPUVBIOSInfo
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) < 3 {
+			logger.Errorf("Invalid GPU VBIOS info line: %s", line)
+			return nil, fmt.Errorf("invalid GPU VBIOS info line: %s", line)
+		}
+
+		gpus = append(gpus, GPUVBIOSInfo{
+			Index:        strings.TrimSpace(parts[0]),
+			Model:        strings.TrimSpace(parts[1]),
+			VBIOSVersion: strings.TrimSpace(parts[2]),
+		})
+	}
+
+	if len(gpus) == 0 {
+		return nil, fmt.Errorf("no GPU VBIOS versions found")
+	}
+
+	return gpus, nil
+}
+
+// validateVBIOSVersions sets the per-GPU status and returns the overall status.
+// Blacklisted versions FAIL, versions missing from the approved list for the GPU model WARN.
+func validateVBIOSVersions(gpus []GPUVBIOSInfo, blacklisted []string, supported map[string][]string) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPU VBIOS versions found")
+	}
+
+	var blacklistedGPUs, unsupportedGPUs []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.Status = "PASS"
+
+		if containsString(blacklisted, gpu.VBIOSVersion) {
+			gpu.Status = "FAIL"
+			blacklistedGPUs = append(blacklistedGPUs, fmt.Sprintf("GPU %s %s", gpu.Index, gpu.VBIOSVersion))
+			continue
+		}
+
+		if !containsString(supported[gpu.Model], gpu.VBIOSVersion) {
+			gpu.Status = "WARN"
+			unsupportedGPUs = append(unsupportedGPUs, fmt.Sprintf("GPU %s %s", gpu.Index, gpu.VBIOSVersion))
+		}
+	}
+
+	if len(blacklistedGPUs) > 0 {
+		return "FAIL", &testerrors.TestThresholdExceededError{TestName: "gpu_vbios_check", Metric: "vbios_version",
+			Actual: strings.Join(blacklistedGPUs, ", "), Expected: "not blacklisted"}
+	}
+	if len(unsupportedGPUs) > 0 {
+		return "WARN", &testerrors.TestThresholdExceededError{TestName: "gpu_vbios_check", Metric: "vbios_version",
+			Actual: strings.Join(unsupportedGPUs, ", "), Expected: "approved version"}
+	}
+	return "PASS", nil
+}
+
+// containsString reports whether value is present in list
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func RunGPUVBIOSCheck() error {
+	logger.Info("=== GPU VBIOS Check ===")
+	testConfig, err := getGPUVBIOSCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_vbios_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU VBIOS version check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU VBIOS versions
+	logger.Info("Step 1: Getting GPU VBIOS versions...")
+	gpus, err := getGPUVBIOSInfo("gpu_vbios_check")
+	if err != nil {
+		logger.Error("GPU VBIOS Check: FAIL - Could not get GPU VBIOS versions:", err)
+		rep.AddGPUVBIOSResult("FAIL", nil, err)
+		return fmt.Errorf("could not get GPU VBIOS versions: %w", err)
+	}
+
+	// Step 2: Validate VBIOS versions
+	logger.Info("Step 2: Validating VBIOS versions...")
+	logger.Info("Blacklisted versions:", testConfig.BlacklistedVersions)
+	logger.Info("Supported versions:", testConfig.SupportedVersions)
+
+	status, validationErr := validateVBIOSVersions(gpus, testConfig.BlacklistedVersions, testConfig.SupportedVersions)
+	for _, gpu := range gpus {
+		logger.Infof("GPU %s (%s): VBIOS %s - %s", gpu.Index, gpu.Model, gpu.VBIOSVersion, gpu.Status)
+	}
+
+	switch status {
+	case "PASS":
+		logger.Info("GPU VBIOS Check: PASS - All GPU VBIOS versions are approved")
+		rep.AddGPUVBIOSResult("PASS", gpus, nil)
+		return nil
+	case "WARN":
+		logger.Info("GPU VBIOS Check: WARN -", validationErr)
+		rep.AddGPUVBIOSResult("WARN", gpus, validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("GPU VBIOS Check: FAIL -", validationErr)
+		rep.AddGPUVBIOSResult("FAIL", gpus, validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,136 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseGPUVBIOSInfo function
+func TestParseGPUVBIOSInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name:          "Single GPU",
+			input:         "0, NVIDIA H100 80GB HBM3, 96.00.89.00.01",
+			expectedCount: 1,
+			expectError:   false,
+		},
+		{
+			name:          "Multiple GPUs with blank lines",
+			input:         "0, NVIDIA H100 80GB HBM3, 96.00.89.00.01\n\n1, NVIDIA H100 80GB HBM3, 96.00.89.00.01\n",
+			expectedCount: 2,
+			expectError:   false,
+		},
+		{
+			name:        "Malformed line",
+			input:       "0, NVIDIA H100 80GB HBM3",
+			expectError: true,
+		},
+		{
+			name:        "Empty output",
+			input:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gpus, err := parseGPUVBIOSInfo(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseGPUVBIOSInfo() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if !tt.expectError && len(gpus) != tt.expectedCount {
+				t.Errorf("parseGPUVBIOSInfo() returned %d GPUs, want %d", len(gpus), tt.expectedCount)
+			}
+		})
+	}
+
+	gpus, _ := parseGPUVBIOSInfo("3, NVIDIA H100 80GB HBM3, 96.00.89.00.01")
+	if gpus[0].Index != "3" || gpus[0].Model != "NVIDIA H100 80GB HBM3" || gpus[0].VBIOSVersion != "96.00.89.00.01" {
+		t.Errorf("parseGPUVBIOSInfo() parsed unexpected fields: %+v", gpus[0])
+	}
+}
+
+// Test validateVBIOSVersions function
+func TestValidateVBIOSVersions(t *testing.T) {
+	blacklisted := []string{"96.00.30.00.01"}
+	supported := map[string][]string{
+		"NVIDIA H100 80GB HBM3": {"96.00.74.00.01", "96.00.89.00.01"},
+	}
+	h100 := "NVIDIA H100 80GB HBM3"
+
+	tests := []struct {
+		name           string
+		gpus           []GPUVBIOSInfo
+		expectedStatus string
+		expectedError  bool
+		expectedGPU    []string
+	}{
+		{
+			name:           "No GPUs",
+			gpus:           []GPUVBIOSInfo{},
+			expectedStatus: "FAIL",
+			expectedError:  true,
+		},
+		{
+			name: "All approved",
+			gpus: []GPUVBIOSInfo{
+				{Index: "0", Model: h100, VBIOSVersion: "96.00.89.00.01"},
+				{Index: "1", Model: h100, VBIOSVersion: "96.00.74.00.01"},
+			},
+			expectedStatus: "PASS",
+			expectedError:  false,
+			expectedGPU:    []string{"PASS", "PASS"},
+		},
+		{
+			name: "Unapproved version",
+			gpus: []GPUVBIOSInfo{
+				{Index: "0", Model: h100, VBIOSVersion: "96.00.89.00.01"},
+				{Index: "1", Model: h100, VBIOSVersion: "96.00.50.00.01"},
+			},
+			expectedStatus: "WARN",
+			expectedError:  true,
+			expectedGPU:    []string{"PASS", "WARN"},
+		},
+		{
+			name: "Unknown GPU model",
+			gpus: []GPUVBIOSInfo{
+				{Index: "0", Model: "NVIDIA B200", VBIOSVersion: "97.00.01.00.01"},
+			},
+			expectedStatus: "WARN",
+			expectedError:  true,
+			expectedGPU:    []string{"WARN"},
+		},
+		{
+			name: "Blacklisted version takes precedence",
+			gpus: []GPUVBIOSInfo{
+				{Index: "0", Model: h100, VBIOSVersion: "96.00.30.00.01"},
+				{Index: "1", Model: h100, VBIOSVersion: "96.00.50.00.01"},
+			},
+			expectedStatus: "FAIL",
+			expectedError:  true,
+			expectedGPU:    []string{"FAIL", "WARN"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateVBIOSVersions(tt.gpus, blacklisted, supported)
+
+			if status != tt.expectedStatus {
+				t.Errorf("validateVBIOSVersions() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("validateVBIOSVersions() error = %v, wantErr %v", err, tt.expectedError)
+			}
+			for i, want := range tt.expectedGPU {
+				if tt.gpus[i].Status != want {
+					t.Errorf("GPU %s status = %v, want %v", tt.gpus[i].Index, tt.gpus[i].Status, want)
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"os/exec"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -28,6 +30,7 @@ type GPUXIDCheckResult struct {
 	Message        string     `json:"message"`
 	CriticalErrors []XIDError `json:"critical_errors,omitempty"`
 	WarningErrors  []XIDError `json:"warning_errors,omitempty"`
+	InfoErrors     []XIDError `json:"info_errors,omitempty"`
 }
 
 // XIDErrorCode represents the structure of XID error codes in test_limits.json
@@ -105,6 +108,29 @@ func getGPUXIDCheckTestConfig(shape string) (*GPUXIDCheckTestConfig, error) {
 					}
 				}
 			}
+
+			// xid_codes sets the severity of specific XID codes for the shape: FAIL, WARN or INFO
+			if xidCodes, ok := thresholdMap["xid_codes"].([]interface{}); ok {
+				for _, xidCodeRaw := range xidCodes {
+					xidCodeMap, ok := xidCodeRaw.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					code, ok := xidCodeMap["code"].(float64)
+					if !ok {
+						continue
+					}
+					xidCode := strconv.Itoa(int(code))
+					xidError := gpuXIDTestConfig.XIDErrorCodes[xidCode]
+					if sev, ok := xidCodeMap["severity"].(string); ok {
+						xidError.Severity = sev
+					}
+					if desc, ok := xidCodeMap["description"].(string); ok {
+						xidError.Description = desc
+					}
+					gpuXIDTestConfig.XIDErrorCodes[xidCode] = xidError
+				}
+			}
 		}
 	}
 
@@ -112,83 +138,91 @@ func getGPUXIDCheckTestConfig(shape string) (*GPUXIDCheckTestConfig, error) {
 	return gpuXIDTestConfig, nil
 }
 
-// checkGPUXIDErrors checks for XID errors in dmesg output
-func checkGPUXIDErrors(xidErrorCodes map[string]XIDErrorCode) *GPUXIDCheckResult {
+// xidEventPattern matches XID errors in dmesg: NVRM: Xid (PCI:0000:3b:00): 79, pid=...
+var xidEventPattern = regexp.MustCompile(`NVRM: Xid \(PCI:([^)]+)\): (\d+),`)
+
+// xidSeverityStatus maps a configured XID severity to the test status it causes. Severities of
+// xid_codes are FAIL, WARN or INFO; xid_error_codes uses Critical and Warn.
+func xidSeverityStatus(severity string) string {
+	switch strings.ToUpper(severity) {
+	case "FAIL", "CRITICAL":
+		return "FAIL"
+	case "INFO":
+		return "INFO"
+	default:
+		return "WARN"
+	}
+}
+
+// parseXIDEvents returns the PCI addresses reporting each XID code found in dmesg output,
+// with one address per occurrence
+func parseXIDEvents(dmesgOutput string) map[string][]string {
+	events := make(map[string][]string)
+	for _, match := range xidEventPattern.FindAllStringSubmatch(dmesgOutput, -1) {
+		events[match[2]] = append(events[match[2]], match[1])
+	}
+	return events
+}
+
+// classifyXIDEvents looks up the configured severity of every detected XID code. Detected codes
+// that are not configured are reported as warnings, so unknown XIDs are not silently ignored.
+func classifyXIDEvents(events map[string][]string, xidErrorCodes map[string]XIDErrorCode) *GPUXIDCheckResult {
 	result := &GPUXIDCheckResult{
 		Status:         "PASS",
 		Message:        "No XID errors found in system logs",
 		CriticalErrors: []XIDError{},
 		WarningErrors:  []XIDError{},
 	}
-
-	// Get dmesg output
-	cmd := exec.Command("sudo", "dmesg")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		result.Status = "ERROR"
-		result.Message = fmt.Sprintf("Failed to get dmesg output: %v", err)
+	if len(events) == 0 {
 		return result
 	}
 
-	dmesgOutput := string(output)
-
-	// Check if any XID errors exist
-	if !strings.Contains(dmesgOutput, "NVRM: Xid") {
-		// No XID errors found
-		return result
+	// Report XID codes in numeric order
+	codes := make([]string, 0, len(events))
+	for code := range events {
+		codes = append(codes, code)
 	}
+	sort.Slice(codes, func(i, j int) bool {
+		a, _ := strconv.Atoi(codes[i])
+		b, _ := strconv.Atoi(codes[j])
+		return a < b
+	})
 
-	// Parse XID errors
-	criticalCount := 0
-	warningCount := 0
-
-	for xidCode, xidInfo := range xidErrorCodes {
-		// Pattern to match XID errors: NVRM: Xid (PCI:xxxx:xx:xx.x): <code>,
-		pattern := fmt.Sprintf(`NVRM: Xid \(PCI:([^:]+): %s,`, xidCode)
-		re, err := regexp.Compile(pattern)
-		if err != nil {
-			continue
+	for _, code := range codes {
+		xidInfo, configured := xidErrorCodes[code]
+		if !configured {
+			xidInfo = XIDErrorCode{Description: "XID code not configured for this shape", Severity: "WARN"}
 		}
 
-		matches := re.FindAllStringSubmatch(dmesgOutput, -1)
-		if len(matches) > 0 {
-			// Extract PCI addresses
-			pciAddrs := make([]string, 0, len(matches))
-			for _, match := range matches {
-				if len(match) > 1 {
-					pciAddrs = append(pciAddrs, match[1])
-				}
-			}
-
-			// Remove duplicates
-			pciAddrMap := make(map[string]bool)
-			for _, addr := range pciAddrs {
-				pciAddrMap[addr] = true
-			}
-			uniquePCIAddrs := make([]string, 0, len(pciAddrMap))
-			for addr := range pciAddrMap {
+		// Remove duplicate PCI addresses
+		var uniquePCIAddrs []string
+		seen := make(map[string]bool)
+		for _, addr := range events[code] {
+			if !seen[addr] {
+				seen[addr] = true
 				uniquePCIAddrs = append(uniquePCIAddrs, addr)
 			}
+		}
 
-			xidError := XIDError{
-				XIDCode:     xidCode,
-				Description: xidInfo.Description,
-				Severity:    xidInfo.Severity,
-				Count:       len(matches),
-				PCIAddrs:    uniquePCIAddrs,
-			}
-
-			if xidInfo.Severity == "Critical" {
-				result.CriticalErrors = append(result.CriticalErrors, xidError)
-				criticalCount++
-			} else {
-				result.WarningErrors = append(result.WarningErrors, xidError)
-				warningCount++
-			}
+		xidError := XIDError{
+			XIDCode:     code,
+			Description: xidInfo.Description,
+			Severity:    xidSeverityStatus(xidInfo.Severity),
+			Count:       len(events[code]),
+			PCIAddrs:    uniquePCIAddrs,
+		}
+		switch xidError.Severity {
+		case "FAIL":
+			result.CriticalErrors = append(result.CriticalErrors, xidError)
+		case "INFO":
+			result.InfoErrors = append(result.InfoErrors, xidError)
+		default:
+			result.WarningErrors = append(result.WarningErrors, xidError)
 		}
 	}
 
 	// Set result status and message based on findings
+	criticalCount, warningCount, infoCount := len(result.CriticalErrors), len(result.WarningErrors), len(result.InfoErrors)
 	if criticalCount > 0 {
 		result.Status = "FAIL"
 		result.Message = fmt.Sprintf("Critical XID errors detected: %d critical, %d warnings", criticalCount, warningCount)
@@ -196,14 +230,38 @@ func checkGPUXIDErrors(xidErrorCodes map[string]XIDErrorCode) *GPUXIDCheckResult
 		result.Status = "WARN"
 		result.Message = fmt.Sprintf("Warning XID errors detected: %d warnings", warningCount)
 	} else {
-		// XID messages found but no recognized error codes
-		result.Status = "WARN"
-		result.Message = "XID messages found but no recognized error codes"
+		result.Message = fmt.Sprintf("Only informational XID errors detected: %d informational", infoCount)
 	}
 
 	return result
 }
 
+// xidBreakdown returns the count and severity of every detected XID code
+func xidBreakdown(result *GPUXIDCheckResult) []reporter.XIDCodeBreakdown {
+	var breakdown []reporter.XIDCodeBreakdown
+	for _, xidErrors := range [][]XIDError{result.CriticalErrors, result.WarningErrors, result.InfoErrors} {
+		for _, xidError := range xidErrors {
+			breakdown = append(breakdown, reporter.XIDCodeBreakdown{XIDCode: xidError.XIDCode, Severity: xidError.Severity, Count: xidError.Count})
+		}
+	}
+	return breakdown
+}
+
+// checkGPUXIDErrors checks for XID errors in dmesg output
+func checkGPUXIDErrors(xidErrorCodes map[string]XIDErrorCode) *GPUXIDCheckResult {
+	// Get dmesg output
+	cmd := exec.Command("sudo", "dmesg")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return &GPUXIDCheckResult{
+			Status:  "ERROR",
+			Message: fmt.Sprintf("Failed to get dmesg output: %v", err),
+		}
+	}
+
+	return classifyXIDEvents(parseXIDEvents(string(output)), xidErrorCodes)
+}
+
 // RunGPUXIDCheck performs the GPU XID error check
 func RunGPUXIDCheck() error {
 	logger.Info("=== GPU XID Error Check ===")
@@ -236,7 +294,7 @@ func RunGPUXIDCheck() error {
 	if !gpuXIDTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "gpu_xid_check", Shape: shape}
 	}
 
 	// Step 3: Check for GPU XID errors
@@ -245,18 +303,23 @@ func RunGPUXIDCheck() error {
 
 	// Step 4: Report results
 	logger.Info("Step 4: Reporting results...")
+	breakdown := xidBreakdown(result)
+	for _, xid := range breakdown {
+		logger.Infof("XID %s: %d occurrence(s) - %s", xid.XIDCode, xid.Count, xid.Severity)
+	}
 	if result.Status == "PASS" {
 		logger.Info("GPU XID Check: PASS -", result.Message)
-		rep.AddGPUXIDResult("PASS", result, nil)
+		rep.AddGPUXIDDetailsResult("PASS", result, breakdown, nil)
 		return nil
 	} else if result.Status == "WARN" {
 		logger.Info("GPU XID Check: WARN -", result.Message)
-		rep.AddGPUXIDResult("WARN", result, nil)
-		return nil // Warnings are not fatal
+		err = fmt.Errorf("%s", result.Message)
+		rep.AddGPUXIDDetailsResult("WARN", result, breakdown, err)
+		return err
 	} else {
 		logger.Error("GPU XID Check: FAIL -", result.Message)
 		err = fmt.Errorf("%s", result.Message)
-		rep.AddGPUXIDResult("FAIL", result, err)
+		rep.AddGPUXIDDetailsResult("FAIL", result, breakdown, err)
 		return err
 	}
 }
\ No newline at end of file
@@ -1,7 +1,10 @@
 package level1_tests
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
 )
 
 func TestXIDError(t *testing.T) {
@@ -309,4 +312,123 @@ func TestGPUXIDCheckConfigurationParsing(t *testing.T) {
 	if xidErrorCodes["43"].Severity != "Warn" {
 		t.Errorf("Expected Warn severity for XID 43, got %s", xidErrorCodes["43"].Severity)
 	}
-}
\ No newline at end of file
+}
+
+func TestGetGPUXIDCheckTestConfigXIDCodes(t *testing.T) {
+	config, err := getGPUXIDCheckTestConfig("BM.GPU.H100.8")
+	if err != nil {
+		t.Fatalf("Failed to get GPU XID test config: %v", err)
+	}
+
+	// xid_codes overrides the severity of xid_error_codes and adds codes missing from it
+	expected := map[string]string{"74": "FAIL", "79": "FAIL", "94": "FAIL", "61": "WARN"}
+	for code, severity := range expected {
+		if config.XIDErrorCodes[code].Severity != severity {
+			t.Errorf("Expected severity %s for XID %s, got %s", severity, code, config.XIDErrorCodes[code].Severity)
+		}
+	}
+	if config.XIDErrorCodes["79"].Description != "GPU has fallen off the bus" {
+		t.Errorf("Expected the xid_error_codes description for XID 79, got %s", config.XIDErrorCodes["79"].Description)
+	}
+	if config.XIDErrorCodes["61"].Description == "" {
+		t.Error("Expected a description for XID 61")
+	}
+}
+
+func TestXIDSeverityStatus(t *testing.T) {
+	tests := map[string]string{
+		"FAIL":     "FAIL",
+		"Critical": "FAIL",
+		"WARN":     "WARN",
+		"Warn":     "WARN",
+		"INFO":     "INFO",
+		"":         "WARN",
+	}
+	for severity, expected := range tests {
+		if status := xidSeverityStatus(severity); status != expected {
+			t.Errorf("xidSeverityStatus(%q) = %s, want %s", severity, status, expected)
+		}
+	}
+}
+
+func TestParseXIDEvents(t *testing.T) {
+	dmesg := `[ 100.1] NVRM: Xid (PCI:0000:3b:00): 79, pid='<unknown>', name=<unknown>, GPU has fallen off the bus.
+[ 100.2] NVRM: Xid (PCI:0000:3b:00): 79, pid='<unknown>', name=<unknown>, GPU has fallen off the bus.
+[ 100.3] NVRM: Xid (PCI:0000:d8:00): 61, pid=1234, name=python, 0cec(3098): 00000000 00000000
+[ 100.4] mlx5_core 0000:0c:00.0: link up`
+
+	events := parseXIDEvents(dmesg)
+	expected := map[string][]string{
+		"79": {"0000:3b:00", "0000:3b:00"},
+		"61": {"0000:d8:00"},
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("parseXIDEvents() = %v, want %v", events, expected)
+	}
+}
+
+func TestClassifyXIDEvents(t *testing.T) {
+	xidCodes := map[string]XIDErrorCode{
+		"13": {Description: "Graphics Engine Exception", Severity: "INFO"},
+		"61": {Description: "Internal micro-controller breakpoint/warning", Severity: "WARN"},
+		"79": {Description: "GPU has fallen off the bus", Severity: "FAIL"},
+	}
+
+	tests := []struct {
+		name              string
+		events            map[string][]string
+		expectedStatus    string
+		expectedBreakdown []reporter.XIDCodeBreakdown
+	}{
+		{
+			name:           "No XID errors",
+			events:         map[string][]string{},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "Informational XID only",
+			events:         map[string][]string{"13": {"0000:3b:00"}},
+			expectedStatus: "PASS",
+			expectedBreakdown: []reporter.XIDCodeBreakdown{
+				{XIDCode: "13", Severity: "INFO", Count: 1},
+			},
+		},
+		{
+			name:           "Warning and unconfigured XIDs",
+			events:         map[string][]string{"61": {"0000:3b:00"}, "999": {"0000:d8:00"}},
+			expectedStatus: "WARN",
+			expectedBreakdown: []reporter.XIDCodeBreakdown{
+				{XIDCode: "61", Severity: "WARN", Count: 1},
+				{XIDCode: "999", Severity: "WARN", Count: 1},
+			},
+		},
+		{
+			name:           "Critical XID",
+			events:         map[string][]string{"79": {"0000:3b:00", "0000:3b:00"}, "61": {"0000:d8:00"}, "13": {"0000:d8:00"}},
+			expectedStatus: "FAIL",
+			expectedBreakdown: []reporter.XIDCodeBreakdown{
+				{XIDCode: "79", Severity: "FAIL", Count: 2},
+				{XIDCode: "61", Severity: "WARN", Count: 1},
+				{XIDCode: "13", Severity: "INFO", Count: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := classifyXIDEvents(tt.events, xidCodes)
+			if result.Status != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s (%s)", tt.expectedStatus, result.Status, result.Message)
+			}
+			if breakdown := xidBreakdown(result); !reflect.DeepEqual(breakdown, tt.expectedBreakdown) {
+				t.Errorf("Expected breakdown %+v, got %+v", tt.expectedBreakdown, breakdown)
+			}
+		})
+	}
+
+	// Occurrences on the same GPU are counted but its PCI address is listed once
+	result := classifyXIDEvents(map[string][]string{"79": {"0000:3b:00", "0000:3b:00"}}, xidCodes)
+	if len(result.CriticalErrors) != 1 || result.CriticalErrors[0].Count != 2 || len(result.CriticalErrors[0].PCIAddrs) != 1 {
+		t.Errorf("Expected one critical XID 79 with 2 occurrences on one GPU, got %+v", result.CriticalErrors)
+	}
+}
@@ -1,11 +1,15 @@
 package level1_tests
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
-	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+	"regexp"
 	"strings"
 
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -59,6 +63,141 @@ func parseDmesgForMLX5FatalErrors(output string) []string {
 	return mlx5FatalLines
 }
 
+// MLX5 error severities used by the classification table
+const (
+	MLX5SeverityCritical = "critical"
+	MLX5SeverityWarning  = "warning"
+	MLX5SeverityInfo     = "info"
+)
+
+// defaultMaxCriticalMessages is the number of most recent critical messages kept in the report
+const defaultMaxCriticalMessages = 5
+
+// MLX5ErrorClassification maps MLX5 kernel messages matching Pattern to a severity
+type MLX5ErrorClassification struct {
+	Pattern     string `json:"pattern"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+
+	regex *regexp.Regexp
+}
+
+// MLX5ErrorConfig represents the MLX5 error classification table in mlx5_errors.json
+type MLX5ErrorConfig struct {
+	DefaultSeverity     string                    `json:"default_severity"`
+	MaxCriticalMessages int                       `json:"max_critical_messages"`
+	Classifications     []MLX5ErrorClassification `json:"classifications"`
+}
+
+// MLX5ErrorSummary represents the MLX5 kernel messages found in dmesg grouped by severity
+type MLX5ErrorSummary struct {
+	CriticalCount  int      `json:"critical_count"`
+	WarningCount   int      `json:"warning_count"`
+	InfoCount      int      `json:"info_count"`
+	CriticalErrors []string `json:"critical_errors"`
+}
+
+// defaultMLX5ErrorConfig is used when mlx5_errors.json cannot be found.
+// Without a classification table every fatal MLX5 entry is critical.
+func defaultMLX5ErrorConfig() *MLX5ErrorConfig {
+	return &MLX5ErrorConfig{
+		DefaultSeverity:     MLX5SeverityCritical,
+		MaxCriticalMessages: defaultMaxCriticalMessages,
+	}
+}
+
+// parseMLX5ErrorConfig parses and validates an MLX5 error classification table
+func parseMLX5ErrorConfig(data []byte) (*MLX5ErrorConfig, error) {
+	config := defaultMLX5ErrorConfig()
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse MLX5 error classification config: %w", err)
+	}
+
+	if config.MaxCriticalMessages <= 0 {
+		config.MaxCriticalMessages = defaultMaxCriticalMessages
+	}
+	config.DefaultSeverity = strings.ToLower(config.DefaultSeverity)
+	if !isValidMLX5Severity(config.DefaultSeverity) {
+		return nil, fmt.Errorf("invalid default MLX5 error severity: %s", config.DefaultSeverity)
+	}
+
+	for i := range config.Classifications {
+		classification := &config.Classifications[i]
+		classification.Severity = strings.ToLower(classification.Severity)
+		if !isValidMLX5Severity(classification.Severity) {
+			return nil, fmt.Errorf("invalid severity %q for MLX5 error pattern %q", classification.Severity, classification.Pattern)
+		}
+		regex, err := regexp.Compile("(?i)" + classification.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MLX5 error pattern %q: %w", classification.Pattern, err)
+		}
+		classification.regex = regex
+	}
+
+	return config, nil
+}
+
+// isValidMLX5Severity reports whether severity is one of the supported MLX5 error severities
+func isValidMLX5Severity(severity string) bool {
+	return severity == MLX5SeverityCritical || severity == MLX5SeverityWarning || severity == MLX5SeverityInfo
+}
+
+// loadMLX5ErrorConfig loads the MLX5 error classification table, falling back to the default
+// table when no mlx5_errors.json is installed
+func loadMLX5ErrorConfig() (*MLX5ErrorConfig, error) {
+	data, path, err := config.FindConfigFile("mlx5_errors.json")
+	if err != nil {
+		logger.Debugf("MLX5 error classification config not found, treating all fatal errors as critical: %v", err)
+		return defaultMLX5ErrorConfig(), nil
+	}
+
+	logger.Infof("Loading MLX5 error classification config from: %s", path)
+	return parseMLX5ErrorConfig(data)
+}
+
+// classifyMLX5Error returns the severity of an MLX5 kernel message, or "" when it is not an error.
+// The first matching classification wins; unmatched fatal entries get the default severity.
+func classifyMLX5Error(line string, config *MLX5ErrorConfig) string {
+	for _, classification := range config.Classifications {
+		if classification.regex != nil && classification.regex.MatchString(line) {
+			return classification.Severity
+		}
+	}
+	if strings.Contains(strings.ToLower(line), "fatal") {
+		return config.DefaultSeverity
+	}
+	return ""
+}
+
+// classifyDmesgMLX5Errors classifies every MLX5 kernel message in the dmesg output and keeps
+// the most recent critical messages
+func classifyDmesgMLX5Errors(output string, config *MLX5ErrorConfig) *MLX5ErrorSummary {
+	summary := &MLX5ErrorSummary{CriticalErrors: []string{}}
+
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.Contains(strings.ToLower(line), "mlx5") {
+			continue
+		}
+
+		switch classifyMLX5Error(line, config) {
+		case MLX5SeverityCritical:
+			summary.CriticalCount++
+			summary.CriticalErrors = append(summary.CriticalErrors, strings.TrimSpace(line))
+		case MLX5SeverityWarning:
+			summary.WarningCount++
+		case MLX5SeverityInfo:
+			summary.InfoCount++
+		}
+	}
+
+	// dmesg is in chronological order, so the most recent messages are at the end
+	if len(summary.CriticalErrors) > config.MaxCriticalMessages {
+		summary.CriticalErrors = summary.CriticalErrors[len(summary.CriticalErrors)-config.MaxCriticalMessages:]
+	}
+
+	return summary
+}
+
 func RunHCAErrorCheck() error {
 	logger.Info("=== HCA Error Check ===")
 	testConfig, err := getHcaErrorCheckTestConfig()
@@ -69,44 +208,60 @@ func RunHCAErrorCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "hca_error_check", Shape: testConfig.Shape}
 	}
 
 	logger.Info("Starting HCA error check...")
 	logger.Info("This will take about 1 minute to complete.")
 	rep := reporter.GetReporter()
 
-	// Run the dmesg command to get MLX5 fatal error messages
-	// dmesg -T shows timestamped kernel messages, filtered for mlx5 and Fatal errors
-	logger.Info("Checking for MLX5 fatal errors...")
+	// Load the MLX5 error classification table
+	mlx5Config, err := loadMLX5ErrorConfig()
+	if err != nil {
+		logger.Error("HCA Error Check: FAIL - Could not load MLX5 error classification config:", err)
+		rep.AddHCAErrorResult("FAIL", 0, 0, nil, err)
+		return err
+	}
+
+	// Run the dmesg command to get MLX5 error messages
+	// dmesg -T shows timestamped kernel messages
+	logger.Info("Checking for MLX5 errors...")
 	result, err := executor.RunDmesg("-T")
 	if err != nil {
 		logger.Error("Failed to run dmesg command:", err)
 		logger.Info("HCA Error Check: FAIL - Could not run dmesg command")
-		rep.AddHCAResult("FAIL", fmt.Errorf("could not run dmesg command: %v", err))
-		return fmt.Errorf("could not run dmesg command: %v", err)
+		err = commandError("hca_error_check", result, err)
+		rep.AddHCAResult("FAIL", err)
+		return err
 	}
 
-	// Parse the dmesg output for MLX5 fatal errors
-	mlx5FatalLines := parseDmesgForMLX5FatalErrors(result.Output)
+	// Classify the MLX5 messages by severity
+	summary := classifyDmesgMLX5Errors(result.Output, mlx5Config)
+	logger.Infof("MLX5 errors: %d critical, %d warning, %d info", summary.CriticalCount, summary.WarningCount, summary.InfoCount)
 
-	// For HCA check: if we get ANY output, that means fatal errors were found
-	// This is opposite logic from PCIe check
-	if len(mlx5FatalLines) > 0 {
-		// Found fatal errors - check fails
-		logger.Error("Found MLX5 fatal errors:")
-		for _, line := range mlx5FatalLines {
+	if summary.CriticalCount > 0 {
+		// Found critical errors - check fails
+		logger.Error("Found critical MLX5 errors:")
+		for _, line := range summary.CriticalErrors {
 			logger.Error(line)
 		}
-		logger.Info("HCA Error Check: FAIL - MLX5 fatal errors found")
-		err = fmt.Errorf("found MLX5 fatal errors: %d errors detected", len(mlx5FatalLines))
-		rep.AddHCAResult("FAIL", err)
+		logger.Info("HCA Error Check: FAIL - Critical MLX5 errors found")
+		err = fmt.Errorf("found critical MLX5 errors: %d errors detected", summary.CriticalCount)
+		rep.AddHCAErrorResult("FAIL", summary.CriticalCount, summary.WarningCount, summary.CriticalErrors, err)
 		return err
 	}
 
-	// No fatal errors found - check passes
+	// Warning entries do not require immediate action
+	if summary.WarningCount > 0 {
+		err = fmt.Errorf("found MLX5 warnings: %d warnings detected", summary.WarningCount)
+		logger.Info("HCA Error Check: WARN -", err)
+		rep.AddHCAErrorResult("WARN", 0, summary.WarningCount, nil, err)
+		return err
+	}
+
+	// No critical or warning errors found - check passes
 	logger.Info("No MLX5 fatal errors found")
 	logger.Info("HCA Error Check: PASS")
 	rep.AddHCAResult("PASS", nil)
 	return nil
-}
\ No newline at end of file
+}
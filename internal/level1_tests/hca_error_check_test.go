@@ -1,6 +1,8 @@
 package level1_tests
 
 import (
+	"os"
+	"reflect"
 	"testing"
 )
 
@@ -75,4 +77,121 @@ func BenchmarkParseDmesgForMLX5FatalErrors(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		parseDmesgForMLX5FatalErrors(input)
 	}
-}
\ No newline at end of file
+}
+
+func TestParseMLX5ErrorConfig(t *testing.T) {
+	config, err := parseMLX5ErrorConfig([]byte(`{
+		"default_severity": "Critical",
+		"classifications": [
+			{"pattern": "fw_fatal.*recovered", "severity": "warning"},
+			{"pattern": "cable unplugged", "severity": "WARNING"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("parseMLX5ErrorConfig() error = %v", err)
+	}
+	if config.DefaultSeverity != MLX5SeverityCritical {
+		t.Errorf("Expected default severity critical, got %s", config.DefaultSeverity)
+	}
+	if config.MaxCriticalMessages != defaultMaxCriticalMessages {
+		t.Errorf("Expected max critical messages %d, got %d", defaultMaxCriticalMessages, config.MaxCriticalMessages)
+	}
+	if config.Classifications[1].Severity != MLX5SeverityWarning {
+		t.Errorf("Expected severity to be lowercased, got %s", config.Classifications[1].Severity)
+	}
+
+	invalid := []string{
+		`{"classifications": [{"pattern": "fatal", "severity": "severe"}]}`,
+		`{"classifications": [{"pattern": "fatal(", "severity": "critical"}]}`,
+		`{"default_severity": "unknown"}`,
+		`not json`,
+	}
+	for _, data := range invalid {
+		if _, err := parseMLX5ErrorConfig([]byte(data)); err == nil {
+			t.Errorf("Expected error for config %s", data)
+		}
+	}
+}
+
+func TestLoadMLX5ErrorConfig_RepoConfig(t *testing.T) {
+	data, err := os.ReadFile("../../configs/mlx5_errors.json")
+	if err != nil {
+		t.Skipf("configs/mlx5_errors.json not available: %v", err)
+	}
+	if _, err := parseMLX5ErrorConfig(data); err != nil {
+		t.Errorf("configs/mlx5_errors.json is invalid: %v", err)
+	}
+}
+
+func TestClassifyDmesgMLX5Errors(t *testing.T) {
+	config, err := parseMLX5ErrorConfig([]byte(`{
+		"default_severity": "critical",
+		"max_critical_messages": 2,
+		"classifications": [
+			{"pattern": "fw_fatal.*recovered", "severity": "warning"},
+			{"pattern": "fw_fatal.*registered", "severity": "info"},
+			{"pattern": "cable unplugged", "severity": "warning"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("parseMLX5ErrorConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		input            string
+		expectedCritical int
+		expectedWarning  int
+		expectedMessages []string
+	}{
+		{
+			name:  "No errors",
+			input: "kernel: normal log message\nkernel: mlx5_core 0000:17:00.0: info message",
+		},
+		{
+			name:             "Unclassified fatal error is critical",
+			input:            "kernel: mlx5_core 0000:17:00.0: Fatal error detected",
+			expectedCritical: 1,
+			expectedMessages: []string{"kernel: mlx5_core 0000:17:00.0: Fatal error detected"},
+		},
+		{
+			name:            "Recovered fatal error is a warning",
+			input:           "kernel: mlx5_core 0000:17:00.0: fw_fatal reporter recovered\nkernel: mlx5_core 0000:18:00.0: Port module event: module 0, Cable unplugged",
+			expectedWarning: 2,
+		},
+		{
+			name:  "Info entries are ignored",
+			input: "kernel: mlx5_core 0000:17:00.0: devlink health reporter fw_fatal registered",
+		},
+		{
+			name:             "Most recent critical errors are kept",
+			input:            "mlx5_core: fatal 1\nmlx5_core: fatal 2\nmlx5_core: fw_fatal recovered\nmlx5_core: fatal 3",
+			expectedCritical: 3,
+			expectedWarning:  1,
+			expectedMessages: []string{"mlx5_core: fatal 2", "mlx5_core: fatal 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := classifyDmesgMLX5Errors(tt.input, config)
+			if summary.CriticalCount != tt.expectedCritical || summary.WarningCount != tt.expectedWarning {
+				t.Errorf("Expected %d critical and %d warning, got %d and %d",
+					tt.expectedCritical, tt.expectedWarning, summary.CriticalCount, summary.WarningCount)
+			}
+			if len(tt.expectedMessages) > 0 && !reflect.DeepEqual(summary.CriticalErrors, tt.expectedMessages) {
+				t.Errorf("Expected critical errors %v, got %v", tt.expectedMessages, summary.CriticalErrors)
+			}
+		})
+	}
+}
+
+func TestClassifyMLX5Error_DefaultConfig(t *testing.T) {
+	config := defaultMLX5ErrorConfig()
+	if severity := classifyMLX5Error("mlx5_core 0000:17:00.0: FATAL issue", config); severity != MLX5SeverityCritical {
+		t.Errorf("Expected critical without a classification table, got %q", severity)
+	}
+	if severity := classifyMLX5Error("mlx5_core 0000:17:00.0: link up", config); severity != "" {
+		t.Errorf("Expected no severity for non-error message, got %q", severity)
+	}
+}
@@ -0,0 +1,322 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+const (
+	meminfoPath         = "/proc/meminfo"
+	kernelCmdlinePath   = "/proc/cmdline"
+	sysctlConfPath      = "/etc/sysctl.conf"
+	hugepages1GBSysPath = "/sys/kernel/mm/hugepages/hugepages-1048576kB/nr_hugepages"
+	hugepageSize2MBKB   = 2048
+)
+
+// sysctlConfigDirs lists the sysctl.d directories in order of precedence: a file overrides
+// files with the same name in the directories after it
+var sysctlConfigDirs = []string{"/etc/sysctl.d", "/run/sysctl.d", "/usr/local/lib/sysctl.d", "/usr/lib/sysctl.d"}
+
+// HugepagesCheckTestConfig represents the config needed to run this test
+type HugepagesCheckTestConfig struct {
+	IsEnabled         bool   `json:"enabled"`
+	Shape             string `json:"shape"`
+	Min2MBPages       int    `json:"min_2mb_pages"`
+	Min1GBPages       int    `json:"min_1gb_pages"`
+	RequirePersistent bool   `json:"require_persistent"`
+}
+
+// HugepagesInfo represents the hugepage configuration found on the host
+type HugepagesInfo struct {
+	DefaultPageSizeKB int  `json:"default_page_size_kb"`
+	TotalPages        int  `json:"total_pages"`
+	FreePages         int  `json:"free_pages"`
+	Pages2MB          int  `json:"pages_2mb"`
+	Pages1GB          int  `json:"pages_1gb"`
+	Persistent        bool `json:"persistent"`
+}
+
+// getHugepagesCheckTestConfig gets test config needed to run this test
+func getHugepagesCheckTestConfig() (*HugepagesCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	hugepagesCheckTestConfig := &HugepagesCheckTestConfig{
+		IsEnabled:         false,
+		Shape:             shape,
+		Min2MBPages:       0,
+		Min1GBPages:       0,
+		RequirePersistent: false,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "hugepages_check")
+	if err != nil {
+		return nil, err
+	}
+	hugepagesCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "hugepages_check")
+	if err == nil {
+		switch v := threshold.(type) {
+		case map[string]interface{}:
+			if min2MB, ok := v["min_2mb_pages"].(float64); ok {
+				hugepagesCheckTestConfig.Min2MBPages = int(min2MB)
+			}
+			if min1GB, ok := v["min_1gb_pages"].(float64); ok {
+				hugepagesCheckTestConfig.Min1GBPages = int(min1GB)
+			}
+			if requirePersistent, ok := v["require_persistent"].(bool); ok {
+				hugepagesCheckTestConfig.RequirePersistent = requirePersistent
+			}
+		}
+	}
+
+	return hugepagesCheckTestConfig, nil
+}
+
+// parseMeminfoHugepages parses HugePages_Total, HugePages_Free and Hugepagesize from /proc/meminfo
+func parseMeminfoHugepages(output string) (*HugepagesInfo, error) {
+	info := &HugepagesInfo{}
+	found := map[string]bool{}
+
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.Fields(line)
+		if len(parts) < 2 {
+			continue
+		}
+
+		key := strings.TrimSuffix(parts[0], ":")
+		switch key {
+		case "HugePages_Total", "HugePages_Free", "Hugepagesize":
+		default:
+			continue
+		}
+
+		value, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value in meminfo: %s", key, parts[1])
+		}
+		found[key] = true
+
+		switch key {
+		case "HugePages_Total":
+			info.TotalPages = value
+		case "HugePages_Free":
+			info.FreePages = value
+		case "Hugepagesize":
+			info.DefaultPageSizeKB = value
+		}
+	}
+
+	for _, key := range []string{"HugePages_Total", "HugePages_Free", "Hugepagesize"} {
+		if !found[key] {
+			return nil, fmt.Errorf("%s not found in meminfo", key)
+		}
+	}
+
+	// HugePages_Total in meminfo reports pages of the default hugepage size only
+	if info.DefaultPageSizeKB == hugepageSize2MBKB {
+		info.Pages2MB = info.TotalPages
+	}
+
+	return info, nil
+}
+
+// parseNrHugepages parses the page count from a sysfs nr_hugepages file
+func parseNrHugepages(output string) (int, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, fmt.Errorf("invalid nr_hugepages value: %s", strings.TrimSpace(output))
+	}
+	return value, nil
+}
+
+// isHugepagesReservationPersistent reports whether hugepages are reserved on the kernel command
+// line or through vm.nr_hugepages in the sysctl configuration, so they survive a reboot.
+// sysctlConfigs are applied in order, so the last vm.nr_hugepages setting wins.
+func isHugepagesReservationPersistent(cmdline string, sysctlConfigs []string) bool {
+	for _, param := range strings.Fields(cmdline) {
+		if strings.HasPrefix(param, "hugepages=") {
+			return true
+		}
+	}
+
+	nrHugepages := 0
+	for _, sysctlConf := range sysctlConfigs {
+		for _, line := range strings.Split(sysctlConf, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+				continue
+			}
+			parts := strings.SplitN(line, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			// Keys may use "/" instead of "." as separator, and a leading "-" ignores failures
+			key := strings.ReplaceAll(strings.TrimPrefix(strings.TrimSpace(parts[0]), "-"), "/", ".")
+			if key == "vm.nr_hugepages" {
+				if value, err := strconv.Atoi(strings.TrimSpace(parts[1])); err == nil {
+					nrHugepages = value
+				}
+			}
+		}
+	}
+
+	return nrHugepages > 0
+}
+
+// readSysctlConfigs reads the sysctl configuration in the order it is applied at boot: the
+// *.conf files of sysctlConfigDirs sorted by file name, then /etc/sysctl.conf. Missing or
+// unreadable files are left out.
+func readSysctlConfigs() []string {
+	files := make(map[string]string)
+	for i := len(sysctlConfigDirs) - 1; i >= 0; i-- {
+		paths, err := filepath.Glob(filepath.Join(sysctlConfigDirs[i], "*.conf"))
+		if err != nil {
+			continue
+		}
+		for _, path := range paths {
+			files[filepath.Base(path)] = path
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	paths := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		paths = append(paths, files[name])
+	}
+	paths = append(paths, sysctlConfPath)
+
+	var configs []string
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			logger.Debugf("Skipping sysctl configuration %s: %v", path, err)
+			continue
+		}
+		configs = append(configs, string(data))
+	}
+	return configs
+}
+
+// validateHugepages compares the hugepage configuration against the test config
+func validateHugepages(info *HugepagesInfo, config *HugepagesCheckTestConfig) error {
+	var failures []error
+
+	if info.Pages2MB < config.Min2MBPages {
+		failures = append(failures, &testerrors.TestThresholdExceededError{TestName: "hugepages_check", Metric: "hugepages_2mb",
+			Actual: info.Pages2MB, Expected: fmt.Sprintf(">= %d", config.Min2MBPages)})
+	}
+	if info.Pages1GB < config.Min1GBPages {
+		failures = append(failures, &testerrors.TestThresholdExceededError{TestName: "hugepages_check", Metric: "hugepages_1gb",
+			Actual: info.Pages1GB, Expected: fmt.Sprintf(">= %d", config.Min1GBPages)})
+	}
+	if config.RequirePersistent && !info.Persistent {
+		failures = append(failures, &testerrors.TestThresholdExceededError{TestName: "hugepages_check", Metric: "persistent",
+			Actual: info.Persistent, Expected: true})
+	}
+
+	return errors.Join(failures...)
+}
+
+// getHugepagesInfo collects hugepage counts and reservation state from the host
+func getHugepagesInfo() (*HugepagesInfo, error) {
+	meminfo, err := os.ReadFile(meminfoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", meminfoPath, err)
+	}
+
+	info, err := parseMeminfoHugepages(string(meminfo))
+	if err != nil {
+		return nil, err
+	}
+
+	// 1GB pages are not reported in meminfo unless they are the default size
+	nrHugepages1GB, err := os.ReadFile(hugepages1GBSysPath)
+	if err != nil {
+		logger.Info("1GB hugepages not supported or not configured:", err)
+	} else {
+		pages1GB, err := parseNrHugepages(string(nrHugepages1GB))
+		if err != nil {
+			return nil, err
+		}
+		info.Pages1GB = pages1GB
+	}
+
+	cmdline, err := os.ReadFile(kernelCmdlinePath)
+	if err != nil {
+		logger.Info("Failed to read kernel command line:", err)
+	}
+	info.Persistent = isHugepagesReservationPersistent(string(cmdline), readSysctlConfigs())
+
+	return info, nil
+}
+
+func RunHugepagesCheck() error {
+	logger.Info("=== Hugepages Check ===")
+	testConfig, err := getHugepagesCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "hugepages_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting hugepages configuration check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get hugepage configuration
+	logger.Info("Step 1: Getting hugepage configuration...")
+	info, err := getHugepagesInfo()
+	if err != nil {
+		logger.Error("Hugepages Check: FAIL - Could not get hugepage configuration:", err)
+		rep.AddHugepagesResult("FAIL", 0, 0, false, err)
+		return fmt.Errorf("could not get hugepage configuration: %w", err)
+	}
+	logger.Infof("Hugepages: default size %d kB, total %d, free %d, 2MB %d, 1GB %d, persistent %t",
+		info.DefaultPageSizeKB, info.TotalPages, info.FreePages, info.Pages2MB, info.Pages1GB, info.Persistent)
+
+	// Step 2: Validate against thresholds
+	logger.Info("Step 2: Validating hugepage configuration...")
+	logger.Infof("Minimum 2MB pages: %d, minimum 1GB pages: %d, require persistent: %t",
+		testConfig.Min2MBPages, testConfig.Min1GBPages, testConfig.RequirePersistent)
+	if err := validateHugepages(info, testConfig); err != nil {
+		logger.Error("Hugepages Check: FAIL -", err)
+		rep.AddHugepagesResult("FAIL", info.Pages2MB, info.Pages1GB, info.Persistent, err)
+		return err
+	}
+
+	logger.Info("Hugepages Check: PASS - Hugepage configuration meets requirements")
+	rep.AddHugepagesResult("PASS", info.Pages2MB, info.Pages1GB, info.Persistent, nil)
+	return nil
+}
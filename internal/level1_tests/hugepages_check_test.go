@@ -0,0 +1,234 @@
+package level1_tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+)
+
+const testMeminfo = `MemTotal:       2113411892 kB
+MemFree:        2080000000 kB
+HugePages_Total:    4096
+HugePages_Free:     4000
+HugePages_Rsvd:        0
+HugePages_Surp:        0
+Hugepagesize:       2048 kB
+Hugetlb:         8388608 kB`
+
+// Test parseMeminfoHugepages function
+func TestParseMeminfoHugepages(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedTotal int
+		expectedFree  int
+		expected2MB   int
+		expectError   bool
+	}{
+		{
+			name:          "2MB default page size",
+			input:         testMeminfo,
+			expectedTotal: 4096,
+			expectedFree:  4000,
+			expected2MB:   4096,
+			expectError:   false,
+		},
+		{
+			name:          "1GB default page size",
+			input:         "HugePages_Total:      16\nHugePages_Free:       16\nHugepagesize:    1048576 kB",
+			expectedTotal: 16,
+			expectedFree:  16,
+			expected2MB:   0,
+			expectError:   false,
+		},
+		{
+			name:        "Missing hugepage size",
+			input:       "HugePages_Total:    4096\nHugePages_Free:     4000",
+			expectError: true,
+		},
+		{
+			name:        "Invalid value",
+			input:       "HugePages_Total:    abc\nHugePages_Free:     4000\nHugepagesize:       2048 kB",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := parseMeminfoHugepages(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseMeminfoHugepages() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if info.TotalPages != tt.expectedTotal {
+				t.Errorf("TotalPages = %d, want %d", info.TotalPages, tt.expectedTotal)
+			}
+			if info.FreePages != tt.expectedFree {
+				t.Errorf("FreePages = %d, want %d", info.FreePages, tt.expectedFree)
+			}
+			if info.Pages2MB != tt.expected2MB {
+				t.Errorf("Pages2MB = %d, want %d", info.Pages2MB, tt.expected2MB)
+			}
+		})
+	}
+}
+
+// Test isHugepagesReservationPersistent function
+func TestIsHugepagesReservationPersistent(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdline       string
+		sysctlConfigs []string
+		expected      bool
+	}{
+		{
+			name:     "Kernel command line reservation",
+			cmdline:  "BOOT_IMAGE=/vmlinuz root=/dev/sda1 hugepagesz=1G hugepages=16",
+			expected: true,
+		},
+		{
+			name:          "Sysctl reservation",
+			cmdline:       "BOOT_IMAGE=/vmlinuz root=/dev/sda1",
+			sysctlConfigs: []string{"# hugepages\nvm.nr_hugepages = 4096\n"},
+			expected:      true,
+		},
+		{
+			name:          "Sysctl.d reservation with slash separator",
+			sysctlConfigs: []string{"kernel.pid_max = 4194304\n", "vm/nr_hugepages = 4096\n", ""},
+			expected:      true,
+		},
+		{
+			name:          "Commented out sysctl reservation",
+			cmdline:       "BOOT_IMAGE=/vmlinuz root=/dev/sda1",
+			sysctlConfigs: []string{"# vm.nr_hugepages = 4096\n"},
+			expected:      false,
+		},
+		{
+			name:          "Zero sysctl reservation",
+			sysctlConfigs: []string{"vm.nr_hugepages=0"},
+			expected:      false,
+		},
+		{
+			name:          "Reservation overridden by a later file",
+			sysctlConfigs: []string{"vm.nr_hugepages = 4096", "vm.nr_hugepages = 0"},
+			expected:      false,
+		},
+		{
+			name:     "No reservation",
+			cmdline:  "BOOT_IMAGE=/vmlinuz root=/dev/sda1 hugepagesz=1G",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHugepagesReservationPersistent(tt.cmdline, tt.sysctlConfigs); got != tt.expected {
+				t.Errorf("isHugepagesReservationPersistent() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test readSysctlConfigs function
+func TestReadSysctlConfigs(t *testing.T) {
+	etcDir, libDir := t.TempDir(), t.TempDir()
+	originalDirs := sysctlConfigDirs
+	sysctlConfigDirs = []string{etcDir, libDir}
+	defer func() { sysctlConfigDirs = originalDirs }()
+
+	files := map[string]string{
+		filepath.Join(libDir, "10-default.conf"):   "vm.nr_hugepages = 0\n",
+		filepath.Join(libDir, "50-hugepages.conf"): "vm.nr_hugepages = 1024\n",
+		filepath.Join(etcDir, "50-hugepages.conf"): "vm.nr_hugepages = 4096\n",
+		filepath.Join(etcDir, "README"):            "not a sysctl configuration\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", path, err)
+		}
+	}
+
+	// The file in the first directory overrides the file with the same name in the second
+	configs := readSysctlConfigs()
+	if len(configs) < 2 || configs[0] != "vm.nr_hugepages = 0\n" || configs[1] != "vm.nr_hugepages = 4096\n" {
+		t.Errorf("readSysctlConfigs() = %q, want 10-default.conf then %s/50-hugepages.conf", configs, etcDir)
+	}
+	if !isHugepagesReservationPersistent("", configs) {
+		t.Error("isHugepagesReservationPersistent() = false for a reservation in sysctl.d")
+	}
+}
+
+// Test validateHugepages function
+func TestValidateHugepages(t *testing.T) {
+	config := &HugepagesCheckTestConfig{
+		Min2MBPages:       1024,
+		Min1GBPages:       8,
+		RequirePersistent: true,
+	}
+
+	tests := []struct {
+		name        string
+		info        *HugepagesInfo
+		config      *HugepagesCheckTestConfig
+		expectError bool
+	}{
+		{
+			name:        "Meets requirements",
+			info:        &HugepagesInfo{Pages2MB: 4096, Pages1GB: 16, Persistent: true},
+			config:      config,
+			expectError: false,
+		},
+		{
+			name:        "Too few 2MB pages",
+			info:        &HugepagesInfo{Pages2MB: 512, Pages1GB: 16, Persistent: true},
+			config:      config,
+			expectError: true,
+		},
+		{
+			name:        "Too few 1GB pages",
+			info:        &HugepagesInfo{Pages2MB: 4096, Pages1GB: 0, Persistent: true},
+			config:      config,
+			expectError: true,
+		},
+		{
+			name:        "Not persistent",
+			info:        &HugepagesInfo{Pages2MB: 4096, Pages1GB: 16, Persistent: false},
+			config:      config,
+			expectError: true,
+		},
+		{
+			name:        "Persistence not required",
+			info:        &HugepagesInfo{Pages2MB: 4096, Pages1GB: 16, Persistent: false},
+			config:      &HugepagesCheckTestConfig{Min2MBPages: 1024, Min1GBPages: 8, RequirePersistent: false},
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHugepages(tt.info, tt.config)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateHugepages() error = %v, wantErr %v", err, tt.expectError)
+			}
+			var thresholdErr *testerrors.TestThresholdExceededError
+			if err != nil && !errors.As(err, &thresholdErr) {
+				t.Errorf("validateHugepages() error = %T, want *TestThresholdExceededError", err)
+			}
+		})
+	}
+}
+
+// Test parseNrHugepages function
+func TestParseNrHugepages(t *testing.T) {
+	if got, err := parseNrHugepages("16\n"); err != nil || got != 16 {
+		t.Errorf("parseNrHugepages() = %d, %v, want 16, nil", got, err)
+	}
+	if _, err := parseNrHugepages("invalid"); err == nil {
+		t.Error("parseNrHugepages() expected error for invalid input")
+	}
+}
@@ -0,0 +1,398 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/shapes"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// CableSpecRange is the range a cable DDM value is expected in. Values outside the nominal
+// range are a warning, values outside the absolute range are a failure.
+type CableSpecRange struct {
+	NominalMin  float64 `json:"nominal_min"`
+	NominalMax  float64 `json:"nominal_max"`
+	AbsoluteMin float64 `json:"absolute_min"`
+	AbsoluteMax float64 `json:"absolute_max"`
+}
+
+// IBCableCheckTestConfig represents the config needed to run this test
+type IBCableCheckTestConfig struct {
+	IsEnabled     bool           `json:"enabled"`
+	Shape         string         `json:"shape"`
+	TemperatureC  CableSpecRange `json:"temperature_c"`
+	RXPowerDBm    CableSpecRange `json:"rx_power_dbm"`
+	TXPowerDBm    CableSpecRange `json:"tx_power_dbm"`
+	BiasCurrentMA CableSpecRange `json:"bias_current_ma"`
+}
+
+// IBCableCheckResult represents the cable DDM values of an RDMA port
+type IBCableCheckResult struct {
+	Device        string    `json:"device"`
+	MSTDevice     string    `json:"mst_device,omitempty"`
+	CableType     string    `json:"cable_type"`
+	Optical       bool      `json:"optical"`
+	TemperatureC  *float64  `json:"temperature_c,omitempty"`
+	RXPowerDBm    []float64 `json:"rx_power_dbm,omitempty"`
+	TXPowerDBm    []float64 `json:"tx_power_dbm,omitempty"`
+	BiasCurrentMA []float64 `json:"bias_current_ma,omitempty"`
+	Status        string    `json:"status"`
+	Issues        []string  `json:"issues,omitempty"`
+}
+
+// Default spec ranges of QSFP/OSFP optical modules
+var (
+	defaultCableTemperatureRange = CableSpecRange{NominalMin: 0, NominalMax: 70, AbsoluteMin: -5, AbsoluteMax: 80}
+	defaultCableRXPowerRange     = CableSpecRange{NominalMin: -9, NominalMax: 4, AbsoluteMin: -12, AbsoluteMax: 5}
+	defaultCableTXPowerRange     = CableSpecRange{NominalMin: -7, NominalMax: 4, AbsoluteMin: -10, AbsoluteMax: 5}
+	defaultCableBiasCurrentRange = CableSpecRange{NominalMin: 2, NominalMax: 12, AbsoluteMin: 1, AbsoluteMax: 15}
+)
+
+// mlxcableValuePattern matches the number at the start of an mlxcable value such as "47C" or "-0.893dBm"
+var mlxcableValuePattern = regexp.MustCompile(`^-?\d+(\.\d+)?`)
+
+// getIBCableCheckTestConfig gets test config needed to run this test
+func getIBCableCheckTestConfig() (*IBCableCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	ibCableCheckTestConfig := &IBCableCheckTestConfig{
+		IsEnabled:     false,
+		Shape:         shape,
+		TemperatureC:  defaultCableTemperatureRange,
+		RXPowerDBm:    defaultCableRXPowerRange,
+		TXPowerDBm:    defaultCableTXPowerRange,
+		BiasCurrentMA: defaultCableBiasCurrentRange,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "ib_cable_check")
+	if err != nil {
+		return nil, err
+	}
+	ibCableCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "ib_cable_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			parseCableSpecRange(thresholdMap, "temperature_c", &ibCableCheckTestConfig.TemperatureC)
+			parseCableSpecRange(thresholdMap, "rx_power_dbm", &ibCableCheckTestConfig.RXPowerDBm)
+			parseCableSpecRange(thresholdMap, "tx_power_dbm", &ibCableCheckTestConfig.TXPowerDBm)
+			parseCableSpecRange(thresholdMap, "bias_current_ma", &ibCableCheckTestConfig.BiasCurrentMA)
+		}
+	}
+
+	return ibCableCheckTestConfig, nil
+}
+
+// parseCableSpecRange overrides the bounds of specRange set in the key object of thresholdMap
+func parseCableSpecRange(thresholdMap map[string]interface{}, key string, specRange *CableSpecRange) {
+	rangeMap, ok := thresholdMap[key].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if value, ok := rangeMap["nominal_min"].(float64); ok {
+		specRange.NominalMin = value
+	}
+	if value, ok := rangeMap["nominal_max"].(float64); ok {
+		specRange.NominalMax = value
+	}
+	if value, ok := rangeMap["absolute_min"].(float64); ok {
+		specRange.AbsoluteMin = value
+	}
+	if value, ok := rangeMap["absolute_max"].(float64); ok {
+		specRange.AbsoluteMax = value
+	}
+}
+
+// parseMstDevices maps RDMA device names, such as mlx5_0, to their mst device path from the
+// output of mst status -v, where lines look like
+// "ConnectX7(rev:0) /dev/mst/mt4129_pciconf0 0c:00.0 mlx5_0 net-rdma0 0"
+func parseMstDevices(output string) map[string]string {
+	mstDevices := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[1], "/dev/mst/") {
+			continue
+		}
+		mstDevices[fields[3]] = fields[1]
+	}
+	return mstDevices
+}
+
+// parseMlxcableValue parses the number of an mlxcable value, returning false for values
+// such as "N/A" that the module does not report
+func parseMlxcableValue(value string) (float64, bool) {
+	match := mlxcableValuePattern.FindString(strings.TrimSpace(value))
+	if match == "" {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(match, 64)
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+// parseMlxcablePower parses an optical power value in dBm, converting values reported in mW
+func parseMlxcablePower(value string) (float64, bool) {
+	number, ok := parseMlxcableValue(value)
+	if !ok {
+		return 0, false
+	}
+	if strings.HasSuffix(strings.ToLower(strings.TrimSpace(value)), "mw") {
+		if number <= 0 {
+			return 0, false
+		}
+		return 10 * math.Log10(number), true
+	}
+	return number, true
+}
+
+// parseMlxcableOutput parses the cable type and DDM values of mlxcable output. Copper cables
+// have no optical module, so they report no temperature, power or bias current.
+func parseMlxcableOutput(device, output string) IBCableCheckResult {
+	result := IBCableCheckResult{Device: device, CableType: "Unknown"}
+	copper := false
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch {
+		case key == "cable technology" || key == "cable type":
+			result.CableType = value
+			if strings.Contains(strings.ToLower(value), "copper") {
+				copper = true
+			}
+		case key == "temperature":
+			if temperature, ok := parseMlxcableValue(value); ok {
+				result.TemperatureC = &temperature
+			}
+		case strings.HasPrefix(key, "rx power"):
+			if power, ok := parseMlxcablePower(value); ok {
+				result.RXPowerDBm = append(result.RXPowerDBm, power)
+			}
+		case strings.HasPrefix(key, "tx power"):
+			if power, ok := parseMlxcablePower(value); ok {
+				result.TXPowerDBm = append(result.TXPowerDBm, power)
+			}
+		case strings.HasPrefix(key, "tx bias") || strings.HasPrefix(key, "laser bias"):
+			if bias, ok := parseMlxcableValue(value); ok {
+				result.BiasCurrentMA = append(result.BiasCurrentMA, bias)
+			}
+		}
+	}
+
+	result.Optical = !copper && (len(result.RXPowerDBm) > 0 || len(result.TXPowerDBm) > 0)
+	return result
+}
+
+// checkCableSpecRange returns FAIL when value is outside the absolute range of specRange,
+// WARN when it is only outside the nominal range and PASS otherwise
+func checkCableSpecRange(value float64, specRange CableSpecRange) string {
+	switch {
+	case value < specRange.AbsoluteMin || value > specRange.AbsoluteMax:
+		return "FAIL"
+	case value < specRange.NominalMin || value > specRange.NominalMax:
+		return "WARN"
+	default:
+		return "PASS"
+	}
+}
+
+// validateIBCable sets the status of an optical cable from its DDM values. Non-optical cables pass.
+func validateIBCable(cable *IBCableCheckResult, config *IBCableCheckTestConfig) {
+	cable.Status = "PASS"
+	if !cable.Optical {
+		return
+	}
+
+	check := func(name, unit string, values []float64, specRange CableSpecRange) {
+		for lane, value := range values {
+			status := checkCableSpecRange(value, specRange)
+			if status == "PASS" {
+				continue
+			}
+			limitMin, limitMax, rangeName := specRange.NominalMin, specRange.NominalMax, "nominal"
+			if status == "FAIL" {
+				limitMin, limitMax, rangeName = specRange.AbsoluteMin, specRange.AbsoluteMax, "absolute"
+				cable.Status = "FAIL"
+			} else if cable.Status == "PASS" {
+				cable.Status = "WARN"
+			}
+			label := name
+			if len(values) > 1 {
+				label = fmt.Sprintf("%s lane %d", name, lane+1)
+			}
+			cable.Issues = append(cable.Issues, fmt.Sprintf("%s %.2f%s outside %s range [%g, %g]", label, value, unit, rangeName, limitMin, limitMax))
+		}
+	}
+
+	if cable.TemperatureC != nil {
+		check("temperature", "C", []float64{*cable.TemperatureC}, config.TemperatureC)
+	}
+	check("RX power", "dBm", cable.RXPowerDBm, config.RXPowerDBm)
+	check("TX power", "dBm", cable.TXPowerDBm, config.TXPowerDBm)
+	check("bias current", "mA", cable.BiasCurrentMA, config.BiasCurrentMA)
+}
+
+// validateIBCables validates every cable and returns the overall status, FAIL if any cable
+// is outside its absolute limits and WARN if any is outside its nominal range
+func validateIBCables(cables []IBCableCheckResult, config *IBCableCheckTestConfig) (string, error) {
+	var failed, warned []string
+	for i := range cables {
+		if cables[i].Status != "FAIL" {
+			validateIBCable(&cables[i], config)
+		}
+		switch cables[i].Status {
+		case "FAIL":
+			failed = append(failed, fmt.Sprintf("%s (%s)", cables[i].Device, strings.Join(cables[i].Issues, "; ")))
+		case "WARN":
+			warned = append(warned, fmt.Sprintf("%s (%s)", cables[i].Device, strings.Join(cables[i].Issues, "; ")))
+		}
+	}
+
+	if len(failed) > 0 {
+		return "FAIL", fmt.Errorf("cable signal quality outside absolute limits on: %s", strings.Join(failed, ", "))
+	}
+	if len(warned) > 0 {
+		return "WARN", fmt.Errorf("cable signal quality outside nominal range on: %s", strings.Join(warned, ", "))
+	}
+	return "PASS", nil
+}
+
+// getIBCableResults reads the cable DDM values of every device. A device without an mst device
+// or whose mlxcable command fails is marked FAIL. A missing mlxcable tool fails the whole test.
+func getIBCableResults(devices []string, mstDevices map[string]string) ([]IBCableCheckResult, error) {
+	cables := make([]IBCableCheckResult, 0, len(devices))
+	for _, device := range devices {
+		mstDevice, found := mstDevices[device]
+		if !found {
+			cables = append(cables, IBCableCheckResult{Device: device, CableType: "Unknown", Status: "FAIL", Issues: []string{"no mst device found"}})
+			continue
+		}
+
+		result, err := executor.RunMlxcable(mstDevice)
+		if err != nil {
+			err = commandError("ib_cable_check", result, err)
+			var toolErr *testerrors.TestToolNotFoundError
+			if errors.As(err, &toolErr) {
+				return nil, err
+			}
+			cables = append(cables, IBCableCheckResult{Device: device, MSTDevice: mstDevice, CableType: "Unknown", Status: "FAIL", Issues: []string{"mlxcable failed"}})
+			continue
+		}
+
+		cable := parseMlxcableOutput(device, result.Output)
+		cable.MSTDevice = mstDevice
+		cables = append(cables, cable)
+	}
+	return cables, nil
+}
+
+func RunIBCableCheck() error {
+	logger.Info("=== IB Cable Check ===")
+	testConfig, err := getIBCableCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "ib_cable_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting IB cable check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get expected RDMA devices from shapes configuration
+	logger.Info("Step 1: Loading shape configuration...")
+	shapeManager, err := shapes.GetDefaultShapeManager()
+	if err != nil {
+		logger.Error("IB Cable Check: FAIL - Could not load shapes configuration:", err)
+		rep.AddIBCableResult("FAIL", nil, err)
+		return fmt.Errorf("failed to load shapes configuration: %w", err)
+	}
+	rdmaNics, err := shapeManager.GetRDMANics(testConfig.Shape)
+	if err != nil {
+		logger.Error("IB Cable Check: FAIL - Could not get expected RDMA NICs:", err)
+		rep.AddIBCableResult("FAIL", nil, err)
+		return fmt.Errorf("failed to get expected RDMA NICs: %w", err)
+	}
+	var devices []string
+	for _, nic := range rdmaNics {
+		if nic.DeviceName != "" {
+			devices = append(devices, nic.DeviceName)
+		}
+	}
+	if len(devices) == 0 {
+		errorStatement := fmt.Sprintf("No RDMA devices expected for shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return errors.New(errorStatement)
+	}
+
+	// Step 2: Map RDMA devices to mst devices
+	logger.Info("Step 2: Getting mst devices...")
+	mstResult, err := executor.RunMstStatus()
+	if err != nil {
+		err = commandError("ib_cable_check", mstResult, err)
+		logger.Error("IB Cable Check: FAIL - Could not get MST status:", err)
+		rep.AddIBCableResult("FAIL", nil, err)
+		return fmt.Errorf("failed to get MST status: %w", err)
+	}
+	mstDevices := parseMstDevices(mstResult.Output)
+
+	// Step 3: Read the cable DDM values of every device
+	logger.Info("Step 3: Reading cable DDM values of", len(devices), "devices...")
+	cables, err := getIBCableResults(devices, mstDevices)
+	if err != nil {
+		logger.Error("IB Cable Check: FAIL -", err)
+		rep.AddIBCableResult("FAIL", nil, err)
+		return err
+	}
+
+	// Step 4: Validate the cable DDM values against the spec ranges
+	logger.Info("Step 4: Validating cable signal quality...")
+	status, validationErr := validateIBCables(cables, testConfig)
+	for _, cable := range cables {
+		logger.Infof("%s: %s cable, status %s", cable.Device, cable.CableType, cable.Status)
+	}
+	rep.AddIBCableResult(status, cables, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("IB Cable Check: PASS - Cable signal quality within nominal range on all %d devices", len(cables))
+		return nil
+	case "WARN":
+		logger.Info("IB Cable Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("IB Cable Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,126 @@
+package level1_tests
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const opticalMlxcableOutput = `Cable DDM:
+------------------------------
+Cable Technology      : 850 nm VCSEL
+Temperature           : 47C
+Voltage               : 3.2990V
+Rx Power Lane 1       : -0.893dBm
+Rx Power Lane 2       : -1.120dBm
+Tx Power Lane 1       : 0.502dBm
+Tx Power Lane 2       : 0.498dBm
+Tx Bias Lane 1        : 7.500mA
+Tx Bias Lane 2        : 7.400mA
+`
+
+func defaultIBCableCheckTestConfig() *IBCableCheckTestConfig {
+	return &IBCableCheckTestConfig{
+		TemperatureC:  defaultCableTemperatureRange,
+		RXPowerDBm:    defaultCableRXPowerRange,
+		TXPowerDBm:    defaultCableTXPowerRange,
+		BiasCurrentMA: defaultCableBiasCurrentRange,
+	}
+}
+
+// Test parseMstDevices function
+func TestParseMstDevices(t *testing.T) {
+	output := `MST modules:
+------------
+    MST PCI module is not loaded
+
+PCI devices:
+------------
+DEVICE_TYPE             MST                           PCI       RDMA            NET                                     NUMA
+ConnectX7(rev:0)        /dev/mst/mt4129_pciconf0      0c:00.0   mlx5_0          net-rdma0                               0
+ConnectX7(rev:0)        /dev/mst/mt4129_pciconf1      2a:00.0   mlx5_1          net-rdma1                               0
+`
+	expected := map[string]string{
+		"mlx5_0": "/dev/mst/mt4129_pciconf0",
+		"mlx5_1": "/dev/mst/mt4129_pciconf1",
+	}
+	if devices := parseMstDevices(output); !reflect.DeepEqual(devices, expected) {
+		t.Errorf("parseMstDevices() = %v, want %v", devices, expected)
+	}
+}
+
+// Test parseMlxcableOutput function
+func TestParseMlxcableOutput(t *testing.T) {
+	cable := parseMlxcableOutput("mlx5_0", opticalMlxcableOutput)
+	if !cable.Optical || cable.CableType != "850 nm VCSEL" {
+		t.Errorf("parseMlxcableOutput() type = %q optical = %v, want optical 850 nm VCSEL", cable.CableType, cable.Optical)
+	}
+	if cable.TemperatureC == nil || *cable.TemperatureC != 47 {
+		t.Errorf("parseMlxcableOutput() temperature = %v, want 47", cable.TemperatureC)
+	}
+	if !reflect.DeepEqual(cable.RXPowerDBm, []float64{-0.893, -1.12}) {
+		t.Errorf("parseMlxcableOutput() RX power = %v", cable.RXPowerDBm)
+	}
+	if !reflect.DeepEqual(cable.TXPowerDBm, []float64{0.502, 0.498}) {
+		t.Errorf("parseMlxcableOutput() TX power = %v", cable.TXPowerDBm)
+	}
+	if !reflect.DeepEqual(cable.BiasCurrentMA, []float64{7.5, 7.4}) {
+		t.Errorf("parseMlxcableOutput() bias current = %v", cable.BiasCurrentMA)
+	}
+
+	copper := parseMlxcableOutput("mlx5_1", "Cable Technology      : Copper cable unequalized\nTemperature           : N/A\nRx Power Lane 1       : N/A\n")
+	if copper.Optical || copper.TemperatureC != nil || len(copper.RXPowerDBm) != 0 {
+		t.Errorf("parseMlxcableOutput() copper cable = %+v, want no DDM values", copper)
+	}
+}
+
+// Test parseMlxcablePower function
+func TestParseMlxcablePower(t *testing.T) {
+	if power, ok := parseMlxcablePower("-2.5dBm"); !ok || power != -2.5 {
+		t.Errorf("parseMlxcablePower(-2.5dBm) = %v, %v", power, ok)
+	}
+	if power, ok := parseMlxcablePower("1.0mW"); !ok || math.Abs(power) > 1e-9 {
+		t.Errorf("parseMlxcablePower(1.0mW) = %v, %v, want 0 dBm", power, ok)
+	}
+	if _, ok := parseMlxcablePower("N/A"); ok {
+		t.Error("parseMlxcablePower(N/A) expected no value")
+	}
+}
+
+// Test validateIBCables function
+func TestValidateIBCables(t *testing.T) {
+	config := defaultIBCableCheckTestConfig()
+
+	healthy := parseMlxcableOutput("mlx5_0", opticalMlxcableOutput)
+	copper := IBCableCheckResult{Device: "mlx5_1", CableType: "Copper cable unequalized"}
+	status, err := validateIBCables([]IBCableCheckResult{healthy, copper}, config)
+	if status != "PASS" || err != nil {
+		t.Errorf("validateIBCables() = %s, %v, want PASS", status, err)
+	}
+
+	// RX power below nominal but within absolute limits
+	weak := parseMlxcableOutput("mlx5_2", strings.Replace(opticalMlxcableOutput, "-1.120dBm", "-10.5dBm", 1))
+	cables := []IBCableCheckResult{healthy, weak}
+	status, err = validateIBCables(cables, config)
+	if status != "WARN" || err == nil || !strings.Contains(err.Error(), "RX power lane 2 -10.50dBm outside nominal range") {
+		t.Errorf("validateIBCables() = %s, %v, want WARN for mlx5_2", status, err)
+	}
+	if cables[0].Status != "PASS" || cables[1].Status != "WARN" {
+		t.Errorf("cable statuses = %s, %s, want PASS, WARN", cables[0].Status, cables[1].Status)
+	}
+
+	// Temperature beyond the absolute limit
+	hot := parseMlxcableOutput("mlx5_3", strings.Replace(opticalMlxcableOutput, "47C", "85C", 1))
+	status, err = validateIBCables([]IBCableCheckResult{weak, hot}, config)
+	if status != "FAIL" || err == nil || !strings.Contains(err.Error(), "mlx5_3 (temperature 85.00C outside absolute range [-5, 80])") {
+		t.Errorf("validateIBCables() = %s, %v, want FAIL for mlx5_3", status, err)
+	}
+
+	// Devices already failed while reading their cable stay failed
+	missing := IBCableCheckResult{Device: "mlx5_4", Status: "FAIL", Issues: []string{"no mst device found"}}
+	status, err = validateIBCables([]IBCableCheckResult{missing}, config)
+	if status != "FAIL" || err == nil || !strings.Contains(err.Error(), "mlx5_4 (no mst device found)") {
+		t.Errorf("validateIBCables() = %s, %v, want FAIL for mlx5_4", status, err)
+	}
+}
@@ -0,0 +1,163 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// IBPortStateCheckTestConfig represents the config needed to run this test
+type IBPortStateCheckTestConfig struct {
+	IsEnabled             bool   `json:"enabled"`
+	Shape                 string `json:"shape"`
+	ExpectedState         string `json:"expected_state"`
+	ExpectedPhysicalState string `json:"expected_physical_state"`
+}
+
+// IBPortState represents the ibstat state of a single port of an RDMA device
+type IBPortState struct {
+	Device        string `json:"device"`
+	Port          int    `json:"port"`
+	LinkLayer     string `json:"link_layer"`
+	State         string `json:"state"`
+	PhysicalState string `json:"physical_state"`
+	Status        string `json:"status"`
+}
+
+// getIBPortStateCheckTestConfig gets test config needed to run this test
+func getIBPortStateCheckTestConfig() (*IBPortStateCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	ibPortStateCheckTestConfig := &IBPortStateCheckTestConfig{
+		IsEnabled:             false,
+		Shape:                 shape,
+		ExpectedState:         "Active",
+		ExpectedPhysicalState: "LinkUp",
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "ib_port_state_check")
+	if err != nil {
+		return nil, err
+	}
+	ibPortStateCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "ib_port_state_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if state, ok := thresholdMap["expected_state"].(string); ok && state != "" {
+				ibPortStateCheckTestConfig.ExpectedState = state
+			}
+			if physicalState, ok := thresholdMap["expected_physical_state"].(string); ok && physicalState != "" {
+				ibPortStateCheckTestConfig.ExpectedPhysicalState = physicalState
+			}
+		}
+	}
+
+	return ibPortStateCheckTestConfig, nil
+}
+
+// validateIBPortStates sets the per-port status and returns the ports whose State or Physical state
+// differ from the expected values, as "device/port (state/physical state)"
+func validateIBPortStates(ports []IBPortState, testConfig *IBPortStateCheckTestConfig) []string {
+	var failed []string
+	for i := range ports {
+		port := &ports[i]
+		port.Status = "PASS"
+		if !strings.EqualFold(port.State, testConfig.ExpectedState) || !strings.EqualFold(port.PhysicalState, testConfig.ExpectedPhysicalState) {
+			port.Status = "FAIL"
+			failed = append(failed, fmt.Sprintf("%s/%d (%s/%s)", port.Device, port.Port, port.State, port.PhysicalState))
+		}
+	}
+	return failed
+}
+
+// getIBPortStates reads the State and Physical state of every port with ibstat
+func getIBPortStates() ([]IBPortState, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, commandError("ib_port_state_check", result, err)
+	}
+
+	var ports []IBPortState
+	for _, port := range parseIbstatPorts(result.Output) {
+		ports = append(ports, IBPortState{
+			Device:        port.Device,
+			Port:          port.Port,
+			LinkLayer:     port.LinkLayer,
+			State:         port.State,
+			PhysicalState: port.PhysicalState,
+		})
+	}
+
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no ports found in ibstat output")
+	}
+	return ports, nil
+}
+
+func RunIBPortStateCheck() error {
+	logger.Info("=== IB Port State Check ===")
+	testConfig, err := getIBPortStateCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "ib_port_state_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting IB port state check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the port states with ibstat
+	logger.Info("Step 1: Reading port states with ibstat...")
+	ports, err := getIBPortStates()
+	if err != nil {
+		logger.Error("IB Port State Check: FAIL - Could not read port states:", err)
+		rep.AddIBPortStateResult("FAIL", nil, nil, err)
+		return fmt.Errorf("could not read port states: %w", err)
+	}
+
+	// Step 2: Compare against the expected port states
+	logger.Infof("Step 2: Validating port states (expected State: %s, Physical state: %s)...",
+		testConfig.ExpectedState, testConfig.ExpectedPhysicalState)
+	failed := validateIBPortStates(ports, testConfig)
+	var failedPorts []string
+	for _, port := range ports {
+		logger.Infof("%s port %d (%s): State %s, Physical state %s - %s",
+			port.Device, port.Port, port.LinkLayer, port.State, port.PhysicalState, port.Status)
+		if port.Status == "FAIL" {
+			failedPorts = append(failedPorts, fmt.Sprintf("%s/%d", port.Device, port.Port))
+		}
+	}
+
+	if len(failed) > 0 {
+		err = fmt.Errorf("port(s) not %s/%s: %s", testConfig.ExpectedState, testConfig.ExpectedPhysicalState, strings.Join(failed, ", "))
+		logger.Error("IB Port State Check: FAIL -", err)
+		rep.AddIBPortStateResult("FAIL", ports, failedPorts, err)
+		return err
+	}
+
+	logger.Info("IB Port State Check: PASS - All ports are in the expected state")
+	rep.AddIBPortStateResult("PASS", ports, nil, nil)
+	return nil
+}
@@ -0,0 +1,35 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test validateIBPortStates function
+func TestValidateIBPortStates(t *testing.T) {
+	testConfig := &IBPortStateCheckTestConfig{ExpectedState: "Active", ExpectedPhysicalState: "LinkUp"}
+
+	var ports []IBPortState
+	for _, port := range parseIbstatPorts(testIbstatOutput) {
+		ports = append(ports, IBPortState{Device: port.Device, Port: port.Port, State: port.State, PhysicalState: port.PhysicalState})
+	}
+	ports = append(ports, IBPortState{Device: "mlx5_3", Port: 1, State: "Polling", PhysicalState: "Polling"},
+		IBPortState{Device: "mlx5_4", Port: 1, State: "Init", PhysicalState: "LinkUp"})
+
+	failed := validateIBPortStates(ports, testConfig)
+	want := []string{"mlx5_1/1 (Down/Disabled)", "mlx5_3/1 (Polling/Polling)", "mlx5_4/1 (Init/LinkUp)"}
+	if len(failed) != len(want) {
+		t.Fatalf("validateIBPortStates() = %v, want %v", failed, want)
+	}
+	for i := range want {
+		if failed[i] != want[i] {
+			t.Errorf("failed[%d] = %q, want %q", i, failed[i], want[i])
+		}
+	}
+	if ports[0].Status != "PASS" || ports[2].Status != "PASS" || ports[1].Status != "FAIL" {
+		t.Errorf("Unexpected port statuses %+v", ports)
+	}
+
+	if failed := validateIBPortStates(ports[:1], testConfig); len(failed) != 0 {
+		t.Errorf("validateIBPortStates() = %v, want no failed ports", failed)
+	}
+}
@@ -0,0 +1,269 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// Matches sminfo output such as:
+// sminfo: sm lid 1 sm guid 0x98039b0300a1b2c3, activity count 5032 priority 15 state 3 SMINFO_MASTER
+var sminfoRegex = regexp.MustCompile(`sm lid (\d+) sm guid (0x[0-9a-fA-F]+).*state \d+ (SMINFO_\w+)`)
+
+// IBSMCheckTestConfig represents the config needed to run this test
+type IBSMCheckTestConfig struct {
+	IsEnabled       bool   `json:"enabled"`
+	Shape           string `json:"shape"`
+	ExpectedSMCount int    `json:"expected_sm_count"`
+}
+
+// IBPortSMInfo represents the subnet manager state seen by a single InfiniBand port
+type IBPortSMInfo struct {
+	Device        string `json:"device"`
+	Port          int    `json:"port"`
+	State         string `json:"state"`
+	PhysicalState string `json:"physical_state,omitempty"`
+	LinkLayer     string `json:"link_layer"`
+	BaseLID       int    `json:"base_lid"`
+	SMLID         int    `json:"sm_lid"`
+	SMGUID        string `json:"sm_guid,omitempty"`
+	SMState       string `json:"sm_state,omitempty"`
+	Active        bool   `json:"active"`
+	Registered    bool   `json:"registered"`
+}
+
+// getIBSMCheckTestConfig gets test config needed to run this test
+func getIBSMCheckTestConfig() (*IBSMCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	ibSMCheckTestConfig := &IBSMCheckTestConfig{
+		IsEnabled:       false,
+		Shape:           shape,
+		ExpectedSMCount: 1,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "ib_sm_check")
+	if err != nil {
+		return nil, err
+	}
+	ibSMCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "ib_sm_check")
+	if err == nil {
+		switch v := threshold.(type) {
+		case map[string]interface{}:
+			if smCount, ok := v["expected_sm_count"].(float64); ok {
+				ibSMCheckTestConfig.ExpectedSMCount = int(smCount)
+			}
+		}
+	}
+
+	return ibSMCheckTestConfig, nil
+}
+
+// parseIbstatPorts parses ibstat output into per-port entries
+func parseIbstatPorts(output string) []IBPortSMInfo {
+	var ports []IBPortSMInfo
+	var current *IBPortSMInfo
+	device := ""
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if strings.HasPrefix(line, "CA '") {
+			device = strings.TrimSuffix(strings.TrimPrefix(line, "CA '"), "'")
+			continue
+		}
+
+		if strings.HasPrefix(line, "Port ") && strings.HasSuffix(line, ":") {
+			port, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "Port "), ":"))
+			if err != nil {
+				continue
+			}
+			ports = append(ports, IBPortSMInfo{Device: device, Port: port})
+			current = &ports[len(ports)-1]
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		switch strings.TrimSpace(parts[0]) {
+		case "State":
+			current.State = value
+		case "Physical state":
+			current.PhysicalState = value
+		case "Link layer":
+			current.LinkLayer = value
+		case "Base lid":
+			current.BaseLID, _ = strconv.Atoi(value)
+		case "SM lid":
+			current.SMLID, _ = strconv.Atoi(value)
+		}
+	}
+
+	for i := range ports {
+		ports[i].Active = ports[i].State == "Active"
+		ports[i].Registered = ports[i].BaseLID != 0 && ports[i].SMLID != 0
+	}
+
+	return ports
+}
+
+// parseSminfo parses sminfo output and returns the SM LID, GUID and state
+func parseSminfo(output string) (int, string, string, error) {
+	matches := sminfoRegex.FindStringSubmatch(output)
+	if matches == nil {
+		return 0, "", "", fmt.Errorf("could not parse sminfo output: %s", strings.TrimSpace(output))
+	}
+
+	lid, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, "", "", fmt.Errorf("invalid SM lid in sminfo output: %s", matches[1])
+	}
+
+	state := strings.ToLower(strings.TrimPrefix(matches[3], "SMINFO_"))
+	return lid, matches[2], state, nil
+}
+
+// validateIBSM checks that every InfiniBand port is active and registered with a master SM,
+// and that the number of distinct SMs seen across ports matches the expected count
+func validateIBSM(ports []IBPortSMInfo, expectedSMCount int) error {
+	if len(ports) == 0 {
+		return fmt.Errorf("no InfiniBand ports found")
+	}
+
+	var failures []string
+	smGUIDs := make(map[string]bool)
+	for _, port := range ports {
+		name := fmt.Sprintf("%s/%d", port.Device, port.Port)
+		if !port.Active {
+			failures = append(failures, fmt.Sprintf("%s is %s", name, port.State))
+			continue
+		}
+		if !port.Registered {
+			failures = append(failures, fmt.Sprintf("%s has no SM lid", name))
+			continue
+		}
+		if port.SMState != "master" {
+			failures = append(failures, fmt.Sprintf("%s SM is %s instead of master", name, port.SMState))
+			continue
+		}
+		if port.SMGUID != "" {
+			smGUIDs[port.SMGUID] = true
+		}
+	}
+
+	if len(smGUIDs) > expectedSMCount {
+		failures = append(failures, fmt.Sprintf("found %d master SMs, expected %d", len(smGUIDs), expectedSMCount))
+	}
+
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// getIBSMInfo collects per-port SM information using ibstat and sminfo
+func getIBSMInfo() ([]IBPortSMInfo, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("ib_sm_check", result, err))
+	}
+
+	// Only ports with an InfiniBand link layer are managed by a subnet manager
+	var ibPorts []IBPortSMInfo
+	for _, port := range parseIbstatPorts(result.Output) {
+		if port.LinkLayer != "InfiniBand" {
+			continue
+		}
+
+		if port.Active && port.SMLID != 0 {
+			smResult, err := executor.RunSminfo(port.Device, port.Port)
+			if err != nil {
+				logger.Errorf("SM not reachable from %s port %d: %v", port.Device, port.Port, err)
+				port.SMState = "unreachable"
+			} else {
+				_, guid, state, err := parseSminfo(smResult.Output)
+				if err != nil {
+					logger.Error(err)
+					port.SMState = "unknown"
+				} else {
+					port.SMGUID = guid
+					port.SMState = state
+				}
+			}
+		}
+		ibPorts = append(ibPorts, port)
+	}
+
+	return ibPorts, nil
+}
+
+func RunIBSMCheck() error {
+	logger.Info("=== InfiniBand Subnet Manager Check ===")
+	testConfig, err := getIBSMCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "ib_sm_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting InfiniBand subnet manager check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get SM information for each InfiniBand port
+	logger.Info("Step 1: Getting InfiniBand port and SM information...")
+	ports, err := getIBSMInfo()
+	if err != nil {
+		logger.Error("IB SM Check: FAIL - Could not get SM information:", err)
+		rep.AddIBSMResult("FAIL", nil, err)
+		return fmt.Errorf("could not get SM information: %w", err)
+	}
+	for _, port := range ports {
+		logger.Infof("%s port %d: state %s, SM lid %d, SM state %s", port.Device, port.Port, port.State, port.SMLID, port.SMState)
+	}
+
+	// Step 2: Validate SM state
+	logger.Info("Step 2: Validating subnet manager state...")
+	logger.Info("Expected SM count:", testConfig.ExpectedSMCount)
+	if err := validateIBSM(ports, testConfig.ExpectedSMCount); err != nil {
+		logger.Error("IB SM Check: FAIL -", err)
+		rep.AddIBSMResult("FAIL", ports, err)
+		return err
+	}
+
+	logger.Info("IB SM Check: PASS - All InfiniBand ports are registered with the subnet manager")
+	rep.AddIBSMResult("PASS", ports, nil)
+	return nil
+}
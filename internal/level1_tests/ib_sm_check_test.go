@@ -0,0 +1,177 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const testIbstatOutput = `CA 'mlx5_0'
+	CA type: MT4129
+	Number of ports: 1
+	Firmware version: 28.39.1002
+	Port 1:
+		State: Active
+		Physical state: LinkUp
+		Rate: 400
+		Base lid: 12
+		LMC: 0
+		SM lid: 1
+		Capability mask: 0xa751e848
+		Port GUID: 0x946dae0300d0e1f2
+		Link layer: InfiniBand
+CA 'mlx5_1'
+	CA type: MT4129
+	Number of ports: 1
+	Port 1:
+		State: Down
+		Physical state: Disabled
+		Base lid: 0
+		SM lid: 0
+		Link layer: InfiniBand
+CA 'mlx5_2'
+	CA type: MT4125
+	Number of ports: 1
+	Port 1:
+		State: Active
+		Physical state: LinkUp
+		Base lid: 0
+		SM lid: 0
+		Link layer: Ethernet
+`
+
+// Test parseIbstatPorts function
+func TestParseIbstatPorts(t *testing.T) {
+	ports := parseIbstatPorts(testIbstatOutput)
+	if len(ports) != 3 {
+		t.Fatalf("parseIbstatPorts() returned %d ports, want 3", len(ports))
+	}
+
+	expected := []IBPortSMInfo{
+		{Device: "mlx5_0", Port: 1, State: "Active", PhysicalState: "LinkUp", LinkLayer: "InfiniBand", BaseLID: 12, SMLID: 1, Active: true, Registered: true},
+		{Device: "mlx5_1", Port: 1, State: "Down", PhysicalState: "Disabled", LinkLayer: "InfiniBand", BaseLID: 0, SMLID: 0, Active: false, Registered: false},
+		{Device: "mlx5_2", Port: 1, State: "Active", PhysicalState: "LinkUp", LinkLayer: "Ethernet", BaseLID: 0, SMLID: 0, Active: true, Registered: false},
+	}
+	for i, want := range expected {
+		if ports[i] != want {
+			t.Errorf("port %d = %+v, want %+v", i, ports[i], want)
+		}
+	}
+
+	if ports := parseIbstatPorts(""); len(ports) != 0 {
+		t.Errorf("parseIbstatPorts(\"\") returned %d ports, want 0", len(ports))
+	}
+}
+
+// Test parseSminfo function
+func TestParseSminfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedLID   int
+		expectedGUID  string
+		expectedState string
+		expectError   bool
+	}{
+		{
+			name:          "Master SM",
+			input:         "sminfo: sm lid 1 sm guid 0x98039b0300a1b2c3, activity count 5032 priority 15 state 3 SMINFO_MASTER",
+			expectedLID:   1,
+			expectedGUID:  "0x98039b0300a1b2c3",
+			expectedState: "master",
+		},
+		{
+			name:          "Standby SM",
+			input:         "sminfo: sm lid 4 sm guid 0x98039b0300a1b2c4, activity count 12 priority 14 state 2 SMINFO_STANDBY",
+			expectedLID:   4,
+			expectedGUID:  "0x98039b0300a1b2c4",
+			expectedState: "standby",
+		},
+		{
+			name:        "Query failure",
+			input:       "ibwarn: [1234] mad_rpc: _do_madrpc failed; dport (Lid 1)\nsminfo: iberror: failed: query",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lid, guid, state, err := parseSminfo(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseSminfo() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if lid != tt.expectedLID || guid != tt.expectedGUID || state != tt.expectedState {
+				t.Errorf("parseSminfo() = %d, %s, %s, want %d, %s, %s", lid, guid, state, tt.expectedLID, tt.expectedGUID, tt.expectedState)
+			}
+		})
+	}
+}
+
+// Test validateIBSM function
+func TestValidateIBSM(t *testing.T) {
+	healthy := func(device string, guid string) IBPortSMInfo {
+		return IBPortSMInfo{Device: device, Port: 1, State: "Active", BaseLID: 12, SMLID: 1, SMGUID: guid, SMState: "master", Active: true, Registered: true}
+	}
+
+	tests := []struct {
+		name            string
+		ports           []IBPortSMInfo
+		expectedSMCount int
+		expectError     bool
+	}{
+		{
+			name:            "All ports registered with one SM",
+			ports:           []IBPortSMInfo{healthy("mlx5_0", "0x1"), healthy("mlx5_1", "0x1")},
+			expectedSMCount: 1,
+			expectError:     false,
+		},
+		{
+			name:            "No ports",
+			ports:           []IBPortSMInfo{},
+			expectedSMCount: 1,
+			expectError:     true,
+		},
+		{
+			name:            "Port down",
+			ports:           []IBPortSMInfo{healthy("mlx5_0", "0x1"), {Device: "mlx5_1", Port: 1, State: "Down"}},
+			expectedSMCount: 1,
+			expectError:     true,
+		},
+		{
+			name:            "Port without SM lid",
+			ports:           []IBPortSMInfo{{Device: "mlx5_0", Port: 1, State: "Active", Active: true, BaseLID: 12}},
+			expectedSMCount: 1,
+			expectError:     true,
+		},
+		{
+			name: "SM unreachable",
+			ports: []IBPortSMInfo{
+				{Device: "mlx5_0", Port: 1, State: "Active", BaseLID: 12, SMLID: 1, SMState: "unreachable", Active: true, Registered: true},
+			},
+			expectedSMCount: 1,
+			expectError:     true,
+		},
+		{
+			name:            "Conflicting master SMs",
+			ports:           []IBPortSMInfo{healthy("mlx5_0", "0x1"), healthy("mlx5_1", "0x2")},
+			expectedSMCount: 1,
+			expectError:     true,
+		},
+		{
+			name:            "Multiple fabrics each with one SM",
+			ports:           []IBPortSMInfo{healthy("mlx5_0", "0x1"), healthy("mlx5_1", "0x2")},
+			expectedSMCount: 2,
+			expectError:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIBSM(tt.ports, tt.expectedSMCount)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateIBSM() error = %v, wantErr %v", err, tt.expectError)
+			}
+		})
+	}
+}
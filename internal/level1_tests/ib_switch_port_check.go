@@ -0,0 +1,303 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// neighborDirectedRoute is the directed route from a local port to the node at the other end of its link
+const neighborDirectedRoute = "0,1"
+
+// ibSwitchPortErrorCounters are the perfquery counters of a switch port that indicate link or receive errors
+var ibSwitchPortErrorCounters = []string{
+	"SymbolErrorCounter",
+	"LinkErrorRecoveryCounter",
+	"LinkDownedCounter",
+	"PortRcvErrors",
+	"PortRcvRemotePhysicalErrors",
+	"PortXmitDiscards",
+	"LocalLinkIntegrityErrors",
+	"ExcessiveBufferOverrunErrors",
+}
+
+// IBSwitchPortCheckTestConfig represents the config needed to run this test.
+// A switch port error counter above MaxErrorCount fails the test.
+type IBSwitchPortCheckTestConfig struct {
+	IsEnabled     bool   `json:"enabled"`
+	Shape         string `json:"shape"`
+	MaxErrorCount int64  `json:"max_error_count"`
+}
+
+// getIBSwitchPortCheckTestConfig gets test config needed to run this test
+func getIBSwitchPortCheckTestConfig() (*IBSwitchPortCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	ibSwitchPortCheckTestConfig := &IBSwitchPortCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "ib_switch_port_check")
+	if err != nil {
+		return nil, err
+	}
+	ibSwitchPortCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "ib_switch_port_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if maxErrorCount, ok := thresholdMap["max_error_count"].(float64); ok {
+				ibSwitchPortCheckTestConfig.MaxErrorCount = int64(maxErrorCount)
+			}
+		}
+	}
+
+	return ibSwitchPortCheckTestConfig, nil
+}
+
+// parseIBDiagFields parses the "Name:.....value" lines of smpquery and perfquery output.
+// Comment lines such as "# Port info: DR path slid 65535; dlid 65535; 0,1 port 17" are skipped.
+func parseIBDiagFields(output string) map[string]string {
+	fields := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		fields[strings.TrimSpace(parts[0])] = strings.TrimSpace(strings.TrimLeft(parts[1], "."))
+	}
+	return fields
+}
+
+// parseIBSwitchPortErrorCounters returns the error counters from perfquery output of a switch port
+func parseIBSwitchPortErrorCounters(output string) (map[string]int64, error) {
+	fields := parseIBDiagFields(output)
+	counters := make(map[string]int64)
+	for _, name := range ibSwitchPortErrorCounters {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q in perfquery output", name, value)
+		}
+		counters[name] = count
+	}
+	if len(counters) == 0 {
+		return nil, fmt.Errorf("no error counters found in perfquery output")
+	}
+	return counters, nil
+}
+
+// querySwitchPortFields runs smpquery over the directed route to the neighbor of a local port
+func querySwitchPortFields(device string, port int, options ...string) (map[string]string, error) {
+	args := append([]string{"-D"}, options...)
+	result, err := executor.RunSmpquery(device, port, args...)
+	if err != nil {
+		return nil, commandError("ib_switch_port_check", result, err)
+	}
+	return parseIBDiagFields(result.Output), nil
+}
+
+// getIBSwitchPort reads the state and error counters of the switch port connected to a local port.
+// The neighbor NodeInfo LocalPort is the switch port the query entered through, and port 0 of the
+// switch holds the switch LID used to read the counters with perfquery.
+func getIBSwitchPort(device string, port int, nicState string) (reporter.IBSwitchPortResult, error) {
+	switchPort := reporter.IBSwitchPortResult{Device: device, Port: port, NICState: nicState}
+
+	nodeInfo, err := querySwitchPortFields(device, port, "nodeinfo", neighborDirectedRoute)
+	if err != nil {
+		return switchPort, fmt.Errorf("nodeinfo failed: %w", err)
+	}
+	if nodeType := nodeInfo["NodeType"]; nodeType != "Switch" {
+		return switchPort, fmt.Errorf("neighbor node type is %q, not a switch", nodeType)
+	}
+	switchPort.SwitchPort, err = strconv.Atoi(nodeInfo["LocalPort"])
+	if err != nil {
+		return switchPort, fmt.Errorf("invalid neighbor LocalPort %q", nodeInfo["LocalPort"])
+	}
+
+	managementPort, err := querySwitchPortFields(device, port, "portinfo", neighborDirectedRoute, "0")
+	if err != nil {
+		return switchPort, fmt.Errorf("switch portinfo failed: %w", err)
+	}
+	switchPort.SwitchLID, err = strconv.Atoi(managementPort["Lid"])
+	if err != nil || switchPort.SwitchLID == 0 {
+		return switchPort, fmt.Errorf("switch has no LID assigned by the subnet manager")
+	}
+
+	portInfo, err := querySwitchPortFields(device, port, "portinfo", neighborDirectedRoute, strconv.Itoa(switchPort.SwitchPort))
+	if err != nil {
+		return switchPort, fmt.Errorf("switch port %d portinfo failed: %w", switchPort.SwitchPort, err)
+	}
+	switchPort.SwitchState = portInfo["LinkState"]
+
+	result, err := executor.RunPerfquery(device, port, strconv.Itoa(switchPort.SwitchLID), strconv.Itoa(switchPort.SwitchPort))
+	if err != nil {
+		return switchPort, fmt.Errorf("switch port %d perfquery failed: %w", switchPort.SwitchPort, commandError("ib_switch_port_check", result, err))
+	}
+	switchPort.ErrorCounters, err = parseIBSwitchPortErrorCounters(result.Output)
+	if err != nil {
+		return switchPort, err
+	}
+
+	return switchPort, nil
+}
+
+// getIBSwitchPorts reads the switch port of every InfiniBand port. A port whose switch port
+// cannot be queried is reported with the query error and an empty switch state.
+func getIBSwitchPorts() ([]reporter.IBSwitchPortResult, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("ib_switch_port_check", result, err))
+	}
+
+	var switchPorts []reporter.IBSwitchPortResult
+	for _, port := range parseIbstatPorts(result.Output) {
+		if port.LinkLayer != "InfiniBand" {
+			continue
+		}
+
+		if !port.Active {
+			switchPorts = append(switchPorts, reporter.IBSwitchPortResult{
+				Device:   port.Device,
+				Port:     port.Port,
+				NICState: port.State,
+				Error:    "NIC port is not active, switch port not reachable",
+			})
+			continue
+		}
+
+		switchPort, err := getIBSwitchPort(port.Device, port.Port, port.State)
+		if err != nil {
+			logger.Errorf("Could not query switch port of %s port %d: %v", port.Device, port.Port, err)
+			switchPort.Error = err.Error()
+		}
+		switchPorts = append(switchPorts, switchPort)
+	}
+
+	if len(switchPorts) == 0 {
+		return nil, fmt.Errorf("no InfiniBand ports found")
+	}
+	return switchPorts, nil
+}
+
+// validateIBSwitchPorts sets the per-port status and returns the overall status.
+// A switch port error counter above maxErrorCount FAILs. A switch port state other than the
+// NIC port state, or a switch port that cannot be queried, WARNs.
+func validateIBSwitchPorts(switchPorts []reporter.IBSwitchPortResult, maxErrorCount int64) (string, error) {
+	if len(switchPorts) == 0 {
+		return "FAIL", fmt.Errorf("no InfiniBand ports found")
+	}
+
+	var erroredPorts, mismatchedPorts []string
+	for i := range switchPorts {
+		switchPort := &switchPorts[i]
+		switchPort.Status = "PASS"
+		name := fmt.Sprintf("%s/%d", switchPort.Device, switchPort.Port)
+
+		counters := make([]string, 0, len(switchPort.ErrorCounters))
+		for counter, count := range switchPort.ErrorCounters {
+			if count > maxErrorCount {
+				counters = append(counters, fmt.Sprintf("%s=%d", counter, count))
+			}
+		}
+		sort.Strings(counters)
+
+		switch {
+		case len(counters) > 0:
+			switchPort.Status = "FAIL"
+			erroredPorts = append(erroredPorts, fmt.Sprintf("%s switch LID %d port %d (%s)",
+				name, switchPort.SwitchLID, switchPort.SwitchPort, strings.Join(counters, ", ")))
+		case switchPort.Error != "":
+			switchPort.Status = "WARN"
+			mismatchedPorts = append(mismatchedPorts, fmt.Sprintf("%s (%s)", name, switchPort.Error))
+		case !strings.EqualFold(switchPort.SwitchState, switchPort.NICState):
+			switchPort.Status = "WARN"
+			mismatchedPorts = append(mismatchedPorts, fmt.Sprintf("%s is %s, switch LID %d port %d is %s",
+				name, switchPort.NICState, switchPort.SwitchLID, switchPort.SwitchPort, switchPort.SwitchState))
+		}
+	}
+
+	if len(erroredPorts) > 0 {
+		return "FAIL", fmt.Errorf("switch port errors on: %s", strings.Join(erroredPorts, "; "))
+	}
+	if len(mismatchedPorts) > 0 {
+		return "WARN", fmt.Errorf("switch port state differs from NIC port state: %s", strings.Join(mismatchedPorts, "; "))
+	}
+	return "PASS", nil
+}
+
+// RunIBSwitchPortCheck checks the state and error counters of the switch port of every InfiniBand port
+func RunIBSwitchPortCheck() error {
+	logger.Info("=== InfiniBand Switch Port Check ===")
+	testConfig, err := getIBSwitchPortCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "ib_switch_port_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting InfiniBand switch port check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Query the switch port of each InfiniBand port
+	logger.Info("Step 1: Querying switch ports...")
+	switchPorts, err := getIBSwitchPorts()
+	if err != nil {
+		logger.Error("IB Switch Port Check: FAIL - Could not query switch ports:", err)
+		rep.AddIBSwitchPortResult("FAIL", nil, err)
+		return fmt.Errorf("could not query switch ports: %w", err)
+	}
+
+	// Step 2: Validate switch port state and error counters
+	logger.Info("Step 2: Validating switch port state and error counters...")
+	logger.Info("Max error count:", testConfig.MaxErrorCount)
+	status, validationErr := validateIBSwitchPorts(switchPorts, testConfig.MaxErrorCount)
+	for _, switchPort := range switchPorts {
+		logger.Infof("%s port %d (%s): switch LID %d port %d (%s) - %s", switchPort.Device, switchPort.Port,
+			switchPort.NICState, switchPort.SwitchLID, switchPort.SwitchPort, switchPort.SwitchState, switchPort.Status)
+	}
+	rep.AddIBSwitchPortResult(status, switchPorts, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("IB Switch Port Check: PASS - All switch ports match the NIC port state without errors")
+		return nil
+	case "WARN":
+		logger.Info("IB Switch Port Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("IB Switch Port Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
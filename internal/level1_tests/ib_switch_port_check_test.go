@@ -0,0 +1,104 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+// Test parseIBDiagFields function with smpquery nodeinfo output
+func TestParseIBDiagFields(t *testing.T) {
+	output := "# Node info: DR path slid 65535; dlid 65535; 0,1\n" +
+		"BaseVers:........................1\n" +
+		"NodeType:........................Switch\n" +
+		"NumPorts:........................41\n" +
+		"NodeGuid:........................0xfc6a1c0300a1b2c3\n" +
+		"LocalPort:.......................17\n"
+
+	fields := parseIBDiagFields(output)
+	if fields["NodeType"] != "Switch" || fields["LocalPort"] != "17" || fields["NodeGuid"] != "0xfc6a1c0300a1b2c3" {
+		t.Errorf("parseIBDiagFields() = %v", fields)
+	}
+	if _, ok := fields["# Node info"]; ok {
+		t.Error("parseIBDiagFields() should skip comment lines")
+	}
+}
+
+// Test parseIBSwitchPortErrorCounters function with perfquery output
+func TestParseIBSwitchPortErrorCounters(t *testing.T) {
+	output := "# Port counters: Lid 12 port 17 (CapMask: 0x5A00)\n" +
+		"PortSelect:......................17\n" +
+		"CounterSelect:...................0x0000\n" +
+		"SymbolErrorCounter:..............3\n" +
+		"LinkErrorRecoveryCounter:........0\n" +
+		"LinkDownedCounter:...............0\n" +
+		"PortRcvErrors:...................1\n" +
+		"PortRcvRemotePhysicalErrors:.....0\n" +
+		"PortXmitDiscards:................0\n" +
+		"LocalLinkIntegrityErrors:........0\n" +
+		"ExcessiveBufferOverrunErrors:....0\n" +
+		"PortXmitData:....................123456789\n"
+
+	counters, err := parseIBSwitchPortErrorCounters(output)
+	if err != nil {
+		t.Fatalf("parseIBSwitchPortErrorCounters() error = %v", err)
+	}
+	expected := map[string]int64{
+		"SymbolErrorCounter":           3,
+		"LinkErrorRecoveryCounter":     0,
+		"LinkDownedCounter":            0,
+		"PortRcvErrors":                1,
+		"PortRcvRemotePhysicalErrors":  0,
+		"PortXmitDiscards":             0,
+		"LocalLinkIntegrityErrors":     0,
+		"ExcessiveBufferOverrunErrors": 0,
+	}
+	if !reflect.DeepEqual(counters, expected) {
+		t.Errorf("parseIBSwitchPortErrorCounters() = %v, want %v", counters, expected)
+	}
+
+	if _, err := parseIBSwitchPortErrorCounters("ibwarn: [1234] mad_rpc: _do_madrpc failed\n"); err == nil {
+		t.Error("parseIBSwitchPortErrorCounters() expected error for output without counters")
+	}
+}
+
+// Test validateIBSwitchPorts function
+func TestValidateIBSwitchPorts(t *testing.T) {
+	healthy := func(device string) reporter.IBSwitchPortResult {
+		return reporter.IBSwitchPortResult{Device: device, Port: 1, NICState: "Active", SwitchLID: 12, SwitchPort: 17,
+			SwitchState: "Active", ErrorCounters: map[string]int64{"SymbolErrorCounter": 0, "PortRcvErrors": 0}}
+	}
+
+	errored := healthy("mlx5_1")
+	errored.ErrorCounters = map[string]int64{"SymbolErrorCounter": 5, "PortRcvErrors": 0}
+	mismatched := healthy("mlx5_2")
+	mismatched.SwitchState = "Armed"
+	unreachable := reporter.IBSwitchPortResult{Device: "mlx5_3", Port: 1, NICState: "Down", Error: "NIC port is not active, switch port not reachable"}
+
+	tests := []struct {
+		name           string
+		ports          []reporter.IBSwitchPortResult
+		maxErrorCount  int64
+		expectedStatus string
+	}{
+		{"all healthy", []reporter.IBSwitchPortResult{healthy("mlx5_0"), healthy("mlx5_1")}, 0, "PASS"},
+		{"switch port errors", []reporter.IBSwitchPortResult{healthy("mlx5_0"), errored, mismatched}, 0, "FAIL"},
+		{"errors within threshold", []reporter.IBSwitchPortResult{healthy("mlx5_0"), errored}, 5, "PASS"},
+		{"state mismatch", []reporter.IBSwitchPortResult{healthy("mlx5_0"), mismatched}, 0, "WARN"},
+		{"switch port unreachable", []reporter.IBSwitchPortResult{unreachable}, 0, "WARN"},
+		{"no ports", nil, 0, "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateIBSwitchPorts(tt.ports, tt.maxErrorCount)
+			if status != tt.expectedStatus {
+				t.Errorf("validateIBSwitchPorts() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateIBSwitchPorts() error = %v", err)
+			}
+		})
+	}
+}
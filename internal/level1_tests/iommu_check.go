@@ -0,0 +1,195 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// IOMMUCheckTestConfig represents the config needed to run this test
+type IOMMUCheckTestConfig struct {
+	IsEnabled    bool     `json:"enabled"`
+	Shape        string   `json:"shape"`
+	AllowedModes []string `json:"allowed_modes"`
+}
+
+// IOMMUInfo represents the IOMMU configuration of the host
+type IOMMUInfo struct {
+	Enabled        bool   `json:"enabled"`
+	Mode           string `json:"mode"`
+	GPUIOMMUGroups int    `json:"gpu_iommu_groups"`
+}
+
+// getIOMMUCheckTestConfig gets test config needed to run this test
+func getIOMMUCheckTestConfig() (*IOMMUCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	iommuCheckTestConfig := &IOMMUCheckTestConfig{
+		IsEnabled:    false,
+		Shape:        shape,
+		AllowedModes: []string{"passthrough", "disabled"},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "iommu_check")
+	if err != nil {
+		return nil, err
+	}
+	iommuCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "iommu_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if allowed, ok := thresholdMap["allowed_modes"].([]interface{}); ok {
+				var allowedModes []string
+				for _, mode := range allowed {
+					if modeStr, ok := mode.(string); ok {
+						allowedModes = append(allowedModes, modeStr)
+					}
+				}
+				if len(allowedModes) > 0 {
+					iommuCheckTestConfig.AllowedModes = allowedModes
+				}
+			}
+		}
+	}
+
+	return iommuCheckTestConfig, nil
+}
+
+// parseIOMMUCmdline determines the IOMMU state from the kernel command line.
+// hasGroups reports whether the kernel created IOMMU groups, which also covers
+// IOMMUs that are enabled by default without a command line parameter.
+// The returned mode is "disabled", "passthrough" or "strict".
+func parseIOMMUCmdline(cmdline string, hasGroups bool) (bool, string) {
+	enabled := hasGroups
+	passthrough := false
+
+	for _, param := range strings.Fields(cmdline) {
+		switch param {
+		case "intel_iommu=on", "amd_iommu=on":
+			enabled = true
+		case "intel_iommu=off", "amd_iommu=off", "iommu=off":
+			enabled = false
+		case "iommu=pt", "iommu.passthrough=1":
+			passthrough = true
+		case "iommu=nopt", "iommu.passthrough=0":
+			passthrough = false
+		}
+	}
+
+	if !enabled {
+		return false, "disabled"
+	}
+	if passthrough {
+		return true, "passthrough"
+	}
+	return true, "strict"
+}
+
+// countGPUIOMMUGroups counts IOMMU groups containing at least one of the GPU PCI addresses
+func countGPUIOMMUGroups(groups map[string][]string, gpuPCIAddresses []string) int {
+	count := 0
+	for _, devices := range groups {
+		for _, device := range devices {
+			if containsString(gpuPCIAddresses, strings.ToLower(device)) {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// validateIOMMU checks the IOMMU mode is one of the modes allowed for the shape
+func validateIOMMU(info *IOMMUInfo, allowedModes []string) error {
+	if !containsString(allowedModes, info.Mode) {
+		return fmt.Errorf("IOMMU mode %s is not allowed for this shape (allowed: %s)", info.Mode, strings.Join(allowedModes, ", "))
+	}
+	return nil
+}
+
+// getIOMMUInfo collects the IOMMU mode and the number of IOMMU groups containing GPUs
+func getIOMMUInfo() (*IOMMUInfo, error) {
+	result, err := executor.RunCat("/proc/cmdline")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kernel command line: %w", err)
+	}
+
+	groups, err := executor.GetIOMMUGroups()
+	if err != nil {
+		logger.Info("No IOMMU groups available:", err)
+		groups = map[string][]string{}
+	}
+
+	info := &IOMMUInfo{}
+	info.Enabled, info.Mode = parseIOMMUCmdline(result.Output, len(groups) > 0)
+
+	gpus, err := executor.GetGPUInfo()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GPU information: %w", err)
+	}
+	var gpuPCIAddresses []string
+	for _, gpu := range gpus {
+		gpuPCIAddresses = append(gpuPCIAddresses, gpu.PCI)
+	}
+	info.GPUIOMMUGroups = countGPUIOMMUGroups(groups, gpuPCIAddresses)
+
+	return info, nil
+}
+
+func RunIOMMUCheck() error {
+	logger.Info("=== IOMMU Check ===")
+	testConfig, err := getIOMMUCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "iommu_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting IOMMU configuration check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get IOMMU configuration
+	logger.Info("Step 1: Getting IOMMU configuration...")
+	info, err := getIOMMUInfo()
+	if err != nil {
+		logger.Error("IOMMU Check: FAIL - Could not get IOMMU configuration:", err)
+		rep.AddIOMMUResult("FAIL", false, "", 0, err)
+		return fmt.Errorf("could not get IOMMU configuration: %w", err)
+	}
+	logger.Infof("IOMMU enabled: %t, mode: %s, IOMMU groups with GPUs: %d", info.Enabled, info.Mode, info.GPUIOMMUGroups)
+
+	// Step 2: Validate IOMMU mode
+	logger.Info("Step 2: Validating IOMMU mode...")
+	logger.Info("Allowed IOMMU modes:", testConfig.AllowedModes)
+	if err := validateIOMMU(info, testConfig.AllowedModes); err != nil {
+		logger.Error("IOMMU Check: FAIL -", err)
+		rep.AddIOMMUResult("FAIL", info.Enabled, info.Mode, info.GPUIOMMUGroups, err)
+		return err
+	}
+
+	logger.Info("IOMMU Check: PASS - IOMMU mode is allowed for this shape")
+	rep.AddIOMMUResult("PASS", info.Enabled, info.Mode, info.GPUIOMMUGroups, nil)
+	return nil
+}
@@ -0,0 +1,102 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseIOMMUCmdline function
+func TestParseIOMMUCmdline(t *testing.T) {
+	tests := []struct {
+		name            string
+		cmdline         string
+		hasGroups       bool
+		expectedEnabled bool
+		expectedMode    string
+	}{
+		{
+			name:            "Intel IOMMU passthrough",
+			cmdline:         "BOOT_IMAGE=/vmlinuz root=/dev/sda1 intel_iommu=on iommu=pt",
+			hasGroups:       true,
+			expectedEnabled: true,
+			expectedMode:    "passthrough",
+		},
+		{
+			name:            "AMD IOMMU strict",
+			cmdline:         "BOOT_IMAGE=/vmlinuz root=/dev/sda1 amd_iommu=on",
+			hasGroups:       true,
+			expectedEnabled: true,
+			expectedMode:    "strict",
+		},
+		{
+			name:            "Enabled by default with passthrough",
+			cmdline:         "BOOT_IMAGE=/vmlinuz root=/dev/sda1 iommu.passthrough=1",
+			hasGroups:       true,
+			expectedEnabled: true,
+			expectedMode:    "passthrough",
+		},
+		{
+			name:            "Explicitly disabled",
+			cmdline:         "BOOT_IMAGE=/vmlinuz root=/dev/sda1 intel_iommu=off",
+			hasGroups:       false,
+			expectedEnabled: false,
+			expectedMode:    "disabled",
+		},
+		{
+			name:            "No IOMMU",
+			cmdline:         "BOOT_IMAGE=/vmlinuz root=/dev/sda1",
+			hasGroups:       false,
+			expectedEnabled: false,
+			expectedMode:    "disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled, mode := parseIOMMUCmdline(tt.cmdline, tt.hasGroups)
+			if enabled != tt.expectedEnabled || mode != tt.expectedMode {
+				t.Errorf("parseIOMMUCmdline() = %t, %s, want %t, %s", enabled, mode, tt.expectedEnabled, tt.expectedMode)
+			}
+		})
+	}
+}
+
+// Test countGPUIOMMUGroups function
+func TestCountGPUIOMMUGroups(t *testing.T) {
+	groups := map[string][]string{
+		"10": {"0000:0f:00.0"},
+		"11": {"0000:2d:00.0", "0000:2d:00.1"},
+		"12": {"0000:0c:00.0"},
+	}
+	gpus := []string{"0000:0f:00.0", "0000:2d:00.0"}
+
+	if count := countGPUIOMMUGroups(groups, gpus); count != 2 {
+		t.Errorf("countGPUIOMMUGroups() = %d, want 2", count)
+	}
+	if count := countGPUIOMMUGroups(map[string][]string{}, gpus); count != 0 {
+		t.Errorf("countGPUIOMMUGroups() with no groups = %d, want 0", count)
+	}
+}
+
+// Test validateIOMMU function
+func TestValidateIOMMU(t *testing.T) {
+	allowed := []string{"passthrough", "disabled"}
+
+	tests := []struct {
+		name        string
+		mode        string
+		expectError bool
+	}{
+		{name: "Passthrough allowed", mode: "passthrough", expectError: false},
+		{name: "Disabled allowed", mode: "disabled", expectError: false},
+		{name: "Strict not allowed", mode: "strict", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIOMMU(&IOMMUInfo{Mode: tt.mode}, allowed)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateIOMMU() error = %v, wantErr %v", err, tt.expectError)
+			}
+		})
+	}
+}
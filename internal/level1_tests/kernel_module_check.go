@@ -0,0 +1,222 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// KernelModuleCheckTestConfig represents the config needed to run this test
+type KernelModuleCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// KernelModuleVersion represents the kernel and userspace versions of a single module
+type KernelModuleVersion struct {
+	Module           string `json:"module"`
+	KernelVersion    string `json:"kernel_version"`
+	UserspaceVersion string `json:"userspace_version"`
+	Match            bool   `json:"match"`
+	Status           string `json:"status"`
+}
+
+// getKernelModuleCheckTestConfig gets test config needed to run this test
+func getKernelModuleCheckTestConfig() (*KernelModuleCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	kernelModuleCheckTestConfig := &KernelModuleCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "kernel_module_check")
+	if err != nil {
+		return nil, err
+	}
+	kernelModuleCheckTestConfig.IsEnabled = enabled
+
+	return kernelModuleCheckTestConfig, nil
+}
+
+// parseModinfoVersion parses the output of modinfo -F version
+func parseModinfoVersion(output string) (string, error) {
+	version := strings.TrimSpace(output)
+	if version == "" || strings.Contains(version, "\n") || strings.Contains(version, " ") {
+		return "", fmt.Errorf("could not parse module version from modinfo output: %s", version)
+	}
+	return version, nil
+}
+
+// parseOfedVersion parses ofed_info -s output such as "MLNX_OFED_LINUX-24.01-0.3.3.1:"
+// or "OFED-internal-24.10-1.1.4:" by taking everything from the first digit
+func parseOfedVersion(output string) (string, error) {
+	version := strings.TrimSuffix(strings.TrimSpace(output), ":")
+	idx := strings.IndexAny(version, "0123456789")
+	if idx < 0 {
+		return "", fmt.Errorf("could not parse OFED version from ofed_info output: %s", output)
+	}
+	return version[idx:], nil
+}
+
+// validateKernelModules sets the per-module match status and returns the overall status.
+// An nvidia mismatch FAILs since the driver must be rebuilt for the running kernel,
+// an mlx5_core mismatch with the installed OFED WARNs. Modules without a userspace
+// version to compare against are skipped.
+func validateKernelModules(modules []KernelModuleVersion) (string, error) {
+	status := "PASS"
+	var mismatches []string
+
+	for i := range modules {
+		module := &modules[i]
+		if module.UserspaceVersion == "" {
+			module.Status = "SKIP"
+			continue
+		}
+
+		if module.Module == "nvidia" {
+			module.Match = module.KernelVersion == module.UserspaceVersion
+		} else {
+			// OFED versions carry an extra build suffix that modinfo omits
+			module.Match = strings.HasPrefix(module.UserspaceVersion, module.KernelVersion) ||
+				strings.HasPrefix(module.KernelVersion, module.UserspaceVersion)
+		}
+
+		if module.Match {
+			module.Status = "PASS"
+			continue
+		}
+
+		mismatches = append(mismatches, fmt.Sprintf("%s kernel module %s does not match userspace %s",
+			module.Module, module.KernelVersion, module.UserspaceVersion))
+		if module.Module == "nvidia" {
+			module.Status = "FAIL"
+			status = "FAIL"
+		} else {
+			module.Status = "WARN"
+			if status != "FAIL" {
+				status = "WARN"
+			}
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return status, errors.New(strings.Join(mismatches, "; "))
+	}
+	return status, nil
+}
+
+// getKernelModuleVersions collects kernel module and userspace versions for nvidia and mlx5_core
+func getKernelModuleVersions() ([]KernelModuleVersion, error) {
+	// nvidia kernel module vs nvidia-smi driver version
+	result, err := executor.RunModinfo("nvidia", "-F", "version")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nvidia module version: %w", commandError("kernel_module_check", result, err))
+	}
+	nvidiaKernelVersion, err := parseModinfoVersion(result.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	smiResult := executor.RunNvidiaSMIQuery("driver_version")
+	if !smiResult.Available {
+		return nil, nvidiaSMIError("kernel_module_check", "nvidia-smi --query-gpu=driver_version", smiResult)
+	}
+	driverVersion := strings.TrimSpace(strings.Split(strings.TrimSpace(smiResult.Output), "\n")[0])
+	if driverVersion == "" {
+		return nil, fmt.Errorf("no driver version returned from nvidia-smi")
+	}
+
+	modules := []KernelModuleVersion{
+		{Module: "nvidia", KernelVersion: nvidiaKernelVersion, UserspaceVersion: driverVersion},
+	}
+
+	// mlx5_core kernel module vs installed MLNX_OFED
+	result, err = executor.RunModinfo("mlx5_core", "-F", "version")
+	if err != nil {
+		logger.Info("Could not get mlx5_core module version:", err)
+		return modules, nil
+	}
+	mlx5KernelVersion, err := parseModinfoVersion(result.Output)
+	if err != nil {
+		logger.Info("Could not parse mlx5_core module version:", err)
+		return modules, nil
+	}
+
+	// Inbox mlx5 drivers have no OFED userspace to compare against
+	ofedVersion := ""
+	if result, err := executor.RunOfedInfo(); err == nil {
+		if version, err := parseOfedVersion(result.Output); err == nil {
+			ofedVersion = version
+		}
+	} else {
+		logger.Info("MLNX_OFED not installed, skipping mlx5_core version comparison")
+	}
+
+	modules = append(modules, KernelModuleVersion{Module: "mlx5_core", KernelVersion: mlx5KernelVersion, UserspaceVersion: ofedVersion})
+	return modules, nil
+}
+
+func RunKernelModuleCheck() error {
+	logger.Info("=== Kernel Module Check ===")
+	testConfig, err := getKernelModuleCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "kernel_module_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting kernel module version check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get kernel module and userspace versions
+	logger.Info("Step 1: Getting kernel module and userspace versions...")
+	modules, err := getKernelModuleVersions()
+	if err != nil {
+		logger.Error("Kernel Module Check: FAIL - Could not get module versions:", err)
+		rep.AddKernelModuleResult("FAIL", nil, err)
+		return fmt.Errorf("could not get module versions: %w", err)
+	}
+
+	// Step 2: Compare kernel and userspace versions
+	logger.Info("Step 2: Comparing kernel module and userspace versions...")
+	status, validationErr := validateKernelModules(modules)
+	for _, module := range modules {
+		logger.Infof("%s: kernel %s, userspace %s - %s", module.Module, module.KernelVersion, module.UserspaceVersion, module.Status)
+	}
+
+	switch status {
+	case "PASS":
+		logger.Info("Kernel Module Check: PASS - Kernel module versions match userspace")
+		rep.AddKernelModuleResult("PASS", modules, nil)
+		return nil
+	case "WARN":
+		logger.Info("Kernel Module Check: WARN -", validationErr)
+		rep.AddKernelModuleResult("WARN", modules, validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("Kernel Module Check: FAIL -", validationErr)
+		rep.AddKernelModuleResult("FAIL", modules, validationErr)
+		return validationErr
+	}
+}
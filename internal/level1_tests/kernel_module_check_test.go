@@ -0,0 +1,126 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseModinfoVersion function
+func TestParseModinfoVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{name: "nvidia version", input: "550.90.12\n", expected: "550.90.12"},
+		{name: "mlx5_core version", input: "24.01-0.3.3\n", expected: "24.01-0.3.3"},
+		{name: "Empty output", input: "", expectError: true},
+		{name: "Module not found", input: "modinfo: ERROR: Module nvidia not found.", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := parseModinfoVersion(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseModinfoVersion() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if version != tt.expected {
+				t.Errorf("parseModinfoVersion() = %s, want %s", version, tt.expected)
+			}
+		})
+	}
+}
+
+// Test parseOfedVersion function
+func TestParseOfedVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{name: "MLNX_OFED", input: "MLNX_OFED_LINUX-24.01-0.3.3.1:\n", expected: "24.01-0.3.3.1"},
+		{name: "DOCA OFED", input: "OFED-internal-24.10-1.1.4:\n", expected: "24.10-1.1.4"},
+		{name: "Empty output", input: "", expectError: true},
+		{name: "Not installed", input: "ofed_info: command not found", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := parseOfedVersion(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseOfedVersion() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if version != tt.expected {
+				t.Errorf("parseOfedVersion() = %s, want %s", version, tt.expected)
+			}
+		})
+	}
+}
+
+// Test validateKernelModules function
+func TestValidateKernelModules(t *testing.T) {
+	tests := []struct {
+		name           string
+		modules        []KernelModuleVersion
+		expectedStatus string
+		expectedError  bool
+		expectedModule []string
+	}{
+		{
+			name: "All versions match",
+			modules: []KernelModuleVersion{
+				{Module: "nvidia", KernelVersion: "550.90.12", UserspaceVersion: "550.90.12"},
+				{Module: "mlx5_core", KernelVersion: "24.01-0.3.3", UserspaceVersion: "24.01-0.3.3.1"},
+			},
+			expectedStatus: "PASS",
+			expectedModule: []string{"PASS", "PASS"},
+		},
+		{
+			name: "nvidia mismatch after kernel update",
+			modules: []KernelModuleVersion{
+				{Module: "nvidia", KernelVersion: "535.104.12", UserspaceVersion: "550.90.12"},
+				{Module: "mlx5_core", KernelVersion: "24.01-0.3.3", UserspaceVersion: "24.01-0.3.3.1"},
+			},
+			expectedStatus: "FAIL",
+			expectedError:  true,
+			expectedModule: []string{"FAIL", "PASS"},
+		},
+		{
+			name: "mlx5_core mismatch",
+			modules: []KernelModuleVersion{
+				{Module: "nvidia", KernelVersion: "550.90.12", UserspaceVersion: "550.90.12"},
+				{Module: "mlx5_core", KernelVersion: "23.10-1.1.9", UserspaceVersion: "24.01-0.3.3.1"},
+			},
+			expectedStatus: "WARN",
+			expectedError:  true,
+			expectedModule: []string{"PASS", "WARN"},
+		},
+		{
+			name: "Inbox mlx5_core without OFED",
+			modules: []KernelModuleVersion{
+				{Module: "nvidia", KernelVersion: "550.90.12", UserspaceVersion: "550.90.12"},
+				{Module: "mlx5_core", KernelVersion: "5.15.0-1060-oracle"},
+			},
+			expectedStatus: "PASS",
+			expectedModule: []string{"PASS", "SKIP"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateKernelModules(tt.modules)
+			if status != tt.expectedStatus {
+				t.Errorf("validateKernelModules() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("validateKernelModules() error = %v, wantErr %v", err, tt.expectedError)
+			}
+			for i, want := range tt.expectedModule {
+				if tt.modules[i].Status != want {
+					t.Errorf("%s status = %v, want %v", tt.modules[i].Module, tt.modules[i].Status, want)
+				}
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -15,6 +16,102 @@ import (
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 )
 
+// Default BER thresholds used when test_limits.json does not configure them
+const (
+	defaultEffectivePhysicalBERThreshold = 1E-12
+	defaultRawPhysicalBERThreshold       = 1E-5
+)
+
+// infiniBandGenerations maps InfiniBand link speeds to the names of their generations
+var infiniBandGenerations = map[string]string{
+	"100G": "EDR",
+	"200G": "HDR",
+	"400G": "NDR",
+	"800G": "XDR",
+}
+
+// BERThresholds represents the effective and raw physical BER thresholds of an interface type
+type BERThresholds struct {
+	EffectivePhysicalBER float64 `json:"effective_physical_ber"`
+	RawPhysicalBER       float64 `json:"raw_physical_ber"`
+}
+
+// linkInterfaceType returns the interface type whose BER thresholds apply to a link of the given speed,
+// e.g. "IB_HDR" for a 200G InfiniBand interface (ib* interface names) or "RoCE_100GbE" for a 100G
+// Ethernet interface
+func linkInterfaceType(interfaceName string, speed string) string {
+	if strings.HasPrefix(interfaceName, "ib") {
+		if speed == "" {
+			return "IB"
+		}
+		if generation, ok := infiniBandGenerations[speed]; ok {
+			return "IB_" + generation
+		}
+		return "IB_" + speed
+	}
+	if speed == "" {
+		return "RoCE"
+	}
+	return "RoCE_" + speed + "bE"
+}
+
+// shapeInterfaceName returns the first interface name shapes.json configures for the NICs of a shape,
+// or "" when it configures none
+func shapeInterfaceName(interfaceNames []string) string {
+	for _, interfaceName := range interfaceNames {
+		if interfaceName != "" {
+			return interfaceName
+		}
+	}
+	return ""
+}
+
+// readBERThresholds returns thresholds updated with the effective_physical_ber and raw_physical_ber
+// configured in values
+func readBERThresholds(values map[string]interface{}, thresholds BERThresholds) BERThresholds {
+	if effBER, ok := values["effective_physical_ber"].(float64); ok {
+		thresholds.EffectivePhysicalBER = effBER
+	}
+	if rawBER, ok := values["raw_physical_ber"].(float64); ok {
+		thresholds.RawPhysicalBER = rawBER
+	}
+	return thresholds
+}
+
+// parseInterfaceTypeBERThresholds parses the interface_type_ber_thresholds map of a link check threshold.
+// A single effective_physical_ber and raw_physical_ber apply to interfaceType when the map does not configure it.
+func parseInterfaceTypeBERThresholds(threshold map[string]interface{}, interfaceType string) map[string]BERThresholds {
+	defaults := BERThresholds{
+		EffectivePhysicalBER: defaultEffectivePhysicalBERThreshold,
+		RawPhysicalBER:       defaultRawPhysicalBERThreshold,
+	}
+
+	berThresholds := make(map[string]BERThresholds)
+	if interfaceTypes, ok := threshold["interface_type_ber_thresholds"].(map[string]interface{}); ok {
+		for name, value := range interfaceTypes {
+			if values, ok := value.(map[string]interface{}); ok {
+				berThresholds[name] = readBERThresholds(values, defaults)
+			}
+		}
+	}
+	if _, ok := berThresholds[interfaceType]; !ok {
+		berThresholds[interfaceType] = readBERThresholds(threshold, defaults)
+	}
+	return berThresholds
+}
+
+// berThresholdsFor returns the BER thresholds of an interface type, or the default thresholds when
+// the interface type has none configured
+func berThresholdsFor(interfaceType string, berThresholds map[string]BERThresholds) BERThresholds {
+	if thresholds, ok := berThresholds[interfaceType]; ok {
+		return thresholds
+	}
+	return BERThresholds{
+		EffectivePhysicalBER: defaultEffectivePhysicalBERThreshold,
+		RawPhysicalBER:       defaultRawPhysicalBERThreshold,
+	}
+}
+
 // LinkCheckResult represents the result of link parsing
 type LinkCheckResult struct {
 	Device                      string `json:"device"`
@@ -26,14 +123,19 @@ type LinkCheckResult struct {
 	EffectivePhysicalBER        string `json:"effective_physical_ber"`
 	RawPhysicalErrorsPerLane    string `json:"raw_physical_errors_per_lane"`
 	RawPhysicalBER              string `json:"raw_physical_ber"`
+	// EffectivePhysicalBERValue is the measured BER, kept in reports for ber_trend_check
+	EffectivePhysicalBERValue   *float64 `json:"effective_physical_ber_value,omitempty"`
 }
 
-// LinkCheckTestConfig represents the test configuration for link check
+// LinkCheckTestConfig represents the test configuration for link check.
+// InterfaceType selects the BER thresholds of the RDMA interfaces from InterfaceTypeBERThresholds.
 type LinkCheckTestConfig struct {
-	IsEnabled                           bool    `json:"enabled"`
-	ExpectedSpeed                       string  `json:"speed"`
-	EffectivePhysicalErrorsThreshold    int     `json:"effective_physical_errors"`
-	RawPhysicalErrorsPerLaneThreshold   int     `json:"raw_physical_errors_per_lane"`
+	IsEnabled                         bool                     `json:"enabled"`
+	ExpectedSpeed                     string                   `json:"speed"`
+	EffectivePhysicalErrorsThreshold  int                      `json:"effective_physical_errors"`
+	RawPhysicalErrorsPerLaneThreshold int                      `json:"raw_physical_errors_per_lane"`
+	InterfaceType                     string                   `json:"interface_type"`
+	InterfaceTypeBERThresholds        map[string]BERThresholds `json:"interface_type_ber_thresholds"`
 }
 
 // getLinkCheckTestConfig gets test config needed to run this test
@@ -94,6 +196,23 @@ func getLinkCheckTestConfig(shape string) (*LinkCheckTestConfig, error) {
 			logger.Info("Using configured raw physical errors per lane threshold:", int(rawErrors), "for shape", shape)
 		}
 		
+		// Determine the interface type from the RDMA interfaces of the shape and the link speed
+		var interfaceNames []string
+		if shapeManager, err := shapes.NewShapeManager(config.GetShapesFilePath()); err == nil {
+			if rdmaNics, err := shapeManager.GetRDMANics(shape); err == nil {
+				for _, nic := range rdmaNics {
+					interfaceNames = append(interfaceNames, nic.Interface)
+				}
+			}
+		}
+		linkCheckTestConfig.InterfaceType = linkInterfaceType(shapeInterfaceName(interfaceNames), linkCheckTestConfig.ExpectedSpeed)
+
+		// Update BER thresholds per interface type
+		linkCheckTestConfig.InterfaceTypeBERThresholds = parseInterfaceTypeBERThresholds(v, linkCheckTestConfig.InterfaceType)
+		berThresholds := berThresholdsFor(linkCheckTestConfig.InterfaceType, linkCheckTestConfig.InterfaceTypeBERThresholds)
+		logger.Infof("Using BER thresholds of interface type %s: effective physical BER %g, raw physical BER %g for shape %s",
+			linkCheckTestConfig.InterfaceType, berThresholds.EffectivePhysicalBER, berThresholds.RawPhysicalBER, shape)
+
 		logger.Info("Successfully loaded link_check configuration for shape", shape)
 	default:
 		logger.Info("Unexpected threshold format for link_check on shape", shape, ", using defaults")
@@ -103,8 +222,10 @@ func getLinkCheckTestConfig(shape string) (*LinkCheckTestConfig, error) {
 }
 
 // parseLinkResults parses the output from mlxlink command and validates link parameters
+// using the BER thresholds of interfaceType
 func parseLinkResults(interfaceName string, mlxlinkOutput string, expectedSpeed string,
-	rawPhysicalErrorsPerLaneThreshold int, effectivePhysicalErrorsThreshold int) (*LinkCheckResult, error) {
+	rawPhysicalErrorsPerLaneThreshold int, effectivePhysicalErrorsThreshold int,
+	interfaceType string, berThresholds map[string]BERThresholds) (*LinkCheckResult, error) {
 
 	result := &LinkCheckResult{
 		Device: interfaceName,
@@ -269,13 +390,17 @@ func parseLinkResults(interfaceName string, mlxlinkOutput string, expectedSpeed
 	if statusOpcode == "0" {
 		result.LinkStatus = "PASS"
 	}
+	typeBERThresholds := berThresholdsFor(interfaceType, berThresholds)
 	if isFloat(effectivePhysicalBER) {
-		if berFloat, err := strconv.ParseFloat(effectivePhysicalBER, 64); err == nil && berFloat < 1E-12 {
-			result.EffectivePhysicalBER = "PASS"
+		if berFloat, err := strconv.ParseFloat(effectivePhysicalBER, 64); err == nil {
+			result.EffectivePhysicalBERValue = &berFloat
+			if berFloat < typeBERThresholds.EffectivePhysicalBER {
+				result.EffectivePhysicalBER = "PASS"
+			}
 		}
 	}
 	if isFloat(rawPhysicalBER) {
-		if berFloat, err := strconv.ParseFloat(rawPhysicalBER, 64); err == nil && berFloat < 1E-5 {
+		if berFloat, err := strconv.ParseFloat(rawPhysicalBER, 64); err == nil && berFloat < typeBERThresholds.RawPhysicalBER {
 			result.RawPhysicalBER = "PASS"
 		}
 	}
@@ -326,7 +451,7 @@ func RunLinkCheck() error {
 	if !linkCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "link_check", Shape: shape}
 	}
 
 	// Step 3: Get expected device names from shapes configuration
@@ -450,6 +575,8 @@ func RunLinkCheck() error {
 			linkCheckTestConfig.ExpectedSpeed,
 			linkCheckTestConfig.RawPhysicalErrorsPerLaneThreshold,
 			linkCheckTestConfig.EffectivePhysicalErrorsThreshold,
+			linkCheckTestConfig.InterfaceType,
+			linkCheckTestConfig.InterfaceTypeBERThresholds,
 		)
 		if err != nil {
 			logger.Errorf("Failed to parse link results for %s: %v", interfaceName, err)
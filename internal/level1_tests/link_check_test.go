@@ -79,6 +79,8 @@ func TestParseLinkResults(t *testing.T) {
 				tt.expectedSpeed,
 					10000, // rawPhysicalErrorsPerLaneThreshold
 				0,     // effectivePhysicalErrorsThreshold
+				"RoCE_200GbE", // interfaceType
+				map[string]BERThresholds{"RoCE_200GbE": {EffectivePhysicalBER: 1E-12, RawPhysicalBER: 1E-5}},
 			)
 
 			if tt.expectError {
@@ -309,6 +311,64 @@ func TestBERValidation(t *testing.T) {
 	}
 }
 
+// Test parseLinkResults uses the configured BER thresholds
+func TestParseLinkResultsBERThresholds(t *testing.T) {
+	mlxlinkOutput := `{
+		"result": {
+			"output": {
+				"Operational Info": {
+					"Speed": "200G",
+					"State": "Active",
+					"Physical state": "LinkUp"
+				},
+				"Troubleshooting Info": {
+					"Status Opcode": "0"
+				},
+				"Physical Counters and BER Info": {
+					"Effective Physical Errors": "0",
+					"Effective Physical BER": "1E-13",
+					"Raw Physical Errors Per Lane": ["0", "0", "0", "0"],
+					"Raw Physical BER": "1E-6"
+				}
+			}
+		}
+	}`
+
+	berThresholds := map[string]BERThresholds{
+		"RoCE_200GbE": {EffectivePhysicalBER: 1E-12, RawPhysicalBER: 1E-5},
+		"IB_HDR":      {EffectivePhysicalBER: 1E-14, RawPhysicalBER: 1E-7},
+	}
+
+	tests := []struct {
+		name           string
+		interfaceType  string
+		expectedEffBER string
+		expectedRawBER string
+	}{
+		{"RoCE thresholds", "RoCE_200GbE", "PASS", "PASS"},
+		{"Stricter InfiniBand thresholds", "IB_HDR", "FAIL - 1E-13", "FAIL - 1E-6"},
+		{"Default thresholds of unconfigured interface type", "IB_NDR", "PASS", "PASS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseLinkResults("rdma0", mlxlinkOutput, "200G", 10000, 0, tt.interfaceType, berThresholds)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.EffectivePhysicalBER != tt.expectedEffBER {
+				t.Errorf("EffectivePhysicalBER: expected %s, got %s", tt.expectedEffBER, result.EffectivePhysicalBER)
+			}
+			if result.RawPhysicalBER != tt.expectedRawBER {
+				t.Errorf("RawPhysicalBER: expected %s, got %s", tt.expectedRawBER, result.RawPhysicalBER)
+			}
+			if result.EffectivePhysicalBERValue == nil || *result.EffectivePhysicalBERValue != 1E-13 {
+				t.Errorf("EffectivePhysicalBERValue: expected 1E-13, got %v", result.EffectivePhysicalBERValue)
+			}
+		})
+	}
+}
+
 
 // Test configuration structure
 func TestLinkCheckTestConfig(t *testing.T) {
@@ -438,4 +498,56 @@ func TestRawPhysicalErrorsPerLaneValidation(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+// Test linkInterfaceType function
+func TestLinkInterfaceType(t *testing.T) {
+	tests := []struct {
+		interfaceName string
+		speed         string
+		expected      string
+	}{
+		{"ib0", "200G", "IB_HDR"},
+		{"ibp12s0", "400G", "IB_NDR"},
+		{"ib0", "50G", "IB_50G"},
+		{"ib0", "", "IB"},
+		{"rdma0", "200G", "RoCE_200GbE"},
+		{"enp12s0f0np0", "100G", "RoCE_100GbE"},
+		{"", "200G", "RoCE_200GbE"},
+		{"rdma0", "", "RoCE"},
+	}
+
+	for _, tt := range tests {
+		if got := linkInterfaceType(tt.interfaceName, tt.speed); got != tt.expected {
+			t.Errorf("linkInterfaceType(%q, %q) = %s, want %s", tt.interfaceName, tt.speed, got, tt.expected)
+		}
+	}
+}
+
+// Test parseInterfaceTypeBERThresholds function
+func TestParseInterfaceTypeBERThresholds(t *testing.T) {
+	threshold := map[string]interface{}{
+		"speed": "200G",
+		"interface_type_ber_thresholds": map[string]interface{}{
+			"IB_HDR":      map[string]interface{}{"effective_physical_ber": 1E-14, "raw_physical_ber": 1E-6},
+			"RoCE_100GbE": map[string]interface{}{"raw_physical_ber": 1E-4},
+		},
+	}
+
+	berThresholds := parseInterfaceTypeBERThresholds(threshold, "IB_HDR")
+	if got := berThresholds["IB_HDR"]; got != (BERThresholds{EffectivePhysicalBER: 1E-14, RawPhysicalBER: 1E-6}) {
+		t.Errorf("IB_HDR thresholds = %+v", got)
+	}
+	if got := berThresholds["RoCE_100GbE"]; got != (BERThresholds{EffectivePhysicalBER: defaultEffectivePhysicalBERThreshold, RawPhysicalBER: 1E-4}) {
+		t.Errorf("RoCE_100GbE thresholds = %+v, want default effective physical BER", got)
+	}
+
+	// A single BER threshold applies to the interface type when the map does not configure it
+	threshold["effective_physical_ber"] = 1E-13
+	berThresholds = parseInterfaceTypeBERThresholds(threshold, "RoCE_200GbE")
+	if got := berThresholds["RoCE_200GbE"]; got != (BERThresholds{EffectivePhysicalBER: 1E-13, RawPhysicalBER: defaultRawPhysicalBERThreshold}) {
+		t.Errorf("RoCE_200GbE thresholds = %+v, want the single BER thresholds", got)
+	}
+	if got := berThresholdsFor("IB_NDR", berThresholds); got != (BERThresholds{EffectivePhysicalBER: defaultEffectivePhysicalBERThreshold, RawPhysicalBER: defaultRawPhysicalBERThreshold}) {
+		t.Errorf("berThresholdsFor(IB_NDR) = %+v, want default thresholds", got)
+	}
+}
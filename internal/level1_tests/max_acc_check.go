@@ -1,22 +1,24 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 )
 
-// MaxAccCheckTestConfig represents the config needed to run this test
+// MaxAccCheckTestConfig represents the config needed to run this test.
+// Parameters maps each mlxconfig parameter to its allowed values.
 type MaxAccCheckTestConfig struct {
-	IsEnabled bool     `json:"enabled"`
-	Shape     string   `json:"shape"`
-	PCIIDs    []string `json:"pci_ids"`
+	IsEnabled  bool                `json:"enabled"`
+	Shape      string              `json:"shape"`
+	PCIIDs     []string            `json:"pci_ids"`
+	Parameters map[string][]string `json:"parameters"`
 }
 
 // PCIEConfig represents the PCIe configuration for a single device
@@ -59,6 +61,10 @@ func getMaxAccCheckTestConfig() (*MaxAccCheckTestConfig, error) {
 			"0000:bd:00.0",
 			"0000:d5:00.0",
 		},
+		Parameters: map[string][]string{
+			"MAX_ACC_OUT_READ":      defaultMaxAccParameters["MAX_ACC_OUT_READ"],
+			"ADVANCED_PCI_SETTINGS": defaultMaxAccParameters["ADVANCED_PCI_SETTINGS"],
+		},
 	}
 
 	// Check if test is enabled for this shape
@@ -84,47 +90,40 @@ func getMaxAccCheckTestConfig() (*MaxAccCheckTestConfig, error) {
 					maxAccCheckTestConfig.PCIIDs = pciIDStrings
 				}
 			}
+			if validValues, ok := v["valid_max_acc_values"]; ok {
+				maxAccCheckTestConfig.Parameters["MAX_ACC_OUT_READ"] = mlxconfigExpectedValues(validValues)
+			}
+			if advancedPCISettings, ok := v["required_advanced_pci_settings"]; ok {
+				maxAccCheckTestConfig.Parameters["ADVANCED_PCI_SETTINGS"] = mlxconfigExpectedValues(advancedPCISettings)
+			}
 		}
 	}
 
 	return maxAccCheckTestConfig, nil
 }
 
-// runMLXConfig runs mlxconfig query for a specific PCI device
-func runMLXConfig(pciID string) ([]string, error) {
-	mlxconfigBin := "/usr/bin/mlxconfig"
-	cmd := exec.Command("sudo", mlxconfigBin, "-d", pciID, "query")
-	
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("mlxconfig command failed for %s: %w, output: %s", pciID, err, string(output))
-	}
-
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	return lines, nil
+// defaultMaxAccParameters are the allowed values of the mlxconfig parameters validated by
+// max_acc_check when test_limits.json does not set valid_max_acc_values or required_advanced_pci_settings
+var defaultMaxAccParameters = map[string][]string{
+	"MAX_ACC_OUT_READ":      {"0", "44", "128"},
+	"ADVANCED_PCI_SETTINGS": {"True"},
 }
 
-// parseAccResults parses mlxconfig output for a specific PCI device
-func parseAccResults(pciID string, results []string) PCIEConfig {
+// parseAccResults parses mlxconfig output for a specific PCI device and checks MAX_ACC_OUT_READ
+// and ADVANCED_PCI_SETTINGS against their allowed values in parameters
+func parseAccResults(pciID string, results []string, parameters map[string][]string) PCIEConfig {
 	config := PCIEConfig{
 		PCIBusID:            pciID,
 		MaxAccOut:           "FAIL",
 		AdvancedPCISettings: "FAIL",
 	}
 
-	for _, line := range results {
-		line = strings.TrimSpace(line)
-		
-		// Check MAX_ACC_OUT_READ - must be 0, 44, or 128
-		if strings.Contains(line, "MAX_ACC_OUT_READ") {
-			if strings.Contains(line, "0") || strings.Contains(line, "44") || strings.Contains(line, "128") {
-				config.MaxAccOut = "PASS"
-			}
-		}
-		
-		// Check ADVANCED_PCI_SETTINGS - must be True
-		if strings.Contains(line, "ADVANCED_PCI_SETTINGS") && strings.Contains(line, "True") {
-			config.AdvancedPCISettings = "PASS"
+	for _, result := range checkMLXConfigParameters(pciID, parseMLXConfigParameters(results), parameters) {
+		switch result.Parameter {
+		case "MAX_ACC_OUT_READ":
+			config.MaxAccOut = result.Status
+		case "ADVANCED_PCI_SETTINGS":
+			config.AdvancedPCISettings = result.Status
 		}
 	}
 
@@ -150,7 +149,7 @@ func runMaxAccCheck(config *MaxAccCheckTestConfig) (*MaxAccCheckResult, error) {
 			continue
 		}
 
-		pcieConfig := parseAccResults(pciID, output)
+		pcieConfig := parseAccResults(pciID, output, config.Parameters)
 		pcieConfigs = append(pcieConfigs, pcieConfig)
 	}
 
@@ -205,7 +204,7 @@ func RunMaxAccCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "max_acc_check", Shape: testConfig.Shape}
 	}
 
 	logger.Info("Starting MAX_ACC_OUT_READ configuration check...")
@@ -213,10 +212,11 @@ func RunMaxAccCheck() error {
 
 	// Step 1: Check mlxconfig availability
 	logger.Info("Step 1: Checking mlxconfig availability...")
-	if _, err := exec.LookPath("/usr/bin/mlxconfig"); err != nil {
+	if _, err := exec.LookPath(mlxconfigBin); err != nil {
 		logger.Error("MAX_ACC Check: FAIL - mlxconfig not found")
-		rep.AddMaxAccResult("FAIL", nil, fmt.Errorf("mlxconfig not found: %w", err))
-		return fmt.Errorf("mlxconfig not found: %w", err)
+		err = testerrors.NewToolNotFoundError("max_acc_check", "mlxconfig", err)
+		rep.AddMaxAccResult("FAIL", nil, err)
+		return err
 	}
 
 	// Step 2: Run max_acc_check for all PCI devices
@@ -135,7 +135,7 @@ func TestParseAccResults(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseAccResults(tt.pciID, tt.mlxconfigOutput)
+			result := parseAccResults(tt.pciID, tt.mlxconfigOutput, defaultMaxAccParameters)
 
 			if result.PCIBusID != tt.pciID {
 				t.Errorf("parseAccResults() PCIBusID = %v, want %v", result.PCIBusID, tt.pciID)
@@ -382,7 +382,7 @@ func TestMaxAccOutReadValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseAccResults("0000:0c:00.0", []string{tt.mlxconfigLine})
+			result := parseAccResults("0000:0c:00.0", []string{tt.mlxconfigLine}, defaultMaxAccParameters)
 			
 			if result.MaxAccOut != tt.expectedResult {
 				t.Errorf("Test %s: %s - Expected %s, got %s", 
@@ -442,7 +442,7 @@ func TestAdvancedPCISettingsValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseAccResults("0000:0c:00.0", []string{tt.mlxconfigLine})
+			result := parseAccResults("0000:0c:00.0", []string{tt.mlxconfigLine}, defaultMaxAccParameters)
 			
 			if result.AdvancedPCISettings != tt.expectedResult {
 				t.Errorf("Test %s: %s - Expected %s, got %s", 
@@ -546,7 +546,7 @@ func TestRealisticMLXConfigOutput(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseAccResults(tt.pciID, tt.mlxconfigOutput)
+			result := parseAccResults(tt.pciID, tt.mlxconfigOutput, defaultMaxAccParameters)
 
 			if result.PCIBusID != tt.pciID {
 				t.Errorf("parseAccResults() PCIBusID = %v, want %v", result.PCIBusID, tt.pciID)
@@ -661,6 +661,26 @@ func TestValidationWithRealisticScenarios(t *testing.T) {
 	}
 }
 
+// Test parseAccResults with allowed values from test_limits.json
+func TestParseAccResultsConfiguredParameters(t *testing.T) {
+	output := []string{
+		"         MAX_ACC_OUT_READ            44",
+		"         ADVANCED_PCI_SETTINGS       False",
+	}
+	parameters := map[string][]string{
+		"MAX_ACC_OUT_READ":      mlxconfigExpectedValues([]interface{}{float64(128)}),
+		"ADVANCED_PCI_SETTINGS": mlxconfigExpectedValues(false),
+	}
+
+	result := parseAccResults("0000:0c:00.0", output, parameters)
+	if result.MaxAccOut != "FAIL" {
+		t.Errorf("parseAccResults() MaxAccOut = %v, want FAIL", result.MaxAccOut)
+	}
+	if result.AdvancedPCISettings != "PASS" {
+		t.Errorf("parseAccResults() AdvancedPCISettings = %v, want PASS", result.AdvancedPCISettings)
+	}
+}
+
 // Benchmark tests
 func BenchmarkParseAccResults(b *testing.B) {
 	mlxconfigOutput := []string{
@@ -675,7 +695,7 @@ func BenchmarkParseAccResults(b *testing.B) {
 	
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_ = parseAccResults("0000:0c:00.0", mlxconfigOutput)
+		_ = parseAccResults("0000:0c:00.0", mlxconfigOutput, defaultMaxAccParameters)
 	}
 }
 
@@ -0,0 +1,297 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// memInfoPath reports the memory usable by the kernel in kB
+const memInfoPath = "/proc/meminfo"
+
+// memoryCapacityTolerancePercent is the lowest MemTotal, as a percentage of the expected capacity,
+// that passes. MemTotal excludes the memory reserved by the firmware and the kernel.
+const memoryCapacityTolerancePercent = 90
+
+// MemoryCheckTestConfig represents the config needed to run this test.
+// Expected values of zero are not checked.
+type MemoryCheckTestConfig struct {
+	IsEnabled             bool    `json:"enabled"`
+	Shape                 string  `json:"shape"`
+	ExpectedTotalMemoryGB float64 `json:"expected_total_memory_gb"`
+	MinSpeedMTs           int     `json:"min_speed_mts"`
+	ExpectedDIMMCount     int     `json:"expected_dimm_count"`
+}
+
+// MemoryDIMM represents a memory slot read from dmidecode -t memory
+type MemoryDIMM struct {
+	Locator     string  `json:"locator"`
+	BankLocator string  `json:"bank_locator"`
+	SizeGB      float64 `json:"size_gb"`
+	Type        string  `json:"type"`
+	SpeedMTs    int     `json:"speed_mts"`
+	Populated   bool    `json:"populated"`
+}
+
+// MemoryInfo represents the memory capacity and DIMM population of the node
+type MemoryInfo struct {
+	TotalMemoryGB     float64  `json:"total_memory_gb"`
+	AvailableMemoryGB float64  `json:"available_memory_gb"`
+	DIMMCount         int      `json:"dimm_count"`
+	DIMMCapacityGB    float64  `json:"dimm_capacity_gb"`
+	MemoryType        string   `json:"memory_type"`
+	SpeedMTs          int      `json:"speed_mts"`
+	EmptySlots        []string `json:"empty_slots"`
+}
+
+// getMemoryCheckTestConfig gets test config needed to run this test
+func getMemoryCheckTestConfig() (*MemoryCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	memoryCheckTestConfig := &MemoryCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "memory_check")
+	if err != nil {
+		return nil, err
+	}
+	memoryCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "memory_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if totalMemory, ok := thresholdMap["expected_total_memory_gb"].(float64); ok {
+				memoryCheckTestConfig.ExpectedTotalMemoryGB = totalMemory
+			}
+			if speed, ok := thresholdMap["min_speed_mts"].(float64); ok {
+				memoryCheckTestConfig.MinSpeedMTs = int(speed)
+			}
+			if dimmCount, ok := thresholdMap["expected_dimm_count"].(float64); ok {
+				memoryCheckTestConfig.ExpectedDIMMCount = int(dimmCount)
+			}
+		}
+	}
+
+	return memoryCheckTestConfig, nil
+}
+
+// parseMemInfo parses MemTotal and MemAvailable of /proc/meminfo in GB
+func parseMemInfo(output string) (float64, float64, error) {
+	var totalGB, availableGB float64
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		kb, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			totalGB = kb / (1024 * 1024)
+		case "MemAvailable:":
+			availableGB = kb / (1024 * 1024)
+		}
+	}
+
+	if totalGB == 0 {
+		return 0, 0, fmt.Errorf("MemTotal not found in %s", memInfoPath)
+	}
+	return totalGB, availableGB, nil
+}
+
+// parseDIMMSize parses a dmidecode memory size such as "64 GB" or "65536 MB" in GB.
+// Empty slots report "No Module Installed" and parse as 0.
+func parseDIMMSize(size string) float64 {
+	fields := strings.Fields(size)
+	if len(fields) != 2 {
+		return 0
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	switch fields[1] {
+	case "TB":
+		return value * 1024
+	case "GB":
+		return value
+	case "MB":
+		return value / 1024
+	}
+	return 0
+}
+
+// parseDIMMSpeed parses a dmidecode memory speed such as "4800 MT/s", or "4800 MHz" on older
+// dmidecode versions. Unknown speeds parse as 0.
+func parseDIMMSpeed(speed string) int {
+	fields := strings.Fields(speed)
+	if len(fields) == 0 {
+		return 0
+	}
+	value, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// parseDmidecodeMemory parses the memory slots of dmidecode -t memory output. The speed of a DIMM is
+// its configured speed, which is lower than its rated speed when the memory controller slowed it down.
+func parseDmidecodeMemory(output string) ([]MemoryDIMM, error) {
+	var dimms []MemoryDIMM
+
+	// Each "Memory Device" section describes one slot
+	for _, section := range strings.Split(output, "Memory Device")[1:] {
+		dimm := MemoryDIMM{
+			Locator:     dmidecodeField(section, "Locator"),
+			BankLocator: dmidecodeField(section, "Bank Locator"),
+			SizeGB:      parseDIMMSize(dmidecodeField(section, "Size")),
+			Type:        dmidecodeField(section, "Type"),
+		}
+		dimm.Populated = dimm.SizeGB > 0
+
+		dimm.SpeedMTs = parseDIMMSpeed(dmidecodeField(section, "Configured Memory Speed"))
+		if dimm.SpeedMTs == 0 {
+			dimm.SpeedMTs = parseDIMMSpeed(dmidecodeField(section, "Configured Clock Speed"))
+		}
+		if dimm.SpeedMTs == 0 {
+			dimm.SpeedMTs = parseDIMMSpeed(dmidecodeField(section, "Speed"))
+		}
+		dimms = append(dimms, dimm)
+	}
+
+	if len(dimms) == 0 {
+		return nil, fmt.Errorf("no memory devices found in dmidecode output")
+	}
+	return dimms, nil
+}
+
+// summarizeDIMMs sets the DIMM count, capacity, type, lowest speed and empty slots of the memory info
+func summarizeDIMMs(info *MemoryInfo, dimms []MemoryDIMM) {
+	for _, dimm := range dimms {
+		if !dimm.Populated {
+			info.EmptySlots = append(info.EmptySlots, dimm.Locator)
+			continue
+		}
+		info.DIMMCount++
+		info.DIMMCapacityGB += dimm.SizeGB
+		if info.MemoryType == "" {
+			info.MemoryType = dimm.Type
+		}
+		if dimm.SpeedMTs > 0 && (info.SpeedMTs == 0 || dimm.SpeedMTs < info.SpeedMTs) {
+			info.SpeedMTs = dimm.SpeedMTs
+		}
+	}
+}
+
+// validateMemory compares the memory capacity, DIMM count and speed with the expected values
+func validateMemory(info *MemoryInfo, testConfig *MemoryCheckTestConfig) error {
+	var failures []string
+	minTotalMemoryGB := testConfig.ExpectedTotalMemoryGB * memoryCapacityTolerancePercent / 100
+	if testConfig.ExpectedTotalMemoryGB > 0 && info.TotalMemoryGB < minTotalMemoryGB {
+		failures = append(failures, fmt.Sprintf("total memory %.0f GB is below %d%% of the expected %.0f GB",
+			info.TotalMemoryGB, memoryCapacityTolerancePercent, testConfig.ExpectedTotalMemoryGB))
+	}
+	if testConfig.ExpectedDIMMCount > 0 && info.DIMMCount < testConfig.ExpectedDIMMCount {
+		failures = append(failures, fmt.Sprintf("%d DIMMs populated, expected %d", info.DIMMCount, testConfig.ExpectedDIMMCount))
+	}
+	if testConfig.MinSpeedMTs > 0 && info.SpeedMTs < testConfig.MinSpeedMTs {
+		failures = append(failures, fmt.Sprintf("memory speed %d MT/s is below the minimum %d MT/s", info.SpeedMTs, testConfig.MinSpeedMTs))
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("memory does not meet the shape requirements: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// getMemoryInfo reads the memory capacity from /proc/meminfo and the DIMMs from dmidecode
+func getMemoryInfo() (*MemoryInfo, error) {
+	memInfoResult, err := executor.RunCat(memInfoPath)
+	if err != nil {
+		return nil, commandError("memory_check", memInfoResult, err)
+	}
+	info := &MemoryInfo{}
+	info.TotalMemoryGB, info.AvailableMemoryGB, err = parseMemInfo(memInfoResult.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	dmidecodeResult, err := executor.RunDmidecode("memory")
+	if err != nil {
+		return nil, fmt.Errorf("dmidecode failed: %w", commandError("memory_check", dmidecodeResult, err))
+	}
+	dimms, err := parseDmidecodeMemory(dmidecodeResult.Output)
+	if err != nil {
+		return nil, err
+	}
+	summarizeDIMMs(info, dimms)
+
+	return info, nil
+}
+
+// RunMemoryCheck validates the memory capacity from /proc/meminfo and the DIMM count and speed from
+// dmidecode, which are lower than expected when DIMMs are missing, failed or misconfigured
+func RunMemoryCheck() error {
+	logger.Info("=== Memory Check ===")
+	testConfig, err := getMemoryCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "memory_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting memory check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the memory capacity and DIMMs
+	logger.Info("Step 1: Reading memory capacity and DIMM population...")
+	info, err := getMemoryInfo()
+	if err != nil {
+		logger.Error("Memory Check: FAIL - Could not read memory information:", err)
+		rep.AddMemoryResult("FAIL", 0, 0, 0, 0, "", nil, testConfig.ExpectedTotalMemoryGB, testConfig.MinSpeedMTs, err)
+		return err
+	}
+	logger.Infof("Total memory: %.0f GB (%.0f GB available), %d %s DIMMs (%.0f GB) at %d MT/s, %d empty slots",
+		info.TotalMemoryGB, info.AvailableMemoryGB, info.DIMMCount, info.MemoryType, info.DIMMCapacityGB, info.SpeedMTs, len(info.EmptySlots))
+
+	// Step 2: Compare with the expected memory configuration
+	logger.Infof("Step 2: Validating memory (expected %.0f GB, %d DIMMs, at least %d MT/s)...",
+		testConfig.ExpectedTotalMemoryGB, testConfig.ExpectedDIMMCount, testConfig.MinSpeedMTs)
+	if err := validateMemory(info, testConfig); err != nil {
+		logger.Error("Memory Check: FAIL -", err)
+		rep.AddMemoryResult("FAIL", info.TotalMemoryGB, info.AvailableMemoryGB, info.DIMMCount, info.SpeedMTs, info.MemoryType,
+			info.EmptySlots, testConfig.ExpectedTotalMemoryGB, testConfig.MinSpeedMTs, err)
+		return err
+	}
+
+	logger.Info("Memory Check: PASS - Memory capacity and speed meet the shape requirements")
+	rep.AddMemoryResult("PASS", info.TotalMemoryGB, info.AvailableMemoryGB, info.DIMMCount, info.SpeedMTs, info.MemoryType,
+		info.EmptySlots, testConfig.ExpectedTotalMemoryGB, testConfig.MinSpeedMTs, nil)
+	return nil
+}
@@ -0,0 +1,116 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseMemInfo function
+func TestParseMemInfo(t *testing.T) {
+	output := `MemTotal:       2113929216 kB
+MemFree:        2097152000 kB
+MemAvailable:   2097152000 kB
+Buffers:            4096 kB`
+
+	totalGB, availableGB, err := parseMemInfo(output)
+	if err != nil {
+		t.Fatalf("parseMemInfo() error = %v", err)
+	}
+	if totalGB != 2016 {
+		t.Errorf("parseMemInfo() total = %v, want 2016", totalGB)
+	}
+	if availableGB != 2000 {
+		t.Errorf("parseMemInfo() available = %v, want 2000", availableGB)
+	}
+
+	if _, _, err := parseMemInfo("MemFree: 1024 kB"); err == nil {
+		t.Error("parseMemInfo() expected error for output without MemTotal")
+	}
+}
+
+// Test parseDmidecodeMemory function
+func TestParseDmidecodeMemory(t *testing.T) {
+	output := `# dmidecode 3.3
+Handle 0x0020, DMI type 16, 23 bytes
+Physical Memory Array
+	Location: System Board Or Motherboard
+	Number Of Devices: 3
+
+Handle 0x0021, DMI type 17, 92 bytes
+Memory Device
+	Size: 64 GB
+	Locator: CPU0_DIMM_A1
+	Bank Locator: P0_Node0_Channel0_Dimm0
+	Type: DDR5
+	Type Detail: Synchronous
+	Speed: 4800 MT/s
+	Configured Memory Speed: 4800 MT/s
+
+Handle 0x0022, DMI type 17, 92 bytes
+Memory Device
+	Size: 65536 MB
+	Locator: CPU0_DIMM_B1
+	Bank Locator: P0_Node0_Channel1_Dimm0
+	Type: DDR5
+	Speed: 4800 MT/s
+	Configured Memory Speed: 4400 MT/s
+
+Handle 0x0023, DMI type 17, 92 bytes
+Memory Device
+	Size: No Module Installed
+	Locator: CPU0_DIMM_C1
+	Bank Locator: P0_Node0_Channel2_Dimm0
+	Type: Unknown
+	Speed: Unknown
+`
+
+	dimms, err := parseDmidecodeMemory(output)
+	if err != nil {
+		t.Fatalf("parseDmidecodeMemory() error = %v", err)
+	}
+	expected := []MemoryDIMM{
+		{Locator: "CPU0_DIMM_A1", BankLocator: "P0_Node0_Channel0_Dimm0", SizeGB: 64, Type: "DDR5", SpeedMTs: 4800, Populated: true},
+		{Locator: "CPU0_DIMM_B1", BankLocator: "P0_Node0_Channel1_Dimm0", SizeGB: 64, Type: "DDR5", SpeedMTs: 4400, Populated: true},
+		{Locator: "CPU0_DIMM_C1", BankLocator: "P0_Node0_Channel2_Dimm0", Type: "Unknown"},
+	}
+	if !reflect.DeepEqual(dimms, expected) {
+		t.Errorf("parseDmidecodeMemory() = %+v, want %+v", dimms, expected)
+	}
+
+	info := &MemoryInfo{}
+	summarizeDIMMs(info, dimms)
+	if info.DIMMCount != 2 || info.DIMMCapacityGB != 128 || info.MemoryType != "DDR5" || info.SpeedMTs != 4400 {
+		t.Errorf("summarizeDIMMs() = %+v, want 2 DDR5 DIMMs, 128 GB at 4400 MT/s", info)
+	}
+	if !reflect.DeepEqual(info.EmptySlots, []string{"CPU0_DIMM_C1"}) {
+		t.Errorf("summarizeDIMMs() empty slots = %v, want [CPU0_DIMM_C1]", info.EmptySlots)
+	}
+
+	if _, err := parseDmidecodeMemory("# dmidecode 3.3"); err == nil {
+		t.Error("parseDmidecodeMemory() expected error for output without memory devices")
+	}
+}
+
+// Test validateMemory function
+func TestValidateMemory(t *testing.T) {
+	testConfig := &MemoryCheckTestConfig{ExpectedTotalMemoryGB: 2048, MinSpeedMTs: 4800, ExpectedDIMMCount: 32}
+
+	tests := []struct {
+		name        string
+		info        *MemoryInfo
+		expectError bool
+	}{
+		{name: "Expected memory", info: &MemoryInfo{TotalMemoryGB: 2016, DIMMCount: 32, SpeedMTs: 4800}},
+		{name: "Missing DIMMs", info: &MemoryInfo{TotalMemoryGB: 1512, DIMMCount: 24, SpeedMTs: 4800}, expectError: true},
+		{name: "Slow memory", info: &MemoryInfo{TotalMemoryGB: 2016, DIMMCount: 32, SpeedMTs: 4400}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMemory(tt.info, testConfig)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateMemory() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
@@ -1,15 +1,17 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/shapes"
 )
 
 // MissingInterfaceCheckTestConfig represents the config needed to run this test
@@ -75,6 +77,148 @@ func parseLspciForMissingInterfaces(output string) (int, error) {
 	return missingCount, nil
 }
 
+// ExpectedInterface represents a network interface the shape is expected to have
+type ExpectedInterface struct {
+	Name       string `json:"name"`
+	DeviceName string `json:"device_name"`
+	RDMA       bool   `json:"rdma"`
+}
+
+// InterfaceComparison represents the expected interfaces of a shape compared with the interfaces found on the host.
+// Missing RDMA interfaces are critical, missing VCN interfaces are warnings.
+type InterfaceComparison struct {
+	ExpectedCount         int      `json:"expected_count"`
+	FoundCount            int      `json:"found_count"`
+	MissingRDMAInterfaces []string `json:"missing_rdma_interfaces"`
+	MissingVCNInterfaces  []string `json:"missing_vcn_interfaces"`
+	ExtraInterfaces       []string `json:"extra_interfaces"`
+}
+
+// getExpectedInterfaces returns the RDMA and VCN interfaces of a shape from shapes.json.
+// Interfaces without a name in shapes.json are identified by their RDMA device name.
+func getExpectedInterfaces(shapeManager *shapes.ShapeManager, shape string) ([]ExpectedInterface, error) {
+	rdmaNics, err := shapeManager.GetRDMANics(shape)
+	if err != nil {
+		return nil, err
+	}
+	vcnNics, err := shapeManager.GetVCNNics(shape)
+	if err != nil {
+		return nil, err
+	}
+
+	var expected []ExpectedInterface
+	for _, nic := range rdmaNics {
+		expected = append(expected, newExpectedInterface(nic.Interface, nic.DeviceName, true))
+	}
+	for _, nic := range vcnNics {
+		expected = append(expected, newExpectedInterface(nic.Interface, nic.DeviceName, false))
+	}
+	return expected, nil
+}
+
+// newExpectedInterface creates an expected interface, ignoring "undefined" device names
+func newExpectedInterface(name, deviceName string, rdma bool) ExpectedInterface {
+	if deviceName == "undefined" {
+		deviceName = ""
+	}
+	if name == "" {
+		name = deviceName
+	}
+	return ExpectedInterface{Name: name, DeviceName: deviceName, RDMA: rdma}
+}
+
+// parseIPLinkInterfaces parses the interface names from ip -o link show output.
+// Lines look like "2: eth0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 9000 ..." or "5: eth0.100@eth0: ...".
+func parseIPLinkInterfaces(output string) []string {
+	var interfaces []string
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), ":", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		name := strings.TrimSpace(parts[1])
+		if at := strings.Index(name, "@"); at >= 0 {
+			name = name[:at]
+		}
+		if name != "" {
+			interfaces = append(interfaces, name)
+		}
+	}
+	return interfaces
+}
+
+// compareInterfaces compares the expected interfaces with the interfaces found with ip link and ibdev2netdev.
+// An expected interface is found when its name is listed by ip link or its RDMA device is listed by ibdev2netdev.
+// RDMA devices listed by ibdev2netdev that the shape does not expect are extra.
+func compareInterfaces(expected []ExpectedInterface, linkInterfaces []string, ibdevToNetdev map[string]string) *InterfaceComparison {
+	comparison := &InterfaceComparison{
+		ExpectedCount:         len(expected),
+		MissingRDMAInterfaces: []string{},
+		MissingVCNInterfaces:  []string{},
+		ExtraInterfaces:       []string{},
+	}
+
+	linkSet := make(map[string]bool)
+	for _, name := range linkInterfaces {
+		linkSet[name] = true
+	}
+
+	knownDevices := make(map[string]bool)
+	knownInterfaces := make(map[string]bool)
+	for _, iface := range expected {
+		knownDevices[iface.DeviceName] = true
+		knownInterfaces[iface.Name] = true
+
+		_, deviceFound := ibdevToNetdev[iface.DeviceName]
+		if linkSet[iface.Name] || (iface.DeviceName != "" && deviceFound) {
+			comparison.FoundCount++
+			continue
+		}
+		if iface.RDMA {
+			comparison.MissingRDMAInterfaces = append(comparison.MissingRDMAInterfaces, iface.Name)
+		} else {
+			comparison.MissingVCNInterfaces = append(comparison.MissingVCNInterfaces, iface.Name)
+		}
+	}
+
+	var extra []string
+	for device, netdev := range ibdevToNetdev {
+		if !knownDevices[device] && !knownInterfaces[netdev] {
+			extra = append(extra, netdev)
+		}
+	}
+	comparison.ExtraInterfaces = sortedUnique(extra)
+
+	return comparison
+}
+
+// getInterfaceComparison compares the interfaces expected for the shape with the interfaces found on the host.
+// It returns nil when the shape has no interface configuration in shapes.json.
+func getInterfaceComparison(shape string) (*InterfaceComparison, error) {
+	shapeManager, err := shapes.NewShapeManager(config.GetShapesFilePath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shapes configuration: %w", err)
+	}
+
+	expected, err := getExpectedInterfaces(shapeManager, shape)
+	if err != nil {
+		logger.Infof("No interface configuration for shape %s, skipping interface comparison: %v", shape, err)
+		return nil, nil
+	}
+
+	result, err := executor.RunIPLinkList()
+	if err != nil {
+		return nil, fmt.Errorf("ip link failed: %w", commandError("missing_interface_check", result, err))
+	}
+
+	ibdevToNetdev, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+	}
+
+	return compareInterfaces(expected, parseIPLinkInterfaces(result.Output), ibdevToNetdev), nil
+}
+
 func RunMissingInterfaceCheck() error {
 	logger.Info("=== Missing Interface Check ===")
 	testConfig, err := getMissingInterfaceCheckTestConfig()
@@ -85,7 +229,7 @@ func RunMissingInterfaceCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "missing_interface_check", Shape: testConfig.Shape}
 	}
 
 	logger.Info("Starting missing interface check...")
@@ -98,8 +242,9 @@ func RunMissingInterfaceCheck() error {
 	if err != nil {
 		logger.Error("Failed to run lspci command:", err)
 		logger.Info("Missing Interface Check: FAIL - Could not run lspci command")
-		rep.AddMissingInterfaceResult("FAIL", 0, fmt.Errorf("could not run lspci command: %v", err))
-		return fmt.Errorf("could not run lspci command: %v", err)
+		err = commandError("missing_interface_check", result, err)
+		rep.AddMissingInterfaceResult("FAIL", 0, err)
+		return err
 	}
 
 	// Parse the lspci output for missing interfaces
@@ -115,14 +260,55 @@ func RunMissingInterfaceCheck() error {
 	if missingCount > testConfig.Threshold {
 		logger.Error(fmt.Sprintf("Found %d missing interface(s), exceeds threshold of %d", missingCount, testConfig.Threshold))
 		logger.Info("Missing Interface Check: FAIL - Missing PCIe interfaces detected")
-		err = fmt.Errorf("found %d missing PCIe interfaces, exceeds threshold of %d", missingCount, testConfig.Threshold)
+		err = &testerrors.TestThresholdExceededError{TestName: "missing_interface_check", Metric: "missing_count", Actual: missingCount, Expected: testConfig.Threshold}
 		rep.AddMissingInterfaceResult("FAIL", missingCount, err)
 		return err
 	}
 
+	// Compare the network interfaces against the shape configuration
+	logger.Info("Comparing network interfaces against shape configuration...")
+	comparison, err := getInterfaceComparison(testConfig.Shape)
+	if err != nil {
+		logger.Error("Missing Interface Check: FAIL - Could not compare network interfaces:", err)
+		rep.AddMissingInterfaceResult("FAIL", missingCount, err)
+		return err
+	}
+	if comparison == nil {
+		logger.Info("No missing interfaces found")
+		logger.Info("Missing Interface Check: PASS")
+		rep.AddMissingInterfaceResult("PASS", 0, nil)
+		return nil
+	}
+
+	logger.Infof("Expected %d network interfaces, found %d", comparison.ExpectedCount, comparison.FoundCount)
+	if len(comparison.ExtraInterfaces) > 0 {
+		logger.Info("Interfaces not in shape configuration:", strings.Join(comparison.ExtraInterfaces, ", "))
+	}
+
+	if len(comparison.MissingRDMAInterfaces) > 0 {
+		err = fmt.Errorf("missing RDMA interfaces: %s", strings.Join(comparison.MissingRDMAInterfaces, ", "))
+		if len(comparison.MissingVCNInterfaces) > 0 {
+			err = fmt.Errorf("%w; missing VCN interfaces: %s", err, strings.Join(comparison.MissingVCNInterfaces, ", "))
+		}
+		logger.Error("Missing Interface Check: FAIL -", err)
+		rep.AddMissingInterfaceDetailsResult("FAIL", missingCount, comparison.ExpectedCount, comparison.FoundCount,
+			comparison.MissingRDMAInterfaces, comparison.MissingVCNInterfaces, comparison.ExtraInterfaces, err)
+		return err
+	}
+
+	// A missing VCN interface does not affect RDMA traffic
+	if len(comparison.MissingVCNInterfaces) > 0 {
+		err = fmt.Errorf("missing VCN interfaces: %s", strings.Join(comparison.MissingVCNInterfaces, ", "))
+		logger.Info("Missing Interface Check: WARN -", err)
+		rep.AddMissingInterfaceDetailsResult("WARN", missingCount, comparison.ExpectedCount, comparison.FoundCount,
+			comparison.MissingRDMAInterfaces, comparison.MissingVCNInterfaces, comparison.ExtraInterfaces, err)
+		return err
+	}
+
 	// No missing interfaces found - check passes
 	logger.Info("No missing interfaces found")
 	logger.Info("Missing Interface Check: PASS")
-	rep.AddMissingInterfaceResult("PASS", 0, nil)
+	rep.AddMissingInterfaceDetailsResult("PASS", 0, comparison.ExpectedCount, comparison.FoundCount,
+		nil, nil, comparison.ExtraInterfaces, nil)
 	return nil
 }
@@ -0,0 +1,96 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLspciForMissingInterfaces(t *testing.T) {
+	output := `0000:0f:00.0 3D controller: NVIDIA Corporation Device 2330 (rev a1)
+0000:2d:00.0 Infiniband controller: Mellanox Technologies MT2910 Family [ConnectX-7] (rev ff)`
+
+	missingCount, err := parseLspciForMissingInterfaces(output)
+	if err != nil {
+		t.Fatalf("parseLspciForMissingInterfaces() error = %v", err)
+	}
+	if missingCount != 1 {
+		t.Errorf("Expected 1 missing interface, got %d", missingCount)
+	}
+}
+
+func TestParseIPLinkInterfaces(t *testing.T) {
+	output := `1: lo: <LOOPBACK,UP,LOWER_UP> mtu 65536 qdisc noqueue state UNKNOWN mode DEFAULT group default qlen 1000\    link/loopback 00:00:00:00:00:00 brd 00:00:00:00:00:00
+2: eth0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 9000 qdisc mq state UP mode DEFAULT group default qlen 1000\    link/ether 02:00:17:00:00:01 brd ff:ff:ff:ff:ff:ff
+3: eth0.100@eth0: <BROADCAST,MULTICAST> mtu 9000 qdisc noop state DOWN mode DEFAULT group default qlen 1000\    link/ether 02:00:17:00:00:01 brd ff:ff:ff:ff:ff:ff`
+
+	got := parseIPLinkInterfaces(output)
+	want := []string{"lo", "eth0", "eth0.100"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseIPLinkInterfaces() = %v, want %v", got, want)
+	}
+}
+
+func TestCompareInterfaces(t *testing.T) {
+	expected := []ExpectedInterface{
+		newExpectedInterface("", "mlx5_0", true),
+		newExpectedInterface("", "mlx5_1", true),
+		newExpectedInterface("eth0", "mlx5_2", false),
+		newExpectedInterface("eno2", "undefined", false),
+	}
+
+	tests := []struct {
+		name           string
+		linkInterfaces []string
+		ibdevToNetdev  map[string]string
+		expectedFound  int
+		expectedRDMA   []string
+		expectedVCN    []string
+		expectedExtra  []string
+	}{
+		{
+			name:           "All interfaces present",
+			linkInterfaces: []string{"lo", "eth0", "eno2", "rdma0", "rdma1"},
+			ibdevToNetdev:  map[string]string{"mlx5_0": "rdma0", "mlx5_1": "rdma1", "mlx5_2": "eth0"},
+			expectedFound:  4,
+			expectedRDMA:   []string{},
+			expectedVCN:    []string{},
+			expectedExtra:  []string{},
+		},
+		{
+			name:           "Missing RDMA interface",
+			linkInterfaces: []string{"lo", "eth0", "eno2", "rdma0"},
+			ibdevToNetdev:  map[string]string{"mlx5_0": "rdma0", "mlx5_2": "eth0"},
+			expectedFound:  3,
+			expectedRDMA:   []string{"mlx5_1"},
+			expectedVCN:    []string{},
+			expectedExtra:  []string{},
+		},
+		{
+			name:           "Missing VCN interface and extra RDMA device",
+			linkInterfaces: []string{"lo", "eth0", "rdma0", "rdma1", "rdma9"},
+			ibdevToNetdev:  map[string]string{"mlx5_0": "rdma0", "mlx5_1": "rdma1", "mlx5_2": "eth0", "mlx5_9": "rdma9"},
+			expectedFound:  3,
+			expectedRDMA:   []string{},
+			expectedVCN:    []string{"eno2"},
+			expectedExtra:  []string{"rdma9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparison := compareInterfaces(expected, tt.linkInterfaces, tt.ibdevToNetdev)
+			if comparison.ExpectedCount != len(expected) || comparison.FoundCount != tt.expectedFound {
+				t.Errorf("Expected %d/%d interfaces, got %d/%d", tt.expectedFound, len(expected), comparison.FoundCount, comparison.ExpectedCount)
+			}
+			if !reflect.DeepEqual(comparison.MissingRDMAInterfaces, tt.expectedRDMA) {
+				t.Errorf("Expected missing RDMA interfaces %v, got %v", tt.expectedRDMA, comparison.MissingRDMAInterfaces)
+			}
+			if !reflect.DeepEqual(comparison.MissingVCNInterfaces, tt.expectedVCN) {
+				t.Errorf("Expected missing VCN interfaces %v, got %v", tt.expectedVCN, comparison.MissingVCNInterfaces)
+			}
+			if !reflect.DeepEqual(comparison.ExtraInterfaces, tt.expectedExtra) {
+				t.Errorf("Expected extra interfaces %v, got %v", tt.expectedExtra, comparison.ExtraInterfaces)
+			}
+		})
+	}
+}
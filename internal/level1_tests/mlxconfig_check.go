@@ -0,0 +1,250 @@
+package level1_tests
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// mlxconfigBin is the path of the mlxconfig binary
+const mlxconfigBin = "/usr/bin/mlxconfig"
+
+// mlxconfigParameterRegex matches a configuration line of mlxconfig query output and captures
+// the parameter and its current value, e.g. "         MAX_ACC_OUT_READ            44".
+// Header lines such as "PCI device:         0000:0c:00.0" do not match.
+var mlxconfigParameterRegex = regexp.MustCompile(`^\s*([A-Z][A-Z0-9_]*)\s+([^\s:]+)(?:\s|$)`)
+
+// MLXConfigCheckTestConfig represents the config needed to run this test.
+// Parameters maps each mlxconfig parameter to its allowed values.
+type MLXConfigCheckTestConfig struct {
+	IsEnabled  bool                `json:"enabled"`
+	Shape      string              `json:"shape"`
+	PCIIDs     []string            `json:"pci_ids"`
+	Parameters map[string][]string `json:"parameters"`
+}
+
+// getMLXConfigCheckTestConfig gets test config needed to run this test
+func getMLXConfigCheckTestConfig() (*MLXConfigCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	mlxConfigCheckTestConfig := &MLXConfigCheckTestConfig{
+		IsEnabled:  false,
+		Shape:      shape,
+		Parameters: map[string][]string{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "mlxconfig_check")
+	if err != nil {
+		return nil, err
+	}
+	mlxConfigCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "mlxconfig_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			mlxConfigCheckTestConfig.PCIIDs = bdfList(thresholdMap["pci_ids"])
+			if parameters, ok := thresholdMap["parameters"].(map[string]interface{}); ok {
+				for parameter, expected := range parameters {
+					mlxConfigCheckTestConfig.Parameters[parameter] = mlxconfigExpectedValues(expected)
+				}
+			}
+		}
+	}
+
+	return mlxConfigCheckTestConfig, nil
+}
+
+// mlxconfigExpectedValues converts the expected value of a parameter from test_limits.json,
+// a single value or a list of allowed values, to strings
+func mlxconfigExpectedValues(expected interface{}) []string {
+	items, ok := expected.([]interface{})
+	if !ok {
+		items = []interface{}{expected}
+	}
+
+	var values []string
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			values = append(values, v)
+		case float64:
+			values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			// mlxconfig reports booleans as True and False
+			if v {
+				values = append(values, "True")
+			} else {
+				values = append(values, "False")
+			}
+		}
+	}
+	return values
+}
+
+// runMLXConfig runs mlxconfig query for a specific PCI device
+func runMLXConfig(pciID string) ([]string, error) {
+	cmd := exec.Command("sudo", mlxconfigBin, "-d", pciID, "query")
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("mlxconfig command failed for %s: %w, output: %s", pciID, err, string(output))
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	return lines, nil
+}
+
+// parseMLXConfigParameters parses the current value of every parameter from mlxconfig query output
+func parseMLXConfigParameters(lines []string) map[string]string {
+	parameters := make(map[string]string)
+	for _, line := range lines {
+		if match := mlxconfigParameterRegex.FindStringSubmatch(line); match != nil {
+			parameters[match[1]] = match[2]
+		}
+	}
+	return parameters
+}
+
+// mlxconfigValueMatches returns whether an mlxconfig value is one of the allowed values.
+// Values reported with their raw value such as "True(1)" or "ENABLED(1)" match "True" and "ENABLED".
+func mlxconfigValueMatches(actual string, allowed []string) bool {
+	for _, value := range allowed {
+		if actual == value || strings.HasPrefix(actual, value+"(") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMLXConfigParameters validates the parameters of one NIC against their allowed values,
+// in parameter name order. A missing parameter FAILs.
+func checkMLXConfigParameters(pciID string, actual map[string]string, expected map[string][]string) []reporter.MLXConfigParameterResult {
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]reporter.MLXConfigParameterResult, 0, len(names))
+	for _, name := range names {
+		result := reporter.MLXConfigParameterResult{
+			PCIBusID:  pciID,
+			Parameter: name,
+			Expected:  expected[name],
+			Actual:    actual[name],
+			Status:    "FAIL",
+		}
+		if result.Actual != "" && mlxconfigValueMatches(result.Actual, result.Expected) {
+			result.Status = "PASS"
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// runMLXConfigCheck queries every configured NIC and validates its parameters.
+// A NIC that cannot be queried FAILs all of its parameters.
+func runMLXConfigCheck(config *MLXConfigCheckTestConfig) []reporter.MLXConfigParameterResult {
+	var results []reporter.MLXConfigParameterResult
+	for _, pciID := range config.PCIIDs {
+		logger.Info(fmt.Sprintf("Checking PCI device: %s", pciID))
+
+		output, err := runMLXConfig(pciID)
+		if err != nil {
+			logger.Errorf("Failed to query PCI device %s: %v", pciID, err)
+			output = nil
+		}
+		results = append(results, checkMLXConfigParameters(pciID, parseMLXConfigParameters(output), config.Parameters)...)
+	}
+	return results
+}
+
+// validateMLXConfigResults returns the overall status of the parameter results
+func validateMLXConfigResults(results []reporter.MLXConfigParameterResult) (string, error) {
+	if len(results) == 0 {
+		return "FAIL", fmt.Errorf("no mlxconfig parameters checked")
+	}
+
+	var failures []string
+	for _, result := range results {
+		if result.Status == "PASS" {
+			continue
+		}
+		actual := result.Actual
+		if actual == "" {
+			actual = "missing"
+		}
+		failures = append(failures, fmt.Sprintf("%s %s=%s (expected %s)",
+			result.PCIBusID, result.Parameter, actual, strings.Join(result.Expected, "|")))
+	}
+
+	if len(failures) > 0 {
+		return "FAIL", fmt.Errorf("mlxconfig parameters not as expected: %s", strings.Join(failures, ", "))
+	}
+	return "PASS", nil
+}
+
+// RunMLXConfigCheck checks the configured mlxconfig parameters of every NIC
+func RunMLXConfigCheck() error {
+	logger.Info("=== MLXConfig Check ===")
+	testConfig, err := getMLXConfigCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "mlxconfig_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting mlxconfig parameter check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Check mlxconfig availability
+	logger.Info("Step 1: Checking mlxconfig availability...")
+	if _, err := exec.LookPath(mlxconfigBin); err != nil {
+		logger.Error("MLXConfig Check: FAIL - mlxconfig not found")
+		err = testerrors.NewToolNotFoundError("mlxconfig_check", "mlxconfig", err)
+		rep.AddMLXConfigResult("FAIL", nil, err)
+		return err
+	}
+
+	// Step 2: Query and validate the parameters of every NIC
+	logger.Info("Step 2: Checking mlxconfig parameters...")
+	logger.Info(fmt.Sprintf("Checking %d parameters on %d PCI devices: %v", len(testConfig.Parameters), len(testConfig.PCIIDs), testConfig.PCIIDs))
+	results := runMLXConfigCheck(testConfig)
+
+	status, validationErr := validateMLXConfigResults(results)
+	rep.AddMLXConfigResult(status, results, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("MLXConfig Check: PASS - All %d parameters configured correctly on %d PCI devices", len(testConfig.Parameters), len(testConfig.PCIIDs))
+		return nil
+	default: // FAIL
+		logger.Error("MLXConfig Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
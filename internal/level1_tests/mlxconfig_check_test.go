@@ -0,0 +1,123 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseMLXConfigParameters function with mlxconfig query output
+func TestParseMLXConfigParameters(t *testing.T) {
+	output := []string{
+		"Device #1:",
+		"----------",
+		"",
+		"Device type:        ConnectX7",
+		"PCI device:         0000:0c:00.0",
+		"",
+		"Configurations:                                  Next Boot",
+		"         MAX_ACC_OUT_READ                        44",
+		"         ADVANCED_PCI_SETTINGS                   True(1)",
+		"         PCI_ATOMIC_MODE                         PCI_ATOMIC_DISABLED_EXT_ATOMIC_ENABLED(0)",
+	}
+
+	expected := map[string]string{
+		"MAX_ACC_OUT_READ":      "44",
+		"ADVANCED_PCI_SETTINGS": "True(1)",
+		"PCI_ATOMIC_MODE":       "PCI_ATOMIC_DISABLED_EXT_ATOMIC_ENABLED(0)",
+	}
+
+	parameters := parseMLXConfigParameters(output)
+	if !reflect.DeepEqual(parameters, expected) {
+		t.Errorf("parseMLXConfigParameters() = %v, want %v", parameters, expected)
+	}
+}
+
+// Test checkMLXConfigParameters function
+func TestCheckMLXConfigParameters(t *testing.T) {
+	expected := map[string][]string{
+		"MAX_ACC_OUT_READ":      {"0", "44", "128"},
+		"ADVANCED_PCI_SETTINGS": {"True"},
+	}
+
+	tests := []struct {
+		name           string
+		actual         map[string]string
+		expectedStatus map[string]string
+	}{
+		{
+			name:           "all parameters match",
+			actual:         map[string]string{"MAX_ACC_OUT_READ": "128", "ADVANCED_PCI_SETTINGS": "True(1)"},
+			expectedStatus: map[string]string{"ADVANCED_PCI_SETTINGS": "PASS", "MAX_ACC_OUT_READ": "PASS"},
+		},
+		{
+			name:           "wrong value",
+			actual:         map[string]string{"MAX_ACC_OUT_READ": "32", "ADVANCED_PCI_SETTINGS": "True"},
+			expectedStatus: map[string]string{"ADVANCED_PCI_SETTINGS": "PASS", "MAX_ACC_OUT_READ": "FAIL"},
+		},
+		{
+			name:           "raw value prefix does not match",
+			actual:         map[string]string{"MAX_ACC_OUT_READ": "440", "ADVANCED_PCI_SETTINGS": "TrueX"},
+			expectedStatus: map[string]string{"ADVANCED_PCI_SETTINGS": "FAIL", "MAX_ACC_OUT_READ": "FAIL"},
+		},
+		{
+			name:           "missing parameter",
+			actual:         map[string]string{"MAX_ACC_OUT_READ": "0"},
+			expectedStatus: map[string]string{"ADVANCED_PCI_SETTINGS": "FAIL", "MAX_ACC_OUT_READ": "PASS"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := checkMLXConfigParameters("0000:0c:00.0", tt.actual, expected)
+			if len(results) != len(expected) {
+				t.Fatalf("checkMLXConfigParameters() returned %d results, want %d", len(results), len(expected))
+			}
+			// Results are sorted by parameter name
+			if results[0].Parameter != "ADVANCED_PCI_SETTINGS" || results[1].Parameter != "MAX_ACC_OUT_READ" {
+				t.Errorf("checkMLXConfigParameters() parameters = %s, %s, want sorted order", results[0].Parameter, results[1].Parameter)
+			}
+			for _, result := range results {
+				if result.PCIBusID != "0000:0c:00.0" {
+					t.Errorf("checkMLXConfigParameters() PCIBusID = %s, want 0000:0c:00.0", result.PCIBusID)
+				}
+				if result.Status != tt.expectedStatus[result.Parameter] {
+					t.Errorf("checkMLXConfigParameters() %s status = %s, want %s", result.Parameter, result.Status, tt.expectedStatus[result.Parameter])
+				}
+			}
+
+			status, err := validateMLXConfigResults(results)
+			allPass := true
+			for _, s := range tt.expectedStatus {
+				if s != "PASS" {
+					allPass = false
+				}
+			}
+			if allPass != (status == "PASS") || allPass != (err == nil) {
+				t.Errorf("validateMLXConfigResults() = %s, %v", status, err)
+			}
+		})
+	}
+}
+
+// Test mlxconfigExpectedValues function with test_limits.json values
+func TestMLXConfigExpectedValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected []string
+	}{
+		{"string", "True", []string{"True"}},
+		{"number", float64(44), []string{"44"}},
+		{"bool", true, []string{"True"}},
+		{"list", []interface{}{float64(0), float64(44), float64(128)}, []string{"0", "44", "128"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := mlxconfigExpectedValues(tt.value)
+			if !reflect.DeepEqual(values, tt.expected) {
+				t.Errorf("mlxconfigExpectedValues() = %v, want %v", values, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/shapes"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// Authentication methods of RDMA interfaces
+const (
+	// authTypeIBSA validates that InfiniBand ports are registered with the subnet administrator
+	authTypeIBSA = "IB_SA"
+	// authTypeEAP validates the 802.1X (EAP) authentication of Ethernet RDMA interfaces using wpa_cli
+	authTypeEAP = "EAP"
+)
+
+// Matches the LID of a saquery node record, e.g. "		lid.....................0x000C"
+var saqueryLIDRegex = regexp.MustCompile(`(?m)^\s*lid\.+(0x[0-9a-fA-F]+)\s*$`)
+
+// NetworkAuthCheckTestConfig represents the config needed to run this test.
+// AuthType selects the validation method of every RDMA interface; when empty it is
+// derived per interface from the interface name.
+type NetworkAuthCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+	AuthType  string `json:"auth_type"`
+}
+
+// NetworkAuthCheckResult represents the authentication status of a single RDMA interface
+type NetworkAuthCheckResult struct {
+	Device     string `json:"device"`
+	Interface  string `json:"interface"`
+	AuthType   string `json:"auth_type"`
+	AuthStatus string `json:"auth_status"`
+}
+
+// AuthCheckResult represents the EAP authentication status of an interface parsed from wpa_cli
+type AuthCheckResult struct {
+	Device     string `json:"device"`
+	AuthStatus string `json:"auth_status"`
+}
+
+// rdmaAuthInterface represents an RDMA device of the shape and its network interface
+type rdmaAuthInterface struct {
+	Device    string
+	Interface string
+}
+
+// getNetworkAuthCheckTestConfig gets test config needed to run this test
+func getNetworkAuthCheckTestConfig() (*NetworkAuthCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	networkAuthCheckTestConfig := &NetworkAuthCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "network_auth_check")
+	if err != nil {
+		return nil, err
+	}
+	networkAuthCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "network_auth_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if authType, ok := thresholdMap["auth_type"].(string); ok {
+				switch strings.ToUpper(strings.TrimSpace(authType)) {
+				case authTypeIBSA:
+					networkAuthCheckTestConfig.AuthType = authTypeIBSA
+				case authTypeEAP:
+					networkAuthCheckTestConfig.AuthType = authTypeEAP
+				default:
+					return nil, fmt.Errorf("unsupported auth_type %q for network_auth_check on shape %s, expected %s or %s",
+						authType, shape, authTypeIBSA, authTypeEAP)
+				}
+			}
+		}
+	}
+
+	return networkAuthCheckTestConfig, nil
+}
+
+// authTypeForInterface returns the configured authentication method, or IB_SA for InfiniBand
+// interfaces (ib* interface names) and EAP for Ethernet RDMA interfaces when none is configured
+func authTypeForInterface(configuredAuthType string, interfaceName string) string {
+	if configuredAuthType != "" {
+		return configuredAuthType
+	}
+	if strings.HasPrefix(interfaceName, "ib") {
+		return authTypeIBSA
+	}
+	return authTypeEAP
+}
+
+// getRDMAAuthInterfaces maps the RDMA NICs of the shape in shapes.json to their network interfaces
+// using ibdev2netdev. RDMA devices without an interface are left out.
+func getRDMAAuthInterfaces(shape string) ([]rdmaAuthInterface, error) {
+	deviceMap, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device mapping: %w", err)
+	}
+
+	shapeManager, err := shapes.NewShapeManager("internal/shapes/shapes.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shapes configuration: %w", err)
+	}
+
+	rdmaNics, err := shapeManager.GetRDMANics(shape)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RDMA NICs for shape %s: %w", shape, err)
+	}
+	logger.Info("Found", len(rdmaNics), "RDMA NICs for shape", shape)
+
+	var interfaces []rdmaAuthInterface
+	for _, rdmaNic := range rdmaNics {
+		if interfaceName, exists := deviceMap[rdmaNic.DeviceName]; exists {
+			interfaces = append(interfaces, rdmaAuthInterface{Device: rdmaNic.DeviceName, Interface: interfaceName})
+			logger.Info("Mapped RDMA device", rdmaNic.DeviceName, "to interface", interfaceName)
+		} else {
+			logger.Info("RDMA device", rdmaNic.DeviceName, "not found in device mapping")
+		}
+	}
+
+	return interfaces, nil
+}
+
+// checkEAPAuth returns the EAP authentication status of an interface from wpa_cli
+func checkEAPAuth(interfaceName string) (*AuthCheckResult, error) {
+	var wpaCliOutput string
+	result, err := executor.RunWpaCliStatus(interfaceName)
+	if err != nil {
+		logger.Error("Failed to run wpa_cli for interface", interfaceName, ":", err)
+	} else {
+		wpaCliOutput = result.Output
+	}
+	return parseAuthResults(interfaceName, wpaCliOutput)
+}
+
+// parseAuthResults parses the output from wpa_cli command and validates authentication status
+func parseAuthResults(interfaceName string, wpaCliOutput string) (*AuthCheckResult, error) {
+	result := &AuthCheckResult{
+		Device:     interfaceName,
+		AuthStatus: "FAIL - Unable to check authentication",
+	}
+
+	// If error, check if it's a command execution error
+	if strings.HasPrefix(wpaCliOutput, "Error:") {
+		result.AuthStatus = "FAIL - Unable to run wpa_cli command"
+		return result, nil
+	}
+
+	if strings.TrimSpace(wpaCliOutput) == "" {
+		result.AuthStatus = "FAIL - Unable to run wpa_cli command"
+		return result, nil
+	}
+
+	// Check for specific authenticated status in the output
+	if strings.Contains(wpaCliOutput, "Supplicant PAE state=AUTHENTICATED") {
+		result.AuthStatus = "PASS"
+	} else {
+		result.AuthStatus = "FAIL - Interface not authenticated"
+	}
+
+	return result, nil
+}
+
+// parseSAQueryNodeRecord validates that saquery returned the node record of the port LID,
+// i.e. that the port is registered with the subnet administrator
+func parseSAQueryNodeRecord(saqueryOutput string, lid int) string {
+	if strings.TrimSpace(saqueryOutput) == "" {
+		return "FAIL - Unable to run saquery command"
+	}
+
+	for _, match := range saqueryLIDRegex.FindAllStringSubmatch(saqueryOutput, -1) {
+		recordLID, err := strconv.ParseInt(match[1], 0, 32)
+		if err == nil && int(recordLID) == lid {
+			return "PASS"
+		}
+	}
+	return fmt.Sprintf("FAIL - LID %d not registered with the subnet administrator", lid)
+}
+
+// checkIBSAAuth returns the subnet administrator registration status of the ports of an InfiniBand device
+func checkIBSAAuth(deviceName string, ports []IBPortSMInfo) string {
+	found := false
+	for _, port := range ports {
+		if port.Device != deviceName {
+			continue
+		}
+		found = true
+
+		if !port.Active {
+			return fmt.Sprintf("FAIL - Port %d is %s", port.Port, port.State)
+		}
+		if port.BaseLID == 0 {
+			return fmt.Sprintf("FAIL - Port %d has no LID assigned", port.Port)
+		}
+
+		result, err := executor.RunSaquery(deviceName, port.Port, "NR", strconv.Itoa(port.BaseLID))
+		if err != nil {
+			logger.Errorf("Failed to run saquery for %s port %d: %v", deviceName, port.Port, err)
+			return fmt.Sprintf("FAIL - LID %d not registered with the subnet administrator", port.BaseLID)
+		}
+		if status := parseSAQueryNodeRecord(result.Output, port.BaseLID); status != "PASS" {
+			return status
+		}
+	}
+
+	if !found {
+		return "FAIL - No InfiniBand port found"
+	}
+	return "PASS"
+}
+
+// RunNetworkAuthCheck validates the authentication status of every RDMA interface: InfiniBand ports
+// must be registered with the subnet administrator (IB_SA) and Ethernet RDMA interfaces must be
+// authenticated by wpa_supplicant (EAP). It supersedes auth_check, which only covers EAP.
+func RunNetworkAuthCheck() error {
+	logger.Info("=== Network Authentication Check ===")
+	testConfig, err := getNetworkAuthCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "network_auth_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting network authentication check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Map the RDMA NICs of the shape to their interfaces
+	logger.Info("Step 1: Getting RDMA interfaces...")
+	interfaces, err := getRDMAAuthInterfaces(testConfig.Shape)
+	if err != nil {
+		logger.Error("Network Authentication Check: FAIL -", err)
+		rep.AddNetworkAuthCheckResult("FAIL", []NetworkAuthCheckResult{}, nil, err)
+		return err
+	}
+	if len(interfaces) == 0 {
+		logger.Info("Network Authentication Check: SKIP - No RDMA interfaces found for checking")
+		rep.AddNetworkAuthCheckResult("SKIP", []NetworkAuthCheckResult{}, nil, nil)
+		return nil
+	}
+
+	// Step 2: Get InfiniBand port states when any interface uses subnet administrator registration
+	var ibPorts []IBPortSMInfo
+	for _, iface := range interfaces {
+		if authTypeForInterface(testConfig.AuthType, iface.Interface) != authTypeIBSA {
+			continue
+		}
+		logger.Info("Step 2: Getting InfiniBand port states...")
+		result, err := executor.RunIbstat()
+		if err != nil {
+			err = fmt.Errorf("ibstat failed: %w", commandError("network_auth_check", result, err))
+			logger.Error("Network Authentication Check: FAIL -", err)
+			rep.AddNetworkAuthCheckResult("FAIL", []NetworkAuthCheckResult{}, nil, err)
+			return err
+		}
+		ibPorts = parseIbstatPorts(result.Output)
+		break
+	}
+
+	// Step 3: Validate the authentication status of every interface
+	logger.Info("Step 3: Validating authentication status of", len(interfaces), "RDMA interfaces...")
+	var results []NetworkAuthCheckResult
+	var failed []string
+	for _, iface := range interfaces {
+		authResult := NetworkAuthCheckResult{
+			Device:    iface.Device,
+			Interface: iface.Interface,
+			AuthType:  authTypeForInterface(testConfig.AuthType, iface.Interface),
+		}
+
+		if authResult.AuthType == authTypeIBSA {
+			authResult.AuthStatus = checkIBSAAuth(iface.Device, ibPorts)
+		} else {
+			eapResult, err := checkEAPAuth(iface.Interface)
+			if err != nil {
+				authResult.AuthStatus = fmt.Sprintf("FAIL - %v", err)
+			} else {
+				authResult.AuthStatus = eapResult.AuthStatus
+			}
+		}
+
+		logger.Infof("%s (%s) %s authentication: %s", iface.Interface, iface.Device, authResult.AuthType, authResult.AuthStatus)
+		if !strings.HasPrefix(authResult.AuthStatus, "PASS") {
+			failed = append(failed, iface.Interface)
+		}
+		results = append(results, authResult)
+	}
+
+	if len(failed) > 0 {
+		err = fmt.Errorf("RDMA interfaces not authenticated: %s", strings.Join(failed, ", "))
+		logger.Error("Network Authentication Check: FAIL -", err)
+		rep.AddNetworkAuthCheckResult("FAIL", results, failed, err)
+		return err
+	}
+
+	logger.Info("Network Authentication Check: PASS - All RDMA interfaces are authenticated")
+	rep.AddNetworkAuthCheckResult("PASS", results, nil, nil)
+	return nil
+}
@@ -0,0 +1,145 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const testSAQueryNodeRecordOutput = `NodeRecord dump:
+		lid.....................0x000C
+		reserved................0x0
+		base_version............0x1
+		class_version...........0x1
+		node_type...............Channel Adapter
+		num_ports...............1
+		sys_guid................0xb8cef60300a1b2c3
+		node_guid...............0xb8cef60300a1b2c3
+		port_guid...............0xb8cef60300a1b2c3
+		NodeDescription.........gpu-node-1 mlx5_0
+`
+
+// Test authTypeForInterface function
+func TestAuthTypeForInterface(t *testing.T) {
+	tests := []struct {
+		name               string
+		configuredAuthType string
+		interfaceName      string
+		expected           string
+	}{
+		{name: "InfiniBand interface", interfaceName: "ib0", expected: authTypeIBSA},
+		{name: "Ethernet RDMA interface", interfaceName: "rdma0", expected: authTypeEAP},
+		{name: "Configured auth type", configuredAuthType: authTypeEAP, interfaceName: "ib0", expected: authTypeEAP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authTypeForInterface(tt.configuredAuthType, tt.interfaceName); got != tt.expected {
+				t.Errorf("authTypeForInterface(%q, %q) = %s, want %s", tt.configuredAuthType, tt.interfaceName, got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test parseAuthResults function
+func TestParseAuthResults(t *testing.T) {
+	tests := []struct {
+		name           string
+		interfaceName  string
+		wpaCliOutput   string
+		expectedStatus string
+	}{
+		{
+			name:           "Authenticated interface",
+			interfaceName:  "rdma0",
+			wpaCliOutput:   "Supplicant PAE state=AUTHENTICATED\nwpa_state=COMPLETED\n",
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "Non-authenticated interface",
+			interfaceName:  "rdma1",
+			wpaCliOutput:   "Supplicant PAE state=DISCONNECTED\nwpa_state=DISCONNECTED\n",
+			expectedStatus: "FAIL - Interface not authenticated",
+		},
+		{
+			name:           "Empty output",
+			interfaceName:  "rdma2",
+			wpaCliOutput:   "",
+			expectedStatus: "FAIL - Unable to run wpa_cli command",
+		},
+		{
+			name:           "Error output",
+			interfaceName:  "rdma3",
+			wpaCliOutput:   "Error: Failed to connect to wpa_supplicant",
+			expectedStatus: "FAIL - Unable to run wpa_cli command",
+		},
+		{
+			name:           "Interface without supplicant",
+			interfaceName:  "rdma4",
+			wpaCliOutput:   "Could not connect to wpa_supplicant: rdma4 - re-trying\n",
+			expectedStatus: "FAIL - Interface not authenticated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseAuthResults(tt.interfaceName, tt.wpaCliOutput)
+			if err != nil {
+				t.Errorf("parseAuthResults() error = %v", err)
+				return
+			}
+
+			if result.Device != tt.interfaceName {
+				t.Errorf("parseAuthResults() device = %v, want %v", result.Device, tt.interfaceName)
+			}
+
+			if result.AuthStatus != tt.expectedStatus {
+				t.Errorf("parseAuthResults() status = %v, want %v", result.AuthStatus, tt.expectedStatus)
+			}
+		})
+	}
+}
+
+// Test parseSAQueryNodeRecord function
+func TestParseSAQueryNodeRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		lid      int
+		expected string
+	}{
+		{name: "Registered LID", output: testSAQueryNodeRecordOutput, lid: 12, expected: "PASS"},
+		{name: "Other LID", output: testSAQueryNodeRecordOutput, lid: 13, expected: "FAIL - LID 13 not registered with the subnet administrator"},
+		{name: "No records", output: "ERROR: Query result returned 0 records\n", lid: 12, expected: "FAIL - LID 12 not registered with the subnet administrator"},
+		{name: "Empty output", output: "", lid: 12, expected: "FAIL - Unable to run saquery command"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseSAQueryNodeRecord(tt.output, tt.lid); got != tt.expected {
+				t.Errorf("parseSAQueryNodeRecord() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test checkIBSAAuth for ports that cannot be registered with the subnet administrator
+func TestCheckIBSAAuthUnregisteredPorts(t *testing.T) {
+	ports := []IBPortSMInfo{
+		{Device: "mlx5_0", Port: 1, State: "Down", Active: false},
+		{Device: "mlx5_1", Port: 1, State: "Active", Active: true, BaseLID: 0},
+	}
+
+	tests := []struct {
+		device   string
+		expected string
+	}{
+		{"mlx5_0", "FAIL - Port 1 is Down"},
+		{"mlx5_1", "FAIL - Port 1 has no LID assigned"},
+		{"mlx5_2", "FAIL - No InfiniBand port found"},
+	}
+
+	for _, tt := range tests {
+		if got := checkIBSAAuth(tt.device, ports); got != tt.expected {
+			t.Errorf("checkIBSAAuth(%s) = %s, want %s", tt.device, got, tt.expected)
+		}
+	}
+}
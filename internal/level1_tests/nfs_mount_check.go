@@ -0,0 +1,222 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+const (
+	procMountsPath = "/proc/mounts"
+	// nfsStatTimeout bounds the stat of each mount so a hung NFS server does not hang the test
+	nfsStatTimeout          = 2 * time.Second
+	defaultMaxStatLatencyMs = 500
+)
+
+// NFSMountCheckTestConfig represents the config needed to run this test
+type NFSMountCheckTestConfig struct {
+	IsEnabled        bool     `json:"enabled"`
+	Shape            string   `json:"shape"`
+	ExpectedMounts   []string `json:"expected_mounts"`
+	MaxStatLatencyMs int      `json:"max_stat_latency_ms"`
+}
+
+// NFSMount represents the state of an expected NFS mount point
+type NFSMount struct {
+	Path       string  `json:"path"`
+	FSType     string  `json:"fs_type"`
+	Mounted    bool    `json:"mounted"`
+	Responsive bool    `json:"responsive"`
+	LatencyMs  float64 `json:"latency_ms"`
+	Status     string  `json:"status"`
+}
+
+// getNFSMountCheckTestConfig gets test config needed to run this test
+func getNFSMountCheckTestConfig() (*NFSMountCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	nfsMountCheckTestConfig := &NFSMountCheckTestConfig{
+		IsEnabled:        false,
+		Shape:            shape,
+		MaxStatLatencyMs: defaultMaxStatLatencyMs,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "nfs_mount_check")
+	if err != nil {
+		return nil, err
+	}
+	nfsMountCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "nfs_mount_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if mounts, ok := thresholdMap["expected_mounts"].([]interface{}); ok {
+				for _, mount := range mounts {
+					if path, ok := mount.(string); ok {
+						nfsMountCheckTestConfig.ExpectedMounts = append(nfsMountCheckTestConfig.ExpectedMounts, path)
+					}
+				}
+			}
+			if latency, ok := thresholdMap["max_stat_latency_ms"].(float64); ok {
+				nfsMountCheckTestConfig.MaxStatLatencyMs = int(latency)
+			}
+		}
+	}
+
+	return nfsMountCheckTestConfig, nil
+}
+
+// parseNFSMounts returns the filesystem type of every NFS mount in /proc/mounts keyed by mount point
+func parseNFSMounts(output string) map[string]string {
+	mounts := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		// Format: <device> <mount point> <fs type> <options> <dump> <pass>
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		if fields[2] == "nfs" || fields[2] == "nfs4" {
+			// Spaces in mount points are escaped as \040
+			mounts[strings.ReplaceAll(fields[1], `\040`, " ")] = fields[2]
+		}
+	}
+	return mounts
+}
+
+// findExpectedNFSMounts returns the state of every expected mount point, marking those found in nfsMounts as mounted
+func findExpectedNFSMounts(expectedMounts []string, nfsMounts map[string]string) []NFSMount {
+	mounts := make([]NFSMount, 0, len(expectedMounts))
+	for _, path := range expectedMounts {
+		fsType, mounted := nfsMounts[path]
+		mounts = append(mounts, NFSMount{Path: path, FSType: fsType, Mounted: mounted})
+	}
+	return mounts
+}
+
+// validateNFSMounts sets the per-mount status and returns the overall status.
+// Missing mounts and mounts whose stat did not return in time FAIL, since jobs cannot reach
+// their data. Responsive mounts slower than maxLatencyMs only WARN. The test is SKIPped when
+// no NFS mounts are expected, since the mounts are site-specific.
+func validateNFSMounts(mounts []NFSMount, maxLatencyMs int) (string, error) {
+	if len(mounts) == 0 {
+		return "SKIP", nil
+	}
+
+	var missing, hung, slow []string
+	for i := range mounts {
+		mount := &mounts[i]
+		mount.Status = "PASS"
+
+		switch {
+		case !mount.Mounted:
+			mount.Status = "FAIL"
+			missing = append(missing, mount.Path)
+		case !mount.Responsive:
+			mount.Status = "FAIL"
+			hung = append(hung, mount.Path)
+		case mount.LatencyMs > float64(maxLatencyMs):
+			mount.Status = "WARN"
+			slow = append(slow, fmt.Sprintf("%s (%.0fms)", mount.Path, mount.LatencyMs))
+		}
+	}
+
+	var issues []string
+	if len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("NFS mount(s) missing: %s", strings.Join(missing, ", ")))
+	}
+	if len(hung) > 0 {
+		issues = append(issues, fmt.Sprintf("NFS mount(s) not responding: %s", strings.Join(hung, ", ")))
+	}
+	if len(issues) > 0 {
+		return "FAIL", errors.New(strings.Join(issues, "; "))
+	}
+	if len(slow) > 0 {
+		return "WARN", fmt.Errorf("NFS mount(s) slower than %dms: %s", maxLatencyMs, strings.Join(slow, ", "))
+	}
+	return "PASS", nil
+}
+
+func RunNFSMountCheck() error {
+	logger.Info("=== NFS Mount Check ===")
+	testConfig, err := getNFSMountCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "nfs_mount_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting NFS mount check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the mounted filesystems
+	logger.Info("Step 1: Reading mounted NFS filesystems...")
+	result, err := executor.RunCat(procMountsPath)
+	if err != nil {
+		err = commandError("nfs_mount_check", result, err)
+		logger.Error("NFS Mount Check: FAIL - Could not read mounts:", err)
+		rep.AddNFSMountResult("FAIL", nil, err)
+		return fmt.Errorf("could not read mounts: %w", err)
+	}
+	mounts := findExpectedNFSMounts(testConfig.ExpectedMounts, parseNFSMounts(result.Output))
+	logger.Info("Expected NFS mounts:", testConfig.ExpectedMounts)
+
+	// Step 2: Check every mounted NFS filesystem responds
+	logger.Info("Step 2: Checking NFS mounts respond...")
+	for i := range mounts {
+		if !mounts[i].Mounted {
+			continue
+		}
+		latency, statErr := executor.StatWithTimeout(mounts[i].Path, nfsStatTimeout)
+		mounts[i].Responsive = statErr == nil
+		mounts[i].LatencyMs = float64(latency.Microseconds()) / 1000
+		if statErr != nil {
+			logger.Errorf("NFS mount %s did not respond: %v", mounts[i].Path, statErr)
+		}
+	}
+
+	// Step 3: Validate the NFS mounts
+	logger.Info("Step 3: Validating NFS mounts...")
+	status, validationErr := validateNFSMounts(mounts, testConfig.MaxStatLatencyMs)
+	for _, mount := range mounts {
+		logger.Infof("%s: mounted %t, responsive %t, latency %.1fms - %s", mount.Path, mount.Mounted, mount.Responsive, mount.LatencyMs, mount.Status)
+	}
+	rep.AddNFSMountResult(status, mounts, validationErr)
+
+	switch status {
+	case "SKIP":
+		logger.Info("NFS Mount Check: SKIP - No expected NFS mounts configured in expected_mounts")
+		return nil
+	case "PASS":
+		logger.Infof("NFS Mount Check: PASS - All %d expected NFS mounts are mounted and responsive", len(mounts))
+		return nil
+	case "WARN":
+		logger.Info("NFS Mount Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("NFS Mount Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
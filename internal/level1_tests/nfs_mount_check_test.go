@@ -0,0 +1,82 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseNFSMounts function
+func TestParseNFSMounts(t *testing.T) {
+	output := `/dev/sda1 / xfs rw,relatime 0 0
+10.0.0.5:/export/cluster /nfs/cluster nfs rw,relatime,vers=3 0 0
+10.0.0.6:/scratch /nfs/scratch\040data nfs4 rw,relatime,vers=4.1 0 0
+tmpfs /run tmpfs rw,nosuid 0 0
+`
+	mounts := parseNFSMounts(output)
+	expected := map[string]string{"/nfs/cluster": "nfs", "/nfs/scratch data": "nfs4"}
+	if !reflect.DeepEqual(mounts, expected) {
+		t.Errorf("parseNFSMounts() = %v, want %v", mounts, expected)
+	}
+}
+
+// Test findExpectedNFSMounts function
+func TestFindExpectedNFSMounts(t *testing.T) {
+	mounts := findExpectedNFSMounts([]string{"/nfs/cluster", "/nfs/home"}, map[string]string{"/nfs/cluster": "nfs"})
+	expected := []NFSMount{
+		{Path: "/nfs/cluster", FSType: "nfs", Mounted: true},
+		{Path: "/nfs/home"},
+	}
+	if !reflect.DeepEqual(mounts, expected) {
+		t.Errorf("findExpectedNFSMounts() = %+v, want %+v", mounts, expected)
+	}
+}
+
+// Test validateNFSMounts function
+func TestValidateNFSMounts(t *testing.T) {
+	tests := []struct {
+		name           string
+		mounts         []NFSMount
+		expectedStatus string
+	}{
+		{
+			name:           "Mounted and responsive",
+			mounts:         []NFSMount{{Path: "/nfs/cluster", Mounted: true, Responsive: true, LatencyMs: 3}},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "Slow mount",
+			mounts:         []NFSMount{{Path: "/nfs/cluster", Mounted: true, Responsive: true, LatencyMs: 900}},
+			expectedStatus: "WARN",
+		},
+		{
+			name:           "Hung mount",
+			mounts:         []NFSMount{{Path: "/nfs/cluster", Mounted: true, LatencyMs: 2000}},
+			expectedStatus: "FAIL",
+		},
+		{
+			name: "Missing mount",
+			mounts: []NFSMount{
+				{Path: "/nfs/cluster", Mounted: true, Responsive: true, LatencyMs: 900},
+				{Path: "/nfs/home"},
+			},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "No expected mounts",
+			mounts:         []NFSMount{},
+			expectedStatus: "SKIP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateNFSMounts(tt.mounts, 500)
+			if status != tt.expectedStatus {
+				t.Errorf("validateNFSMounts() = %s, want %s", status, tt.expectedStatus)
+			}
+			if (status == "PASS" || status == "SKIP") != (err == nil) {
+				t.Errorf("validateNFSMounts() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
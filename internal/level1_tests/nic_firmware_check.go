@@ -0,0 +1,206 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// ethtoolFirmwareVersionRegex matches the firmware version in ethtool -i output,
+// e.g. "firmware-version: 28.39.1002 (MT_0000000834)"
+var ethtoolFirmwareVersionRegex = regexp.MustCompile(`(?m)^firmware-version:\s*(\S*)`)
+
+// NICFirmwareCheckTestConfig represents the config needed to run this test
+type NICFirmwareCheckTestConfig struct {
+	IsEnabled          bool   `json:"enabled"`
+	Shape              string `json:"shape"`
+	MinFirmwareVersion string `json:"min_firmware_version"`
+}
+
+// NICFirmwareInfo represents the firmware version and validation status of a single RDMA network interface
+type NICFirmwareInfo struct {
+	Device          string `json:"device"`
+	Interface       string `json:"interface"`
+	FirmwareVersion string `json:"firmware_version"`
+	Status          string `json:"status"`
+}
+
+// getNICFirmwareCheckTestConfig gets test config needed to run this test
+func getNICFirmwareCheckTestConfig() (*NICFirmwareCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	nicFirmwareCheckTestConfig := &NICFirmwareCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "nic_firmware_check")
+	if err != nil {
+		return nil, err
+	}
+	nicFirmwareCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "nic_firmware_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if version, ok := thresholdMap["min_firmware_version"].(string); ok {
+				nicFirmwareCheckTestConfig.MinFirmwareVersion = version
+			}
+		}
+	}
+
+	return nicFirmwareCheckTestConfig, nil
+}
+
+// parseEthtoolFirmwareVersion parses the firmware version from ethtool -i output
+func parseEthtoolFirmwareVersion(output string) (string, error) {
+	match := ethtoolFirmwareVersionRegex.FindStringSubmatch(output)
+	if match == nil {
+		return "", fmt.Errorf("firmware-version not found in ethtool -i output")
+	}
+	return match[1], nil
+}
+
+// getNICFirmwareInfo uses ethtool -i to get the firmware version of the network interface of each RDMA device
+func getNICFirmwareInfo() ([]NICFirmwareInfo, error) {
+	netdevs, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+	}
+
+	devices := make([]string, 0, len(netdevs))
+	for device := range netdevs {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+
+	var nics []NICFirmwareInfo
+	for _, device := range devices {
+		interfaceName := netdevs[device]
+		result, err := executor.RunEthtool(interfaceName, "-i")
+		if err != nil {
+			return nil, fmt.Errorf("ethtool -i failed: %w", commandError("nic_firmware_check", result, err))
+		}
+		version, err := parseEthtoolFirmwareVersion(result.Output)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", interfaceName, err)
+		}
+
+		nics = append(nics, NICFirmwareInfo{
+			Device:          device,
+			Interface:       interfaceName,
+			FirmwareVersion: version,
+		})
+	}
+
+	if len(nics) == 0 {
+		return nil, fmt.Errorf("no RDMA network interfaces found")
+	}
+	return nics, nil
+}
+
+// validateNICFirmwareVersions sets the per-interface status and returns the overall status.
+// Unreadable versions, versions below the minimum and versions that differ between NICs FAIL,
+// since NICs running different firmware on one node behave unpredictably.
+func validateNICFirmwareVersions(nics []NICFirmwareInfo, minVersion string) (string, error) {
+	if len(nics) == 0 {
+		return "FAIL", fmt.Errorf("no NIC firmware versions found")
+	}
+
+	var invalidNICs, outdatedNICs []string
+	versions := []string{}
+	for i := range nics {
+		nic := &nics[i]
+		nic.Status = "PASS"
+
+		if nic.FirmwareVersion == "" || nic.FirmwareVersion == "N/A" {
+			nic.Status = "FAIL"
+			invalidNICs = append(invalidNICs, nic.Interface)
+			continue
+		}
+		if !containsString(versions, nic.FirmwareVersion) {
+			versions = append(versions, nic.FirmwareVersion)
+		}
+
+		if minVersion != "" && compareFirmwareVersions(nic.FirmwareVersion, minVersion) < 0 {
+			nic.Status = "FAIL"
+			outdatedNICs = append(outdatedNICs, nic.Interface)
+		}
+	}
+
+	if len(invalidNICs) > 0 {
+		return "FAIL", fmt.Errorf("firmware version could not be read on NIC(s): %s", strings.Join(invalidNICs, ","))
+	}
+	if len(versions) > 1 {
+		return "FAIL", fmt.Errorf("inconsistent NIC firmware versions: %s", strings.Join(versions, ","))
+	}
+	if len(outdatedNICs) > 0 {
+		return "FAIL", fmt.Errorf("NIC firmware version %s is older than the minimum supported version %s on NIC(s): %s",
+			versions[0], minVersion, strings.Join(outdatedNICs, ","))
+	}
+	return "PASS", nil
+}
+
+func RunNICFirmwareCheck() error {
+	logger.Info("=== NIC Firmware Check ===")
+	testConfig, err := getNICFirmwareCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "nic_firmware_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting NIC firmware version check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get NIC firmware versions
+	logger.Info("Step 1: Getting NIC firmware versions with ethtool -i...")
+	nics, err := getNICFirmwareInfo()
+	if err != nil {
+		logger.Error("NIC Firmware Check: FAIL - Could not get NIC firmware versions:", err)
+		rep.AddNICFirmwareResult("FAIL", nil, err)
+		return fmt.Errorf("could not get NIC firmware versions: %w", err)
+	}
+
+	// Step 2: Validate NIC firmware versions
+	logger.Info("Step 2: Validating NIC firmware versions...")
+	logger.Info("Minimum NIC firmware version:", testConfig.MinFirmwareVersion)
+
+	status, validationErr := validateNICFirmwareVersions(nics, testConfig.MinFirmwareVersion)
+	for _, nic := range nics {
+		logger.Infof("%s (%s): firmware %s - %s", nic.Interface, nic.Device, nic.FirmwareVersion, nic.Status)
+	}
+	rep.AddNICFirmwareResult(status, nics, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("NIC Firmware Check: PASS - All NICs run the same supported firmware version")
+		return nil
+	default: // FAIL
+		logger.Error("NIC Firmware Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,60 @@
+package level1_tests
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test parseEthtoolFirmwareVersion function
+func TestParseEthtoolFirmwareVersion(t *testing.T) {
+	output := `driver: mlx5_core
+version: 5.8-3.0.7
+firmware-version: 28.39.1002 (MT_0000000834)
+expansion-rom-version:
+bus-info: 0000:0c:00.0
+`
+	if version, err := parseEthtoolFirmwareVersion(output); err != nil || version != "28.39.1002" {
+		t.Errorf("parseEthtoolFirmwareVersion() = %q, %v, want 28.39.1002", version, err)
+	}
+	if _, err := parseEthtoolFirmwareVersion("driver: mlx5_core\n"); err == nil {
+		t.Error("Expected error for ethtool -i output without a firmware version")
+	}
+}
+
+// Test validateNICFirmwareVersions function
+func TestValidateNICFirmwareVersions(t *testing.T) {
+	tests := []struct {
+		name       string
+		versions   []string
+		minVersion string
+		wantStatus string
+		wantErr    string
+	}{
+		{"same supported version", []string{"28.39.1002", "28.39.1002"}, "28.39.1002", "PASS", ""},
+		{"no minimum", []string{"28.36.1010", "28.36.1010"}, "", "PASS", ""},
+		{"inconsistent versions", []string{"28.39.1002", "28.36.1010"}, "", "FAIL", "inconsistent NIC firmware versions: 28.39.1002,28.36.1010"},
+		{"outdated version", []string{"28.36.1010", "28.36.1010"}, "28.39.1002", "FAIL", "older than the minimum supported version 28.39.1002 on NIC(s): rdma0,rdma1"},
+		{"unreadable version", []string{"28.39.1002", ""}, "", "FAIL", "could not be read on NIC(s): rdma1"},
+		{"no NICs", nil, "", "FAIL", "no NIC firmware versions found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nics []NICFirmwareInfo
+			for i, version := range tt.versions {
+				nics = append(nics, NICFirmwareInfo{Interface: "rdma" + string(rune('0'+i)), FirmwareVersion: version})
+			}
+
+			status, err := validateNICFirmwareVersions(nics, tt.minVersion)
+			if status != tt.wantStatus {
+				t.Errorf("validateNICFirmwareVersions() status = %s, want %s", status, tt.wantStatus)
+			}
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("validateNICFirmwareVersions() unexpected error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("validateNICFirmwareVersions() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
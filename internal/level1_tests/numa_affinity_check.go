@@ -0,0 +1,219 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// nvidia-smi topo -m may underline the header row with ANSI escape sequences
+var ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// NUMAAffinityCheckTestConfig represents the config needed to run this test
+type NUMAAffinityCheckTestConfig struct {
+	IsEnabled  bool        `json:"enabled"`
+	Shape      string      `json:"shape"`
+	GPUNUMAMap map[int]int `json:"gpu_numa_map"`
+}
+
+// GPUNUMAAffinity represents the expected and actual NUMA node of a single GPU
+type GPUNUMAAffinity struct {
+	GPU          int    `json:"gpu"`
+	ExpectedNUMA int    `json:"expected_numa"`
+	ActualNUMA   int    `json:"actual_numa"`
+	Status       string `json:"status"`
+}
+
+// getNUMAAffinityCheckTestConfig gets test config needed to run this test
+func getNUMAAffinityCheckTestConfig() (*NUMAAffinityCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	numaAffinityCheckTestConfig := &NUMAAffinityCheckTestConfig{
+		IsEnabled:  false,
+		Shape:      shape,
+		GPUNUMAMap: map[int]int{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "numa_affinity_check")
+	if err != nil {
+		return nil, err
+	}
+	numaAffinityCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "numa_affinity_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			// gpu_numa_map is keyed by GPU index with the expected NUMA node as value
+			if gpuNUMAMap, ok := thresholdMap["gpu_numa_map"].(map[string]interface{}); ok {
+				for gpu, node := range gpuNUMAMap {
+					gpuIndex, err := strconv.Atoi(gpu)
+					if err != nil {
+						logger.Errorf("Invalid GPU index in gpu_numa_map: %s", gpu)
+						continue
+					}
+					if nodeVal, ok := node.(float64); ok {
+						numaAffinityCheckTestConfig.GPUNUMAMap[gpuIndex] = int(nodeVal)
+					}
+				}
+			}
+		}
+	}
+
+	return numaAffinityCheckTestConfig, nil
+}
+
+// parseTopoNUMAAffinity parses the "NUMA Affinity" column of nvidia-smi topo -m output.
+// GPUs without a NUMA affinity (N/A) are reported as -1.
+func parseTopoNUMAAffinity(output string) (map[int]int, error) {
+	lines := strings.Split(ansiEscapeRegex.ReplaceAllString(output, ""), "\n")
+
+	numaColumn := -1
+	gpuNUMA := make(map[int]int)
+	for _, line := range lines {
+		fields := strings.Split(line, "\t")
+
+		if numaColumn < 0 {
+			for i, field := range fields {
+				if strings.TrimSpace(field) == "NUMA Affinity" {
+					numaColumn = i
+					break
+				}
+			}
+			continue
+		}
+
+		label := strings.TrimSpace(fields[0])
+		if !strings.HasPrefix(label, "GPU") {
+			continue
+		}
+		gpuIndex, err := strconv.Atoi(strings.TrimPrefix(label, "GPU"))
+		if err != nil {
+			continue
+		}
+		if len(fields) <= numaColumn {
+			return nil, fmt.Errorf("missing NUMA affinity for %s", label)
+		}
+
+		value := strings.TrimSpace(fields[numaColumn])
+		node, err := strconv.Atoi(value)
+		if err != nil {
+			node = -1
+		}
+		gpuNUMA[gpuIndex] = node
+	}
+
+	if numaColumn < 0 {
+		return nil, fmt.Errorf("NUMA Affinity column not found in nvidia-smi topo output")
+	}
+	if len(gpuNUMA) == 0 {
+		return nil, fmt.Errorf("no GPUs found in nvidia-smi topo output")
+	}
+
+	return gpuNUMA, nil
+}
+
+// validateNUMAAffinity compares actual GPU NUMA nodes with the expected mapping
+func validateNUMAAffinity(actual map[int]int, expected map[int]int) ([]GPUNUMAAffinity, error) {
+	gpus := make([]int, 0, len(expected))
+	for gpu := range expected {
+		gpus = append(gpus, gpu)
+	}
+	sort.Ints(gpus)
+
+	var results []GPUNUMAAffinity
+	var mismatched []string
+	for _, gpu := range gpus {
+		affinity := GPUNUMAAffinity{
+			GPU:          gpu,
+			ExpectedNUMA: expected[gpu],
+			ActualNUMA:   -1,
+			Status:       "PASS",
+		}
+
+		node, found := actual[gpu]
+		if found {
+			affinity.ActualNUMA = node
+		}
+		if !found || node != affinity.ExpectedNUMA {
+			affinity.Status = "FAIL"
+			mismatched = append(mismatched, fmt.Sprintf("GPU%d (expected NUMA %d, got %d)", gpu, affinity.ExpectedNUMA, affinity.ActualNUMA))
+		}
+		results = append(results, affinity)
+	}
+
+	if len(mismatched) > 0 {
+		return results, fmt.Errorf("GPU(s) on unexpected NUMA node: %s", strings.Join(mismatched, ", "))
+	}
+	return results, nil
+}
+
+func RunNUMAAffinityCheck() error {
+	logger.Info("=== NUMA Affinity Check ===")
+	testConfig, err := getNUMAAffinityCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "numa_affinity_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting GPU NUMA affinity check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU NUMA affinity from nvidia-smi topo
+	logger.Info("Step 1: Getting GPU NUMA affinity from nvidia-smi topo -m...")
+	result := executor.RunNvidiaSMITopo()
+	if !result.Available {
+		err := nvidiaSMIError("numa_affinity_check", "nvidia-smi topo -m", result)
+		logger.Error("NUMA Affinity Check: FAIL -", err)
+		rep.AddNUMAAffinityResult("FAIL", nil, err)
+		return err
+	}
+
+	actual, err := parseTopoNUMAAffinity(result.Output)
+	if err != nil {
+		logger.Error("NUMA Affinity Check: FAIL - Could not parse NUMA affinity:", err)
+		rep.AddNUMAAffinityResult("FAIL", nil, err)
+		return fmt.Errorf("could not parse NUMA affinity: %w", err)
+	}
+
+	// Step 2: Validate against expected mapping
+	logger.Info("Step 2: Validating GPU NUMA affinity...")
+	logger.Info("Expected GPU NUMA mapping:", testConfig.GPUNUMAMap)
+	affinities, err := validateNUMAAffinity(actual, testConfig.GPUNUMAMap)
+	for _, affinity := range affinities {
+		logger.Infof("GPU%d: expected NUMA %d, actual NUMA %d - %s", affinity.GPU, affinity.ExpectedNUMA, affinity.ActualNUMA, affinity.Status)
+	}
+	if err != nil {
+		logger.Error("NUMA Affinity Check: FAIL -", err)
+		rep.AddNUMAAffinityResult("FAIL", affinities, err)
+		return err
+	}
+
+	logger.Info("NUMA Affinity Check: PASS - All GPUs are on the expected NUMA node")
+	rep.AddNUMAAffinityResult("PASS", affinities, nil)
+	return nil
+}
@@ -0,0 +1,125 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const testTopoOutput = "\x1b[4m\tGPU0\tGPU1\tNIC0\tCPU Affinity\tNUMA Affinity\tGPU NUMA ID\x1b[0m\n" +
+	"GPU0\t X \tNV18\tPXB\t0-55,112-167\t0\t\tN/A\n" +
+	"GPU1\tNV18\t X \tNODE\t56-111,168-223\t1\t\tN/A\n" +
+	"NIC0\tPXB\tNODE\t X \t\t\t\t\n" +
+	"\n" +
+	"Legend:\n" +
+	"\n" +
+	"  X    = Self\n"
+
+// Test parseTopoNUMAAffinity function
+func TestParseTopoNUMAAffinity(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    map[int]int
+		expectError bool
+	}{
+		{
+			name:     "Two GPUs on different NUMA nodes",
+			input:    testTopoOutput,
+			expected: map[int]int{0: 0, 1: 1},
+		},
+		{
+			name:     "NUMA affinity not available",
+			input:    "\tGPU0\tCPU Affinity\tNUMA Affinity\tGPU NUMA ID\nGPU0\t X \t0-55\tN/A\t\tN/A\n",
+			expected: map[int]int{0: -1},
+		},
+		{
+			name:        "Missing NUMA Affinity column",
+			input:       "\tGPU0\tCPU Affinity\nGPU0\t X \t0-55\n",
+			expectError: true,
+		},
+		{
+			name:        "No GPU rows",
+			input:       "\tGPU0\tCPU Affinity\tNUMA Affinity\n",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseTopoNUMAAffinity(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseTopoNUMAAffinity() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("parseTopoNUMAAffinity() returned %d GPUs, want %d", len(result), len(tt.expected))
+			}
+			for gpu, node := range tt.expected {
+				if result[gpu] != node {
+					t.Errorf("GPU%d NUMA = %d, want %d", gpu, result[gpu], node)
+				}
+			}
+		})
+	}
+}
+
+// Test validateNUMAAffinity function
+func TestValidateNUMAAffinity(t *testing.T) {
+	expected := map[int]int{0: 0, 1: 0, 2: 1, 3: 1}
+
+	tests := []struct {
+		name          string
+		actual        map[int]int
+		expectError   bool
+		expectedFails int
+	}{
+		{
+			name:        "All GPUs on expected node",
+			actual:      map[int]int{0: 0, 1: 0, 2: 1, 3: 1},
+			expectError: false,
+		},
+		{
+			name:          "GPU on wrong node",
+			actual:        map[int]int{0: 0, 1: 1, 2: 1, 3: 1},
+			expectError:   true,
+			expectedFails: 1,
+		},
+		{
+			name:          "GPU missing from topology",
+			actual:        map[int]int{0: 0, 1: 0, 2: 1},
+			expectError:   true,
+			expectedFails: 1,
+		},
+		{
+			name:          "NUMA affinity not available",
+			actual:        map[int]int{0: -1, 1: -1, 2: -1, 3: -1},
+			expectError:   true,
+			expectedFails: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := validateNUMAAffinity(tt.actual, expected)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateNUMAAffinity() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if len(results) != len(expected) {
+				t.Fatalf("validateNUMAAffinity() returned %d results, want %d", len(results), len(expected))
+			}
+			fails := 0
+			for i, result := range results {
+				if result.GPU != i {
+					t.Errorf("results not sorted by GPU index: got GPU%d at position %d", result.GPU, i)
+				}
+				if result.Status == "FAIL" {
+					fails++
+				}
+			}
+			if fails != tt.expectedFails {
+				t.Errorf("validateNUMAAffinity() %d failed GPUs, want %d", fails, tt.expectedFails)
+			}
+		})
+	}
+}
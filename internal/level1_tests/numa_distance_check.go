@@ -0,0 +1,193 @@
+package level1_tests
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// numaDistanceTolerancePercent is the largest deviation of a NUMA distance from its expected value
+const numaDistanceTolerancePercent = 10
+
+// NUMADistanceCheckTestConfig represents the config needed to run this test.
+// ExpectedMatrix holds the expected distance from node i to node j at [i][j].
+type NUMADistanceCheckTestConfig struct {
+	IsEnabled      bool    `json:"enabled"`
+	Shape          string  `json:"shape"`
+	ExpectedMatrix [][]int `json:"expected_matrix"`
+}
+
+// getNUMADistanceCheckTestConfig gets test config needed to run this test
+func getNUMADistanceCheckTestConfig() (*NUMADistanceCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	numaDistanceCheckTestConfig := &NUMADistanceCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "numa_distance_check")
+	if err != nil {
+		return nil, err
+	}
+	numaDistanceCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "numa_distance_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if rows, ok := thresholdMap["expected_matrix"].([]interface{}); ok {
+				for _, row := range rows {
+					values, ok := row.([]interface{})
+					if !ok {
+						return nil, fmt.Errorf("invalid expected_matrix row for numa_distance_check on shape %s: %v", shape, row)
+					}
+					var distances []int
+					for _, value := range values {
+						distance, ok := value.(float64)
+						if !ok {
+							return nil, fmt.Errorf("invalid expected_matrix distance for numa_distance_check on shape %s: %v", shape, value)
+						}
+						distances = append(distances, int(distance))
+					}
+					numaDistanceCheckTestConfig.ExpectedMatrix = append(numaDistanceCheckTestConfig.ExpectedMatrix, distances)
+				}
+			}
+		}
+	}
+
+	return numaDistanceCheckTestConfig, nil
+}
+
+// buildNUMADistanceMatrix builds the NUMA distance matrix from the distance files of the nodes,
+// keyed by node name such as "node0". Nodes must be numbered 0 to n-1 and list n distances.
+func buildNUMADistanceMatrix(nodeDistances map[string]string) ([][]int, error) {
+	nodeCount := len(nodeDistances)
+	matrix := make([][]int, nodeCount)
+	for node, line := range nodeDistances {
+		index, err := strconv.Atoi(strings.TrimPrefix(node, "node"))
+		if err != nil || index < 0 || index >= nodeCount {
+			return nil, fmt.Errorf("unexpected NUMA node %s for %d nodes", node, nodeCount)
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != nodeCount {
+			return nil, fmt.Errorf("%s lists %d distances, expected %d", node, len(fields), nodeCount)
+		}
+		distances := make([]int, nodeCount)
+		for i, field := range fields {
+			distance, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid distance %q for %s", field, node)
+			}
+			distances[i] = distance
+		}
+		matrix[index] = distances
+	}
+	for i, distances := range matrix {
+		if distances == nil {
+			return nil, fmt.Errorf("missing distances of node%d", i)
+		}
+	}
+	return matrix, nil
+}
+
+// validateNUMADistances compares the NUMA distance matrix with the expected matrix and returns the
+// node-to-node distances that differ from the expected distance by more than numaDistanceTolerancePercent
+func validateNUMADistances(actual, expected [][]int) ([]string, error) {
+	if len(actual) != len(expected) {
+		return nil, fmt.Errorf("found %d NUMA nodes, expected %d", len(actual), len(expected))
+	}
+
+	var mismatches []string
+	for i := range expected {
+		if len(actual[i]) != len(expected[i]) {
+			return nil, fmt.Errorf("node%d lists %d distances, expected %d", i, len(actual[i]), len(expected[i]))
+		}
+		for j := range expected[i] {
+			deviation := math.Abs(float64(actual[i][j] - expected[i][j]))
+			if deviation > float64(expected[i][j])*numaDistanceTolerancePercent/100 {
+				mismatches = append(mismatches, fmt.Sprintf("node%d->node%d: %d (expected %d)", i, j, actual[i][j], expected[i][j]))
+			}
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return mismatches, fmt.Errorf("NUMA distances differ from the expected distances by more than %d%%: %s",
+			numaDistanceTolerancePercent, strings.Join(mismatches, ", "))
+	}
+	return nil, nil
+}
+
+// RunNUMADistanceCheck validates the NUMA distance matrix from /sys/devices/system/node/node*/distance
+// against the expected matrix of the shape
+func RunNUMADistanceCheck() error {
+	logger.Info("=== NUMA Distance Check ===")
+	testConfig, err := getNUMADistanceCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "numa_distance_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting NUMA distance check...")
+	rep := reporter.GetReporter()
+
+	if len(testConfig.ExpectedMatrix) == 0 {
+		logger.Info("NUMA Distance Check: SKIP - No expected NUMA distance matrix configured for shape", testConfig.Shape)
+		rep.AddNUMADistanceResult("SKIP", nil, nil, nil, nil)
+		return nil
+	}
+
+	// Step 1: Read the distances of every NUMA node
+	logger.Info("Step 1: Reading NUMA node distances...")
+	nodeDistances, err := executor.GetNUMANodeDistances()
+	if err != nil {
+		logger.Error("NUMA Distance Check: FAIL - Could not read NUMA node distances:", err)
+		rep.AddNUMADistanceResult("FAIL", nil, testConfig.ExpectedMatrix, nil, err)
+		return err
+	}
+	matrix, err := buildNUMADistanceMatrix(nodeDistances)
+	if err != nil {
+		logger.Error("NUMA Distance Check: FAIL - Could not build NUMA distance matrix:", err)
+		rep.AddNUMADistanceResult("FAIL", nil, testConfig.ExpectedMatrix, nil, err)
+		return err
+	}
+	for i, distances := range matrix {
+		logger.Infof("node%d distances: %v", i, distances)
+	}
+
+	// Step 2: Compare the matrix with the expected matrix
+	logger.Infof("Step 2: Validating NUMA distances against %v...", testConfig.ExpectedMatrix)
+	mismatches, err := validateNUMADistances(matrix, testConfig.ExpectedMatrix)
+	if err != nil {
+		logger.Error("NUMA Distance Check: FAIL -", err)
+		rep.AddNUMADistanceResult("FAIL", matrix, testConfig.ExpectedMatrix, mismatches, err)
+		return err
+	}
+
+	logger.Infof("NUMA Distance Check: PASS - All distances of %d NUMA nodes match the expected matrix", len(matrix))
+	rep.AddNUMADistanceResult("PASS", matrix, testConfig.ExpectedMatrix, nil, nil)
+	return nil
+}
@@ -0,0 +1,62 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test buildNUMADistanceMatrix function
+func TestBuildNUMADistanceMatrix(t *testing.T) {
+	matrix, err := buildNUMADistanceMatrix(map[string]string{"node1": "21 10", "node0": "10 21"})
+	if err != nil {
+		t.Fatalf("buildNUMADistanceMatrix() error = %v", err)
+	}
+	if expected := [][]int{{10, 21}, {21, 10}}; !reflect.DeepEqual(matrix, expected) {
+		t.Errorf("buildNUMADistanceMatrix() = %v, want %v", matrix, expected)
+	}
+
+	invalid := []map[string]string{
+		{"node0": "10 21", "node2": "21 10"},
+		{"node0": "10", "node1": "21 10"},
+		{"node0": "10 x", "node1": "21 10"},
+	}
+	for _, nodeDistances := range invalid {
+		if _, err := buildNUMADistanceMatrix(nodeDistances); err == nil {
+			t.Errorf("buildNUMADistanceMatrix(%v) expected error", nodeDistances)
+		}
+	}
+}
+
+// Test validateNUMADistances function
+func TestValidateNUMADistances(t *testing.T) {
+	expected := [][]int{{10, 21}, {21, 10}}
+
+	tests := []struct {
+		name               string
+		actual             [][]int
+		expectedMismatches []string
+		expectError        bool
+	}{
+		{name: "Matching matrix", actual: [][]int{{10, 21}, {21, 10}}},
+		{name: "Within 10 percent", actual: [][]int{{10, 23}, {23, 11}}},
+		{
+			name:               "More than 10 percent",
+			actual:             [][]int{{10, 32}, {21, 10}},
+			expectedMismatches: []string{"node0->node1: 32 (expected 21)"},
+			expectError:        true,
+		},
+		{name: "Missing node", actual: [][]int{{10}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mismatches, err := validateNUMADistances(tt.actual, expected)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateNUMADistances() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !reflect.DeepEqual(mismatches, tt.expectedMismatches) {
+				t.Errorf("validateNUMADistances() mismatches = %v, want %v", mismatches, tt.expectedMismatches)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package level1_tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// ncclAlgorithmRegex matches the algorithm line printed by the NVLink bandwidth test, e.g. "# Algorithm : Ring"
+var ncclAlgorithmRegex = regexp.MustCompile(`(?m)^#\s*Algorithm\s*:\s*(\S+)`)
+
+// NVLinkBWCheckTestConfig represents the config needed to run this test.
+// An empty Algorithm lets NCCL pick the all-reduce algorithm.
+type NVLinkBWCheckTestConfig struct {
+	IsEnabled               bool    `json:"enabled"`
+	Shape                   string  `json:"shape"`
+	MinBusBandwidthGBps     float64 `json:"min_bus_bandwidth_gbps"`
+	MessageSize             string  `json:"message_size"`
+	Iterations              int     `json:"iterations"`
+	Algorithm               string  `json:"algorithm"`
+	BenchmarkTimeoutSeconds int     `json:"benchmark_timeout_seconds"`
+}
+
+// getNVLinkBWCheckTestConfig gets test config needed to run this test
+func getNVLinkBWCheckTestConfig() (*NVLinkBWCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	nvlinkBWCheckTestConfig := &NVLinkBWCheckTestConfig{
+		IsEnabled:               false,
+		Shape:                   shape,
+		MessageSize:             "8G",
+		Iterations:              20,
+		BenchmarkTimeoutSeconds: 30,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "nvlink_bw_check")
+	if err != nil {
+		return nil, err
+	}
+	nvlinkBWCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "nvlink_bw_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if minBandwidth, ok := thresholdMap["min_bus_bandwidth_gbps"].(float64); ok {
+				nvlinkBWCheckTestConfig.MinBusBandwidthGBps = minBandwidth
+			}
+			if messageSize, ok := thresholdMap["message_size"].(string); ok && messageSize != "" {
+				nvlinkBWCheckTestConfig.MessageSize = messageSize
+			}
+			if iterations, ok := thresholdMap["iterations"].(float64); ok && iterations > 0 {
+				nvlinkBWCheckTestConfig.Iterations = int(iterations)
+			}
+			if algorithm, ok := thresholdMap["algorithm"].(string); ok {
+				nvlinkBWCheckTestConfig.Algorithm = algorithm
+			}
+			if timeout, ok := thresholdMap["benchmark_timeout_seconds"].(float64); ok && timeout > 0 {
+				nvlinkBWCheckTestConfig.BenchmarkTimeoutSeconds = int(timeout)
+			}
+		}
+	}
+
+	return nvlinkBWCheckTestConfig, nil
+}
+
+// parseNVLinkBusBandwidth returns the peak bus bandwidth in GB/s of nccl-tests style all-reduce
+// output, taking the highest out-of-place or in-place busbw of any result row, and the algorithm
+// the test reports. The algorithm is empty when the output does not report it.
+func parseNVLinkBusBandwidth(output string) (float64, string, error) {
+	peak := 0.0
+	found := false
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		// size count type redop root time algbw busbw #wrong time algbw busbw #wrong
+		if len(fields) < 13 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if _, err := strconv.ParseInt(fields[0], 10, 64); err != nil {
+			continue
+		}
+		for _, column := range []int{7, 11} {
+			busBandwidth, err := strconv.ParseFloat(fields[column], 64)
+			if err != nil {
+				continue
+			}
+			found = true
+			if busBandwidth > peak {
+				peak = busBandwidth
+			}
+		}
+	}
+	if !found {
+		return 0, "", fmt.Errorf("no all-reduce bus bandwidth found in NVLink bandwidth test output")
+	}
+
+	algorithm := ""
+	if match := ncclAlgorithmRegex.FindStringSubmatch(output); match != nil {
+		algorithm = match[1]
+	}
+	return peak, algorithm, nil
+}
+
+// validateNVLinkBusBandwidth FAILs when the peak bus bandwidth is below minGBps
+func validateNVLinkBusBandwidth(busBandwidthGBps, minGBps float64) (string, error) {
+	if busBandwidthGBps < minGBps {
+		return "FAIL", fmt.Errorf("NVLink all-reduce bus bandwidth %.2f GB/s below the expected %.2f GB/s", busBandwidthGBps, minGBps)
+	}
+	return "PASS", nil
+}
+
+// RunNVLinkBWCheck measures the NVLink all-reduce bus bandwidth across all GPUs
+func RunNVLinkBWCheck() error {
+	logger.Info("=== NVLink Bandwidth Check ===")
+	testConfig, err := getNVLinkBWCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "nvlink_bw_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting NVLink bandwidth check...")
+	rep := reporter.GetReporter()
+	algorithm := testConfig.Algorithm
+	if algorithm == "" {
+		algorithm = "auto"
+	}
+
+	// Step 1: Run the all-reduce bandwidth test
+	timeout := time.Duration(testConfig.BenchmarkTimeoutSeconds) * time.Second
+	logger.Infof("Step 1: Running all-reduce bandwidth test (%s messages, %d iterations, %s timeout)...", testConfig.MessageSize, testConfig.Iterations, timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result, err := executor.RunNVLinkBandwidthTest(ctx, testConfig.MessageSize, testConfig.Iterations, testConfig.Algorithm)
+	if err != nil {
+		err = commandError("nvlink_bw_check", result, err)
+		var toolErr *testerrors.TestToolNotFoundError
+		if errors.As(err, &toolErr) {
+			logger.Info("NVLink Bandwidth Check: SKIP - NVLink bandwidth test binary not installed:", err)
+			rep.AddNVLinkBWResult("SKIP", 0, testConfig.MinBusBandwidthGBps, algorithm, err)
+			return nil
+		}
+		logger.Error("NVLink Bandwidth Check: FAIL - NVLink bandwidth test failed:", err)
+		rep.AddNVLinkBWResult("FAIL", 0, testConfig.MinBusBandwidthGBps, algorithm, err)
+		return err
+	}
+
+	// Step 2: Parse the peak bus bandwidth
+	logger.Info("Step 2: Parsing all-reduce bus bandwidth...")
+	busBandwidth, reportedAlgorithm, err := parseNVLinkBusBandwidth(result.Output)
+	if err != nil {
+		logger.Error("NVLink Bandwidth Check: FAIL -", err)
+		rep.AddNVLinkBWResult("FAIL", 0, testConfig.MinBusBandwidthGBps, algorithm, err)
+		return err
+	}
+	if reportedAlgorithm != "" {
+		algorithm = reportedAlgorithm
+	}
+	logger.Infof("Peak all-reduce bus bandwidth: %.2f GB/s (algorithm %s)", busBandwidth, algorithm)
+
+	// Step 3: Validate against the expected bandwidth
+	logger.Infof("Step 3: Validating bus bandwidth against %.2f GB/s...", testConfig.MinBusBandwidthGBps)
+	status, validationErr := validateNVLinkBusBandwidth(busBandwidth, testConfig.MinBusBandwidthGBps)
+	rep.AddNVLinkBWResult(status, busBandwidth, testConfig.MinBusBandwidthGBps, algorithm, validationErr)
+
+	if status == "PASS" {
+		logger.Infof("NVLink Bandwidth Check: PASS - All-reduce bus bandwidth %.2f GB/s", busBandwidth)
+		return nil
+	}
+	logger.Error("NVLink Bandwidth Check: FAIL -", validationErr)
+	return validationErr
+}
@@ -0,0 +1,48 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const testNVLinkBWOutput = `# nThread 1 nGpus 8 minBytes 8589934592 maxBytes 8589934592 step: 1048576(bytes) warmup iters: 5 iters: 20
+# Algorithm : Ring
+#
+#                                                              out-of-place                       in-place
+#       size         count      type   redop    root     time   algbw   busbw #wrong     time   algbw   busbw #wrong
+#        (B)    (elements)                               (us)  (GB/s)  (GB/s)            (us)  (GB/s)  (GB/s)
+  8589934592    2147483648     float     sum      -1    37467  229.26  401.21      0    37412  229.60  401.80      0
+# Out of bounds values : 0 OK
+# Avg bus bandwidth    : 401.505
+`
+
+// Test parseNVLinkBusBandwidth function with nccl-tests style all-reduce output
+func TestParseNVLinkBusBandwidth(t *testing.T) {
+	busBandwidth, algorithm, err := parseNVLinkBusBandwidth(testNVLinkBWOutput)
+	if err != nil {
+		t.Fatalf("parseNVLinkBusBandwidth() error = %v", err)
+	}
+	if busBandwidth != 401.80 {
+		t.Errorf("parseNVLinkBusBandwidth() bus bandwidth = %v, want 401.8", busBandwidth)
+	}
+	if algorithm != "Ring" {
+		t.Errorf("parseNVLinkBusBandwidth() algorithm = %q, want Ring", algorithm)
+	}
+
+	if _, algorithm, err := parseNVLinkBusBandwidth("  1048576  262144  float  sum  -1  95.1  11.03  19.30  0  94.8  11.06  19.36  0\n"); err != nil || algorithm != "" {
+		t.Errorf("parseNVLinkBusBandwidth() without algorithm = %q, %v", algorithm, err)
+	}
+
+	if _, _, err := parseNVLinkBusBandwidth("NCCL WARN Cuda failure 'out of memory'\n"); err == nil {
+		t.Error("parseNVLinkBusBandwidth() expected error without result rows")
+	}
+}
+
+// Test validateNVLinkBusBandwidth function
+func TestValidateNVLinkBusBandwidth(t *testing.T) {
+	if status, err := validateNVLinkBusBandwidth(910, 900); status != "PASS" || err != nil {
+		t.Errorf("validateNVLinkBusBandwidth(910, 900) = %v, %v, want PASS", status, err)
+	}
+	if status, err := validateNVLinkBusBandwidth(401.8, 900); status != "FAIL" || err == nil {
+		t.Errorf("validateNVLinkBusBandwidth(401.8, 900) = %v, %v, want FAIL", status, err)
+	}
+}
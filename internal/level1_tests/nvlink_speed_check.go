@@ -1,12 +1,12 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -197,7 +197,7 @@ func RunNVLinkSpeedCheck() error {
 	if !nvlinkConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Error(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "nvlink_speed_check", Shape: shape}
 	}
 
 	logger.Infof("Step 2: Expected NVLink parameters - Speed: %.1f GB/s, Count: %d per GPU",
@@ -208,7 +208,7 @@ func RunNVLinkSpeedCheck() error {
 	nvlinkResult := executor.RunNvidiaSMINvlink()
 	if !nvlinkResult.Available {
 		logger.Error("NVLink Speed Check: FAIL - nvidia-smi nvlink command failed:", nvlinkResult.Error)
-		err = fmt.Errorf("nvidia-smi nvlink command failed: %s", nvlinkResult.Error)
+		err = nvidiaSMIError("nvlink_speed_check", "nvidia-smi nvlink -s", nvlinkResult)
 		rep.AddNVLinkResult("FAIL", nil, err)
 		return err
 	}
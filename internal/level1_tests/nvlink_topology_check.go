@@ -0,0 +1,252 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// nvlinkCellRegex matches an NVLink connection in nvidia-smi topo -m, e.g. "NV18" for 18 bonded NVLinks
+var nvlinkCellRegex = regexp.MustCompile(`^NV(\d+)$`)
+
+// NVLinkTopologyCheckTestConfig represents the configuration for NVLink topology check.
+// Every pair of the first GPUCount GPUs is expected to be connected by at least MinLinks NVLinks.
+type NVLinkTopologyCheckTestConfig struct {
+	IsEnabled bool `json:"enabled"`
+	GPUCount  int  `json:"gpu_count"`
+	MinLinks  int  `json:"min_links"`
+}
+
+// NVLinkTopology represents the GPU-to-GPU part of the nvidia-smi topo -m matrix
+type NVLinkTopology struct {
+	GPUs   []int      `json:"gpus"`
+	Matrix [][]string `json:"matrix"`
+}
+
+// Gets test config needed to run this test
+func getNVLinkTopologyCheckTestConfig(shape string) (*NVLinkTopologyCheckTestConfig, error) {
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Result
+	nvlinkTopologyCheckTestConfig := &NVLinkTopologyCheckTestConfig{
+		IsEnabled: false,
+		GPUCount:  0,
+		MinLinks:  0,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "nvlink_topology_check")
+	if err != nil {
+		return nil, err
+	}
+	nvlinkTopologyCheckTestConfig.IsEnabled = enabled
+
+	threshold, err := limits.GetThresholdForTest(shape, "nvlink_topology_check")
+	if err != nil {
+		return nil, err
+	}
+
+	if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+		if count, exists := thresholdMap["gpu_count"]; exists {
+			if countFloat, ok := count.(float64); ok {
+				nvlinkTopologyCheckTestConfig.GPUCount = int(countFloat)
+			}
+		}
+		if minLinks, exists := thresholdMap["min_links"]; exists {
+			if minLinksFloat, ok := minLinks.(float64); ok {
+				nvlinkTopologyCheckTestConfig.MinLinks = int(minLinksFloat)
+			}
+		}
+	}
+
+	return nvlinkTopologyCheckTestConfig, nil
+}
+
+// parseNVLinkTopology parses the GPU-to-GPU connection matrix from nvidia-smi topo -m output.
+// Rows and columns of the matrix are ordered by GPU index.
+func parseNVLinkTopology(output string) (*NVLinkTopology, error) {
+	lines := strings.Split(ansiEscapeRegex.ReplaceAllString(output, ""), "\n")
+
+	// Map the header columns of GPUs to their GPU index
+	gpuColumns := map[int]int{}
+	rows := map[int][]string{}
+	for _, line := range lines {
+		fields := strings.Split(line, "\t")
+
+		if len(gpuColumns) == 0 {
+			for i, field := range fields {
+				label := strings.TrimSpace(field)
+				if !strings.HasPrefix(label, "GPU") {
+					continue
+				}
+				if gpuIndex, err := strconv.Atoi(strings.TrimPrefix(label, "GPU")); err == nil {
+					gpuColumns[gpuIndex] = i
+				}
+			}
+			continue
+		}
+
+		label := strings.TrimSpace(fields[0])
+		if !strings.HasPrefix(label, "GPU") {
+			continue
+		}
+		gpuIndex, err := strconv.Atoi(strings.TrimPrefix(label, "GPU"))
+		if err != nil {
+			continue
+		}
+		rows[gpuIndex] = fields
+	}
+
+	if len(gpuColumns) == 0 {
+		return nil, fmt.Errorf("GPU columns not found in nvidia-smi topo output")
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no GPUs found in nvidia-smi topo output")
+	}
+
+	topology := &NVLinkTopology{}
+	for gpu := range rows {
+		topology.GPUs = append(topology.GPUs, gpu)
+	}
+	sort.Ints(topology.GPUs)
+
+	for _, gpu := range topology.GPUs {
+		fields := rows[gpu]
+		row := make([]string, len(topology.GPUs))
+		for j, peer := range topology.GPUs {
+			column, found := gpuColumns[peer]
+			if !found || column >= len(fields) {
+				return nil, fmt.Errorf("missing connection from GPU%d to GPU%d", gpu, peer)
+			}
+			row[j] = strings.TrimSpace(fields[column])
+		}
+		topology.Matrix = append(topology.Matrix, row)
+	}
+
+	return topology, nil
+}
+
+// nvlinkCount returns the number of NVLinks in a connection matrix cell, 0 for non-NVLink connections
+func nvlinkCount(cell string) int {
+	match := nvlinkCellRegex.FindStringSubmatch(cell)
+	if match == nil {
+		return 0
+	}
+	count, _ := strconv.Atoi(match[1])
+	return count
+}
+
+// findMissingNVLinkConnections returns the GPU pairs among the first gpuCount GPUs that are not
+// connected by at least minLinks NVLinks, e.g. "GPU0-GPU3"
+func findMissingNVLinkConnections(topology *NVLinkTopology, gpuCount int, minLinks int) []string {
+	if minLinks < 1 {
+		minLinks = 1
+	}
+
+	positions := map[int]int{}
+	for i, gpu := range topology.GPUs {
+		positions[gpu] = i
+	}
+
+	var missing []string
+	for gpu := 0; gpu < gpuCount; gpu++ {
+		for peer := gpu + 1; peer < gpuCount; peer++ {
+			connection := fmt.Sprintf("GPU%d-GPU%d", gpu, peer)
+			i, gpuFound := positions[gpu]
+			j, peerFound := positions[peer]
+			if !gpuFound || !peerFound {
+				logger.Errorf("%s: GPU not found in topology", connection)
+				missing = append(missing, connection)
+				continue
+			}
+
+			if links := nvlinkCount(topology.Matrix[i][j]); links < minLinks {
+				logger.Errorf("%s: connected by %s, expected at least NV%d", connection, topology.Matrix[i][j], minLinks)
+				missing = append(missing, connection)
+			}
+		}
+	}
+
+	return missing
+}
+
+// RunNVLinkTopologyCheck verifies that all GPUs are fully connected over NVLink
+func RunNVLinkTopologyCheck() error {
+	logger.Info("=== NVLink Topology Check ===")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get shape from IMDS
+	logger.Info("Step 1: Getting shape from IMDS...")
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		logger.Error("NVLink Topology Check: FAIL - Could not get shape from IMDS:", err)
+		rep.AddNVLinkTopologyResult("FAIL", nil, nil, err)
+		return fmt.Errorf("failed to get shape from IMDS: %w", err)
+	}
+	logger.Info("Current shape from IMDS:", shape)
+
+	// Step 2: Check if the test is enabled for this shape
+	topologyConfig, err := getNVLinkTopologyCheckTestConfig(shape)
+	if err != nil {
+		logger.Error("NVLink Topology Check: FAIL - Could not get test configuration:", err)
+		rep.AddNVLinkTopologyResult("FAIL", nil, nil, err)
+		return fmt.Errorf("failed to get test configuration: %w", err)
+	}
+
+	if !topologyConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
+		logger.Error(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "nvlink_topology_check", Shape: shape}
+	}
+
+	logger.Infof("Step 2: Expected NVLink topology - %d GPUs fully connected with at least NV%d",
+		topologyConfig.GPUCount, topologyConfig.MinLinks)
+
+	// Step 3: Run nvidia-smi topo -m command
+	logger.Info("Step 3: Running nvidia-smi topo -m...")
+	topoResult := executor.RunNvidiaSMITopo()
+	if !topoResult.Available {
+		logger.Error("NVLink Topology Check: FAIL - nvidia-smi topo command failed:", topoResult.Error)
+		err = nvidiaSMIError("nvlink_topology_check", "nvidia-smi topo -m", topoResult)
+		rep.AddNVLinkTopologyResult("FAIL", nil, nil, err)
+		return err
+	}
+
+	logger.Debug("NVIDIA topology raw output:", topoResult.Output)
+
+	// Step 4: Parse the connection matrix
+	logger.Info("Step 4: Parsing NVLink topology...")
+	topology, err := parseNVLinkTopology(topoResult.Output)
+	if err != nil {
+		logger.Error("NVLink Topology Check: FAIL - Failed to parse nvidia-smi topo output:", err)
+		rep.AddNVLinkTopologyResult("FAIL", nil, nil, err)
+		return fmt.Errorf("failed to parse nvidia-smi topo output: %w", err)
+	}
+
+	logger.Infof("Found %d GPUs in NVLink topology", len(topology.GPUs))
+
+	// Step 5: Compare against the expected fully connected topology
+	logger.Info("Step 5: Validating NVLink connections...")
+	missing := findMissingNVLinkConnections(topology, topologyConfig.GPUCount, topologyConfig.MinLinks)
+	if len(missing) > 0 {
+		logger.Errorf("NVLink Topology Check: FAIL - Missing NVLink connections: %s", strings.Join(missing, ", "))
+		err = fmt.Errorf("missing NVLink connections: %s", strings.Join(missing, ", "))
+		rep.AddNVLinkTopologyResult("FAIL", topology.Matrix, missing, err)
+		return err
+	}
+
+	logger.Info("NVLink Topology Check: PASS - All GPUs are fully connected over NVLink")
+	rep.AddNVLinkTopologyResult("PASS", topology.Matrix, nil, nil)
+	return nil
+}
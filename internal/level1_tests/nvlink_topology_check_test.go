@@ -0,0 +1,173 @@
+package level1_tests
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fullyConnectedTopo returns nvidia-smi topo -m output for gpuCount GPUs connected with NV18,
+// with the cell between GPU0 and GPU1 replaced by degraded
+func fullyConnectedTopo(gpuCount int, degraded string) string {
+	var output strings.Builder
+	output.WriteString("\x1b[4m")
+	for gpu := 0; gpu < gpuCount; gpu++ {
+		output.WriteString(fmt.Sprintf("\tGPU%d", gpu))
+	}
+	output.WriteString("\tNIC0\tCPU Affinity\tNUMA Affinity\tGPU NUMA ID\x1b[0m\n")
+
+	for gpu := 0; gpu < gpuCount; gpu++ {
+		output.WriteString(fmt.Sprintf("GPU%d", gpu))
+		for peer := 0; peer < gpuCount; peer++ {
+			cell := "NV18"
+			switch {
+			case gpu == peer:
+				cell = " X "
+			case degraded != "" && gpu+peer == 1:
+				cell = degraded
+			}
+			output.WriteString("\t" + cell)
+		}
+		output.WriteString("\tPXB\t0-55,112-167\t0\t\tN/A\n")
+	}
+	output.WriteString("NIC0")
+	for gpu := 0; gpu < gpuCount; gpu++ {
+		output.WriteString("\tPXB")
+	}
+	output.WriteString("\t X \t\t\t\t\n\nLegend:\n\n  X    = Self\n  NV#  = Connection traversing a bonded set of # NVLinks\n")
+	return output.String()
+}
+
+// Test parseNVLinkTopology function
+func TestParseNVLinkTopology(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedGPUs   []int
+		expectedMatrix [][]string
+		expectError    bool
+	}{
+		{
+			name:           "Two GPUs connected over NVLink",
+			input:          testTopoOutput,
+			expectedGPUs:   []int{0, 1},
+			expectedMatrix: [][]string{{"X", "NV18"}, {"NV18", "X"}},
+		},
+		{
+			name:           "GPUs without NVLink",
+			input:          fullyConnectedTopo(3, "SYS"),
+			expectedGPUs:   []int{0, 1, 2},
+			expectedMatrix: [][]string{{"X", "SYS", "NV18"}, {"SYS", "X", "NV18"}, {"NV18", "NV18", "X"}},
+		},
+		{
+			name:        "No GPU columns",
+			input:       "\tNIC0\tCPU Affinity\nNIC0\t X \t\n",
+			expectError: true,
+		},
+		{
+			name:        "No GPU rows",
+			input:       "\tGPU0\tGPU1\tCPU Affinity\n",
+			expectError: true,
+		},
+		{
+			name:        "Truncated GPU row",
+			input:       "\tGPU0\tGPU1\tCPU Affinity\nGPU0\t X \nGPU1\tNV18\t X \t0-55\n",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topology, err := parseNVLinkTopology(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseNVLinkTopology() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if !reflect.DeepEqual(topology.GPUs, tt.expectedGPUs) {
+				t.Errorf("parseNVLinkTopology() GPUs = %v, want %v", topology.GPUs, tt.expectedGPUs)
+			}
+			if !reflect.DeepEqual(topology.Matrix, tt.expectedMatrix) {
+				t.Errorf("parseNVLinkTopology() matrix = %v, want %v", topology.Matrix, tt.expectedMatrix)
+			}
+		})
+	}
+}
+
+// Test findMissingNVLinkConnections function
+func TestFindMissingNVLinkConnections(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           string
+		gpuCount        int
+		minLinks        int
+		expectedMissing []string
+	}{
+		{
+			name:     "Fully connected H100",
+			input:    fullyConnectedTopo(8, ""),
+			gpuCount: 8,
+			minLinks: 18,
+		},
+		{
+			name:            "Pair connected over PCIe",
+			input:           fullyConnectedTopo(8, "SYS"),
+			gpuCount:        8,
+			minLinks:        18,
+			expectedMissing: []string{"GPU0-GPU1"},
+		},
+		{
+			name:            "Pair with degraded NVLinks",
+			input:           fullyConnectedTopo(8, "NV12"),
+			gpuCount:        8,
+			minLinks:        18,
+			expectedMissing: []string{"GPU0-GPU1"},
+		},
+		{
+			name:     "Any NVLink accepted without minimum",
+			input:    fullyConnectedTopo(4, "NV4"),
+			gpuCount: 4,
+			minLinks: 0,
+		},
+		{
+			name:            "GPU missing from topology",
+			input:           fullyConnectedTopo(3, ""),
+			gpuCount:        4,
+			minLinks:        18,
+			expectedMissing: []string{"GPU0-GPU3", "GPU1-GPU3", "GPU2-GPU3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topology, err := parseNVLinkTopology(tt.input)
+			if err != nil {
+				t.Fatalf("parseNVLinkTopology() error = %v", err)
+			}
+			missing := findMissingNVLinkConnections(topology, tt.gpuCount, tt.minLinks)
+			if !reflect.DeepEqual(missing, tt.expectedMissing) {
+				t.Errorf("findMissingNVLinkConnections() = %v, want %v", missing, tt.expectedMissing)
+			}
+		})
+	}
+}
+
+// Test nvlinkCount function
+func TestNVLinkCount(t *testing.T) {
+	tests := map[string]int{
+		"NV18": 18,
+		"NV4":  4,
+		"X":    0,
+		"SYS":  0,
+		"PXB":  0,
+		"NODE": 0,
+	}
+
+	for cell, expected := range tests {
+		if got := nvlinkCount(cell); got != expected {
+			t.Errorf("nvlinkCount(%q) = %d, want %d", cell, got, expected)
+		}
+	}
+}
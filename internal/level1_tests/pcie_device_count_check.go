@@ -0,0 +1,163 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// PCIeDeviceCountCheckTestConfig represents the config needed to run this test
+type PCIeDeviceCountCheckTestConfig struct {
+	IsEnabled   bool     `json:"enabled"`
+	Shape       string   `json:"shape"`
+	GPUBDFs     []string `json:"gpu_bdfs"`
+	RDMANICBDFs []string `json:"rdma_nic_bdfs"`
+}
+
+// bdfList converts a list of BDFs from test_limits.json to lowercase strings
+func bdfList(value interface{}) []string {
+	var bdfs []string
+	if list, ok := value.([]interface{}); ok {
+		for _, item := range list {
+			if bdf, ok := item.(string); ok && bdf != "" {
+				bdfs = append(bdfs, strings.ToLower(bdf))
+			}
+		}
+	}
+	return bdfs
+}
+
+// getPCIeDeviceCountCheckTestConfig gets test config needed to run this test
+func getPCIeDeviceCountCheckTestConfig() (*PCIeDeviceCountCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	pcieDeviceCountCheckTestConfig := &PCIeDeviceCountCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "pcie_device_count_check")
+	if err != nil {
+		return nil, err
+	}
+	pcieDeviceCountCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "pcie_device_count_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			pcieDeviceCountCheckTestConfig.GPUBDFs = bdfList(thresholdMap["gpu_bdfs"])
+			pcieDeviceCountCheckTestConfig.RDMANICBDFs = bdfList(thresholdMap["rdma_nic_bdfs"])
+		}
+	}
+
+	return pcieDeviceCountCheckTestConfig, nil
+}
+
+// parseLspciBDFs parses `lspci -D` output into the set of enumerated BDFs
+func parseLspciBDFs(output string) map[string]bool {
+	bdfs := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		bdfs[strings.ToLower(fields[0])] = true
+	}
+	return bdfs
+}
+
+// findMissingBDFs returns the expected BDFs not present in found
+func findMissingBDFs(expected []string, found map[string]bool) []string {
+	missing := []string{}
+	for _, bdf := range expected {
+		if !found[bdf] {
+			missing = append(missing, bdf)
+		}
+	}
+	return missing
+}
+
+// validatePCIeDevices returns the overall status and the missing GPU and RDMA NIC BDFs.
+// Any expected BDF absent from lspci FAILs.
+func validatePCIeDevices(found map[string]bool, testConfig *PCIeDeviceCountCheckTestConfig) (string, []string, []string, error) {
+	missingGPUs := findMissingBDFs(testConfig.GPUBDFs, found)
+	missingNICs := findMissingBDFs(testConfig.RDMANICBDFs, found)
+
+	if len(missingGPUs) == 0 && len(missingNICs) == 0 {
+		return "PASS", missingGPUs, missingNICs, nil
+	}
+
+	var missing []string
+	if len(missingGPUs) > 0 {
+		missing = append(missing, "GPU "+strings.Join(missingGPUs, ","))
+	}
+	if len(missingNICs) > 0 {
+		missing = append(missing, "RDMA NIC "+strings.Join(missingNICs, ","))
+	}
+	return "FAIL", missingGPUs, missingNICs, fmt.Errorf("expected PCIe devices missing from lspci: %s", strings.Join(missing, "; "))
+}
+
+// RunPCIeDeviceCountCheck checks every expected GPU and RDMA NIC BDF is enumerated by lspci
+func RunPCIeDeviceCountCheck() error {
+	logger.Info("=== PCIe Device Count Check ===")
+	testConfig, err := getPCIeDeviceCountCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "pcie_device_count_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting PCIe device count check...")
+	rep := reporter.GetReporter()
+	expectedGPUs := len(testConfig.GPUBDFs)
+	expectedNICs := len(testConfig.RDMANICBDFs)
+
+	// Step 1: Enumerate PCIe devices
+	logger.Info("Step 1: Enumerating PCIe devices...")
+	result, err := executor.RunLspci("-D")
+	if err != nil {
+		logger.Error("Failed to run lspci command:", err)
+		logger.Info("PCIe Device Count Check: FAIL - Could not run lspci command")
+		err = commandError("pcie_device_count_check", result, err)
+		rep.AddPCIeDeviceCountResult("FAIL", expectedGPUs, 0, expectedNICs, 0, nil, nil, err)
+		return err
+	}
+	found := parseLspciBDFs(result.Output)
+	logger.Infof("Found %d PCIe devices", len(found))
+
+	// Step 2: Check expected BDFs
+	logger.Info("Step 2: Checking expected GPU and RDMA NIC BDFs...")
+	status, missingGPUs, missingNICs, validationErr := validatePCIeDevices(found, testConfig)
+	foundGPUs := expectedGPUs - len(missingGPUs)
+	foundNICs := expectedNICs - len(missingNICs)
+	logger.Infof("GPUs: %d/%d, RDMA NICs: %d/%d", foundGPUs, expectedGPUs, foundNICs, expectedNICs)
+	rep.AddPCIeDeviceCountResult(status, expectedGPUs, foundGPUs, expectedNICs, foundNICs, missingGPUs, missingNICs, validationErr)
+
+	if status == "PASS" {
+		logger.Info("PCIe Device Count Check: PASS - All expected GPUs and RDMA NICs are enumerated")
+		return nil
+	}
+	logger.Error("PCIe Device Count Check: FAIL -", validationErr)
+	return validationErr
+}
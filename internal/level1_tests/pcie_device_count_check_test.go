@@ -0,0 +1,42 @@
+package level1_tests
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test parseLspciBDFs function
+func TestParseLspciBDFs(t *testing.T) {
+	output := `0000:0f:00.0 3D controller: NVIDIA Corporation GH100 [H100 SXM5 80GB] (rev a1)
+0000:0C:00.1 Ethernet controller: Mellanox Technologies MT2910 Family [ConnectX-7]
+
+`
+	bdfs := parseLspciBDFs(output)
+	if len(bdfs) != 2 || !bdfs["0000:0f:00.0"] || !bdfs["0000:0c:00.1"] {
+		t.Errorf("parseLspciBDFs() = %v, want 0000:0f:00.0 and lowercased 0000:0c:00.1", bdfs)
+	}
+}
+
+// Test validatePCIeDevices function
+func TestValidatePCIeDevices(t *testing.T) {
+	testConfig := &PCIeDeviceCountCheckTestConfig{
+		GPUBDFs:     bdfList([]interface{}{"0000:0f:00.0", "0000:D8:00.0"}),
+		RDMANICBDFs: bdfList([]interface{}{"0000:0c:00.0", "0000:0c:00.1"}),
+	}
+	found := parseLspciBDFs("0000:0f:00.0 3D controller\n0000:d8:00.0 3D controller\n0000:0c:00.0 Ethernet controller\n0000:0c:00.1 Ethernet controller\n")
+
+	status, missingGPUs, missingNICs, err := validatePCIeDevices(found, testConfig)
+	if status != "PASS" || err != nil || len(missingGPUs) != 0 || len(missingNICs) != 0 {
+		t.Errorf("validatePCIeDevices() = %s, %v, %v, %v, want PASS", status, missingGPUs, missingNICs, err)
+	}
+
+	delete(found, "0000:d8:00.0")
+	delete(found, "0000:0c:00.1")
+	status, missingGPUs, missingNICs, err = validatePCIeDevices(found, testConfig)
+	if status != "FAIL" || err == nil || !strings.Contains(err.Error(), "GPU 0000:d8:00.0; RDMA NIC 0000:0c:00.1") {
+		t.Errorf("validatePCIeDevices() = %s, %v, want FAIL for the missing GPU and NIC", status, err)
+	}
+	if len(missingGPUs) != 1 || len(missingNICs) != 1 {
+		t.Errorf("Expected 1 missing GPU and 1 missing NIC, got %v and %v", missingGPUs, missingNICs)
+	}
+}
@@ -1,11 +1,11 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -55,7 +55,7 @@ func RunPCIeErrorCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "pcie_error_check", Shape: testConfig.Shape}
 	}
 
 	logger.Info("Starting PCIe health check...")
@@ -69,8 +69,9 @@ func RunPCIeErrorCheck() error {
 	if err != nil {
 		logger.Error("Failed to run dmesg command:", err)
 		logger.Info("PCIe Error Check: FAIL - Could not run dmesg command")
-		rep.AddPCIeResult("FAIL", fmt.Errorf("could not run dmesg command: %v", err))
-		return fmt.Errorf("could not run dmesg command: %v", err)
+		err = commandError("pcie_error_check", result, err)
+		rep.AddPCIeResult("FAIL", err)
+		return err
 	}
 
 	// Check if dmesg output is empty
@@ -0,0 +1,250 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+var (
+	// pciDeviceHeaderRegex matches an lspci device header, e.g. "0f:00.0 3D controller: NVIDIA Corporation ..."
+	pciDeviceHeaderRegex = regexp.MustCompile(`^((?:[0-9a-fA-F]{4}:)?[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-9a-fA-F])\s+(.*)$`)
+	// pcieLinkSpeedRegex matches the link speed of a LnkCap or LnkSta line, e.g. "Speed 32GT/s"
+	pcieLinkSpeedRegex = regexp.MustCompile(`Speed\s+([0-9.]+)GT/s`)
+	// pcieLinkWidthRegex matches the link width of a LnkCap or LnkSta line, e.g. "Width x16"
+	pcieLinkWidthRegex = regexp.MustCompile(`Width\s+x(\d+)`)
+)
+
+// pcieSpeedGenerations maps PCIe link speeds in GT/s to the PCIe generation
+var pcieSpeedGenerations = map[string]int{
+	"2.5": 1,
+	"5":   2,
+	"8":   3,
+	"16":  4,
+	"32":  5,
+	"64":  6,
+}
+
+// PCIeGenCheckTestConfig represents the config needed to run this test.
+// GPU links are expected to run at least at GPUExpectedGen and GPUExpectedWidth, 0 disables the check.
+type PCIeGenCheckTestConfig struct {
+	IsEnabled        bool   `json:"enabled"`
+	Shape            string `json:"shape"`
+	GPUExpectedGen   int    `json:"gpu_expected_gen"`
+	GPUExpectedWidth int    `json:"gpu_expected_width"`
+}
+
+// PCIeGenDevice represents the PCIe link capability and current state of a device
+type PCIeGenDevice struct {
+	BDF         string `json:"bdf"`
+	Type        string `json:"type"`
+	CapGen      int    `json:"cap_gen"`
+	CapWidth    int    `json:"cap_width"`
+	ActualGen   int    `json:"actual_gen"`
+	ActualWidth int    `json:"actual_width"`
+	Status      string `json:"status"`
+}
+
+// getPCIeGenCheckTestConfig gets test config needed to run this test
+func getPCIeGenCheckTestConfig() (*PCIeGenCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	pcieGenCheckTestConfig := &PCIeGenCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "pcie_gen_check")
+	if err != nil {
+		return nil, err
+	}
+	pcieGenCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "pcie_gen_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if gen, ok := thresholdMap["gpu_expected_gen"].(float64); ok {
+				pcieGenCheckTestConfig.GPUExpectedGen = int(gen)
+			}
+			if width, ok := thresholdMap["gpu_expected_width"].(float64); ok {
+				pcieGenCheckTestConfig.GPUExpectedWidth = int(width)
+			}
+		}
+	}
+
+	return pcieGenCheckTestConfig, nil
+}
+
+// pcieDeviceType returns "gpu", "nvswitch" or "rdma" for NVIDIA and Mellanox devices, "" otherwise
+func pcieDeviceType(description string) string {
+	switch {
+	case strings.Contains(description, "NVIDIA") && strings.Contains(description, "Bridge"):
+		return "nvswitch"
+	case strings.Contains(description, "NVIDIA"):
+		return "gpu"
+	case strings.Contains(description, "Mellanox"):
+		return "rdma"
+	}
+	return ""
+}
+
+// parsePCIeLink returns the PCIe generation and width of a LnkCap or LnkSta line
+func parsePCIeLink(line string) (int, int) {
+	gen, width := 0, 0
+	if match := pcieLinkSpeedRegex.FindStringSubmatch(line); match != nil {
+		gen = pcieSpeedGenerations[match[1]]
+	}
+	if match := pcieLinkWidthRegex.FindStringSubmatch(line); match != nil {
+		width, _ = strconv.Atoi(match[1])
+	}
+	return gen, width
+}
+
+// parsePCIeGenDevices parses the LnkCap and LnkSta lines of NVIDIA and Mellanox devices from lspci -vv output.
+// Devices without link information, such as virtual functions, are skipped.
+func parsePCIeGenDevices(output string) []PCIeGenDevice {
+	var devices []PCIeGenDevice
+	var current *PCIeGenDevice
+	hasCap, hasSta := false, false
+
+	flush := func() {
+		if current != nil && hasCap && hasSta {
+			devices = append(devices, *current)
+		}
+		current = nil
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if match := pciDeviceHeaderRegex.FindStringSubmatch(line); match != nil {
+			flush()
+			if deviceType := pcieDeviceType(match[2]); deviceType != "" {
+				current = &PCIeGenDevice{BDF: match[1], Type: deviceType}
+				hasCap, hasSta = false, false
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "LnkCap:"):
+			current.CapGen, current.CapWidth = parsePCIeLink(trimmed)
+			hasCap = true
+		case strings.HasPrefix(trimmed, "LnkSta:"):
+			current.ActualGen, current.ActualWidth = parsePCIeLink(trimmed)
+			hasSta = true
+		}
+	}
+	flush()
+
+	return devices
+}
+
+// validatePCIeGenDevices sets the per-device status and returns the overall status.
+// Links running below their capability FAIL, as do GPU links below the shape's expected generation or width.
+func validatePCIeGenDevices(devices []PCIeGenDevice, testConfig *PCIeGenCheckTestConfig) (string, error) {
+	if len(devices) == 0 {
+		return "FAIL", fmt.Errorf("no NVIDIA or Mellanox PCIe devices found")
+	}
+
+	var issues []string
+	for i := range devices {
+		device := &devices[i]
+		device.Status = "PASS"
+
+		var deviceIssues []string
+		if device.ActualGen < device.CapGen {
+			deviceIssues = append(deviceIssues, fmt.Sprintf("Gen%d, capable of Gen%d", device.ActualGen, device.CapGen))
+		}
+		if device.ActualWidth < device.CapWidth {
+			deviceIssues = append(deviceIssues, fmt.Sprintf("x%d, capable of x%d", device.ActualWidth, device.CapWidth))
+		}
+		if device.Type == "gpu" {
+			if testConfig.GPUExpectedGen > 0 && device.ActualGen < testConfig.GPUExpectedGen {
+				deviceIssues = append(deviceIssues, fmt.Sprintf("Gen%d, expected Gen%d", device.ActualGen, testConfig.GPUExpectedGen))
+			}
+			if testConfig.GPUExpectedWidth > 0 && device.ActualWidth < testConfig.GPUExpectedWidth {
+				deviceIssues = append(deviceIssues, fmt.Sprintf("x%d, expected x%d", device.ActualWidth, testConfig.GPUExpectedWidth))
+			}
+		}
+
+		if len(deviceIssues) > 0 {
+			device.Status = "FAIL"
+			issues = append(issues, fmt.Sprintf("%s (%s): %s", device.BDF, device.Type, strings.Join(deviceIssues, ", ")))
+		}
+	}
+
+	if len(issues) > 0 {
+		return "FAIL", fmt.Errorf("PCIe link downgraded on %d device(s): %s", len(issues), strings.Join(issues, "; "))
+	}
+	return "PASS", nil
+}
+
+func RunPCIeGenCheck() error {
+	logger.Info("=== PCIe Gen Check ===")
+	testConfig, err := getPCIeGenCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "pcie_gen_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting PCIe generation and width check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read PCIe link capability and state
+	logger.Info("Step 1: Reading PCIe link capability and state with lspci...")
+	result, err := executor.RunLspci("-vv")
+	if err != nil {
+		err = commandError("pcie_gen_check", result, err)
+		logger.Error("PCIe Gen Check: FAIL - Could not run lspci:", err)
+		rep.AddPCIeGenResult("FAIL", nil, err)
+		return fmt.Errorf("could not run lspci: %w", err)
+	}
+	devices := parsePCIeGenDevices(result.Output)
+	logger.Infof("Found %d NVIDIA and Mellanox PCIe devices", len(devices))
+
+	// Step 2: Validate link generation and width
+	logger.Info("Step 2: Validating PCIe link generation and width...")
+	logger.Infof("Expected GPU link: Gen%d x%d", testConfig.GPUExpectedGen, testConfig.GPUExpectedWidth)
+	status, validationErr := validatePCIeGenDevices(devices, testConfig)
+	for _, device := range devices {
+		logger.Debugf("%s (%s): LnkCap Gen%d x%d, LnkSta Gen%d x%d - %s", device.BDF, device.Type,
+			device.CapGen, device.CapWidth, device.ActualGen, device.ActualWidth, device.Status)
+	}
+	rep.AddPCIeGenResult(status, devices, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("PCIe Gen Check: PASS - All %d devices run at their PCIe link capability", len(devices))
+		return nil
+	default: // FAIL
+		logger.Error("PCIe Gen Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
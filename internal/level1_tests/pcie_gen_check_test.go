@@ -0,0 +1,108 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const sampleLspciGenOutput = `0f:00.0 3D controller: NVIDIA Corporation GH100 [H100 SXM5 80GB] (rev a1)
+	Subsystem: NVIDIA Corporation Device 16c1
+		LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+		LnkSta:	Speed 32GT/s (ok), Width x16 (ok)
+		LnkCap2: Supported Link Speeds: 2.5-32GT/s, Crosslink- Retimer+ 2Retimers+ DRS-
+		LnkSta2: Current De-emphasis Level: -3.5dB, EqualizationComplete+
+
+1f:00.0 3D controller: NVIDIA Corporation GH100 [H100 SXM5 80GB] (rev a1)
+		LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+		LnkSta:	Speed 16GT/s (downgraded), Width x16 (ok)
+
+07:00.0 Bridge: NVIDIA Corporation Device 22a3 (rev a1)
+		LnkCap:	Port #0, Speed 16GT/s, Width x2, ASPM not supported
+		LnkSta:	Speed 16GT/s (ok), Width x2 (ok)
+
+0c:00.0 Infiniband controller: Mellanox Technologies MT2910 Family [ConnectX-7]
+		LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+		LnkSta:	Speed 32GT/s (ok), Width x8 (downgraded)
+
+0c:00.1 Infiniband controller: Mellanox Technologies ConnectX Family mlx5Gen Virtual Function
+
+00:14.0 USB controller: Intel Corporation Device 1bcd
+		LnkCap:	Port #0, Speed 8GT/s, Width x1
+		LnkSta:	Speed 2.5GT/s, Width x1
+`
+
+// Test parsePCIeGenDevices function
+func TestParsePCIeGenDevices(t *testing.T) {
+	devices := parsePCIeGenDevices(sampleLspciGenOutput)
+	if len(devices) != 4 {
+		t.Fatalf("parsePCIeGenDevices() returned %d devices, want 4", len(devices))
+	}
+
+	expected := []PCIeGenDevice{
+		{BDF: "0f:00.0", Type: "gpu", CapGen: 5, CapWidth: 16, ActualGen: 5, ActualWidth: 16},
+		{BDF: "1f:00.0", Type: "gpu", CapGen: 5, CapWidth: 16, ActualGen: 4, ActualWidth: 16},
+		{BDF: "07:00.0", Type: "nvswitch", CapGen: 4, CapWidth: 2, ActualGen: 4, ActualWidth: 2},
+		{BDF: "0c:00.0", Type: "rdma", CapGen: 5, CapWidth: 16, ActualGen: 5, ActualWidth: 8},
+	}
+	for i, device := range devices {
+		if device != expected[i] {
+			t.Errorf("parsePCIeGenDevices() device %d = %+v, want %+v", i, device, expected[i])
+		}
+	}
+}
+
+// Test validatePCIeGenDevices function
+func TestValidatePCIeGenDevices(t *testing.T) {
+	testConfig := &PCIeGenCheckTestConfig{GPUExpectedGen: 5, GPUExpectedWidth: 16}
+
+	tests := []struct {
+		name           string
+		devices        []PCIeGenDevice
+		expectedStatus string
+	}{
+		{
+			name: "All links at capability",
+			devices: []PCIeGenDevice{
+				{BDF: "0f:00.0", Type: "gpu", CapGen: 5, CapWidth: 16, ActualGen: 5, ActualWidth: 16},
+				{BDF: "07:00.0", Type: "nvswitch", CapGen: 4, CapWidth: 2, ActualGen: 4, ActualWidth: 2},
+			},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "GPU link downgraded",
+			devices:        []PCIeGenDevice{{BDF: "1f:00.0", Type: "gpu", CapGen: 5, CapWidth: 16, ActualGen: 4, ActualWidth: 16}},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "GPU below expected generation",
+			devices:        []PCIeGenDevice{{BDF: "1f:00.0", Type: "gpu", CapGen: 4, CapWidth: 16, ActualGen: 4, ActualWidth: 16}},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "NIC width downgraded",
+			devices:        []PCIeGenDevice{{BDF: "0c:00.0", Type: "rdma", CapGen: 5, CapWidth: 16, ActualGen: 5, ActualWidth: 8}},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "No devices",
+			devices:        []PCIeGenDevice{},
+			expectedStatus: "FAIL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validatePCIeGenDevices(tt.devices, testConfig)
+			if status != tt.expectedStatus {
+				t.Errorf("validatePCIeGenDevices() = %s, want %s", status, tt.expectedStatus)
+			}
+			for _, device := range tt.devices {
+				if device.Status != tt.expectedStatus {
+					t.Errorf("validatePCIeGenDevices() device %s status = %s, want %s", device.BDF, device.Status, tt.expectedStatus)
+				}
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validatePCIeGenDevices() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/shapes"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// defaultMinBAR1SizeMB is the BAR1 size below which resizable BAR is considered disabled.
+// Without ReBAR GPUs expose the legacy 256MB BAR1 window.
+const defaultMinBAR1SizeMB = 256
+
+// pciBARSizeRegex matches the size of an lspci memory region, e.g. "[size=32G]"
+var pciBARSizeRegex = regexp.MustCompile(`\[size=(\d+)([KMGT]?)\]`)
+
+// PCIeReBARCheckTestConfig represents the config needed to run this test.
+// ExpectedBAR1SizeMB is the full BAR1 size of the shape's GPUs, 0 disables the full size check.
+type PCIeReBARCheckTestConfig struct {
+	IsEnabled          bool   `json:"enabled"`
+	Shape              string `json:"shape"`
+	ExpectedBAR1SizeMB int64  `json:"expected_bar1_size_mb"`
+	MinBAR1SizeMB      int64  `json:"min_bar1_size_mb"`
+}
+
+// GPUBARSize represents the BAR1 (framebuffer) mapping of a GPU
+type GPUBARSize struct {
+	BDF                string `json:"bdf"`
+	ExpectedBAR1SizeMB int64  `json:"expected_bar1_size_mb"`
+	ActualBAR1SizeMB   int64  `json:"actual_bar1_size_mb"`
+	Status             string `json:"status"`
+}
+
+// getPCIeReBARCheckTestConfig gets test config needed to run this test
+func getPCIeReBARCheckTestConfig() (*PCIeReBARCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	pcieReBARCheckTestConfig := &PCIeReBARCheckTestConfig{
+		IsEnabled:     false,
+		Shape:         shape,
+		MinBAR1SizeMB: defaultMinBAR1SizeMB,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "pcie_rebar_check")
+	if err != nil {
+		return nil, err
+	}
+	pcieReBARCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "pcie_rebar_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if expected, ok := thresholdMap["expected_bar1_size_gb"].(float64); ok {
+				pcieReBARCheckTestConfig.ExpectedBAR1SizeMB = int64(expected * 1024)
+			}
+			if minSize, ok := thresholdMap["min_bar1_size_mb"].(float64); ok {
+				pcieReBARCheckTestConfig.MinBAR1SizeMB = int64(minSize)
+			}
+		}
+	}
+
+	return pcieReBARCheckTestConfig, nil
+}
+
+// parseBARSizeMB converts an lspci region size such as "32G" to MB. Regions smaller than 1MB round down to 0.
+func parseBARSizeMB(value, unit string) int64 {
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	switch unit {
+	case "K":
+		return size / 1024
+	case "M":
+		return size
+	case "G":
+		return size * 1024
+	case "T":
+		return size * 1024 * 1024
+	}
+	return size / (1024 * 1024)
+}
+
+// parseGPUBAR1SizeMB returns the BAR1 size in MB of a GPU from its lspci -v output. BAR1 is the
+// first prefetchable memory region, BAR0 holds the non-prefetchable GPU registers.
+func parseGPUBAR1SizeMB(output string) (int64, bool) {
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "Memory at") && !strings.Contains(trimmed, ": Memory at") {
+			continue
+		}
+		if !strings.Contains(trimmed, "prefetchable") || strings.Contains(trimmed, "non-prefetchable") {
+			continue
+		}
+		if match := pciBARSizeRegex.FindStringSubmatch(trimmed); match != nil {
+			return parseBARSizeMB(match[1], match[2]), true
+		}
+	}
+	return 0, false
+}
+
+// formatBARSize formats a BAR size in MB for messages, e.g. "32GB" or "256MB"
+func formatBARSize(sizeMB int64) string {
+	if sizeMB >= 1024 && sizeMB%1024 == 0 {
+		return fmt.Sprintf("%dGB", sizeMB/1024)
+	}
+	return fmt.Sprintf("%dMB", sizeMB)
+}
+
+// validateGPUBARSizes sets the per-GPU status and returns the overall status. A BAR1 below
+// MinBAR1SizeMB means resizable BAR is disabled and FAILs, a BAR1 below the full expected size WARNs.
+func validateGPUBARSizes(gpus []GPUBARSize, testConfig *PCIeReBARCheckTestConfig) (string, error) {
+	if len(gpus) == 0 {
+		return "FAIL", fmt.Errorf("no GPUs found to check BAR1 size")
+	}
+
+	var failed, warned []string
+	for i := range gpus {
+		gpu := &gpus[i]
+		gpu.ExpectedBAR1SizeMB = testConfig.ExpectedBAR1SizeMB
+		switch {
+		case gpu.ActualBAR1SizeMB < testConfig.MinBAR1SizeMB:
+			gpu.Status = "FAIL"
+			failed = append(failed, fmt.Sprintf("%s (%s)", gpu.BDF, formatBARSize(gpu.ActualBAR1SizeMB)))
+		case testConfig.ExpectedBAR1SizeMB > 0 && gpu.ActualBAR1SizeMB < testConfig.ExpectedBAR1SizeMB:
+			gpu.Status = "WARN"
+			warned = append(warned, fmt.Sprintf("%s (%s)", gpu.BDF, formatBARSize(gpu.ActualBAR1SizeMB)))
+		default:
+			gpu.Status = "PASS"
+		}
+	}
+
+	if len(failed) > 0 {
+		return "FAIL", fmt.Errorf("BAR1 mapped below %s, resizable BAR disabled on: %s", formatBARSize(testConfig.MinBAR1SizeMB), strings.Join(failed, ", "))
+	}
+	if len(warned) > 0 {
+		return "WARN", fmt.Errorf("BAR1 mapped below the full %s on: %s", formatBARSize(testConfig.ExpectedBAR1SizeMB), strings.Join(warned, ", "))
+	}
+	return "PASS", nil
+}
+
+// getGPUBARSizes reads the BAR1 size of every GPU. GPUs missing from lspci are reported with a 0MB BAR1.
+func getGPUBARSizes(bdfs []string) ([]GPUBARSize, error) {
+	gpus := make([]GPUBARSize, 0, len(bdfs))
+	for _, bdf := range bdfs {
+		result, err := executor.RunLspciForDevice(bdf, true)
+		if err != nil {
+			return nil, commandError("pcie_rebar_check", result, err)
+		}
+		size, found := parseGPUBAR1SizeMB(result.Output)
+		if !found {
+			logger.Warnf("No BAR1 region found for GPU %s", bdf)
+		}
+		gpus = append(gpus, GPUBARSize{BDF: bdf, ActualBAR1SizeMB: size})
+	}
+	return gpus, nil
+}
+
+func RunPCIeReBARCheck() error {
+	logger.Info("=== PCIe ReBAR Check ===")
+	testConfig, err := getPCIeReBARCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "pcie_rebar_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting PCIe resizable BAR check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get GPU BDFs from shapes configuration
+	logger.Info("Step 1: Loading GPU PCI addresses from shape configuration...")
+	shapeManager, err := shapes.GetDefaultShapeManager()
+	if err != nil {
+		logger.Error("PCIe ReBAR Check: FAIL - Could not load shapes configuration:", err)
+		rep.AddPCIeReBARResult("FAIL", nil, err)
+		return fmt.Errorf("failed to load shapes configuration: %w", err)
+	}
+	bdfs, err := shapeManager.GetGPUPCIAddresses(testConfig.Shape)
+	if err != nil {
+		logger.Error("PCIe ReBAR Check: FAIL - Could not get GPU PCI addresses:", err)
+		rep.AddPCIeReBARResult("FAIL", nil, err)
+		return fmt.Errorf("failed to get GPU PCI addresses: %w", err)
+	}
+	if len(bdfs) == 0 {
+		errorStatement := fmt.Sprintf("No GPUs expected for shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return errors.New(errorStatement)
+	}
+
+	// Step 2: Read the BAR1 size of every GPU
+	logger.Info("Step 2: Reading BAR1 size of", len(bdfs), "GPUs with lspci...")
+	gpus, err := getGPUBARSizes(bdfs)
+	if err != nil {
+		logger.Error("PCIe ReBAR Check: FAIL - Could not run lspci:", err)
+		rep.AddPCIeReBARResult("FAIL", nil, err)
+		return fmt.Errorf("could not run lspci: %w", err)
+	}
+
+	// Step 3: Validate BAR1 sizes
+	logger.Info("Step 3: Validating BAR1 sizes...")
+	logger.Infof("Expected BAR1 size: %s", formatBARSize(testConfig.ExpectedBAR1SizeMB))
+	status, validationErr := validateGPUBARSizes(gpus, testConfig)
+	for _, gpu := range gpus {
+		logger.Debugf("%s: BAR1 %s - %s", gpu.BDF, formatBARSize(gpu.ActualBAR1SizeMB), gpu.Status)
+	}
+	rep.AddPCIeReBARResult(status, gpus, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("PCIe ReBAR Check: PASS - BAR1 mapped at full size on all %d GPUs", len(gpus))
+		return nil
+	case "WARN":
+		logger.Info("PCIe ReBAR Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("PCIe ReBAR Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,91 @@
+package level1_tests
+
+import (
+	"strings"
+	"testing"
+)
+
+const h100LspciOutput = `0f:00.0 3D controller: NVIDIA Corporation GH100 [H100 SXM5 80GB] (rev a1)
+	Subsystem: NVIDIA Corporation Device 16c1
+	Flags: bus master, fast devsel, latency 0, IRQ 18, NUMA node 0
+	Memory at 9d000000 (32-bit, non-prefetchable) [size=16M]
+	Memory at 218000000000 (64-bit, prefetchable) [size=32G]
+	Memory at 220000000000 (64-bit, prefetchable) [size=32M]
+	Capabilities: [60] Power Management version 3
+	Kernel driver in use: nvidia
+`
+
+// Test parseGPUBAR1SizeMB function
+func TestParseGPUBAR1SizeMB(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		expected int64
+		found    bool
+	}{
+		{"full size BAR1", h100LspciOutput, 32768, true},
+		{"ReBAR disabled", strings.Replace(h100LspciOutput, "[size=32G]", "[size=256M]", 1), 256, true},
+		{"GPU not found", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, found := parseGPUBAR1SizeMB(tt.output)
+			if size != tt.expected || found != tt.found {
+				t.Errorf("parseGPUBAR1SizeMB() = %d, %v, want %d, %v", size, found, tt.expected, tt.found)
+			}
+		})
+	}
+}
+
+// Test parseBARSizeMB function
+func TestParseBARSizeMB(t *testing.T) {
+	tests := []struct {
+		value, unit string
+		expected    int64
+	}{
+		{"512", "K", 0},
+		{"256", "M", 256},
+		{"32", "G", 32768},
+		{"1", "T", 1048576},
+	}
+
+	for _, tt := range tests {
+		if size := parseBARSizeMB(tt.value, tt.unit); size != tt.expected {
+			t.Errorf("parseBARSizeMB(%s, %s) = %d, want %d", tt.value, tt.unit, size, tt.expected)
+		}
+	}
+}
+
+// Test validateGPUBARSizes function
+func TestValidateGPUBARSizes(t *testing.T) {
+	testConfig := &PCIeReBARCheckTestConfig{ExpectedBAR1SizeMB: 32768, MinBAR1SizeMB: 256}
+
+	gpus := []GPUBARSize{{BDF: "0000:0f:00.0", ActualBAR1SizeMB: 32768}, {BDF: "0000:2d:00.0", ActualBAR1SizeMB: 131072}}
+	status, err := validateGPUBARSizes(gpus, testConfig)
+	if status != "PASS" || err != nil {
+		t.Errorf("validateGPUBARSizes() = %s, %v, want PASS", status, err)
+	}
+	if gpus[0].ExpectedBAR1SizeMB != 32768 || gpus[0].Status != "PASS" {
+		t.Errorf("gpu = %+v, want expected size 32768 and PASS", gpus[0])
+	}
+
+	gpus = []GPUBARSize{{BDF: "0000:0f:00.0", ActualBAR1SizeMB: 32768}, {BDF: "0000:2d:00.0", ActualBAR1SizeMB: 8192}}
+	status, err = validateGPUBARSizes(gpus, testConfig)
+	if status != "WARN" || err == nil || !strings.Contains(err.Error(), "below the full 32GB on: 0000:2d:00.0 (8GB)") {
+		t.Errorf("validateGPUBARSizes() = %s, %v, want WARN for 0000:2d:00.0", status, err)
+	}
+
+	gpus = []GPUBARSize{{BDF: "0000:0f:00.0", ActualBAR1SizeMB: 128}, {BDF: "0000:2d:00.0", ActualBAR1SizeMB: 8192}}
+	status, err = validateGPUBARSizes(gpus, testConfig)
+	if status != "FAIL" || err == nil || !strings.Contains(err.Error(), "resizable BAR disabled on: 0000:0f:00.0 (128MB)") {
+		t.Errorf("validateGPUBARSizes() = %s, %v, want FAIL for 0000:0f:00.0", status, err)
+	}
+	if gpus[1].Status != "WARN" {
+		t.Errorf("0000:2d:00.0 status = %s, want WARN", gpus[1].Status)
+	}
+
+	if status, err := validateGPUBARSizes(nil, testConfig); status != "FAIL" || err == nil {
+		t.Errorf("validateGPUBARSizes(nil) = %s, %v, want FAIL", status, err)
+	}
+}
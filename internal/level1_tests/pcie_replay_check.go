@@ -0,0 +1,214 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// pcieReplaySampleInterval is the time between the two replay counter samples
+const pcieReplaySampleInterval = 5 * time.Second
+
+var (
+	// nvidia-smi -q starts each GPU section with its bus ID, e.g. "GPU 00000000:0F:00.0"
+	pcieReplayGPURegex = regexp.MustCompile(`^GPU\s+([0-9A-Fa-f]+:[0-9A-Fa-f]+:[0-9A-Fa-f]+\.[0-9A-Fa-f])\s*$`)
+	// Replays since reset in the PCI section of nvidia-smi -q, e.g. "Replays Since Reset : 0"
+	pcieReplayCountRegex = regexp.MustCompile(`^Replays Since Reset\s*:\s*(\d+)`)
+)
+
+// PCIeReplayCheckTestConfig represents the config needed to run this test
+type PCIeReplayCheckTestConfig struct {
+	IsEnabled     bool    `json:"enabled"`
+	Shape         string  `json:"shape"`
+	MaxReplayRate float64 `json:"max_replay_rate"`
+}
+
+// GPUPCIeReplay represents the PCIe replay counter samples of a single GPU
+type GPUPCIeReplay struct {
+	BDF         string  `json:"bdf"`
+	ReplayCount int     `json:"replay_count"`
+	ReplayDelta int     `json:"replay_delta"`
+	ReplayRate  float64 `json:"replay_rate"`
+	Status      string  `json:"status"`
+}
+
+// getPCIeReplayCheckTestConfig gets test config needed to run this test
+func getPCIeReplayCheckTestConfig() (*PCIeReplayCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	pcieReplayCheckTestConfig := &PCIeReplayCheckTestConfig{
+		IsEnabled:     false,
+		Shape:         shape,
+		MaxReplayRate: 0,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "pcie_replay_check")
+	if err != nil {
+		return nil, err
+	}
+	pcieReplayCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "pcie_replay_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if rate, ok := thresholdMap["max_replay_rate"].(float64); ok {
+				pcieReplayCheckTestConfig.MaxReplayRate = rate
+			}
+		}
+	}
+
+	return pcieReplayCheckTestConfig, nil
+}
+
+// parsePCIeReplayCounters parses the replay counter of each GPU from nvidia-smi -q output, keyed by bus ID
+func parsePCIeReplayCounters(output string) (map[string]int, error) {
+	counters := make(map[string]int)
+	currentBDF := ""
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := pcieReplayGPURegex.FindStringSubmatch(line); match != nil {
+			currentBDF = match[1]
+			continue
+		}
+
+		if match := pcieReplayCountRegex.FindStringSubmatch(line); match != nil && currentBDF != "" {
+			count, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid replay counter for GPU %s: %s", currentBDF, match[1])
+			}
+			counters[currentBDF] = count
+		}
+	}
+
+	if len(counters) == 0 {
+		return nil, fmt.Errorf("no PCIe replay counters found in nvidia-smi -q output")
+	}
+	return counters, nil
+}
+
+// computePCIeReplayRates computes the replay rate per second of each GPU between two samples
+// and marks GPUs above maxReplayRate with WARN
+func computePCIeReplayRates(first, second map[string]int, elapsed time.Duration, maxReplayRate float64) []GPUPCIeReplay {
+	bdfs := make([]string, 0, len(second))
+	for bdf := range second {
+		bdfs = append(bdfs, bdf)
+	}
+	sort.Strings(bdfs)
+
+	var results []GPUPCIeReplay
+	for _, bdf := range bdfs {
+		delta := second[bdf] - first[bdf]
+		// The counter was reset between the samples
+		if delta < 0 {
+			delta = second[bdf]
+		}
+
+		replay := GPUPCIeReplay{
+			BDF:         bdf,
+			ReplayCount: second[bdf],
+			ReplayDelta: delta,
+			Status:      "PASS",
+		}
+		if elapsed > 0 {
+			replay.ReplayRate = float64(delta) / elapsed.Seconds()
+		}
+		if replay.ReplayRate > maxReplayRate {
+			replay.Status = "WARN"
+		}
+		results = append(results, replay)
+	}
+	return results
+}
+
+// samplePCIeReplayCounters runs nvidia-smi -q and parses the replay counters
+func samplePCIeReplayCounters() (map[string]int, error) {
+	result := executor.RunNvidiaSMIQueryDetailed()
+	if !result.Available {
+		return nil, nvidiaSMIError("pcie_replay_check", "nvidia-smi -q", result)
+	}
+	return parsePCIeReplayCounters(result.Output)
+}
+
+func RunPCIeReplayCheck() error {
+	logger.Info("=== PCIe Replay Check ===")
+	testConfig, err := getPCIeReplayCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "pcie_replay_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting PCIe replay counter check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Take two samples of the replay counters
+	logger.Info("Step 1: Sampling PCIe replay counters from nvidia-smi -q...")
+	first, err := samplePCIeReplayCounters()
+	if err != nil {
+		logger.Error("PCIe Replay Check: FAIL - Could not read PCIe replay counters:", err)
+		rep.AddPCIeReplayResult("FAIL", nil, err)
+		return err
+	}
+	start := time.Now()
+
+	logger.Infof("Waiting %s before taking the second sample...", pcieReplaySampleInterval)
+	time.Sleep(pcieReplaySampleInterval)
+
+	second, err := samplePCIeReplayCounters()
+	if err != nil {
+		logger.Error("PCIe Replay Check: FAIL - Could not read PCIe replay counters:", err)
+		rep.AddPCIeReplayResult("FAIL", nil, err)
+		return err
+	}
+	elapsed := time.Since(start)
+
+	// Step 2: Compare replay rates against the threshold
+	logger.Info("Step 2: Validating PCIe replay rates...")
+	logger.Infof("Maximum replay rate: %.2f/s", testConfig.MaxReplayRate)
+	replays := computePCIeReplayRates(first, second, elapsed, testConfig.MaxReplayRate)
+
+	var degraded []string
+	for _, replay := range replays {
+		logger.Infof("GPU %s: %d replays (+%d, %.2f/s) - %s", replay.BDF, replay.ReplayCount, replay.ReplayDelta, replay.ReplayRate, replay.Status)
+		if replay.Status == "WARN" {
+			degraded = append(degraded, fmt.Sprintf("%s (%.2f/s)", replay.BDF, replay.ReplayRate))
+		}
+	}
+
+	if len(degraded) > 0 {
+		err = fmt.Errorf("PCIe replay rate above %.2f/s on GPU(s): %s", testConfig.MaxReplayRate, strings.Join(degraded, ", "))
+		logger.Info("PCIe Replay Check: WARN -", err)
+		rep.AddPCIeReplayResult("WARN", replays, err)
+		return err
+	}
+
+	logger.Info("PCIe Replay Check: PASS - PCIe replay rates are within limits")
+	rep.AddPCIeReplayResult("PASS", replays, nil)
+	return nil
+}
@@ -0,0 +1,89 @@
+package level1_tests
+
+import (
+	"testing"
+	"time"
+)
+
+const testNvidiaSMIQueryOutput = `
+==============NVSMI LOG==============
+
+Timestamp                                 : Fri Oct 16 10:00:00 2026
+Driver Version                            : 550.90.07
+CUDA Version                              : 12.4
+
+Attached GPUs                             : 2
+GPU 00000000:0F:00.0
+    Product Name                          : NVIDIA H100 80GB HBM3
+    PCI
+        Bus                               : 0x0F
+        Bus Id                            : 00000000:0F:00.0
+        GPU Link Info
+            PCIe Generation
+                Max                       : 5
+                Current                   : 5
+        Replays Since Reset               : 3
+        Replay Number Rollovers           : 0
+        Tx Throughput                     : 450 KB/s
+
+GPU 00000000:2D:00.0
+    Product Name                          : NVIDIA H100 80GB HBM3
+    PCI
+        Bus                               : 0x2D
+        Bus Id                            : 00000000:2D:00.0
+        Replays Since Reset               : 120
+        Replay Number Rollovers           : 0
+`
+
+// Test parsePCIeReplayCounters function
+func TestParsePCIeReplayCounters(t *testing.T) {
+	counters, err := parsePCIeReplayCounters(testNvidiaSMIQueryOutput)
+	if err != nil {
+		t.Fatalf("parsePCIeReplayCounters() error = %v", err)
+	}
+
+	expected := map[string]int{"00000000:0F:00.0": 3, "00000000:2D:00.0": 120}
+	if len(counters) != len(expected) {
+		t.Fatalf("parsePCIeReplayCounters() returned %d GPUs, want %d", len(counters), len(expected))
+	}
+	for bdf, count := range expected {
+		if counters[bdf] != count {
+			t.Errorf("GPU %s replays = %d, want %d", bdf, counters[bdf], count)
+		}
+	}
+
+	if _, err := parsePCIeReplayCounters("Attached GPUs : 0\n"); err == nil {
+		t.Error("Expected error when no replay counters are present")
+	}
+}
+
+// Test computePCIeReplayRates function
+func TestComputePCIeReplayRates(t *testing.T) {
+	first := map[string]int{"00000000:0F:00.0": 3, "00000000:2D:00.0": 120, "00000000:3B:00.0": 500}
+	second := map[string]int{"00000000:0F:00.0": 3, "00000000:2D:00.0": 170, "00000000:3B:00.0": 2}
+
+	replays := computePCIeReplayRates(first, second, 5*time.Second, 1.0)
+	if len(replays) != 3 {
+		t.Fatalf("computePCIeReplayRates() returned %d GPUs, want 3", len(replays))
+	}
+
+	tests := []struct {
+		bdf            string
+		expectedDelta  int
+		expectedRate   float64
+		expectedStatus string
+	}{
+		{"00000000:0F:00.0", 0, 0, "PASS"},
+		{"00000000:2D:00.0", 50, 10, "WARN"},
+		// A counter reset between samples counts the replays since the reset
+		{"00000000:3B:00.0", 2, 0.4, "PASS"},
+	}
+
+	for i, tt := range tests {
+		replay := replays[i]
+		if replay.BDF != tt.bdf || replay.ReplayDelta != tt.expectedDelta || replay.ReplayRate != tt.expectedRate || replay.Status != tt.expectedStatus {
+			t.Errorf("GPU %d = %+v, want BDF %s, delta %d, rate %.2f, status %s",
+				i, replay, tt.bdf, tt.expectedDelta, tt.expectedRate, tt.expectedStatus)
+		}
+	}
+}
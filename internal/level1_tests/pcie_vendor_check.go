@@ -0,0 +1,183 @@
+package level1_tests
+
+import (
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// PCIeVendorCheckTestConfig represents the config needed to run this test.
+// GPUDeviceID and RDMANICDeviceID are vendor:device ID pairs as printed by `lspci -n`, e.g. "10de:2330".
+type PCIeVendorCheckTestConfig struct {
+	IsEnabled       bool     `json:"enabled"`
+	Shape           string   `json:"shape"`
+	GPUBDFs         []string `json:"gpu_bdfs"`
+	GPUDeviceID     string   `json:"gpu_device_id"`
+	RDMANICBDFs     []string `json:"rdma_nic_bdfs"`
+	RDMANICDeviceID string   `json:"rdma_nic_device_id"`
+}
+
+// PCIeDeviceVendor represents the vendor:device ID found at an expected BDF.
+// FoundID is empty when the BDF is not enumerated by lspci.
+type PCIeDeviceVendor struct {
+	BDF        string `json:"bdf"`
+	DeviceType string `json:"device_type"`
+	ExpectedID string `json:"expected_id"`
+	FoundID    string `json:"found_id"`
+	Status     string `json:"status"`
+}
+
+// getPCIeVendorCheckTestConfig gets test config needed to run this test
+func getPCIeVendorCheckTestConfig() (*PCIeVendorCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	pcieVendorCheckTestConfig := &PCIeVendorCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "pcie_vendor_check")
+	if err != nil {
+		return nil, err
+	}
+	pcieVendorCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "pcie_vendor_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			pcieVendorCheckTestConfig.GPUBDFs = bdfList(thresholdMap["gpu_bdfs"])
+			pcieVendorCheckTestConfig.RDMANICBDFs = bdfList(thresholdMap["rdma_nic_bdfs"])
+			if id, ok := thresholdMap["gpu_device_id"].(string); ok {
+				pcieVendorCheckTestConfig.GPUDeviceID = strings.ToLower(id)
+			}
+			if id, ok := thresholdMap["rdma_nic_device_id"].(string); ok {
+				pcieVendorCheckTestConfig.RDMANICDeviceID = strings.ToLower(id)
+			}
+		}
+	}
+
+	return pcieVendorCheckTestConfig, nil
+}
+
+// parseLspciDeviceIDs parses `lspci -D -n` output into a map of BDF to vendor:device ID.
+// Lines look like "0000:0f:00.0 0302: 10de:2330 (rev a1)".
+func parseLspciDeviceIDs(output string) map[string]string {
+	ids := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || !strings.HasSuffix(fields[1], ":") {
+			continue
+		}
+		ids[strings.ToLower(fields[0])] = strings.ToLower(fields[2])
+	}
+	return ids
+}
+
+// getPCIeDeviceVendors returns the found vendor:device ID of every expected GPU and RDMA NIC BDF
+func getPCIeDeviceVendors(found map[string]string, testConfig *PCIeVendorCheckTestConfig) []PCIeDeviceVendor {
+	var devices []PCIeDeviceVendor
+	for _, bdf := range testConfig.GPUBDFs {
+		devices = append(devices, PCIeDeviceVendor{BDF: bdf, DeviceType: "GPU", ExpectedID: testConfig.GPUDeviceID, FoundID: found[bdf]})
+	}
+	for _, bdf := range testConfig.RDMANICBDFs {
+		devices = append(devices, PCIeDeviceVendor{BDF: bdf, DeviceType: "RDMA NIC", ExpectedID: testConfig.RDMANICDeviceID, FoundID: found[bdf]})
+	}
+	return devices
+}
+
+// validatePCIeDeviceVendors sets the per-device status and returns the overall status and mismatches.
+// A BDF showing an unexpected vendor:device ID FAILs. BDFs missing from lspci are marked MISSING
+// but do not fail this test, pcie_device_count_check reports them.
+func validatePCIeDeviceVendors(devices []PCIeDeviceVendor) (string, []string, error) {
+	mismatches := []string{}
+	if len(devices) == 0 {
+		return "FAIL", mismatches, fmt.Errorf("no expected GPU or RDMA NIC BDFs configured")
+	}
+
+	for i := range devices {
+		device := &devices[i]
+		switch {
+		case device.FoundID == "":
+			device.Status = "MISSING"
+		case device.ExpectedID != "" && device.FoundID != device.ExpectedID:
+			device.Status = "FAIL"
+			mismatches = append(mismatches, fmt.Sprintf("%s %s (found %s, expected %s)", device.DeviceType, device.BDF, device.FoundID, device.ExpectedID))
+		default:
+			device.Status = "PASS"
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return "FAIL", mismatches, fmt.Errorf("unexpected PCIe vendor:device IDs: %s", strings.Join(mismatches, "; "))
+	}
+	return "PASS", mismatches, nil
+}
+
+// RunPCIeVendorCheck checks every expected GPU and RDMA NIC BDF shows the expected vendor:device ID
+func RunPCIeVendorCheck() error {
+	logger.Info("=== PCIe Vendor Check ===")
+	testConfig, err := getPCIeVendorCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "pcie_vendor_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting PCIe vendor check...")
+	rep := reporter.GetReporter()
+	expectedBDFs := append(append([]string{}, testConfig.GPUBDFs...), testConfig.RDMANICBDFs...)
+
+	// Step 1: Read PCIe vendor:device IDs
+	logger.Info("Step 1: Reading PCIe vendor:device IDs...")
+	result, err := executor.RunLspci("-D", "-n")
+	if err != nil {
+		logger.Error("Failed to run lspci command:", err)
+		logger.Info("PCIe Vendor Check: FAIL - Could not run lspci command")
+		err = commandError("pcie_vendor_check", result, err)
+		rep.AddPCIeVendorResult("FAIL", expectedBDFs, nil, nil, err)
+		return err
+	}
+	found := parseLspciDeviceIDs(result.Output)
+	logger.Infof("Found %d PCIe devices", len(found))
+
+	// Step 2: Compare vendor:device IDs at the expected BDFs
+	logger.Infof("Step 2: Checking GPUs for %s and RDMA NICs for %s...", testConfig.GPUDeviceID, testConfig.RDMANICDeviceID)
+	devices := getPCIeDeviceVendors(found, testConfig)
+	status, mismatches, validationErr := validatePCIeDeviceVendors(devices)
+	for _, device := range devices {
+		if device.Status == "MISSING" {
+			logger.Warnf("%s %s not found by lspci", device.DeviceType, device.BDF)
+			continue
+		}
+		logger.Debugf("%s %s: %s - %s", device.DeviceType, device.BDF, device.FoundID, device.Status)
+	}
+	rep.AddPCIeVendorResult(status, expectedBDFs, devices, mismatches, validationErr)
+
+	if status == "PASS" {
+		logger.Info("PCIe Vendor Check: PASS - All expected GPUs and RDMA NICs show the expected vendor:device IDs")
+		return nil
+	}
+	logger.Error("PCIe Vendor Check: FAIL -", validationErr)
+	return validationErr
+}
@@ -0,0 +1,72 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+const testLspciNumericOutput = `0000:0c:00.0 0207: 15b3:1021
+0000:0c:00.1 0207: 15b3:1021
+0000:0f:00.0 0302: 10de:2330 (rev a1)
+0000:2d:00.0 0302: 10de:2324 (rev a1)
+`
+
+// Test parseLspciDeviceIDs function with lspci -D -n output
+func TestParseLspciDeviceIDs(t *testing.T) {
+	ids := parseLspciDeviceIDs(testLspciNumericOutput)
+	if len(ids) != 4 {
+		t.Errorf("parseLspciDeviceIDs() returned %d devices, want 4", len(ids))
+	}
+	if ids["0000:0f:00.0"] != "10de:2330" {
+		t.Errorf("parseLspciDeviceIDs()[0000:0f:00.0] = %q, want 10de:2330", ids["0000:0f:00.0"])
+	}
+	if ids["0000:0c:00.1"] != "15b3:1021" {
+		t.Errorf("parseLspciDeviceIDs()[0000:0c:00.1] = %q, want 15b3:1021", ids["0000:0c:00.1"])
+	}
+
+	// Non-numeric lspci output is ignored
+	if ids := parseLspciDeviceIDs("0000:0f:00.0 3D controller: NVIDIA Corporation Device 2330 (rev a1)\n"); len(ids) != 0 {
+		t.Errorf("parseLspciDeviceIDs() = %v, want no devices", ids)
+	}
+}
+
+// Test validatePCIeDeviceVendors function
+func TestValidatePCIeDeviceVendors(t *testing.T) {
+	testConfig := &PCIeVendorCheckTestConfig{
+		GPUBDFs:         []string{"0000:0f:00.0"},
+		GPUDeviceID:     "10de:2330",
+		RDMANICBDFs:     []string{"0000:0c:00.0", "0000:0c:00.1"},
+		RDMANICDeviceID: "15b3:1021",
+	}
+
+	tests := []struct {
+		name               string
+		found              map[string]string
+		expectedStatus     string
+		expectedMismatches int
+	}{
+		{"all expected", map[string]string{"0000:0f:00.0": "10de:2330", "0000:0c:00.0": "15b3:1021", "0000:0c:00.1": "15b3:1021"}, "PASS", 0},
+		{"missing BDF", map[string]string{"0000:0f:00.0": "10de:2330", "0000:0c:00.0": "15b3:1021"}, "PASS", 0},
+		{"replaced GPU", map[string]string{"0000:0f:00.0": "10de:2324", "0000:0c:00.0": "15b3:1021", "0000:0c:00.1": "15b3:1021"}, "FAIL", 1},
+		{"unexpected vendors", map[string]string{"0000:0f:00.0": "15b3:1021", "0000:0c:00.0": "10de:2330", "0000:0c:00.1": "15b3:1021"}, "FAIL", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devices := getPCIeDeviceVendors(tt.found, testConfig)
+			status, mismatches, err := validatePCIeDeviceVendors(devices)
+			if status != tt.expectedStatus {
+				t.Errorf("validatePCIeDeviceVendors() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if len(mismatches) != tt.expectedMismatches {
+				t.Errorf("validatePCIeDeviceVendors() mismatches = %v, want %d", mismatches, tt.expectedMismatches)
+			}
+			if (err != nil) != (tt.expectedStatus == "FAIL") {
+				t.Errorf("validatePCIeDeviceVendors() error = %v", err)
+			}
+		})
+	}
+
+	if status, _, err := validatePCIeDeviceVendors(nil); status != "FAIL" || err == nil {
+		t.Errorf("validatePCIeDeviceVendors(nil) = %v, %v, want FAIL", status, err)
+	}
+}
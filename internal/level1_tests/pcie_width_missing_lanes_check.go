@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -17,8 +19,10 @@ type PCIeWidthResult struct {
 	WidthCounts map[string]int `json:"width_counts"`
 	SpeedCounts map[string]int `json:"speed_counts"`
 	StateErrors []string       `json:"state_errors"`
-	Success     bool           `json:"success"`
-	ErrorMsg    string         `json:"error_message,omitempty"`
+	// DowngradedDevices lists devices whose link status is below their link capability
+	DowngradedDevices []string `json:"downgraded_devices,omitempty"`
+	Success           bool     `json:"success"`
+	ErrorMsg          string   `json:"error_message,omitempty"`
 }
 
 // PCIeWidthMissingLanesTestConfig holds configuration for this test
@@ -29,6 +33,7 @@ type PCIeWidthMissingLanesTestConfig struct {
 	ExpectedGPUSpeeds    map[string]int     `json:"expected_gpu_speeds"`
 	ExpectedRDMASpeeds   map[string]int     `json:"expected_rdma_speeds"`
 	ExpectedLinkState    string             `json:"expected_link_state"`
+	CheckLinkDowngrade   bool               `json:"check_link_downgrade"`
 }
 
 // getpcieWidthMissingLanesTestConfig loads test configuration
@@ -47,6 +52,7 @@ func getPcieWidthMissingLanesTestConfig(shape string) (*PCIeWidthMissingLanesTes
 		ExpectedGPUSpeeds:   make(map[string]int),
 		ExpectedRDMASpeeds:  make(map[string]int),
 		ExpectedLinkState:   "Ok",
+		CheckLinkDowngrade:  true,
 	}
 
 	// Check if test is enabled
@@ -114,6 +120,11 @@ func getPcieWidthMissingLanesTestConfig(shape string) (*PCIeWidthMissingLanesTes
 				config.ExpectedLinkState = stateStr
 			}
 		}
+
+		// Parse whether links below their capability fail the test
+		if checkDowngrade, ok := thresholdMap["check_link_downgrade"].(bool); ok {
+			config.CheckLinkDowngrade = checkDowngrade
+		}
 	}
 
 	return config, nil
@@ -121,9 +132,35 @@ func getPcieWidthMissingLanesTestConfig(shape string) (*PCIeWidthMissingLanesTes
 
 // PCIeParseResult holds parsed PCIe information
 type PCIeParseResult struct {
-	WidthCounts map[string]int
-	SpeedCounts map[string]int
-	StateErrors []string
+	WidthCounts       map[string]int
+	SpeedCounts       map[string]int
+	StateErrors       []string
+	LinkComparisons   map[string]*PCIeLinkComparison
+	DowngradedDevices []string
+}
+
+// PCIeLinkComparison holds the link capability and link status of a single PCIe device.
+// CapWidth is 0 when lspci reports no LnkCap for the device.
+type PCIeLinkComparison struct {
+	CapSpeed string
+	CapWidth int
+	StaSpeed string
+	StaWidth int
+}
+
+// lspciDeviceHeaderRegex matches a PCI device header line of lspci output, e.g. "0f:00.0 3D controller: NVIDIA ..."
+var lspciDeviceHeaderRegex = regexp.MustCompile(`^[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-9a-fA-F]`)
+
+// lspciDeviceMatches returns whether a PCI device header line is a device of deviceType
+func lspciDeviceMatches(line string, deviceType string) bool {
+	lowerLine := strings.ToLower(line)
+	switch strings.ToLower(deviceType) {
+	case "nvidia", "gpu", "nvswitch":
+		return strings.Contains(lowerLine, "nvidia")
+	case "mellanox", "rdma":
+		return strings.Contains(lowerLine, "mellanox")
+	}
+	return false
 }
 
 // FilterLspciForDevice filters lspci output to extract LnkSta lines for specific device type
@@ -132,22 +169,11 @@ func FilterLspciForDevice(lspciOutput string, deviceType string) string {
 	var filteredLines []string
 	var deviceFound bool
 	
-	devicePattern := strings.ToLower(deviceType)
-	
 	for _, line := range lines {
 		// Check if this line is a PCI device header (starts with bus:device.function)
-		if regexp.MustCompile(`^[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-9a-fA-F]`).MatchString(line) {
-			// Reset device found flag
-			deviceFound = false
-			
+		if lspciDeviceHeaderRegex.MatchString(line) {
 			// Check if this device matches our target type
-			lowerLine := strings.ToLower(line)
-			switch devicePattern {
-			case "nvidia", "gpu", "nvswitch":
-				deviceFound = strings.Contains(lowerLine, "nvidia")
-			case "mellanox", "rdma":
-				deviceFound = strings.Contains(lowerLine, "mellanox")
-			}
+			deviceFound = lspciDeviceMatches(line, deviceType)
 		}
 		
 		// If we found a matching device and this line contains LnkSta, include it
@@ -159,6 +185,30 @@ func FilterLspciForDevice(lspciOutput string, deviceType string) string {
 	return strings.Join(filteredLines, "\n")
 }
 
+// FilterLspciLinksForDevice filters lspci output to extract the LnkCap and LnkSta lines of each device of
+// a specific device type, prefixed with the device address, e.g. "0f:00.0 LnkCap: Port #0, Speed 32GT/s, Width x16"
+func FilterLspciLinksForDevice(lspciOutput string, deviceType string) string {
+	var filteredLines []string
+	device := ""
+
+	for _, line := range strings.Split(lspciOutput, "\n") {
+		if lspciDeviceHeaderRegex.MatchString(line) {
+			device = ""
+			if lspciDeviceMatches(line, deviceType) {
+				device = strings.Fields(line)[0]
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if device != "" && (strings.HasPrefix(trimmed, "LnkCap:") || strings.HasPrefix(trimmed, "LnkSta:")) {
+			filteredLines = append(filteredLines, device+" "+trimmed)
+		}
+	}
+
+	return strings.Join(filteredLines, "\n")
+}
+
 // AggregateLinkStatistics aggregates LnkSta lines by counting identical entries
 func AggregateLinkStatistics(linkStatusLines string) string {
 	if strings.TrimSpace(linkStatusLines) == "" {
@@ -185,12 +235,15 @@ func AggregateLinkStatistics(linkStatusLines string) string {
 	return strings.Join(result, "\n")
 }
 
-// parseLspciWidthOutput parses lspci output to extract PCIe width, speed, and state information
+// parseLspciWidthOutput parses lspci output to extract PCIe width, speed, and state information.
+// Device LnkCap and LnkSta lines from FilterLspciLinksForDevice are compared to find devices whose
+// link is downgraded below its capability; devices without LnkCap are not compared.
 func parseLspciWidthOutput(output string, expectedLinkState string) PCIeParseResult {
 	result := PCIeParseResult{
-		WidthCounts: make(map[string]int),
-		SpeedCounts: make(map[string]int),
-		StateErrors: []string{},
+		WidthCounts:     make(map[string]int),
+		SpeedCounts:     make(map[string]int),
+		StateErrors:     []string{},
+		LinkComparisons: make(map[string]*PCIeLinkComparison),
 	}
 	
 	lines := strings.Split(strings.TrimSpace(output), "\n")
@@ -198,12 +251,31 @@ func parseLspciWidthOutput(output string, expectedLinkState string) PCIeParseRes
 	// Enhanced regex to capture count, speed with (ok), width with (ok)
 	// Example: "4       LnkSta: Speed 16GT/s (ok), Width x16 (ok)"
 	re := regexp.MustCompile(`^\s*(\d+)\s+LnkSta:\s*Speed\s+([^\s]+)\s*\(([^)]+)\),\s*Width\s+x(\d+)\s*\(([^)]+)\)`)
+
+	// Device link lines, e.g. "0f:00.0 LnkCap: Port #0, Speed 32GT/s, Width x16, ASPM not supported"
+	// and "0f:00.0 LnkSta: Speed 16GT/s (downgraded), Width x8 (downgraded)"
+	linkRe := regexp.MustCompile(`^([0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-9a-fA-F])\s+(LnkCap|LnkSta):.*?Speed\s+([0-9.]+GT/s).*?Width\s+x(\d+)`)
 	
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
 		}
+
+		if linkMatches := linkRe.FindStringSubmatch(line); linkMatches != nil {
+			comparison, exists := result.LinkComparisons[linkMatches[1]]
+			if !exists {
+				comparison = &PCIeLinkComparison{}
+				result.LinkComparisons[linkMatches[1]] = comparison
+			}
+			width, _ := strconv.Atoi(linkMatches[4])
+			if linkMatches[2] == "LnkCap" {
+				comparison.CapSpeed, comparison.CapWidth = linkMatches[3], width
+			} else {
+				comparison.StaSpeed, comparison.StaWidth = linkMatches[3], width
+			}
+			continue
+		}
 		
 		matches := re.FindStringSubmatch(line)
 		if len(matches) == 6 {
@@ -239,10 +311,41 @@ func parseLspciWidthOutput(output string, expectedLinkState string) PCIeParseRes
 			logger.Debugf("Parsed PCIe: %s (%s) = %d, %s (%s) = %d", widthKey, widthState, count, speedKey, speedState, count)
 		}
 	}
+
+	result.DowngradedDevices = findDowngradedLinks(result.LinkComparisons)
 	
 	return result
 }
 
+// findDowngradedLinks returns the devices whose link status width or speed is below the link capability,
+// sorted by device address. Devices missing LnkCap or LnkSta are skipped.
+func findDowngradedLinks(comparisons map[string]*PCIeLinkComparison) []string {
+	var devices []string
+	for device := range comparisons {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+
+	var downgraded []string
+	for _, device := range devices {
+		link := comparisons[device]
+		if link.CapWidth == 0 || link.StaWidth == 0 {
+			continue
+		}
+		if link.StaWidth < link.CapWidth || pcieSpeedGTs(link.StaSpeed) < pcieSpeedGTs(link.CapSpeed) {
+			downgraded = append(downgraded, fmt.Sprintf("%s (Speed %s Width x%d, capable of Speed %s Width x%d)",
+				device, link.StaSpeed, link.StaWidth, link.CapSpeed, link.CapWidth))
+		}
+	}
+	return downgraded
+}
+
+// pcieSpeedGTs converts a PCIe link speed such as "32GT/s" to GT/s
+func pcieSpeedGTs(speed string) float64 {
+	value, _ := strconv.ParseFloat(strings.TrimSuffix(speed, "GT/s"), 64)
+	return value
+}
+
 // checkGPUNVSwitchPCIeWidth checks PCIe width, speed, and state for GPU and NVSwitch interfaces
 func checkGPUNVSwitchPCIeWidth(expectedLinkState string) PCIeWidthResult {
 	logger.Info("Checking GPU/NVSwitch PCIe width, speed, and state...")
@@ -275,13 +378,17 @@ func checkGPUNVSwitchPCIeWidth(expectedLinkState string) PCIeWidthResult {
 	// Aggregate identical LnkSta lines with counts
 	aggregatedOutput := AggregateLinkStatistics(filteredOutput)
 	
-	parseResult := parseLspciWidthOutput(aggregatedOutput, expectedLinkState)
+	// Add the LnkCap and LnkSta lines of each device to compare link status against capability
+	linkOutput := FilterLspciLinksForDevice(result.Output, "nvidia")
+
+	parseResult := parseLspciWidthOutput(aggregatedOutput+"\n"+linkOutput, expectedLinkState)
 	
 	return PCIeWidthResult{
-		WidthCounts: parseResult.WidthCounts,
-		SpeedCounts: parseResult.SpeedCounts,
-		StateErrors: parseResult.StateErrors,
-		Success:     true,
+		WidthCounts:       parseResult.WidthCounts,
+		SpeedCounts:       parseResult.SpeedCounts,
+		StateErrors:       parseResult.StateErrors,
+		DowngradedDevices: parseResult.DowngradedDevices,
+		Success:           true,
 	}
 }
 
@@ -317,13 +424,17 @@ func checkRDMAPCIeWidth(expectedLinkState string) PCIeWidthResult {
 	// Aggregate identical LnkSta lines with counts
 	aggregatedOutput := AggregateLinkStatistics(filteredOutput)
 	
-	parseResult := parseLspciWidthOutput(aggregatedOutput, expectedLinkState)
+	// Add the LnkCap and LnkSta lines of each device to compare link status against capability
+	linkOutput := FilterLspciLinksForDevice(result.Output, "mellanox")
+
+	parseResult := parseLspciWidthOutput(aggregatedOutput+"\n"+linkOutput, expectedLinkState)
 	
 	return PCIeWidthResult{
-		WidthCounts: parseResult.WidthCounts,
-		SpeedCounts: parseResult.SpeedCounts,
-		StateErrors: parseResult.StateErrors,
-		Success:     true,
+		WidthCounts:       parseResult.WidthCounts,
+		SpeedCounts:       parseResult.SpeedCounts,
+		StateErrors:       parseResult.StateErrors,
+		DowngradedDevices: parseResult.DowngradedDevices,
+		Success:           true,
 	}
 }
 
@@ -371,7 +482,7 @@ func RunPCIeWidthMissingLanesCheck() error {
 	shape, err := executor.GetCurrentShape()
 	if err != nil {
 		logger.Error("PCIe Width Missing Lanes Check: FAIL - Could not get shape from IMDS:", err)
-		rep.AddPCIeWidthResult("FAIL", nil, nil, nil, nil, nil, err)
+		rep.AddPCIeWidthResult("FAIL", nil, nil, nil, nil, nil, nil, err)
 		return fmt.Errorf("failed to get shape from IMDS: %w", err)
 	}
 	logger.Info("Current shape from IMDS:", shape)
@@ -380,15 +491,15 @@ func RunPCIeWidthMissingLanesCheck() error {
 	config, err := getPcieWidthMissingLanesTestConfig(shape)
 	if err != nil {
 		logger.Error("PCIe Width Missing Lanes Check: FAIL - Could not load test configuration:", err)
-		rep.AddPCIeWidthResult("FAIL", nil, nil, nil, nil, nil, err)
+		rep.AddPCIeWidthResult("FAIL", nil, nil, nil, nil, nil, nil, err)
 		return fmt.Errorf("failed to load test configuration: %w", err)
 	}
 
 	if !config.IsEnabled {
 		errorMsg := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Error(errorMsg)
-		rep.AddPCIeWidthResult("SKIP", nil, nil, nil, nil, nil, fmt.Errorf(errorMsg))
-		return fmt.Errorf(errorMsg)
+		rep.AddPCIeWidthResult("SKIP", nil, nil, nil, nil, nil, nil, fmt.Errorf(errorMsg))
+		return &testerrors.TestDisabledError{TestName: "pcie_width_missing_lanes_check", Shape: shape}
 	}
 
 	// Step 3: Check GPU/NVSwitch PCIe width, speed, and state
@@ -401,6 +512,7 @@ func RunPCIeWidthMissingLanesCheck() error {
 	var allGPUSpeeds map[string]int
 	var allRDMASpeeds map[string]int
 	var allStateErrors []string
+	var allDowngradedDevices []string
 
 	if !gpuResult.Success {
 		errorMessages = append(errorMessages, fmt.Sprintf("GPU/NVSwitch: %s", gpuResult.ErrorMsg))
@@ -433,6 +545,12 @@ func RunPCIeWidthMissingLanesCheck() error {
 				errorMessages = append(errorMessages, fmt.Sprintf("GPU/NVSwitch state error: %s", stateError))
 			}
 		}
+
+		// Check GPU links downgraded below their capability
+		allDowngradedDevices = append(allDowngradedDevices, gpuResult.DowngradedDevices...)
+		if config.CheckLinkDowngrade && len(gpuResult.DowngradedDevices) > 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf("GPU/NVSwitch link downgraded: %s", strings.Join(gpuResult.DowngradedDevices, ", ")))
+		}
 	}
 
 	// Step 4: Check RDMA PCIe width, speed, and state
@@ -470,12 +588,18 @@ func RunPCIeWidthMissingLanesCheck() error {
 				errorMessages = append(errorMessages, fmt.Sprintf("RDMA state error: %s", stateError))
 			}
 		}
+
+		// Check RDMA links downgraded below their capability
+		allDowngradedDevices = append(allDowngradedDevices, rdmaResult.DowngradedDevices...)
+		if config.CheckLinkDowngrade && len(rdmaResult.DowngradedDevices) > 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf("RDMA link downgraded: %s", strings.Join(rdmaResult.DowngradedDevices, ", ")))
+		}
 	}
 
 	// Step 5: Generate final result
 	if len(errorMessages) == 0 {
 		logger.Info("PCIe Width Missing Lanes Check: PASS - All PCIe interfaces operating at expected width and speed")
-		rep.AddPCIeWidthResult("PASS", allGPUWidths, allRDMAWidths, allGPUSpeeds, allRDMASpeeds, allStateErrors, nil)
+		rep.AddPCIeWidthResult("PASS", allGPUWidths, allRDMAWidths, allGPUSpeeds, allRDMASpeeds, allStateErrors, allDowngradedDevices, nil)
 		return nil
 	} else {
 		// Combine error messages
@@ -483,7 +607,7 @@ func RunPCIeWidthMissingLanesCheck() error {
 		logger.Error("PCIe Width Missing Lanes Check: FAIL -", combinedError)
 		
 		err := fmt.Errorf(combinedError)
-		rep.AddPCIeWidthResult("FAIL", allGPUWidths, allRDMAWidths, allGPUSpeeds, allRDMASpeeds, allStateErrors, err)
+		rep.AddPCIeWidthResult("FAIL", allGPUWidths, allRDMAWidths, allGPUSpeeds, allRDMASpeeds, allStateErrors, allDowngradedDevices, err)
 		return err
 	}
 }
\ No newline at end of file
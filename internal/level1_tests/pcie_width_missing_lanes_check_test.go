@@ -3,6 +3,7 @@ package level1_tests
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -638,4 +639,68 @@ func TestAggregateLinkStatistics(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestFilterLspciLinksForDevice(t *testing.T) {
+	expected := "17:00.0 LnkCap:\tPort #0, Speed 16GT/s, Width x16, ASPM L0s L1, Exit Latency L0s <1us, L1 <4us\n" +
+		"17:00.0 LnkSta:\tSpeed 16GT/s (ok), Width x16 (ok)"
+
+	result := FilterLspciLinksForDevice(mockLspciFullOutput, "nvidia")
+	if result != expected {
+		t.Errorf("FilterLspciLinksForDevice() = %q, want %q", result, expected)
+	}
+
+	if result := FilterLspciLinksForDevice(mockLspciFullOutput, "amd"); result != "" {
+		t.Errorf("FilterLspciLinksForDevice() = %q, want empty output for no matching devices", result)
+	}
+}
+
+func TestParseLspciWidthOutputLinkDowngrade(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              string
+		expectedDowngraded []string
+	}{
+		{
+			name: "Link at capability",
+			input: `1	LnkSta:	Speed 32GT/s (ok), Width x16 (ok)
+0f:00.0 LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+0f:00.0 LnkSta:	Speed 32GT/s (ok), Width x16 (ok)`,
+			expectedDowngraded: nil,
+		},
+		{
+			name: "Width and speed below capability",
+			input: `1	LnkSta:	Speed 16GT/s (downgraded), Width x8 (downgraded)
+0f:00.0 LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+0f:00.0 LnkSta:	Speed 16GT/s (downgraded), Width x8 (downgraded)
+2d:00.0 LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+2d:00.0 LnkSta:	Speed 32GT/s (ok), Width x16 (ok)`,
+			expectedDowngraded: []string{"0f:00.0 (Speed 16GT/s Width x8, capable of Speed 32GT/s Width x16)"},
+		},
+		{
+			name: "Speed below capability",
+			input: `2d:00.0 LnkCap:	Port #0, Speed 32GT/s, Width x16, ASPM not supported
+2d:00.0 LnkSta:	Speed 2.5GT/s (downgraded), Width x16 (ok)`,
+			expectedDowngraded: []string{"2d:00.0 (Speed 2.5GT/s Width x16, capable of Speed 32GT/s Width x16)"},
+		},
+		{
+			name:               "LnkCap absent",
+			input:              "0f:00.0 LnkSta:\tSpeed 16GT/s (downgraded), Width x8 (downgraded)",
+			expectedDowngraded: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseLspciWidthOutput(tt.input, "ok")
+			if !reflect.DeepEqual(result.DowngradedDevices, tt.expectedDowngraded) {
+				t.Errorf("DowngradedDevices = %v, want %v", result.DowngradedDevices, tt.expectedDowngraded)
+			}
+		})
+	}
+
+	// Device link lines are not counted as aggregated LnkSta lines
+	result := parseLspciWidthOutput(tests[1].input, "ok")
+	if len(result.StateErrors) != 2 || len(result.WidthCounts) != 0 {
+		t.Errorf("parseLspciWidthOutput() counted device link lines: %+v", result)
+	}
+}
@@ -1,10 +1,10 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -42,7 +42,7 @@ func checkPeermemModuleLoaded() (bool, error) {
 	// Run lsmod command to get loaded modules
 	result, err := executor.RunLsmod()
 	if err != nil {
-		return false, fmt.Errorf("failed to run lsmod: %w", err)
+		return false, fmt.Errorf("failed to run lsmod: %w", commandError("peermem_module_check", result, err))
 	}
 
 	// Check if nvidia_peermem module is in the output
@@ -110,7 +110,7 @@ func RunPeermemModuleCheck() error {
 	if !peermemModuleCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Error(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "peermem_module_check", Shape: shape}
 	}
 
 	// Step 3: Check if nvidia_peermem module is loaded
@@ -0,0 +1,245 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// rdmaCreditCounters are the perfquery counters that must stay zero on InfiniBand ports
+var rdmaCreditCounters = []string{"PortRcvRemotePhysicalErrors", "PortRcvSwitchRelayErrors"}
+
+var (
+	// Matches perfquery counter lines such as "PortRcvSwitchRelayErrors:........0"
+	perfqueryCounterRegex = regexp.MustCompile(`^(\w+):\.*(\d+)$`)
+	// Matches per-priority receive buffer overflow counters of ethtool -S, e.g. "rx_prio3_buf_discard: 0"
+	bufferDiscardRegex = regexp.MustCompile(`^(rx_prio\d+_buf_discard):\s*(\d+)$`)
+)
+
+// RDMACreditCheckTestConfig represents the config needed to run this test
+type RDMACreditCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// RDMAPortCreditState represents the credit and buffer overflow counters of a single RDMA port
+type RDMAPortCreditState struct {
+	Device    string           `json:"device"`
+	Port      int              `json:"port"`
+	LinkLayer string           `json:"link_layer"`
+	Interface string           `json:"interface,omitempty"`
+	Counters  map[string]int64 `json:"counters"`
+	Status    string           `json:"status"`
+}
+
+// getRDMACreditCheckTestConfig gets test config needed to run this test
+func getRDMACreditCheckTestConfig() (*RDMACreditCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	rdmaCreditCheckTestConfig := &RDMACreditCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "rdma_credit_check")
+	if err != nil {
+		return nil, err
+	}
+	rdmaCreditCheckTestConfig.IsEnabled = enabled
+
+	return rdmaCreditCheckTestConfig, nil
+}
+
+// parsePerfqueryCounters parses the credit related error counters from perfquery output
+func parsePerfqueryCounters(output string) map[string]int64 {
+	counters := make(map[string]int64)
+	for _, line := range strings.Split(output, "\n") {
+		match := perfqueryCounterRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		for _, name := range rdmaCreditCounters {
+			if match[1] == name {
+				counters[name], _ = strconv.ParseInt(match[2], 10, 64)
+			}
+		}
+	}
+	return counters
+}
+
+// parseBufferDiscards parses the per-priority receive buffer overflow counters from ethtool -S output
+func parseBufferDiscards(output string) map[string]int64 {
+	counters := make(map[string]int64)
+	for _, line := range strings.Split(output, "\n") {
+		if match := bufferDiscardRegex.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			counters[match[1]], _ = strconv.ParseInt(match[2], 10, 64)
+		}
+	}
+	return counters
+}
+
+// validateRDMACredits marks ports with any nonzero counter as FAIL
+func validateRDMACredits(ports []RDMAPortCreditState) error {
+	if len(ports) == 0 {
+		return fmt.Errorf("no active RDMA ports found")
+	}
+
+	var failures []string
+	for i := range ports {
+		names := make([]string, 0, len(ports[i].Counters))
+		for name := range ports[i].Counters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		ports[i].Status = "PASS"
+		for _, name := range names {
+			if value := ports[i].Counters[name]; value != 0 {
+				ports[i].Status = "FAIL"
+				failures = append(failures, fmt.Sprintf("%s/%d %s=%d", ports[i].Device, ports[i].Port, name, value))
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// getIBPortCredits reads the credit error counters of an InfiniBand port with perfquery.
+// The extended counters are tried first; older firmware only reports them in the base counters.
+func getIBPortCredits(device string, port int) (map[string]int64, error) {
+	result, err := executor.RunPerfquery(device, port, "-x")
+	if err != nil {
+		return nil, fmt.Errorf("perfquery failed: %w", commandError("rdma_credit_check", result, err))
+	}
+	counters := parsePerfqueryCounters(result.Output)
+	if len(counters) == len(rdmaCreditCounters) {
+		return counters, nil
+	}
+
+	result, err = executor.RunPerfquery(device, port)
+	if err != nil {
+		return nil, fmt.Errorf("perfquery failed: %w", commandError("rdma_credit_check", result, err))
+	}
+	counters = parsePerfqueryCounters(result.Output)
+	if len(counters) != len(rdmaCreditCounters) {
+		return nil, fmt.Errorf("credit error counters not found in perfquery output for %s port %d", device, port)
+	}
+	return counters, nil
+}
+
+// getRoCEPortCredits reads the per-priority receive buffer overflow counters of a RoCE interface
+func getRoCEPortCredits(interfaceName string) (map[string]int64, error) {
+	result, err := executor.RunEthtoolStats(interfaceName, "")
+	if err != nil {
+		return nil, fmt.Errorf("ethtool failed: %w", commandError("rdma_credit_check", result, err))
+	}
+	counters := parseBufferDiscards(result.Output)
+	if len(counters) == 0 {
+		return nil, fmt.Errorf("priority buffer counters not found in ethtool output for %s", interfaceName)
+	}
+	return counters, nil
+}
+
+// getRDMACreditStates collects the credit counters of every active RDMA port
+func getRDMACreditStates() ([]RDMAPortCreditState, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("rdma_credit_check", result, err))
+	}
+
+	var netdevs map[string]string
+	var states []RDMAPortCreditState
+	for _, port := range parseIbstatPorts(result.Output) {
+		if !port.Active {
+			logger.Infof("Skipping %s port %d in state %s", port.Device, port.Port, port.State)
+			continue
+		}
+
+		state := RDMAPortCreditState{Device: port.Device, Port: port.Port, LinkLayer: port.LinkLayer}
+		if port.LinkLayer == "InfiniBand" {
+			state.Counters, err = getIBPortCredits(port.Device, port.Port)
+		} else {
+			// RoCE ports have no InfiniBand credit counters, check priority buffer overflows instead
+			if netdevs == nil {
+				if netdevs, err = executor.GetIbdevToNetdevMap(); err != nil {
+					return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+				}
+			}
+			state.Interface = netdevs[port.Device]
+			if state.Interface == "" {
+				return nil, fmt.Errorf("no network interface found for %s", port.Device)
+			}
+			state.Counters, err = getRoCEPortCredits(state.Interface)
+		}
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+func RunRDMACreditCheck() error {
+	logger.Info("=== RDMA Credit Check ===")
+	testConfig, err := getRDMACreditCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_credit_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RDMA credit flow check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read credit and buffer overflow counters of each port
+	logger.Info("Step 1: Reading RDMA credit and buffer overflow counters...")
+	ports, err := getRDMACreditStates()
+	if err != nil {
+		logger.Error("RDMA Credit Check: FAIL - Could not read RDMA counters:", err)
+		rep.AddRDMACreditResult("FAIL", nil, err)
+		return fmt.Errorf("could not read RDMA counters: %w", err)
+	}
+
+	// Step 2: All counters must be zero
+	logger.Info("Step 2: Validating RDMA credit counters...")
+	err = validateRDMACredits(ports)
+	for _, port := range ports {
+		logger.Infof("%s port %d (%s): %v - %s", port.Device, port.Port, port.LinkLayer, port.Counters, port.Status)
+	}
+	if err != nil {
+		logger.Error("RDMA Credit Check: FAIL -", err)
+		rep.AddRDMACreditResult("FAIL", ports, err)
+		return err
+	}
+
+	logger.Info("RDMA Credit Check: PASS - No credit errors or buffer overflows on RDMA ports")
+	rep.AddRDMACreditResult("PASS", ports, nil)
+	return nil
+}
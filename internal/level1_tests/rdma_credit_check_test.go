@@ -0,0 +1,67 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testPerfqueryExtendedOutput = `# Port extended counters: Lid 1 port 1 (CapMask: 0x5A00)
+PortSelect:......................1
+CounterSelect:...................0x0000
+PortXmitData:....................4235620980
+PortRcvData:.....................4235626130
+PortRcvRemotePhysicalErrors:.....0
+PortRcvSwitchRelayErrors:........7
+`
+
+const testEthtoolBufferOutput = `NIC statistics:
+     rx_packets: 1000
+     rx_prio0_buf_discard: 0
+     rx_prio3_buf_discard: 12
+     rx_prio3_cong_discard: 0
+`
+
+// Test parsePerfqueryCounters function
+func TestParsePerfqueryCounters(t *testing.T) {
+	counters := parsePerfqueryCounters(testPerfqueryExtendedOutput)
+	expected := map[string]int64{"PortRcvRemotePhysicalErrors": 0, "PortRcvSwitchRelayErrors": 7}
+	if !reflect.DeepEqual(counters, expected) {
+		t.Errorf("parsePerfqueryCounters() = %v, want %v", counters, expected)
+	}
+
+	if counters := parsePerfqueryCounters("PortXmitData:....4235620980\n"); len(counters) != 0 {
+		t.Errorf("parsePerfqueryCounters() = %v, want no counters", counters)
+	}
+}
+
+// Test parseBufferDiscards function
+func TestParseBufferDiscards(t *testing.T) {
+	counters := parseBufferDiscards(testEthtoolBufferOutput)
+	expected := map[string]int64{"rx_prio0_buf_discard": 0, "rx_prio3_buf_discard": 12}
+	if !reflect.DeepEqual(counters, expected) {
+		t.Errorf("parseBufferDiscards() = %v, want %v", counters, expected)
+	}
+}
+
+// Test validateRDMACredits function
+func TestValidateRDMACredits(t *testing.T) {
+	ports := []RDMAPortCreditState{
+		{Device: "mlx5_0", Port: 1, LinkLayer: "InfiniBand", Counters: map[string]int64{"PortRcvRemotePhysicalErrors": 0, "PortRcvSwitchRelayErrors": 0}},
+		{Device: "mlx5_1", Port: 1, LinkLayer: "Ethernet", Interface: "rdma0", Counters: map[string]int64{"rx_prio3_buf_discard": 12}},
+	}
+
+	err := validateRDMACredits(ports)
+	if err == nil || err.Error() != "mlx5_1/1 rx_prio3_buf_discard=12" {
+		t.Errorf("validateRDMACredits() error = %v, want mlx5_1/1 rx_prio3_buf_discard=12", err)
+	}
+	if ports[0].Status != "PASS" || ports[1].Status != "FAIL" {
+		t.Errorf("validateRDMACredits() statuses = %s, %s, want PASS, FAIL", ports[0].Status, ports[1].Status)
+	}
+
+	if err := validateRDMACredits(ports[:1]); err != nil {
+		t.Errorf("validateRDMACredits() error = %v, want nil", err)
+	}
+	if err := validateRDMACredits(nil); err == nil {
+		t.Error("Expected error when no active RDMA ports are found")
+	}
+}
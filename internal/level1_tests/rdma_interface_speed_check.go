@@ -0,0 +1,201 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// defaultRDMAInterfaceSpeed is the expected speed of RoCE interfaces on GPU shapes
+const defaultRDMAInterfaceSpeed = "100000Mb/s"
+
+// ethtoolSpeedRegex matches the speed in ethtool output, e.g. "Speed: 100000Mb/s" or "Speed: Unknown!"
+var ethtoolSpeedRegex = regexp.MustCompile(`(?m)^\s*Speed:\s*(\S+)`)
+
+// linkSpeedRegex matches a link speed in Mb/s, e.g. "100000Mb/s"
+var linkSpeedRegex = regexp.MustCompile(`^(\d+)Mb/s$`)
+
+// RDMAInterfaceSpeedCheckTestConfig represents the config needed to run this test
+type RDMAInterfaceSpeedCheckTestConfig struct {
+	IsEnabled     bool   `json:"enabled"`
+	Shape         string `json:"shape"`
+	ExpectedSpeed string `json:"expected_speed"`
+}
+
+// RDMAInterfaceSpeed represents the ethtool speed of the network interface of a single RDMA device
+type RDMAInterfaceSpeed struct {
+	Device        string `json:"device"`
+	Interface     string `json:"interface"`
+	CurrentSpeed  string `json:"current_speed"`
+	ExpectedSpeed string `json:"expected_speed"`
+	Status        string `json:"status"`
+}
+
+// getRDMAInterfaceSpeedCheckTestConfig gets test config needed to run this test
+func getRDMAInterfaceSpeedCheckTestConfig() (*RDMAInterfaceSpeedCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	rdmaInterfaceSpeedCheckTestConfig := &RDMAInterfaceSpeedCheckTestConfig{
+		IsEnabled:     false,
+		Shape:         shape,
+		ExpectedSpeed: defaultRDMAInterfaceSpeed,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "rdma_interface_speed_check")
+	if err != nil {
+		return nil, err
+	}
+	rdmaInterfaceSpeedCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "rdma_interface_speed_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if speed, ok := thresholdMap["expected_speed"].(string); ok && speed != "" {
+				rdmaInterfaceSpeedCheckTestConfig.ExpectedSpeed = speed
+			}
+		}
+	}
+
+	return rdmaInterfaceSpeedCheckTestConfig, nil
+}
+
+// parseEthtoolSpeed parses the speed from ethtool output. A link that is down reports "Unknown!".
+func parseEthtoolSpeed(output string) (string, error) {
+	match := ethtoolSpeedRegex.FindStringSubmatch(output)
+	if match == nil {
+		return "", fmt.Errorf("speed not found in ethtool output")
+	}
+	return match[1], nil
+}
+
+// parseLinkSpeedMbps converts a link speed such as "100000Mb/s" to Mb/s.
+// Unknown speeds are 0, so they are below any expected speed.
+func parseLinkSpeedMbps(speed string) int {
+	match := linkSpeedRegex.FindStringSubmatch(strings.TrimSpace(speed))
+	if match == nil {
+		return 0
+	}
+	mbps, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	return mbps
+}
+
+// validateRDMAInterfaceSpeeds marks interfaces with a speed below the expected speed as FAIL
+// and returns the names of those interfaces
+func validateRDMAInterfaceSpeeds(interfaces []RDMAInterfaceSpeed) []string {
+	var failed []string
+	for i := range interfaces {
+		interfaces[i].Status = "PASS"
+		if parseLinkSpeedMbps(interfaces[i].CurrentSpeed) < parseLinkSpeedMbps(interfaces[i].ExpectedSpeed) {
+			interfaces[i].Status = "FAIL"
+			failed = append(failed, interfaces[i].Interface)
+		}
+	}
+	return failed
+}
+
+// getRDMAInterfaceSpeeds reads the ethtool speed of the network interface of each RDMA device
+func getRDMAInterfaceSpeeds(testConfig *RDMAInterfaceSpeedCheckTestConfig) ([]RDMAInterfaceSpeed, error) {
+	netdevs, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+	}
+
+	devices := make([]string, 0, len(netdevs))
+	for device := range netdevs {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+
+	var interfaces []RDMAInterfaceSpeed
+	for _, device := range devices {
+		interfaceName := netdevs[device]
+		result, err := executor.RunEthtool(interfaceName)
+		if err != nil {
+			return nil, fmt.Errorf("ethtool failed: %w", commandError("rdma_interface_speed_check", result, err))
+		}
+		speed, err := parseEthtoolSpeed(result.Output)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", interfaceName, err)
+		}
+
+		interfaces = append(interfaces, RDMAInterfaceSpeed{
+			Device:        device,
+			Interface:     interfaceName,
+			CurrentSpeed:  speed,
+			ExpectedSpeed: testConfig.ExpectedSpeed,
+		})
+	}
+
+	if len(interfaces) == 0 {
+		return nil, fmt.Errorf("no RDMA network interfaces found")
+	}
+	return interfaces, nil
+}
+
+func RunRDMAInterfaceSpeedCheck() error {
+	logger.Info("=== RDMA Interface Speed Check ===")
+	testConfig, err := getRDMAInterfaceSpeedCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_interface_speed_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RDMA interface speed check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the speed of each RDMA network interface
+	logger.Info("Step 1: Reading speed of RDMA network interfaces...")
+	interfaces, err := getRDMAInterfaceSpeeds(testConfig)
+	if err != nil {
+		logger.Error("RDMA Interface Speed Check: FAIL - Could not read RDMA interface speeds:", err)
+		rep.AddRDMAInterfaceSpeedResult("FAIL", nil, nil, err)
+		return fmt.Errorf("could not read RDMA interface speeds: %w", err)
+	}
+
+	// Step 2: Compare against the expected speed
+	logger.Infof("Step 2: Validating speeds (expected: %s)...", testConfig.ExpectedSpeed)
+	failed := validateRDMAInterfaceSpeeds(interfaces)
+	for _, iface := range interfaces {
+		logger.Infof("%s (%s): speed %s, expected %s - %s",
+			iface.Interface, iface.Device, iface.CurrentSpeed, iface.ExpectedSpeed, iface.Status)
+	}
+
+	if len(failed) > 0 {
+		err = fmt.Errorf("speed below %s on interface(s): %s", testConfig.ExpectedSpeed, strings.Join(failed, ", "))
+		logger.Error("RDMA Interface Speed Check: FAIL -", err)
+		rep.AddRDMAInterfaceSpeedResult("FAIL", interfaces, failed, err)
+		return err
+	}
+
+	logger.Info("RDMA Interface Speed Check: PASS - All RDMA interfaces run at the expected speed")
+	rep.AddRDMAInterfaceSpeedResult("PASS", interfaces, nil, nil)
+	return nil
+}
@@ -0,0 +1,51 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseEthtoolSpeed function
+func TestParseEthtoolSpeed(t *testing.T) {
+	output := `Settings for rdma0:
+	Supported ports: [ FIBRE ]
+	Speed: 100000Mb/s
+	Duplex: Full
+	Link detected: yes
+`
+	if speed, err := parseEthtoolSpeed(output); err != nil || speed != "100000Mb/s" {
+		t.Errorf("parseEthtoolSpeed() = %q, %v, want 100000Mb/s", speed, err)
+	}
+	if speed, err := parseEthtoolSpeed("Settings for rdma1:\n\tSpeed: Unknown!\n"); err != nil || speed != "Unknown!" {
+		t.Errorf("parseEthtoolSpeed() = %q, %v, want Unknown!", speed, err)
+	}
+	if _, err := parseEthtoolSpeed("Settings for rdma2:\n"); err == nil {
+		t.Error("Expected error for ethtool output without a speed")
+	}
+}
+
+// Test parseLinkSpeedMbps function
+func TestParseLinkSpeedMbps(t *testing.T) {
+	for speed, want := range map[string]int{"100000Mb/s": 100000, "50000Mb/s": 50000, "Unknown!": 0, "": 0} {
+		if got := parseLinkSpeedMbps(speed); got != want {
+			t.Errorf("parseLinkSpeedMbps(%q) = %d, want %d", speed, got, want)
+		}
+	}
+}
+
+// Test validateRDMAInterfaceSpeeds function
+func TestValidateRDMAInterfaceSpeeds(t *testing.T) {
+	interfaces := []RDMAInterfaceSpeed{
+		{Interface: "rdma0", CurrentSpeed: "100000Mb/s", ExpectedSpeed: "100000Mb/s"},
+		{Interface: "rdma1", CurrentSpeed: "200000Mb/s", ExpectedSpeed: "100000Mb/s"},
+		{Interface: "rdma2", CurrentSpeed: "50000Mb/s", ExpectedSpeed: "100000Mb/s"},
+		{Interface: "rdma3", CurrentSpeed: "Unknown!", ExpectedSpeed: "100000Mb/s"},
+	}
+
+	failed := validateRDMAInterfaceSpeeds(interfaces)
+	if len(failed) != 2 || failed[0] != "rdma2" || failed[1] != "rdma3" {
+		t.Errorf("validateRDMAInterfaceSpeeds() = %v, want rdma2 and rdma3", failed)
+	}
+	if interfaces[0].Status != "PASS" || interfaces[1].Status != "PASS" || interfaces[2].Status != "FAIL" {
+		t.Errorf("Unexpected statuses %+v", interfaces)
+	}
+}
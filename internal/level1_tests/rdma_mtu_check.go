@@ -0,0 +1,201 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// ipLinkMTURegex matches the MTU in ip link show output, e.g. "2: rdma0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 9000 qdisc mq"
+var ipLinkMTURegex = regexp.MustCompile(`\bmtu\s+(\d+)\b`)
+
+// RDMAMTUCheckTestConfig represents the config needed to run this test.
+// IBMTU applies to interfaces of InfiniBand ports and RoCEMTU to interfaces of Ethernet ports.
+type RDMAMTUCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+	IBMTU     int    `json:"ib_mtu"`
+	RoCEMTU   int    `json:"roce_mtu"`
+}
+
+// RDMAInterfaceMTU represents the MTU of the network interface of a single RDMA device
+type RDMAInterfaceMTU struct {
+	Device      string `json:"device"`
+	Interface   string `json:"interface"`
+	LinkLayer   string `json:"link_layer"`
+	CurrentMTU  int    `json:"current_mtu"`
+	ExpectedMTU int    `json:"expected_mtu"`
+	Status      string `json:"status"`
+}
+
+// getRDMAMTUCheckTestConfig gets test config needed to run this test
+func getRDMAMTUCheckTestConfig() (*RDMAMTUCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	rdmaMTUCheckTestConfig := &RDMAMTUCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+		IBMTU:     4200,
+		RoCEMTU:   9000,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "rdma_mtu_check")
+	if err != nil {
+		return nil, err
+	}
+	rdmaMTUCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "rdma_mtu_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if mtu, ok := thresholdMap["ib_mtu"].(float64); ok {
+				rdmaMTUCheckTestConfig.IBMTU = int(mtu)
+			}
+			if mtu, ok := thresholdMap["roce_mtu"].(float64); ok {
+				rdmaMTUCheckTestConfig.RoCEMTU = int(mtu)
+			}
+		}
+	}
+
+	return rdmaMTUCheckTestConfig, nil
+}
+
+// parseIPLinkMTU parses the MTU from ip link show output
+func parseIPLinkMTU(output string) (int, error) {
+	match := ipLinkMTURegex.FindStringSubmatch(output)
+	if match == nil {
+		return 0, fmt.Errorf("MTU not found in ip link output")
+	}
+	return strconv.Atoi(match[1])
+}
+
+// validateRDMAMTUs marks interfaces with an MTU below the expected value as FAIL
+// and returns the names of those interfaces
+func validateRDMAMTUs(interfaces []RDMAInterfaceMTU) []string {
+	var failed []string
+	for i := range interfaces {
+		interfaces[i].Status = "PASS"
+		if interfaces[i].CurrentMTU < interfaces[i].ExpectedMTU {
+			interfaces[i].Status = "FAIL"
+			failed = append(failed, interfaces[i].Interface)
+		}
+	}
+	return failed
+}
+
+// getRDMAInterfaceMTUs reads the MTU of the network interface of each RDMA device
+func getRDMAInterfaceMTUs(testConfig *RDMAMTUCheckTestConfig) ([]RDMAInterfaceMTU, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("rdma_mtu_check", result, err))
+	}
+
+	netdevs, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+	}
+
+	var interfaces []RDMAInterfaceMTU
+	seen := map[string]bool{}
+	for _, port := range parseIbstatPorts(result.Output) {
+		interfaceName := netdevs[port.Device]
+		if interfaceName == "" {
+			return nil, fmt.Errorf("no network interface found for %s", port.Device)
+		}
+		if seen[interfaceName] {
+			continue
+		}
+		seen[interfaceName] = true
+
+		expectedMTU := testConfig.RoCEMTU
+		if port.LinkLayer == "InfiniBand" {
+			expectedMTU = testConfig.IBMTU
+		}
+
+		linkResult, err := executor.RunIPLinkShow(interfaceName)
+		if err != nil {
+			return nil, fmt.Errorf("ip link show failed: %w", commandError("rdma_mtu_check", linkResult, err))
+		}
+		mtu, err := parseIPLinkMTU(linkResult.Output)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", interfaceName, err)
+		}
+
+		interfaces = append(interfaces, RDMAInterfaceMTU{
+			Device:      port.Device,
+			Interface:   interfaceName,
+			LinkLayer:   port.LinkLayer,
+			CurrentMTU:  mtu,
+			ExpectedMTU: expectedMTU,
+		})
+	}
+
+	if len(interfaces) == 0 {
+		return nil, fmt.Errorf("no RDMA network interfaces found")
+	}
+	return interfaces, nil
+}
+
+func RunRDMAMTUCheck() error {
+	logger.Info("=== RDMA MTU Check ===")
+	testConfig, err := getRDMAMTUCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_mtu_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RDMA interface MTU check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the MTU of each RDMA network interface
+	logger.Info("Step 1: Reading MTU of RDMA network interfaces...")
+	interfaces, err := getRDMAInterfaceMTUs(testConfig)
+	if err != nil {
+		logger.Error("RDMA MTU Check: FAIL - Could not read RDMA interface MTUs:", err)
+		rep.AddRDMAMTUResult("FAIL", nil, nil, err)
+		return fmt.Errorf("could not read RDMA interface MTUs: %w", err)
+	}
+
+	// Step 2: Compare against the expected MTU of the link layer
+	logger.Infof("Step 2: Validating MTUs (InfiniBand: %d, RoCE: %d)...", testConfig.IBMTU, testConfig.RoCEMTU)
+	failed := validateRDMAMTUs(interfaces)
+	for _, iface := range interfaces {
+		logger.Infof("%s (%s, %s): MTU %d, expected %d - %s",
+			iface.Interface, iface.Device, iface.LinkLayer, iface.CurrentMTU, iface.ExpectedMTU, iface.Status)
+	}
+
+	if len(failed) > 0 {
+		err = fmt.Errorf("MTU below expected value on interface(s): %s", strings.Join(failed, ", "))
+		logger.Error("RDMA MTU Check: FAIL -", err)
+		rep.AddRDMAMTUResult("FAIL", interfaces, failed, err)
+		return err
+	}
+
+	logger.Info("RDMA MTU Check: PASS - All RDMA interfaces have the expected MTU")
+	rep.AddRDMAMTUResult("PASS", interfaces, nil, nil)
+	return nil
+}
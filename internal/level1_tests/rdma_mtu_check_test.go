@@ -0,0 +1,66 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseIPLinkMTU function
+func TestParseIPLinkMTU(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedMTU int
+		expectError bool
+	}{
+		{
+			name:        "RoCE interface with jumbo frames",
+			input:       "5: rdma0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 9000 qdisc mq state UP mode DEFAULT group default qlen 1000\n    link/ether 0c:42:a1:00:00:01 brd ff:ff:ff:ff:ff:ff\n",
+			expectedMTU: 9000,
+		},
+		{
+			name:        "IPoIB interface",
+			input:       "7: ib0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 4092 qdisc mq state UP mode DEFAULT group default qlen 256\n    link/infiniband 00:00:10:29:fe:80:00:00:00:00:00:00 brd 00:ff:ff:ff:ff:12:40:1b\n",
+			expectedMTU: 4092,
+		},
+		{
+			name:        "No MTU",
+			input:       "Device \"rdma9\" does not exist.\n",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mtu, err := parseIPLinkMTU(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseIPLinkMTU() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if mtu != tt.expectedMTU {
+				t.Errorf("parseIPLinkMTU() = %d, want %d", mtu, tt.expectedMTU)
+			}
+		})
+	}
+}
+
+// Test validateRDMAMTUs function
+func TestValidateRDMAMTUs(t *testing.T) {
+	interfaces := []RDMAInterfaceMTU{
+		{Device: "mlx5_0", Interface: "rdma0", LinkLayer: "Ethernet", CurrentMTU: 9000, ExpectedMTU: 9000},
+		{Device: "mlx5_1", Interface: "rdma1", LinkLayer: "Ethernet", CurrentMTU: 1500, ExpectedMTU: 9000},
+		{Device: "mlx5_2", Interface: "ib0", LinkLayer: "InfiniBand", CurrentMTU: 4092, ExpectedMTU: 4200},
+		{Device: "mlx5_3", Interface: "ib1", LinkLayer: "InfiniBand", CurrentMTU: 65520, ExpectedMTU: 4200},
+	}
+
+	failed := validateRDMAMTUs(interfaces)
+	if !reflect.DeepEqual(failed, []string{"rdma1", "ib0"}) {
+		t.Errorf("validateRDMAMTUs() = %v, want [rdma1 ib0]", failed)
+	}
+
+	expectedStatuses := []string{"PASS", "FAIL", "FAIL", "PASS"}
+	for i, iface := range interfaces {
+		if iface.Status != expectedStatuses[i] {
+			t.Errorf("%s status = %s, want %s", iface.Interface, iface.Status, expectedStatuses[i])
+		}
+	}
+}
@@ -2,16 +2,17 @@ package level1_tests
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 	"os"
 	"strings"
 
 	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/shapes"
 )
 
 // RDMANic represents an RDMA NIC configuration in shapes.json
@@ -129,8 +130,11 @@ type ShapesConfigRDMA struct {
 	HPCShapes    []ShapeHardwareRDMA `json:"hpc-shapes"`
 }
 
+// RdmaNicsCountTestConfig represents the config needed to run this test.
+// RDMANICBDFs lists the expected RDMA NIC BDFs; when empty the PCI IDs from shapes.json are used.
 type RdmaNicsCountTestConfig struct {
-	IsEnabled bool `json:"enabled"`
+	IsEnabled   bool     `json:"enabled"`
+	RDMANICBDFs []string `json:"rdma_nic_bdfs"`
 }
 
 // Gets test config needed to run this test
@@ -151,6 +155,15 @@ func getRdmaNicsCountTestConfig(shape string) (*RdmaNicsCountTestConfig, error)
 		return nil, err
 	}
 	rdmaNicsCountTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "rdma_nic_count")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			rdmaNicsCountTestConfig.RDMANICBDFs = bdfList(thresholdMap["rdma_nic_bdfs"])
+		}
+	}
+
 	return rdmaNicsCountTestConfig, nil
 }
 
@@ -215,6 +228,75 @@ func getActualRDMANicCount(expectedPCIIDs []string) (int, error) {
 	return detectedNics, nil
 }
 
+// getVCNNicPCIIDs returns the PCI IDs of the VCN NICs of the shape from shapes.json
+func getVCNNicPCIIDs(shapeName string) ([]string, error) {
+	shapeManager, err := shapes.NewShapeManager(config.GetShapesFilePath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shapes configuration: %w", err)
+	}
+
+	vcnNics, err := shapeManager.GetVCNNics(shapeName)
+	if err != nil {
+		return nil, err
+	}
+	var pciIDs []string
+	for _, nic := range vcnNics {
+		pciIDs = append(pciIDs, strings.ToLower(nic.PCI))
+	}
+	return pciIDs, nil
+}
+
+// getDiscoveredRDMANicBDFs returns the BDFs of the RDMA devices reported by ibstat
+func getDiscoveredRDMANicBDFs() ([]string, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("rdma_nics_count", result, err))
+	}
+
+	addresses, err := executor.GetRDMADevicePCIAddresses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve RDMA device PCI addresses: %w", err)
+	}
+
+	var bdfs []string
+	for _, port := range parseIbstatPorts(result.Output) {
+		bdf, ok := addresses[port.Device]
+		if !ok {
+			logger.Errorf("No PCI address found for RDMA device %s", port.Device)
+			continue
+		}
+		logger.Debugf("Found RDMA NIC %s at %s", port.Device, bdf)
+		bdfs = append(bdfs, bdf)
+	}
+	return sortedUnique(bdfs), nil
+}
+
+// compareRDMANicBDFs compares the expected RDMA NIC BDFs with the BDFs discovered on the host.
+// VCN NICs are Mellanox devices too and show up in ibstat, so they are never reported as extra.
+func compareRDMANicBDFs(expected []string, discovered []string, vcnPCIIDs []string) *RDMATopology {
+	normalized := make([]string, 0, len(expected))
+	for _, bdf := range expected {
+		normalized = append(normalized, strings.ToLower(bdf))
+	}
+	return compareRDMATopology(normalized, discovered, vcnPCIIDs)
+}
+
+// validateRDMANicBDFs FAILs when an expected RDMA NIC is missing and WARNs when unexpected
+// RDMA NICs are found
+func validateRDMANicBDFs(devices *RDMATopology) (string, error) {
+	if len(devices.Missing) > 0 {
+		err := fmt.Errorf("missing RDMA NICs: %s", strings.Join(devices.Missing, ", "))
+		if len(devices.Extra) > 0 {
+			err = fmt.Errorf("%w; unexpected RDMA NICs: %s", err, strings.Join(devices.Extra, ", "))
+		}
+		return "FAIL", err
+	}
+	if len(devices.Extra) > 0 {
+		return "WARN", fmt.Errorf("unexpected RDMA NICs: %s", strings.Join(devices.Extra, ", "))
+	}
+	return "PASS", nil
+}
+
 // RDMANicsCountResult represents the result of RDMA NIC count check
 type RDMANicsCountResult struct {
 	NumRDMANics int    `json:"num_rdma_nics"`
@@ -260,7 +342,7 @@ func GetRDMANicsCountResult() (*RDMANicsCountResult, error) {
 		return &RDMANicsCountResult{
 			NumRDMANics: actualCount,
 			Status:      "FAIL",
-		}, fmt.Errorf("RDMA NIC count mismatch: expected %d, actual %d", expectedCount, actualCount)
+		}, &testerrors.TestThresholdExceededError{TestName: "rdma_nics_count", Metric: "num_rdma_nics", Actual: actualCount, Expected: expectedCount}
 	}
 }
 
@@ -273,7 +355,7 @@ func RunRDMANicsCount() error {
 	shape, err := executor.GetCurrentShape()
 	if err != nil {
 		logger.Error("RDMA NIC Count Check: FAIL - Could not get shape from IMDS:", err)
-		rep.AddRDMAResult("FAIL", 0, err)
+		rep.AddRDMAResult("FAIL", 0, nil, nil, err)
 		return fmt.Errorf("failed to get shape from IMDS: %w", err)
 	}
 	logger.Info("Current shape from IMDS:", shape)
@@ -287,48 +369,58 @@ func RunRDMANicsCount() error {
 	if !rdmaNicsCountTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Error(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_nics_count", Shape: shape}
 	}
 
-	// Step 3: Look up for Shape and corresponding RDMA NICs - get count and PCI IDs
-	logger.Info("Step 2: Getting expected RDMA NIC count and PCI IDs from shapes.json...")
-	expectedCount, expectedPCIIDs, err := getExpectedRDMANicConfig(shape)
-	if err != nil {
-		logger.Error("RDMA NIC Count Check: FAIL - Could not get expected RDMA NIC configuration:", err)
-		rep.AddRDMAResult("FAIL", 0, err)
-		return fmt.Errorf("failed to get expected RDMA NIC configuration: %w", err)
+	// Step 3: Get the expected RDMA NIC BDFs, from test_limits.json or else shapes.json
+	logger.Info("Step 2: Getting expected RDMA NIC BDFs...")
+	expectedBDFs := rdmaNicsCountTestConfig.RDMANICBDFs
+	if len(expectedBDFs) == 0 {
+		_, expectedBDFs, err = getExpectedRDMANicConfig(shape)
+		if err != nil {
+			logger.Error("RDMA NIC Count Check: FAIL - Could not get expected RDMA NIC configuration:", err)
+			rep.AddRDMAResult("FAIL", 0, nil, nil, err)
+			return fmt.Errorf("failed to get expected RDMA NIC configuration: %w", err)
+		}
 	}
-	logger.Info("Expected RDMA NIC count for shape", shape+":", expectedCount)
-	logger.Debugf("Expected PCI IDs: %v", expectedPCIIDs)
+	logger.Info("Expected RDMA NIC count for shape", shape+":", len(expectedBDFs))
+	logger.Debugf("Expected RDMA NIC BDFs: %v", expectedBDFs)
 
-	// Step 4: Using PCI IDs from Step 2, find the RDMA NICs count from OS using lspci
-	logger.Info("Step 3: Checking actual RDMA NIC count using lspci...")
-	actualCount, err := getActualRDMANicCount(expectedPCIIDs)
+	vcnPCIIDs, err := getVCNNicPCIIDs(shape)
 	if err != nil {
-		logger.Error("RDMA NIC Count Check: FAIL - Could not get actual RDMA NIC count:", err)
-		rep.AddRDMAResult("FAIL", actualCount, err)
-		return fmt.Errorf("failed to get actual RDMA NIC count: %w", err)
+		logger.Error("RDMA NIC Count Check: FAIL - Could not get VCN NICs for shape", shape, ":", err)
+		rep.AddRDMAResult("FAIL", 0, nil, nil, err)
+		return fmt.Errorf("failed to get VCN NICs: %w", err)
 	}
-	logger.Info("Actual RDMA NIC count from lspci:", actualCount)
 
-	// Step 5: Compare expected vs actual
-	logger.Info("Step 4: Comparing expected vs actual RDMA NIC counts...")
-	if expectedCount == actualCount {
-		logger.Info("RDMA NIC Count Check: PASS - Expected:", expectedCount, "Actual:", actualCount)
-		rep.AddRDMAResult("PASS", actualCount, nil)
+	// Step 4: Discover the RDMA NICs on the host with ibstat
+	logger.Info("Step 3: Discovering RDMA NICs with ibstat...")
+	discoveredBDFs, err := getDiscoveredRDMANicBDFs()
+	if err != nil {
+		logger.Error("RDMA NIC Count Check: FAIL - Could not discover RDMA NICs:", err)
+		rep.AddRDMAResult("FAIL", 0, nil, nil, err)
+		return fmt.Errorf("could not discover RDMA NICs: %w", err)
+	}
+	logger.Info("RDMA NICs discovered by ibstat:", len(discoveredBDFs))
+
+	// Step 5: Compare expected vs discovered
+	logger.Info("Step 4: Comparing expected vs discovered RDMA NICs...")
+	devices := compareRDMANicBDFs(expectedBDFs, discoveredBDFs, vcnPCIIDs)
+	actualCount := len(devices.Expected) - len(devices.Missing)
+	status, validationErr := validateRDMANicBDFs(devices)
+	rep.AddRDMAResult(status, actualCount, devices.Missing, devices.Extra, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Info("RDMA NIC Count Check: PASS - Expected:", len(devices.Expected), "Actual:", actualCount)
 		return nil
-	} else {
-		if actualCount < expectedCount {
-			missingCount := expectedCount - actualCount
-			logger.Error("RDMA NIC Count Check: FAIL - Missing", missingCount, "RDMA NICs")
-		} else {
-			extraCount := actualCount - expectedCount
-			logger.Error("RDMA NIC Count Check: FAIL - Found", extraCount, "extra RDMA NICs")
-		}
-		logger.Error("RDMA NIC Count Check: FAIL - Expected:", expectedCount, "Actual:", actualCount)
-		err = fmt.Errorf("RDMA NIC count mismatch: expected %d, actual %d", expectedCount, actualCount)
-		rep.AddRDMAResult("FAIL", actualCount, err)
-		return err
+	case "WARN":
+		logger.Info("RDMA NIC Count Check: WARN -", validationErr)
+		return validationErr
+	default:
+		logger.Error("RDMA NIC Count Check: FAIL - Expected:", len(devices.Expected), "Actual:", actualCount)
+		logger.Error("RDMA NIC Count Check: FAIL -", validationErr)
+		return validationErr
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -272,6 +273,73 @@ func TestRDMANicsCountResult(t *testing.T) {
 	}
 }
 
+// Test compareRDMANicBDFs and validateRDMANicBDFs functions
+func TestValidateRDMANicBDFs(t *testing.T) {
+	tests := []struct {
+		name            string
+		expected        []string
+		discovered      []string
+		vcnPCIIDs       []string
+		expectedStatus  string
+		expectedMissing []string
+		expectedExtra   []string
+	}{
+		{
+			name:            "All expected NICs present",
+			expected:        []string{"0000:0C:00.0", "0000:0c:00.1"},
+			discovered:      []string{"0000:0c:00.0", "0000:0c:00.1", "0000:1f:00.0"},
+			vcnPCIIDs:       []string{"0000:1f:00.0"},
+			expectedStatus:  "PASS",
+			expectedMissing: []string{},
+			expectedExtra:   []string{},
+		},
+		{
+			name:            "Missing NIC",
+			expected:        []string{"0000:0c:00.0", "0000:0c:00.1"},
+			discovered:      []string{"0000:0c:00.0"},
+			expectedStatus:  "FAIL",
+			expectedMissing: []string{"0000:0c:00.1"},
+			expectedExtra:   []string{},
+		},
+		{
+			name:            "Extra NIC",
+			expected:        []string{"0000:0c:00.0"},
+			discovered:      []string{"0000:0c:00.0", "0000:2a:00.0"},
+			expectedStatus:  "WARN",
+			expectedMissing: []string{},
+			expectedExtra:   []string{"0000:2a:00.0"},
+		},
+		{
+			name:            "Missing and extra NICs",
+			expected:        []string{"0000:0c:00.0", "0000:0c:00.1"},
+			discovered:      []string{"0000:0c:00.0", "0000:2a:00.0"},
+			expectedStatus:  "FAIL",
+			expectedMissing: []string{"0000:0c:00.1"},
+			expectedExtra:   []string{"0000:2a:00.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devices := compareRDMANicBDFs(tt.expected, tt.discovered, tt.vcnPCIIDs)
+			if !reflect.DeepEqual(devices.Missing, tt.expectedMissing) {
+				t.Errorf("compareRDMANicBDFs() missing = %v, want %v", devices.Missing, tt.expectedMissing)
+			}
+			if !reflect.DeepEqual(devices.Extra, tt.expectedExtra) {
+				t.Errorf("compareRDMANicBDFs() extra = %v, want %v", devices.Extra, tt.expectedExtra)
+			}
+
+			status, err := validateRDMANicBDFs(devices)
+			if status != tt.expectedStatus {
+				t.Errorf("validateRDMANicBDFs() status = %s, want %s", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateRDMANicBDFs() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
+
 func TestShapeHardwareRDMAUnmarshaling(t *testing.T) {
 	// Test that our structs can properly unmarshal the JSON
 	var shapesConfig ShapesConfigRDMA
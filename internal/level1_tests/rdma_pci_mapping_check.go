@@ -0,0 +1,150 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// RDMAPCIMappingCheckTestConfig represents the config needed to run this test.
+// ExpectedMapping maps each RDMA NIC BDF to its expected RDMA device name.
+type RDMAPCIMappingCheckTestConfig struct {
+	IsEnabled       bool              `json:"enabled"`
+	Shape           string            `json:"shape"`
+	ExpectedMapping map[string]string `json:"expected_mapping"`
+}
+
+// getRDMAPCIMappingCheckTestConfig gets test config needed to run this test
+func getRDMAPCIMappingCheckTestConfig() (*RDMAPCIMappingCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	rdmaPCIMappingCheckTestConfig := &RDMAPCIMappingCheckTestConfig{
+		IsEnabled:       false,
+		Shape:           shape,
+		ExpectedMapping: map[string]string{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "rdma_pci_mapping_check")
+	if err != nil {
+		return nil, err
+	}
+	rdmaPCIMappingCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "rdma_pci_mapping_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if mapping, ok := thresholdMap["expected_mapping"].(map[string]interface{}); ok {
+				for bdf, device := range mapping {
+					if deviceName, ok := device.(string); ok {
+						rdmaPCIMappingCheckTestConfig.ExpectedMapping[strings.ToLower(bdf)] = deviceName
+					}
+				}
+			}
+		}
+	}
+
+	return rdmaPCIMappingCheckTestConfig, nil
+}
+
+// invertRDMADeviceAddresses converts the device to BDF map read from sysfs into a BDF to device map
+func invertRDMADeviceAddresses(addresses map[string]string) map[string]string {
+	mapping := make(map[string]string, len(addresses))
+	for device, bdf := range addresses {
+		mapping[bdf] = device
+	}
+	return mapping
+}
+
+// validateRDMAPCIMapping compares the actual BDF to device mapping against the expected one and
+// returns the overall status and the mismatched BDFs. A BDF with a different device or no device FAILs,
+// which indicates a device swap, a missing device or a PCIe topology change.
+func validateRDMAPCIMapping(expected, actual map[string]string) (string, []string, error) {
+	if len(expected) == 0 {
+		return "FAIL", nil, fmt.Errorf("no expected RDMA device to PCI address mapping configured")
+	}
+
+	bdfs := make([]string, 0, len(expected))
+	for bdf := range expected {
+		bdfs = append(bdfs, bdf)
+	}
+	sort.Strings(bdfs)
+
+	mismatchedBDFs := []string{}
+	var mismatches []string
+	for _, bdf := range bdfs {
+		actualDevice, ok := actual[bdf]
+		if !ok {
+			actualDevice = "none"
+		}
+		if actualDevice != expected[bdf] {
+			mismatchedBDFs = append(mismatchedBDFs, bdf)
+			mismatches = append(mismatches, fmt.Sprintf("%s is %s, expected %s", bdf, actualDevice, expected[bdf]))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return "FAIL", mismatchedBDFs, fmt.Errorf("RDMA device to PCI address mapping mismatch: %s", strings.Join(mismatches, "; "))
+	}
+	return "PASS", mismatchedBDFs, nil
+}
+
+// RunRDMAPCIMappingCheck checks every RDMA NIC BDF belongs to the expected RDMA device
+func RunRDMAPCIMappingCheck() error {
+	logger.Info("=== RDMA PCI Mapping Check ===")
+	testConfig, err := getRDMAPCIMappingCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_pci_mapping_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RDMA device to PCI address mapping check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Read the PCI address of every RDMA device
+	logger.Info("Step 1: Reading RDMA device PCI addresses from sysfs...")
+	addresses, err := executor.GetRDMADevicePCIAddresses()
+	if err != nil {
+		logger.Error("RDMA PCI Mapping Check: FAIL - Could not read RDMA device PCI addresses:", err)
+		err = fmt.Errorf("could not read RDMA device PCI addresses: %w", err)
+		rep.AddRDMAPCIMappingResult("FAIL", testConfig.ExpectedMapping, nil, nil, err)
+		return err
+	}
+	actual := invertRDMADeviceAddresses(addresses)
+
+	// Step 2: Compare against the expected mapping
+	logger.Info("Step 2: Comparing against the expected mapping...")
+	status, mismatchedBDFs, validationErr := validateRDMAPCIMapping(testConfig.ExpectedMapping, actual)
+	rep.AddRDMAPCIMappingResult(status, testConfig.ExpectedMapping, actual, mismatchedBDFs, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("RDMA PCI Mapping Check: PASS - All %d RDMA NIC BDFs belong to the expected devices", len(testConfig.ExpectedMapping))
+		return nil
+	default: // FAIL
+		logger.Error("RDMA PCI Mapping Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
@@ -0,0 +1,53 @@
+package level1_tests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test invertRDMADeviceAddresses function
+func TestInvertRDMADeviceAddresses(t *testing.T) {
+	mapping := invertRDMADeviceAddresses(map[string]string{"mlx5_0": "0000:0c:00.0", "mlx5_1": "0000:0c:00.1"})
+	expected := map[string]string{"0000:0c:00.0": "mlx5_0", "0000:0c:00.1": "mlx5_1"}
+	if !reflect.DeepEqual(mapping, expected) {
+		t.Errorf("invertRDMADeviceAddresses() = %v, want %v", mapping, expected)
+	}
+}
+
+// Test validateRDMAPCIMapping function
+func TestValidateRDMAPCIMapping(t *testing.T) {
+	expected := map[string]string{"0000:0c:00.0": "mlx5_0", "0000:0c:00.1": "mlx5_1"}
+
+	tests := []struct {
+		name           string
+		expected       map[string]string
+		actual         map[string]string
+		wantStatus     string
+		wantMismatched []string
+		wantErr        string
+	}{
+		{"matching mapping", expected, map[string]string{"0000:0c:00.0": "mlx5_0", "0000:0c:00.1": "mlx5_1", "0000:1f:00.0": "mlx5_2"}, "PASS", []string{}, ""},
+		{"swapped devices", expected, map[string]string{"0000:0c:00.0": "mlx5_1", "0000:0c:00.1": "mlx5_0"}, "FAIL", []string{"0000:0c:00.0", "0000:0c:00.1"}, "0000:0c:00.0 is mlx5_1, expected mlx5_0"},
+		{"missing device", expected, map[string]string{"0000:0c:00.0": "mlx5_0"}, "FAIL", []string{"0000:0c:00.1"}, "0000:0c:00.1 is none, expected mlx5_1"},
+		{"no expected mapping", map[string]string{}, map[string]string{"0000:0c:00.0": "mlx5_0"}, "FAIL", nil, "no expected RDMA device to PCI address mapping configured"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, mismatched, err := validateRDMAPCIMapping(tt.expected, tt.actual)
+			if status != tt.wantStatus {
+				t.Errorf("validateRDMAPCIMapping() status = %s, want %s", status, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(mismatched, tt.wantMismatched) {
+				t.Errorf("validateRDMAPCIMapping() mismatched = %v, want %v", mismatched, tt.wantMismatched)
+			}
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("validateRDMAPCIMapping() unexpected error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("validateRDMAPCIMapping() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
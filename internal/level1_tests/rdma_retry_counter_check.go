@@ -0,0 +1,242 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// rdmaRetryCounters are the port counters that indicate packet loss and retransmission on an RDMA
+// link. VL15_dropped and port_rcv_remote_physical_errors are standard InfiniBand counters, the
+// others are mlx5 hw_counters incremented when the transport retransmits.
+var rdmaRetryCounters = []string{
+	"VL15_dropped",
+	"port_rcv_remote_physical_errors",
+	"local_ack_timeout_err",
+	"packet_seq_err",
+	"out_of_sequence",
+	"rnr_nak_retry_err",
+	"implied_nak_seq_err",
+}
+
+// RDMARetryCounterCheckTestConfig represents the config needed to run this test
+type RDMARetryCounterCheckTestConfig struct {
+	IsEnabled             bool    `json:"enabled"`
+	Shape                 string  `json:"shape"`
+	MaxRetryRatePerSec    float64 `json:"max_retry_rate_per_sec"`
+	SampleIntervalSeconds int     `json:"sample_interval_seconds"`
+}
+
+// RDMAPortRetryCounters represents the retry counters of an RDMA port sampled over an interval.
+// Counters holds the increase of each counter during the interval.
+type RDMAPortRetryCounters struct {
+	Device          string           `json:"device"`
+	Port            int              `json:"port"`
+	Counters        map[string]int64 `json:"counters"`
+	RetryRatePerSec float64          `json:"retry_rate_per_sec"`
+	CumulativeCount int64            `json:"cumulative_count"`
+	Status          string           `json:"status"`
+}
+
+// getRDMARetryCounterCheckTestConfig gets test config needed to run this test
+func getRDMARetryCounterCheckTestConfig() (*RDMARetryCounterCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	rdmaRetryCounterCheckTestConfig := &RDMARetryCounterCheckTestConfig{
+		IsEnabled:             false,
+		Shape:                 shape,
+		MaxRetryRatePerSec:    1,
+		SampleIntervalSeconds: 10,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "rdma_retry_counter_check")
+	if err != nil {
+		return nil, err
+	}
+	rdmaRetryCounterCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "rdma_retry_counter_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if maxRate, ok := thresholdMap["max_retry_rate_per_sec"].(float64); ok {
+				rdmaRetryCounterCheckTestConfig.MaxRetryRatePerSec = maxRate
+			}
+			if interval, ok := thresholdMap["sample_interval_seconds"].(float64); ok && interval > 0 {
+				rdmaRetryCounterCheckTestConfig.SampleIntervalSeconds = int(interval)
+			}
+		}
+	}
+
+	return rdmaRetryCounterCheckTestConfig, nil
+}
+
+// computeRDMARetryRate returns the per-counter increase between two samples, the total increase
+// per second over interval and the cumulative count of the second sample. A counter lower in the
+// second sample was reset during the interval and counts from zero.
+func computeRDMARetryRate(before, after map[string]int64, interval time.Duration) (map[string]int64, float64, int64) {
+	deltas := make(map[string]int64)
+	var total, cumulative int64
+	for counter, value := range after {
+		delta := value - before[counter]
+		if delta < 0 {
+			delta = value
+		}
+		deltas[counter] = delta
+		total += delta
+		cumulative += value
+	}
+
+	rate := 0.0
+	if interval > 0 {
+		rate = float64(total) / interval.Seconds()
+	}
+	return deltas, rate, cumulative
+}
+
+// sampleRDMARetryCounters reads the retry counters of every active RDMA port twice, interval apart
+func sampleRDMARetryCounters(interval time.Duration) ([]RDMAPortRetryCounters, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("rdma_retry_counter_check", result, err))
+	}
+
+	var activePorts []IBPortSMInfo
+	for _, port := range parseIbstatPorts(result.Output) {
+		if !port.Active {
+			logger.Infof("Skipping %s port %d in state %s", port.Device, port.Port, port.State)
+			continue
+		}
+		activePorts = append(activePorts, port)
+	}
+	if len(activePorts) == 0 {
+		return nil, fmt.Errorf("no active RDMA ports found")
+	}
+
+	before := make([]map[string]int64, len(activePorts))
+	for i, port := range activePorts {
+		before[i], err = executor.GetRDMAPortCounters(port.Device, port.Port, rdmaRetryCounters)
+		if err != nil {
+			return nil, fmt.Errorf("could not read counters of %s port %d: %w", port.Device, port.Port, err)
+		}
+	}
+
+	logger.Infof("Sampling retry counters of %d ports for %s...", len(activePorts), interval)
+	start := time.Now()
+	time.Sleep(interval)
+
+	ports := make([]RDMAPortRetryCounters, 0, len(activePorts))
+	for i, port := range activePorts {
+		after, err := executor.GetRDMAPortCounters(port.Device, port.Port, rdmaRetryCounters)
+		if err != nil {
+			return nil, fmt.Errorf("could not read counters of %s port %d: %w", port.Device, port.Port, err)
+		}
+		deltas, rate, cumulative := computeRDMARetryRate(before[i], after, time.Since(start))
+		ports = append(ports, RDMAPortRetryCounters{
+			Device:          port.Device,
+			Port:            port.Port,
+			Counters:        deltas,
+			RetryRatePerSec: rate,
+			CumulativeCount: cumulative,
+		})
+	}
+	return ports, nil
+}
+
+// validateRDMARetryCounters sets the per-port status and returns the overall status.
+// A port retrying faster than maxRatePerSec WARNs.
+func validateRDMARetryCounters(ports []RDMAPortRetryCounters, maxRatePerSec float64) (string, error) {
+	if len(ports) == 0 {
+		return "FAIL", fmt.Errorf("no RDMA ports found to check retry counters")
+	}
+
+	var warned []string
+	for i := range ports {
+		port := &ports[i]
+		if port.RetryRatePerSec <= maxRatePerSec {
+			port.Status = "PASS"
+			continue
+		}
+		port.Status = "WARN"
+
+		var increased []string
+		for counter, delta := range port.Counters {
+			if delta > 0 {
+				increased = append(increased, fmt.Sprintf("%s +%d", counter, delta))
+			}
+		}
+		sort.Strings(increased)
+		warned = append(warned, fmt.Sprintf("%s port %d (%.2f/s: %s)", port.Device, port.Port, port.RetryRatePerSec, strings.Join(increased, ", ")))
+	}
+
+	if len(warned) > 0 {
+		return "WARN", fmt.Errorf("RDMA retry rate above %.2f/s on: %s", maxRatePerSec, strings.Join(warned, "; "))
+	}
+	return "PASS", nil
+}
+
+// RunRDMARetryCounterCheck checks the rate at which RDMA ports drop and retransmit packets
+func RunRDMARetryCounterCheck() error {
+	logger.Info("=== RDMA Retry Counter Check ===")
+	testConfig, err := getRDMARetryCounterCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_retry_counter_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RDMA retry counter check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Sample retry counters of every active port
+	interval := time.Duration(testConfig.SampleIntervalSeconds) * time.Second
+	logger.Infof("Step 1: Sampling RDMA retry counters over %s...", interval)
+	ports, err := sampleRDMARetryCounters(interval)
+	if err != nil {
+		logger.Error("RDMA Retry Counter Check: FAIL - Could not sample retry counters:", err)
+		rep.AddRDMARetryCounterResult("FAIL", nil, testConfig.MaxRetryRatePerSec, err)
+		return err
+	}
+
+	// Step 2: Validate retry rates
+	logger.Infof("Step 2: Validating retry rates against %.2f/s...", testConfig.MaxRetryRatePerSec)
+	status, validationErr := validateRDMARetryCounters(ports, testConfig.MaxRetryRatePerSec)
+	for _, port := range ports {
+		logger.Infof("%s port %d: %.2f retries/s, %d cumulative - %s", port.Device, port.Port, port.RetryRatePerSec, port.CumulativeCount, port.Status)
+	}
+	rep.AddRDMARetryCounterResult(status, ports, testConfig.MaxRetryRatePerSec, validationErr)
+
+	switch status {
+	case "PASS":
+		logger.Infof("RDMA Retry Counter Check: PASS - All %d ports within %.2f retries/s", len(ports), testConfig.MaxRetryRatePerSec)
+		return nil
+	case "WARN":
+		logger.Info("RDMA Retry Counter Check: WARN -", validationErr)
+		return validationErr
+	default: // FAIL
+		logger.Error("RDMA Retry Counter Check: FAIL -", validationErr)
+		return validationErr
+	}
+}
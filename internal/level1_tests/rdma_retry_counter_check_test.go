@@ -0,0 +1,53 @@
+package level1_tests
+
+import (
+	"testing"
+	"time"
+)
+
+// Test computeRDMARetryRate function
+func TestComputeRDMARetryRate(t *testing.T) {
+	before := map[string]int64{"VL15_dropped": 10, "local_ack_timeout_err": 100, "packet_seq_err": 50}
+	after := map[string]int64{"VL15_dropped": 10, "local_ack_timeout_err": 120, "packet_seq_err": 5}
+
+	deltas, rate, cumulative := computeRDMARetryRate(before, after, 10*time.Second)
+	if deltas["VL15_dropped"] != 0 || deltas["local_ack_timeout_err"] != 20 {
+		t.Errorf("computeRDMARetryRate() deltas = %v", deltas)
+	}
+	// packet_seq_err was reset during the interval and counts from zero
+	if deltas["packet_seq_err"] != 5 {
+		t.Errorf("computeRDMARetryRate() packet_seq_err delta = %d, want 5", deltas["packet_seq_err"])
+	}
+	if rate != 2.5 {
+		t.Errorf("computeRDMARetryRate() rate = %v, want 2.5", rate)
+	}
+	if cumulative != 135 {
+		t.Errorf("computeRDMARetryRate() cumulative = %d, want 135", cumulative)
+	}
+}
+
+// Test validateRDMARetryCounters function
+func TestValidateRDMARetryCounters(t *testing.T) {
+	tests := []struct {
+		name           string
+		ports          []RDMAPortRetryCounters
+		expectedStatus string
+	}{
+		{"no retries", []RDMAPortRetryCounters{{Device: "mlx5_0", Port: 1}, {Device: "mlx5_1", Port: 1}}, "PASS"},
+		{"at threshold", []RDMAPortRetryCounters{{Device: "mlx5_0", Port: 1, RetryRatePerSec: 1, CumulativeCount: 5000}}, "PASS"},
+		{"above threshold", []RDMAPortRetryCounters{{Device: "mlx5_0", Port: 1}, {Device: "mlx5_1", Port: 1, RetryRatePerSec: 4.2, Counters: map[string]int64{"local_ack_timeout_err": 42}}}, "WARN"},
+		{"no ports", nil, "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateRDMARetryCounters(tt.ports, 1)
+			if status != tt.expectedStatus {
+				t.Errorf("validateRDMARetryCounters() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (err != nil) != (tt.expectedStatus != "PASS") {
+				t.Errorf("validateRDMARetryCounters() error = %v", err)
+			}
+		})
+	}
+}
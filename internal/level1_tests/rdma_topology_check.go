@@ -0,0 +1,215 @@
+package level1_tests
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/shapes"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// RDMATopologyCheckTestConfig represents the config needed to run this test
+type RDMATopologyCheckTestConfig struct {
+	IsEnabled bool   `json:"enabled"`
+	Shape     string `json:"shape"`
+}
+
+// RDMATopology represents the expected RDMA devices of a shape compared with the devices found on the host
+type RDMATopology struct {
+	Expected []string `json:"expected"`
+	Actual   []string `json:"actual"`
+	Missing  []string `json:"missing"`
+	Extra    []string `json:"extra"`
+}
+
+// getRDMATopologyCheckTestConfig gets test config needed to run this test
+func getRDMATopologyCheckTestConfig() (*RDMATopologyCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	rdmaTopologyCheckTestConfig := &RDMATopologyCheckTestConfig{
+		IsEnabled: false,
+		Shape:     shape,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "rdma_topology_check")
+	if err != nil {
+		return nil, err
+	}
+	rdmaTopologyCheckTestConfig.IsEnabled = enabled
+
+	return rdmaTopologyCheckTestConfig, nil
+}
+
+// compareRDMATopology compares the expected RDMA devices with the devices found on the host.
+// Devices listed in ignored, such as the VCN NICs of the shape, are neither expected nor extra.
+func compareRDMATopology(expected []string, actual []string, ignored []string) *RDMATopology {
+	topology := &RDMATopology{
+		Expected: sortedUnique(expected),
+		Actual:   sortedUnique(actual),
+		Missing:  []string{},
+		Extra:    []string{},
+	}
+
+	actualSet := make(map[string]bool)
+	for _, device := range topology.Actual {
+		actualSet[device] = true
+	}
+	knownSet := make(map[string]bool)
+	for _, device := range topology.Expected {
+		knownSet[device] = true
+	}
+	for _, device := range ignored {
+		knownSet[device] = true
+	}
+
+	for _, device := range topology.Expected {
+		if !actualSet[device] {
+			topology.Missing = append(topology.Missing, device)
+		}
+	}
+	for _, device := range topology.Actual {
+		if !knownSet[device] {
+			topology.Extra = append(topology.Extra, device)
+		}
+	}
+
+	return topology
+}
+
+// sortedUnique returns the sorted values without duplicates
+func sortedUnique(values []string) []string {
+	seen := make(map[string]bool)
+	unique := []string{}
+	for _, value := range values {
+		if value != "" && !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// getActualRDMADevices returns the RDMA devices reported by ibstat or ibdev2netdev
+func getActualRDMADevices() ([]string, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("rdma_topology_check", result, err))
+	}
+
+	var devices []string
+	for _, port := range parseIbstatPorts(result.Output) {
+		devices = append(devices, port.Device)
+	}
+
+	netdevs, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+	}
+	for device := range netdevs {
+		devices = append(devices, device)
+	}
+
+	return devices, nil
+}
+
+func RunRDMATopologyCheck() error {
+	logger.Info("=== RDMA Topology Check ===")
+	testConfig, err := getRDMATopologyCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "rdma_topology_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RDMA topology check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get expected RDMA devices from shapes configuration
+	logger.Info("Step 1: Loading expected RDMA devices from shape configuration...")
+	shapeManager, err := shapes.NewShapeManager(config.GetShapesFilePath())
+	if err != nil {
+		logger.Error("RDMA Topology Check: FAIL - Could not load shapes configuration:", err)
+		rep.AddRDMATopologyResult("FAIL", nil, nil, nil, nil, err)
+		return fmt.Errorf("failed to load shapes configuration: %w", err)
+	}
+
+	rdmaNics, err := shapeManager.GetRDMANics(testConfig.Shape)
+	if err != nil {
+		logger.Error("RDMA Topology Check: FAIL - Could not get expected RDMA NICs for shape", testConfig.Shape, ":", err)
+		rep.AddRDMATopologyResult("FAIL", nil, nil, nil, nil, err)
+		return fmt.Errorf("failed to get expected RDMA NICs: %w", err)
+	}
+	var expected []string
+	for _, nic := range rdmaNics {
+		expected = append(expected, nic.DeviceName)
+	}
+
+	// VCN NICs are Mellanox devices too and show up in ibstat
+	vcnNics, err := shapeManager.GetVCNNics(testConfig.Shape)
+	if err != nil {
+		logger.Error("RDMA Topology Check: FAIL - Could not get VCN NICs for shape", testConfig.Shape, ":", err)
+		rep.AddRDMATopologyResult("FAIL", nil, nil, nil, nil, err)
+		return fmt.Errorf("failed to get VCN NICs: %w", err)
+	}
+	var ignored []string
+	for _, nic := range vcnNics {
+		ignored = append(ignored, nic.DeviceName)
+	}
+
+	// Step 2: Discover RDMA devices on the host
+	logger.Info("Step 2: Discovering RDMA devices with ibstat and ibdev2netdev...")
+	actual, err := getActualRDMADevices()
+	if err != nil {
+		logger.Error("RDMA Topology Check: FAIL - Could not discover RDMA devices:", err)
+		rep.AddRDMATopologyResult("FAIL", nil, nil, nil, nil, err)
+		return fmt.Errorf("could not discover RDMA devices: %w", err)
+	}
+
+	// Step 3: Compare discovered devices against the shape
+	logger.Info("Step 3: Comparing RDMA devices against shape configuration...")
+	topology := compareRDMATopology(expected, actual, ignored)
+	logger.Infof("Expected %d RDMA devices, found %d", len(topology.Expected), len(topology.Actual))
+
+	if len(topology.Missing) > 0 {
+		err = fmt.Errorf("missing RDMA devices: %s", strings.Join(topology.Missing, ", "))
+		if len(topology.Extra) > 0 {
+			err = fmt.Errorf("%w; unexpected RDMA devices: %s", err, strings.Join(topology.Extra, ", "))
+		}
+		logger.Error("RDMA Topology Check: FAIL -", err)
+		rep.AddRDMATopologyResult("FAIL", topology.Expected, topology.Actual, topology.Missing, topology.Extra, err)
+		return err
+	}
+
+	// Unexpected devices do not prevent the expected ones from working
+	if len(topology.Extra) > 0 {
+		err = fmt.Errorf("unexpected RDMA devices: %s", strings.Join(topology.Extra, ", "))
+		logger.Info("RDMA Topology Check: WARN -", err)
+		rep.AddRDMATopologyResult("WARN", topology.Expected, topology.Actual, topology.Missing, topology.Extra, err)
+		return err
+	}
+
+	logger.Info("RDMA Topology Check: PASS - All expected RDMA devices are present")
+	rep.AddRDMATopologyResult("PASS", topology.Expected, topology.Actual, nil, nil, nil)
+	return nil
+}
@@ -0,0 +1,68 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test compareRDMATopology function
+func TestCompareRDMATopology(t *testing.T) {
+	tests := []struct {
+		name            string
+		expected        []string
+		actual          []string
+		ignored         []string
+		expectedMissing []string
+		expectedExtra   []string
+	}{
+		{
+			name:            "All devices present",
+			expected:        []string{"mlx5_0", "mlx5_1", "mlx5_3"},
+			actual:          []string{"mlx5_3", "mlx5_0", "mlx5_1", "mlx5_0"},
+			expectedMissing: []string{},
+			expectedExtra:   []string{},
+		},
+		{
+			name:            "VCN NICs are not extra devices",
+			expected:        []string{"mlx5_0", "mlx5_1"},
+			actual:          []string{"mlx5_0", "mlx5_1", "mlx5_2"},
+			ignored:         []string{"mlx5_2"},
+			expectedMissing: []string{},
+			expectedExtra:   []string{},
+		},
+		{
+			name:            "Missing device",
+			expected:        []string{"mlx5_0", "mlx5_1", "mlx5_3"},
+			actual:          []string{"mlx5_0", "mlx5_3"},
+			expectedMissing: []string{"mlx5_1"},
+			expectedExtra:   []string{},
+		},
+		{
+			name:            "Missing and extra devices",
+			expected:        []string{"mlx5_0", "mlx5_1"},
+			actual:          []string{"mlx5_0", "mlx5_18"},
+			expectedMissing: []string{"mlx5_1"},
+			expectedExtra:   []string{"mlx5_18"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topology := compareRDMATopology(tt.expected, tt.actual, tt.ignored)
+			if !reflect.DeepEqual(topology.Missing, tt.expectedMissing) {
+				t.Errorf("compareRDMATopology() missing = %v, want %v", topology.Missing, tt.expectedMissing)
+			}
+			if !reflect.DeepEqual(topology.Extra, tt.expectedExtra) {
+				t.Errorf("compareRDMATopology() extra = %v, want %v", topology.Extra, tt.expectedExtra)
+			}
+		})
+	}
+}
+
+// Test sortedUnique function
+func TestSortedUnique(t *testing.T) {
+	got := sortedUnique([]string{"mlx5_3", "", "mlx5_0", "mlx5_3"})
+	if !reflect.DeepEqual(got, []string{"mlx5_0", "mlx5_3"}) {
+		t.Errorf("sortedUnique() = %v, want [mlx5_0 mlx5_3]", got)
+	}
+}
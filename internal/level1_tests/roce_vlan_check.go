@@ -0,0 +1,278 @@
+package level1_tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// procNetVLANConfigPath lists the VLAN interfaces of the 8021q module with their VLAN ID and parent interface
+const procNetVLANConfigPath = "/proc/net/vlan/config"
+
+// ipLinkVLANRegex matches the VLAN details in ip -d link show output, e.g. "vlan protocol 802.1Q id 100 <REORDER_HDR>"
+var ipLinkVLANRegex = regexp.MustCompile(`\bvlan\s+protocol\s+(\S+)\s+id\s+(\d+)\b`)
+
+// RoCEVLANCheckTestConfig represents the config needed to run this test.
+// Every RoCE interface must have a VLAN interface with VLANID using VLANProtocol.
+type RoCEVLANCheckTestConfig struct {
+	IsEnabled    bool   `json:"enabled"`
+	Shape        string `json:"shape"`
+	VLANID       int    `json:"vlan_id"`
+	VLANProtocol string `json:"vlan_protocol"`
+}
+
+// VLANInterface represents a VLAN interface from /proc/net/vlan/config
+type VLANInterface struct {
+	Name   string `json:"name"`
+	VLANID int    `json:"vlan_id"`
+	Parent string `json:"parent"`
+}
+
+// RoCEInterfaceVLAN represents the VLAN tagging of the network interface of a single RoCE device.
+// VLANInterface is empty when the interface has no VLAN interface.
+type RoCEInterfaceVLAN struct {
+	Device        string `json:"device"`
+	Interface     string `json:"interface"`
+	VLANInterface string `json:"vlan_interface,omitempty"`
+	VLANID        int    `json:"vlan_id,omitempty"`
+	VLANProtocol  string `json:"vlan_protocol,omitempty"`
+	Status        string `json:"status"`
+}
+
+// getRoCEVLANCheckTestConfig gets test config needed to run this test
+func getRoCEVLANCheckTestConfig() (*RoCEVLANCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	roceVLANCheckTestConfig := &RoCEVLANCheckTestConfig{
+		IsEnabled:    false,
+		Shape:        shape,
+		VLANProtocol: "802.1Q",
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "roce_vlan_check")
+	if err != nil {
+		return nil, err
+	}
+	roceVLANCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "roce_vlan_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if vlanID, ok := thresholdMap["vlan_id"].(float64); ok {
+				roceVLANCheckTestConfig.VLANID = int(vlanID)
+			}
+			if protocol, ok := thresholdMap["vlan_protocol"].(string); ok {
+				roceVLANCheckTestConfig.VLANProtocol = protocol
+			}
+		}
+	}
+
+	return roceVLANCheckTestConfig, nil
+}
+
+// parseProcNetVLANConfig parses /proc/net/vlan/config, e.g. "rdma0.100      | 100  | rdma0".
+// The header lines are skipped.
+func parseProcNetVLANConfig(output string) []VLANInterface {
+	var vlans []VLANInterface
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 3 {
+			continue
+		}
+		vlanID, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			continue
+		}
+		vlans = append(vlans, VLANInterface{
+			Name:   strings.TrimSpace(fields[0]),
+			VLANID: vlanID,
+			Parent: strings.TrimSpace(fields[2]),
+		})
+	}
+	return vlans
+}
+
+// parseIPLinkVLAN parses the VLAN protocol and ID from ip -d link show output of a VLAN interface
+func parseIPLinkVLAN(output string) (string, int, error) {
+	match := ipLinkVLANRegex.FindStringSubmatch(output)
+	if match == nil {
+		return "", 0, fmt.Errorf("VLAN details not found in ip link output")
+	}
+	vlanID, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", 0, err
+	}
+	return match[1], vlanID, nil
+}
+
+// getRoCENetdevs returns the network interface of each RDMA device with an Ethernet link layer, keyed by device
+func getRoCENetdevs() (map[string]string, error) {
+	result, err := executor.RunIbstat()
+	if err != nil {
+		return nil, fmt.Errorf("ibstat failed: %w", commandError("roce_vlan_check", result, err))
+	}
+
+	netdevs, err := executor.GetIbdevToNetdevMap()
+	if err != nil {
+		return nil, fmt.Errorf("ibdev2netdev failed: %w", err)
+	}
+
+	roceNetdevs := make(map[string]string)
+	for _, port := range parseIbstatPorts(result.Output) {
+		if port.LinkLayer != "Ethernet" {
+			continue
+		}
+		interfaceName := netdevs[port.Device]
+		if interfaceName == "" {
+			return nil, fmt.Errorf("no network interface found for %s", port.Device)
+		}
+		roceNetdevs[port.Device] = interfaceName
+	}
+
+	if len(roceNetdevs) == 0 {
+		return nil, fmt.Errorf("no RoCE network interfaces found")
+	}
+	return roceNetdevs, nil
+}
+
+// getRoCEInterfaceVLANs finds the VLAN interface of each RoCE network interface and reads its VLAN protocol and ID.
+// A RoCE interface with several VLAN interfaces reports the one with the expected VLAN ID if present.
+func getRoCEInterfaceVLANs(roceNetdevs map[string]string, vlans []VLANInterface, expectedVLANID int) ([]RoCEInterfaceVLAN, error) {
+	devices := make([]string, 0, len(roceNetdevs))
+	for device := range roceNetdevs {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+
+	var interfaces []RoCEInterfaceVLAN
+	for _, device := range devices {
+		iface := RoCEInterfaceVLAN{Device: device, Interface: roceNetdevs[device]}
+
+		for _, vlan := range vlans {
+			if vlan.Parent != iface.Interface {
+				continue
+			}
+			if iface.VLANInterface == "" || vlan.VLANID == expectedVLANID {
+				iface.VLANInterface = vlan.Name
+			}
+		}
+
+		if iface.VLANInterface != "" {
+			linkResult, err := executor.RunIPLinkShowDetails(iface.VLANInterface)
+			if err != nil {
+				return nil, fmt.Errorf("ip -d link show failed: %w", commandError("roce_vlan_check", linkResult, err))
+			}
+			iface.VLANProtocol, iface.VLANID, err = parseIPLinkVLAN(linkResult.Output)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", iface.VLANInterface, err)
+			}
+		}
+
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces, nil
+}
+
+// validateRoCEVLANs marks interfaces without a VLAN interface, or with a VLAN ID or protocol other than expected,
+// as FAIL and returns a description of each failure
+func validateRoCEVLANs(interfaces []RoCEInterfaceVLAN, expectedVLANID int, expectedProtocol string) []string {
+	var failures []string
+	for i := range interfaces {
+		iface := &interfaces[i]
+		iface.Status = "FAIL"
+
+		switch {
+		case iface.VLANInterface == "":
+			failures = append(failures, fmt.Sprintf("%s missing VLAN %d interface", iface.Interface, expectedVLANID))
+		case iface.VLANID != expectedVLANID:
+			failures = append(failures, fmt.Sprintf("%s VLAN ID %d, expected %d", iface.VLANInterface, iface.VLANID, expectedVLANID))
+		case !strings.EqualFold(iface.VLANProtocol, expectedProtocol):
+			failures = append(failures, fmt.Sprintf("%s VLAN protocol %s, expected %s", iface.VLANInterface, iface.VLANProtocol, expectedProtocol))
+		default:
+			iface.Status = "PASS"
+		}
+	}
+	return failures
+}
+
+// RunRoCEVLANCheck checks the VLAN tagging of every RoCE network interface
+func RunRoCEVLANCheck() error {
+	logger.Info("=== RoCE VLAN Check ===")
+	testConfig, err := getRoCEVLANCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "roce_vlan_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting RoCE VLAN check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Find the RoCE network interfaces
+	logger.Info("Step 1: Finding RoCE network interfaces...")
+	roceNetdevs, err := getRoCENetdevs()
+	if err != nil {
+		logger.Error("RoCE VLAN Check: FAIL - Could not find RoCE network interfaces:", err)
+		rep.AddRoCEVLANResult("FAIL", nil, err)
+		return fmt.Errorf("could not find RoCE network interfaces: %w", err)
+	}
+
+	// Step 2: Read the VLAN interfaces. Without the 8021q module there are no VLAN interfaces.
+	logger.Info("Step 2: Reading VLAN interfaces...")
+	var vlans []VLANInterface
+	if vlanResult, err := executor.RunCat(procNetVLANConfigPath); err != nil {
+		logger.Infof("Could not read %s, no VLAN interfaces configured: %v", procNetVLANConfigPath, err)
+	} else {
+		vlans = parseProcNetVLANConfig(vlanResult.Output)
+	}
+
+	interfaces, err := getRoCEInterfaceVLANs(roceNetdevs, vlans, testConfig.VLANID)
+	if err != nil {
+		logger.Error("RoCE VLAN Check: FAIL - Could not read VLAN details:", err)
+		rep.AddRoCEVLANResult("FAIL", nil, err)
+		return fmt.Errorf("could not read VLAN details: %w", err)
+	}
+
+	// Step 3: Validate the VLAN tagging
+	logger.Infof("Step 3: Validating VLAN tagging (VLAN %d, %s)...", testConfig.VLANID, testConfig.VLANProtocol)
+	failures := validateRoCEVLANs(interfaces, testConfig.VLANID, testConfig.VLANProtocol)
+	for _, iface := range interfaces {
+		logger.Infof("%s (%s): VLAN interface %q, VLAN ID %d, protocol %q - %s",
+			iface.Interface, iface.Device, iface.VLANInterface, iface.VLANID, iface.VLANProtocol, iface.Status)
+	}
+
+	if len(failures) > 0 {
+		err = fmt.Errorf("incorrect VLAN tagging on RoCE interface(s): %s", strings.Join(failures, ", "))
+		logger.Error("RoCE VLAN Check: FAIL -", err)
+		rep.AddRoCEVLANResult("FAIL", interfaces, err)
+		return err
+	}
+
+	logger.Info("RoCE VLAN Check: PASS - All RoCE interfaces have the expected VLAN tagging")
+	rep.AddRoCEVLANResult("PASS", interfaces, nil)
+	return nil
+}
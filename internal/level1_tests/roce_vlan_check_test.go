@@ -0,0 +1,70 @@
+package level1_tests
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test parseProcNetVLANConfig function with /proc/net/vlan/config contents
+func TestParseProcNetVLANConfig(t *testing.T) {
+	output := "VLAN Dev name	 | VLAN ID\n" +
+		"Name-Type: VLAN_NAME_TYPE_RAW_PLUS_VID_NO_PAD\n" +
+		"rdma0.100      | 100  | rdma0\n" +
+		"rdma1.200      | 200  | rdma1\n"
+
+	expected := []VLANInterface{
+		{Name: "rdma0.100", VLANID: 100, Parent: "rdma0"},
+		{Name: "rdma1.200", VLANID: 200, Parent: "rdma1"},
+	}
+
+	vlans := parseProcNetVLANConfig(output)
+	if !reflect.DeepEqual(vlans, expected) {
+		t.Errorf("parseProcNetVLANConfig() = %+v, want %+v", vlans, expected)
+	}
+}
+
+// Test parseIPLinkVLAN function with ip -d link show output
+func TestParseIPLinkVLAN(t *testing.T) {
+	output := "12: rdma0.100@rdma0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 9000 qdisc noqueue state UP mode DEFAULT group default qlen 1000\n" +
+		"    link/ether b8:3f:d2:00:00:01 brd ff:ff:ff:ff:ff:ff promiscuity 0 minmtu 0 maxmtu 65535\n" +
+		"    vlan protocol 802.1ad id 100 <REORDER_HDR> addrgenmode eui64 numtxqueues 1 numrxqueues 1\n"
+
+	protocol, vlanID, err := parseIPLinkVLAN(output)
+	if err != nil {
+		t.Fatalf("parseIPLinkVLAN() error = %v", err)
+	}
+	if protocol != "802.1ad" || vlanID != 100 {
+		t.Errorf("parseIPLinkVLAN() = %s, %d, want 802.1ad, 100", protocol, vlanID)
+	}
+
+	if _, _, err := parseIPLinkVLAN("2: rdma0: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 9000"); err == nil {
+		t.Error("parseIPLinkVLAN() expected error for interface without VLAN")
+	}
+}
+
+// Test validateRoCEVLANs function
+func TestValidateRoCEVLANs(t *testing.T) {
+	interfaces := []RoCEInterfaceVLAN{
+		{Device: "mlx5_0", Interface: "rdma0", VLANInterface: "rdma0.100", VLANID: 100, VLANProtocol: "802.1Q"},
+		{Device: "mlx5_1", Interface: "rdma1"},
+		{Device: "mlx5_2", Interface: "rdma2", VLANInterface: "rdma2.200", VLANID: 200, VLANProtocol: "802.1Q"},
+		{Device: "mlx5_3", Interface: "rdma3", VLANInterface: "rdma3.100", VLANID: 100, VLANProtocol: "802.1ad"},
+	}
+
+	failures := validateRoCEVLANs(interfaces, 100, "802.1Q")
+	expected := []string{
+		"rdma1 missing VLAN 100 interface",
+		"rdma2.200 VLAN ID 200, expected 100",
+		"rdma3.100 VLAN protocol 802.1ad, expected 802.1Q",
+	}
+	if !reflect.DeepEqual(failures, expected) {
+		t.Errorf("validateRoCEVLANs() = %v, want %v", failures, expected)
+	}
+
+	expectedStatus := []string{"PASS", "FAIL", "FAIL", "FAIL"}
+	for i, iface := range interfaces {
+		if iface.Status != expectedStatus[i] {
+			t.Errorf("validateRoCEVLANs() %s status = %s, want %s", iface.Interface, iface.Status, expectedStatus[i])
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -149,7 +150,7 @@ func RunRowRemapErrorCheck() error {
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "row_remap_error_check", Shape: testConfig.Shape}
 	}
 
 	// Check nvidia-smi driver version first
@@ -199,8 +200,9 @@ func RunRowRemapErrorCheck() error {
 	if !result.Available {
 		logger.Error("Failed to run nvidia-smi remapped rows query:", result.Error)
 		logger.Info("Row Remap Error Check: FAIL - Could not run nvidia-smi remapped rows query")
-		rep.AddRowRemapResult("FAIL", fmt.Errorf("could not run nvidia-smi remapped rows query: %s", result.Error), 0)
-		return fmt.Errorf("could not run nvidia-smi remapped rows query: %s", result.Error)
+		err := nvidiaSMIError("row_remap_error_check", "nvidia-smi --query-remapped-rows=gpu_bus_id,remapped_rows.failure", result)
+		rep.AddRowRemapResult("FAIL", err, 0)
+		return err
 	}
 
 	// Parse the nvidia-smi output for row remap failures
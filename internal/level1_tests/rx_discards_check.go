@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
 	"github.com/oracle/oci-dr-hpc-v2/internal/utils"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -149,7 +150,8 @@ func getRxDiscardTestConfig() (*RxDiscardTestConfig, error) {
 }
 
 // runRXDiscardsCheck executes RX discards health check across all relevant network interfaces
-func runRXDiscardsCheck() ([]RXDiscardsResult, error) {
+// and returns the results with the discard threshold they were checked against
+func runRXDiscardsCheck() ([]RXDiscardsResult, float64, error) {
 	// Process each interface and collect results
 	var results []RXDiscardsResult
 
@@ -158,13 +160,13 @@ func runRXDiscardsCheck() ([]RXDiscardsResult, error) {
 
 	testConfig, err := getRxDiscardTestConfig()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if !testConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
 		logger.Info(errorStatement)
-		return nil, errors.New(errorStatement)
+		return nil, 0, &testerrors.TestDisabledError{TestName: "rx_discards_check", Shape: testConfig.Shape}
 	}
 
 	// Threshold for considering RX discards problematic
@@ -201,7 +203,7 @@ func runRXDiscardsCheck() ([]RXDiscardsResult, error) {
 		results = append(results, parsedResult)
 	}
 
-	return results, nil
+	return results, threshold, nil
 }
 
 // RunRXDiscardsCheck is the main entry point for RX discards health check
@@ -212,7 +214,11 @@ func RunRXDiscardsCheck() error {
 	logger.Info("Health check is in progress...")
 
 	// Run the RX discards check
-	results, err := runRXDiscardsCheck()
+	results, threshold, err := runRXDiscardsCheck()
+	var disabledErr *testerrors.TestDisabledError
+	if errors.As(err, &disabledErr) {
+		return err
+	}
 	if err != nil {
 		logger.Error("RX Discards Check: FAIL - Error during check:", err)
 		rep.AddRXDiscardsCheckResult("FAIL", 0, []string{}, err)
@@ -243,7 +249,9 @@ func RunRXDiscardsCheck() error {
 
 	// Report overall result
 	if len(failedInterfaces) > 0 {
-		err := fmt.Errorf("RX discards check failed for %d out of %d interfaces", len(failedInterfaces), len(results))
+		logger.Errorf("RX discards check failed for %d out of %d interfaces", len(failedInterfaces), len(results))
+		err := &testerrors.TestThresholdExceededError{TestName: "rx_discards_check", Metric: "rx_discards",
+			Actual: failedInterfaces, Expected: threshold}
 		logger.Error("RX Discards Check: FAIL -", err)
 		rep.AddRXDiscardsCheckResult("FAIL", len(results), failedInterfaces, err)
 		return err
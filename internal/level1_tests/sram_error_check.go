@@ -5,12 +5,12 @@
 package level1_tests
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
@@ -205,6 +205,18 @@ func extractErrorCounts(lines []string) []GPUErrorDetails {
 	return gpuErrors
 }
 
+// buildSRAMPerGPUErrors converts the parsed SRAM error counts to the per-GPU reporter map
+func buildSRAMPerGPUErrors(results []SRAMErrorCounts) map[int]reporter.SRAMGPUErrors {
+	perGPUErrors := make(map[int]reporter.SRAMGPUErrors, len(results))
+	for _, result := range results {
+		perGPUErrors[result.GPUIndex] = reporter.SRAMGPUErrors{
+			Correctable:   result.Correctable,
+			Uncorrectable: result.Uncorrectable,
+		}
+	}
+	return perGPUErrors
+}
+
 // checkSRAMThresholds validates SRAM error counts against thresholds
 func checkSRAMThresholds(results []SRAMErrorCounts, config *SRAMCheckTestConfig) (string, SRAMErrorSummary) {
 	if len(results) == 0 {
@@ -258,7 +270,7 @@ func RunSRAMCheck() error {
 	shape, err := executor.GetCurrentShape()
 	if err != nil {
 		logger.Error("SRAM Check: FAIL - Could not get shape from IMDS:", err)
-		rep.AddSRAMErrorResult("FAIL", 0, 0, err)
+		rep.AddSRAMErrorResult("FAIL", 0, 0, nil, err)
 		return fmt.Errorf("failed to get shape from IMDS: %w", err)
 	}
 	logger.Info("Current shape from IMDS:", shape)
@@ -267,14 +279,14 @@ func RunSRAMCheck() error {
 	sramErrorCheckTestConfig, err := getSRAMCheckTestConfig(shape)
 	if err != nil {
 		logger.Error("SRAM Check: FAIL - Could not get test configuration:", err)
-		rep.AddSRAMErrorResult("FAIL", 0, 0, err)
+		rep.AddSRAMErrorResult("FAIL", 0, 0, nil, err)
 		return fmt.Errorf("failed to get test configuration: %w", err)
 	}
 
 	if !sramErrorCheckTestConfig.IsEnabled {
 		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", shape)
 		logger.Info(errorStatement)
-		return errors.New(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "sram_error_check", Shape: shape}
 	}
 
 	// Step 3: Get SRAM error information from nvidia-smi
@@ -286,14 +298,14 @@ func RunSRAMCheck() error {
 	uncorrectableOutput, err := executor.RunNvidiaSMIErrorQuery("uncorrectable")
 	if err != nil {
 		logger.Error("SRAM Check: FAIL - Could not get uncorrectable SRAM errors:", err)
-		rep.AddSRAMErrorResult("FAIL", 0, 0, err)
+		rep.AddSRAMErrorResult("FAIL", 0, 0, nil, err)
 		return fmt.Errorf("failed to get uncorrectable SRAM errors: %w", err)
 	}
 
 	correctableOutput, err := executor.RunNvidiaSMIErrorQuery("correctable")
 	if err != nil {
 		logger.Error("SRAM Check: FAIL - Could not get correctable SRAM errors:", err)
-		rep.AddSRAMErrorResult("FAIL", 0, 0, err)
+		rep.AddSRAMErrorResult("FAIL", 0, 0, nil, err)
 		return fmt.Errorf("failed to get correctable SRAM errors: %w", err)
 	}
 
@@ -302,7 +314,7 @@ func RunSRAMCheck() error {
 	sramResults, err := parseSRAMResults(uncorrectableOutput.Output, correctableOutput.Output)
 	if err != nil {
 		logger.Error("SRAM Check: FAIL - Could not parse SRAM error results:", err)
-		rep.AddSRAMErrorResult("FAIL", 0, 0, err)
+		rep.AddSRAMErrorResult("FAIL", 0, 0, nil, err)
 		return fmt.Errorf("failed to parse SRAM error results: %w", err)
 	}
 	logger.Info("Found SRAM data for", len(sramResults), "GPUs")
@@ -310,30 +322,36 @@ func RunSRAMCheck() error {
 	// Step 5: Validate SRAM error counts against thresholds
 	logger.Info("Step 4: Validating SRAM error counts...")
 	status, summary := checkSRAMThresholds(sramResults, sramErrorCheckTestConfig)
+	perGPUErrors := buildSRAMPerGPUErrors(sramResults)
+	for _, result := range sramResults {
+		if result.Uncorrectable > 0 || result.Correctable > 0 {
+			logger.Infof("GPU %d: uncorrectable %d, correctable %d", result.GPUIndex, result.Uncorrectable, result.Correctable)
+		}
+	}
 
 	// Step 6: Report results
 	if status == "PASS" {
 		logger.Info("SRAM Check: PASS - All SRAM error counts within acceptable limits")
 		logger.Info("Max uncorrectable errors:", summary.MaxUncorrectable)
 		logger.Info("Max correctable errors:", summary.MaxCorrectable)
-		rep.AddSRAMErrorResult("PASS", summary.MaxUncorrectable, summary.MaxCorrectable, nil)
+		rep.AddSRAMErrorResult("PASS", summary.MaxUncorrectable, summary.MaxCorrectable, perGPUErrors, nil)
 		return nil
 	} else if status == "WARN" {
 		logger.Info("SRAM Check: FAIL - Correctable errors exceed threshold")
 		logger.Info("GPUs with excessive correctable errors:", summary.GPUsWithCorrectable)
 		logger.Info("Max correctable errors:", summary.MaxCorrectable)
-		err = fmt.Errorf("correctable SRAM errors exceed threshold: max=%d, threshold=%d",
-			summary.MaxCorrectable, sramErrorCheckTestConfig.CorrectableThreshold)
+		err = &testerrors.TestThresholdExceededError{TestName: "sram_error_check", Metric: "max_correctable",
+			Actual: summary.MaxCorrectable, Expected: sramErrorCheckTestConfig.CorrectableThreshold}
 		// Sending FAIL as the threshold is exceeded
-		rep.AddSRAMErrorResult("FAIL", summary.MaxUncorrectable, summary.MaxCorrectable, err)
+		rep.AddSRAMErrorResult("FAIL", summary.MaxUncorrectable, summary.MaxCorrectable, perGPUErrors, err)
 		return err
 	} else {
 		logger.Error("SRAM Check: FAIL - Uncorrectable errors exceed threshold")
 		logger.Error("GPUs with uncorrectable errors:", summary.GPUsWithUncorrectable)
 		logger.Error("Max uncorrectable errors:", summary.MaxUncorrectable)
-		err = fmt.Errorf("uncorrectable SRAM errors exceed threshold: max=%d, threshold=%d",
-			summary.MaxUncorrectable, sramErrorCheckTestConfig.UncorrectableThreshold)
-		rep.AddSRAMErrorResult("FAIL", summary.MaxUncorrectable, summary.MaxCorrectable, err)
+		err = &testerrors.TestThresholdExceededError{TestName: "sram_error_check", Metric: "max_uncorrectable",
+			Actual: summary.MaxUncorrectable, Expected: sramErrorCheckTestConfig.UncorrectableThreshold}
+		rep.AddSRAMErrorResult("FAIL", summary.MaxUncorrectable, summary.MaxCorrectable, perGPUErrors, err)
 		return err
 	}
 }
@@ -0,0 +1,199 @@
+package level1_tests
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// SystemdServiceCheckTestConfig represents the config needed to run this test
+type SystemdServiceCheckTestConfig struct {
+	IsEnabled        bool     `json:"enabled"`
+	Shape            string   `json:"shape"`
+	ExpectedActive   []string `json:"expected_active"`
+	ExpectedInactive []string `json:"expected_inactive"`
+}
+
+// SystemdServiceState represents the expected and actual state of a single service
+type SystemdServiceState struct {
+	Name        string `json:"name"`
+	Expected    string `json:"expected"`
+	ActiveState string `json:"active_state"`
+	Result      string `json:"result"`
+	ExitCode    int    `json:"exit_code"`
+	Status      string `json:"status"`
+}
+
+// getSystemdServiceCheckTestConfig gets test config needed to run this test
+func getSystemdServiceCheckTestConfig() (*SystemdServiceCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	systemdServiceCheckTestConfig := &SystemdServiceCheckTestConfig{
+		IsEnabled:        false,
+		Shape:            shape,
+		ExpectedActive:   []string{},
+		ExpectedInactive: []string{},
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "systemd_service_check")
+	if err != nil {
+		return nil, err
+	}
+	systemdServiceCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "systemd_service_check")
+	if err == nil {
+		if thresholdMap, ok := threshold.(map[string]interface{}); ok {
+			if active, ok := thresholdMap["expected_active"].([]interface{}); ok {
+				for _, service := range active {
+					if serviceStr, ok := service.(string); ok {
+						systemdServiceCheckTestConfig.ExpectedActive = append(systemdServiceCheckTestConfig.ExpectedActive, serviceStr)
+					}
+				}
+			}
+			if inactive, ok := thresholdMap["expected_inactive"].([]interface{}); ok {
+				for _, service := range inactive {
+					if serviceStr, ok := service.(string); ok {
+						systemdServiceCheckTestConfig.ExpectedInactive = append(systemdServiceCheckTestConfig.ExpectedInactive, serviceStr)
+					}
+				}
+			}
+		}
+	}
+
+	return systemdServiceCheckTestConfig, nil
+}
+
+// parseSystemctlShow parses "Key=Value" lines from systemctl show output
+func parseSystemctlShow(output string) map[string]string {
+	properties := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(parts) == 2 {
+			properties[parts[0]] = parts[1]
+		}
+	}
+	return properties
+}
+
+// validateServiceStates sets the per-service status and returns an error listing
+// services that should be active but are not, and services that should be inactive but are running
+func validateServiceStates(services []SystemdServiceState) error {
+	var failures []string
+	for i := range services {
+		service := &services[i]
+		isActive := service.ExitCode == 0
+
+		if (service.Expected == "active") == isActive {
+			service.Status = "PASS"
+			continue
+		}
+
+		service.Status = "FAIL"
+		if service.Expected == "active" {
+			failures = append(failures, fmt.Sprintf("%s is %s (expected active)", service.Name, service.ActiveState))
+		} else {
+			failures = append(failures, fmt.Sprintf("%s is running (expected inactive)", service.Name))
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// getServiceState gets the active state, start result and is-active exit code of a service
+func getServiceState(name string, expected string) SystemdServiceState {
+	service := SystemdServiceState{Name: name, Expected: expected}
+
+	result, _ := executor.RunSystemctlIsActive(name)
+	service.ExitCode = result.ExitCode
+	if result.Error != nil && result.ExitCode == 0 {
+		// systemctl could not be run at all
+		service.ExitCode = -1
+	}
+
+	showResult, err := executor.RunSystemctlShow(name, "ActiveState", "Result")
+	if err == nil {
+		properties := parseSystemctlShow(showResult.Output)
+		service.ActiveState = properties["ActiveState"]
+		service.Result = properties["Result"]
+	}
+	if service.ActiveState == "" {
+		service.ActiveState = "unknown"
+	}
+
+	return service
+}
+
+func RunSystemdServiceCheck() error {
+	logger.Info("=== Systemd Service Check ===")
+	testConfig, err := getSystemdServiceCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "systemd_service_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting systemd service health check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get the state of each configured service
+	logger.Info("Step 1: Getting service states...")
+	logger.Info("Expected active services:", testConfig.ExpectedActive)
+	logger.Info("Expected inactive services:", testConfig.ExpectedInactive)
+	var services []SystemdServiceState
+	for _, name := range testConfig.ExpectedActive {
+		services = append(services, getServiceState(name, "active"))
+	}
+	for _, name := range testConfig.ExpectedInactive {
+		services = append(services, getServiceState(name, "inactive"))
+	}
+
+	if len(services) == 0 {
+		err := fmt.Errorf("no services configured for shape %s", testConfig.Shape)
+		logger.Error("Systemd Service Check: FAIL -", err)
+		rep.AddSystemdServiceResult("FAIL", nil, err)
+		return err
+	}
+
+	// Step 2: Validate service states
+	logger.Info("Step 2: Validating service states...")
+	err = validateServiceStates(services)
+	for _, service := range services {
+		logger.Infof("%s: %s (result %s, exit code %d), expected %s - %s",
+			service.Name, service.ActiveState, service.Result, service.ExitCode, service.Expected, service.Status)
+	}
+	if err != nil {
+		logger.Error("Systemd Service Check: FAIL -", err)
+		rep.AddSystemdServiceResult("FAIL", services, err)
+		return err
+	}
+
+	logger.Info("Systemd Service Check: PASS - All services are in the expected state")
+	rep.AddSystemdServiceResult("PASS", services, nil)
+	return nil
+}
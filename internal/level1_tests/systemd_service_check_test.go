@@ -0,0 +1,79 @@
+package level1_tests
+
+import (
+	"testing"
+)
+
+// Test parseSystemctlShow function
+func TestParseSystemctlShow(t *testing.T) {
+	properties := parseSystemctlShow("ActiveState=failed\nResult=exit-code\n")
+	if properties["ActiveState"] != "failed" {
+		t.Errorf("ActiveState = %s, want failed", properties["ActiveState"])
+	}
+	if properties["Result"] != "exit-code" {
+		t.Errorf("Result = %s, want exit-code", properties["Result"])
+	}
+
+	if properties := parseSystemctlShow(""); len(properties) != 0 {
+		t.Errorf("parseSystemctlShow(\"\") returned %d properties, want 0", len(properties))
+	}
+}
+
+// Test validateServiceStates function
+func TestValidateServiceStates(t *testing.T) {
+	tests := []struct {
+		name           string
+		services       []SystemdServiceState
+		expectError    bool
+		expectedStatus []string
+	}{
+		{
+			name: "All services in expected state",
+			services: []SystemdServiceState{
+				{Name: "nvidia-fabricmanager", Expected: "active", ActiveState: "active", ExitCode: 0},
+				{Name: "opensm", Expected: "inactive", ActiveState: "inactive", ExitCode: 3},
+			},
+			expectError:    false,
+			expectedStatus: []string{"PASS", "PASS"},
+		},
+		{
+			name: "Required service failed",
+			services: []SystemdServiceState{
+				{Name: "nvidia-fabricmanager", Expected: "active", ActiveState: "failed", Result: "exit-code", ExitCode: 3},
+				{Name: "nvidia-persistenced", Expected: "active", ActiveState: "active", ExitCode: 0},
+			},
+			expectError:    true,
+			expectedStatus: []string{"FAIL", "PASS"},
+		},
+		{
+			name: "Required service not installed",
+			services: []SystemdServiceState{
+				{Name: "nvidia-persistenced", Expected: "active", ActiveState: "inactive", ExitCode: 4},
+			},
+			expectError:    true,
+			expectedStatus: []string{"FAIL"},
+		},
+		{
+			name: "Mutually exclusive service running",
+			services: []SystemdServiceState{
+				{Name: "opensm", Expected: "inactive", ActiveState: "active", ExitCode: 0},
+			},
+			expectError:    true,
+			expectedStatus: []string{"FAIL"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServiceStates(tt.services)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateServiceStates() error = %v, wantErr %v", err, tt.expectError)
+			}
+			for i, want := range tt.expectedStatus {
+				if tt.services[i].Status != want {
+					t.Errorf("%s status = %v, want %v", tt.services[i].Name, tt.services[i].Status, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package level1_tests
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// TimeSyncCheckTestConfig represents the config needed to run this test
+type TimeSyncCheckTestConfig struct {
+	IsEnabled       bool    `json:"enabled"`
+	Shape           string  `json:"shape"`
+	MaxOffsetMs     float64 `json:"max_offset_ms"`
+	ExtremeOffsetMs float64 `json:"extreme_offset_ms"`
+}
+
+// TimeSyncInfo represents the clock synchronization state of the host
+type TimeSyncInfo struct {
+	Source          string  `json:"source"`
+	Synchronized    bool    `json:"synchronized"`
+	OffsetMs        float64 `json:"offset_ms"`
+	OffsetAvailable bool    `json:"offset_available"`
+	ReferenceServer string  `json:"reference_server"`
+	Stratum         int     `json:"stratum"`
+}
+
+// getTimeSyncCheckTestConfig gets test config needed to run this test
+func getTimeSyncCheckTestConfig() (*TimeSyncCheckTestConfig, error) {
+	// Get shape from IMDS
+	shape, err := executor.GetCurrentShape()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration from test_limits.json
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize with defaults
+	timeSyncCheckTestConfig := &TimeSyncCheckTestConfig{
+		IsEnabled:       false,
+		Shape:           shape,
+		MaxOffsetMs:     10,
+		ExtremeOffsetMs: 1000,
+	}
+
+	enabled, err := limits.IsTestEnabled(shape, "time_sync_check")
+	if err != nil {
+		return nil, err
+	}
+	timeSyncCheckTestConfig.IsEnabled = enabled
+
+	// Get threshold configuration if available
+	threshold, err := limits.GetThresholdForTest(shape, "time_sync_check")
+	if err == nil {
+		switch v := threshold.(type) {
+		case map[string]interface{}:
+			if maxOffset, ok := v["max_offset_ms"].(float64); ok {
+				timeSyncCheckTestConfig.MaxOffsetMs = maxOffset
+			}
+			if extremeOffset, ok := v["extreme_offset_ms"].(float64); ok {
+				timeSyncCheckTestConfig.ExtremeOffsetMs = extremeOffset
+			}
+		}
+	}
+
+	return timeSyncCheckTestConfig, nil
+}
+
+// parseChronycTracking parses chronyc tracking output
+func parseChronycTracking(output string) (*TimeSyncInfo, error) {
+	info := &TimeSyncInfo{Source: "chronyc"}
+	foundOffset := false
+
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+
+		switch key {
+		case "Reference ID":
+			// Reference ID    : A9FEA97B (169.254.169.123)
+			if start := strings.Index(value, "("); start >= 0 {
+				info.ReferenceServer = strings.TrimSuffix(value[start+1:], ")")
+			} else {
+				info.ReferenceServer = value
+			}
+		case "Stratum":
+			stratum, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid stratum in chronyc tracking output: %s", value)
+			}
+			info.Stratum = stratum
+		case "System time":
+			// System time     : 0.000012345 seconds fast of NTP time
+			fields := strings.Fields(value)
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("invalid system time in chronyc tracking output: %s", value)
+			}
+			seconds, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid system time offset in chronyc tracking output: %s", value)
+			}
+			info.OffsetMs = math.Abs(seconds) * 1000
+			foundOffset = true
+		case "Leap status":
+			info.Synchronized = value != "Not synchronised"
+		}
+	}
+
+	if !foundOffset {
+		return nil, fmt.Errorf("system time offset not found in chronyc tracking output")
+	}
+	info.OffsetAvailable = true
+
+	return info, nil
+}
+
+// parseTimedatectlShow parses timedatectl show output. timedatectl does not report the offset.
+func parseTimedatectlShow(output string) (*TimeSyncInfo, bool, error) {
+	info := &TimeSyncInfo{Source: "timedatectl"}
+	ntpEnabled := false
+	foundSync := false
+
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "NTP":
+			ntpEnabled = parts[1] == "yes"
+		case "NTPSynchronized":
+			info.Synchronized = parts[1] == "yes"
+			foundSync = true
+		}
+	}
+
+	if !foundSync {
+		return nil, false, fmt.Errorf("NTPSynchronized not found in timedatectl output")
+	}
+
+	return info, ntpEnabled, nil
+}
+
+// validateTimeSync returns PASS, WARN or FAIL for the synchronization state
+func validateTimeSync(info *TimeSyncInfo, maxOffsetMs float64, extremeOffsetMs float64) (string, error) {
+	if info.OffsetAvailable && info.OffsetMs > extremeOffsetMs {
+		return "FAIL", &testerrors.TestThresholdExceededError{TestName: "time_sync_check", Metric: "offset_ms", Actual: info.OffsetMs, Expected: extremeOffsetMs}
+	}
+	if info.OffsetAvailable && info.OffsetMs > maxOffsetMs {
+		return "WARN", &testerrors.TestThresholdExceededError{TestName: "time_sync_check", Metric: "offset_ms", Actual: info.OffsetMs, Expected: maxOffsetMs}
+	}
+	if !info.Synchronized {
+		return "WARN", fmt.Errorf("clock is not yet synchronized")
+	}
+	return "PASS", nil
+}
+
+// getTimeSyncInfo reads synchronization state from chronyc, falling back to timedatectl
+func getTimeSyncInfo() (*TimeSyncInfo, error) {
+	result, err := executor.RunChronycTracking()
+	if err == nil {
+		return parseChronycTracking(result.Output)
+	}
+	logger.Info("chronyc not available, falling back to timedatectl:", err)
+
+	result, err = executor.RunTimedatectlShow()
+	if err != nil {
+		return nil, fmt.Errorf("no time synchronization service is running: %w", commandError("time_sync_check", result, err))
+	}
+
+	info, ntpEnabled, err := parseTimedatectlShow(result.Output)
+	if err != nil {
+		return nil, err
+	}
+	if !ntpEnabled {
+		return info, fmt.Errorf("NTP synchronization is disabled")
+	}
+	return info, nil
+}
+
+func RunTimeSyncCheck() error {
+	logger.Info("=== Time Sync Check ===")
+	testConfig, err := getTimeSyncCheckTestConfig()
+	if err != nil {
+		return err
+	}
+
+	if !testConfig.IsEnabled {
+		errorStatement := fmt.Sprintf("Test not applicable for this shape %s", testConfig.Shape)
+		logger.Info(errorStatement)
+		return &testerrors.TestDisabledError{TestName: "time_sync_check", Shape: testConfig.Shape}
+	}
+
+	logger.Info("Starting time synchronization check...")
+	rep := reporter.GetReporter()
+
+	// Step 1: Get time synchronization state
+	logger.Info("Step 1: Getting time synchronization state...")
+	info, err := getTimeSyncInfo()
+	if err != nil {
+		logger.Error("Time Sync Check: FAIL -", err)
+		if info == nil {
+			info = &TimeSyncInfo{}
+		}
+		rep.AddTimeSyncResult("FAIL", info.Synchronized, info.OffsetMs, info.ReferenceServer, info.Stratum, err)
+		return err
+	}
+	logger.Infof("Time sync (%s): synchronized %t, offset %.3fms, reference %s, stratum %d",
+		info.Source, info.Synchronized, info.OffsetMs, info.ReferenceServer, info.Stratum)
+
+	// Step 2: Validate offset against thresholds
+	logger.Info("Step 2: Validating clock offset...")
+	logger.Infof("Max offset: %.3fms, extreme offset: %.3fms", testConfig.MaxOffsetMs, testConfig.ExtremeOffsetMs)
+	status, err := validateTimeSync(info, testConfig.MaxOffsetMs, testConfig.ExtremeOffsetMs)
+	switch status {
+	case "PASS":
+		logger.Info("Time Sync Check: PASS - Clock is synchronized within threshold")
+		rep.AddTimeSyncResult("PASS", info.Synchronized, info.OffsetMs, info.ReferenceServer, info.Stratum, nil)
+		return nil
+	case "WARN":
+		logger.Info("Time Sync Check: WARN -", err)
+		rep.AddTimeSyncResult("WARN", info.Synchronized, info.OffsetMs, info.ReferenceServer, info.Stratum, err)
+		return err
+	default: // FAIL
+		logger.Error("Time Sync Check: FAIL -", err)
+		rep.AddTimeSyncResult("FAIL", info.Synchronized, info.OffsetMs, info.ReferenceServer, info.Stratum, err)
+		return err
+	}
+}
@@ -0,0 +1,147 @@
+package level1_tests
+
+import (
+	"math"
+	"testing"
+)
+
+const testChronycTracking = `Reference ID    : A9FEA97B (169.254.169.123)
+Stratum         : 4
+Ref time (UTC)  : Thu Oct 15 10:12:41 2026
+System time     : 0.000012345 seconds fast of NTP time
+Last offset     : -0.000001234 seconds
+RMS offset      : 0.000010000 seconds
+Frequency       : 12.345 ppm slow
+Residual freq   : -0.001 ppm
+Skew            : 0.010 ppm
+Root delay      : 0.000512000 seconds
+Root dispersion : 0.000100000 seconds
+Update interval : 16.0 seconds
+Leap status     : Normal`
+
+// Test parseChronycTracking function
+func TestParseChronycTracking(t *testing.T) {
+	info, err := parseChronycTracking(testChronycTracking)
+	if err != nil {
+		t.Fatalf("parseChronycTracking() unexpected error: %v", err)
+	}
+	if info.ReferenceServer != "169.254.169.123" {
+		t.Errorf("ReferenceServer = %s, want 169.254.169.123", info.ReferenceServer)
+	}
+	if info.Stratum != 4 {
+		t.Errorf("Stratum = %d, want 4", info.Stratum)
+	}
+	if math.Abs(info.OffsetMs-0.012345) > 1e-9 {
+		t.Errorf("OffsetMs = %f, want 0.012345", info.OffsetMs)
+	}
+	if !info.Synchronized || !info.OffsetAvailable {
+		t.Errorf("Synchronized = %t, OffsetAvailable = %t, want true, true", info.Synchronized, info.OffsetAvailable)
+	}
+
+	// Slow clocks report a positive value too, the offset is always absolute
+	info, err = parseChronycTracking("System time     : 0.250000000 seconds slow of NTP time\nLeap status     : Not synchronised")
+	if err != nil {
+		t.Fatalf("parseChronycTracking() unexpected error: %v", err)
+	}
+	if math.Abs(info.OffsetMs-250) > 1e-9 || info.Synchronized {
+		t.Errorf("got offset %f synchronized %t, want 250 false", info.OffsetMs, info.Synchronized)
+	}
+
+	if _, err := parseChronycTracking("506 Cannot talk to daemon"); err == nil {
+		t.Error("parseChronycTracking() expected error when daemon is not reachable")
+	}
+}
+
+// Test parseTimedatectlShow function
+func TestParseTimedatectlShow(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		expectedSync bool
+		expectedNTP  bool
+		expectError  bool
+	}{
+		{
+			name:         "Synchronized",
+			input:        "Timezone=UTC\nLocalRTC=no\nCanNTP=yes\nNTP=yes\nNTPSynchronized=yes\n",
+			expectedSync: true,
+			expectedNTP:  true,
+		},
+		{
+			name:         "NTP disabled",
+			input:        "Timezone=UTC\nNTP=no\nNTPSynchronized=no\n",
+			expectedSync: false,
+			expectedNTP:  false,
+		},
+		{
+			name:        "Missing NTPSynchronized",
+			input:       "Timezone=UTC\n",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, ntpEnabled, err := parseTimedatectlShow(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseTimedatectlShow() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if info.Synchronized != tt.expectedSync || ntpEnabled != tt.expectedNTP {
+				t.Errorf("parseTimedatectlShow() = %t, %t, want %t, %t", info.Synchronized, ntpEnabled, tt.expectedSync, tt.expectedNTP)
+			}
+			if info.OffsetAvailable {
+				t.Error("timedatectl should not report an offset")
+			}
+		})
+	}
+}
+
+// Test validateTimeSync function
+func TestValidateTimeSync(t *testing.T) {
+	tests := []struct {
+		name           string
+		info           *TimeSyncInfo
+		expectedStatus string
+	}{
+		{
+			name:           "Within threshold",
+			info:           &TimeSyncInfo{Synchronized: true, OffsetMs: 0.5, OffsetAvailable: true},
+			expectedStatus: "PASS",
+		},
+		{
+			name:           "Above threshold",
+			info:           &TimeSyncInfo{Synchronized: true, OffsetMs: 50, OffsetAvailable: true},
+			expectedStatus: "WARN",
+		},
+		{
+			name:           "Extreme offset",
+			info:           &TimeSyncInfo{Synchronized: true, OffsetMs: 5000, OffsetAvailable: true},
+			expectedStatus: "FAIL",
+		},
+		{
+			name:           "Not yet synchronized",
+			info:           &TimeSyncInfo{Synchronized: false, OffsetMs: 1, OffsetAvailable: true},
+			expectedStatus: "WARN",
+		},
+		{
+			name:           "Synchronized without offset",
+			info:           &TimeSyncInfo{Synchronized: true},
+			expectedStatus: "PASS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := validateTimeSync(tt.info, 10, 1000)
+			if status != tt.expectedStatus {
+				t.Errorf("validateTimeSync() status = %v, want %v", status, tt.expectedStatus)
+			}
+			if (status == "PASS") != (err == nil) {
+				t.Errorf("validateTimeSync() error = %v for status %s", err, status)
+			}
+		})
+	}
+}
@@ -3,13 +3,17 @@
 package logger
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -19,8 +23,27 @@ var (
 	debugLogger *log.Logger
 	logFile     *os.File
 	logLevel    string = "info" // Default log level
+	logFormat   string = "text" // Default log format
+
+	fieldsMutex  sync.RWMutex
+	globalFields = Fields{}
 )
 
+// LogLevels are the accepted log levels, from most to least verbose
+var LogLevels = []string{"debug", "info", "warn", "error"}
+
+// LogFormats are the accepted log formats. json writes one JSON object per line with
+// timestamp, level, message, caller and the structured fields of the entry.
+var LogFormats = []string{"text", "json"}
+
+// Fields are structured fields added to a log entry, such as test_name, shape and duration_ms
+type Fields map[string]interface{}
+
+// Entry logs messages with structured fields
+type Entry struct {
+	fields Fields
+}
+
 // InitLogger initializes the logger with optional file output
 func InitLogger(logFilePath string) error {
 	return InitLoggerWithLevel(logFilePath, logLevel)
@@ -88,7 +111,12 @@ func CloseLogFile() error {
 
 // getCallerInfo returns the filename, function name, and line number of the caller
 func getCallerInfo() string {
-	pc, file, line, ok := runtime.Caller(3)
+	return callerInfo(4)
+}
+
+// callerInfo returns the filename, function name, and line number of the caller skip frames up the stack
+func callerInfo(skip int) string {
+	pc, file, line, ok := runtime.Caller(skip)
 	if !ok {
 		return "unknown:unknown:0"
 	}
@@ -133,69 +161,203 @@ func isNvidiaSMIFunction(funcName string) bool {
 
 // formatMessage creates a formatted log message with timestamp, level, caller info
 func formatMessage(level string, msg string) string {
+	return formatEntry(level, getCallerInfo(), msg, nil)
+}
+
+// formatEntry creates a text log message with timestamp, level, caller info and the
+// structured fields appended as key=value pairs in key order
+func formatEntry(level string, caller string, msg string, fields Fields) string {
 	now := time.Now().UTC()
-	caller := getCallerInfo()
-	return fmt.Sprintf("%s: %s %s: %s", level, now.Format("2006/01/02 15:04:05"), caller, msg)
+	line := fmt.Sprintf("%s: %s %s: %s", level, now.Format("2006/01/02 15:04:05"), caller, msg)
+	for _, key := range sortedKeys(fields) {
+		line += fmt.Sprintf(" %s=%v", key, fields[key])
+	}
+	return line
+}
+
+// formatJSONEntry creates a JSON log message with timestamp, level, message, caller and the structured fields
+func formatJSONEntry(level string, caller string, msg string, fields Fields) string {
+	var buffer strings.Builder
+	handler := slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			switch {
+			case len(groups) > 0:
+			case attr.Key == slog.TimeKey:
+				return slog.String("timestamp", attr.Value.Time().UTC().Format(time.RFC3339Nano))
+			case attr.Key == slog.MessageKey:
+				attr.Key = "message"
+			case attr.Key == slog.LevelKey:
+				return slog.String(slog.LevelKey, level)
+			}
+			return attr
+		},
+	})
+
+	record := slog.NewRecord(time.Now(), slog.LevelInfo, msg, 0)
+	record.AddAttrs(slog.String("caller", caller))
+	for _, key := range sortedKeys(fields) {
+		record.AddAttrs(slog.Any(key, fields[key]))
+	}
+	if err := handler.Handle(context.Background(), record); err != nil {
+		return formatEntry(level, caller, msg, fields)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// sortedKeys returns the keys of fields in sorted order
+func sortedKeys(fields Fields) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// output writes a message at level with the global fields and fields to the logger of the
+// level. It must be called directly by the exported logging functions to report their caller.
+func output(level string, msg string, fields Fields) {
+	caller := callerInfo(3)
+
+	fieldsMutex.RLock()
+	entryFields := make(Fields, len(globalFields)+len(fields))
+	for key, value := range globalFields {
+		entryFields[key] = value
+	}
+	fieldsMutex.RUnlock()
+	for key, value := range fields {
+		entryFields[key] = value
+	}
+
+	line := formatEntry(level, caller, msg, entryFields)
+	if logFormat == "json" {
+		line = formatJSONEntry(level, caller, msg, entryFields)
+	}
+
+	switch level {
+	case "ERROR":
+		errorLogger.Println(line)
+	case "DEBUG":
+		debugLogger.Println(line)
+	default:
+		infoLogger.Println(line)
+	}
 }
 
 // Info logs an info message
 func Info(v ...interface{}) {
 	if shouldLog("info") {
-		msg := fmt.Sprint(v...)
-		infoLogger.Println(formatMessage("INFO", msg))
+		output("INFO", fmt.Sprint(v...), nil)
+	}
+}
+
+// Warn logs a warning message
+func Warn(v ...interface{}) {
+	if shouldLog("warn") {
+		output("WARN", fmt.Sprint(v...), nil)
 	}
 }
 
 // Error logs an error message
 func Error(v ...interface{}) {
 	if shouldLog("error") {
-		msg := fmt.Sprint(v...)
-		errorLogger.Println(formatMessage("ERROR", msg))
+		output("ERROR", fmt.Sprint(v...), nil)
 	}
 }
 
 // Debug logs a debug message
 func Debug(v ...interface{}) {
 	if shouldLog("debug") {
-		msg := fmt.Sprint(v...)
-		debugLogger.Println(formatMessage("DEBUG", msg))
+		output("DEBUG", fmt.Sprint(v...), nil)
 	}
 }
 
 // Infof logs a formatted info message
 func Infof(format string, v ...interface{}) {
 	if shouldLog("info") {
-		msg := fmt.Sprintf(format, v...)
-		infoLogger.Println(formatMessage("INFO", msg))
+		output("INFO", fmt.Sprintf(format, v...), nil)
+	}
+}
+
+// Warnf logs a formatted warning message
+func Warnf(format string, v ...interface{}) {
+	if shouldLog("warn") {
+		output("WARN", fmt.Sprintf(format, v...), nil)
 	}
 }
 
 // Errorf logs a formatted error message
 func Errorf(format string, v ...interface{}) {
 	if shouldLog("error") {
-		msg := fmt.Sprintf(format, v...)
-		errorLogger.Println(formatMessage("ERROR", msg))
+		output("ERROR", fmt.Sprintf(format, v...), nil)
 	}
 }
 
 // Debugf logs a formatted debug message
 func Debugf(format string, v ...interface{}) {
 	if shouldLog("debug") {
-		msg := fmt.Sprintf(format, v...)
-		debugLogger.Println(formatMessage("DEBUG", msg))
+		output("DEBUG", fmt.Sprintf(format, v...), nil)
+	}
+}
+
+// WithFields returns an entry logging its messages with fields, e.g.
+// logger.WithFields(logger.Fields{"test_name": name, "duration_ms": ms}).Infof(...)
+func WithFields(fields Fields) *Entry {
+	return &Entry{fields: fields}
+}
+
+// Debugf logs a formatted debug message with the fields of the entry
+func (e *Entry) Debugf(format string, v ...interface{}) {
+	if shouldLog("debug") {
+		output("DEBUG", fmt.Sprintf(format, v...), e.fields)
 	}
 }
 
+// Infof logs a formatted info message with the fields of the entry
+func (e *Entry) Infof(format string, v ...interface{}) {
+	if shouldLog("info") {
+		output("INFO", fmt.Sprintf(format, v...), e.fields)
+	}
+}
+
+// Warnf logs a formatted warning message with the fields of the entry
+func (e *Entry) Warnf(format string, v ...interface{}) {
+	if shouldLog("warn") {
+		output("WARN", fmt.Sprintf(format, v...), e.fields)
+	}
+}
+
+// Errorf logs a formatted error message with the fields of the entry
+func (e *Entry) Errorf(format string, v ...interface{}) {
+	if shouldLog("error") {
+		output("ERROR", fmt.Sprintf(format, v...), e.fields)
+	}
+}
+
+// SetField adds a structured field to every following log entry, such as the shape of the
+// host. A nil value removes the field.
+func SetField(key string, value interface{}) {
+	fieldsMutex.Lock()
+	defer fieldsMutex.Unlock()
+	if value == nil {
+		delete(globalFields, key)
+		return
+	}
+	globalFields[key] = value
+}
+
 // shouldLog determines if a message should be logged based on the current log level
 func shouldLog(level string) bool {
 	currentLevel := strings.ToLower(logLevel)
 	targetLevel := strings.ToLower(level)
-	
+
 	switch currentLevel {
 	case "debug":
 		return true // Log everything
 	case "info":
-		return targetLevel == "info" || targetLevel == "error"
+		return targetLevel == "info" || targetLevel == "warn" || targetLevel == "error"
+	case "warn":
+		return targetLevel == "warn" || targetLevel == "error"
 	case "error":
 		return targetLevel == "error"
 	case "silent", "none":
@@ -209,3 +371,24 @@ func shouldLog(level string) bool {
 func SetLogLevel(level string) {
 	logLevel = level
 }
+
+// ParseLogLevel validates a log level given on the command line, one of LogLevels
+func ParseLogLevel(level string) (string, error) {
+	for _, valid := range LogLevels {
+		if strings.EqualFold(level, valid) {
+			return valid, nil
+		}
+	}
+	return "", fmt.Errorf("invalid log level %q, must be one of: %s", level, strings.Join(LogLevels, ", "))
+}
+
+// SetLogFormat sets the format of log lines, one of LogFormats
+func SetLogFormat(format string) error {
+	for _, valid := range LogFormats {
+		if strings.EqualFold(format, valid) {
+			logFormat = valid
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid log format %q, must be one of: %s", format, strings.Join(LogFormats, ", "))
+}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -548,4 +549,139 @@ func TestLogLevelFiltering(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestWarnf(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "test.log")
+
+	tests := []struct {
+		logLevel   string
+		expectWarn bool
+	}{
+		{"debug", true},
+		{"info", true},
+		{"warn", true},
+		{"error", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.logLevel, func(t *testing.T) {
+			os.Remove(logFilePath)
+			SetLogLevel(tt.logLevel)
+			if err := InitLogger(logFilePath); err != nil {
+				t.Fatalf("Failed to initialize logger: %v", err)
+			}
+
+			Warnf("formatted %s", "warning")
+			Info("test info message")
+			CloseLogFile()
+
+			content, err := os.ReadFile(logFilePath)
+			if err != nil {
+				t.Fatalf("Failed to read log file: %v", err)
+			}
+			output := string(content)
+			if strings.Contains(output, "WARN:") != tt.expectWarn || strings.Contains(output, "formatted warning") != tt.expectWarn {
+				t.Errorf("Expected warning logged = %v at level %s, got: %s", tt.expectWarn, tt.logLevel, output)
+			}
+			if tt.logLevel == "warn" && strings.Contains(output, "test info message") {
+				t.Error("Expected info message to be filtered out at warn level")
+			}
+		})
+	}
+	SetLogLevel("info")
+}
+
+func TestStructuredFields(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "test.log")
+	SetLogLevel("info")
+	if err := InitLogger(logFilePath); err != nil {
+		t.Fatalf("Failed to initialize logger: %v", err)
+	}
+	SetField("shape", "BM.GPU.H100.8")
+	defer SetField("shape", nil)
+
+	WithFields(Fields{"test_name": "gpu_count_check", "duration_ms": 42}).Infof("Test %s finished", "gpu_count_check")
+	CloseLogFile()
+
+	content, err := os.ReadFile(logFilePath)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	expected := "Test gpu_count_check finished duration_ms=42 shape=BM.GPU.H100.8 test_name=gpu_count_check"
+	if !strings.Contains(string(content), expected) {
+		t.Errorf("Expected fields in key order %q, got: %s", expected, content)
+	}
+	if !strings.Contains(string(content), "logger_test.go:TestStructuredFields:") {
+		t.Errorf("Expected the caller of Infof, got: %s", content)
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "test.log")
+	SetLogLevel("debug")
+	if err := InitLogger(logFilePath); err != nil {
+		t.Fatalf("Failed to initialize logger: %v", err)
+	}
+	if err := SetLogFormat("json"); err != nil {
+		t.Fatalf("SetLogFormat() error = %v", err)
+	}
+	defer func() {
+		SetLogFormat("text")
+		SetLogLevel("info")
+	}()
+
+	WithFields(Fields{"test_name": "link_check", "shape": "BM.GPU.H100.8", "duration_ms": 1500}).Warnf("Test %s is slow", "link_check")
+	Errorf("plain %s", "error")
+	CloseLogFile()
+
+	content, err := os.ReadFile(logFilePath)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 log lines, got %d: %s", len(lines), content)
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Log line is not JSON: %v: %s", err, lines[0])
+	}
+	expected := map[string]interface{}{
+		"level":       "WARN",
+		"message":     "Test link_check is slow",
+		"test_name":   "link_check",
+		"shape":       "BM.GPU.H100.8",
+		"duration_ms": float64(1500),
+	}
+	for key, value := range expected {
+		if entry[key] != value {
+			t.Errorf("%s = %v, want %v", key, entry[key], value)
+		}
+	}
+	if _, err := time.Parse(time.RFC3339Nano, entry["timestamp"].(string)); err != nil {
+		t.Errorf("Invalid timestamp %v: %v", entry["timestamp"], err)
+	}
+
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil || entry["level"] != "ERROR" || entry["message"] != "plain error" {
+		t.Errorf("Unexpected error line: %s", lines[1])
+	}
+}
+
+func TestParseLogLevelAndFormat(t *testing.T) {
+	for _, level := range []string{"debug", "info", "warn", "error", "WARN"} {
+		if _, err := ParseLogLevel(level); err != nil {
+			t.Errorf("ParseLogLevel(%q) error = %v", level, err)
+		}
+	}
+	if _, err := ParseLogLevel("verbose"); err == nil {
+		t.Error("ParseLogLevel(verbose) expected an error")
+	}
+
+	if err := SetLogFormat("xml"); err == nil {
+		t.Error("SetLogFormat(xml) expected an error")
+	}
+	if err := SetLogFormat("text"); err != nil {
+		t.Errorf("SetLogFormat(text) error = %v", err)
+	}
+}
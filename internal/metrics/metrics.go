@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus gauges for the last completed diagnostic run
+type Metrics struct {
+	mutex      sync.RWMutex
+	registry   *prometheus.Registry
+	testStatus *prometheus.GaugeVec
+	lastRun    *prometheus.GaugeVec
+}
+
+// NewMetrics creates the diagnostic gauges on their own registry
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		testStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "oci_hpc_diagnostic_test_status",
+			Help: "Status of each test in the last completed diagnostic run (1 = PASS, 0 = FAIL or WARN).",
+		}, []string{"test", "shape"}),
+		lastRun: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "oci_hpc_diagnostic_last_run_timestamp_seconds",
+			Help: "Unix time at which the last diagnostic run completed.",
+		}, []string{"shape"}),
+	}
+	m.registry.MustRegister(m.testStatus, m.lastRun)
+	return m
+}
+
+// statusValue converts a test status to its gauge value
+func statusValue(status string) float64 {
+	if status == "PASS" {
+		return 1
+	}
+	return 0
+}
+
+// Update replaces the published metrics with the results of a completed diagnostic run
+func (m *Metrics) Update(shape string, results map[string]reporter.TestResult) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.testStatus.Reset()
+	for testName, result := range results {
+		m.testStatus.WithLabelValues(testName, shape).Set(statusValue(result.Status))
+	}
+
+	m.lastRun.Reset()
+	m.lastRun.WithLabelValues(shape).SetToCurrentTime()
+	logger.Debugf("Updated metrics for %d test results", len(results))
+}
+
+// Handler returns the HTTP handler serving the metrics. Scrapes never observe a partially updated run.
+func (m *Metrics) Handler() http.Handler {
+	handler := promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mutex.RLock()
+		defer m.mutex.RUnlock()
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Serve starts an HTTP server exposing the metrics at /metrics on the given port
+func (m *Metrics) Serve(port int) (*http.Server, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	server := &http.Server{Handler: mux}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logger.Errorf("Metrics server stopped: %v", err)
+		}
+	}()
+
+	logger.Infof("Serving metrics at http://%s/metrics", listener.Addr())
+	return server, nil
+}
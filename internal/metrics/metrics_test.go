@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+func scrape(t *testing.T, handler http.Handler) string {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to scrape metrics: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read metrics: %v", err)
+	}
+	return string(body)
+}
+
+func TestMetricsUpdate(t *testing.T) {
+	m := NewMetrics()
+	m.Update("BM.GPU.H100.8", map[string]reporter.TestResult{
+		"gpu_count_check":  {Name: "gpu_count_check", Status: "PASS"},
+		"pcie_error_check": {Name: "pcie_error_check", Status: "FAIL"},
+		"sram_error_check": {Name: "sram_error_check", Status: "WARN"},
+	})
+
+	body := scrape(t, m.Handler())
+
+	expected := []string{
+		"# TYPE oci_hpc_diagnostic_test_status gauge",
+		`oci_hpc_diagnostic_test_status{shape="BM.GPU.H100.8",test="gpu_count_check"} 1`,
+		`oci_hpc_diagnostic_test_status{shape="BM.GPU.H100.8",test="pcie_error_check"} 0`,
+		`oci_hpc_diagnostic_test_status{shape="BM.GPU.H100.8",test="sram_error_check"} 0`,
+		"# TYPE oci_hpc_diagnostic_last_run_timestamp_seconds gauge",
+		`oci_hpc_diagnostic_last_run_timestamp_seconds{shape="BM.GPU.H100.8"}`,
+	}
+	for _, want := range expected {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected metrics to contain %q, got:\n%s", want, body)
+		}
+	}
+}
+
+func TestMetricsUpdateReplacesPreviousRun(t *testing.T) {
+	m := NewMetrics()
+	m.Update("BM.GPU.H100.8", map[string]reporter.TestResult{
+		"gpu_count_check": {Name: "gpu_count_check", Status: "FAIL"},
+		"link_check":      {Name: "link_check", Status: "PASS"},
+	})
+	m.Update("BM.GPU.H100.8", map[string]reporter.TestResult{
+		"gpu_count_check": {Name: "gpu_count_check", Status: "PASS"},
+	})
+
+	body := scrape(t, m.Handler())
+
+	if !strings.Contains(body, `oci_hpc_diagnostic_test_status{shape="BM.GPU.H100.8",test="gpu_count_check"} 1`) {
+		t.Errorf("Expected gpu_count_check to reflect the latest run, got:\n%s", body)
+	}
+	if strings.Contains(body, `test="link_check"`) {
+		t.Errorf("Expected link_check from the previous run to be removed, got:\n%s", body)
+	}
+}
+
+func TestMetricsBeforeFirstRun(t *testing.T) {
+	body := scrape(t, NewMetrics().Handler())
+
+	if strings.Contains(body, "oci_hpc_diagnostic_test_status{") {
+		t.Errorf("Expected no test status samples before the first run, got:\n%s", body)
+	}
+}
+
+func TestMetricsServe(t *testing.T) {
+	m := NewMetrics()
+	m.Update("BM.GPU.GB200.4", map[string]reporter.TestResult{
+		"gpu_count_check": {Name: "gpu_count_check", Status: "PASS"},
+	})
+
+	server, err := m.Serve(0)
+	if err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	defer server.Close()
+
+	if _, err := m.Serve(-1); err == nil {
+		t.Error("Expected error for invalid port")
+	}
+}
+
+func TestStatusValue(t *testing.T) {
+	tests := map[string]float64{"PASS": 1, "FAIL": 0, "WARN": 0, "SKIP": 0}
+	for status, want := range tests {
+		if got := statusValue(status); got != want {
+			t.Errorf("statusValue(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Package notifier sends notifications about diagnostic results to external services
+package notifier
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+// SignatureHeader carries the HMAC-SHA256 signature of the payload as "sha256=<hex digest>"
+// when the webhook is signed with a secret
+const SignatureHeader = "X-OCI-HPC-Signature"
+
+const (
+	// webhookTimeout bounds each delivery attempt so a slow receiver cannot stall the run
+	webhookTimeout = 10 * time.Second
+	// webhookAttempts is the number of delivery attempts before the notification is dropped
+	webhookAttempts = 3
+)
+
+// webhookRetryDelay is the delay before the second delivery attempt, doubled for every further attempt
+var webhookRetryDelay = time.Second
+
+// DefaultStatuses are the test statuses notified when no statuses are given
+var DefaultStatuses = []string{"FAIL"}
+
+// WebhookPayload represents the JSON body posted to a webhook. Tests holds only the
+// results of the tests with a notified status, keyed by test name.
+type WebhookPayload struct {
+	Hostname     string                              `json:"hostname"`
+	Shape        string                              `json:"shape,omitempty"`
+	TimestampUTC string                              `json:"timestamp_utc"`
+	Statuses     []string                            `json:"statuses"`
+	Tests        map[string][]map[string]interface{} `json:"tests"`
+}
+
+// NotifyWebhook posts the results of the tests of report with one of statuses to url.
+// Nothing is posted when no test has one of the statuses.
+func NotifyWebhook(url string, report *reporter.ReportOutput, statuses []string) error {
+	return NotifySignedWebhook(url, "", report, statuses)
+}
+
+// NotifySignedWebhook is like NotifyWebhook but signs the payload with secret in the
+// SignatureHeader header. An empty secret leaves the payload unsigned.
+func NotifySignedWebhook(url, secret string, report *reporter.ReportOutput, statuses []string) error {
+	if len(statuses) == 0 {
+		statuses = DefaultStatuses
+	}
+
+	tests, err := matchingTests(report, statuses)
+	if err != nil {
+		return err
+	}
+	if len(tests) == 0 {
+		logger.Debugf("No tests with status %v, skipping webhook notification", statuses)
+		return nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	body, err := json.Marshal(WebhookPayload{
+		Hostname:     hostname,
+		Shape:        report.Shape,
+		TimestampUTC: time.Now().UTC().Format(time.RFC3339),
+		Statuses:     statuses,
+		Tests:        tests,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	names := make([]string, 0, len(tests))
+	for name := range tests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	logger.Infof("Notifying webhook of %d test(s): %v", len(names), names)
+
+	return postWithRetries(url, secret, body)
+}
+
+// matchingTests returns the results of report with one of statuses, keyed by test name
+func matchingTests(report *reporter.ReportOutput, statuses []string) (map[string][]map[string]interface{}, error) {
+	results, err := report.TestResults()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report results: %w", err)
+	}
+
+	notified := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		notified[status] = true
+	}
+
+	tests := make(map[string][]map[string]interface{})
+	for name, testResults := range results {
+		for _, result := range testResults {
+			if status, ok := result["status"].(string); ok && notified[status] {
+				tests[name] = append(tests[name], result)
+			}
+		}
+	}
+	return tests, nil
+}
+
+// Sign returns the SignatureHeader value of body signed with secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// postWithRetries posts body to url up to webhookAttempts times with exponential backoff.
+// Client errors other than 429 Too Many Requests are not retried.
+func postWithRetries(url, secret string, body []byte) error {
+	client := &http.Client{Timeout: webhookTimeout}
+	delay := webhookRetryDelay
+
+	var err error
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		var retry bool
+		retry, err = post(client, url, secret, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == webhookAttempts {
+			break
+		}
+		logger.Debugf("Webhook delivery attempt %d of %d failed, retrying in %s: %v", attempt, webhookAttempts, delay, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+	return fmt.Errorf("failed to notify webhook: %w", err)
+}
+
+// post makes a single delivery attempt and returns whether a failed attempt may be retried
+func post(client *http.Client, url, secret string, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retry, fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return false, nil
+}
@@ -0,0 +1,166 @@
+package notifier
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+// createTestReport returns a report with a failed, a warned and a passed test
+func createTestReport() *reporter.ReportOutput {
+	return &reporter.ReportOutput{
+		Shape: "BM.GPU.H100.8",
+		Localhost: reporter.HostResults{
+			GPUCountCheck:  []reporter.GPUTestResult{{Status: "FAIL", GPUCount: 7, TimestampUTC: "2025-01-15T10:30:00Z"}},
+			GPUModeCheck:   []reporter.GPUModeTestResult{{Status: "WARN", Message: "MIG enabled", TimestampUTC: "2025-01-15T10:30:00Z"}},
+			PCIeErrorCheck: []reporter.PCIeTestResult{{Status: "PASS", TimestampUTC: "2025-01-15T10:30:00Z"}},
+		},
+	}
+}
+
+// withoutRetryDelay removes the backoff between delivery attempts for the duration of a test
+func withoutRetryDelay(t *testing.T) {
+	delay := webhookRetryDelay
+	webhookRetryDelay = time.Millisecond
+	t.Cleanup(func() { webhookRetryDelay = delay })
+}
+
+func TestNotifyWebhook(t *testing.T) {
+	var payload WebhookPayload
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		signature = r.Header.Get(SignatureHeader)
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode payload: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	if err := NotifyWebhook(server.URL, createTestReport(), nil); err != nil {
+		t.Fatalf("NotifyWebhook() error = %v", err)
+	}
+	if signature != "" {
+		t.Errorf("Expected no signature without a secret, got %q", signature)
+	}
+	if payload.Hostname == "" || payload.TimestampUTC == "" || payload.Shape != "BM.GPU.H100.8" {
+		t.Errorf("Expected hostname, timestamp and shape in payload, got %+v", payload)
+	}
+	if len(payload.Tests) != 1 || len(payload.Tests["gpu_count_check"]) != 1 {
+		t.Fatalf("Expected only gpu_count_check with the default FAIL status, got %v", payload.Tests)
+	}
+	if result := payload.Tests["gpu_count_check"][0]; result["status"] != "FAIL" || result["gpu_count"] != float64(7) {
+		t.Errorf("Unexpected gpu_count_check result %v", result)
+	}
+
+	if err := NotifyWebhook(server.URL, createTestReport(), []string{"FAIL", "WARN"}); err != nil {
+		t.Fatalf("NotifyWebhook() error = %v", err)
+	}
+	if len(payload.Tests) != 2 || payload.Tests["gpu_mode_check"] == nil || payload.Tests["pcie_error_check"] != nil {
+		t.Errorf("Expected gpu_count_check and gpu_mode_check for FAIL and WARN, got %v", payload.Tests)
+	}
+}
+
+func TestNotifyWebhook_NoMatchingTests(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	report := createTestReport()
+	report.Localhost.GPUCountCheck[0].Status = "PASS"
+	report.Localhost.GPUModeCheck[0].Status = "PASS"
+	if err := NotifyWebhook(server.URL, report, []string{"FAIL"}); err != nil {
+		t.Fatalf("NotifyWebhook() error = %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no notification for a passing report, got %d requests", requests)
+	}
+}
+
+func TestNotifySignedWebhook(t *testing.T) {
+	const secret = "webhook-secret"
+	var body []byte
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(SignatureHeader)
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	if err := NotifySignedWebhook(server.URL, secret, createTestReport(), nil); err != nil {
+		t.Fatalf("NotifySignedWebhook() error = %v", err)
+	}
+	if signature == "" || signature != Sign(secret, body) {
+		t.Errorf("Signature %q does not match the body", signature)
+	}
+	if signature == Sign("other-secret", body) {
+		t.Error("Expected the signature to depend on the secret")
+	}
+	// HMAC-SHA256 of "payload" with key "key"
+	if got := Sign("key", []byte("payload")); got != "sha256=5d98b45c90a207fa998ce639fea6f02ecc8cc3f36fef81d694fb856b4d0a28ca" {
+		t.Errorf("Sign() = %s", got)
+	}
+}
+
+func TestNotifyWebhook_Retries(t *testing.T) {
+	withoutRetryDelay(t)
+
+	t.Run("Succeeds After Server Errors", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer server.Close()
+
+		if err := NotifyWebhook(server.URL, createTestReport(), nil); err != nil {
+			t.Fatalf("NotifyWebhook() error = %v", err)
+		}
+		if requests != 3 {
+			t.Errorf("Expected 3 attempts, got %d", requests)
+		}
+	})
+
+	t.Run("Gives Up After 3 Attempts", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		if err := NotifyWebhook(server.URL, createTestReport(), nil); err == nil {
+			t.Error("Expected error after failed attempts")
+		}
+		if requests != webhookAttempts {
+			t.Errorf("Expected %d attempts, got %d", webhookAttempts, requests)
+		}
+	})
+
+	t.Run("Client Errors Are Not Retried", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		if err := NotifyWebhook(server.URL, createTestReport(), nil); err == nil {
+			t.Error("Expected error for rejected webhook")
+		}
+		if requests != 1 {
+			t.Errorf("Expected 1 attempt, got %d", requests)
+		}
+	})
+}
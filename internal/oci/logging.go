@@ -0,0 +1,210 @@
+package oci
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/loggingingestion"
+)
+
+const (
+	// LogEntryType is the type of the log entries sent to OCI Logging
+	LogEntryType = "com.oraclecloud.hpc.diagnostics.test_result"
+	// MaxBufferedLogEntries is the number of log entries buffered before they are sent
+	MaxBufferedLogEntries = 1000
+	// logSpecVersion is the version of the Logging Ingestion API payload
+	logSpecVersion = "1.0"
+)
+
+// logPutter sends log entries; implemented by loggingingestion.LoggingClient
+type logPutter interface {
+	PutLogs(ctx context.Context, request loggingingestion.PutLogsRequest) (loggingingestion.PutLogsResponse, error)
+}
+
+// TestResultLogEntry represents the structured log entry of a single test result
+type TestResultLogEntry struct {
+	InstanceOCID string                 `json:"instance_ocid"`
+	Shape        string                 `json:"shape"`
+	TestName     string                 `json:"test_name"`
+	Status       string                 `json:"status"`
+	Timestamp    string                 `json:"timestamp"`
+	Details      map[string]interface{} `json:"details,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// LoggingClient is a log.Logger backend sending every written line as a log entry to an
+// OCI Logging custom log. Entries are buffered and sent when the buffer is full or on Close.
+// The instance OCID is the subject of all entries so they can be correlated per node.
+type LoggingClient struct {
+	mutex        sync.Mutex
+	client       logPutter
+	logID        string
+	instanceOCID string
+	shape        string
+	hostname     string
+	entries      []loggingingestion.LogEntry
+}
+
+// NewLoggingClient creates a client for the custom log logID in logGroupID. The log is looked
+// up first so a wrong OCID pair or missing permissions are reported before any tests run.
+func NewLoggingClient(logGroupID, logID string) (*LoggingClient, error) {
+	metadata, err := executor.GetCurrentInstanceMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance metadata: %w", err)
+	}
+
+	provider, err := configurationProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	management, err := logging.NewLoggingManagementClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCI Logging management client: %w", err)
+	}
+	management.SetRegion(metadata.CanonicalRegionName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), postTimeout)
+	defer cancel()
+	if _, err := management.GetLog(ctx, logging.GetLogRequest{LogGroupId: common.String(logGroupID), LogId: common.String(logID)}); err != nil {
+		return nil, fmt.Errorf("failed to get log %s in log group %s: %w", logID, logGroupID, err)
+	}
+
+	client, err := loggingingestion.NewLoggingClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCI Logging ingestion client: %w", err)
+	}
+	client.SetRegion(metadata.CanonicalRegionName)
+
+	return &LoggingClient{
+		client:       client,
+		logID:        logID,
+		instanceOCID: metadata.ID,
+		shape:        metadata.Shape,
+		hostname:     metadata.Hostname,
+	}, nil
+}
+
+// Logger returns a log.Logger writing to this client
+func (c *LoggingClient) Logger() *log.Logger {
+	return log.New(c, "", 0)
+}
+
+// Write buffers each line of p as a log entry, sending the buffer when it is full
+func (c *LoggingClient) Write(p []byte) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now().UTC()
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		c.entries = append(c.entries, loggingingestion.LogEntry{
+			Data: common.String(line),
+			Id:   common.String(newEntryID()),
+			Time: &common.SDKTime{Time: now},
+		})
+		if len(c.entries) >= MaxBufferedLogEntries {
+			if err := c.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// LogTestResults writes one structured JSON log entry per test result
+func (c *LoggingClient) LogTestResults(results map[string]reporter.TestResult) {
+	testNames := make([]string, 0, len(results))
+	for testName := range results {
+		testNames = append(testNames, testName)
+	}
+	sort.Strings(testNames)
+
+	entryLogger := c.Logger()
+	for _, testName := range testNames {
+		result := results[testName]
+		data, err := json.Marshal(TestResultLogEntry{
+			InstanceOCID: c.instanceOCID,
+			Shape:        c.shape,
+			TestName:     testName,
+			Status:       result.Status,
+			Timestamp:    result.Timestamp.UTC().Format(time.RFC3339),
+			Details:      result.Details,
+			Error:        result.Error,
+		})
+		if err != nil {
+			logger.Errorf("Failed to marshal log entry for %s: %v", testName, err)
+			continue
+		}
+		entryLogger.Println(string(data))
+	}
+}
+
+// Flush sends all buffered log entries
+func (c *LoggingClient) Flush() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.flush()
+}
+
+// Close sends all buffered log entries; call it before the program exits
+func (c *LoggingClient) Close() error {
+	return c.Flush()
+}
+
+// flush sends the buffered entries; the caller must hold the mutex. Entries that fail
+// to send are dropped so the buffer stays bounded.
+func (c *LoggingClient) flush() error {
+	if len(c.entries) == 0 {
+		return nil
+	}
+	entries := c.entries
+	c.entries = nil
+
+	ctx, cancel := context.WithTimeout(context.Background(), postTimeout)
+	defer cancel()
+
+	_, err := c.client.PutLogs(ctx, loggingingestion.PutLogsRequest{
+		LogId: common.String(c.logID),
+		PutLogsDetails: loggingingestion.PutLogsDetails{
+			Specversion: common.String(logSpecVersion),
+			LogEntryBatches: []loggingingestion.LogEntryBatch{{
+				Entries:             entries,
+				Source:              common.String(c.hostname),
+				Type:                common.String(LogEntryType),
+				Subject:             common.String(c.instanceOCID),
+				Defaultlogentrytime: &common.SDKTime{Time: time.Now().UTC()},
+			}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send %d log entries to OCI Logging: %w", len(entries), err)
+	}
+
+	logger.Infof("Sent %d log entries to OCI Logging", len(entries))
+	return nil
+}
+
+// newEntryID returns a random identifier for a log entry
+func newEntryID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(id)
+}
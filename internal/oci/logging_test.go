@@ -0,0 +1,111 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-go-sdk/v65/loggingingestion"
+)
+
+type fakeLogPutter struct {
+	requests []loggingingestion.PutLogsRequest
+	err      error
+}
+
+func (f *fakeLogPutter) PutLogs(ctx context.Context, request loggingingestion.PutLogsRequest) (loggingingestion.PutLogsResponse, error) {
+	f.requests = append(f.requests, request)
+	return loggingingestion.PutLogsResponse{}, f.err
+}
+
+func newTestLoggingClient(putter *fakeLogPutter) *LoggingClient {
+	return &LoggingClient{
+		client:       putter,
+		logID:        "ocid1.log.oc1..test",
+		instanceOCID: "ocid1.instance.oc1..test",
+		shape:        "BM.GPU.H100.8",
+		hostname:     "gpu-node-1",
+	}
+}
+
+func TestLogTestResults(t *testing.T) {
+	putter := &fakeLogPutter{}
+	client := newTestLoggingClient(putter)
+
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	client.LogTestResults(map[string]reporter.TestResult{
+		"gpu_count_check":  {Status: "PASS", Details: map[string]interface{}{"gpu_count": 8}, Timestamp: timestamp},
+		"pcie_error_check": {Status: "FAIL", Error: "pcie errors", Timestamp: timestamp},
+	})
+
+	if len(putter.requests) != 0 {
+		t.Fatal("Expected entries to be buffered until flushed")
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if len(putter.requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(putter.requests))
+	}
+
+	request := putter.requests[0]
+	batch := request.LogEntryBatches[0]
+	if *request.LogId != "ocid1.log.oc1..test" || *batch.Subject != "ocid1.instance.oc1..test" || *batch.Type != LogEntryType {
+		t.Errorf("Unexpected request: %s", request)
+	}
+	if len(batch.Entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(batch.Entries))
+	}
+
+	var entry TestResultLogEntry
+	if err := json.Unmarshal([]byte(*batch.Entries[1].Data), &entry); err != nil {
+		t.Fatalf("Entry is not JSON: %v", err)
+	}
+	if entry.TestName != "pcie_error_check" || entry.Status != "FAIL" || entry.Error != "pcie errors" ||
+		entry.InstanceOCID != "ocid1.instance.oc1..test" || entry.Shape != "BM.GPU.H100.8" || entry.Timestamp != "2024-01-01T12:00:00Z" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+
+	// Nothing is sent when the buffer is empty
+	if err := client.Flush(); err != nil || len(putter.requests) != 1 {
+		t.Errorf("Expected empty flush to be a no-op, got %d requests, err %v", len(putter.requests), err)
+	}
+}
+
+func TestLoggingClientFlushesFullBuffer(t *testing.T) {
+	putter := &fakeLogPutter{}
+	client := newTestLoggingClient(putter)
+
+	entryLogger := client.Logger()
+	for i := 0; i < MaxBufferedLogEntries+1; i++ {
+		entryLogger.Println(fmt.Sprintf("entry %d", i))
+	}
+
+	if len(putter.requests) != 1 || len(putter.requests[0].LogEntryBatches[0].Entries) != MaxBufferedLogEntries {
+		t.Fatalf("Expected a full buffer of %d entries to be sent", MaxBufferedLogEntries)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if len(putter.requests) != 2 || len(putter.requests[1].LogEntryBatches[0].Entries) != 1 {
+		t.Errorf("Expected remaining entry to be sent on close")
+	}
+}
+
+func TestLoggingClientFlushError(t *testing.T) {
+	putter := &fakeLogPutter{err: errors.New("404 NotAuthorizedOrNotFound")}
+	client := newTestLoggingClient(putter)
+
+	client.Logger().Println("entry")
+	if err := client.Flush(); err == nil {
+		t.Fatal("Expected error when PutLogs fails")
+	}
+	// Failed entries are dropped so the buffer stays bounded
+	if len(client.entries) != 0 {
+		t.Errorf("Expected buffer to be cleared, got %d entries", len(client.entries))
+	}
+}
@@ -0,0 +1,147 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+)
+
+const (
+	// MonitoringNamespace is the OCI Monitoring namespace diagnostic results are posted to
+	MonitoringNamespace = "oci_hpc_diagnostics"
+	// TestStatusMetric is the metric name of test results, 1 for PASS and 0 otherwise
+	TestStatusMetric = "test_status"
+	// maxMetricsPerRequest is the number of metric streams PostMetricData accepts in one request
+	maxMetricsPerRequest = 50
+	// postTimeout bounds posting all metric data points
+	postTimeout = 30 * time.Second
+)
+
+// metricPoster posts metric data points; implemented by monitoring.MonitoringClient
+type metricPoster interface {
+	PostMetricData(ctx context.Context, request monitoring.PostMetricDataRequest) (monitoring.PostMetricDataResponse, error)
+}
+
+// MonitoringPublisher posts diagnostic test results as custom metrics to OCI Monitoring
+type MonitoringPublisher struct {
+	client        metricPoster
+	compartmentID string
+	shape         string
+	hostname      string
+}
+
+// NewMonitoringPublisher creates a publisher for the compartment of the current instance.
+// It authenticates as an instance principal and falls back to the OCI CLI config file.
+func NewMonitoringPublisher() (*MonitoringPublisher, error) {
+	metadata, err := executor.GetCurrentInstanceMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance metadata: %w", err)
+	}
+
+	provider, err := configurationProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := monitoring.NewMonitoringClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCI Monitoring client: %w", err)
+	}
+	// PostMetricData is only served by the telemetry-ingestion endpoints
+	client.Host = common.StringToRegion(metadata.CanonicalRegionName).EndpointForTemplate("telemetry-ingestion", "https://telemetry-ingestion.{region}.{secondLevelDomain}")
+
+	return &MonitoringPublisher{
+		client:        client,
+		compartmentID: metadata.CompartmentID,
+		shape:         metadata.Shape,
+		hostname:      metadata.Hostname,
+	}, nil
+}
+
+// configurationProvider returns instance principal credentials, or the OCI CLI config file
+// credentials when the instance is not authorized as an instance principal
+func configurationProvider() (common.ConfigurationProvider, error) {
+	provider, err := auth.InstancePrincipalConfigurationProvider()
+	if err == nil {
+		return provider, nil
+	}
+	logger.Debugf("Instance principal credentials unavailable: %v", err)
+
+	provider = common.DefaultConfigProvider()
+	if _, err := provider.KeyID(); err != nil {
+		return nil, fmt.Errorf("no OCI API credentials available: %w", err)
+	}
+	return provider, nil
+}
+
+// statusValue converts a test status to its metric value
+func statusValue(status string) float64 {
+	if status == "PASS" {
+		return 1
+	}
+	return 0
+}
+
+// Publish posts one metric data point per test result with test_name, shape and hostname dimensions
+func (p *MonitoringPublisher) Publish(results map[string]reporter.TestResult) error {
+	testNames := make([]string, 0, len(results))
+	for testName := range results {
+		testNames = append(testNames, testName)
+	}
+	sort.Strings(testNames)
+
+	metricData := make([]monitoring.MetricDataDetails, 0, len(testNames))
+	for _, testName := range testNames {
+		result := results[testName]
+		timestamp := result.Timestamp
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+
+		metricData = append(metricData, monitoring.MetricDataDetails{
+			Namespace:     common.String(MonitoringNamespace),
+			CompartmentId: common.String(p.compartmentID),
+			Name:          common.String(TestStatusMetric),
+			Dimensions: map[string]string{
+				"test_name": testName,
+				"shape":     p.shape,
+				"hostname":  p.hostname,
+			},
+			Datapoints: []monitoring.Datapoint{{
+				Timestamp: &common.SDKTime{Time: timestamp.UTC()},
+				Value:     common.Float64(statusValue(result.Status)),
+			}},
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), postTimeout)
+	defer cancel()
+
+	for start := 0; start < len(metricData); start += maxMetricsPerRequest {
+		end := start + maxMetricsPerRequest
+		if end > len(metricData) {
+			end = len(metricData)
+		}
+
+		response, err := p.client.PostMetricData(ctx, monitoring.PostMetricDataRequest{
+			PostMetricDataDetails: monitoring.PostMetricDataDetails{MetricData: metricData[start:end]},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to post metric data: %w", err)
+		}
+		if response.FailedMetricsCount != nil && *response.FailedMetricsCount > 0 {
+			return fmt.Errorf("OCI Monitoring rejected %d metric(s): %v", *response.FailedMetricsCount, response.FailedMetrics)
+		}
+	}
+
+	logger.Infof("Posted %d test result(s) to OCI Monitoring namespace %s", len(metricData), MonitoringNamespace)
+	return nil
+}
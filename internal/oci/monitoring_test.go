@@ -0,0 +1,98 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+)
+
+type fakePoster struct {
+	requests []monitoring.PostMetricDataRequest
+	err      error
+	failed   int
+}
+
+func (f *fakePoster) PostMetricData(ctx context.Context, request monitoring.PostMetricDataRequest) (monitoring.PostMetricDataResponse, error) {
+	f.requests = append(f.requests, request)
+	response := monitoring.PostMetricDataResponse{}
+	response.FailedMetricsCount = &f.failed
+	return response, f.err
+}
+
+func newTestPublisher(poster *fakePoster) *MonitoringPublisher {
+	return &MonitoringPublisher{
+		client:        poster,
+		compartmentID: "ocid1.compartment.oc1..test",
+		shape:         "BM.GPU.H100.8",
+		hostname:      "gpu-node-1",
+	}
+}
+
+func TestPublish(t *testing.T) {
+	poster := &fakePoster{}
+	publisher := newTestPublisher(poster)
+
+	results := map[string]reporter.TestResult{
+		"gpu_count_check":  {Name: "gpu_count_check", Status: "PASS", Timestamp: time.Now()},
+		"pcie_error_check": {Name: "pcie_error_check", Status: "FAIL", Timestamp: time.Now()},
+	}
+	if err := publisher.Publish(results); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if len(poster.requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(poster.requests))
+	}
+	metricData := poster.requests[0].MetricData
+	if len(metricData) != 2 {
+		t.Fatalf("Expected 2 metrics, got %d", len(metricData))
+	}
+
+	expected := map[string]float64{"gpu_count_check": 1, "pcie_error_check": 0}
+	for _, metric := range metricData {
+		testName := metric.Dimensions["test_name"]
+		if *metric.Namespace != MonitoringNamespace || *metric.Name != TestStatusMetric {
+			t.Errorf("Unexpected metric %s/%s", *metric.Namespace, *metric.Name)
+		}
+		if *metric.CompartmentId != "ocid1.compartment.oc1..test" || metric.Dimensions["shape"] != "BM.GPU.H100.8" {
+			t.Errorf("Unexpected compartment or shape for %s: %+v", testName, metric)
+		}
+		if value := *metric.Datapoints[0].Value; value != expected[testName] {
+			t.Errorf("Expected %s value %v, got %v", testName, expected[testName], value)
+		}
+	}
+}
+
+func TestPublishBatches(t *testing.T) {
+	poster := &fakePoster{}
+	publisher := newTestPublisher(poster)
+
+	results := make(map[string]reporter.TestResult)
+	for i := 0; i < maxMetricsPerRequest+5; i++ {
+		name := fmt.Sprintf("test_%02d", i)
+		results[name] = reporter.TestResult{Name: name, Status: "PASS"}
+	}
+	if err := publisher.Publish(results); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if len(poster.requests) != 2 || len(poster.requests[1].MetricData) != 5 {
+		t.Errorf("Expected 2 requests with the second holding 5 metrics, got %d requests", len(poster.requests))
+	}
+}
+
+func TestPublishErrors(t *testing.T) {
+	results := map[string]reporter.TestResult{"gpu_count_check": {Name: "gpu_count_check", Status: "PASS"}}
+
+	if err := newTestPublisher(&fakePoster{err: errors.New("401 NotAuthenticated")}).Publish(results); err == nil {
+		t.Error("Expected error when the API call fails")
+	}
+	if err := newTestPublisher(&fakePoster{failed: 1}).Publish(results); err == nil {
+		t.Error("Expected error when metrics are rejected")
+	}
+}
@@ -0,0 +1,153 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+)
+
+// DefaultPARExpiry is how long a report pre-authenticated request stays valid when no expiry is given
+const DefaultPARExpiry = 7 * 24 * time.Hour
+
+// parAttempts is the number of attempts of an Object Storage request throttled with 429 Too Many Requests
+const parAttempts = 5
+
+// parRetryDelay is the delay before the second attempt of a throttled request, doubled for every further attempt
+var parRetryDelay = time.Second
+
+// parCreator creates pre-authenticated requests; implemented by objectstorage.ObjectStorageClient
+type parCreator interface {
+	CreatePreauthenticatedRequest(ctx context.Context, request objectstorage.CreatePreauthenticatedRequestRequest) (objectstorage.CreatePreauthenticatedRequestResponse, error)
+}
+
+// PARGenerator uploads JSON reports to an OCI Object Storage bucket and creates a read-only
+// pre-authenticated request (PAR) for each, so a report can be shared with Oracle Support
+// without granting access to the bucket
+type PARGenerator struct {
+	uploader *ReportUploader
+	client   parCreator
+	region   string
+	expiry   time.Duration
+}
+
+// NewPARGenerator creates a generator for bucket in the Object Storage namespace of the tenancy
+// of the current instance, creating PARs valid for expiry
+func NewPARGenerator(bucket string, expiry time.Duration) (*PARGenerator, error) {
+	if expiry <= 0 {
+		return nil, fmt.Errorf("invalid PAR expiry %s, must be positive", expiry)
+	}
+
+	metadata, err := executor.GetCurrentInstanceMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance metadata: %w", err)
+	}
+
+	client, namespace, err := newObjectStorageClient(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PARGenerator{
+		uploader: &ReportUploader{
+			client:       client,
+			namespace:    namespace,
+			bucket:       bucket,
+			instanceOCID: metadata.ID,
+		},
+		client: client,
+		region: metadata.CanonicalRegionName,
+		expiry: expiry,
+	}, nil
+}
+
+// Generate uploads report as <instance-ocid>/oci-dr-hpc-<timestamp>.json and returns the URL of
+// a read-only PAR for the uploaded object
+func (g *PARGenerator) Generate(report []byte) (string, error) {
+	var objectName string
+	err := withRateLimitRetry("report upload", func() error {
+		var err error
+		objectName, err = g.uploader.Upload(report)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var par objectstorage.PreauthenticatedRequest
+	err = withRateLimitRetry("PAR creation", func() error {
+		var err error
+		par, err = g.createPAR(objectName)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	url := g.parURL(par)
+	logger.Infof("Created PAR for oci://%s@%s/%s expiring %s", g.uploader.bucket, g.uploader.namespace, objectName, par.TimeExpires)
+	return url, nil
+}
+
+// createPAR creates a read-only PAR for objectName expiring after the generator expiry
+func (g *PARGenerator) createPAR(objectName string) (objectstorage.PreauthenticatedRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), postTimeout)
+	defer cancel()
+
+	response, err := g.client.CreatePreauthenticatedRequest(ctx, objectstorage.CreatePreauthenticatedRequestRequest{
+		NamespaceName: common.String(g.uploader.namespace),
+		BucketName:    common.String(g.uploader.bucket),
+		CreatePreauthenticatedRequestDetails: objectstorage.CreatePreauthenticatedRequestDetails{
+			Name:        common.String(objectName),
+			ObjectName:  common.String(objectName),
+			AccessType:  objectstorage.CreatePreauthenticatedRequestDetailsAccessTypeObjectread,
+			TimeExpires: &common.SDKTime{Time: time.Now().Add(g.expiry)},
+		},
+	})
+	if err != nil {
+		return objectstorage.PreauthenticatedRequest{}, fmt.Errorf("failed to create PAR for %s: %w", objectName, err)
+	}
+	return response.PreauthenticatedRequest, nil
+}
+
+// parURL returns the full URL of par. The access URI is relative to the Object Storage endpoint
+// of the region when the service does not return the full path.
+func (g *PARGenerator) parURL(par objectstorage.PreauthenticatedRequest) string {
+	if par.FullPath != nil && *par.FullPath != "" {
+		return *par.FullPath
+	}
+	return fmt.Sprintf("https://objectstorage.%s.oraclecloud.com%s", g.region, *par.AccessUri)
+}
+
+// withRateLimitRetry calls request up to parAttempts times with exponential backoff while it
+// fails with 429 Too Many Requests. Other errors are not retried.
+func withRateLimitRetry(operation string, request func() error) error {
+	delay := parRetryDelay
+
+	var err error
+	for attempt := 1; attempt <= parAttempts; attempt++ {
+		err = request()
+		if err == nil || !isRateLimited(err) {
+			return err
+		}
+		if attempt == parAttempts {
+			break
+		}
+		logger.Debugf("Object Storage %s rate limited on attempt %d of %d, retrying in %s", operation, attempt, parAttempts, delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+	return fmt.Errorf("object storage %s still rate limited after %d attempts: %w", operation, parAttempts, err)
+}
+
+// isRateLimited reports whether err is an OCI service error with status 429 Too Many Requests
+func isRateLimited(err error) bool {
+	var serviceErr common.ServiceError
+	return errors.As(err, &serviceErr) && serviceErr.GetHTTPStatusCode() == http.StatusTooManyRequests
+}
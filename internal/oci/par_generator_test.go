@@ -0,0 +1,144 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+)
+
+// fakeServiceError is an OCI service error with an HTTP status code
+type fakeServiceError struct {
+	statusCode int
+}
+
+func (e fakeServiceError) Error() string           { return fmt.Sprintf("service error %d", e.statusCode) }
+func (e fakeServiceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e fakeServiceError) GetMessage() string      { return http.StatusText(e.statusCode) }
+func (e fakeServiceError) GetCode() string         { return "TooManyRequests" }
+func (e fakeServiceError) GetOpcRequestID() string { return "opc-request-id" }
+
+type fakePARCreator struct {
+	requests []objectstorage.CreatePreauthenticatedRequestRequest
+	errs     []error
+	fullPath string
+}
+
+func (f *fakePARCreator) CreatePreauthenticatedRequest(ctx context.Context, request objectstorage.CreatePreauthenticatedRequestRequest) (objectstorage.CreatePreauthenticatedRequestResponse, error) {
+	f.requests = append(f.requests, request)
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return objectstorage.CreatePreauthenticatedRequestResponse{}, err
+	}
+
+	par := objectstorage.PreauthenticatedRequest{
+		AccessUri:   common.String("/p/token/n/testnamespace/b/diagnostics/o/" + *request.CreatePreauthenticatedRequestDetails.ObjectName),
+		TimeExpires: request.CreatePreauthenticatedRequestDetails.TimeExpires,
+	}
+	if f.fullPath != "" {
+		par.FullPath = common.String(f.fullPath)
+	}
+	return objectstorage.CreatePreauthenticatedRequestResponse{PreauthenticatedRequest: par}, nil
+}
+
+// withoutPARRetryDelay removes the backoff between rate limited attempts for the duration of a test
+func withoutPARRetryDelay(t *testing.T) {
+	delay := parRetryDelay
+	parRetryDelay = time.Millisecond
+	t.Cleanup(func() { parRetryDelay = delay })
+}
+
+func newTestPARGenerator(putter *fakeObjectPutter, creator *fakePARCreator) *PARGenerator {
+	return &PARGenerator{
+		uploader: newTestReportUploader(putter),
+		client:   creator,
+		region:   "us-ashburn-1",
+		expiry:   DefaultPARExpiry,
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	putter := &fakeObjectPutter{}
+	creator := &fakePARCreator{}
+	generator := newTestPARGenerator(putter, creator)
+
+	before := time.Now()
+	url, err := generator.Generate([]byte(`{"localhost":{}}`))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(putter.requests) != 1 || len(creator.requests) != 1 {
+		t.Fatalf("Expected 1 upload and 1 PAR request, got %d and %d", len(putter.requests), len(creator.requests))
+	}
+
+	objectName := *putter.requests[0].ObjectName
+	if !strings.HasPrefix(objectName, "ocid1.instance.oc1..test/oci-dr-hpc-") {
+		t.Errorf("Unexpected object name %s", objectName)
+	}
+
+	details := creator.requests[0].CreatePreauthenticatedRequestDetails
+	if *details.ObjectName != objectName || details.AccessType != objectstorage.CreatePreauthenticatedRequestDetailsAccessTypeObjectread {
+		t.Errorf("Unexpected PAR details: %s", details)
+	}
+	if expires := details.TimeExpires.Time.Sub(before); expires < DefaultPARExpiry || expires > DefaultPARExpiry+time.Minute {
+		t.Errorf("PAR expires after %s, want %s", expires, DefaultPARExpiry)
+	}
+
+	if want := "https://objectstorage.us-ashburn-1.oraclecloud.com/p/token/n/testnamespace/b/diagnostics/o/" + objectName; url != want {
+		t.Errorf("Generate() = %s, want %s", url, want)
+	}
+}
+
+func TestGenerateFullPath(t *testing.T) {
+	creator := &fakePARCreator{fullPath: "https://testnamespace.objectstorage.us-ashburn-1.oci.customer-oci.com/p/token/n/testnamespace/b/diagnostics/o/report.json"}
+	url, err := newTestPARGenerator(&fakeObjectPutter{}, creator).Generate([]byte("{}"))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if url != creator.fullPath {
+		t.Errorf("Generate() = %s, want %s", url, creator.fullPath)
+	}
+}
+
+func TestGenerateRetriesRateLimits(t *testing.T) {
+	withoutPARRetryDelay(t)
+
+	creator := &fakePARCreator{errs: []error{fakeServiceError{http.StatusTooManyRequests}, fakeServiceError{http.StatusTooManyRequests}}}
+	if _, err := newTestPARGenerator(&fakeObjectPutter{}, creator).Generate([]byte("{}")); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(creator.requests) != 3 {
+		t.Errorf("Expected 3 PAR requests, got %d", len(creator.requests))
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	withoutPARRetryDelay(t)
+
+	putter := &fakeObjectPutter{err: fakeServiceError{http.StatusTooManyRequests}}
+	if _, err := newTestPARGenerator(putter, &fakePARCreator{}).Generate([]byte("{}")); err == nil {
+		t.Error("Expected error when the upload stays rate limited")
+	}
+	if len(putter.requests) != parAttempts {
+		t.Errorf("Expected %d upload attempts, got %d", parAttempts, len(putter.requests))
+	}
+
+	creator := &fakePARCreator{errs: []error{fakeServiceError{http.StatusNotFound}}}
+	if _, err := newTestPARGenerator(&fakeObjectPutter{}, creator).Generate([]byte("{}")); err == nil {
+		t.Error("Expected error when the PAR creation fails")
+	}
+	if len(creator.requests) != 1 {
+		t.Errorf("Expected errors other than 429 not to be retried, got %d requests", len(creator.requests))
+	}
+
+	if err := withRateLimitRetry("test", func() error { return errors.New("bucket not found") }); err == nil || isRateLimited(err) {
+		t.Errorf("withRateLimitRetry() = %v, want bucket not found error", err)
+	}
+}
@@ -0,0 +1,107 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/executor"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+)
+
+const (
+	// reportObjectPrefix is the object name prefix of uploaded reports
+	reportObjectPrefix = "oci-dr-hpc-"
+	// reportTimestampFormat sorts uploaded reports chronologically by name
+	reportTimestampFormat = "20060102T150405.000Z"
+)
+
+// objectPutter uploads objects; implemented by objectstorage.ObjectStorageClient
+type objectPutter interface {
+	PutObject(ctx context.Context, request objectstorage.PutObjectRequest) (objectstorage.PutObjectResponse, error)
+}
+
+// ReportUploader uploads JSON reports to an OCI Object Storage bucket under the instance OCID,
+// so the reports of all nodes can be collected from one bucket
+type ReportUploader struct {
+	client       objectPutter
+	namespace    string
+	bucket       string
+	instanceOCID string
+}
+
+// NewReportUploader creates an uploader for bucket in the Object Storage namespace of the
+// tenancy of the current instance, in the region of the instance
+func NewReportUploader(bucket string) (*ReportUploader, error) {
+	metadata, err := executor.GetCurrentInstanceMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance metadata: %w", err)
+	}
+
+	client, namespace, err := newObjectStorageClient(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportUploader{
+		client:       client,
+		namespace:    namespace,
+		bucket:       bucket,
+		instanceOCID: metadata.ID,
+	}, nil
+}
+
+// newObjectStorageClient creates an Object Storage client in the region of the instance and
+// returns it with the Object Storage namespace of the tenancy of the instance
+func newObjectStorageClient(metadata *executor.InstanceMetadata) (objectstorage.ObjectStorageClient, string, error) {
+	provider, err := configurationProvider()
+	if err != nil {
+		return objectstorage.ObjectStorageClient{}, "", err
+	}
+
+	client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
+	if err != nil {
+		return objectstorage.ObjectStorageClient{}, "", fmt.Errorf("failed to create OCI Object Storage client: %w", err)
+	}
+	client.SetRegion(metadata.CanonicalRegionName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), postTimeout)
+	defer cancel()
+	response, err := client.GetNamespace(ctx, objectstorage.GetNamespaceRequest{CompartmentId: common.String(metadata.TenantID)})
+	if err != nil {
+		return objectstorage.ObjectStorageClient{}, "", fmt.Errorf("failed to get Object Storage namespace: %w", err)
+	}
+	return client, *response.Value, nil
+}
+
+// reportObjectName returns the object name of a report uploaded at timestamp
+func (u *ReportUploader) reportObjectName(timestamp time.Time) string {
+	return fmt.Sprintf("%s/%s%s.json", u.instanceOCID, reportObjectPrefix, timestamp.UTC().Format(reportTimestampFormat))
+}
+
+// Upload uploads report as <instance-ocid>/oci-dr-hpc-<timestamp>.json and returns the object name
+func (u *ReportUploader) Upload(report []byte) (string, error) {
+	objectName := u.reportObjectName(time.Now())
+
+	ctx, cancel := context.WithTimeout(context.Background(), postTimeout)
+	defer cancel()
+
+	_, err := u.client.PutObject(ctx, objectstorage.PutObjectRequest{
+		NamespaceName: common.String(u.namespace),
+		BucketName:    common.String(u.bucket),
+		ObjectName:    common.String(objectName),
+		ContentLength: common.Int64(int64(len(report))),
+		ContentType:   common.String("application/json"),
+		PutObjectBody: io.NopCloser(bytes.NewReader(report)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload report to bucket %s: %w", u.bucket, err)
+	}
+
+	logger.Infof("Report uploaded to oci://%s@%s/%s", u.bucket, u.namespace, objectName)
+	return objectName, nil
+}
@@ -0,0 +1,69 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+)
+
+type fakeObjectPutter struct {
+	requests []objectstorage.PutObjectRequest
+	bodies   []string
+	err      error
+}
+
+func (f *fakeObjectPutter) PutObject(ctx context.Context, request objectstorage.PutObjectRequest) (objectstorage.PutObjectResponse, error) {
+	f.requests = append(f.requests, request)
+	body, _ := io.ReadAll(request.PutObjectBody)
+	f.bodies = append(f.bodies, string(body))
+	return objectstorage.PutObjectResponse{}, f.err
+}
+
+func newTestReportUploader(putter *fakeObjectPutter) *ReportUploader {
+	return &ReportUploader{
+		client:       putter,
+		namespace:    "testnamespace",
+		bucket:       "diagnostics",
+		instanceOCID: "ocid1.instance.oc1..test",
+	}
+}
+
+func TestReportObjectName(t *testing.T) {
+	uploader := newTestReportUploader(&fakeObjectPutter{})
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	if name := uploader.reportObjectName(timestamp); name != "ocid1.instance.oc1..test/oci-dr-hpc-20240101T120000.000Z.json" {
+		t.Errorf("reportObjectName() = %s", name)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	putter := &fakeObjectPutter{}
+	uploader := newTestReportUploader(putter)
+
+	objectName, err := uploader.Upload([]byte(`{"localhost":{}}`))
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if len(putter.requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(putter.requests))
+	}
+
+	request := putter.requests[0]
+	if *request.NamespaceName != "testnamespace" || *request.BucketName != "diagnostics" || *request.ObjectName != objectName {
+		t.Errorf("Unexpected request: %s", request)
+	}
+	if *request.ContentLength != int64(len(`{"localhost":{}}`)) || putter.bodies[0] != `{"localhost":{}}` {
+		t.Errorf("Unexpected body %q with length %d", putter.bodies[0], *request.ContentLength)
+	}
+}
+
+func TestUploadError(t *testing.T) {
+	uploader := newTestReportUploader(&fakeObjectPutter{err: errors.New("bucket not found")})
+	if _, err := uploader.Upload([]byte("{}")); err == nil {
+		t.Error("Expected error when the upload fails")
+	}
+}
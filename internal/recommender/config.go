@@ -3,21 +3,22 @@ package recommender
 import (
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 )
 
 // RecommendationTemplate represents a recommendation template from config
 type RecommendationTemplate struct {
-	Type       string   `json:"type"`
-	FaultCode  string   `json:"fault_code,omitempty"`
-	Issue      string   `json:"issue"`
-	Suggestion string   `json:"suggestion"`
-	Commands   []string `json:"commands,omitempty"`
-	References []string `json:"references,omitempty"`
+	Type          string   `json:"type"`
+	SafeToAutorun bool     `json:"safe_to_autorun,omitempty"` // commands may be run by the remediate command
+	FaultCode     string   `json:"fault_code,omitempty"`
+	Issue         string   `json:"issue"`
+	Suggestion    string   `json:"suggestion"`
+	Commands      []string `json:"commands,omitempty"`
+	References    []string `json:"references,omitempty"`
 }
 
 // TestRecommendations represents recommendations for a specific test
@@ -36,62 +37,13 @@ type RecommendationConfig struct {
 func LoadRecommendationConfig() (*RecommendationConfig, error) {
 	logger.Debugf("Starting recommendation config search...")
 
-	// Look for config file in multiple locations (order matters - local override > user > system > development)
-	configPaths := []string{
-		"./recommendations.json", // Current directory (highest priority override)
-	}
-
-	// Add user home directory for user-specific configs
-	if home, err := os.UserHomeDir(); err == nil {
-		userConfigPath := filepath.Join(home, ".config/oci-dr-hpc/recommendations.json")
-		configPaths = append(configPaths, userConfigPath)
-		logger.Debugf("Added user config path: %s", userConfigPath)
-	} else {
-		logger.Debugf("Could not determine user home directory: %v", err)
-	}
-
-	// Add system locations
-	configPaths = append(configPaths, []string{
-		"/etc/oci-dr-hpc/recommendations.json",       // System config location
-		"/usr/share/oci-dr-hpc/recommendations.json", // System data location
-		"/etc/oci-dr-hpc-recommendations.json",       // Legacy location
-		"configs/recommendations.json",               // Development location
-	}...)
-
-	logger.Debugf("Searching for recommendation config in %d locations: %v", len(configPaths), configPaths)
-
-	var configData []byte
-	var configFile string
-
-	for i, path := range configPaths {
-		logger.Debugf("Checking path %d/%d: %s", i+1, len(configPaths), path)
-
-		if absPath, err := filepath.Abs(path); err == nil {
-			logger.Debugf("  Absolute path: %s", absPath)
-
-			if _, err := os.Stat(absPath); err == nil {
-				logger.Debugf("  File exists, attempting to read...")
-
-				if data, err := os.ReadFile(absPath); err == nil {
-					configData = data
-					configFile = absPath
-					logger.Infof("Loading recommendation config from: %s", configFile)
-					break
-				} else {
-					logger.Debugf("  Failed to read file: %v", err)
-				}
-			} else {
-				logger.Debugf("  File does not exist: %v", err)
-			}
-		} else {
-			logger.Debugf("  Failed to get absolute path: %v", err)
-		}
-	}
-
-	if configData == nil {
-		logger.Errorf("Recommendation config file not found in any of the searched locations: %v", configPaths)
-		return nil, fmt.Errorf("recommendation config file not found in any of: %v", configPaths)
+	// /etc/oci-dr-hpc-recommendations.json is the legacy system location
+	configData, configFile, err := config.FindConfigFile("recommendations.json", "/etc/oci-dr-hpc-recommendations.json")
+	if err != nil {
+		logger.Errorf("Recommendation config file not found: %v", err)
+		return nil, fmt.Errorf("recommendation config file not found: %w", err)
 	}
+	logger.Infof("Loading recommendation config from: %s", configFile)
 
 	var config RecommendationConfig
 	if err := json.Unmarshal(configData, &config); err != nil {
@@ -115,6 +67,8 @@ func (config *RecommendationConfig) GetRecommendation(testName, status string, t
 		template = testConfig.Fail
 	case "WARN":
 		template = testConfig.Fail
+	case "TIMEOUT":
+		template = testConfig.Fail
 	case "PASS":
 		template = testConfig.Pass
 	default:
@@ -129,13 +83,15 @@ func (config *RecommendationConfig) GetRecommendation(testName, status string, t
 
 	// Create recommendation and apply variable substitutions
 	rec := &Recommendation{
-		Type:       template.Type,
-		TestName:   testName,
-		FaultCode:  template.FaultCode,
-		Issue:      applyVariableSubstitution(template.Issue, testResult),
-		Suggestion: applyVariableSubstitution(template.Suggestion, testResult),
-		Commands:   applyCommandSubstitutions(template.Commands, testResult),
-		References: template.References,
+		Type:          template.Type,
+		TestName:      testName,
+		Status:        strings.ToUpper(status),
+		FaultCode:     template.FaultCode,
+		Issue:         applyVariableSubstitution(template.Issue, testResult),
+		Suggestion:    applyVariableSubstitution(template.Suggestion, testResult),
+		Commands:      applyCommandSubstitutions(template.Commands, testResult),
+		References:    template.References,
+		SafeToAutorun: template.SafeToAutorun,
 	}
 
 	return rec
@@ -171,9 +127,21 @@ func applyVariableSubstitution(template string, testResult TestResult) string {
 	result = strings.ReplaceAll(result, "{failed_interfaces}", fmt.Sprintf("%s", testResult.FailedInterfaces))
 	result = strings.ReplaceAll(result, "{max_uncorrectable}", fmt.Sprintf("%d", testResult.MaxUncorrectable))
 	result = strings.ReplaceAll(result, "{max_correctable}", fmt.Sprintf("%d", testResult.MaxCorrectable))
+	result = strings.ReplaceAll(result, "{sram_error_gpus}", formatSRAMErrorGPUs(testResult.PerGPUErrors))
 	result = strings.ReplaceAll(result, "{missing_count}", fmt.Sprintf("%d", testResult.MissingCount))
 	result = strings.ReplaceAll(result, "{failure_count}", fmt.Sprintf("%d", testResult.FailureCount))
 	result = strings.ReplaceAll(result, "{eth0_present}", fmt.Sprintf("%t", testResult.Eth0Present))
+	result = strings.ReplaceAll(result, "{test_name}", testResult.TestName)
+	result = strings.ReplaceAll(result, "{timeout_seconds}", fmt.Sprintf("%d", testResult.TimeoutSeconds))
+	result = strings.ReplaceAll(result, "{tool}", testResult.Tool)
+	result = strings.ReplaceAll(result, "{package}", testResult.Package)
+	result = strings.ReplaceAll(result, "{command}", testResult.Command)
+	result = strings.ReplaceAll(result, "{exit_code}", fmt.Sprintf("%d", testResult.ExitCode))
+	result = strings.ReplaceAll(result, "{missing_connections}", strings.Join(testResult.MissingConnections, ", "))
+	result = strings.ReplaceAll(result, "{missing_devices}", joinOrNone(testResult.MissingDevices))
+	result = strings.ReplaceAll(result, "{extra_devices}", joinOrNone(testResult.ExtraDevices))
+	result = strings.ReplaceAll(result, "{missing_rdma_interfaces}", joinOrNone(testResult.MissingRDMA))
+	result = strings.ReplaceAll(result, "{missing_vcn_interfaces}", joinOrNone(testResult.MissingVCN))
 
 	// Replace max_acc_check specific variables
 	if testResult.MaxAccResult != nil {
@@ -217,3 +185,42 @@ func applyCommandSubstitutions(commands []string, testResult TestResult) []strin
 
 	return result
 }
+
+// joinOrNone joins the values for a template variable, or returns "none" when there are no values
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
+
+// formatSRAMErrorGPUs lists the GPUs with SRAM errors and their counts, most uncorrectable errors
+// first, e.g. "GPU 3 (uncorrectable: 12, correctable: 40)", or "unknown GPUs" without per-GPU counts
+func formatSRAMErrorGPUs(perGPUErrors map[int]SRAMGPUErrors) string {
+	var gpus []int
+	for index, counts := range perGPUErrors {
+		if counts.Uncorrectable > 0 || counts.Correctable > 0 {
+			gpus = append(gpus, index)
+		}
+	}
+	if len(gpus) == 0 {
+		return "unknown GPUs"
+	}
+	sort.Slice(gpus, func(i, j int) bool {
+		a, b := perGPUErrors[gpus[i]], perGPUErrors[gpus[j]]
+		if a.Uncorrectable != b.Uncorrectable {
+			return a.Uncorrectable > b.Uncorrectable
+		}
+		if a.Correctable != b.Correctable {
+			return a.Correctable > b.Correctable
+		}
+		return gpus[i] < gpus[j]
+	})
+
+	descriptions := make([]string, 0, len(gpus))
+	for _, index := range gpus {
+		counts := perGPUErrors[index]
+		descriptions = append(descriptions, fmt.Sprintf("GPU %d (uncorrectable: %d, correctable: %d)", index, counts.Uncorrectable, counts.Correctable))
+	}
+	return strings.Join(descriptions, ", ")
+}
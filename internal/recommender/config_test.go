@@ -244,6 +244,34 @@ func TestGetRecommendation(t *testing.T) {
 	}
 }
 
+func TestGetRecommendation_Timeout(t *testing.T) {
+	config := &RecommendationConfig{
+		Recommendations: map[string]TestRecommendations{
+			"test_timeout": {
+				Fail: &RecommendationTemplate{
+					Type:       "critical",
+					FaultCode:  "HPCGPU-0026-0001",
+					Issue:      "Test {test_name} did not finish within {timeout_seconds} seconds and was stopped",
+					Suggestion: "Check whether nvidia-smi or mlxlink is stuck",
+				},
+			},
+		},
+	}
+
+	testResult := TestResult{Status: "TIMEOUT", TestName: "link_check", TimeoutSeconds: 120}
+	rec := config.GetRecommendation("test_timeout", "TIMEOUT", testResult)
+	if rec == nil {
+		t.Fatal("Expected recommendation for TIMEOUT status but got nil")
+	}
+	if rec.Type != "critical" || rec.FaultCode != "HPCGPU-0026-0001" {
+		t.Errorf("Unexpected recommendation: %+v", rec)
+	}
+	expectedIssue := "Test link_check did not finish within 120 seconds and was stopped"
+	if rec.Issue != expectedIssue {
+		t.Errorf("Expected issue %q, got %q", expectedIssue, rec.Issue)
+	}
+}
+
 func TestGetSummary(t *testing.T) {
 	config := &RecommendationConfig{
 		SummaryTemplates: map[string]string{
@@ -294,6 +322,9 @@ func TestApplyVariableSubstitution(t *testing.T) {
 		FailedInterfaces:  "rdma2,rdma3",
 		FailureCount:      3,
 		Eth0Present:       true,
+		MissingDevices:    []string{"mlx5_3", "mlx5_4"},
+		MissingVCN:        []string{"eth1"},
+		PerGPUErrors:      map[int]SRAMGPUErrors{0: {}, 2: {Correctable: 100}, 5: {Uncorrectable: 5, Correctable: 40}},
 	}
 
 	tests := []struct {
@@ -316,6 +347,10 @@ func TestApplyVariableSubstitution(t *testing.T) {
 			template: "Errors: uncorr={max_uncorrectable}, corr={max_correctable}",
 			expected: "Errors: uncorr=5, corr=100",
 		},
+		{
+			template: "SRAM errors on {sram_error_gpus}",
+			expected: "SRAM errors on GPU 5 (uncorrectable: 5, correctable: 40), GPU 2 (uncorrectable: 0, correctable: 100)",
+		},
 		{
 			template: "Failed interfaces: {failed_interfaces}",
 			expected: "Failed interfaces: rdma2,rdma3",
@@ -328,6 +363,14 @@ func TestApplyVariableSubstitution(t *testing.T) {
 			template: "GPU failures: {failure_count}",
 			expected: "GPU failures: 3",
 		},
+		{
+			template: "Missing: {missing_devices}, extra: {extra_devices}",
+			expected: "Missing: mlx5_3, mlx5_4, extra: none",
+		},
+		{
+			template: "RDMA: {missing_rdma_interfaces}, VCN: {missing_vcn_interfaces}",
+			expected: "RDMA: none, VCN: eth1",
+		},
 		{
 			template: "No variables here",
 			expected: "No variables here",
@@ -0,0 +1,77 @@
+package recommender
+
+import (
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// loadTestDependencies returns the test_dependencies of test_limits.json, or none when it cannot be loaded
+func loadTestDependencies() map[string][]string {
+	limits, err := test_limits.LoadTestLimits()
+	if err != nil {
+		logger.Errorf("Failed to load test limits, recommendations are not grouped by root cause: %v", err)
+		return nil
+	}
+	return limits.TestDependencies
+}
+
+// markDerivedRecommendations sets DerivedFrom on the failure recommendations of tests depending,
+// directly or through other tests, on a failed test, since they fail as a consequence of it.
+// It returns the recommendations with derived ones grouped right after those of their root cause.
+func markDerivedRecommendations(recommendations []Recommendation, dependencies map[string][]string) []Recommendation {
+	failed := make(map[string]bool)
+	for _, rec := range recommendations {
+		if rec.Type != "info" {
+			failed[rec.TestName] = true
+		}
+	}
+
+	marked := make([]Recommendation, len(recommendations))
+	derived := make(map[string][]Recommendation)
+	for i, rec := range recommendations {
+		if rec.Type != "info" {
+			rec.DerivedFrom = rootCause(rec.TestName, failed, dependencies, map[string]bool{rec.TestName: true})
+		}
+		if rec.DerivedFrom != "" {
+			derived[rec.DerivedFrom] = append(derived[rec.DerivedFrom], rec)
+		}
+		marked[i] = rec
+	}
+
+	grouped := make([]Recommendation, 0, len(recommendations))
+	for _, rec := range marked {
+		if rec.DerivedFrom != "" {
+			continue
+		}
+		grouped = append(grouped, rec)
+		if rec.Type != "info" {
+			grouped = append(grouped, derived[rec.TestName]...)
+			delete(derived, rec.TestName)
+		}
+	}
+
+	// Tests failing in a dependency cycle have no primary root cause and are kept as primary
+	for _, rec := range marked {
+		if _, ok := derived[rec.DerivedFrom]; ok {
+			rec.DerivedFrom = ""
+			grouped = append(grouped, rec)
+		}
+	}
+	return grouped
+}
+
+// rootCause returns the failed test testName ultimately depends on, or "" when none of its
+// dependencies failed. visited guards against dependency cycles.
+func rootCause(testName string, failed map[string]bool, dependencies map[string][]string, visited map[string]bool) string {
+	for _, dep := range dependencies[testName] {
+		if !failed[dep] || visited[dep] {
+			continue
+		}
+		visited[dep] = true
+		if root := rootCause(dep, failed, dependencies, visited); root != "" {
+			return root
+		}
+		return dep
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package recommender
+
+import (
+	"testing"
+)
+
+func TestMarkDerivedRecommendations(t *testing.T) {
+	dependencies := map[string][]string{
+		"link_check":         {"rdma_nics_count"},
+		"network_auth_check": {"rdma_nics_count"},
+		"gid_index_check":    {"rdma_nics_count"},
+		"eth_link_check":     {"link_check"},
+	}
+	recommendations := []Recommendation{
+		{Type: "critical", TestName: "gpu_count_check"},
+		{Type: "critical", TestName: "gid_index_check"},
+		{Type: "critical", TestName: "link_check"},
+		{Type: "critical", TestName: "rdma_nics_count"},
+		{Type: "info", TestName: "network_auth_check"},
+		{Type: "warning", TestName: "eth_link_check"},
+	}
+
+	grouped := markDerivedRecommendations(recommendations, dependencies)
+
+	expected := []struct {
+		testName    string
+		derivedFrom string
+	}{
+		{"gpu_count_check", ""},
+		{"rdma_nics_count", ""},
+		{"gid_index_check", "rdma_nics_count"},
+		{"link_check", "rdma_nics_count"},
+		{"eth_link_check", "rdma_nics_count"},
+		{"network_auth_check", ""},
+	}
+	if len(grouped) != len(expected) {
+		t.Fatalf("markDerivedRecommendations() returned %d recommendations, want %d", len(grouped), len(expected))
+	}
+	for i, rec := range grouped {
+		if rec.TestName != expected[i].testName || rec.DerivedFrom != expected[i].derivedFrom {
+			t.Errorf("recommendation %d = %s derived from %q, want %s derived from %q",
+				i, rec.TestName, rec.DerivedFrom, expected[i].testName, expected[i].derivedFrom)
+		}
+	}
+}
+
+func TestMarkDerivedRecommendationsPassingDependency(t *testing.T) {
+	dependencies := map[string][]string{"link_check": {"rdma_nics_count"}}
+	recommendations := []Recommendation{
+		{Type: "info", TestName: "rdma_nics_count"},
+		{Type: "critical", TestName: "link_check"},
+	}
+
+	grouped := markDerivedRecommendations(recommendations, dependencies)
+	if len(grouped) != 2 || grouped[1].DerivedFrom != "" {
+		t.Errorf("markDerivedRecommendations() = %+v, want link_check as a primary failure", grouped)
+	}
+}
+
+func TestMarkDerivedRecommendationsCycle(t *testing.T) {
+	dependencies := map[string][]string{"a": {"b"}, "b": {"a"}}
+	recommendations := []Recommendation{
+		{Type: "critical", TestName: "a"},
+		{Type: "critical", TestName: "b"},
+	}
+
+	grouped := markDerivedRecommendations(recommendations, dependencies)
+	if len(grouped) != 2 {
+		t.Fatalf("markDerivedRecommendations() returned %d recommendations, want 2", len(grouped))
+	}
+	for _, rec := range grouped {
+		if rec.DerivedFrom != "" {
+			t.Errorf("recommendation %s derived from %q, want primary in a dependency cycle", rec.TestName, rec.DerivedFrom)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,25 +13,44 @@ import (
 
 // TestResult represents a single test result from the reporter
 type TestResult struct {
-	Status            string      `json:"status"`
-	GPUCount          int         `json:"gpu_count,omitempty"`
-	Message           string      `json:"message,omitempty"`
-	EnabledGPUIndexes []string    `json:"enabled_gpu_indexes,omitempty"`
-	NumRDMANics       int         `json:"num_rdma_nics,omitempty"`
-	FailedCount       int         `json:"failed_count,omitempty"`
-	FailedInterfaces  string      `json:"failed_interfaces,omitempty"`
-	InterfaceCount    int         `json:"interface_count,omitempty"`
-	InvalidGIDIndexes []int       `json:"invalid_gid_indexes,omitempty"`
-	Interfaces        interface{} `json:"interfaces,omitempty"`
-	MaxUncorrectable  int         `json:"max_uncorrectable,omitempty"`
-	MaxCorrectable    int         `json:"max_correctable,omitempty"`
-	MissingCount      int         `json:"missing_count,omitempty"`
-	FailureCount      int         `json:"failure_count,omitempty"`
-	ModuleLoaded      bool        `json:"module_loaded,omitempty"`
-	NVLinks           interface{} `json:"nvlinks,omitempty"`
-	Eth0Present       bool        `json:"eth0_present,omitempty"`
-	MaxAccResult      interface{} `json:"max_acc_result,omitempty"`
-	TimestampUTC      string      `json:"timestamp_utc"`
+	Status             string                `json:"status"`
+	GPUCount           int                   `json:"gpu_count,omitempty"`
+	Message            string                `json:"message,omitempty"`
+	EnabledGPUIndexes  []string              `json:"enabled_gpu_indexes,omitempty"`
+	NumRDMANics        int                   `json:"num_rdma_nics,omitempty"`
+	FailedCount        int                   `json:"failed_count,omitempty"`
+	FailedInterfaces   string                `json:"failed_interfaces,omitempty"`
+	InterfaceCount     int                   `json:"interface_count,omitempty"`
+	InvalidGIDIndexes  []int                 `json:"invalid_gid_indexes,omitempty"`
+	Interfaces         interface{}           `json:"interfaces,omitempty"`
+	MaxUncorrectable   int                   `json:"max_uncorrectable,omitempty"`
+	MaxCorrectable     int                   `json:"max_correctable,omitempty"`
+	PerGPUErrors       map[int]SRAMGPUErrors `json:"per_gpu_errors,omitempty"`
+	MissingCount       int                   `json:"missing_count,omitempty"`
+	FailureCount       int                   `json:"failure_count,omitempty"`
+	ModuleLoaded       bool                  `json:"module_loaded,omitempty"`
+	NVLinks            interface{}           `json:"nvlinks,omitempty"`
+	Eth0Present        bool                  `json:"eth0_present,omitempty"`
+	MaxAccResult       interface{}           `json:"max_acc_result,omitempty"`
+	MissingConnections []string              `json:"missing_connections,omitempty"`
+	MissingDevices     []string              `json:"missing_devices,omitempty"`
+	ExtraDevices       []string              `json:"extra_devices,omitempty"`
+	MissingRDMA        []string              `json:"missing_rdma_interfaces,omitempty"`
+	MissingVCN         []string              `json:"missing_vcn_interfaces,omitempty"`
+	TestName           string                `json:"test_name,omitempty"`
+	TimeoutSeconds     int                   `json:"timeout_seconds,omitempty"`
+	ErrorType          string                `json:"error_type,omitempty"`
+	Tool               string                `json:"tool,omitempty"`
+	Package            string                `json:"package,omitempty"`
+	Command            string                `json:"command,omitempty"`
+	ExitCode           int                   `json:"exit_code,omitempty"`
+	TimestampUTC       string                `json:"timestamp_utc"`
+}
+
+// SRAMGPUErrors represents the SRAM error counts of a single GPU reported by sram_error_check
+type SRAMGPUErrors struct {
+	Correctable   int `json:"correctable"`
+	Uncorrectable int `json:"uncorrectable"`
 }
 
 // HostResults represents test results for a host
@@ -43,7 +63,6 @@ type HostResults struct {
 	GIDIndexCheck      []TestResult `json:"gid_index_check,omitempty"`
 	LinkCheck          []TestResult `json:"link_check,omitempty"`
 	EthLinkCheck       []TestResult `json:"eth_link_check,omitempty"`
-	AuthCheck          []TestResult `json:"auth_check,omitempty"`
 	SRAMErrorCheck     []TestResult `json:"sram_error_check,omitempty"`
 	GPUDriverCheck     []TestResult `json:"gpu_driver_check,omitempty"`
 	PeerMemModuleCheck []TestResult `json:"peermem_module_check,omitempty"`
@@ -57,6 +76,61 @@ type HostResults struct {
 	GPUXIDCheck           []TestResult `json:"gpu_xid_check,omitempty"`
 	MaxAccCheck           []TestResult `json:"max_acc_check,omitempty"`
 	RowRemapErrorCheck    []TestResult `json:"row_remap_error_check,omitempty"`
+	GPUVBIOSCheck         []TestResult `json:"gpu_vbios_check,omitempty"`
+	HugepagesCheck        []TestResult `json:"hugepages_check,omitempty"`
+	NUMAAffinityCheck     []TestResult `json:"numa_affinity_check,omitempty"`
+	IBSMCheck             []TestResult `json:"ib_sm_check,omitempty"`
+	TimeSyncCheck         []TestResult `json:"time_sync_check,omitempty"`
+	KernelModuleCheck     []TestResult `json:"kernel_module_check,omitempty"`
+	SystemdServiceCheck   []TestResult `json:"systemd_service_check,omitempty"`
+	IOMMUCheck            []TestResult `json:"iommu_check,omitempty"`
+	NVLinkTopologyCheck   []TestResult `json:"nvlink_topology_check,omitempty"`
+	PCIeReplayCheck       []TestResult `json:"pcie_replay_check,omitempty"`
+	RDMACreditCheck       []TestResult `json:"rdma_credit_check,omitempty"`
+	RDMAMTUCheck          []TestResult `json:"rdma_mtu_check,omitempty"`
+	RDMATopologyCheck     []TestResult `json:"rdma_topology_check,omitempty"`
+	BIOSSettingsCheck     []TestResult `json:"bios_settings_check,omitempty"`
+	GPUInfoROMCheck       []TestResult `json:"gpu_inforom_check,omitempty"`
+	GPURowRemapCheck      []TestResult `json:"gpu_row_remap_check,omitempty"`
+	CPUGovernorCheck      []TestResult `json:"cpu_governor_check,omitempty"`
+	GPUFirmwareCheck      []TestResult `json:"gpu_firmware_check,omitempty"`
+	GPUP2PBWCheck         []TestResult `json:"gpu_p2p_bw_check,omitempty"`
+	CPUIsolationCheck     []TestResult `json:"cpu_isolation_check,omitempty"`
+	PCIeGenCheck          []TestResult `json:"pcie_gen_check,omitempty"`
+	GPUCStateCheck        []TestResult `json:"gpu_cstate_check,omitempty"`
+	NFSMountCheck         []TestResult `json:"nfs_mount_check,omitempty"`
+	BERTrendCheck         []TestResult `json:"ber_trend_check,omitempty"`
+	IBCableCheck          []TestResult `json:"ib_cable_check,omitempty"`
+	PCIeReBARCheck        []TestResult `json:"pcie_rebar_check,omitempty"`
+	GPUIdleCheck          []TestResult `json:"gpu_idle_check,omitempty"`
+	PCIeDeviceCountCheck  []TestResult `json:"pcie_device_count_check,omitempty"`
+	GPUFirmwareUpdateCheck []TestResult `json:"gpu_firmware_update_check,omitempty"`
+	GPUResetCheck         []TestResult `json:"gpu_reset_check,omitempty"`
+	RDMAInterfaceSpeedCheck []TestResult `json:"rdma_interface_speed_check,omitempty"`
+	IBPortStateCheck      []TestResult `json:"ib_port_state_check,omitempty"`
+	NICFirmwareCheck      []TestResult `json:"nic_firmware_check,omitempty"`
+	RDMAPCIMappingCheck   []TestResult `json:"rdma_pci_mapping_check,omitempty"`
+	GPUPCIeTopoCheck      []TestResult `json:"gpu_pcie_topo_check,omitempty"`
+	MLXConfigCheck        []TestResult `json:"mlxconfig_check,omitempty"`
+	GPUPowerRailCheck     []TestResult `json:"gpu_power_rail_check,omitempty"`
+	GPUTemperatureCheck   []TestResult `json:"gpu_temperature_check,omitempty"`
+	RoCEVLANCheck         []TestResult `json:"roce_vlan_check,omitempty"`
+	IBSwitchPortCheck     []TestResult `json:"ib_switch_port_check,omitempty"`
+	GPUCPUBWCheck         []TestResult `json:"gpu_cpu_bw_check,omitempty"`
+	FabricManagerLogCheck []TestResult `json:"fabricmanager_log_check,omitempty"`
+	GPUMemTemperatureCheck []TestResult `json:"gpu_mem_temperature_check,omitempty"`
+	PCIeVendorCheck       []TestResult `json:"pcie_vendor_check,omitempty"`
+	RDMARetryCounterCheck []TestResult `json:"rdma_retry_counter_check,omitempty"`
+	NVLinkBWCheck         []TestResult `json:"nvlink_bw_check,omitempty"`
+	GPUMIGProfileCheck    []TestResult `json:"gpu_mig_profile_check,omitempty"`
+	NetworkAuthCheck      []TestResult `json:"network_auth_check,omitempty"`
+	DCGMFieldCheck        []TestResult `json:"dcgm_field_check,omitempty"`
+	NUMADistanceCheck     []TestResult `json:"numa_distance_check,omitempty"`
+	CPUTopologyCheck      []TestResult `json:"cpu_topology_check,omitempty"`
+	MemoryCheck           []TestResult `json:"memory_check,omitempty"`
+	GPUFirmwareConsistencyCheck []TestResult `json:"gpu_firmware_consistency_check,omitempty"`
+	TestTimeouts          []TestResult `json:"test_timeouts,omitempty"`
+	TestErrors            []TestResult `json:"test_errors,omitempty"`
 }
 
 // ReportOutput represents the single report format
@@ -64,11 +138,14 @@ type ReportOutput struct {
 	Localhost HostResults `json:"localhost"`
 }
 
-// TestRun represents a single test run with timestamp
+// TestRun represents a single test run with timestamp. The status counts are set by ListRuns.
 type TestRun struct {
 	RunID       string      `json:"run_id"`
 	Timestamp   string      `json:"timestamp"`
 	TestResults HostResults `json:"test_results"`
+	PassCount   int         `json:"-"`
+	WarnCount   int         `json:"-"`
+	FailCount   int         `json:"-"`
 }
 
 // AppendedReport represents multiple test runs in a single file
@@ -78,13 +155,17 @@ type AppendedReport struct {
 
 // Recommendation represents a single recommendation
 type Recommendation struct {
-	Type       string   `json:"type"` // "critical", "warning", "info"
-	TestName   string   `json:"test_name"`
-	FaultCode  string   `json:"fault_code,omitempty"`
-	Issue      string   `json:"issue"`
-	Suggestion string   `json:"suggestion"`
-	Commands   []string `json:"commands,omitempty"`
-	References []string `json:"references,omitempty"`
+	Type              string   `json:"type"` // "critical", "warning", "info"
+	TestName          string   `json:"test_name"`
+	Status            string   `json:"status,omitempty"` // test status, or "SUPPRESSED"
+	FaultCode         string   `json:"fault_code,omitempty"`
+	Issue             string   `json:"issue"`
+	Suggestion        string   `json:"suggestion"`
+	Commands          []string `json:"commands,omitempty"`
+	References        []string `json:"references,omitempty"`
+	SuppressionReason string   `json:"suppression_reason,omitempty"`
+	SafeToAutorun     bool     `json:"safe_to_autorun,omitempty"`
+	DerivedFrom       string   `json:"derived_from,omitempty"` // failed test this failure is a consequence of
 }
 
 // RecommendationReport represents the final recommendations
@@ -94,29 +175,28 @@ type RecommendationReport struct {
 	CriticalIssues  int              `json:"critical_issues"`
 	WarningIssues   int              `json:"warning_issues"`
 	InfoIssues      int              `json:"info_issues"`
+	SuppressedCount int              `json:"suppressed_issues,omitempty"`
 	Recommendations []Recommendation `json:"recommendations"`
 	GeneratedAt     string           `json:"generated_at"`
 }
 
-// AnalyzeResults analyzes test results and provides recommendations
-func AnalyzeResults(resultsFile, outputFormat string) error {
-	logger.Info(fmt.Sprintf("Analyzing results file: %s", resultsFile))
+// errorRecommendations maps the error types of test_errors entries to the recommendations
+// replacing the failure recommendation of the test; other error types keep it
+var errorRecommendations = map[string]string{
+	"tool_not_found":  "tool_not_found",
+	"execution_error": "command_failed",
+}
 
-	// Read the results file
-	data, err := os.ReadFile(resultsFile)
-	if err != nil {
-		return fmt.Errorf("failed to read results file: %w", err)
-	}
+// AnalyzeResults analyzes test results and provides recommendations. runSelector selects the
+// run of an appended report, see selectRun; an empty selector analyzes the latest run.
+func AnalyzeResults(resultsFile, runSelector, outputFormat string) error {
+	logger.Info(fmt.Sprintf("Analyzing results file: %s", resultsFile))
 
-	// Parse the results
-	hostResults, err := parseResults(data)
+	recommendations, err := loadRecommendations(resultsFile, runSelector)
 	if err != nil {
-		return fmt.Errorf("failed to parse results: %w", err)
+		return err
 	}
 
-	// Generate recommendations
-	recommendations := generateRecommendations(hostResults)
-
 	// Format and display recommendations based on output format
 	if err := outputRecommendations(recommendations, outputFormat); err != nil {
 		return fmt.Errorf("failed to output recommendations: %w", err)
@@ -125,23 +205,46 @@ func AnalyzeResults(resultsFile, outputFormat string) error {
 	return nil
 }
 
-// parseResults parses the JSON results file and returns the latest test results
-func parseResults(data []byte) (HostResults, error) {
+// loadRecommendations reads the results file and generates the recommendations of the selected run
+func loadRecommendations(resultsFile, runSelector string) (RecommendationReport, error) {
+	// Read the results file
+	data, err := os.ReadFile(resultsFile)
+	if err != nil {
+		return RecommendationReport{}, fmt.Errorf("failed to read results file: %w", err)
+	}
+
+	// Parse the results
+	hostResults, err := parseResults(data, runSelector)
+	if err != nil {
+		return RecommendationReport{}, fmt.Errorf("failed to parse results: %w", err)
+	}
+
+	return generateRecommendations(hostResults), nil
+}
+
+// parseResults parses the JSON results file and returns the test results of the run selected
+// by runSelector, see selectRun. A single report has one run, selected by "", "0" or "-1".
+func parseResults(data []byte, runSelector string) (HostResults, error) {
 	var hostResults HostResults
 
 	// Try to parse as AppendedReport first
 	var appendedReport AppendedReport
 	if err := json.Unmarshal(data, &appendedReport); err == nil && len(appendedReport.TestRuns) > 0 {
-		// Use the latest test run
-		latestRun := appendedReport.TestRuns[len(appendedReport.TestRuns)-1]
-		hostResults = latestRun.TestResults
-		logger.Info(fmt.Sprintf("Found %d test runs, analyzing latest run: %s", len(appendedReport.TestRuns), latestRun.RunID))
+		run, err := selectRun(appendedReport.TestRuns, runSelector)
+		if err != nil {
+			return hostResults, err
+		}
+		hostResults = run.TestResults
+		logger.Info(fmt.Sprintf("Found %d test runs, analyzing run: %s", len(appendedReport.TestRuns), run.RunID))
 	} else {
 		// Try to parse as single ReportOutput
 		var singleReport ReportOutput
 		if err := json.Unmarshal(data, &singleReport); err != nil {
 			return hostResults, fmt.Errorf("failed to parse as either appended or single report format: %w", err)
 		}
+		if runSelector != "" && runSelector != "0" && runSelector != "-1" {
+			return hostResults, fmt.Errorf("run %s not found, a single report has only one run", runSelector)
+		}
 		hostResults = singleReport.Localhost
 		logger.Info("Analyzing single report format")
 	}
@@ -149,6 +252,116 @@ func parseResults(data []byte) (HostResults, error) {
 	return hostResults, nil
 }
 
+// selectRun returns the run matching runSelector, which is either a run ID or an index into
+// runs: 0 is the oldest run and negative indexes count back from the latest run (-1).
+// An empty selector selects the latest run.
+func selectRun(runs []TestRun, runSelector string) (TestRun, error) {
+	if runSelector == "" {
+		return runs[len(runs)-1], nil
+	}
+
+	for _, run := range runs {
+		if run.RunID == runSelector {
+			return run, nil
+		}
+	}
+
+	index, err := strconv.Atoi(runSelector)
+	if err != nil {
+		return TestRun{}, fmt.Errorf("run %s not found", runSelector)
+	}
+	if index < 0 {
+		index += len(runs)
+	}
+	if index < 0 || index >= len(runs) {
+		return TestRun{}, fmt.Errorf("run index %s out of range, the report has %d runs", runSelector, len(runs))
+	}
+	return runs[index], nil
+}
+
+// ListRuns returns the runs of a results file with their pass, warn and fail counts.
+// A single report is returned as one run without an ID.
+func ListRuns(filename string) ([]TestRun, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results file: %w", err)
+	}
+
+	var runs []TestRun
+	var appendedReport AppendedReport
+	if err := json.Unmarshal(data, &appendedReport); err == nil && len(appendedReport.TestRuns) > 0 {
+		runs = appendedReport.TestRuns
+	} else {
+		var singleReport ReportOutput
+		if err := json.Unmarshal(data, &singleReport); err != nil {
+			return nil, fmt.Errorf("failed to parse as either appended or single report format: %w", err)
+		}
+		runs = []TestRun{{TestResults: singleReport.Localhost}}
+	}
+
+	for i := range runs {
+		if err := countRunStatuses(&runs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+// countRunStatuses sets the pass, warn and fail counts of a run. Timeout and error entries
+// duplicate the FAIL result of their test and are not counted.
+func countRunStatuses(run *TestRun) error {
+	data, err := json.Marshal(run.TestResults)
+	if err != nil {
+		return fmt.Errorf("failed to read results of run %s: %w", run.RunID, err)
+	}
+	var tests map[string][]TestResult
+	if err := json.Unmarshal(data, &tests); err != nil {
+		return fmt.Errorf("failed to read results of run %s: %w", run.RunID, err)
+	}
+
+	run.PassCount, run.WarnCount, run.FailCount = 0, 0, 0
+	for name, results := range tests {
+		if name == "test_timeouts" || name == "test_errors" {
+			continue
+		}
+		for _, result := range results {
+			switch strings.ToUpper(result.Status) {
+			case "PASS":
+				run.PassCount++
+			case "WARN":
+				run.WarnCount++
+			case "FAIL":
+				run.FailCount++
+			}
+		}
+	}
+	return nil
+}
+
+// FormatRunsTable formats the runs of a results file as a table, oldest run first
+func FormatRunsTable(runs []TestRun) string {
+	var output strings.Builder
+
+	output.WriteString("┌───────┬──────────────────────┬──────────────────────┬──────┬──────┬──────┐\n")
+	output.WriteString("│ Index │ Run ID               │ Timestamp            │ Pass │ Warn │ Fail │\n")
+	output.WriteString("├───────┼──────────────────────┼──────────────────────┼──────┼──────┼──────┤\n")
+	for i, run := range runs {
+		runID := run.RunID
+		if runID == "" {
+			runID = "-"
+		}
+		timestamp := run.Timestamp
+		if timestamp == "" {
+			timestamp = "-"
+		}
+		output.WriteString(fmt.Sprintf("│ %5d │ %-20s │ %-20s │ %4d │ %4d │ %4d │\n",
+			i, runID, timestamp, run.PassCount, run.WarnCount, run.FailCount))
+	}
+	output.WriteString("└───────┴──────────────────────┴──────────────────────┴──────┴──────┴──────┘\n")
+
+	return output.String()
+}
+
 // generateRecommendations analyzes test results and generates recommendations using config
 func generateRecommendations(results HostResults) RecommendationReport {
 	// Load recommendation configuration
@@ -158,14 +371,21 @@ func generateRecommendations(results HostResults) RecommendationReport {
 		return generateFallbackRecommendations(results)
 	}
 
+	suppressionRules, err := LoadSuppressionRules()
+	if err != nil {
+		logger.Errorf("Failed to load suppression rules, no recommendations are suppressed: %v", err)
+		suppressionRules = &SuppressionRules{}
+	}
+
 	var recommendations []Recommendation
-	var criticalCount, warningCount, infoCount int
+	var criticalCount, warningCount, infoCount, suppressedCount int
 
 	// Process all test types using config
-	testMappings := []struct {
+	type testMapping struct {
 		testName string
 		results  []TestResult
-	}{
+	}
+	testMappings := []testMapping{
 		{"gpu_count_check", results.GPUCountCheck},
 		{"gpu_mode_check", results.GPUModeCheck},
 		{"pcie_error_check", results.PCIeErrorCheck},
@@ -174,7 +394,6 @@ func generateRecommendations(results HostResults) RecommendationReport {
 		{"gid_index_check", results.GIDIndexCheck},
 		{"link_check", results.LinkCheck},
 		{"eth_link_check", results.EthLinkCheck},
-		{"auth_check", results.AuthCheck},
 		{"sram_error_check", results.SRAMErrorCheck},
 		{"gpu_driver_check", results.GPUDriverCheck},
 		{"peermem_module_check", results.PeerMemModuleCheck},
@@ -188,12 +407,84 @@ func generateRecommendations(results HostResults) RecommendationReport {
 		{"gpu_xid_check", results.GPUXIDCheck},
 		{"max_acc_check", results.MaxAccCheck},
 		{"row_remap_error_check", results.RowRemapErrorCheck},
+		{"gpu_vbios_check", results.GPUVBIOSCheck},
+		{"hugepages_check", results.HugepagesCheck},
+		{"numa_affinity_check", results.NUMAAffinityCheck},
+		{"ib_sm_check", results.IBSMCheck},
+		{"time_sync_check", results.TimeSyncCheck},
+		{"kernel_module_check", results.KernelModuleCheck},
+		{"systemd_service_check", results.SystemdServiceCheck},
+		{"iommu_check", results.IOMMUCheck},
+		{"nvlink_topology_check", results.NVLinkTopologyCheck},
+		{"pcie_replay_check", results.PCIeReplayCheck},
+		{"rdma_credit_check", results.RDMACreditCheck},
+		{"rdma_mtu_check", results.RDMAMTUCheck},
+		{"rdma_topology_check", results.RDMATopologyCheck},
+		{"bios_settings_check", results.BIOSSettingsCheck},
+		{"gpu_inforom_check", results.GPUInfoROMCheck},
+		{"gpu_row_remap_check", results.GPURowRemapCheck},
+		{"cpu_governor_check", results.CPUGovernorCheck},
+		{"gpu_firmware_check", results.GPUFirmwareCheck},
+		{"gpu_p2p_bw_check", results.GPUP2PBWCheck},
+		{"cpu_isolation_check", results.CPUIsolationCheck},
+		{"pcie_gen_check", results.PCIeGenCheck},
+		{"gpu_cstate_check", results.GPUCStateCheck},
+		{"nfs_mount_check", results.NFSMountCheck},
+		{"ber_trend_check", results.BERTrendCheck},
+		{"ib_cable_check", results.IBCableCheck},
+		{"pcie_rebar_check", results.PCIeReBARCheck},
+		{"gpu_idle_check", results.GPUIdleCheck},
+		{"pcie_device_count_check", results.PCIeDeviceCountCheck},
+		{"gpu_firmware_update_check", results.GPUFirmwareUpdateCheck},
+		{"gpu_reset_check", results.GPUResetCheck},
+		{"rdma_interface_speed_check", results.RDMAInterfaceSpeedCheck},
+		{"ib_port_state_check", results.IBPortStateCheck},
+		{"nic_firmware_check", results.NICFirmwareCheck},
+		{"rdma_pci_mapping_check", results.RDMAPCIMappingCheck},
+		{"gpu_pcie_topo_check", results.GPUPCIeTopoCheck},
+		{"mlxconfig_check", results.MLXConfigCheck},
+		{"gpu_power_rail_check", results.GPUPowerRailCheck},
+		{"gpu_temperature_check", results.GPUTemperatureCheck},
+		{"roce_vlan_check", results.RoCEVLANCheck},
+		{"ib_switch_port_check", results.IBSwitchPortCheck},
+		{"gpu_cpu_bw_check", results.GPUCPUBWCheck},
+		{"fabricmanager_log_check", results.FabricManagerLogCheck},
+		{"gpu_mem_temperature_check", results.GPUMemTemperatureCheck},
+		{"pcie_vendor_check", results.PCIeVendorCheck},
+		{"rdma_retry_counter_check", results.RDMARetryCounterCheck},
+		{"nvlink_bw_check", results.NVLinkBWCheck},
+		{"gpu_mig_profile_check", results.GPUMIGProfileCheck},
+		{"network_auth_check", results.NetworkAuthCheck},
+		{"dcgm_field_check", results.DCGMFieldCheck},
+		{"numa_distance_check", results.NUMADistanceCheck},
+		{"cpu_topology_check", results.CPUTopologyCheck},
+		{"memory_check", results.MemoryCheck},
+		{"gpu_firmware_consistency_check", results.GPUFirmwareConsistencyCheck},
+		{"test_timeout", results.TestTimeouts},
+	}
+
+	// A test that could not run gets a recommendation for its error instead of its failure
+	erroredTests := make(map[string]bool)
+	for _, testError := range results.TestErrors {
+		if recommendation, ok := errorRecommendations[testError.ErrorType]; ok {
+			erroredTests[testError.TestName] = true
+			testMappings = append(testMappings, testMapping{recommendation, []TestResult{testError}})
+		}
 	}
 
 	for _, mapping := range testMappings {
 		for _, testResult := range mapping.results {
+			if erroredTests[mapping.testName] && strings.ToUpper(testResult.Status) != "PASS" {
+				continue
+			}
 			if rec := config.GetRecommendation(mapping.testName, testResult.Status, testResult); rec != nil {
+				// Suppressed recommendations are reported with their reason but not counted as issues
+				suppressed := rec.Type != "info" && suppressionRules.Suppress(rec)
 				recommendations = append(recommendations, *rec)
+				if suppressed {
+					suppressedCount++
+					continue
+				}
 
 				// Count by type
 				switch rec.Type {
@@ -208,6 +499,9 @@ func generateRecommendations(results HostResults) RecommendationReport {
 		}
 	}
 
+	// Group failures caused by a failed dependency under their root cause
+	recommendations = markDerivedRecommendations(recommendations, loadTestDependencies())
+
 	// Generate summary using config
 	totalIssues := criticalCount + warningCount
 	summary := config.GetSummary(totalIssues, criticalCount, warningCount)
@@ -218,6 +512,7 @@ func generateRecommendations(results HostResults) RecommendationReport {
 		CriticalIssues:  criticalCount,
 		WarningIssues:   warningCount,
 		InfoIssues:      infoCount,
+		SuppressedCount: suppressedCount,
 		Recommendations: recommendations,
 		GeneratedAt:     time.Now().UTC().Format(time.RFC3339),
 	}
@@ -281,7 +576,7 @@ func generateFallbackRecommendations(results HostResults) RecommendationReport {
 			rec := Recommendation{
 				Type:       "warning",
 				TestName:   "rdma_nics_count",
-				Issue:      fmt.Sprintf("RDMA NIC count mismatch (found: %d)", rdma.NumRDMANics),
+				Issue:      fmt.Sprintf("RDMA NIC count mismatch (found: %d, missing: %s)", rdma.NumRDMANics, joinOrNone(rdma.MissingDevices)),
 				Suggestion: "Verify RDMA hardware installation and driver configuration",
 				Commands:   []string{"ibstat", "ibv_devices"},
 			}
@@ -360,7 +655,7 @@ func generateFallbackRecommendations(results HostResults) RecommendationReport {
 			rec := Recommendation{
 				Type:       "critical",
 				TestName:   "sram_error_check",
-				Issue:      fmt.Sprintf("SRAM uncorrectable errors detected (max: %d)", sram.MaxUncorrectable),
+				Issue:      fmt.Sprintf("SRAM uncorrectable errors detected (max: %d) on %s", sram.MaxUncorrectable, formatSRAMErrorGPUs(sram.PerGPUErrors)),
 				Suggestion: "Check GPU memory health and consider replacing affected hardware",
 				Commands: []string{
 					"sudo nvidia-smi -q | grep -A 3 Aggregate | grep Correctable",
@@ -373,7 +668,7 @@ func generateFallbackRecommendations(results HostResults) RecommendationReport {
 			rec := Recommendation{
 				Type:       "warning",
 				TestName:   "sram_error_check",
-				Issue:      fmt.Sprintf("SRAM correctable errors exceed threshold (max: %d)", sram.MaxCorrectable),
+				Issue:      fmt.Sprintf("SRAM correctable errors exceed threshold (max: %d) on %s", sram.MaxCorrectable, formatSRAMErrorGPUs(sram.PerGPUErrors)),
 				Suggestion: "Monitor GPU memory health and consider maintenance scheduling",
 				Commands: []string{
 					"sudo nvidia-smi -q | grep -A 3 Aggregate | grep Correctable",
@@ -494,6 +789,10 @@ func generateFallbackRecommendations(results HostResults) RecommendationReport {
 				Suggestion: "Check hardware connections, reseat PCIe cards, and verify all components are properly installed",
 				Commands:   []string{"lspci | grep -i 'rev ff'", "lspci -tv"},
 			}
+			if missingCheck.MissingCount == 0 && len(missingCheck.MissingRDMA) > 0 {
+				rec.Issue = fmt.Sprintf("Missing RDMA interfaces detected: %s", strings.Join(missingCheck.MissingRDMA, ", "))
+				rec.Commands = []string{"ip -o link show", "sudo ibdev2netdev"}
+			}
 			recommendations = append(recommendations, rec)
 			criticalCount++
 		}
@@ -518,6 +817,22 @@ func generateFallbackRecommendations(results HostResults) RecommendationReport {
 		}
 	}
 
+	// Basic missing tool recommendations
+	for _, testError := range results.TestErrors {
+		if testError.ErrorType == "tool_not_found" && testError.Package != "" {
+			rec := Recommendation{
+				Type:       "warning",
+				TestName:   "tool_not_found",
+				FaultCode:  "HPCGPU-0027-0001",
+				Issue:      fmt.Sprintf("Test %s could not run because %s is not installed", testError.TestName, testError.Tool),
+				Suggestion: fmt.Sprintf("Install the %s package, which provides %s, and rerun the test", testError.Package, testError.Tool),
+				Commands:   []string{"sudo apt-get install -y " + testError.Package, "sudo dnf install -y " + testError.Package},
+			}
+			recommendations = append(recommendations, rec)
+			warningCount++
+		}
+	}
+
 	totalIssues := criticalCount + warningCount
 	summary := fmt.Sprintf("Found %d issue(s) requiring attention: %d critical, %d warning (fallback mode)",
 		totalIssues, criticalCount, warningCount)
@@ -585,6 +900,9 @@ func formatRecommendationsTable(report RecommendationReport) (string, error) {
 	output.WriteString(fmt.Sprintf("│ %-63s │\n", fmt.Sprintf("Critical: %d", report.CriticalIssues)))
 	output.WriteString(fmt.Sprintf("│ %-63s │\n", fmt.Sprintf("Warning: %d", report.WarningIssues)))
 	output.WriteString(fmt.Sprintf("│ %-63s │\n", fmt.Sprintf("Info: %d", report.InfoIssues)))
+	if report.SuppressedCount > 0 {
+		output.WriteString(fmt.Sprintf("│ %-63s │\n", fmt.Sprintf("Suppressed: %d", report.SuppressedCount)))
+	}
 	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
 
 	// Recommendations section
@@ -607,17 +925,30 @@ func formatRecommendationsTable(report RecommendationReport) (string, error) {
 
 			// Calculate remaining space for test name after number, type, and brackets
 			typeStr := strings.ToUpper(rec.Type)
+			if rec.Status == SuppressedStatus {
+				typeStr = SuppressedStatus
+			}
 			prefixLen := len(fmt.Sprintf(" %d. [%s] ", i+1, typeStr))
 			testNameSpace := 64 - prefixLen
 			if testNameSpace < 0 {
 				testNameSpace = 10 // minimum space
 			}
 			output.WriteString(fmt.Sprintf("│ %d. [%s] %-*s │\n", i+1, typeStr, testNameSpace, rec.TestName))
+			if rec.DerivedFrom != "" {
+				output.WriteString(fmt.Sprintf("│    Derived from: %-46s │\n", rec.DerivedFrom))
+			}
 			if rec.FaultCode != "" {
 				output.WriteString(fmt.Sprintf("│    Fault Code: %-48s │\n", rec.FaultCode))
 			}
 			output.WriteString(fmt.Sprintf("│    Issue: %-53s │\n", issue))
 			output.WriteString(fmt.Sprintf("│    Suggestion: %-48s │\n", suggestion))
+			if rec.SuppressionReason != "" {
+				reason := rec.SuppressionReason
+				if len(reason) > 48 {
+					reason = reason[:45] + "..."
+				}
+				output.WriteString(fmt.Sprintf("│    Suppressed: %-48s │\n", reason))
+			}
 
 			if len(rec.Commands) > 0 && len(rec.Commands[0]) <= 51 {
 				output.WriteString(fmt.Sprintf("│    Command: %-51s │\n", rec.Commands[0]))
@@ -649,6 +980,9 @@ func formatRecommendationsFriendly(report RecommendationReport) (string, error)
 	output.WriteString(fmt.Sprintf("   • Critical: %d\n", report.CriticalIssues))
 	output.WriteString(fmt.Sprintf("   • Warning: %d\n", report.WarningIssues))
 	output.WriteString(fmt.Sprintf("   • Info: %d\n", report.InfoIssues))
+	if report.SuppressedCount > 0 {
+		output.WriteString(fmt.Sprintf("   • Suppressed: %d\n", report.SuppressedCount))
+	}
 
 	if len(report.Recommendations) == 0 {
 		output.WriteString("\n✅ No recommendations needed. System appears healthy!\n")
@@ -661,23 +995,36 @@ func formatRecommendationsFriendly(report RecommendationReport) (string, error)
 
 	for i, rec := range report.Recommendations {
 		var icon string
-		switch rec.Type {
-		case "critical":
+		typeStr := strings.ToUpper(rec.Type)
+		switch {
+		case rec.Status == SuppressedStatus:
+			icon = "🔕"
+			typeStr = SuppressedStatus
+		case rec.Type == "critical":
 			icon = "🚨"
-		case "warning":
+		case rec.Type == "warning":
 			icon = "⚠️"
-		case "info":
+		case rec.Type == "info":
 			icon = "ℹ️"
 		default:
 			icon = "•"
 		}
 
-		output.WriteString(fmt.Sprintf("\n%s %d. %s [%s]\n", icon, i+1, strings.ToUpper(rec.Type), rec.TestName))
+		if rec.DerivedFrom != "" {
+			// Derived recommendations are indented under their root cause
+			output.WriteString(fmt.Sprintf("\n   ↳ %s %d. %s [%s]\n", icon, i+1, typeStr, rec.TestName))
+			output.WriteString(fmt.Sprintf("   Derived from: %s (resolve it first)\n", rec.DerivedFrom))
+		} else {
+			output.WriteString(fmt.Sprintf("\n%s %d. %s [%s]\n", icon, i+1, typeStr, rec.TestName))
+		}
 		if rec.FaultCode != "" {
 			output.WriteString(fmt.Sprintf("   Fault Code: %s\n", rec.FaultCode))
 		}
 		output.WriteString(fmt.Sprintf("   Issue: %s\n", rec.Issue))
 		output.WriteString(fmt.Sprintf("   Suggestion: %s\n", rec.Suggestion))
+		if rec.SuppressionReason != "" {
+			output.WriteString(fmt.Sprintf("   Suppression reason: %s\n", rec.SuppressionReason))
+		}
 
 		if len(rec.Commands) > 0 {
 			output.WriteString("   Commands to run:\n")
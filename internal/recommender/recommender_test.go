@@ -591,6 +591,54 @@ func TestGenerateRecommendations_WithConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateRecommendations_TestErrors(t *testing.T) {
+	config := createTestConfig()
+	config.Recommendations["tool_not_found"] = TestRecommendations{
+		Fail: &RecommendationTemplate{
+			Type:       "warning",
+			Issue:      "Test {test_name} could not run because {tool} is not installed",
+			Suggestion: "Install the {package} package",
+			Commands:   []string{"sudo dnf install -y {package}"},
+		},
+	}
+
+	currentDirConfig := "./recommendations.json"
+	configData, _ := json.MarshalIndent(config, "", "  ")
+	os.WriteFile(currentDirConfig, configData, 0644)
+	defer os.Remove(currentDirConfig)
+
+	results := HostResults{
+		GPUCountCheck: []TestResult{
+			{Status: "FAIL", GPUCount: 0},
+		},
+		PeerMemModuleCheck: []TestResult{
+			{Status: "FAIL", ModuleLoaded: false},
+		},
+		TestErrors: []TestResult{
+			{Status: "FAIL", TestName: "gpu_count_check", ErrorType: "tool_not_found", Tool: "nvidia-smi", Package: "nvidia-utils"},
+			{Status: "FAIL", TestName: "peermem_module_check", ErrorType: "threshold_exceeded"},
+		},
+	}
+
+	report := generateRecommendations(results)
+
+	// The missing tool replaces the GPU count recommendation; other error types keep theirs
+	if len(report.Recommendations) != 2 {
+		t.Fatalf("Expected 2 recommendations, got %+v", report.Recommendations)
+	}
+	if rec := report.Recommendations[0]; rec.TestName != "peermem_module_check" {
+		t.Errorf("Expected peermem_module_check recommendation, got %+v", rec)
+	}
+	rec := report.Recommendations[1]
+	if rec.TestName != "tool_not_found" || rec.Issue != "Test gpu_count_check could not run because nvidia-smi is not installed" ||
+		rec.Suggestion != "Install the nvidia-utils package" || rec.Commands[0] != "sudo dnf install -y nvidia-utils" {
+		t.Errorf("Unexpected tool_not_found recommendation: %+v", rec)
+	}
+	if report.WarningIssues != 1 || report.CriticalIssues != 1 {
+		t.Errorf("Expected 1 critical and 1 warning issue, got %d and %d", report.CriticalIssues, report.WarningIssues)
+	}
+}
+
 func TestGenerateRecommendations_FallbackMode(t *testing.T) {
 	// Ensure no config file exists
 	tempDir := t.TempDir()
@@ -627,6 +675,31 @@ func TestGenerateRecommendations_FallbackMode(t *testing.T) {
 	}
 }
 
+func TestGenerateRecommendations_FallbackToolNotFound(t *testing.T) {
+	tempDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+
+	os.Chdir(tempDir)
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", "")
+
+	results := HostResults{
+		TestErrors: []TestResult{
+			{Status: "FAIL", TestName: "max_acc_check", ErrorType: "tool_not_found", Tool: "mlxconfig", Package: "mft"},
+		},
+	}
+
+	report := generateRecommendations(results)
+
+	if len(report.Recommendations) != 1 {
+		t.Fatalf("Expected 1 fallback recommendation, got %d", len(report.Recommendations))
+	}
+	if rec := report.Recommendations[0]; !strings.Contains(rec.Suggestion, "Install the mft package") || rec.Type != "warning" {
+		t.Errorf("Unexpected fallback recommendation: %+v", rec)
+	}
+}
+
 // Specific test type validations
 
 func TestSpecificTestTypes(t *testing.T) {
@@ -746,3 +819,81 @@ func TestSpecificTestTypes(t *testing.T) {
 		})
 	}
 }
+
+// appendedRunsJSON is an appended report with a failing oldest run and a warning latest run
+const appendedRunsJSON = `{"test_runs": [
+	{"run_id": "run_1", "timestamp": "2025-01-15T10:30:00Z", "test_results": {
+		"gpu_count_check": [{"status": "FAIL", "gpu_count": 7}],
+		"pcie_error_check": [{"status": "PASS"}],
+		"test_timeouts": [{"status": "FAIL"}]}},
+	{"run_id": "run_2", "timestamp": "2025-01-16T10:30:00Z", "test_results": {
+		"gpu_count_check": [{"status": "PASS", "gpu_count": 8}],
+		"pcie_error_check": [{"status": "WARN"}]}}
+]}`
+
+func TestParseResults_RunSelector(t *testing.T) {
+	tests := []struct {
+		selector  string
+		wantCount int
+		wantErr   bool
+	}{
+		{"", 8, false},
+		{"-1", 8, false},
+		{"0", 7, false},
+		{"-2", 7, false},
+		{"run_1", 7, false},
+		{"run_2", 8, false},
+		{"2", 0, true},
+		{"-3", 0, true},
+		{"run_9", 0, true},
+	}
+
+	for _, tt := range tests {
+		results, err := parseResults([]byte(appendedRunsJSON), tt.selector)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseResults(%q) error = %v, wantErr %v", tt.selector, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && results.GPUCountCheck[0].GPUCount != tt.wantCount {
+			t.Errorf("parseResults(%q) selected the run with %d GPUs, want %d", tt.selector, results.GPUCountCheck[0].GPUCount, tt.wantCount)
+		}
+	}
+
+	single := []byte(`{"localhost": {"gpu_count_check": [{"status": "PASS", "gpu_count": 8}]}}`)
+	if _, err := parseResults(single, "-1"); err != nil {
+		t.Errorf("parseResults() of a single report error = %v", err)
+	}
+	if _, err := parseResults(single, "run_1"); err == nil {
+		t.Error("Expected error selecting a run ID in a single report")
+	}
+}
+
+func TestListRuns(t *testing.T) {
+	resultsFile := filepath.Join(t.TempDir(), "results.json")
+	if err := os.WriteFile(resultsFile, []byte(appendedRunsJSON), 0644); err != nil {
+		t.Fatalf("Failed to write results file: %v", err)
+	}
+
+	runs, err := ListRuns(resultsFile)
+	if err != nil {
+		t.Fatalf("ListRuns() error = %v", err)
+	}
+	if len(runs) != 2 || runs[0].RunID != "run_1" || runs[1].Timestamp != "2025-01-16T10:30:00Z" {
+		t.Fatalf("Unexpected runs %+v", runs)
+	}
+	if runs[0].PassCount != 1 || runs[0].WarnCount != 0 || runs[0].FailCount != 1 {
+		t.Errorf("Run 0 counts = %d/%d/%d, want 1 pass and 1 fail without the timeout entry", runs[0].PassCount, runs[0].WarnCount, runs[0].FailCount)
+	}
+	if runs[1].PassCount != 1 || runs[1].WarnCount != 1 || runs[1].FailCount != 0 {
+		t.Errorf("Run 1 counts = %d/%d/%d, want 1 pass and 1 warn", runs[1].PassCount, runs[1].WarnCount, runs[1].FailCount)
+	}
+
+	table := FormatRunsTable(runs)
+	if !strings.Contains(table, "run_1") || !strings.Contains(table, "2025-01-16T10:30:00Z") {
+		t.Errorf("Runs table missing run details:\n%s", table)
+	}
+
+	if _, err := ListRuns(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected error for missing results file")
+	}
+}
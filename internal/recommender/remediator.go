@@ -0,0 +1,175 @@
+package recommender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+const (
+	// remediationLogPath records every command executed by the remediator
+	remediationLogPath = "/var/log/oci-dr-hpc/remediation.log"
+	// remediationCommandTimeout bounds each remediation command so a hanging command does not block the others
+	remediationCommandTimeout = 5 * time.Minute
+)
+
+// commandRunner runs a shell command and returns its stdout and stderr
+type commandRunner func(command string) (stdout, stderr string, err error)
+
+// Remediator runs the commands of recommendations marked safe_to_autorun in recommendations.json
+type Remediator struct {
+	dryRun  bool
+	isRoot  bool
+	out     io.Writer
+	logPath string
+	run     commandRunner
+}
+
+// NewRemediator creates a remediator; in dry run mode the commands are only printed
+func NewRemediator(dryRun bool) *Remediator {
+	return &Remediator{
+		dryRun:  dryRun,
+		isRoot:  os.Geteuid() == 0,
+		out:     os.Stdout,
+		logPath: remediationLogPath,
+		run:     runShellCommand,
+	}
+}
+
+// RemediateResults generates the recommendations of the results file and remediates them
+func RemediateResults(resultsFile string, dryRun bool) error {
+	logger.Info(fmt.Sprintf("Remediating results file: %s", resultsFile))
+
+	report, err := loadRecommendations(resultsFile, "")
+	if err != nil {
+		return err
+	}
+
+	return NewRemediator(dryRun).Remediate(report)
+}
+
+// Remediate runs the commands of every actionable recommendation marked safe to autorun.
+// All commands of a recommendation are run even if one fails, since diagnostic commands
+// such as grep exit non-zero when the issue is present.
+func (r *Remediator) Remediate(report RecommendationReport) error {
+	var logFile *os.File
+	if !r.dryRun {
+		var err error
+		logFile, err = r.openLog()
+		if err != nil {
+			return err
+		}
+		defer logFile.Close()
+	}
+
+	executed, failed := 0, 0
+	for _, rec := range report.Recommendations {
+		if rec.Type == "info" || rec.Status == SuppressedStatus || len(rec.Commands) == 0 {
+			continue
+		}
+
+		if !rec.SafeToAutorun {
+			fmt.Fprintf(r.out, "Skipping %s [%s]: commands are not marked safe_to_autorun\n", rec.FaultCode, rec.TestName)
+			continue
+		}
+
+		fmt.Fprintf(r.out, "Remediating %s [%s]: %s\n", rec.FaultCode, rec.TestName, rec.Issue)
+		for _, command := range rec.Commands {
+			command = r.prepareCommand(command)
+			if r.dryRun {
+				fmt.Fprintf(r.out, "[DRY RUN] %s\n", command)
+				continue
+			}
+
+			fmt.Fprintf(r.out, "$ %s\n", command)
+			stdout, stderr, err := r.run(command)
+			executed++
+			if stdout != "" {
+				fmt.Fprint(r.out, stdout)
+			}
+			if stderr != "" {
+				fmt.Fprint(r.out, stderr)
+			}
+			if err != nil {
+				failed++
+				logger.Errorf("Remediation command %q failed: %v", command, err)
+			}
+			writeRemediationLog(logFile, rec.TestName, command, stdout, stderr, err)
+		}
+	}
+
+	if r.dryRun {
+		return nil
+	}
+	logger.Infof("Remediation executed %d command(s), %d failed; log written to %s", executed, failed, r.logPath)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d remediation command(s) failed, see %s", failed, executed, r.logPath)
+	}
+	return nil
+}
+
+// prepareCommand strips sudo when running as root and otherwise makes sudo non-interactive,
+// so a command needing a password fails instead of waiting for input
+func (r *Remediator) prepareCommand(command string) string {
+	if !strings.HasPrefix(command, "sudo ") {
+		return command
+	}
+	if r.isRoot {
+		return strings.TrimPrefix(command, "sudo ")
+	}
+	if strings.HasPrefix(command, "sudo -n ") {
+		return command
+	}
+	return "sudo -n " + strings.TrimPrefix(command, "sudo ")
+}
+
+// openLog opens the remediation log for appending, creating its directory if needed
+func (r *Remediator) openLog() (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(r.logPath), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create remediation log directory: %w", err)
+	}
+	logFile, err := os.OpenFile(r.logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open remediation log %s: %w", r.logPath, err)
+	}
+	return logFile, nil
+}
+
+// writeRemediationLog appends an executed command and its output to the remediation log
+func writeRemediationLog(w io.Writer, testName, command, stdout, stderr string, err error) {
+	result := "success"
+	if err != nil {
+		result = err.Error()
+	}
+	fmt.Fprintf(w, "%s test=%s command=%q result=%q\n", time.Now().UTC().Format(time.RFC3339), testName, command, result)
+	if stdout != "" {
+		fmt.Fprintf(w, "stdout:\n%s\n", strings.TrimRight(stdout, "\n"))
+	}
+	if stderr != "" {
+		fmt.Fprintf(w, "stderr:\n%s\n", strings.TrimRight(stderr, "\n"))
+	}
+}
+
+// runShellCommand runs command with bash, since recommendation commands may use pipes
+func runShellCommand(command string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remediationCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "bash", "-c", command)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %v", remediationCommandTimeout)
+	}
+	return stdout.String(), stderr.String(), err
+}
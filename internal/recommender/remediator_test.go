@@ -0,0 +1,102 @@
+package recommender
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestRemediator(t *testing.T, dryRun bool, ran *[]string) (*Remediator, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	return &Remediator{
+		dryRun:  dryRun,
+		out:     out,
+		logPath: filepath.Join(t.TempDir(), "oci-dr-hpc", "remediation.log"),
+		run: func(command string) (string, string, error) {
+			*ran = append(*ran, command)
+			if strings.HasPrefix(command, "lsmod") {
+				return "", "", errors.New("exit status 1")
+			}
+			return "ok\n", "", nil
+		},
+	}, out
+}
+
+func testRemediationReport() RecommendationReport {
+	return RecommendationReport{
+		Recommendations: []Recommendation{
+			{Type: "critical", TestName: "peermem_module_check", Commands: []string{"lsmod | grep nvidia_peermem", "sudo modprobe nvidia_peermem"}, SafeToAutorun: true},
+			{Type: "critical", TestName: "gpu_driver_check", Commands: []string{"sudo systemctl reboot"}},
+			{Type: "warning", TestName: "cpu_governor_check", Status: SuppressedStatus, Commands: []string{"sudo cpupower frequency-set -g performance"}, SafeToAutorun: true},
+			{Type: "info", TestName: "gpu_count_check", Commands: []string{"nvidia-smi"}, SafeToAutorun: true},
+		},
+	}
+}
+
+func TestRemediateDryRun(t *testing.T) {
+	var ran []string
+	remediator, out := newTestRemediator(t, true, &ran)
+
+	if err := remediator.Remediate(testRemediationReport()); err != nil {
+		t.Fatalf("Remediate() error = %v", err)
+	}
+	if len(ran) != 0 {
+		t.Errorf("Remediate() ran %v in dry run mode", ran)
+	}
+	if !strings.Contains(out.String(), "[DRY RUN] sudo -n modprobe nvidia_peermem") {
+		t.Errorf("Remediate() output missing dry run command:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "systemctl reboot") {
+		t.Errorf("Remediate() printed a command not marked safe_to_autorun:\n%s", out.String())
+	}
+	if _, err := os.Stat(remediator.logPath); !os.IsNotExist(err) {
+		t.Errorf("Remediate() wrote the remediation log in dry run mode")
+	}
+}
+
+func TestRemediate(t *testing.T) {
+	var ran []string
+	remediator, _ := newTestRemediator(t, false, &ran)
+
+	err := remediator.Remediate(testRemediationReport())
+	if err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Errorf("Remediate() error = %v, want 1 of 2 commands failed", err)
+	}
+
+	expected := []string{"lsmod | grep nvidia_peermem", "sudo -n modprobe nvidia_peermem"}
+	if !reflect.DeepEqual(ran, expected) {
+		t.Errorf("Remediate() ran %v, want %v", ran, expected)
+	}
+
+	log, err := os.ReadFile(remediator.logPath)
+	if err != nil {
+		t.Fatalf("Failed to read remediation log: %v", err)
+	}
+	if !strings.Contains(string(log), `command="sudo -n modprobe nvidia_peermem" result="success"`) {
+		t.Errorf("Remediation log missing executed command:\n%s", log)
+	}
+}
+
+func TestPrepareCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		isRoot   bool
+		expected string
+	}{
+		{"sudo modprobe nvidia_peermem", true, "modprobe nvidia_peermem"},
+		{"sudo modprobe nvidia_peermem", false, "sudo -n modprobe nvidia_peermem"},
+		{"sudo -n true", false, "sudo -n true"},
+		{"lsmod | grep nvidia", false, "lsmod | grep nvidia"},
+	}
+
+	for _, tt := range tests {
+		remediator := &Remediator{isRoot: tt.isRoot}
+		if got := remediator.prepareCommand(tt.command); got != tt.expected {
+			t.Errorf("prepareCommand(%q, root %t) = %q, want %q", tt.command, tt.isRoot, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package recommender
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/config"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+const (
+	// SuppressedStatus is the status of recommendations matched by an active suppression rule
+	SuppressedStatus = "SUPPRESSED"
+	// suppressionDateFormat is the format of the expires date of a suppression rule
+	suppressionDateFormat = "2006-01-02"
+)
+
+// SuppressionRule suppresses the recommendations of a test that are accepted as non-blocking.
+// An empty Status matches every status. Expires is the last day, in UTC, the rule applies;
+// an empty Expires never expires.
+type SuppressionRule struct {
+	TestName string `json:"test_name"`
+	Status   string `json:"status,omitempty"`
+	Reason   string `json:"reason"`
+	Expires  string `json:"expires,omitempty"`
+
+	expires time.Time
+}
+
+// SuppressionRules represents the suppression rules in suppression_rules.json
+type SuppressionRules struct {
+	Rules []SuppressionRule `json:"rules"`
+}
+
+// LoadSuppressionRules loads the suppression rules. No rules are loaded when no
+// suppression_rules.json is installed, since suppression is optional.
+func LoadSuppressionRules() (*SuppressionRules, error) {
+	data, path, err := config.FindConfigFile("suppression_rules.json")
+	if err != nil {
+		logger.Debugf("Suppression rules not found, no recommendations are suppressed: %v", err)
+		return &SuppressionRules{}, nil
+	}
+
+	logger.Infof("Loading suppression rules from: %s", path)
+	return parseSuppressionRules(data)
+}
+
+// parseSuppressionRules parses and validates suppression rules
+func parseSuppressionRules(data []byte) (*SuppressionRules, error) {
+	var rules SuppressionRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse suppression rules: %w", err)
+	}
+
+	for i := range rules.Rules {
+		rule := &rules.Rules[i]
+		if rule.TestName == "" {
+			return nil, fmt.Errorf("suppression rule %d has no test_name", i+1)
+		}
+		if rule.Reason == "" {
+			return nil, fmt.Errorf("suppression rule for %s has no reason", rule.TestName)
+		}
+		if rule.Expires != "" {
+			expires, err := time.Parse(suppressionDateFormat, rule.Expires)
+			if err != nil {
+				return nil, fmt.Errorf("suppression rule for %s has an invalid expires date %q: %w", rule.TestName, rule.Expires, err)
+			}
+			rule.expires = expires
+		}
+	}
+
+	return &rules, nil
+}
+
+// matches reports whether the rule applies to rec at now; a rule applies through its expires day
+func (rule *SuppressionRule) matches(rec Recommendation, now time.Time) bool {
+	if rule.TestName != rec.TestName {
+		return false
+	}
+	if rule.Status != "" && !strings.EqualFold(rule.Status, rec.Status) {
+		return false
+	}
+	return rule.expires.IsZero() || now.UTC().Before(rule.expires.AddDate(0, 0, 1))
+}
+
+// matchingRule returns the first rule suppressing rec at now, or nil
+func (s *SuppressionRules) matchingRule(rec Recommendation, now time.Time) *SuppressionRule {
+	for i := range s.Rules {
+		if s.Rules[i].matches(rec, now) {
+			return &s.Rules[i]
+		}
+	}
+	return nil
+}
+
+// ShouldSuppress reports whether an active suppression rule matches rec
+func (s *SuppressionRules) ShouldSuppress(rec Recommendation) bool {
+	return s.matchingRule(rec, time.Now()) != nil
+}
+
+// Suppress marks rec as suppressed with the reason of the matching rule and reports whether it was suppressed
+func (s *SuppressionRules) Suppress(rec *Recommendation) bool {
+	rule := s.matchingRule(*rec, time.Now())
+	if rule == nil {
+		return false
+	}
+	logger.Infof("Suppressing %s recommendation for %s: %s", rec.Status, rec.TestName, rule.Reason)
+	rec.Status = SuppressedStatus
+	rec.SuppressionReason = rule.Reason
+	return true
+}
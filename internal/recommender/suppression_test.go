@@ -0,0 +1,109 @@
+package recommender
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSuppressionRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid rules",
+			data: `{"rules": [{"test_name": "pcie_error_check", "status": "WARN", "reason": "Known issue", "expires": "2026-12-31"},
+				{"test_name": "gpu_count_check", "reason": "Replacement scheduled"}]}`,
+		},
+		{
+			name: "no rules",
+			data: `{"rules": []}`,
+		},
+		{
+			name:    "missing test name",
+			data:    `{"rules": [{"reason": "Known issue"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing reason",
+			data:    `{"rules": [{"test_name": "pcie_error_check"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid expires date",
+			data:    `{"rules": [{"test_name": "pcie_error_check", "reason": "Known issue", "expires": "12/31/2026"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			data:    `{"rules": [`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSuppressionRules([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseSuppressionRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSuppressionRuleMatches(t *testing.T) {
+	rules, err := parseSuppressionRules([]byte(`{"rules": [
+		{"test_name": "pcie_error_check", "status": "warn", "reason": "Known issue", "expires": "2026-12-31"},
+		{"test_name": "gpu_count_check", "reason": "Replacement scheduled"}]}`))
+	if err != nil {
+		t.Fatalf("parseSuppressionRules() error = %v", err)
+	}
+
+	beforeExpiry := time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC)
+	afterExpiry := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rec  Recommendation
+		now  time.Time
+		want bool
+	}{
+		{"matching test and status", Recommendation{TestName: "pcie_error_check", Status: "WARN"}, beforeExpiry, true},
+		{"different status", Recommendation{TestName: "pcie_error_check", Status: "FAIL"}, beforeExpiry, false},
+		{"expired rule", Recommendation{TestName: "pcie_error_check", Status: "WARN"}, afterExpiry, false},
+		{"rule without status or expiry", Recommendation{TestName: "gpu_count_check", Status: "FAIL"}, afterExpiry, true},
+		{"different test", Recommendation{TestName: "gpu_mode_check", Status: "FAIL"}, beforeExpiry, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules.matchingRule(tt.rec, tt.now) != nil; got != tt.want {
+				t.Errorf("matchingRule() matched = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuppress(t *testing.T) {
+	rules, err := parseSuppressionRules([]byte(`{"rules": [{"test_name": "gpu_count_check", "reason": "Replacement scheduled"}]}`))
+	if err != nil {
+		t.Fatalf("parseSuppressionRules() error = %v", err)
+	}
+
+	rec := Recommendation{Type: "critical", TestName: "gpu_count_check", Status: "FAIL"}
+	if !rules.Suppress(&rec) {
+		t.Fatal("Suppress() = false, want true")
+	}
+	if rec.Status != SuppressedStatus {
+		t.Errorf("Status = %s, want %s", rec.Status, SuppressedStatus)
+	}
+	if rec.SuppressionReason != "Replacement scheduled" {
+		t.Errorf("SuppressionReason = %q, want %q", rec.SuppressionReason, "Replacement scheduled")
+	}
+
+	other := Recommendation{Type: "critical", TestName: "gpu_mode_check", Status: "FAIL"}
+	if rules.Suppress(&other) || other.Status != "FAIL" {
+		t.Errorf("Suppress() changed a recommendation without a matching rule: %+v", other)
+	}
+}
@@ -0,0 +1,246 @@
+package remote
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+const (
+	// BinaryName is the name of the diagnostic binary installed on nodes by the RPM and DEB packages
+	BinaryName = "oci-dr-hpc-v2"
+	// DefaultRemoteBinary is where the local binary is copied on nodes that do not have it installed
+	DefaultRemoteBinary = "/tmp/oci-dr-hpc-v2"
+	// DefaultConcurrency is the number of hosts diagnosed at the same time
+	DefaultConcurrency = 16
+	// DefaultHostTimeout bounds the whole diagnostic run on a single host
+	DefaultHostTimeout = 30 * time.Minute
+	// connectTimeoutSeconds bounds establishing each SSH connection
+	connectTimeoutSeconds = 10
+	// sshConnectionError is the exit code of ssh when it cannot connect or authenticate
+	sshConnectionError = 255
+)
+
+// execCommand runs a local command and returns its stdout; replaced in tests
+var execCommand = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return output, fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return output, fmt.Errorf("%s failed: %w", name, err)
+	}
+	return output, nil
+}
+
+// SSHRunner runs the level1 diagnostics on remote hosts using the system ssh and scp clients.
+// Authentication uses the SSH agent and ssh configuration unless KeyFile is set.
+type SSHRunner struct {
+	KeyFile      string
+	LocalBinary  string
+	RemoteBinary string
+	Concurrency  int
+	HostTimeout  time.Duration
+}
+
+// NewSSHRunner creates an SSHRunner that copies the running binary to hosts that do not have it installed
+func NewSSHRunner(keyFile string) (*SSHRunner, error) {
+	if keyFile != "" {
+		if _, err := os.Stat(keyFile); err != nil {
+			return nil, fmt.Errorf("SSH key file not accessible: %w", err)
+		}
+	}
+
+	localBinary, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate diagnostic binary: %w", err)
+	}
+
+	return &SSHRunner{
+		KeyFile:      keyFile,
+		LocalBinary:  localBinary,
+		RemoteBinary: DefaultRemoteBinary,
+		Concurrency:  DefaultConcurrency,
+		HostTimeout:  DefaultHostTimeout,
+	}, nil
+}
+
+// ParseTargets returns the hosts from a hostfile path or a comma-separated list of hostnames.
+// Hostfiles contain one host per line; blank lines, comments and slot counts such as
+// "node1 slots=8" are ignored. Duplicate hosts are removed.
+func ParseTargets(targets string) ([]string, error) {
+	var hosts []string
+
+	if info, err := os.Stat(targets); err == nil && !info.IsDir() {
+		file, err := os.Open(targets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open hostfile %s: %w", targets, err)
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			hosts = append(hosts, strings.Fields(line)[0])
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read hostfile %s: %w", targets, err)
+		}
+	} else {
+		for _, host := range strings.Split(targets, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	unique := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if !seen[host] {
+			seen[host] = true
+			unique = append(unique, host)
+		}
+	}
+
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("no hosts found in targets: %s", targets)
+	}
+	return unique, nil
+}
+
+// Run runs the level1 diagnostics with the given arguments on every host and aggregates the results.
+// Hosts that cannot be reached or fail to produce results are recorded in the report errors.
+func (s *SSHRunner) Run(hosts []string, args []string) *reporter.MultiHostReport {
+	report := &reporter.MultiHostReport{
+		Hosts:  make(map[string]reporter.HostResults),
+		Errors: make(map[string]string),
+	}
+
+	concurrency := s.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			logger.Infof("Running diagnostics on %s", host)
+			results, err := s.RunHost(host, args)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				logger.Errorf("Diagnostics failed on %s: %v", host, err)
+				report.Errors[host] = err.Error()
+				return
+			}
+			report.Hosts[host] = *results
+		}(host)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// RunHost runs the level1 diagnostics on a single host and returns its results
+func (s *SSHRunner) RunHost(host string, args []string) (*reporter.HostResults, error) {
+	ctx := context.Background()
+	if s.HostTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.HostTimeout)
+		defer cancel()
+	}
+
+	binary, err := s.ensureBinary(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	// Results go to a temporary file because the diagnostics log to stdout
+	remoteArgs := append([]string{binary, "level1", "--output=json", "--output-file=\"$f\"", "--append=false"}, quoteArgs(args)...)
+	script := fmt.Sprintf(`f=$(mktemp) && { %s >/dev/null 2>&1; cat "$f"; rm -f "$f"; }`, strings.Join(remoteArgs, " "))
+
+	output, err := execCommand(ctx, "ssh", s.sshArgs(host, script)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run diagnostics: %w", err)
+	}
+
+	var report reporter.ReportOutput
+	if err := json.Unmarshal(output, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse diagnostic results: %w", err)
+	}
+	return &report.Localhost, nil
+}
+
+// ensureBinary returns the path of the diagnostic binary on the host, copying it when not installed
+func (s *SSHRunner) ensureBinary(ctx context.Context, host string) (string, error) {
+	probe := fmt.Sprintf("command -v %s || { test -x %s && echo %s; }", BinaryName, shellQuote(s.RemoteBinary), shellQuote(s.RemoteBinary))
+	output, err := execCommand(ctx, "ssh", s.sshArgs(host, probe)...)
+	if path := strings.TrimSpace(string(output)); err == nil && path != "" {
+		return shellQuote(path), nil
+	}
+
+	// ssh exits with 255 when the connection itself fails
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == sshConnectionError {
+		return "", fmt.Errorf("failed to connect: %w", err)
+	}
+
+	logger.Infof("Copying %s to %s:%s", s.LocalBinary, host, s.RemoteBinary)
+	scpArgs := append(s.commonArgs(), "-p", s.LocalBinary, fmt.Sprintf("%s:%s", host, s.RemoteBinary))
+	if _, err := execCommand(ctx, "scp", scpArgs...); err != nil {
+		return "", fmt.Errorf("failed to copy diagnostic binary: %w", err)
+	}
+	return shellQuote(s.RemoteBinary), nil
+}
+
+// commonArgs returns the options shared by ssh and scp
+func (s *SSHRunner) commonArgs() []string {
+	args := []string{"-o", "BatchMode=yes", "-o", fmt.Sprintf("ConnectTimeout=%d", connectTimeoutSeconds)}
+	if s.KeyFile != "" {
+		args = append(args, "-i", s.KeyFile)
+	}
+	return args
+}
+
+// sshArgs returns the ssh arguments running command on host
+func (s *SSHRunner) sshArgs(host, command string) []string {
+	return append(s.commonArgs(), host, command)
+}
+
+// quoteArgs quotes arguments for the remote shell
+func quoteArgs(args []string) []string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, shellQuote(arg))
+	}
+	return quoted
+}
+
+// shellQuote quotes a string for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
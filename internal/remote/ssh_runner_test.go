@@ -0,0 +1,148 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParseTargets(t *testing.T) {
+	hostfile := filepath.Join(t.TempDir(), "hostfile")
+	content := "# allocation\nnode1 slots=8\n\nnode2\nnode1\n"
+	if err := os.WriteFile(hostfile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write hostfile: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		targets     string
+		expected    []string
+		expectError bool
+	}{
+		{name: "Single host", targets: "node1", expected: []string{"node1"}},
+		{name: "Comma-separated list", targets: "node1, node2,,node3", expected: []string{"node1", "node2", "node3"}},
+		{name: "Duplicates removed", targets: "node1,node1", expected: []string{"node1"}},
+		{name: "Hostfile", targets: hostfile, expected: []string{"node1", "node2"}},
+		{name: "Empty", targets: " , ", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hosts, err := ParseTargets(tt.targets)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("ParseTargets() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if strings.Join(hosts, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("ParseTargets() = %v, want %v", hosts, tt.expected)
+			}
+		})
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := map[string]string{
+		"gpu_count_check": "'gpu_count_check'",
+		"it's":            `'it'\''s'`,
+		"":                "''",
+	}
+	for input, expected := range tests {
+		if got := shellQuote(input); got != expected {
+			t.Errorf("shellQuote(%q) = %s, want %s", input, got, expected)
+		}
+	}
+}
+
+// fakeExec replaces execCommand for the duration of a test
+func fakeExec(t *testing.T, fn func(name string, args []string) ([]byte, error)) {
+	original := execCommand
+	execCommand = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		return fn(name, args)
+	}
+	t.Cleanup(func() { execCommand = original })
+}
+
+func TestSSHRunnerRun(t *testing.T) {
+	var mutex sync.Mutex
+	copied := map[string]bool{}
+
+	fakeExec(t, func(name string, args []string) ([]byte, error) {
+		host := ""
+		for _, arg := range args {
+			if strings.HasPrefix(arg, "node") {
+				host = strings.SplitN(arg, ":", 2)[0]
+				break
+			}
+		}
+		command := args[len(args)-1]
+
+		switch {
+		case host == "node3":
+			return nil, errors.New("ssh failed: connection refused")
+		case name == "scp":
+			mutex.Lock()
+			copied[host] = true
+			mutex.Unlock()
+			return nil, nil
+		case strings.HasPrefix(command, "command -v"):
+			if host == "node2" {
+				return nil, errors.New("ssh failed: exit status 1")
+			}
+			return []byte("/usr/bin/oci-dr-hpc-v2\n"), nil
+		case !strings.Contains(command, "'--test=gpu_count_check'"):
+			return nil, errors.New("test filter not forwarded")
+		case host == "node2":
+			return []byte(`{"localhost":{"gpu_count_check":[{"status":"FAIL","gpu_count":7,"timestamp_utc":"2024-01-01T00:00:00Z"}]}}`), nil
+		default:
+			return []byte(`{"localhost":{"gpu_count_check":[{"status":"PASS","gpu_count":8,"timestamp_utc":"2024-01-01T00:00:00Z"}]}}`), nil
+		}
+	})
+
+	runner := &SSHRunner{LocalBinary: "/usr/local/bin/oci-dr-hpc-v2", RemoteBinary: DefaultRemoteBinary, Concurrency: 2}
+	report := runner.Run([]string{"node1", "node2", "node3"}, []string{"--test=gpu_count_check"})
+
+	if len(report.Hosts) != 2 || len(report.Errors) != 1 {
+		t.Fatalf("Expected 2 hosts and 1 error, got %d hosts and %d errors", len(report.Hosts), len(report.Errors))
+	}
+	if report.Hosts["node1"].GPUCountCheck[0].GPUCount != 8 {
+		t.Errorf("Expected node1 GPU count 8, got %+v", report.Hosts["node1"].GPUCountCheck)
+	}
+	if !copied["node2"] || copied["node1"] {
+		t.Errorf("Expected binary to be copied only to node2, got %v", copied)
+	}
+	if _, exists := report.Errors["node3"]; !exists {
+		t.Error("Expected node3 to be reported as unreachable")
+	}
+	if failed := report.FailedHosts(); strings.Join(failed, ",") != "node2,node3" {
+		t.Errorf("FailedHosts() = %v, want [node2 node3]", failed)
+	}
+}
+
+func TestSSHRunnerInvalidOutput(t *testing.T) {
+	fakeExec(t, func(name string, args []string) ([]byte, error) {
+		if strings.HasPrefix(args[len(args)-1], "command -v") {
+			return []byte("/usr/bin/oci-dr-hpc-v2\n"), nil
+		}
+		return []byte(""), nil
+	})
+
+	runner := &SSHRunner{RemoteBinary: DefaultRemoteBinary}
+	if _, err := runner.RunHost("node1", nil); err == nil {
+		t.Error("Expected error for empty diagnostic output")
+	}
+}
+
+func TestSSHRunnerKeyFile(t *testing.T) {
+	runner := &SSHRunner{KeyFile: "/root/.ssh/id_ed25519"}
+	args := strings.Join(runner.sshArgs("node1", "hostname"), " ")
+	if !strings.Contains(args, "-i /root/.ssh/id_ed25519") || !strings.Contains(args, "BatchMode=yes") {
+		t.Errorf("sshArgs() = %s, missing key file or batch mode", args)
+	}
+
+	if _, err := NewSSHRunner(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("Expected error for missing key file")
+	}
+}
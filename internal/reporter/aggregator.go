@@ -0,0 +1,291 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// aggregateIgnoredSections are report sections that repeat failures already reported by their test,
+// or list tests that did not run
+var aggregateIgnoredSections = map[string]bool{
+	"test_timeouts": true,
+	"test_errors":   true,
+	"skipped_tests": true,
+	"test_retries":  true,
+}
+
+// ClusterTestSummary represents the results of a single test across all nodes of a cluster
+type ClusterTestSummary struct {
+	Passed      int      `json:"passed"`
+	Warned      int      `json:"warned"`
+	Failed      int      `json:"failed"`
+	Skipped     int      `json:"skipped"`
+	FailedNodes []string `json:"failed_nodes,omitempty"`
+}
+
+// ClusterReport represents the aggregated results of several nodes.
+// Hosts are keyed by the name of their result file without extension; files that could not be
+// loaded are listed in Errors instead of Hosts.
+type ClusterReport struct {
+	Hosts    map[string]HostResults         `json:"hosts"`
+	Tests    map[string]*ClusterTestSummary `json:"tests"`
+	Statuses map[string]map[string]string   `json:"statuses"`
+	Errors   map[string]string              `json:"errors,omitempty"`
+}
+
+// AggregateReports loads the result file of each node and summarizes the results per test
+func AggregateReports(files []string) *ClusterReport {
+	cluster := &ClusterReport{
+		Hosts:    make(map[string]HostResults),
+		Tests:    make(map[string]*ClusterTestSummary),
+		Statuses: make(map[string]map[string]string),
+		Errors:   make(map[string]string),
+	}
+
+	for _, file := range files {
+		host := reportHostName(file)
+		if _, exists := cluster.Hosts[host]; exists || cluster.Errors[host] != "" {
+			// Result files of different nodes may share a name in separate directories
+			host = file
+		}
+
+		report, err := LoadReportFile(file)
+		if err != nil {
+			logger.Errorf("Skipping results of %s: %v", host, err)
+			cluster.Errors[host] = err.Error()
+			continue
+		}
+		cluster.Hosts[host] = report.Localhost
+
+		tests, err := flattenResults(report.Localhost)
+		if err != nil {
+			logger.Errorf("Skipping results of %s: %v", host, err)
+			cluster.Errors[host] = err.Error()
+			delete(cluster.Hosts, host)
+			continue
+		}
+
+		cluster.Statuses[host] = make(map[string]string)
+		for testName, results := range tests {
+			if aggregateIgnoredSections[testName] {
+				continue
+			}
+			status := nodeTestStatus(results)
+			cluster.Statuses[host][testName] = status
+
+			summary, exists := cluster.Tests[testName]
+			if !exists {
+				summary = &ClusterTestSummary{}
+				cluster.Tests[testName] = summary
+			}
+			switch status {
+			case "PASS":
+				summary.Passed++
+			case "WARN":
+				summary.Warned++
+			case "SKIP":
+				summary.Skipped++
+			default:
+				summary.Failed++
+				summary.FailedNodes = append(summary.FailedNodes, host)
+			}
+		}
+	}
+
+	for _, summary := range cluster.Tests {
+		sort.Strings(summary.FailedNodes)
+	}
+	return cluster
+}
+
+// reportHostName returns the node name of a result file, e.g. "gpu-node-1" for "/results/gpu-node-1.json"
+func reportHostName(file string) string {
+	return strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+}
+
+// nodeTestStatus returns the overall status of the results of a test on a single node.
+// Any status other than PASS, WARN or SKIP is a failure.
+func nodeTestStatus(results []map[string]interface{}) string {
+	status := "SKIP"
+	for _, result := range results {
+		switch result["status"] {
+		case "PASS":
+			if status == "SKIP" {
+				status = "PASS"
+			}
+		case "WARN":
+			if status != "FAIL" {
+				status = "WARN"
+			}
+		case "SKIP":
+		default:
+			return "FAIL"
+		}
+	}
+	return status
+}
+
+// HostNames returns the names of all nodes in the report, including nodes whose results could not be loaded, in sorted order
+func (c *ClusterReport) HostNames() []string {
+	names := make([]string, 0, len(c.Hosts)+len(c.Errors))
+	for host := range c.Hosts {
+		names = append(names, host)
+	}
+	for host := range c.Errors {
+		names = append(names, host)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestNames returns the names of all tests run on any node, in sorted order
+func (c *ClusterReport) TestNames() []string {
+	names := make([]string, 0, len(c.Tests))
+	for testName := range c.Tests {
+		names = append(names, testName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FailedHosts returns the nodes whose results could not be loaded or have failed tests, in sorted order
+func (c *ClusterReport) FailedHosts() []string {
+	var failed []string
+	for _, host := range c.HostNames() {
+		if _, exists := c.Errors[host]; exists {
+			failed = append(failed, host)
+			continue
+		}
+		for _, status := range c.Statuses[host] {
+			if status == "FAIL" {
+				failed = append(failed, host)
+				break
+			}
+		}
+	}
+	return failed
+}
+
+// FormatClusterReport formats a cluster report as json, table or friendly output
+func FormatClusterReport(report *ClusterReport, format string) (string, error) {
+	switch format {
+	case "json":
+		jsonData, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal cluster report to JSON: %w", err)
+		}
+		return string(jsonData) + "\n", nil
+	case "table":
+		return formatClusterTable(report), nil
+	case "friendly":
+		return formatClusterFriendly(report), nil
+	default:
+		return "", fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// clusterStatusSymbol returns the table symbol of a node test status, a dash when the test did not run on the node.
+// The dash is padded to the width of the emoji symbols so the matrix columns line up.
+func clusterStatusSymbol(status string) string {
+	switch status {
+	case "":
+		return " -"
+	case "PASS":
+		return "✅"
+	case "WARN":
+		return "⚠️"
+	case "SKIP":
+		return "⏭️"
+	default:
+		return "❌"
+	}
+}
+
+// formatClusterTable formats the report as a matrix with a row per node and a column per test.
+// Tests are numbered in the header and listed with their totals below the matrix.
+func formatClusterTable(report *ClusterReport) string {
+	var output strings.Builder
+	testNames := report.TestNames()
+
+	output.WriteString("┌─────────────────────────────────────────────────────────────────┐\n")
+	output.WriteString("│                 CLUSTER DIAGNOSTIC TEST RESULTS                 │\n")
+	output.WriteString("└─────────────────────────────────────────────────────────────────┘\n")
+
+	hostWidth := len("NODE")
+	for _, host := range report.HostNames() {
+		if len(host) > hostWidth {
+			hostWidth = len(host)
+		}
+	}
+
+	output.WriteString(fmt.Sprintf("%-*s", hostWidth, "NODE"))
+	for i := range testNames {
+		output.WriteString(fmt.Sprintf(" %3d", i+1))
+	}
+	output.WriteString("\n")
+	output.WriteString(strings.Repeat("─", hostWidth+4*len(testNames)) + "\n")
+
+	for _, host := range report.HostNames() {
+		output.WriteString(fmt.Sprintf("%-*s", hostWidth, host))
+		if hostErr, exists := report.Errors[host]; exists {
+			output.WriteString(fmt.Sprintf("  ERROR: %s\n", hostErr))
+			continue
+		}
+		for _, testName := range testNames {
+			output.WriteString(fmt.Sprintf("  %s", clusterStatusSymbol(report.Statuses[host][testName])))
+		}
+		output.WriteString("\n")
+	}
+
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("%3s  %-30s %6s %6s %6s\n", "#", "TEST NAME", "PASS", "WARN", "FAIL"))
+	for i, testName := range testNames {
+		summary := report.Tests[testName]
+		output.WriteString(fmt.Sprintf("%3d  %-30s %6d %6d %6d\n", i+1, testName, summary.Passed, summary.Warned, summary.Failed))
+	}
+	output.WriteString("\n✅ PASS  ⚠️  WARN  ❌ FAIL  ⏭️  SKIP  - not run\n")
+
+	return output.String()
+}
+
+// formatClusterFriendly formats the report in a user-friendly format, listing the failed nodes of each test
+func formatClusterFriendly(report *ClusterReport) string {
+	var output strings.Builder
+
+	output.WriteString("🔍 HPC Cluster Diagnostic Results\n")
+	output.WriteString("===================================================\n")
+
+	for _, testName := range report.TestNames() {
+		summary := report.Tests[testName]
+		if summary.Failed == 0 {
+			output.WriteString(fmt.Sprintf("   ✅ %s: passed on %d node(s)\n", testName, summary.Passed+summary.Warned))
+			continue
+		}
+		output.WriteString(fmt.Sprintf("   ❌ %s: failed on %d node(s): %s\n", testName, summary.Failed, strings.Join(summary.FailedNodes, ", ")))
+	}
+
+	if len(report.Errors) > 0 {
+		output.WriteString("\n⚠️  Unreadable Results\n")
+		output.WriteString("   ------------------------------\n")
+		for _, host := range report.HostNames() {
+			if hostErr, exists := report.Errors[host]; exists {
+				output.WriteString(fmt.Sprintf("   ❌ %s: %s\n", host, hostErr))
+			}
+		}
+	}
+
+	failedHosts := report.FailedHosts()
+	output.WriteString("\n📊 Summary\n")
+	output.WriteString("   ------------------------------\n")
+	output.WriteString(fmt.Sprintf("   Total Nodes: %d\n", len(report.HostNames())))
+	output.WriteString(fmt.Sprintf("   Healthy: %d\n", len(report.HostNames())-len(failedHosts)))
+	output.WriteString(fmt.Sprintf("   Unreadable: %d\n", len(report.Errors)))
+	output.WriteString(fmt.Sprintf("   With Failures: %d\n", len(failedHosts)-len(report.Errors)))
+
+	return output.String()
+}
@@ -0,0 +1,152 @@
+package reporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeClusterResults writes a result file per node and returns the file paths
+func writeClusterResults(t *testing.T, dir string, nodes map[string]HostResults) []string {
+	t.Helper()
+	var files []string
+	for node, results := range nodes {
+		data, err := json.Marshal(ReportOutput{Localhost: results})
+		if err != nil {
+			t.Fatalf("Failed to marshal results: %v", err)
+		}
+		file := filepath.Join(dir, node+".json")
+		if err := os.WriteFile(file, data, 0644); err != nil {
+			t.Fatalf("Failed to write results: %v", err)
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+func createClusterReport(t *testing.T) *ClusterReport {
+	dir := t.TempDir()
+	files := writeClusterResults(t, dir, map[string]HostResults{
+		"node1": {
+			GPUCountCheck:   []GPUTestResult{{Status: "PASS", GPUCount: 8}},
+			PCIeReplayCheck: []PCIeReplayTestResult{{Status: "WARN"}},
+		},
+		"node2": {
+			GPUCountCheck:   []GPUTestResult{{Status: "FAIL", GPUCount: 7}},
+			PCIeReplayCheck: []PCIeReplayTestResult{{Status: "PASS"}},
+			TestTimeouts:    []TestTimeoutResult{{TestName: "pcie_replay_check", Status: "TIMEOUT"}},
+		},
+		"node3": {
+			GPUCountCheck: []GPUTestResult{{Status: "FAIL", GPUCount: 6}},
+		},
+	})
+
+	broken := filepath.Join(dir, "node4.json")
+	if err := os.WriteFile(broken, []byte("not json"), 0644); err != nil {
+		t.Fatalf("Failed to write results: %v", err)
+	}
+	return AggregateReports(append(files, broken))
+}
+
+func TestAggregateReports(t *testing.T) {
+	report := createClusterReport(t)
+
+	if hosts := strings.Join(report.HostNames(), ","); hosts != "node1,node2,node3,node4" {
+		t.Errorf("HostNames() = %s, want node1,node2,node3,node4", hosts)
+	}
+	if report.Errors["node4"] == "" {
+		t.Errorf("Expected load error for node4, got %v", report.Errors)
+	}
+	if tests := strings.Join(report.TestNames(), ","); tests != "gpu_count_check,pcie_replay_check" {
+		t.Errorf("TestNames() = %s, want gpu_count_check,pcie_replay_check", tests)
+	}
+
+	gpuCount := report.Tests["gpu_count_check"]
+	if gpuCount.Passed != 1 || gpuCount.Failed != 2 || !reflect.DeepEqual(gpuCount.FailedNodes, []string{"node2", "node3"}) {
+		t.Errorf("Unexpected gpu_count_check summary: %+v", gpuCount)
+	}
+	pcieReplay := report.Tests["pcie_replay_check"]
+	if pcieReplay.Passed != 1 || pcieReplay.Warned != 1 || pcieReplay.Failed != 0 {
+		t.Errorf("Unexpected pcie_replay_check summary: %+v", pcieReplay)
+	}
+
+	if failed := strings.Join(report.FailedHosts(), ","); failed != "node2,node3,node4" {
+		t.Errorf("FailedHosts() = %s, want node2,node3,node4", failed)
+	}
+}
+
+func TestNodeTestStatus(t *testing.T) {
+	tests := []struct {
+		statuses []string
+		expected string
+	}{
+		{[]string{"PASS"}, "PASS"},
+		{[]string{"PASS", "WARN"}, "WARN"},
+		{[]string{"WARN", "FAIL", "PASS"}, "FAIL"},
+		{[]string{"SKIP"}, "SKIP"},
+		{[]string{"SKIP", "PASS"}, "PASS"},
+		{[]string{"TIMEOUT"}, "FAIL"},
+	}
+
+	for _, tt := range tests {
+		var results []map[string]interface{}
+		for _, status := range tt.statuses {
+			results = append(results, map[string]interface{}{"status": status})
+		}
+		if got := nodeTestStatus(results); got != tt.expected {
+			t.Errorf("nodeTestStatus(%v) = %s, want %s", tt.statuses, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatClusterReport(t *testing.T) {
+	report := createClusterReport(t)
+
+	t.Run("JSON", func(t *testing.T) {
+		output, err := FormatClusterReport(report, "json")
+		if err != nil {
+			t.Fatalf("FormatClusterReport() error = %v", err)
+		}
+		var decoded ClusterReport
+		if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+		if decoded.Hosts["node2"].GPUCountCheck[0].GPUCount != 7 || decoded.Tests["gpu_count_check"].Failed != 2 {
+			t.Errorf("Unexpected decoded report: %+v", decoded)
+		}
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		output, err := FormatClusterReport(report, "table")
+		if err != nil {
+			t.Fatalf("FormatClusterReport() error = %v", err)
+		}
+		// node3 did not run pcie_replay_check
+		for _, expected := range []string{"node1  ✅  ⚠️", "node2  ❌  ✅", "node3  ❌   -", "node4  ERROR:", "gpu_count_check"} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected table output to contain %q, got:\n%s", expected, output)
+			}
+		}
+	})
+
+	t.Run("Friendly", func(t *testing.T) {
+		output, err := FormatClusterReport(report, "friendly")
+		if err != nil {
+			t.Fatalf("FormatClusterReport() error = %v", err)
+		}
+		for _, expected := range []string{"gpu_count_check: failed on 2 node(s): node2, node3", "pcie_replay_check: passed on 2 node(s)", "Total Nodes: 4", "Healthy: 1", "Unreadable: 1"} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected friendly output to contain %q", expected)
+			}
+		}
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		if _, err := FormatClusterReport(report, "xml"); err == nil {
+			t.Error("Expected error for unsupported format")
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package reporter
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+const (
+	// archivePrefix is the file name prefix of archived reports
+	archivePrefix = "oci-dr-hpc-"
+	// archiveTimestampFormat sorts archived reports chronologically by name
+	archiveTimestampFormat = "20060102T150405.000Z"
+)
+
+// archiveExtension is the extension of new archived reports, ".json.gz" when compression is enabled
+var archiveExtension = ".json"
+
+// SetArchiveCompression enables or disables gzip compression of archived reports
+func SetArchiveCompression(enabled bool) {
+	if enabled {
+		archiveExtension = ".json.gz"
+	} else {
+		archiveExtension = ".json"
+	}
+}
+
+// ArchiveReport copies sourceFile to <archiveDir>/oci-dr-hpc-<timestamp>.json, or .json.gz with
+// compression enabled, and then removes archived reports older than maxAgeDays and the oldest
+// archived reports beyond maxFiles. A maxFiles or maxAgeDays of 0 disables that limit.
+func ArchiveReport(sourceFile, archiveDir string, maxFiles int, maxAgeDays int) error {
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	archiveFile := filepath.Join(archiveDir, archivePrefix+time.Now().UTC().Format(archiveTimestampFormat)+archiveExtension)
+	if err := copyReport(sourceFile, archiveFile); err != nil {
+		return err
+	}
+	logger.Infof("Test results archived to file: %s", archiveFile)
+
+	return rotateArchive(archiveDir, maxFiles, maxAgeDays)
+}
+
+// copyReport copies sourceFile to archiveFile, compressing it when archiveFile ends in .json.gz.
+// A partially written archiveFile is removed when the copy fails.
+func copyReport(sourceFile, archiveFile string) error {
+	source, err := os.Open(sourceFile)
+	if err != nil {
+		return fmt.Errorf("failed to open report %s: %w", sourceFile, err)
+	}
+	defer source.Close()
+
+	archive, err := os.Create(archiveFile)
+	if err != nil {
+		return fmt.Errorf("failed to create archived report %s: %w", archiveFile, err)
+	}
+
+	err = writeReport(archive, source, strings.HasSuffix(archiveFile, ".json.gz"))
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(archiveFile)
+		return fmt.Errorf("failed to write archived report %s: %w", archiveFile, err)
+	}
+	return nil
+}
+
+// writeReport copies source to archive, gzip compressed when compress is set. The gzip
+// stream is closed before returning so its trailer is written.
+func writeReport(archive io.Writer, source io.Reader, compress bool) error {
+	if !compress {
+		_, err := io.Copy(archive, source)
+		return err
+	}
+
+	gzipWriter := gzip.NewWriter(archive)
+	if _, err := io.Copy(gzipWriter, source); err != nil {
+		gzipWriter.Close()
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// rotateArchive removes archived reports in archiveDir older than maxAgeDays, then the
+// oldest archived reports until at most maxFiles remain
+func rotateArchive(archiveDir string, maxFiles int, maxAgeDays int) error {
+	entries, err := os.ReadDir(archiveDir)
+	if err != nil {
+		return fmt.Errorf("failed to read archive directory: %w", err)
+	}
+
+	// Archived reports sort oldest first by name
+	var archived []string
+	cutoff := time.Now().AddDate(0, 0, -maxAgeDays)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !isArchivedReport(name) {
+			continue
+		}
+
+		path := filepath.Join(archiveDir, name)
+		if maxAgeDays > 0 {
+			info, err := entry.Info()
+			if err == nil && info.ModTime().Before(cutoff) {
+				if err := os.Remove(path); err != nil {
+					return fmt.Errorf("failed to remove expired archived report %s: %w", path, err)
+				}
+				logger.Debugf("Removed expired archived report: %s", path)
+				continue
+			}
+		}
+		archived = append(archived, path)
+	}
+	sort.Strings(archived)
+
+	if maxFiles > 0 && len(archived) > maxFiles {
+		for _, path := range archived[:len(archived)-maxFiles] {
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("failed to remove archived report %s: %w", path, err)
+			}
+			logger.Debugf("Removed archived report beyond limit of %d files: %s", maxFiles, path)
+		}
+	}
+	return nil
+}
+
+// isArchivedReport returns whether name is the name of an archived report
+func isArchivedReport(name string) bool {
+	return strings.HasPrefix(name, archivePrefix) &&
+		(strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".json.gz"))
+}
@@ -0,0 +1,180 @@
+package reporter
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeArchivedReport(t *testing.T, dir, name string, modTime time.Time) string {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to write archived report: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set modification time: %v", err)
+	}
+	return path
+}
+
+func archivedReports(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read archive directory: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestArchiveReport(t *testing.T) {
+	sourceFile := createTempFile(t, "results.json")
+	if err := os.WriteFile(sourceFile, []byte(`{"localhost":{}}`), 0644); err != nil {
+		t.Fatalf("Failed to write source file: %v", err)
+	}
+	archiveDir := filepath.Join(t.TempDir(), "archive")
+
+	if err := ArchiveReport(sourceFile, archiveDir, 10, 0); err != nil {
+		t.Fatalf("ArchiveReport failed: %v", err)
+	}
+
+	names := archivedReports(t, archiveDir)
+	if len(names) != 1 || !strings.HasPrefix(names[0], "oci-dr-hpc-") || !strings.HasSuffix(names[0], ".json") {
+		t.Fatalf("Expected one archived report, got %v", names)
+	}
+	data, err := os.ReadFile(filepath.Join(archiveDir, names[0]))
+	if err != nil || string(data) != `{"localhost":{}}` {
+		t.Errorf("Archived report does not match source: %q, %v", data, err)
+	}
+}
+
+func TestArchiveReport_Compressed(t *testing.T) {
+	SetArchiveCompression(true)
+	defer SetArchiveCompression(false)
+
+	sourceFile := createTempFile(t, "results.json")
+	if err := os.WriteFile(sourceFile, []byte(`{"localhost":{}}`), 0644); err != nil {
+		t.Fatalf("Failed to write source file: %v", err)
+	}
+	archiveDir := t.TempDir()
+
+	if err := ArchiveReport(sourceFile, archiveDir, 0, 0); err != nil {
+		t.Fatalf("ArchiveReport failed: %v", err)
+	}
+
+	names := archivedReports(t, archiveDir)
+	if len(names) != 1 || !strings.HasSuffix(names[0], ".json.gz") {
+		t.Fatalf("Expected one compressed archived report, got %v", names)
+	}
+
+	file, err := os.Open(filepath.Join(archiveDir, names[0]))
+	if err != nil {
+		t.Fatalf("Failed to open archived report: %v", err)
+	}
+	defer file.Close()
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("Archived report is not gzip compressed: %v", err)
+	}
+	data, err := io.ReadAll(gzipReader)
+	if err != nil || string(data) != `{"localhost":{}}` {
+		t.Errorf("Decompressed report does not match source: %q, %v", data, err)
+	}
+}
+
+func TestArchiveReport_MissingSource(t *testing.T) {
+	archiveDir := t.TempDir()
+	if err := ArchiveReport(filepath.Join(archiveDir, "missing.json"), archiveDir, 0, 0); err == nil {
+		t.Error("Expected error for missing source file")
+	}
+}
+
+func TestCopyReport_RemovesPartialFile(t *testing.T) {
+	archiveDir := t.TempDir()
+	for _, name := range []string{"report.json", "report.json.gz"} {
+		// Reading a directory fails after the archived report was created
+		archiveFile := filepath.Join(archiveDir, name)
+		if err := copyReport(archiveDir, archiveFile); err == nil {
+			t.Errorf("Expected error copying unreadable report to %s", name)
+		}
+		if _, err := os.Stat(archiveFile); !os.IsNotExist(err) {
+			t.Errorf("Expected partial archived report %s to be removed, got %v", name, err)
+		}
+	}
+}
+
+func TestRotateArchive(t *testing.T) {
+	archiveDir := t.TempDir()
+	now := time.Now()
+
+	writeArchivedReport(t, archiveDir, "oci-dr-hpc-20260101T000000.000Z.json", now.AddDate(0, 0, -40))
+	writeArchivedReport(t, archiveDir, "oci-dr-hpc-20260901T000000.000Z.json", now.AddDate(0, 0, -3))
+	writeArchivedReport(t, archiveDir, "oci-dr-hpc-20260902T000000.000Z.json.gz", now.AddDate(0, 0, -2))
+	writeArchivedReport(t, archiveDir, "oci-dr-hpc-20260903T000000.000Z.json", now.AddDate(0, 0, -1))
+	// Other files in the archive directory are left alone
+	writeArchivedReport(t, archiveDir, "notes.json", now.AddDate(0, 0, -100))
+
+	if err := rotateArchive(archiveDir, 2, 30); err != nil {
+		t.Fatalf("rotateArchive failed: %v", err)
+	}
+
+	expected := []string{"notes.json", "oci-dr-hpc-20260902T000000.000Z.json.gz", "oci-dr-hpc-20260903T000000.000Z.json"}
+	names := archivedReports(t, archiveDir)
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %v after rotation, got %v", expected, names)
+	}
+}
+
+func TestReporter_MaxRuns(t *testing.T) {
+	outputFile := createTempFile(t, "results.json")
+	reporter := createTestReporter()
+	reporter.SetAppendMode(true)
+	reporter.SetMaxRuns(2)
+	if err := reporter.Initialize(outputFile); err != nil {
+		t.Fatalf("Failed to initialize reporter: %v", err)
+	}
+
+	for _, gpuCount := range []int{6, 7, 8} {
+		reporter.Clear()
+		reporter.AddGPUResult("PASS", gpuCount, nil)
+		if err := reporter.WriteReportWithFormat("json"); err != nil {
+			t.Fatalf("Failed to write report: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	var appended AppendedReport
+	if err := json.Unmarshal(data, &appended); err != nil {
+		t.Fatalf("Failed to parse output file: %v", err)
+	}
+	if appended.MaxRuns != 2 || len(appended.TestRuns) != 2 {
+		t.Fatalf("Expected 2 runs limited by max_runs 2, got %d runs and max_runs %d", len(appended.TestRuns), appended.MaxRuns)
+	}
+	if gpuCount := appended.TestRuns[0].TestResults.GPUCountCheck[0].GPUCount; gpuCount != 7 {
+		t.Errorf("Expected the oldest run to be dropped, first run has GPU count %d", gpuCount)
+	}
+
+	// The limit recorded in the file applies when no limit is set
+	reporter.SetMaxRuns(0)
+	reporter.Clear()
+	reporter.AddGPUResult("PASS", 9, nil)
+	if err := reporter.WriteReportWithFormat("json"); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	data, _ = os.ReadFile(outputFile)
+	appended = AppendedReport{}
+	json.Unmarshal(data, &appended)
+	if len(appended.TestRuns) != 2 {
+		t.Errorf("Expected the recorded max_runs to keep 2 runs, got %d", len(appended.TestRuns))
+	}
+}
@@ -0,0 +1,279 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// StatusChange represents the status of a test in the baseline and current reports.
+// OldStatus is empty for added tests and NewStatus is empty for removed tests.
+type StatusChange struct {
+	OldStatus string `json:"old_status,omitempty"`
+	NewStatus string `json:"new_status,omitempty"`
+}
+
+// DiffReport represents the differences between a baseline report and a current report
+type DiffReport struct {
+	Added         []string                `json:"added"`
+	Removed       []string                `json:"removed"`
+	StatusChanged []string                `json:"status_changed"`
+	DetailChanged []string                `json:"detail_changed"`
+	Statuses      map[string]StatusChange `json:"statuses,omitempty"`
+}
+
+// HasChanges returns whether the reports differ
+func (d *DiffReport) HasChanges() bool {
+	return len(d.Added)+len(d.Removed)+len(d.StatusChanged)+len(d.DetailChanged) > 0
+}
+
+// HasStatusChanges returns whether a test was added, removed or changed status
+func (d *DiffReport) HasStatusChanges() bool {
+	return len(d.Added)+len(d.Removed)+len(d.StatusChanged) > 0
+}
+
+// DiffWebhookPayload represents the JSON body posted to a webhook when results change
+type DiffWebhookPayload struct {
+	Hostname     string      `json:"hostname"`
+	TimestampUTC string      `json:"timestamp_utc"`
+	Diff         *DiffReport `json:"diff"`
+}
+
+// webhookTimeout bounds how long a webhook may take so a slow receiver cannot stall monitoring
+const webhookTimeout = 10 * time.Second
+
+// PostDiff posts the diff as a DiffWebhookPayload to the given webhook URL
+func PostDiff(url string, diff *DiffReport) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	body, err := json.Marshal(DiffWebhookPayload{
+		Hostname:     hostname,
+		TimestampUTC: time.Now().UTC().Format(time.RFC3339),
+		Diff:         diff,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal diff payload: %w", err)
+	}
+
+	client := &http.Client{Timeout: webhookTimeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to post diff to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// LoadReportFile reads a JSON results file written by the reporter. For files in the
+// appended format the latest test run is returned.
+func LoadReportFile(filePath string) (*ReportOutput, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results file %s: %w", filePath, err)
+	}
+
+	var appendedReport AppendedReport
+	if err := json.Unmarshal(data, &appendedReport); err == nil && len(appendedReport.TestRuns) > 0 {
+		latestRun := appendedReport.TestRuns[len(appendedReport.TestRuns)-1]
+		logger.Infof("Found %d test runs in %s, using latest run: %s", len(appendedReport.TestRuns), filePath, latestRun.RunID)
+		return &ReportOutput{Localhost: latestRun.TestResults}, nil
+	}
+
+	var report ReportOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse %s as either appended or single report format: %w", filePath, err)
+	}
+	return &report, nil
+}
+
+// DiffReports compares a baseline report a against a current report b
+func DiffReports(a, b *ReportOutput) *DiffReport {
+	diff := &DiffReport{
+		Added:         []string{},
+		Removed:       []string{},
+		StatusChanged: []string{},
+		DetailChanged: []string{},
+		Statuses:      make(map[string]StatusChange),
+	}
+
+	oldTests, err := flattenResults(a.Localhost)
+	if err != nil {
+		logger.Errorf("Failed to read baseline report for diff: %v", err)
+	}
+	newTests, err := flattenResults(b.Localhost)
+	if err != nil {
+		logger.Errorf("Failed to read current report for diff: %v", err)
+	}
+
+	for _, testName := range sortedTestNames(oldTests, newTests) {
+		oldResults, inOld := oldTests[testName]
+		newResults, inNew := newTests[testName]
+		change := StatusChange{OldStatus: resultStatus(oldResults), NewStatus: resultStatus(newResults)}
+
+		switch {
+		case !inOld:
+			diff.Added = append(diff.Added, testName)
+		case !inNew:
+			diff.Removed = append(diff.Removed, testName)
+		case change.OldStatus != change.NewStatus:
+			diff.StatusChanged = append(diff.StatusChanged, testName)
+		case !reflect.DeepEqual(resultDetails(oldResults), resultDetails(newResults)):
+			diff.DetailChanged = append(diff.DetailChanged, testName)
+		default:
+			continue
+		}
+		diff.Statuses[testName] = change
+	}
+
+	return diff
+}
+
+// sortedTestNames returns the test names present in either set of results
+func sortedTestNames(a, b map[string][]map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, tests := range []map[string][]map[string]interface{}{a, b} {
+		for name := range tests {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resultStatus returns the status of a test, joining the statuses of tests with several results
+func resultStatus(results []map[string]interface{}) string {
+	var statuses []string
+	for _, result := range results {
+		if status, ok := result["status"].(string); ok {
+			statuses = append(statuses, status)
+		}
+	}
+	return strings.Join(statuses, ",")
+}
+
+// resultDetails returns the results without the fields that change on every run
+func resultDetails(results []map[string]interface{}) []map[string]interface{} {
+	details := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		detail := make(map[string]interface{}, len(result))
+		for key, value := range result {
+			if key != "status" && key != "timestamp_utc" {
+				detail[key] = value
+			}
+		}
+		details = append(details, detail)
+	}
+	return details
+}
+
+// FormatDiff formats a diff report as json, table or friendly output
+func FormatDiff(diff *DiffReport, format string) (string, error) {
+	switch format {
+	case "json":
+		jsonData, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal diff to JSON: %w", err)
+		}
+		return string(jsonData) + "\n", nil
+	case "table":
+		return formatDiffTable(diff), nil
+	case "friendly":
+		return formatDiffFriendly(diff), nil
+	default:
+		return "", fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// formatDiffTable formats the diff as a table showing old status → new status for each changed test
+func formatDiffTable(diff *DiffReport) string {
+	var output strings.Builder
+
+	output.WriteString("┌─────────────────────────────────────────────────────────────────┐\n")
+	output.WriteString("│                    DIAGNOSTIC REPORT DIFF                       │\n")
+	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+	output.WriteString(fmt.Sprintf("│ %-28s │ %-8s │ %-21s │\n", "TEST NAME", "CHANGE", "STATUS"))
+	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+
+	if !diff.HasChanges() {
+		output.WriteString(fmt.Sprintf("│ %-63s │\n", "No differences between reports"))
+	}
+
+	sections := []struct {
+		change string
+		tests  []string
+	}{
+		{"status", diff.StatusChanged},
+		{"details", diff.DetailChanged},
+		{"added", diff.Added},
+		{"removed", diff.Removed},
+	}
+	for _, section := range sections {
+		for _, testName := range section.tests {
+			status := diff.Statuses[testName]
+			transition := fmt.Sprintf("%s → %s", status.OldStatus, status.NewStatus)
+			output.WriteString(fmt.Sprintf("│ %-28s │ %-8s │ %-21s │\n", testName, section.change, strings.TrimSpace(transition)))
+		}
+	}
+
+	output.WriteString("└─────────────────────────────────────────────────────────────────┘\n")
+	return output.String()
+}
+
+// formatDiffFriendly formats the diff in a user-friendly format
+func formatDiffFriendly(diff *DiffReport) string {
+	var output strings.Builder
+
+	output.WriteString("🔍 Diagnostic Report Diff\n")
+	output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+
+	if !diff.HasChanges() {
+		output.WriteString("   ✅ No differences between reports\n")
+		return output.String()
+	}
+
+	for _, testName := range diff.StatusChanged {
+		status := diff.Statuses[testName]
+		symbol := "❌"
+		if status.NewStatus == "PASS" {
+			symbol = "✅"
+		}
+		output.WriteString(fmt.Sprintf("   %s %s: %s → %s\n", symbol, testName, status.OldStatus, status.NewStatus))
+	}
+	for _, testName := range diff.DetailChanged {
+		output.WriteString(fmt.Sprintf("   📝 %s: details changed (%s)\n", testName, diff.Statuses[testName].NewStatus))
+	}
+	for _, testName := range diff.Added {
+		output.WriteString(fmt.Sprintf("   ➕ %s: added (%s)\n", testName, diff.Statuses[testName].NewStatus))
+	}
+	for _, testName := range diff.Removed {
+		output.WriteString(fmt.Sprintf("   ➖ %s: removed (was %s)\n", testName, diff.Statuses[testName].OldStatus))
+	}
+
+	output.WriteString("\n📊 Summary\n")
+	output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+	output.WriteString(fmt.Sprintf("   Status Changed: %d\n", len(diff.StatusChanged)))
+	output.WriteString(fmt.Sprintf("   Details Changed: %d\n", len(diff.DetailChanged)))
+	output.WriteString(fmt.Sprintf("   Added: %d\n", len(diff.Added)))
+	output.WriteString(fmt.Sprintf("   Removed: %d\n", len(diff.Removed)))
+
+	return output.String()
+}
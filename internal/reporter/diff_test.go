@@ -0,0 +1,208 @@
+package reporter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func createDiffReports() (*ReportOutput, *ReportOutput) {
+	baseline := &ReportOutput{Localhost: HostResults{
+		GPUCountCheck:  []GPUTestResult{{Status: "PASS", GPUCount: 8, TimestampUTC: "2024-01-01T00:00:00Z"}},
+		PCIeErrorCheck: []PCIeTestResult{{Status: "PASS", TimestampUTC: "2024-01-01T00:00:00Z"}},
+		RDMANicsCount:  []RDMATestResult{{Status: "PASS", NumRDMANics: 16, TimestampUTC: "2024-01-01T00:00:00Z"}},
+		LinkCheck:      []LinkTestResult{{Status: "PASS", TimestampUTC: "2024-01-01T00:00:00Z"}},
+	}}
+	current := &ReportOutput{Localhost: HostResults{
+		GPUCountCheck:    []GPUTestResult{{Status: "FAIL", GPUCount: 7, TimestampUTC: "2024-01-02T00:00:00Z"}},
+		PCIeErrorCheck:   []PCIeTestResult{{Status: "PASS", TimestampUTC: "2024-01-02T00:00:00Z"}},
+		RDMANicsCount:    []RDMATestResult{{Status: "PASS", NumRDMANics: 15, TimestampUTC: "2024-01-02T00:00:00Z"}},
+		NetworkAuthCheck: []NetworkAuthCheckTestResult{{Status: "PASS", TimestampUTC: "2024-01-02T00:00:00Z"}},
+	}}
+	return baseline, current
+}
+
+func assertNames(t *testing.T, field string, got, expected []string) {
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %s %v, got %v", field, expected, got)
+	}
+}
+
+func TestDiffReports(t *testing.T) {
+	baseline, current := createDiffReports()
+	diff := DiffReports(baseline, current)
+
+	assertNames(t, "Added", diff.Added, []string{"network_auth_check"})
+	assertNames(t, "Removed", diff.Removed, []string{"link_check"})
+	assertNames(t, "StatusChanged", diff.StatusChanged, []string{"gpu_count_check"})
+	assertNames(t, "DetailChanged", diff.DetailChanged, []string{"rdma_nics_count"})
+
+	if change := diff.Statuses["gpu_count_check"]; change.OldStatus != "PASS" || change.NewStatus != "FAIL" {
+		t.Errorf("Expected gpu_count_check PASS → FAIL, got %s → %s", change.OldStatus, change.NewStatus)
+	}
+	if _, exists := diff.Statuses["pcie_error_check"]; exists {
+		t.Error("Expected unchanged pcie_error_check to be left out of the diff")
+	}
+	if !diff.HasChanges() {
+		t.Error("Expected diff to have changes")
+	}
+}
+
+func TestDiffReports_Identical(t *testing.T) {
+	baseline, _ := createDiffReports()
+	diff := DiffReports(baseline, baseline)
+
+	if diff.HasChanges() {
+		t.Errorf("Expected no changes for identical reports, got %+v", diff)
+	}
+}
+
+func TestFormatDiff(t *testing.T) {
+	baseline, current := createDiffReports()
+	diff := DiffReports(baseline, current)
+
+	t.Run("JSON", func(t *testing.T) {
+		output, err := FormatDiff(diff, "json")
+		if err != nil {
+			t.Fatalf("FormatDiff failed: %v", err)
+		}
+		var decoded DiffReport
+		if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+			t.Fatalf("Failed to parse JSON diff: %v", err)
+		}
+		assertNames(t, "StatusChanged", decoded.StatusChanged, diff.StatusChanged)
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		output, err := FormatDiff(diff, "table")
+		if err != nil {
+			t.Fatalf("FormatDiff failed: %v", err)
+		}
+		for _, expected := range []string{"DIAGNOSTIC REPORT DIFF", "gpu_count_check", "PASS → FAIL", "network_auth_check", "link_check"} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected table output to contain %q", expected)
+			}
+		}
+	})
+
+	t.Run("Friendly", func(t *testing.T) {
+		output, err := FormatDiff(diff, "friendly")
+		if err != nil {
+			t.Fatalf("FormatDiff failed: %v", err)
+		}
+		for _, expected := range []string{"gpu_count_check: PASS → FAIL", "rdma_nics_count: details changed", "Status Changed: 1"} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected friendly output to contain %q", expected)
+			}
+		}
+	})
+
+	t.Run("No Changes", func(t *testing.T) {
+		output, err := FormatDiff(DiffReports(baseline, baseline), "table")
+		if err != nil {
+			t.Fatalf("FormatDiff failed: %v", err)
+		}
+		if !strings.Contains(output, "No differences between reports") {
+			t.Error("Expected table output to report no differences")
+		}
+	})
+
+	t.Run("Unsupported Format", func(t *testing.T) {
+		if _, err := FormatDiff(diff, "xml"); err == nil {
+			t.Error("Expected error for unsupported format")
+		}
+	})
+}
+
+func TestLoadReportFile(t *testing.T) {
+	baseline, current := createDiffReports()
+
+	t.Run("Single Report", func(t *testing.T) {
+		filePath := createTempFile(t, "single.json")
+		data, _ := json.Marshal(baseline)
+		if err := os.WriteFile(filePath, data, 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+		report, err := LoadReportFile(filePath)
+		if err != nil {
+			t.Fatalf("LoadReportFile failed: %v", err)
+		}
+		if len(report.Localhost.LinkCheck) != 1 {
+			t.Error("Expected link_check result in loaded report")
+		}
+	})
+
+	t.Run("Appended Report Uses Latest Run", func(t *testing.T) {
+		filePath := createTempFile(t, "appended.json")
+		appended := AppendedReport{TestRuns: []TestRun{
+			{RunID: "run_1", TestResults: baseline.Localhost},
+			{RunID: "run_2", TestResults: current.Localhost},
+		}}
+		data, _ := json.Marshal(appended)
+		if err := os.WriteFile(filePath, data, 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+		report, err := LoadReportFile(filePath)
+		if err != nil {
+			t.Fatalf("LoadReportFile failed: %v", err)
+		}
+		if len(report.Localhost.NetworkAuthCheck) != 1 || len(report.Localhost.LinkCheck) != 0 {
+			t.Error("Expected latest run to be loaded")
+		}
+	})
+
+	t.Run("Missing File", func(t *testing.T) {
+		if _, err := LoadReportFile(createTempFile(t, "missing.json")); err == nil {
+			t.Error("Expected error for missing file")
+		}
+	})
+}
+
+func TestPostDiff(t *testing.T) {
+	baseline, current := createDiffReports()
+	diff := DiffReports(baseline, current)
+
+	t.Run("Posts Payload", func(t *testing.T) {
+		var payload DiffWebhookPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("Unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("Failed to decode payload: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		if err := PostDiff(server.URL, diff); err != nil {
+			t.Fatalf("PostDiff failed: %v", err)
+		}
+		if payload.Hostname == "" || payload.TimestampUTC == "" || payload.Diff == nil {
+			t.Fatalf("Expected complete payload, got %+v", payload)
+		}
+		assertNames(t, "StatusChanged", payload.Diff.StatusChanged, diff.StatusChanged)
+	})
+
+	t.Run("Error Status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		if err := PostDiff(server.URL, diff); err == nil {
+			t.Error("Expected error for failed webhook response")
+		}
+	})
+}
+
+func TestDiffReport_HasStatusChanges(t *testing.T) {
+	if (&DiffReport{DetailChanged: []string{"rdma_nics_count"}}).HasStatusChanges() {
+		t.Error("Expected detail-only changes not to count as status changes")
+	}
+	if !(&DiffReport{Removed: []string{"link_check"}}).HasStatusChanges() {
+		t.Error("Expected removed test to count as a status change")
+	}
+}
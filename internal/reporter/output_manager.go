@@ -0,0 +1,105 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+const (
+	// runDirPrefix is the name prefix of per-run output directories
+	runDirPrefix = "run-"
+	// RunResultsFile is the name of the results file in a run directory
+	RunResultsFile = "results.json"
+	// RunMetadataFile is the name of the metadata file in a run directory
+	RunMetadataFile = "metadata.json"
+	// DefaultKeepRuns is the default number of run directories kept in an output directory
+	DefaultKeepRuns = 100
+)
+
+// keepRuns is the number of run directories kept by PrepareOutputDirectory, 0 keeps all runs
+var keepRuns = DefaultKeepRuns
+
+// RunMetadata describes a single diagnostic run, written to metadata.json in its run directory
+type RunMetadata struct {
+	RunID     string `json:"run_id"`
+	StartTime string `json:"start_time"`
+	Shape     string `json:"shape"`
+	Hostname  string `json:"hostname"`
+}
+
+// SetKeepRuns sets the number of run directories kept by PrepareOutputDirectory, 0 keeps all runs
+func SetKeepRuns(n int) {
+	keepRuns = n
+}
+
+// PrepareOutputDirectory creates baseDir if needed and a new run directory
+// <baseDir>/run-<timestamp> in it, then removes the oldest run directories beyond the
+// SetKeepRuns limit. It returns the path of the new run directory.
+func PrepareOutputDirectory(baseDir string) (string, error) {
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	runDir := filepath.Join(baseDir, runDirPrefix+time.Now().UTC().Format(archiveTimestampFormat))
+	if err := os.Mkdir(runDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create run directory: %w", err)
+	}
+	logger.Debugf("Created run directory: %s", runDir)
+
+	if err := rotateRunDirectories(baseDir, keepRuns); err != nil {
+		return "", err
+	}
+	return runDir, nil
+}
+
+// WriteRunMetadata writes metadata to metadata.json in runDir
+func WriteRunMetadata(runDir string, metadata RunMetadata) error {
+	data, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal run metadata: %w", err)
+	}
+
+	metadataFile := filepath.Join(runDir, RunMetadataFile)
+	if err := os.WriteFile(metadataFile, data, 0644); err != nil {
+		return fmt.Errorf("failed to write run metadata %s: %w", metadataFile, err)
+	}
+	return nil
+}
+
+// rotateRunDirectories removes the oldest run directories in baseDir until at most keep remain
+func rotateRunDirectories(baseDir string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(baseDir)
+	if err != nil {
+		return fmt.Errorf("failed to read output directory: %w", err)
+	}
+
+	// Run directories sort oldest first by name
+	var runDirs []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), runDirPrefix) {
+			runDirs = append(runDirs, filepath.Join(baseDir, entry.Name()))
+		}
+	}
+	sort.Strings(runDirs)
+
+	if len(runDirs) > keep {
+		for _, runDir := range runDirs[:len(runDirs)-keep] {
+			if err := os.RemoveAll(runDir); err != nil {
+				return fmt.Errorf("failed to remove run directory %s: %w", runDir, err)
+			}
+			logger.Debugf("Removed run directory beyond limit of %d runs: %s", keep, runDir)
+		}
+	}
+	return nil
+}
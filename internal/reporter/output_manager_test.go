@@ -0,0 +1,65 @@
+package reporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrepareOutputDirectory(t *testing.T) {
+	baseDir := filepath.Join(t.TempDir(), "runs")
+
+	runDir, err := PrepareOutputDirectory(baseDir)
+	if err != nil {
+		t.Fatalf("PrepareOutputDirectory failed: %v", err)
+	}
+	if filepath.Dir(runDir) != baseDir || !strings.HasPrefix(filepath.Base(runDir), "run-") {
+		t.Errorf("Expected a run-<timestamp> directory in %s, got %s", baseDir, runDir)
+	}
+	if info, err := os.Stat(runDir); err != nil || !info.IsDir() {
+		t.Errorf("Run directory %s was not created: %v", runDir, err)
+	}
+}
+
+func TestPrepareOutputDirectory_KeepRuns(t *testing.T) {
+	SetKeepRuns(2)
+	defer SetKeepRuns(DefaultKeepRuns)
+
+	baseDir := t.TempDir()
+	for _, name := range []string{"run-20240101T000000.000Z", "run-20240102T000000.000Z", "other"} {
+		if err := os.Mkdir(filepath.Join(baseDir, name), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+	}
+
+	runDir, err := PrepareOutputDirectory(baseDir)
+	if err != nil {
+		t.Fatalf("PrepareOutputDirectory failed: %v", err)
+	}
+
+	names := archivedReports(t, baseDir)
+	expected := []string{"other", "run-20240102T000000.000Z", filepath.Base(runDir)}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected directories %v after rotation, got %v", expected, names)
+	}
+}
+
+func TestWriteRunMetadata(t *testing.T) {
+	runDir := t.TempDir()
+	metadata := RunMetadata{RunID: "run-20240101T000000.000Z", StartTime: "2024-01-01T00:00:00Z", Shape: "BM.GPU.H100.8", Hostname: "gpu-node-1"}
+
+	if err := WriteRunMetadata(runDir, metadata); err != nil {
+		t.Fatalf("WriteRunMetadata failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(runDir, RunMetadataFile))
+	if err != nil {
+		t.Fatalf("Failed to read metadata: %v", err)
+	}
+	var written RunMetadata
+	if err := json.Unmarshal(data, &written); err != nil || written != metadata {
+		t.Errorf("Expected metadata %+v, got %+v (%v)", metadata, written, err)
+	}
+}
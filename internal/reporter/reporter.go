@@ -1,24 +1,37 @@
 package reporter
 
 import (
+	"bytes"
+	"crypto/rand"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"gopkg.in/yaml.v3"
 )
 
 // TestResult represents a single test result
 type TestResult struct {
-	Name      string                 `json:"name"`
-	Status    string                 `json:"status"`
-	Details   map[string]interface{} `json:"details,omitempty"`
-	Error     string                 `json:"error,omitempty"`
-	Timestamp time.Time              `json:"timestamp"`
+	Name       string                 `json:"name"`
+	Status     string                 `json:"status"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	ErrorType  string                 `json:"error_type,omitempty"`
+	RetryCount int                    `json:"retry_count,omitempty"`
+	Timestamp  time.Time              `json:"timestamp"`
+
+	// err is the error reported by the test, kept to describe typed errors in the report
+	err error
 }
 
 // GPUTestResult represents GPU test results
@@ -44,20 +57,24 @@ type PCIeTestResult struct {
 
 // PCIeWidthTestResult represents PCIe width test results
 type PCIeWidthTestResult struct {
-	Status          string         `json:"status"`
-	GPUWidthCounts  map[string]int `json:"gpu_width_counts,omitempty"`
-	RDMAWidthCounts map[string]int `json:"rdma_width_counts,omitempty"`
-	GPUSpeedCounts  map[string]int `json:"gpu_speed_counts,omitempty"`
-	RDMASpeedCounts map[string]int `json:"rdma_speed_counts,omitempty"`
-	StateErrors     []string       `json:"state_errors,omitempty"`
-	TimestampUTC    string         `json:"timestamp_utc"`
+	Status            string         `json:"status"`
+	GPUWidthCounts    map[string]int `json:"gpu_width_counts,omitempty"`
+	RDMAWidthCounts   map[string]int `json:"rdma_width_counts,omitempty"`
+	GPUSpeedCounts    map[string]int `json:"gpu_speed_counts,omitempty"`
+	RDMASpeedCounts   map[string]int `json:"rdma_speed_counts,omitempty"`
+	StateErrors       []string       `json:"state_errors,omitempty"`
+	DowngradedDevices []string       `json:"downgraded_devices,omitempty"`
+	TimestampUTC      string         `json:"timestamp_utc"`
 }
 
-// RDMATestResult represents RDMA test results
+// RDMATestResult represents RDMA test results.
+// MissingDevices are expected RDMA NIC BDFs not found by ibstat, ExtraDevices are found but not expected.
 type RDMATestResult struct {
-	Status       string `json:"status"`
-	NumRDMANics  int    `json:"num_rdma_nics"`
-	TimestampUTC string `json:"timestamp_utc"`
+	Status         string   `json:"status"`
+	NumRDMANics    int      `json:"num_rdma_nics"`
+	MissingDevices []string `json:"missing_devices,omitempty"`
+	ExtraDevices   []string `json:"extra_devices,omitempty"`
+	TimestampUTC   string   `json:"timestamp_utc"`
 }
 
 // NetworkTestResult represents network test results
@@ -69,11 +86,17 @@ type RXDiscardsCheckTestResult struct {
 	TimestampUTC     string `json:"timestamp_utc"`
 }
 
-// GIDIndexTestResult represents GID index test results
+// GIDIndexTestResult represents GID index test results.
+// ExpectedCount is 0 when the GID table size is not checked.
+// DuplicateGIDs lists GID values found on more than one RDMA port.
 type GIDIndexTestResult struct {
-	Status         string `json:"status"`
-	InvalidIndexes []int  `json:"invalid_indexes,omitempty"`
-	TimestampUTC   string `json:"timestamp_utc"`
+	Status           string   `json:"status"`
+	InvalidIndexes   []int    `json:"invalid_indexes,omitempty"`
+	WrongTypeIndexes []int    `json:"wrong_type_indexes,omitempty"`
+	DuplicateGIDs    []string `json:"duplicate_gids,omitempty"`
+	ExpectedCount    int      `json:"expected_count,omitempty"`
+	ActualCount      int      `json:"actual_count"`
+	TimestampUTC     string   `json:"timestamp_utc"`
 }
 
 // LinkTestResult represents link check test results
@@ -90,19 +113,41 @@ type EthLinkTestResult struct {
 	TimestampUTC string      `json:"timestamp_utc"`
 }
 
-// AuthCheckTestResult represents authentication check test results
-type AuthCheckTestResult struct {
-	Status       string      `json:"status"`
-	Interfaces   interface{} `json:"interfaces,omitempty"`
-	TimestampUTC string      `json:"timestamp_utc"`
+// SRAMGPUErrors represents the SRAM error counts of a single GPU
+type SRAMGPUErrors struct {
+	Correctable   int `json:"correctable"`
+	Uncorrectable int `json:"uncorrectable"`
 }
 
-// SRAMErrorTestResult represents SRAM error test results
+// SRAMErrorTestResult represents SRAM error test results.
+// PerGPUErrors holds the SRAM error counts keyed by GPU index.
 type SRAMErrorTestResult struct {
-	Status           string `json:"status"`
-	MaxUncorrectable int    `json:"max_uncorrectable,omitempty"`
-	MaxCorrectable   int    `json:"max_correctable,omitempty"`
-	TimestampUTC     string `json:"timestamp_utc"`
+	Status           string                `json:"status"`
+	MaxUncorrectable int                   `json:"max_uncorrectable,omitempty"`
+	MaxCorrectable   int                   `json:"max_correctable,omitempty"`
+	PerGPUErrors     map[int]SRAMGPUErrors `json:"per_gpu_errors,omitempty"`
+	TimestampUTC     string                `json:"timestamp_utc"`
+}
+
+// sramErrorGPUs returns the indexes of the GPUs with SRAM errors, most uncorrectable errors first
+func sramErrorGPUs(perGPUErrors map[int]SRAMGPUErrors) []int {
+	var gpus []int
+	for index, counts := range perGPUErrors {
+		if counts.Uncorrectable > 0 || counts.Correctable > 0 {
+			gpus = append(gpus, index)
+		}
+	}
+	sort.Slice(gpus, func(i, j int) bool {
+		a, b := perGPUErrors[gpus[i]], perGPUErrors[gpus[j]]
+		if a.Uncorrectable != b.Uncorrectable {
+			return a.Uncorrectable > b.Uncorrectable
+		}
+		if a.Correctable != b.Correctable {
+			return a.Correctable > b.Correctable
+		}
+		return gpus[i] < gpus[j]
+	})
+	return gpus
 }
 
 // GPUDriverTestResult represents GPU driver test results
@@ -128,9 +173,11 @@ type NVLinkTestResult struct {
 
 // GPUClockTestResult represents GPU clock speed test results
 type GPUClockTestResult struct {
-	Status       string `json:"status"`
-	Message      string `json:"message,omitempty"`
-	TimestampUTC string `json:"timestamp_utc"`
+	Status          string `json:"status"`
+	Message         string `json:"message,omitempty"`
+	BoostClockSpeed int    `json:"boost_clock_speed,omitempty"`
+	BoostEnabled    bool   `json:"boost_enabled"`
+	TimestampUTC    string `json:"timestamp_utc"`
 }
 
 type Eth0PresenceTestResult struct {
@@ -153,25 +200,44 @@ type FabricManagerTestResult struct {
 	TimestampUTC        string      `json:"timestamp_utc"`
 }
 
-// HCAErrorTestResult represents HCA error check test results
+// HCAErrorTestResult represents HCA error check test results.
+// CriticalErrors holds the most recent critical MLX5 kernel messages.
 type HCAErrorTestResult struct {
-	Status       string `json:"status"`
-	TimestampUTC string `json:"timestamp_utc"`
+	Status         string   `json:"status"`
+	CriticalCount  int      `json:"critical_count"`
+	WarningCount   int      `json:"warning_count"`
+	CriticalErrors []string `json:"critical_errors,omitempty"`
+	TimestampUTC   string   `json:"timestamp_utc"`
 }
 
-// MissingInterfaceTestResult represents missing interface check test results
+// MissingInterfaceTestResult represents missing interface check test results.
+// MissingCount is the number of PCIe devices with revision ff; the interface counts and lists
+// compare the RDMA and VCN interfaces of the shape with the interfaces found on the host.
 type MissingInterfaceTestResult struct {
-	Status       string `json:"status"`
-	MissingCount int    `json:"missing_count,omitempty"`
-	TimestampUTC string `json:"timestamp_utc"`
+	Status                string   `json:"status"`
+	MissingCount          int      `json:"missing_count,omitempty"`
+	ExpectedCount         int      `json:"expected_count,omitempty"`
+	FoundCount            int      `json:"found_count,omitempty"`
+	MissingRDMAInterfaces []string `json:"missing_rdma_interfaces,omitempty"`
+	MissingVCNInterfaces  []string `json:"missing_vcn_interfaces,omitempty"`
+	ExtraInterfaces       []string `json:"extra_interfaces,omitempty"`
+	TimestampUTC          string   `json:"timestamp_utc"`
 }
 
 // GPUXIDTestResult represents GPU XID error check test results
 type GPUXIDTestResult struct {
-	Status       string      `json:"status"`
-	Message      string      `json:"message,omitempty"`
-	XIDResult    interface{} `json:"xid_result,omitempty"`
-	TimestampUTC string      `json:"timestamp_utc"`
+	Status       string             `json:"status"`
+	Message      string             `json:"message,omitempty"`
+	XIDResult    interface{}        `json:"xid_result,omitempty"`
+	XIDBreakdown []XIDCodeBreakdown `json:"xid_breakdown,omitempty"`
+	TimestampUTC string             `json:"timestamp_utc"`
+}
+
+// XIDCodeBreakdown counts the occurrences of a detected XID code and the severity configured for it
+type XIDCodeBreakdown struct {
+	XIDCode  string `json:"xid_code"`
+	Severity string `json:"severity"`
+	Count    int    `json:"count"`
 }
 
 // MaxAccTestResult represents MAX_ACC_OUT_READ configuration test results
@@ -189,6 +255,547 @@ type RowRemapErrorTestResult struct {
 	TimestampUTC string `json:"timestamp_utc"`
 }
 
+// GPUVBIOSTestResult represents GPU VBIOS version check test results
+type GPUVBIOSTestResult struct {
+	Status        string      `json:"status"`
+	VBIOSVersions interface{} `json:"vbios_versions,omitempty"`
+	TimestampUTC  string      `json:"timestamp_utc"`
+}
+
+// HugepagesTestResult represents hugepages configuration check test results
+type HugepagesTestResult struct {
+	Status       string `json:"status"`
+	Pages2MB     int    `json:"pages_2mb"`
+	Pages1GB     int    `json:"pages_1gb"`
+	Persistent   bool   `json:"persistent"`
+	TimestampUTC string `json:"timestamp_utc"`
+}
+
+// NUMAAffinityTestResult represents GPU NUMA affinity check test results
+type NUMAAffinityTestResult struct {
+	Status       string      `json:"status"`
+	GPUAffinity  interface{} `json:"gpu_affinity,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// IBSMTestResult represents InfiniBand subnet manager check test results
+type IBSMTestResult struct {
+	Status       string      `json:"status"`
+	Ports        interface{} `json:"ports,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// TimeSyncTestResult represents time synchronization check test results
+type TimeSyncTestResult struct {
+	Status          string  `json:"status"`
+	Synchronized    bool    `json:"synchronized"`
+	OffsetMs        float64 `json:"offset_ms"`
+	ReferenceServer string  `json:"reference_server,omitempty"`
+	Stratum         int     `json:"stratum,omitempty"`
+	TimestampUTC    string  `json:"timestamp_utc"`
+}
+
+// KernelModuleTestResult represents kernel module version compatibility check test results
+type KernelModuleTestResult struct {
+	Status       string      `json:"status"`
+	Modules      interface{} `json:"modules,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// SystemdServiceTestResult represents systemd service health check test results
+type SystemdServiceTestResult struct {
+	Status       string      `json:"status"`
+	Services     interface{} `json:"services,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// IOMMUTestResult represents IOMMU configuration check test results
+type IOMMUTestResult struct {
+	Status         string `json:"status"`
+	Enabled        bool   `json:"enabled"`
+	Mode           string `json:"mode,omitempty"`
+	GPUIOMMUGroups int    `json:"gpu_iommu_groups"`
+	TimestampUTC   string `json:"timestamp_utc"`
+}
+
+// NVLinkTopologyTestResult represents NVLink topology check test results.
+// Matrix holds the GPU-to-GPU cells of nvidia-smi topo -m, e.g. "X", "NV18" or "SYS".
+type NVLinkTopologyTestResult struct {
+	Status             string     `json:"status"`
+	Matrix             [][]string `json:"matrix,omitempty"`
+	MissingConnections []string   `json:"missing_connections,omitempty"`
+	TimestampUTC       string     `json:"timestamp_utc"`
+}
+
+// PCIeReplayTestResult represents PCIe replay counter check test results.
+// GPUs holds the BDF, replay count delta and replay rate per second of each GPU.
+type PCIeReplayTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// RDMACreditTestResult represents RDMA credit flow check test results.
+// Ports holds the credit error or buffer overflow counters of each active RDMA port.
+type RDMACreditTestResult struct {
+	Status       string      `json:"status"`
+	Ports        interface{} `json:"ports,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// RDMAMTUTestResult represents RDMA interface MTU check test results.
+// Interfaces holds the current and expected MTU of each RDMA network interface.
+type RDMAMTUTestResult struct {
+	Status           string      `json:"status"`
+	Interfaces       interface{} `json:"interfaces,omitempty"`
+	FailedInterfaces string      `json:"failed_interfaces,omitempty"`
+	TimestampUTC     string      `json:"timestamp_utc"`
+}
+
+// RDMATopologyTestResult represents RDMA topology check test results.
+// MissingDevices are expected for the shape but not found, ExtraDevices are found but not expected.
+type RDMATopologyTestResult struct {
+	Status          string   `json:"status"`
+	ExpectedDevices []string `json:"expected_devices,omitempty"`
+	ActualDevices   []string `json:"actual_devices,omitempty"`
+	MissingDevices  []string `json:"missing_devices,omitempty"`
+	ExtraDevices    []string `json:"extra_devices,omitempty"`
+	TimestampUTC    string   `json:"timestamp_utc"`
+}
+
+// BIOSSettingsTestResult represents BIOS settings check test results.
+// VersionSupported is false when the BIOS is older than the minimum version for the shape.
+type BIOSSettingsTestResult struct {
+	Status                string `json:"status"`
+	BIOSVersion           string `json:"bios_version,omitempty"`
+	CPUModel              string `json:"cpu_model,omitempty"`
+	HyperthreadingEnabled bool   `json:"hyperthreading_enabled"`
+	VersionSupported      bool   `json:"version_supported"`
+	TimestampUTC          string `json:"timestamp_utc"`
+}
+
+// GPUInfoROMTestResult represents GPU InfoROM check test results.
+// InfoROMVersions holds the image, OEM, ECC and power InfoROM versions of each GPU.
+type GPUInfoROMTestResult struct {
+	Status          string      `json:"status"`
+	InfoROMVersions interface{} `json:"inforom_versions,omitempty"`
+	TimestampUTC    string      `json:"timestamp_utc"`
+}
+
+// RowRemapTestResult represents GPU row remap check test results.
+// GPURowRemaps holds the pending and failure row remapper state of each GPU.
+type RowRemapTestResult struct {
+	Status       string      `json:"status"`
+	GPURowRemaps interface{} `json:"gpu_row_remaps,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// CPUGovernorTestResult represents CPU scaling governor check test results.
+// DifferingCPUs are the CPUs whose governor differs from the expected governor.
+type CPUGovernorTestResult struct {
+	Status           string   `json:"status"`
+	DetectedGovernor string   `json:"detected_governor,omitempty"`
+	ExpectedGovernor string   `json:"expected_governor"`
+	DifferingCPUs    []string `json:"differing_cpus,omitempty"`
+	TimestampUTC     string   `json:"timestamp_utc"`
+}
+
+// GPUFirmwareTestResult represents GPU GSP firmware version check test results
+type GPUFirmwareTestResult struct {
+	Status           string      `json:"status"`
+	FirmwareVersions interface{} `json:"firmware_versions,omitempty"`
+	TimestampUTC     string      `json:"timestamp_utc"`
+}
+
+// GPUP2PBWTestResult represents GPU peer-to-peer bandwidth check test results.
+// BandwidthMatrix holds the measured GB/s between GPUs ordered by GPU index, 0 for pairs not measured.
+type GPUP2PBWTestResult struct {
+	Status            string      `json:"status"`
+	BandwidthMatrix   [][]float64 `json:"bandwidth_matrix,omitempty"`
+	LowBandwidthPairs []string    `json:"low_bandwidth_pairs,omitempty"`
+	MinBandwidthGBps  float64     `json:"min_bandwidth_gbps"`
+	TimestampUTC      string      `json:"timestamp_utc"`
+}
+
+// CPUIsolationTestResult represents CPU isolation check test results.
+// NohzFullMatches reports whether nohz_full covers exactly the isolated CPUs.
+type CPUIsolationTestResult struct {
+	Status                   string `json:"status"`
+	IsolatedCPUCount         int    `json:"isolated_cpu_count"`
+	ExpectedIsolatedCPUCount int    `json:"expected_isolated_cpu_count"`
+	NohzFullMatches          bool   `json:"nohz_full_matches"`
+	TimestampUTC             string `json:"timestamp_utc"`
+}
+
+// PCIeGenTestResult represents PCIe generation and width check test results.
+// Devices holds the BDF, LnkCap generation and LnkSta generation of each NVIDIA and Mellanox device.
+type PCIeGenTestResult struct {
+	Status       string      `json:"status"`
+	Devices      interface{} `json:"devices,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// GPUCStateTestResult represents GPU C-state and power management check test results.
+// GPUs holds the power state and runtime power management state of each GPU.
+type GPUCStateTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// NFSMountTestResult represents NFS mount check test results.
+// Mounts holds whether each expected mount path is mounted and responsive.
+type NFSMountTestResult struct {
+	Status       string      `json:"status"`
+	Mounts       interface{} `json:"mounts,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// BERTrendTestResult represents BER trend check test results.
+// Interfaces holds the BER slope, last 5 BER values and trend direction of each RDMA interface.
+type BERTrendTestResult struct {
+	Status       string      `json:"status"`
+	Interfaces   interface{} `json:"interfaces,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// IBCableTestResult represents IB cable check test results.
+// Cables holds the cable type, temperature, RX/TX power in dBm and status of each RDMA port.
+type IBCableTestResult struct {
+	Status       string      `json:"status"`
+	Cables       interface{} `json:"cables,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// PCIeReBARTestResult represents PCIe resizable BAR check test results.
+// GPUs holds the BDF, expected BAR1 size and actual BAR1 size in MB of each GPU.
+type PCIeReBARTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// GPUIdleTestResult represents GPU idle pre-check test results.
+// GPUs holds the GPU and memory utilization percentage of each GPU before the diagnostics ran.
+type GPUIdleTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// PCIeDeviceCountTestResult represents PCIe device enumeration check test results.
+// MissingGPUBDFs and MissingNICBDFs list the expected BDFs absent from lspci.
+type PCIeDeviceCountTestResult struct {
+	Status           string   `json:"status"`
+	ExpectedGPUCount int      `json:"expected_gpu_count"`
+	FoundGPUCount    int      `json:"found_gpu_count"`
+	ExpectedNICCount int      `json:"expected_nic_count"`
+	FoundNICCount    int      `json:"found_nic_count"`
+	MissingGPUBDFs   []string `json:"missing_gpu_bdfs,omitempty"`
+	MissingNICBDFs   []string `json:"missing_nic_bdfs,omitempty"`
+	TimestampUTC     string   `json:"timestamp_utc"`
+}
+
+// GPUFirmwareUpdateTestResult represents GPU firmware update check test results.
+// LatestVersion is empty when no recommended version is known for the GPU model and driver.
+type GPUFirmwareUpdateTestResult struct {
+	Status            string `json:"status"`
+	CurrentVersion    string `json:"current_version"`
+	LatestVersion     string `json:"latest_version,omitempty"`
+	UpdateRecommended bool   `json:"update_recommended"`
+	TimestampUTC      string `json:"timestamp_utc"`
+}
+
+// GPUResetTestResult represents GPU reset history check test results.
+// GPUs holds the number of resets of each GPU since boot and the time of its most recent reset.
+type GPUResetTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// RDMAInterfaceSpeedTestResult represents RDMA interface speed check test results.
+// Interfaces holds the current and expected ethtool speed of each RDMA network interface.
+type RDMAInterfaceSpeedTestResult struct {
+	Status           string      `json:"status"`
+	Interfaces       interface{} `json:"interfaces,omitempty"`
+	FailedInterfaces string      `json:"failed_interfaces,omitempty"`
+	TimestampUTC     string      `json:"timestamp_utc"`
+}
+
+// IBPortStateTestResult represents IB port state check test results.
+// Ports holds the ibstat State and Physical state of each port of each RDMA device.
+type IBPortStateTestResult struct {
+	Status       string      `json:"status"`
+	Ports        interface{} `json:"ports,omitempty"`
+	FailedPorts  string      `json:"failed_ports,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// NICFirmwareTestResult represents NIC firmware version check test results.
+// Interfaces holds the ethtool firmware version and validation status of each RDMA network interface.
+type NICFirmwareTestResult struct {
+	Status       string      `json:"status"`
+	Interfaces   interface{} `json:"interfaces,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// RDMAPCIMappingTestResult represents RDMA device to PCI address mapping check test results.
+// ExpectedMapping and ActualMapping map each BDF to its RDMA device name.
+type RDMAPCIMappingTestResult struct {
+	Status          string            `json:"status"`
+	ExpectedMapping map[string]string `json:"expected_mapping,omitempty"`
+	ActualMapping   map[string]string `json:"actual_mapping,omitempty"`
+	MismatchedBDFs  []string          `json:"mismatched_bdfs,omitempty"`
+	TimestampUTC    string            `json:"timestamp_utc"`
+}
+
+// GPUPCIeTopoTestResult represents GPU peer-to-peer accessibility check test results.
+// Matrix holds the GPU-to-GPU cells of nvidia-smi topo -p2p r, e.g. "X", "OK" or "CNS".
+type GPUPCIeTopoTestResult struct {
+	Status           string     `json:"status"`
+	Matrix           [][]string `json:"matrix,omitempty"`
+	UnavailablePairs []string   `json:"unavailable_pairs,omitempty"`
+	NonNVLinkPairs   []string   `json:"non_nvlink_pairs,omitempty"`
+	TimestampUTC     string     `json:"timestamp_utc"`
+}
+
+// MLXConfigParameterResult represents the actual and expected values of a single mlxconfig parameter of a NIC
+type MLXConfigParameterResult struct {
+	PCIBusID  string   `json:"pci_busid"`
+	Parameter string   `json:"parameter"`
+	Expected  []string `json:"expected"`
+	Actual    string   `json:"actual"`
+	Status    string   `json:"status"`
+}
+
+// MLXConfigTestResult represents mlxconfig parameter check test results
+type MLXConfigTestResult struct {
+	Status       string                     `json:"status"`
+	Parameters   []MLXConfigParameterResult `json:"parameters,omitempty"`
+	TimestampUTC string                     `json:"timestamp_utc"`
+}
+
+// GPUPowerRailTestResult represents GPU power rail check test results.
+// Readings holds each power reading of each GPU rail with its validation status against TDPWatts.
+type GPUPowerRailTestResult struct {
+	Status       string      `json:"status"`
+	TDPWatts     float64     `json:"tdp_watts"`
+	Readings     interface{} `json:"readings,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// GPUTemperatureTestResult represents GPU temperature check test results.
+// GPUs holds the core temperature of each GPU and its status against Thresholds.
+type GPUTemperatureTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	Thresholds   interface{} `json:"thresholds,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// RoCEVLANTestResult represents RoCE VLAN tagging check test results.
+// Interfaces holds the VLAN interface, VLAN ID and VLAN protocol of each RoCE network interface.
+type RoCEVLANTestResult struct {
+	Status       string      `json:"status"`
+	Interfaces   interface{} `json:"interfaces,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// IBSwitchPortResult represents the switch port connected to a single InfiniBand port of the host.
+// Error is set when the switch port could not be queried.
+type IBSwitchPortResult struct {
+	Device        string           `json:"device"`
+	Port          int              `json:"port"`
+	NICState      string           `json:"nic_state"`
+	SwitchLID     int              `json:"switch_lid,omitempty"`
+	SwitchPort    int              `json:"switch_port,omitempty"`
+	SwitchState   string           `json:"switch_state,omitempty"`
+	ErrorCounters map[string]int64 `json:"error_counters,omitempty"`
+	Error         string           `json:"error,omitempty"`
+	Status        string           `json:"status"`
+}
+
+// IBSwitchPortTestResult represents InfiniBand switch port check test results
+type IBSwitchPortTestResult struct {
+	Status       string               `json:"status"`
+	Ports        []IBSwitchPortResult `json:"ports,omitempty"`
+	TimestampUTC string               `json:"timestamp_utc"`
+}
+
+// GPUCPUBWTestResult represents GPU-CPU bandwidth check test results.
+// GPUs holds the H2D, D2H and bidirectional bandwidth in GB/s measured for each GPU.
+type GPUCPUBWTestResult struct {
+	Status           string      `json:"status"`
+	GPUs             interface{} `json:"gpus,omitempty"`
+	MinBandwidthGBps float64     `json:"min_bandwidth_gbps"`
+	TimestampUTC     string      `json:"timestamp_utc"`
+}
+
+// FabricManagerLogTestResult represents fabric manager log check test results.
+// WarningCount and ErrorCount only count runtime messages; transient warnings logged while the
+// fabric manager starts are counted in StartupWarningCount.
+type FabricManagerLogTestResult struct {
+	Status              string   `json:"status"`
+	WarningCount        int      `json:"warning_count"`
+	ErrorCount          int      `json:"error_count"`
+	StartupWarningCount int      `json:"startup_warning_count"`
+	LastError           string   `json:"last_error,omitempty"`
+	RecentMessages      []string `json:"recent_messages,omitempty"`
+	TimestampUTC        string   `json:"timestamp_utc"`
+}
+
+// GPUMemTemperatureTestResult represents GPU memory temperature check test results.
+// GPUs holds the per-GPU memory temperature and its status against WarningC and CriticalC.
+type GPUMemTemperatureTestResult struct {
+	Status       string      `json:"status"`
+	GPUs         interface{} `json:"gpus,omitempty"`
+	WarningC     int         `json:"warning_c"`
+	CriticalC    int         `json:"critical_c"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// PCIeVendorTestResult represents PCIe vendor check test results.
+// Devices holds the expected and found vendor:device ID of every expected BDF.
+type PCIeVendorTestResult struct {
+	Status       string      `json:"status"`
+	ExpectedBDFs []string    `json:"expected_bdfs,omitempty"`
+	Devices      interface{} `json:"devices,omitempty"`
+	Mismatches   []string    `json:"mismatches,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
+// RDMARetryCounterTestResult represents RDMA retry counter check test results.
+// Ports holds the retry rate over the sample interval and the cumulative retry count of every port.
+type RDMARetryCounterTestResult struct {
+	Status             string      `json:"status"`
+	Ports              interface{} `json:"ports,omitempty"`
+	MaxRetryRatePerSec float64     `json:"max_retry_rate_per_sec"`
+	TimestampUTC       string      `json:"timestamp_utc"`
+}
+
+// NVLinkBWTestResult represents NVLink bandwidth check test results.
+// Algorithm is the NCCL all-reduce algorithm used, "auto" when NCCL picked it and did not report it.
+type NVLinkBWTestResult struct {
+	Status                   string  `json:"status"`
+	BusBandwidthGBps         float64 `json:"bus_bandwidth_gbps"`
+	ExpectedBusBandwidthGBps float64 `json:"expected_bus_bandwidth_gbps"`
+	Algorithm                string  `json:"algorithm"`
+	TimestampUTC             string  `json:"timestamp_utc"`
+}
+
+// GPUMIGProfileTestResult represents GPU MIG profile check test results.
+// GPUs holds the configured profiles of each GPU; MissingProfiles and ExtraProfiles name the GPU of each profile.
+type GPUMIGProfileTestResult struct {
+	Status           string      `json:"status"`
+	ExpectedProfiles []string    `json:"expected_profiles,omitempty"`
+	GPUs             interface{} `json:"gpus,omitempty"`
+	MissingProfiles  []string    `json:"missing_profiles,omitempty"`
+	ExtraProfiles    []string    `json:"extra_profiles,omitempty"`
+	TimestampUTC     string      `json:"timestamp_utc"`
+}
+
+// NetworkAuthCheckTestResult represents network authentication check test results.
+// Interfaces holds the authentication method and status of each RDMA interface.
+type NetworkAuthCheckTestResult struct {
+	Status           string      `json:"status"`
+	Interfaces       interface{} `json:"interfaces,omitempty"`
+	FailedInterfaces []string    `json:"failed_interfaces,omitempty"`
+	TimestampUTC     string      `json:"timestamp_utc"`
+}
+
+// DCGMFieldTestResult represents DCGM field check test results.
+// Fields maps each DCGM field name to its sampled values and PASS/FAIL status.
+type DCGMFieldTestResult struct {
+	Status       string                 `json:"status"`
+	Fields       map[string]interface{} `json:"fields,omitempty"`
+	FailedFields []string               `json:"failed_fields,omitempty"`
+	TimestampUTC string                 `json:"timestamp_utc"`
+}
+
+// NUMADistanceTestResult represents NUMA distance check test results.
+// ActualMatrix and ExpectedMatrix hold the distance from node i to node j at [i][j].
+type NUMADistanceTestResult struct {
+	Status         string   `json:"status"`
+	ActualMatrix   [][]int  `json:"actual_matrix,omitempty"`
+	ExpectedMatrix [][]int  `json:"expected_matrix,omitempty"`
+	Mismatches     []string `json:"mismatches,omitempty"`
+	TimestampUTC   string   `json:"timestamp_utc"`
+}
+
+// CPUTopologyTestResult represents CPU topology check test results
+type CPUTopologyTestResult struct {
+	Status         string `json:"status"`
+	Sockets        int    `json:"sockets"`
+	CoresPerSocket int    `json:"cores_per_socket"`
+	HyperThreading bool   `json:"hyper_threading"`
+	LogicalCPUs    int    `json:"logical_cpus"`
+	TimestampUTC   string `json:"timestamp_utc"`
+}
+
+// MemoryTestResult represents memory check test results.
+// SpeedMTs is the lowest configured speed of the populated DIMMs.
+type MemoryTestResult struct {
+	Status                string   `json:"status"`
+	TotalMemoryGB         float64  `json:"total_memory_gb"`
+	AvailableMemoryGB     float64  `json:"available_memory_gb"`
+	DIMMCount             int      `json:"dimm_count"`
+	SpeedMTs              int      `json:"speed_mts"`
+	MemoryType            string   `json:"memory_type"`
+	EmptySlots            []string `json:"empty_slots,omitempty"`
+	ExpectedTotalMemoryGB float64  `json:"expected_total_memory_gb"`
+	MinSpeedMTs           int      `json:"min_speed_mts"`
+	TimestampUTC          string   `json:"timestamp_utc"`
+}
+
+// GPUFirmwareConsistencyTestResult represents GPU firmware consistency check test results.
+// FirmwareVersions maps every VBIOS version to the indexes of the GPUs running it.
+type GPUFirmwareConsistencyTestResult struct {
+	Status           string              `json:"status"`
+	FirmwareVersions map[string][]string `json:"firmware_versions,omitempty"`
+	TimestampUTC     string              `json:"timestamp_utc"`
+}
+
+// TestTimeoutResult represents a test that was stopped after exceeding its timeout
+type TestTimeoutResult struct {
+	TestName       string `json:"test_name"`
+	Status         string `json:"status"`
+	TimeoutSeconds int    `json:"timeout_seconds"`
+	TimestampUTC   string `json:"timestamp_utc"`
+}
+
+// TestRetryResult represents a test that needed retries after transient errors
+type TestRetryResult struct {
+	TestName     string `json:"test_name"`
+	Status       string `json:"status"`
+	RetryCount   int    `json:"retry_count"`
+	TimestampUTC string `json:"timestamp_utc"`
+}
+
+// TestSkippedResult represents a test that was not run because a test it depends on failed
+type TestSkippedResult struct {
+	TestName         string `json:"test_name"`
+	Status           string `json:"status"`
+	FailedDependency string `json:"failed_dependency"`
+	TimestampUTC     string `json:"timestamp_utc"`
+}
+
+// TestErrorResult describes a test that failed with a typed error, so a missing tool or a
+// failed command can be told apart from a hardware failure
+type TestErrorResult struct {
+	TestName     string      `json:"test_name"`
+	Status       string      `json:"status"`
+	ErrorType    string      `json:"error_type"`
+	Tool         string      `json:"tool,omitempty"`
+	Package      string      `json:"package,omitempty"`
+	Command      string      `json:"command,omitempty"`
+	ExitCode     int         `json:"exit_code,omitempty"`
+	Metric       string      `json:"metric,omitempty"`
+	Actual       interface{} `json:"actual,omitempty"`
+	Expected     interface{} `json:"expected,omitempty"`
+	TimestampUTC string      `json:"timestamp_utc"`
+}
+
 // HostResults represents test results for a host
 type HostResults struct {
 	GPUCountCheck              []GPUTestResult              `json:"gpu_count_check,omitempty"`
@@ -200,7 +807,6 @@ type HostResults struct {
 	GIDIndexCheck              []GIDIndexTestResult         `json:"gid_index_check,omitempty"`
 	LinkCheck                  []LinkTestResult             `json:"link_check,omitempty"`
 	EthLinkCheck               []EthLinkTestResult          `json:"eth_link_check,omitempty"`
-	AuthCheck                  []AuthCheckTestResult        `json:"auth_check,omitempty"`
 	SRAMErrorCheck             []SRAMErrorTestResult        `json:"sram_error_check,omitempty"`
 	GPUDriverCheck             []GPUDriverTestResult        `json:"gpu_driver_check,omitempty"`
 	GPUClockCheck              []GPUClockTestResult         `json:"gpu_clk_check,omitempty"`
@@ -214,22 +820,165 @@ type HostResults struct {
 	GPUXIDCheck                []GPUXIDTestResult           `json:"gpu_xid_check,omitempty"`
 	MaxAccCheck                []MaxAccTestResult           `json:"max_acc_check,omitempty"`
 	RowRemapErrorCheck         []RowRemapErrorTestResult    `json:"row_remap_error_check,omitempty"`
+	GPUVBIOSCheck              []GPUVBIOSTestResult         `json:"gpu_vbios_check,omitempty"`
+	HugepagesCheck             []HugepagesTestResult        `json:"hugepages_check,omitempty"`
+	NUMAAffinityCheck          []NUMAAffinityTestResult     `json:"numa_affinity_check,omitempty"`
+	IBSMCheck                  []IBSMTestResult             `json:"ib_sm_check,omitempty"`
+	TimeSyncCheck              []TimeSyncTestResult         `json:"time_sync_check,omitempty"`
+	KernelModuleCheck          []KernelModuleTestResult     `json:"kernel_module_check,omitempty"`
+	SystemdServiceCheck        []SystemdServiceTestResult   `json:"systemd_service_check,omitempty"`
+	IOMMUCheck                 []IOMMUTestResult            `json:"iommu_check,omitempty"`
+	NVLinkTopologyCheck        []NVLinkTopologyTestResult   `json:"nvlink_topology_check,omitempty"`
+	PCIeReplayCheck            []PCIeReplayTestResult       `json:"pcie_replay_check,omitempty"`
+	RDMACreditCheck            []RDMACreditTestResult       `json:"rdma_credit_check,omitempty"`
+	RDMAMTUCheck               []RDMAMTUTestResult          `json:"rdma_mtu_check,omitempty"`
+	RDMATopologyCheck          []RDMATopologyTestResult     `json:"rdma_topology_check,omitempty"`
+	BIOSSettingsCheck          []BIOSSettingsTestResult     `json:"bios_settings_check,omitempty"`
+	GPUInfoROMCheck            []GPUInfoROMTestResult       `json:"gpu_inforom_check,omitempty"`
+	GPURowRemapCheck           []RowRemapTestResult         `json:"gpu_row_remap_check,omitempty"`
+	CPUGovernorCheck           []CPUGovernorTestResult      `json:"cpu_governor_check,omitempty"`
+	GPUFirmwareCheck           []GPUFirmwareTestResult      `json:"gpu_firmware_check,omitempty"`
+	GPUP2PBWCheck              []GPUP2PBWTestResult         `json:"gpu_p2p_bw_check,omitempty"`
+	CPUIsolationCheck          []CPUIsolationTestResult     `json:"cpu_isolation_check,omitempty"`
+	PCIeGenCheck               []PCIeGenTestResult          `json:"pcie_gen_check,omitempty"`
+	GPUCStateCheck             []GPUCStateTestResult        `json:"gpu_cstate_check,omitempty"`
+	NFSMountCheck              []NFSMountTestResult         `json:"nfs_mount_check,omitempty"`
+	BERTrendCheck              []BERTrendTestResult         `json:"ber_trend_check,omitempty"`
+	IBCableCheck               []IBCableTestResult          `json:"ib_cable_check,omitempty"`
+	PCIeReBARCheck             []PCIeReBARTestResult        `json:"pcie_rebar_check,omitempty"`
+	GPUIdleCheck               []GPUIdleTestResult          `json:"gpu_idle_check,omitempty"`
+	PCIeDeviceCountCheck       []PCIeDeviceCountTestResult  `json:"pcie_device_count_check,omitempty"`
+	GPUFirmwareUpdateCheck     []GPUFirmwareUpdateTestResult `json:"gpu_firmware_update_check,omitempty"`
+	GPUResetCheck              []GPUResetTestResult         `json:"gpu_reset_check,omitempty"`
+	RDMAInterfaceSpeedCheck    []RDMAInterfaceSpeedTestResult `json:"rdma_interface_speed_check,omitempty"`
+	IBPortStateCheck           []IBPortStateTestResult      `json:"ib_port_state_check,omitempty"`
+	NICFirmwareCheck           []NICFirmwareTestResult      `json:"nic_firmware_check,omitempty"`
+	RDMAPCIMappingCheck        []RDMAPCIMappingTestResult   `json:"rdma_pci_mapping_check,omitempty"`
+	GPUPCIeTopoCheck           []GPUPCIeTopoTestResult      `json:"gpu_pcie_topo_check,omitempty"`
+	MLXConfigCheck             []MLXConfigTestResult        `json:"mlxconfig_check,omitempty"`
+	GPUPowerRailCheck          []GPUPowerRailTestResult     `json:"gpu_power_rail_check,omitempty"`
+	GPUTemperatureCheck        []GPUTemperatureTestResult   `json:"gpu_temperature_check,omitempty"`
+	RoCEVLANCheck              []RoCEVLANTestResult         `json:"roce_vlan_check,omitempty"`
+	IBSwitchPortCheck          []IBSwitchPortTestResult     `json:"ib_switch_port_check,omitempty"`
+	GPUCPUBWCheck              []GPUCPUBWTestResult         `json:"gpu_cpu_bw_check,omitempty"`
+	FabricManagerLogCheck      []FabricManagerLogTestResult `json:"fabricmanager_log_check,omitempty"`
+	GPUMemTemperatureCheck     []GPUMemTemperatureTestResult `json:"gpu_mem_temperature_check,omitempty"`
+	PCIeVendorCheck            []PCIeVendorTestResult       `json:"pcie_vendor_check,omitempty"`
+	RDMARetryCounterCheck      []RDMARetryCounterTestResult `json:"rdma_retry_counter_check,omitempty"`
+	NVLinkBWCheck              []NVLinkBWTestResult         `json:"nvlink_bw_check,omitempty"`
+	GPUMIGProfileCheck         []GPUMIGProfileTestResult    `json:"gpu_mig_profile_check,omitempty"`
+	NetworkAuthCheck           []NetworkAuthCheckTestResult `json:"network_auth_check,omitempty"`
+	DCGMFieldCheck             []DCGMFieldTestResult        `json:"dcgm_field_check,omitempty"`
+	NUMADistanceCheck          []NUMADistanceTestResult     `json:"numa_distance_check,omitempty"`
+	CPUTopologyCheck           []CPUTopologyTestResult      `json:"cpu_topology_check,omitempty"`
+	MemoryCheck                []MemoryTestResult           `json:"memory_check,omitempty"`
+	GPUFirmwareConsistencyCheck []GPUFirmwareConsistencyTestResult `json:"gpu_firmware_consistency_check,omitempty"`
+	TestTimeouts               []TestTimeoutResult          `json:"test_timeouts,omitempty"`
+	SkippedTests               []TestSkippedResult          `json:"skipped_tests,omitempty"`
+	TestRetries                []TestRetryResult            `json:"test_retries,omitempty"`
+	TestErrors                 []TestErrorResult            `json:"test_errors,omitempty"`
+}
+
+// InstanceTags represents the OCI tags of the instance the report was generated on.
+// DefinedTags is keyed by tag namespace.
+type InstanceTags struct {
+	DefinedTags  map[string]interface{} `json:"defined_tags,omitempty"`
+	FreeformTags map[string]interface{} `json:"freeform_tags,omitempty"`
 }
 
-// ReportOutput represents the final JSON output structure
+// ReportOutput represents the final JSON output structure.
+// Tags is only set when instance tags are included with SetInstanceTags.
+// ConfigShape is the shape whose test limits were used, which differs from Shape when the
+// shape is not configured and the limits of its shape family are used.
+// DiagnosticNote warns about conditions making the results unreliable, such as a GPU workload
+// running during the diagnostics.
 type ReportOutput struct {
-	Localhost HostResults `json:"localhost"`
+	Shape          string        `json:"shape,omitempty"`
+	ConfigShape    string        `json:"config_shape,omitempty"`
+	DiagnosticNote string        `json:"diagnostic_note,omitempty"`
+	Tags           *InstanceTags `json:"tags,omitempty"`
+	Localhost      HostResults   `json:"localhost"`
+}
+
+// shapeHeader returns the shape line of report headers, or "" when the shape is not set
+func shapeHeader(report *ReportOutput) string {
+	if report.Shape == "" {
+		return ""
+	}
+	if report.ConfigShape != "" && report.ConfigShape != report.Shape {
+		return fmt.Sprintf("Shape: %s (using %s limits)", report.Shape, report.ConfigShape)
+	}
+	return fmt.Sprintf("Shape: %s", report.Shape)
+}
+
+// MultiHostReport represents the results of running diagnostics on several hosts.
+// Hosts that could not be diagnosed are listed in Errors instead of Hosts.
+type MultiHostReport struct {
+	Hosts  map[string]HostResults `json:"hosts"`
+	Errors map[string]string      `json:"errors,omitempty"`
+}
+
+// HostNames returns the names of all hosts in the report, including failed hosts, in sorted order
+func (m *MultiHostReport) HostNames() []string {
+	names := make([]string, 0, len(m.Hosts)+len(m.Errors))
+	for host := range m.Hosts {
+		names = append(names, host)
+	}
+	for host := range m.Errors {
+		if _, exists := m.Hosts[host]; !exists {
+			names = append(names, host)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FailedHosts returns the hosts that could not be diagnosed or have failed tests, in sorted order
+func (m *MultiHostReport) FailedHosts() []string {
+	var failed []string
+	for _, host := range m.HostNames() {
+		if _, exists := m.Errors[host]; exists {
+			failed = append(failed, host)
+			continue
+		}
+		tests, err := flattenResults(m.Hosts[host])
+		if err != nil {
+			failed = append(failed, host)
+			continue
+		}
+		if countFailures(tests) > 0 {
+			failed = append(failed, host)
+		}
+	}
+	return failed
+}
+
+// countFailures returns the number of flattened test results that did not pass
+func countFailures(tests map[string][]map[string]interface{}) int {
+	failures := 0
+	for _, results := range tests {
+		for _, result := range results {
+			if result["status"] != "PASS" {
+				failures++
+			}
+		}
+	}
+	return failures
 }
 
 // TestRun represents a single test run with timestamp
 type TestRun struct {
-	RunID       string      `json:"run_id"`
-	Timestamp   string      `json:"timestamp"`
-	TestResults HostResults `json:"test_results"`
+	RunID          string        `json:"run_id"`
+	Timestamp      string        `json:"timestamp"`
+	DiagnosticNote string        `json:"diagnostic_note,omitempty"`
+	Tags           *InstanceTags `json:"tags,omitempty"`
+	TestResults    HostResults   `json:"test_results"`
 }
 
 // AppendedReport represents multiple test runs in a single file
 type AppendedReport struct {
+	// MaxRuns limits the number of runs kept in the file, oldest runs are dropped first (0 keeps all runs)
+	MaxRuns  int       `json:"max_runs,omitempty"`
 	TestRuns []TestRun `json:"test_runs"`
 }
 
@@ -237,10 +986,25 @@ type AppendedReport struct {
 type Reporter struct {
 	mutex       sync.RWMutex
 	results     map[string]TestResult
+	cached      map[string]json.RawMessage
 	outputFile  string
 	hostname    string
+	shape       string
+	configShape string
+	note        string
 	initialized bool
 	appendMode  bool
+	maxRuns     int
+	tags        *InstanceTags
+
+	// generation is advanced by Clear. timedOut holds the tests that timed out and are still
+	// running, by the generation they timed out in, so their late results can be dropped.
+	generation uint64
+	timedOut   map[string]uint64
+
+	archiveDir        string
+	archiveMaxFiles   int
+	archiveMaxAgeDays int
 }
 
 // Global reporter instance
@@ -252,6 +1016,8 @@ func GetReporter() *Reporter {
 	once.Do(func() {
 		globalReporter = &Reporter{
 			results:    make(map[string]TestResult),
+			cached:     make(map[string]json.RawMessage),
+			timedOut:   make(map[string]uint64),
 			hostname:   "localhost", // Default hostname
 			appendMode: true,        // Default to append mode
 		}
@@ -286,6 +1052,32 @@ func (r *Reporter) SetAppendMode(append bool) {
 	r.appendMode = append
 }
 
+// SetMaxRuns limits the number of runs kept in the output file in append mode.
+// 0 keeps the limit already recorded in the file.
+func (r *Reporter) SetMaxRuns(maxRuns int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.maxRuns = maxRuns
+}
+
+// SetArchive archives the output file to archiveDir after each report is written,
+// keeping at most maxFiles archived reports that are at most maxAgeDays old
+func (r *Reporter) SetArchive(archiveDir string, maxFiles int, maxAgeDays int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.archiveDir = archiveDir
+	r.archiveMaxFiles = maxFiles
+	r.archiveMaxAgeDays = maxAgeDays
+}
+
+// SetInstanceTags includes the instance tags at the root of every written report.
+// Tags are left out of reports unless set, since reports may be shared outside the tenancy.
+func (r *Reporter) SetInstanceTags(definedTags, freeformTags map[string]interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.tags = &InstanceTags{DefinedTags: definedTags, FreeformTags: freeformTags}
+}
+
 // SetHostname sets the hostname for the report
 func (r *Reporter) SetHostname(hostname string) {
 	r.mutex.Lock()
@@ -293,20 +1085,50 @@ func (r *Reporter) SetHostname(hostname string) {
 	r.hostname = hostname
 }
 
+// SetShape sets the shape of the host and the shape whose test limits are used for the report header
+func (r *Reporter) SetShape(shape, configShape string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.shape = shape
+	r.configShape = configShape
+}
+
+// SetDiagnosticNote sets the note at the root of the report warning that its results may be
+// inaccurate. The note is cleared with the results.
+func (r *Reporter) SetDiagnosticNote(note string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.note = note
+}
+
 // AddResult adds a test result to the reporter
 func (r *Reporter) AddResult(testName string, status string, details map[string]interface{}, err error) {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	result := TestResult{
-		Name:      testName,
-		Status:    status,
+	// A test that timed out in an earlier run may still report after the results were cleared
+	if generation, running := r.timedOut[testName]; running && generation != r.generation {
+		logger.Debugf("Ignoring result from an earlier run for timed out test: %s", testName)
+		return
+	}
+
+	// A test that timed out may still report once its command returns; keep the timeout
+	if existing, exists := r.results[testName]; exists && existing.Details["timeout"] == true {
+		logger.Debugf("Ignoring result for timed out test: %s", testName)
+		return
+	}
+
+	result := TestResult{
+		Name:      testName,
+		Status:    status,
 		Details:   details,
 		Timestamp: time.Now(),
 	}
 
 	if err != nil {
 		result.Error = err.Error()
+		result.ErrorType = testerrors.Type(err)
+		result.err = err
 	}
 
 	r.results[testName] = result
@@ -337,21 +1159,24 @@ func (r *Reporter) AddPCIeResult(status string, err error) {
 }
 
 // AddPCIeWidthResult adds PCIe width missing lanes test results
-func (r *Reporter) AddPCIeWidthResult(status string, gpuWidthCounts, rdmaWidthCounts, gpuSpeedCounts, rdmaSpeedCounts map[string]int, stateErrors []string, err error) {
+func (r *Reporter) AddPCIeWidthResult(status string, gpuWidthCounts, rdmaWidthCounts, gpuSpeedCounts, rdmaSpeedCounts map[string]int, stateErrors, downgradedDevices []string, err error) {
 	details := map[string]interface{}{
-		"gpu_width_counts":  gpuWidthCounts,
-		"rdma_width_counts": rdmaWidthCounts,
-		"gpu_speed_counts":  gpuSpeedCounts,
-		"rdma_speed_counts": rdmaSpeedCounts,
-		"state_errors":      stateErrors,
+		"gpu_width_counts":   gpuWidthCounts,
+		"rdma_width_counts":  rdmaWidthCounts,
+		"gpu_speed_counts":   gpuSpeedCounts,
+		"rdma_speed_counts":  rdmaSpeedCounts,
+		"state_errors":       stateErrors,
+		"downgraded_devices": downgradedDevices,
 	}
 	r.AddResult("pcie_width_missing_lanes_check", status, details, err)
 }
 
 // AddRDMAResult adds RDMA test results
-func (r *Reporter) AddRDMAResult(status string, rdmaNicCount int, err error) {
+func (r *Reporter) AddRDMAResult(status string, rdmaNicCount int, missingDevices, extraDevices []string, err error) {
 	details := map[string]interface{}{
-		"rdma_nic_count": rdmaNicCount,
+		"rdma_nic_count":  rdmaNicCount,
+		"missing_devices": missingDevices,
+		"extra_devices":   extraDevices,
 	}
 	r.AddResult("rdma_nic_count", status, details, err)
 }
@@ -375,8 +1200,18 @@ func (r *Reporter) AddRXDiscardsCheckResult(status string, interfaceCount int, f
 
 // AddGIDIndexResult adds GID index test results
 func (r *Reporter) AddGIDIndexResult(status string, invalidIndexes []int, err error) {
+	r.AddGIDIndexDetailsResult(status, invalidIndexes, nil, nil, 0, 0, err)
+}
+
+// AddGIDIndexDetailsResult adds GID index test results along with the GID indexes of an unexpected type,
+// the GID values duplicated across ports and the expected and actual GID entry counts
+func (r *Reporter) AddGIDIndexDetailsResult(status string, invalidIndexes, wrongTypeIndexes []int, duplicateGIDs []string, expectedCount, actualCount int, err error) {
 	details := map[string]interface{}{
-		"invalid_indexes": invalidIndexes,
+		"invalid_indexes":    invalidIndexes,
+		"wrong_type_indexes": wrongTypeIndexes,
+		"duplicate_gids":     duplicateGIDs,
+		"expected_count":     expectedCount,
+		"actual_count":       actualCount,
 	}
 	r.AddResult("gid_index_check", status, details, err)
 }
@@ -397,19 +1232,12 @@ func (r *Reporter) AddEthLinkResult(status string, ethLinks interface{}, err err
 	r.AddResult("eth_link_check", status, details, err)
 }
 
-// AddAuthCheckResult adds authentication check test results
-func (r *Reporter) AddAuthCheckResult(status string, interfaces interface{}, err error) {
-	details := map[string]interface{}{
-		"interfaces": interfaces,
-	}
-	r.AddResult("auth_check", status, details, err)
-}
-
 // AddSRAMErrorResult adds SRAM error test results
-func (r *Reporter) AddSRAMErrorResult(status string, maxUncorrectable int, maxCorrectable int, err error) {
+func (r *Reporter) AddSRAMErrorResult(status string, maxUncorrectable int, maxCorrectable int, perGPUErrors map[int]SRAMGPUErrors, err error) {
 	details := map[string]interface{}{
 		"max_uncorrectable": maxUncorrectable,
 		"max_correctable":   maxCorrectable,
+		"per_gpu_errors":    perGPUErrors,
 	}
 	r.AddResult("sram_error_check", status, details, err)
 }
@@ -422,10 +1250,13 @@ func (r *Reporter) AddGPUDriverResult(status string, driverVersion string, err e
 	r.AddResult("gpu_driver_check", status, details, err)
 }
 
-// AddGPUClockResult adds GPU clock speed test results
-func (r *Reporter) AddGPUClockResult(status string, message string, err error) {
+// AddGPUClockResult adds GPU clock speed test results. boostClockSpeed is the expected maximum SM
+// clock in MHz, 0 when the boost state is not checked.
+func (r *Reporter) AddGPUClockResult(status string, message string, boostClockSpeed int, boostEnabled bool, err error) {
 	details := map[string]interface{}{
-		"message": message,
+		"message":           message,
+		"boost_clock_speed": boostClockSpeed,
+		"boost_enabled":     boostEnabled,
 	}
 	r.AddResult("gpu_clk_check", status, details, err)
 }
@@ -482,7 +1313,16 @@ func (r *Reporter) AddFabricManagerResult(status string, fabricManagerResult int
 
 // AddHCAResult adds HCA error check results
 func (r *Reporter) AddHCAResult(status string, err error) {
-	details := map[string]interface{}{}
+	r.AddHCAErrorResult(status, 0, 0, nil, err)
+}
+
+// AddHCAErrorResult adds HCA error check results with the MLX5 error counts by severity
+func (r *Reporter) AddHCAErrorResult(status string, criticalCount, warningCount int, criticalErrors []string, err error) {
+	details := map[string]interface{}{
+		"critical_count":  criticalCount,
+		"warning_count":   warningCount,
+		"critical_errors": criticalErrors,
+	}
 	r.AddResult("hca_error_check", status, details, err)
 }
 
@@ -494,13 +1334,34 @@ func (r *Reporter) AddMissingInterfaceResult(status string, missingCount int, er
 	r.AddResult("missing_interface_check", status, details, err)
 }
 
+// AddMissingInterfaceDetailsResult adds missing interface check results with the comparison of
+// the expected and found network interfaces
+func (r *Reporter) AddMissingInterfaceDetailsResult(status string, missingCount, expectedCount, foundCount int, missingRDMA, missingVCN, extra []string, err error) {
+	details := map[string]interface{}{
+		"missing_count":           missingCount,
+		"expected_count":          expectedCount,
+		"found_count":             foundCount,
+		"missing_rdma_interfaces": missingRDMA,
+		"missing_vcn_interfaces":  missingVCN,
+		"extra_interfaces":        extra,
+	}
+	r.AddResult("missing_interface_check", status, details, err)
+}
+
 // AddGPUXIDResult adds GPU XID error check test results
 func (r *Reporter) AddGPUXIDResult(status string, xidResult interface{}, err error) {
+	r.AddGPUXIDDetailsResult(status, xidResult, nil, err)
+}
+
+// AddGPUXIDDetailsResult adds GPU XID error check test results along with the count and
+// severity of every detected XID code
+func (r *Reporter) AddGPUXIDDetailsResult(status string, xidResult interface{}, breakdown []XIDCodeBreakdown, err error) {
 	details := map[string]interface{}{}
 	if xidResult != nil {
-		details = map[string]interface{}{
-			"xid_result": xidResult,
-		}
+		details["xid_result"] = xidResult
+	}
+	if len(breakdown) > 0 {
+		details["xid_breakdown"] = breakdown
 	}
 	r.AddResult("gpu_xid_check", status, details, err)
 }
@@ -524,1463 +1385,5474 @@ func (r *Reporter) AddRowRemapResult(status string, err error, failureCount int)
 	r.AddResult("row_remap_error_check", status, details, err)
 }
 
-// GenerateReport generates the final JSON report
-func (r *Reporter) GenerateReport() (*ReportOutput, error) {
-	r.mutex.RLock()
-	defer r.mutex.RUnlock()
-
-	report := &ReportOutput{
-		Localhost: HostResults{},
+// AddGPUVBIOSResult adds GPU VBIOS version check test results
+func (r *Reporter) AddGPUVBIOSResult(status string, vbiosVersions interface{}, err error) {
+	details := map[string]interface{}{}
+	if vbiosVersions != nil {
+		details = map[string]interface{}{
+			"vbios_versions": vbiosVersions,
+		}
 	}
+	r.AddResult("gpu_vbios_check", status, details, err)
+}
 
-	// Process GPU results
-	if result, exists := r.results["gpu_count_check"]; exists {
-		gpuCount := 0
-		if countVal, ok := result.Details["gpu_count"]; ok {
-			if count, ok := countVal.(int); ok {
-				gpuCount = count
-			}
-		}
-		gpuResult := GPUTestResult{
-			Status:       result.Status,
-			GPUCount:     gpuCount,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.GPUCountCheck = []GPUTestResult{gpuResult}
+// AddHugepagesResult adds hugepages configuration check test results
+func (r *Reporter) AddHugepagesResult(status string, pages2MB int, pages1GB int, persistent bool, err error) {
+	details := map[string]interface{}{
+		"pages_2mb":  pages2MB,
+		"pages_1gb":  pages1GB,
+		"persistent": persistent,
 	}
+	r.AddResult("hugepages_check", status, details, err)
+}
 
-	// Process GPU Mode Check results
-	if result, exists := r.results["gpu_mode_check"]; exists {
-		message := ""
-		if msgVal, ok := result.Details["message"]; ok {
-			if msg, ok := msgVal.(string); ok {
-				message = msg
-			}
+// AddNUMAAffinityResult adds GPU NUMA affinity check test results
+func (r *Reporter) AddNUMAAffinityResult(status string, gpuAffinity interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpuAffinity != nil {
+		details = map[string]interface{}{
+			"gpu_affinity": gpuAffinity,
 		}
+	}
+	r.AddResult("numa_affinity_check", status, details, err)
+}
 
-		var enabledGPUIndexes []string
-		if indexesVal, ok := result.Details["enabled_gpu_indexes"]; ok {
-			if indexes, ok := indexesVal.([]string); ok {
-				enabledGPUIndexes = indexes
-			}
+// AddIBSMResult adds InfiniBand subnet manager check test results
+func (r *Reporter) AddIBSMResult(status string, ports interface{}, err error) {
+	details := map[string]interface{}{}
+	if ports != nil {
+		details = map[string]interface{}{
+			"ports": ports,
 		}
+	}
+	r.AddResult("ib_sm_check", status, details, err)
+}
 
-		gpuModeResult := GPUModeTestResult{
-			Status:            result.Status,
-			Message:           message,
-			EnabledGPUIndexes: enabledGPUIndexes,
-			TimestampUTC:      result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.GPUModeCheck = []GPUModeTestResult{gpuModeResult}
+// AddTimeSyncResult adds time synchronization check test results
+func (r *Reporter) AddTimeSyncResult(status string, synchronized bool, offsetMs float64, referenceServer string, stratum int, err error) {
+	details := map[string]interface{}{
+		"synchronized":     synchronized,
+		"offset_ms":        offsetMs,
+		"reference_server": referenceServer,
+		"stratum":          stratum,
 	}
+	r.AddResult("time_sync_check", status, details, err)
+}
 
-	// Process PCIe results
-	if result, exists := r.results["pcie_error_check"]; exists {
-		pcieResult := PCIeTestResult{
-			Status:       result.Status,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+// AddKernelModuleResult adds kernel module version compatibility check test results
+func (r *Reporter) AddKernelModuleResult(status string, modules interface{}, err error) {
+	details := map[string]interface{}{}
+	if modules != nil {
+		details = map[string]interface{}{
+			"modules": modules,
 		}
-		report.Localhost.PCIeErrorCheck = []PCIeTestResult{pcieResult}
 	}
+	r.AddResult("kernel_module_check", status, details, err)
+}
 
-	// Process PCIe width missing lanes results
-	if result, exists := r.results["pcie_width_missing_lanes_check"]; exists {
-		var gpuWidthCounts, rdmaWidthCounts, gpuSpeedCounts, rdmaSpeedCounts map[string]int
-		var stateErrors []string
-
-		if gpuCountsVal, ok := result.Details["gpu_width_counts"]; ok {
-			if gpuCounts, ok := gpuCountsVal.(map[string]int); ok {
-				gpuWidthCounts = gpuCounts
-			}
+// AddSystemdServiceResult adds systemd service health check test results
+func (r *Reporter) AddSystemdServiceResult(status string, services interface{}, err error) {
+	details := map[string]interface{}{}
+	if services != nil {
+		details = map[string]interface{}{
+			"services": services,
 		}
+	}
+	r.AddResult("systemd_service_check", status, details, err)
+}
 
-		if rdmaCountsVal, ok := result.Details["rdma_width_counts"]; ok {
-			if rdmaCounts, ok := rdmaCountsVal.(map[string]int); ok {
-				rdmaWidthCounts = rdmaCounts
-			}
-		}
+// AddIOMMUResult adds IOMMU configuration check test results
+func (r *Reporter) AddIOMMUResult(status string, enabled bool, mode string, gpuIOMMUGroups int, err error) {
+	details := map[string]interface{}{
+		"enabled":          enabled,
+		"mode":             mode,
+		"gpu_iommu_groups": gpuIOMMUGroups,
+	}
+	r.AddResult("iommu_check", status, details, err)
+}
 
-		if gpuSpeedCountsVal, ok := result.Details["gpu_speed_counts"]; ok {
-			if gpuSpeeds, ok := gpuSpeedCountsVal.(map[string]int); ok {
-				gpuSpeedCounts = gpuSpeeds
-			}
-		}
+// AddNVLinkTopologyResult adds NVLink topology check test results
+func (r *Reporter) AddNVLinkTopologyResult(status string, matrix [][]string, missingConnections []string, err error) {
+	details := map[string]interface{}{}
+	if matrix != nil {
+		details["matrix"] = matrix
+	}
+	if len(missingConnections) > 0 {
+		details["missing_connections"] = missingConnections
+	}
+	r.AddResult("nvlink_topology_check", status, details, err)
+}
 
-		if rdmaSpeedCountsVal, ok := result.Details["rdma_speed_counts"]; ok {
-			if rdmaSpeeds, ok := rdmaSpeedCountsVal.(map[string]int); ok {
-				rdmaSpeedCounts = rdmaSpeeds
-			}
+// AddPCIeReplayResult adds PCIe replay counter check test results
+func (r *Reporter) AddPCIeReplayResult(status string, gpus interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpus != nil {
+		details = map[string]interface{}{
+			"gpus": gpus,
 		}
+	}
+	r.AddResult("pcie_replay_check", status, details, err)
+}
 
-		if stateErrorsVal, ok := result.Details["state_errors"]; ok {
-			if stateErrs, ok := stateErrorsVal.([]string); ok {
-				stateErrors = stateErrs
-			}
+// AddRDMACreditResult adds RDMA credit flow check test results
+func (r *Reporter) AddRDMACreditResult(status string, ports interface{}, err error) {
+	details := map[string]interface{}{}
+	if ports != nil {
+		details = map[string]interface{}{
+			"ports": ports,
 		}
+	}
+	r.AddResult("rdma_credit_check", status, details, err)
+}
 
-		pcieWidthResult := PCIeWidthTestResult{
-			Status:          result.Status,
-			GPUWidthCounts:  gpuWidthCounts,
-			RDMAWidthCounts: rdmaWidthCounts,
-			GPUSpeedCounts:  gpuSpeedCounts,
-			RDMASpeedCounts: rdmaSpeedCounts,
-			StateErrors:     stateErrors,
-			TimestampUTC:    result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.PCIeWidthMissingLanesCheck = []PCIeWidthTestResult{pcieWidthResult}
+// AddRDMAMTUResult adds RDMA interface MTU check test results
+func (r *Reporter) AddRDMAMTUResult(status string, interfaces interface{}, failedInterfaces []string, err error) {
+	details := map[string]interface{}{}
+	if interfaces != nil {
+		details["interfaces"] = interfaces
+	}
+	if len(failedInterfaces) > 0 {
+		details["failed_interfaces"] = strings.Join(failedInterfaces, ",")
 	}
+	r.AddResult("rdma_mtu_check", status, details, err)
+}
 
-	// Process RDMA results
-	if result, exists := r.results["rdma_nic_count"]; exists {
-		rdmaCount := 0
-		if countVal, ok := result.Details["rdma_nic_count"]; ok {
-			if count, ok := countVal.(int); ok {
-				rdmaCount = count
-			}
-		}
-		rdmaResult := RDMATestResult{
-			Status:       result.Status,
-			NumRDMANics:  rdmaCount,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.RDMANicsCount = []RDMATestResult{rdmaResult}
+// AddRDMATopologyResult adds RDMA topology check test results
+func (r *Reporter) AddRDMATopologyResult(status string, expected, actual, missing, extra []string, err error) {
+	details := map[string]interface{}{
+		"expected_devices": expected,
+		"actual_devices":   actual,
+		"missing_devices":  missing,
+		"extra_devices":    extra,
 	}
+	r.AddResult("rdma_topology_check", status, details, err)
+}
 
-	// Process Network results
-	if result, exists := r.results["rx_discards_check"]; exists {
-		interfaceCount := 0
-		failedCount := 0
-		failedInterfaces := ""
+// AddBIOSSettingsResult adds BIOS settings check test results
+func (r *Reporter) AddBIOSSettingsResult(status string, biosVersion, cpuModel string, hyperthreadingEnabled, versionSupported bool, err error) {
+	details := map[string]interface{}{
+		"bios_version":           biosVersion,
+		"cpu_model":              cpuModel,
+		"hyperthreading_enabled": hyperthreadingEnabled,
+		"version_supported":      versionSupported,
+	}
+	r.AddResult("bios_settings_check", status, details, err)
+}
 
-		if countVal, ok := result.Details["interface_count"]; ok {
-			if count, ok := countVal.(int); ok {
-				interfaceCount = count
-			}
+// AddGPUInfoROMResult adds GPU InfoROM check test results
+func (r *Reporter) AddGPUInfoROMResult(status string, inforomVersions interface{}, err error) {
+	details := map[string]interface{}{}
+	if inforomVersions != nil {
+		details = map[string]interface{}{
+			"inforom_versions": inforomVersions,
 		}
+	}
+	r.AddResult("gpu_inforom_check", status, details, err)
+}
 
-		if failedVal, ok := result.Details["failed_count"]; ok {
-			if count, ok := failedVal.(int); ok {
-				failedCount = count
-			}
+// AddGPURowRemapResult adds GPU row remap check test results
+func (r *Reporter) AddGPURowRemapResult(status string, gpuRowRemaps interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpuRowRemaps != nil {
+		details = map[string]interface{}{
+			"gpu_row_remaps": gpuRowRemaps,
 		}
+	}
+	r.AddResult("gpu_row_remap_check", status, details, err)
+}
 
-		if failedInterfacesInResult, ok := result.Details["failed_interfaces"]; ok {
-			if failedInterfacesInResultStr, ok := failedInterfacesInResult.(string); ok {
-				failedInterfaces = failedInterfacesInResultStr
-			}
-		}
+// AddCPUGovernorResult adds CPU scaling governor check test results
+func (r *Reporter) AddCPUGovernorResult(status string, detectedGovernor, expectedGovernor string, differingCPUs []string, err error) {
+	details := map[string]interface{}{
+		"detected_governor": detectedGovernor,
+		"expected_governor": expectedGovernor,
+		"differing_cpus":    differingCPUs,
+	}
+	r.AddResult("cpu_governor_check", status, details, err)
+}
 
-		networkResult := RXDiscardsCheckTestResult{
-			InterfaceCount:   interfaceCount,
-			FailedCount:      failedCount,
-			Status:           result.Status,
-			FailedInterfaces: failedInterfaces,
-			TimestampUTC:     result.Timestamp.UTC().Format(time.RFC3339),
+// AddGPUFirmwareResult adds GPU GSP firmware version check test results
+func (r *Reporter) AddGPUFirmwareResult(status string, firmwareVersions interface{}, err error) {
+	details := map[string]interface{}{}
+	if firmwareVersions != nil {
+		details = map[string]interface{}{
+			"firmware_versions": firmwareVersions,
 		}
-		report.Localhost.RXDiscardsCheck = []RXDiscardsCheckTestResult{networkResult}
 	}
+	r.AddResult("gpu_firmware_check", status, details, err)
+}
 
-	// Process GID Index results
-	if result, exists := r.results["gid_index_check"]; exists {
-		var invalidIndexes []int
-		if indexesVal, ok := result.Details["invalid_indexes"]; ok {
-			if indexes, ok := indexesVal.([]int); ok {
-				invalidIndexes = indexes
-			}
-		}
-		gidResult := GIDIndexTestResult{
-			Status:         result.Status,
-			InvalidIndexes: invalidIndexes,
-			TimestampUTC:   result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.GIDIndexCheck = []GIDIndexTestResult{gidResult}
+// AddGPUP2PBWResult adds GPU peer-to-peer bandwidth check test results
+func (r *Reporter) AddGPUP2PBWResult(status string, bandwidthMatrix [][]float64, lowBandwidthPairs []string, minBandwidthGBps float64, err error) {
+	details := map[string]interface{}{
+		"min_bandwidth_gbps": minBandwidthGBps,
+	}
+	if bandwidthMatrix != nil {
+		details["bandwidth_matrix"] = bandwidthMatrix
 	}
+	if len(lowBandwidthPairs) > 0 {
+		details["low_bandwidth_pairs"] = lowBandwidthPairs
+	}
+	r.AddResult("gpu_p2p_bw_check", status, details, err)
+}
 
-	// Process Link Check results
-	if result, exists := r.results["link_check"]; exists {
-		var links interface{}
-		if linksVal, ok := result.Details["links"]; ok {
-			links = linksVal
-		}
+// AddCPUIsolationResult adds CPU isolation check test results
+func (r *Reporter) AddCPUIsolationResult(status string, isolatedCPUCount, expectedIsolatedCPUCount int, nohzFullMatches bool, err error) {
+	details := map[string]interface{}{
+		"isolated_cpu_count":          isolatedCPUCount,
+		"expected_isolated_cpu_count": expectedIsolatedCPUCount,
+		"nohz_full_matches":           nohzFullMatches,
+	}
+	r.AddResult("cpu_isolation_check", status, details, err)
+}
 
-		linkResult := LinkTestResult{
-			Status:       result.Status,
-			Links:        links,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.LinkCheck = []LinkTestResult{linkResult}
+// AddPCIeGenResult adds PCIe generation and width check test results
+func (r *Reporter) AddPCIeGenResult(status string, devices interface{}, err error) {
+	details := map[string]interface{}{}
+	if devices != nil {
+		details["devices"] = devices
 	}
+	r.AddResult("pcie_gen_check", status, details, err)
+}
 
-	// Process Ethernet link check results
-	if result, exists := r.results["eth_link_check"]; exists {
-		var ethLinks interface{}
-		if ethLinksVal, ok := result.Details["eth_links"]; ok {
-			ethLinks = ethLinksVal
-		}
+// AddGPUCStateResult adds GPU C-state and power management check test results
+func (r *Reporter) AddGPUCStateResult(status string, gpus interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpus != nil {
+		details["gpus"] = gpus
+	}
+	r.AddResult("gpu_cstate_check", status, details, err)
+}
 
-		ethLinkResult := EthLinkTestResult{
-			Status:       result.Status,
-			EthLinks:     ethLinks,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.EthLinkCheck = []EthLinkTestResult{ethLinkResult}
+// AddNFSMountResult adds NFS mount check test results
+func (r *Reporter) AddNFSMountResult(status string, mounts interface{}, err error) {
+	details := map[string]interface{}{}
+	if mounts != nil {
+		details["mounts"] = mounts
 	}
+	r.AddResult("nfs_mount_check", status, details, err)
+}
 
-	// Process Auth check results
-	if result, exists := r.results["auth_check"]; exists {
-		var interfaces interface{}
-		if interfacesVal, ok := result.Details["interfaces"]; ok {
-			interfaces = interfacesVal
-		}
-
-		authResult := AuthCheckTestResult{
-			Status:       result.Status,
-			Interfaces:   interfaces,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.AuthCheck = []AuthCheckTestResult{authResult}
+// AddBERTrendResult adds BER trend check test results
+func (r *Reporter) AddBERTrendResult(status string, interfaces interface{}, err error) {
+	details := map[string]interface{}{}
+	if interfaces != nil {
+		details["interfaces"] = interfaces
 	}
+	r.AddResult("ber_trend_check", status, details, err)
+}
 
-	// Process SRAM Error Check results
-	if result, exists := r.results["sram_error_check"]; exists {
-		maxUncorrectable := 0
-		if uncorrectableVal, ok := result.Details["max_uncorrectable"]; ok {
-			if uncorrectable, ok := uncorrectableVal.(int); ok {
-				maxUncorrectable = uncorrectable
-			}
-		}
-
-		maxCorrectable := 0
-		if correctableVal, ok := result.Details["max_correctable"]; ok {
-			if correctable, ok := correctableVal.(int); ok {
-				maxCorrectable = correctable
-			}
-		}
-
-		sramResult := SRAMErrorTestResult{
-			Status:           result.Status,
-			MaxUncorrectable: maxUncorrectable,
-			MaxCorrectable:   maxCorrectable,
-			TimestampUTC:     result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.SRAMErrorCheck = []SRAMErrorTestResult{sramResult}
+// AddIBCableResult adds IB cable check test results
+func (r *Reporter) AddIBCableResult(status string, cables interface{}, err error) {
+	details := map[string]interface{}{}
+	if cables != nil {
+		details["cables"] = cables
 	}
+	r.AddResult("ib_cable_check", status, details, err)
+}
 
-	// Process GPU Driver Check results
-	if result, exists := r.results["gpu_driver_check"]; exists {
-		driverVersion := ""
-		if versionVal, ok := result.Details["driver_version"]; ok {
-			if version, ok := versionVal.(string); ok {
-				driverVersion = version
-			}
-		}
-		gpuDriverResult := GPUDriverTestResult{
-			Status:        result.Status,
-			DriverVersion: driverVersion,
-			TimestampUTC:  result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.GPUDriverCheck = []GPUDriverTestResult{gpuDriverResult}
+// AddPCIeReBARResult adds PCIe resizable BAR check test results
+func (r *Reporter) AddPCIeReBARResult(status string, gpus interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpus != nil {
+		details["gpus"] = gpus
 	}
+	r.AddResult("pcie_rebar_check", status, details, err)
+}
 
-	// Process GPU Clock Check results
-	if result, exists := r.results["gpu_clk_check"]; exists {
-		message := ""
-		if messageVal, ok := result.Details["message"]; ok {
-			if msg, ok := messageVal.(string); ok {
-				message = msg
-			}
-		}
-		gpuClockResult := GPUClockTestResult{
-			Status:       result.Status,
-			Message:      message,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.GPUClockCheck = []GPUClockTestResult{gpuClockResult}
+// AddGPUIdleResult adds GPU idle pre-check test results
+func (r *Reporter) AddGPUIdleResult(status string, gpus interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpus != nil {
+		details["gpus"] = gpus
 	}
+	r.AddResult("gpu_idle_check", status, details, err)
+}
 
-	// Process PeerMem Module results
-	if result, exists := r.results["peermem_module_check"]; exists {
-		moduleLoaded := false
-		if loadedVal, ok := result.Details["module_loaded"]; ok {
-			if loaded, ok := loadedVal.(bool); ok {
-				moduleLoaded = loaded
-			}
-		}
-		peerMemResult := PeerMemTestResult{
-			Status:       result.Status,
-			ModuleLoaded: moduleLoaded,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.PeerMemModuleCheck = []PeerMemTestResult{peerMemResult}
+// AddPCIeDeviceCountResult adds PCIe device enumeration check test results
+func (r *Reporter) AddPCIeDeviceCountResult(status string, expectedGPUs, foundGPUs, expectedNICs, foundNICs int, missingGPUBDFs, missingNICBDFs []string, err error) {
+	details := map[string]interface{}{
+		"expected_gpu_count": expectedGPUs,
+		"found_gpu_count":    foundGPUs,
+		"expected_nic_count": expectedNICs,
+		"found_nic_count":    foundNICs,
+		"missing_gpu_bdfs":   missingGPUBDFs,
+		"missing_nic_bdfs":   missingNICBDFs,
 	}
+	r.AddResult("pcie_device_count_check", status, details, err)
+}
 
-	// Process NVLink Speed Check results
-	if result, exists := r.results["nvlink_speed_check"]; exists {
-		var nvlinks interface{}
-		if nvlinksVal, ok := result.Details["nvlinks"]; ok {
-			nvlinks = nvlinksVal
-		}
-
-		nvlinkResult := NVLinkTestResult{
-			Status:       result.Status,
-			NVLinks:      nvlinks,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.NVLinkSpeedCheck = []NVLinkTestResult{nvlinkResult}
+// AddGPUFirmwareUpdateResult adds GPU firmware update check test results
+func (r *Reporter) AddGPUFirmwareUpdateResult(status, currentVersion, latestVersion string, updateRecommended bool, err error) {
+	details := map[string]interface{}{
+		"current_version":    currentVersion,
+		"latest_version":     latestVersion,
+		"update_recommended": updateRecommended,
 	}
+	r.AddResult("gpu_firmware_update_check", status, details, err)
+}
 
-	// Process Eth0 Presence Check results
-	if result, exists := r.results["eth0_presence_check"]; exists {
-		eth0Present := false
-		if presentVal, ok := result.Details["eth0_present"]; ok {
-			if present, ok := presentVal.(bool); ok {
-				eth0Present = present
-			}
-		}
-		eth0Result := Eth0PresenceTestResult{
-			Status:       result.Status,
-			Eth0Present:  eth0Present,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.Eth0PresenceCheck = []Eth0PresenceTestResult{eth0Result}
+// AddGPUResetResult adds GPU reset history check test results
+func (r *Reporter) AddGPUResetResult(status string, gpus interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpus != nil {
+		details["gpus"] = gpus
 	}
+	r.AddResult("gpu_reset_check", status, details, err)
+}
 
-	// Process CDFP Cable Check results
-	if result, exists := r.results["cdfp_cable_check"]; exists {
-		var cdfpResult interface{}
-		if cdfpVal, ok := result.Details["cdfp_result"]; ok {
-			cdfpResult = cdfpVal
-		}
+// AddRDMAInterfaceSpeedResult adds RDMA interface speed check test results
+func (r *Reporter) AddRDMAInterfaceSpeedResult(status string, interfaces interface{}, failedInterfaces []string, err error) {
+	details := map[string]interface{}{}
+	if interfaces != nil {
+		details["interfaces"] = interfaces
+	}
+	if len(failedInterfaces) > 0 {
+		details["failed_interfaces"] = strings.Join(failedInterfaces, ",")
+	}
+	r.AddResult("rdma_interface_speed_check", status, details, err)
+}
 
-		cdfpCableResult := CDFPCableCheckTestResult{
-			Status:       result.Status,
-			CDFPResult:   cdfpResult,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.CDFPCableCheck = []CDFPCableCheckTestResult{cdfpCableResult}
+// AddIBPortStateResult adds IB port state check test results
+func (r *Reporter) AddIBPortStateResult(status string, ports interface{}, failedPorts []string, err error) {
+	details := map[string]interface{}{}
+	if ports != nil {
+		details["ports"] = ports
 	}
+	if len(failedPorts) > 0 {
+		details["failed_ports"] = strings.Join(failedPorts, ",")
+	}
+	r.AddResult("ib_port_state_check", status, details, err)
+}
 
-	// Process Fabric Manager Check results
-	if result, exists := r.results["fabricmanager_check"]; exists {
-		var fabricManagerResult interface{}
-		if fabricVal, ok := result.Details["fabricmanager_result"]; ok {
-			fabricManagerResult = fabricVal
-		}
+// AddNICFirmwareResult adds NIC firmware version check test results
+func (r *Reporter) AddNICFirmwareResult(status string, interfaces interface{}, err error) {
+	details := map[string]interface{}{}
+	if interfaces != nil {
+		details["interfaces"] = interfaces
+	}
+	r.AddResult("nic_firmware_check", status, details, err)
+}
 
-		fabricManagerCheckResult := FabricManagerTestResult{
-			Status:              result.Status,
-			FabricManagerResult: fabricManagerResult,
-			TimestampUTC:        result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.FabricManagerCheck = []FabricManagerTestResult{fabricManagerCheckResult}
+// AddRDMAPCIMappingResult adds RDMA device to PCI address mapping check test results
+func (r *Reporter) AddRDMAPCIMappingResult(status string, expectedMapping, actualMapping map[string]string, mismatchedBDFs []string, err error) {
+	details := map[string]interface{}{
+		"expected_mapping": expectedMapping,
+		"actual_mapping":   actualMapping,
+		"mismatched_bdfs":  mismatchedBDFs,
 	}
+	r.AddResult("rdma_pci_mapping_check", status, details, err)
+}
 
-	// Process HCA Error Check results
-	if result, exists := r.results["hca_error_check"]; exists {
-		hcaResult := HCAErrorTestResult{
-			Status:       result.Status,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.HCAErrorCheck = []HCAErrorTestResult{hcaResult}
+// AddGPUPCIeTopoResult adds GPU peer-to-peer accessibility check test results
+func (r *Reporter) AddGPUPCIeTopoResult(status string, matrix [][]string, unavailablePairs, nonNVLinkPairs []string, err error) {
+	details := map[string]interface{}{}
+	if matrix != nil {
+		details["matrix"] = matrix
+	}
+	if len(unavailablePairs) > 0 {
+		details["unavailable_pairs"] = unavailablePairs
 	}
+	if len(nonNVLinkPairs) > 0 {
+		details["non_nvlink_pairs"] = nonNVLinkPairs
+	}
+	r.AddResult("gpu_pcie_topo_check", status, details, err)
+}
 
-	// Process Missing Interface Check results
-	if result, exists := r.results["missing_interface_check"]; exists {
-		missingCount := 0
-		if count, ok := result.Details["missing_count"].(int); ok {
-			missingCount = count
-		}
-		missingInterfaceResult := MissingInterfaceTestResult{
-			Status:       result.Status,
-			MissingCount: missingCount,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.MissingInterfaceCheck = []MissingInterfaceTestResult{missingInterfaceResult}
+// AddMLXConfigResult adds mlxconfig parameter check test results
+func (r *Reporter) AddMLXConfigResult(status string, parameters []MLXConfigParameterResult, err error) {
+	details := map[string]interface{}{}
+	if len(parameters) > 0 {
+		details["parameters"] = parameters
 	}
+	r.AddResult("mlxconfig_check", status, details, err)
+}
 
-	// Process GPU XID check results
-	if result, exists := r.results["gpu_xid_check"]; exists {
-		var xidResult interface{}
-		if xidVal, ok := result.Details["xid_result"]; ok {
-			xidResult = xidVal
-		}
+// AddGPUPowerRailResult adds GPU power rail check test results
+func (r *Reporter) AddGPUPowerRailResult(status string, tdpWatts float64, readings interface{}, err error) {
+	details := map[string]interface{}{
+		"tdp_watts": tdpWatts,
+	}
+	if readings != nil {
+		details["readings"] = readings
+	}
+	r.AddResult("gpu_power_rail_check", status, details, err)
+}
 
-		gpuXIDCheckResult := GPUXIDTestResult{
-			Status:       result.Status,
-			XIDResult:    xidResult,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
+// AddGPUTemperatureResult adds GPU temperature check test results
+func (r *Reporter) AddGPUTemperatureResult(status string, gpus interface{}, thresholds interface{}, err error) {
+	details := map[string]interface{}{}
+	if gpus != nil {
+		details["gpus"] = gpus
+	}
+	if thresholds != nil {
+		details["thresholds"] = thresholds
+	}
+	r.AddResult("gpu_temperature_check", status, details, err)
+}
 
-		// Add message from result details if available
-		if xidResultObj, ok := xidResult.(map[string]interface{}); ok {
-			if message, ok := xidResultObj["message"].(string); ok {
-				gpuXIDCheckResult.Message = message
-			}
-		}
+// AddRoCEVLANResult adds RoCE VLAN tagging check test results
+func (r *Reporter) AddRoCEVLANResult(status string, interfaces interface{}, err error) {
+	details := map[string]interface{}{}
+	if interfaces != nil {
+		details["interfaces"] = interfaces
+	}
+	r.AddResult("roce_vlan_check", status, details, err)
+}
 
-		report.Localhost.GPUXIDCheck = []GPUXIDTestResult{gpuXIDCheckResult}
+// AddIBSwitchPortResult adds InfiniBand switch port check test results
+func (r *Reporter) AddIBSwitchPortResult(status string, ports []IBSwitchPortResult, err error) {
+	details := map[string]interface{}{}
+	if len(ports) > 0 {
+		details["ports"] = ports
 	}
+	r.AddResult("ib_switch_port_check", status, details, err)
+}
 
-	// Process MAX_ACC check results
-	if result, exists := r.results["max_acc_check"]; exists {
-		var maxAccResult interface{}
-		if maxAccVal, ok := result.Details["max_acc_result"]; ok {
-			maxAccResult = maxAccVal
-		}
+// AddGPUCPUBWResult adds GPU-CPU bandwidth check test results
+func (r *Reporter) AddGPUCPUBWResult(status string, gpus interface{}, minBandwidthGBps float64, err error) {
+	details := map[string]interface{}{
+		"min_bandwidth_gbps": minBandwidthGBps,
+	}
+	if gpus != nil {
+		details["gpus"] = gpus
+	}
+	r.AddResult("gpu_cpu_bw_check", status, details, err)
+}
 
-		maxAccCheckResult := MaxAccTestResult{
-			Status:       result.Status,
-			MaxAccResult: maxAccResult,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
+// AddFabricManagerLogResult adds fabric manager log check test results
+func (r *Reporter) AddFabricManagerLogResult(status string, warningCount, errorCount, startupWarningCount int, lastError string, recentMessages []string, err error) {
+	details := map[string]interface{}{
+		"warning_count":         warningCount,
+		"error_count":           errorCount,
+		"startup_warning_count": startupWarningCount,
+	}
+	if lastError != "" {
+		details["last_error"] = lastError
+	}
+	if len(recentMessages) > 0 {
+		details["recent_messages"] = recentMessages
+	}
+	r.AddResult("fabricmanager_log_check", status, details, err)
+}
 
-		// Add message from result details if available
-		if maxAccResultObj, ok := maxAccResult.(map[string]interface{}); ok {
-			if message, ok := maxAccResultObj["message"].(string); ok {
-				maxAccCheckResult.Message = message
-			}
-		}
+// AddGPUMemTemperatureResult adds GPU memory temperature check test results
+func (r *Reporter) AddGPUMemTemperatureResult(status string, gpus interface{}, warningC, criticalC int, err error) {
+	details := map[string]interface{}{
+		"gpus":       gpus,
+		"warning_c":  warningC,
+		"critical_c": criticalC,
+	}
+	r.AddResult("gpu_mem_temperature_check", status, details, err)
+}
 
-		report.Localhost.MaxAccCheck = []MaxAccTestResult{maxAccCheckResult}
+// AddPCIeVendorResult adds PCIe vendor check test results
+func (r *Reporter) AddPCIeVendorResult(status string, expectedBDFs []string, devices interface{}, mismatches []string, err error) {
+	details := map[string]interface{}{
+		"expected_bdfs": expectedBDFs,
+		"devices":       devices,
+		"mismatches":    mismatches,
 	}
+	r.AddResult("pcie_vendor_check", status, details, err)
+}
 
-	// Process Row Remap Error Check results
-	if result, exists := r.results["row_remap_error_check"]; exists {
-		failureCount := 0
-		if count, ok := result.Details["failure_count"].(int); ok {
-			failureCount = count
-		}
-		rowRemapResult := RowRemapErrorTestResult{
-			Status:       result.Status,
-			FailureCount: failureCount,
-			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
-		}
-		report.Localhost.RowRemapErrorCheck = []RowRemapErrorTestResult{rowRemapResult}
+// AddRDMARetryCounterResult adds RDMA retry counter check test results
+func (r *Reporter) AddRDMARetryCounterResult(status string, ports interface{}, maxRetryRatePerSec float64, err error) {
+	details := map[string]interface{}{
+		"ports":                  ports,
+		"max_retry_rate_per_sec": maxRetryRatePerSec,
 	}
+	r.AddResult("rdma_retry_counter_check", status, details, err)
+}
 
-	return report, nil
+// AddNVLinkBWResult adds NVLink bandwidth check test results
+func (r *Reporter) AddNVLinkBWResult(status string, busBandwidthGBps, expectedBusBandwidthGBps float64, algorithm string, err error) {
+	details := map[string]interface{}{
+		"bus_bandwidth_gbps":          busBandwidthGBps,
+		"expected_bus_bandwidth_gbps": expectedBusBandwidthGBps,
+		"algorithm":                   algorithm,
+	}
+	r.AddResult("nvlink_bw_check", status, details, err)
 }
 
-// WriteReport writes the report to the configured output
-func (r *Reporter) WriteReport() error {
-	// Use default format (json) for backward compatibility
-	return r.WriteReportWithFormat("json")
+// AddGPUMIGProfileResult adds GPU MIG profile check test results
+func (r *Reporter) AddGPUMIGProfileResult(status string, expectedProfiles []string, gpus interface{}, missingProfiles, extraProfiles []string, err error) {
+	details := map[string]interface{}{
+		"expected_profiles": expectedProfiles,
+		"gpus":              gpus,
+		"missing_profiles":  missingProfiles,
+		"extra_profiles":    extraProfiles,
+	}
+	r.AddResult("gpu_mig_profile_check", status, details, err)
 }
 
-// WriteReportWithFormat writes the report with the specified format
-func (r *Reporter) WriteReportWithFormat(format string) error {
+// AddNetworkAuthCheckResult adds network authentication check test results
+func (r *Reporter) AddNetworkAuthCheckResult(status string, interfaces interface{}, failedInterfaces []string, err error) {
+	details := map[string]interface{}{
+		"interfaces":        interfaces,
+		"failed_interfaces": failedInterfaces,
+	}
+	r.AddResult("network_auth_check", status, details, err)
+}
+
+// AddDCGMFieldResult adds DCGM field check test results
+func (r *Reporter) AddDCGMFieldResult(status string, fields map[string]interface{}, failedFields []string, err error) {
+	details := map[string]interface{}{
+		"fields":        fields,
+		"failed_fields": failedFields,
+	}
+	r.AddResult("dcgm_field_check", status, details, err)
+}
+
+// AddNUMADistanceResult adds NUMA distance check test results
+func (r *Reporter) AddNUMADistanceResult(status string, actualMatrix, expectedMatrix [][]int, mismatches []string, err error) {
+	details := map[string]interface{}{
+		"actual_matrix":   actualMatrix,
+		"expected_matrix": expectedMatrix,
+		"mismatches":      mismatches,
+	}
+	r.AddResult("numa_distance_check", status, details, err)
+}
+
+// AddCPUTopologyResult adds CPU topology check test results
+func (r *Reporter) AddCPUTopologyResult(status string, sockets, coresPerSocket, logicalCPUs int, hyperThreading bool, err error) {
+	details := map[string]interface{}{
+		"sockets":          sockets,
+		"cores_per_socket": coresPerSocket,
+		"hyper_threading":  hyperThreading,
+		"logical_cpus":     logicalCPUs,
+	}
+	r.AddResult("cpu_topology_check", status, details, err)
+}
+
+// AddMemoryResult adds memory check test results
+func (r *Reporter) AddMemoryResult(status string, totalMemoryGB, availableMemoryGB float64, dimmCount, speedMTs int, memoryType string, emptySlots []string, expectedTotalMemoryGB float64, minSpeedMTs int, err error) {
+	details := map[string]interface{}{
+		"total_memory_gb":          totalMemoryGB,
+		"available_memory_gb":      availableMemoryGB,
+		"dimm_count":               dimmCount,
+		"speed_mts":                speedMTs,
+		"memory_type":              memoryType,
+		"empty_slots":              emptySlots,
+		"expected_total_memory_gb": expectedTotalMemoryGB,
+		"min_speed_mts":            minSpeedMTs,
+	}
+	r.AddResult("memory_check", status, details, err)
+}
+
+// AddGPUFirmwareConsistencyResult adds GPU firmware consistency check test results
+func (r *Reporter) AddGPUFirmwareConsistencyResult(status string, firmwareVersions map[string][]string, err error) {
+	details := map[string]interface{}{}
+	if firmwareVersions != nil {
+		details = map[string]interface{}{
+			"firmware_versions": firmwareVersions,
+		}
+	}
+	r.AddResult("gpu_firmware_consistency_check", status, details, err)
+}
+
+// AddTimeoutResult records a test that did not finish within its timeout as a failure.
+// The test counts as running until TimedOutTestFinished is called.
+func (r *Reporter) AddTimeoutResult(testName string, timeoutSeconds int, err error) {
+	r.mutex.Lock()
+	r.timedOut[testName] = r.generation
+	r.mutex.Unlock()
+
+	details := map[string]interface{}{
+		"timeout":         true,
+		"timeout_seconds": timeoutSeconds,
+	}
+	r.AddResult(testName, "FAIL", details, err)
+}
+
+// IsTimedOutTestRunning reports whether a test that timed out is still running
+func (r *Reporter) IsTimedOutTestRunning(testName string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	_, running := r.timedOut[testName]
+	return running
+}
+
+// TimedOutTestFinished records that a test that timed out has returned
+func (r *Reporter) TimedOutTestFinished(testName string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.timedOut, testName)
+}
+
+// AddSkippedResult records a test that was not run because its dependency failed
+func (r *Reporter) AddSkippedResult(testName, failedDependency string, err error) {
+	details := map[string]interface{}{
+		"skipped":           true,
+		"failed_dependency": failedDependency,
+	}
+	r.AddResult(testName, "SKIP", details, err)
+}
+
+// SetRetryCount records that a test needed retries after transient errors. A test that passed
+// only after retries is downgraded to WARN, since its tools are not reliable.
+func (r *Reporter) SetRetryCount(testName string, retries int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	result, exists := r.results[testName]
+	if !exists || retries <= 0 {
+		return
+	}
+	result.RetryCount = retries
+	if result.Status == "PASS" {
+		result.Status = "WARN"
+	}
+	r.results[testName] = result
+}
+
+// AddCachedResult replays a passing result of an earlier run, as returned by ReportSections,
+// for a test that was not run because its result was cached
+func (r *Reporter) AddCachedResult(testName string, section json.RawMessage) {
+	r.AddResult(testName, "PASS", map[string]interface{}{"cached": true}, nil)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.cached == nil {
+		r.cached = make(map[string]json.RawMessage)
+	}
+	r.cached[testName] = section
+}
+
+// ReportSections returns the report entries of each test keyed by test name, so results
+// can be cached and replayed with AddCachedResult
+func (r *Reporter) ReportSections() (map[string]json.RawMessage, error) {
 	report, err := r.GenerateReport()
 	if err != nil {
-		return fmt.Errorf("failed to generate report: %w", err)
+		return nil, err
 	}
-
-	var output string
-	switch format {
-	case "json":
-		output, err = r.formatJSON(report)
-	case "table":
-		output, err = r.formatTable(report)
-	case "friendly":
-		output, err = r.formatFriendly(report)
-	default:
-		return fmt.Errorf("unsupported output format: %s", format)
+	data, err := json.Marshal(report.Localhost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal results: %w", err)
+	}
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return nil, fmt.Errorf("failed to decode results: %w", err)
 	}
+	return sections, nil
+}
 
+// mergeCachedSections replaces the sections of results with the cached sections of tests
+// that were not run
+func mergeCachedSections(results *HostResults, cached map[string]json.RawMessage) error {
+	data, err := json.Marshal(results)
 	if err != nil {
-		return fmt.Errorf("failed to format report: %w", err)
+		return fmt.Errorf("failed to marshal results: %w", err)
+	}
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return fmt.Errorf("failed to decode results: %w", err)
+	}
+	for testName, section := range cached {
+		sections[testName] = section
 	}
+	data, err = json.Marshal(sections)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cached results: %w", err)
+	}
+	merged := HostResults{}
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return fmt.Errorf("failed to decode cached results: %w", err)
+	}
+	*results = merged
+	return nil
+}
 
-	// Write to file if configured
-	if r.outputFile != "" {
-		if r.appendMode && format == "json" {
-			err = r.appendToFile(report)
-		} else {
-			err = os.WriteFile(r.outputFile, []byte(output), 0644)
+// GenerateReport generates the final JSON report
+func (r *Reporter) GenerateReport() (*ReportOutput, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	report := &ReportOutput{
+		Shape:          r.shape,
+		ConfigShape:    r.configShape,
+		DiagnosticNote: r.note,
+		Localhost:      HostResults{},
+	}
+
+	// Process GPU results
+	if result, exists := r.results["gpu_count_check"]; exists {
+		gpuCount := 0
+		if countVal, ok := result.Details["gpu_count"]; ok {
+			if count, ok := countVal.(int); ok {
+				gpuCount = count
+			}
+		}
+		gpuResult := GPUTestResult{
+			Status:       result.Status,
+			GPUCount:     gpuCount,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
 		}
+		report.Localhost.GPUCountCheck = []GPUTestResult{gpuResult}
+	}
 
-		if err != nil {
-			return fmt.Errorf("failed to write report to file %s: %w", r.outputFile, err)
+	// Process GPU Mode Check results
+	if result, exists := r.results["gpu_mode_check"]; exists {
+		message := ""
+		if msgVal, ok := result.Details["message"]; ok {
+			if msg, ok := msgVal.(string); ok {
+				message = msg
+			}
 		}
-		logger.Infof("Report written to file: %s", r.outputFile)
-	} else {
-		// Write to console if no file specified
-		fmt.Print(output)
+
+		var enabledGPUIndexes []string
+		if indexesVal, ok := result.Details["enabled_gpu_indexes"]; ok {
+			if indexes, ok := indexesVal.([]string); ok {
+				enabledGPUIndexes = indexes
+			}
+		}
+
+		gpuModeResult := GPUModeTestResult{
+			Status:            result.Status,
+			Message:           message,
+			EnabledGPUIndexes: enabledGPUIndexes,
+			TimestampUTC:      result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUModeCheck = []GPUModeTestResult{gpuModeResult}
 	}
 
-	return nil
-}
+	// Process PCIe results
+	if result, exists := r.results["pcie_error_check"]; exists {
+		pcieResult := PCIeTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.PCIeErrorCheck = []PCIeTestResult{pcieResult}
+	}
 
-// appendToFile appends the current test results to an existing file
-func (r *Reporter) appendToFile(currentReport *ReportOutput) error {
-	var appendedReport AppendedReport
+	// Process PCIe width missing lanes results
+	if result, exists := r.results["pcie_width_missing_lanes_check"]; exists {
+		var gpuWidthCounts, rdmaWidthCounts, gpuSpeedCounts, rdmaSpeedCounts map[string]int
+		var stateErrors, downgradedDevices []string
 
-	// Try to read existing file
-	if _, err := os.Stat(r.outputFile); err == nil {
-		// File exists, read it
-		existingData, err := os.ReadFile(r.outputFile)
-		if err != nil {
-			return fmt.Errorf("failed to read existing file: %w", err)
+		if gpuCountsVal, ok := result.Details["gpu_width_counts"]; ok {
+			if gpuCounts, ok := gpuCountsVal.(map[string]int); ok {
+				gpuWidthCounts = gpuCounts
+			}
+		}
+
+		if rdmaCountsVal, ok := result.Details["rdma_width_counts"]; ok {
+			if rdmaCounts, ok := rdmaCountsVal.(map[string]int); ok {
+				rdmaWidthCounts = rdmaCounts
+			}
+		}
+
+		if gpuSpeedCountsVal, ok := result.Details["gpu_speed_counts"]; ok {
+			if gpuSpeeds, ok := gpuSpeedCountsVal.(map[string]int); ok {
+				gpuSpeedCounts = gpuSpeeds
+			}
+		}
+
+		if rdmaSpeedCountsVal, ok := result.Details["rdma_speed_counts"]; ok {
+			if rdmaSpeeds, ok := rdmaSpeedCountsVal.(map[string]int); ok {
+				rdmaSpeedCounts = rdmaSpeeds
+			}
+		}
+
+		if stateErrorsVal, ok := result.Details["state_errors"]; ok {
+			if stateErrs, ok := stateErrorsVal.([]string); ok {
+				stateErrors = stateErrs
+			}
+		}
+
+		if downgradedVal, ok := result.Details["downgraded_devices"]; ok {
+			if downgraded, ok := downgradedVal.([]string); ok {
+				downgradedDevices = downgraded
+			}
+		}
+
+		pcieWidthResult := PCIeWidthTestResult{
+			Status:          result.Status,
+			GPUWidthCounts:    gpuWidthCounts,
+			RDMAWidthCounts:   rdmaWidthCounts,
+			GPUSpeedCounts:    gpuSpeedCounts,
+			RDMASpeedCounts:   rdmaSpeedCounts,
+			StateErrors:       stateErrors,
+			DowngradedDevices: downgradedDevices,
+			TimestampUTC:      result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.PCIeWidthMissingLanesCheck = []PCIeWidthTestResult{pcieWidthResult}
+	}
+
+	// Process RDMA results
+	if result, exists := r.results["rdma_nic_count"]; exists {
+		rdmaCount := 0
+		if countVal, ok := result.Details["rdma_nic_count"]; ok {
+			if count, ok := countVal.(int); ok {
+				rdmaCount = count
+			}
+		}
+		rdmaResult := RDMATestResult{
+			Status:       result.Status,
+			NumRDMANics:  rdmaCount,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if missing, ok := result.Details["missing_devices"].([]string); ok {
+			rdmaResult.MissingDevices = missing
+		}
+		if extra, ok := result.Details["extra_devices"].([]string); ok {
+			rdmaResult.ExtraDevices = extra
+		}
+		report.Localhost.RDMANicsCount = []RDMATestResult{rdmaResult}
+	}
+
+	// Process Network results
+	if result, exists := r.results["rx_discards_check"]; exists {
+		interfaceCount := 0
+		failedCount := 0
+		failedInterfaces := ""
+
+		if countVal, ok := result.Details["interface_count"]; ok {
+			if count, ok := countVal.(int); ok {
+				interfaceCount = count
+			}
+		}
+
+		if failedVal, ok := result.Details["failed_count"]; ok {
+			if count, ok := failedVal.(int); ok {
+				failedCount = count
+			}
+		}
+
+		if failedInterfacesInResult, ok := result.Details["failed_interfaces"]; ok {
+			if failedInterfacesInResultStr, ok := failedInterfacesInResult.(string); ok {
+				failedInterfaces = failedInterfacesInResultStr
+			}
+		}
+
+		networkResult := RXDiscardsCheckTestResult{
+			InterfaceCount:   interfaceCount,
+			FailedCount:      failedCount,
+			Status:           result.Status,
+			FailedInterfaces: failedInterfaces,
+			TimestampUTC:     result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.RXDiscardsCheck = []RXDiscardsCheckTestResult{networkResult}
+	}
+
+	// Process GID Index results
+	if result, exists := r.results["gid_index_check"]; exists {
+		var invalidIndexes []int
+		if indexesVal, ok := result.Details["invalid_indexes"]; ok {
+			if indexes, ok := indexesVal.([]int); ok {
+				invalidIndexes = indexes
+			}
+		}
+		gidResult := GIDIndexTestResult{
+			Status:         result.Status,
+			InvalidIndexes: invalidIndexes,
+			TimestampUTC:   result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if expectedCount, ok := result.Details["expected_count"].(int); ok {
+			gidResult.ExpectedCount = expectedCount
+		}
+		if actualCount, ok := result.Details["actual_count"].(int); ok {
+			gidResult.ActualCount = actualCount
+		}
+		if wrongType, ok := result.Details["wrong_type_indexes"].([]int); ok {
+			gidResult.WrongTypeIndexes = wrongType
+		}
+		if duplicates, ok := result.Details["duplicate_gids"].([]string); ok {
+			gidResult.DuplicateGIDs = duplicates
+		}
+		report.Localhost.GIDIndexCheck = []GIDIndexTestResult{gidResult}
+	}
+
+	// Process Link Check results
+	if result, exists := r.results["link_check"]; exists {
+		var links interface{}
+		if linksVal, ok := result.Details["links"]; ok {
+			links = linksVal
+		}
+
+		linkResult := LinkTestResult{
+			Status:       result.Status,
+			Links:        links,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.LinkCheck = []LinkTestResult{linkResult}
+	}
+
+	// Process Ethernet link check results
+	if result, exists := r.results["eth_link_check"]; exists {
+		var ethLinks interface{}
+		if ethLinksVal, ok := result.Details["eth_links"]; ok {
+			ethLinks = ethLinksVal
+		}
+
+		ethLinkResult := EthLinkTestResult{
+			Status:       result.Status,
+			EthLinks:     ethLinks,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.EthLinkCheck = []EthLinkTestResult{ethLinkResult}
+	}
+
+	// Process SRAM Error Check results
+	if result, exists := r.results["sram_error_check"]; exists {
+		maxUncorrectable := 0
+		if uncorrectableVal, ok := result.Details["max_uncorrectable"]; ok {
+			if uncorrectable, ok := uncorrectableVal.(int); ok {
+				maxUncorrectable = uncorrectable
+			}
+		}
+
+		maxCorrectable := 0
+		if correctableVal, ok := result.Details["max_correctable"]; ok {
+			if correctable, ok := correctableVal.(int); ok {
+				maxCorrectable = correctable
+			}
+		}
+
+		sramResult := SRAMErrorTestResult{
+			Status:           result.Status,
+			MaxUncorrectable: maxUncorrectable,
+			MaxCorrectable:   maxCorrectable,
+			TimestampUTC:     result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		sramResult.PerGPUErrors, _ = result.Details["per_gpu_errors"].(map[int]SRAMGPUErrors)
+		report.Localhost.SRAMErrorCheck = []SRAMErrorTestResult{sramResult}
+	}
+
+	// Process GPU Driver Check results
+	if result, exists := r.results["gpu_driver_check"]; exists {
+		driverVersion := ""
+		if versionVal, ok := result.Details["driver_version"]; ok {
+			if version, ok := versionVal.(string); ok {
+				driverVersion = version
+			}
+		}
+		gpuDriverResult := GPUDriverTestResult{
+			Status:        result.Status,
+			DriverVersion: driverVersion,
+			TimestampUTC:  result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUDriverCheck = []GPUDriverTestResult{gpuDriverResult}
+	}
+
+	// Process GPU Clock Check results
+	if result, exists := r.results["gpu_clk_check"]; exists {
+		message := ""
+		if messageVal, ok := result.Details["message"]; ok {
+			if msg, ok := messageVal.(string); ok {
+				message = msg
+			}
+		}
+		boostClockSpeed, _ := result.Details["boost_clock_speed"].(int)
+		boostEnabled, _ := result.Details["boost_enabled"].(bool)
+		gpuClockResult := GPUClockTestResult{
+			Status:          result.Status,
+			Message:         message,
+			BoostClockSpeed: boostClockSpeed,
+			BoostEnabled:    boostEnabled,
+			TimestampUTC:    result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUClockCheck = []GPUClockTestResult{gpuClockResult}
+	}
+
+	// Process PeerMem Module results
+	if result, exists := r.results["peermem_module_check"]; exists {
+		moduleLoaded := false
+		if loadedVal, ok := result.Details["module_loaded"]; ok {
+			if loaded, ok := loadedVal.(bool); ok {
+				moduleLoaded = loaded
+			}
+		}
+		peerMemResult := PeerMemTestResult{
+			Status:       result.Status,
+			ModuleLoaded: moduleLoaded,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.PeerMemModuleCheck = []PeerMemTestResult{peerMemResult}
+	}
+
+	// Process NVLink Speed Check results
+	if result, exists := r.results["nvlink_speed_check"]; exists {
+		var nvlinks interface{}
+		if nvlinksVal, ok := result.Details["nvlinks"]; ok {
+			nvlinks = nvlinksVal
+		}
+
+		nvlinkResult := NVLinkTestResult{
+			Status:       result.Status,
+			NVLinks:      nvlinks,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.NVLinkSpeedCheck = []NVLinkTestResult{nvlinkResult}
+	}
+
+	// Process Eth0 Presence Check results
+	if result, exists := r.results["eth0_presence_check"]; exists {
+		eth0Present := false
+		if presentVal, ok := result.Details["eth0_present"]; ok {
+			if present, ok := presentVal.(bool); ok {
+				eth0Present = present
+			}
+		}
+		eth0Result := Eth0PresenceTestResult{
+			Status:       result.Status,
+			Eth0Present:  eth0Present,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.Eth0PresenceCheck = []Eth0PresenceTestResult{eth0Result}
+	}
+
+	// Process CDFP Cable Check results
+	if result, exists := r.results["cdfp_cable_check"]; exists {
+		var cdfpResult interface{}
+		if cdfpVal, ok := result.Details["cdfp_result"]; ok {
+			cdfpResult = cdfpVal
+		}
+
+		cdfpCableResult := CDFPCableCheckTestResult{
+			Status:       result.Status,
+			CDFPResult:   cdfpResult,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.CDFPCableCheck = []CDFPCableCheckTestResult{cdfpCableResult}
+	}
+
+	// Process Fabric Manager Check results
+	if result, exists := r.results["fabricmanager_check"]; exists {
+		var fabricManagerResult interface{}
+		if fabricVal, ok := result.Details["fabricmanager_result"]; ok {
+			fabricManagerResult = fabricVal
+		}
+
+		fabricManagerCheckResult := FabricManagerTestResult{
+			Status:              result.Status,
+			FabricManagerResult: fabricManagerResult,
+			TimestampUTC:        result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.FabricManagerCheck = []FabricManagerTestResult{fabricManagerCheckResult}
+	}
+
+	// Process HCA Error Check results
+	if result, exists := r.results["hca_error_check"]; exists {
+		hcaResult := HCAErrorTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if criticalCount, ok := result.Details["critical_count"].(int); ok {
+			hcaResult.CriticalCount = criticalCount
+		}
+		if warningCount, ok := result.Details["warning_count"].(int); ok {
+			hcaResult.WarningCount = warningCount
+		}
+		if criticalErrors, ok := result.Details["critical_errors"].([]string); ok {
+			hcaResult.CriticalErrors = criticalErrors
+		}
+		report.Localhost.HCAErrorCheck = []HCAErrorTestResult{hcaResult}
+	}
+
+	// Process Missing Interface Check results
+	if result, exists := r.results["missing_interface_check"]; exists {
+		missingCount := 0
+		if count, ok := result.Details["missing_count"].(int); ok {
+			missingCount = count
+		}
+		missingInterfaceResult := MissingInterfaceTestResult{
+			Status:       result.Status,
+			MissingCount: missingCount,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if expectedCount, ok := result.Details["expected_count"].(int); ok {
+			missingInterfaceResult.ExpectedCount = expectedCount
+		}
+		if foundCount, ok := result.Details["found_count"].(int); ok {
+			missingInterfaceResult.FoundCount = foundCount
+		}
+		if missingRDMA, ok := result.Details["missing_rdma_interfaces"].([]string); ok {
+			missingInterfaceResult.MissingRDMAInterfaces = missingRDMA
+		}
+		if missingVCN, ok := result.Details["missing_vcn_interfaces"].([]string); ok {
+			missingInterfaceResult.MissingVCNInterfaces = missingVCN
+		}
+		if extra, ok := result.Details["extra_interfaces"].([]string); ok {
+			missingInterfaceResult.ExtraInterfaces = extra
+		}
+		report.Localhost.MissingInterfaceCheck = []MissingInterfaceTestResult{missingInterfaceResult}
+	}
+
+	// Process GPU XID check results
+	if result, exists := r.results["gpu_xid_check"]; exists {
+		var xidResult interface{}
+		if xidVal, ok := result.Details["xid_result"]; ok {
+			xidResult = xidVal
+		}
+
+		gpuXIDCheckResult := GPUXIDTestResult{
+			Status:       result.Status,
+			XIDResult:    xidResult,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if breakdown, ok := result.Details["xid_breakdown"].([]XIDCodeBreakdown); ok {
+			gpuXIDCheckResult.XIDBreakdown = breakdown
+		}
+
+		// Add message from result details if available
+		if xidResultObj, ok := xidResult.(map[string]interface{}); ok {
+			if message, ok := xidResultObj["message"].(string); ok {
+				gpuXIDCheckResult.Message = message
+			}
+		}
+
+		report.Localhost.GPUXIDCheck = []GPUXIDTestResult{gpuXIDCheckResult}
+	}
+
+	// Process MAX_ACC check results
+	if result, exists := r.results["max_acc_check"]; exists {
+		var maxAccResult interface{}
+		if maxAccVal, ok := result.Details["max_acc_result"]; ok {
+			maxAccResult = maxAccVal
+		}
+
+		maxAccCheckResult := MaxAccTestResult{
+			Status:       result.Status,
+			MaxAccResult: maxAccResult,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+
+		// Add message from result details if available
+		if maxAccResultObj, ok := maxAccResult.(map[string]interface{}); ok {
+			if message, ok := maxAccResultObj["message"].(string); ok {
+				maxAccCheckResult.Message = message
+			}
+		}
+
+		report.Localhost.MaxAccCheck = []MaxAccTestResult{maxAccCheckResult}
+	}
+
+	// Process Row Remap Error Check results
+	if result, exists := r.results["row_remap_error_check"]; exists {
+		failureCount := 0
+		if count, ok := result.Details["failure_count"].(int); ok {
+			failureCount = count
+		}
+		rowRemapResult := RowRemapErrorTestResult{
+			Status:       result.Status,
+			FailureCount: failureCount,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.RowRemapErrorCheck = []RowRemapErrorTestResult{rowRemapResult}
+	}
+
+	// Process GPU VBIOS Check results
+	if result, exists := r.results["gpu_vbios_check"]; exists {
+		var vbiosVersions interface{}
+		if vbiosVal, ok := result.Details["vbios_versions"]; ok {
+			vbiosVersions = vbiosVal
+		}
+
+		gpuVBIOSResult := GPUVBIOSTestResult{
+			Status:        result.Status,
+			VBIOSVersions: vbiosVersions,
+			TimestampUTC:  result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUVBIOSCheck = []GPUVBIOSTestResult{gpuVBIOSResult}
+	}
+
+	// Process Hugepages Check results
+	if result, exists := r.results["hugepages_check"]; exists {
+		pages2MB := 0
+		if count, ok := result.Details["pages_2mb"].(int); ok {
+			pages2MB = count
+		}
+		pages1GB := 0
+		if count, ok := result.Details["pages_1gb"].(int); ok {
+			pages1GB = count
+		}
+		persistent := false
+		if val, ok := result.Details["persistent"].(bool); ok {
+			persistent = val
+		}
+		hugepagesResult := HugepagesTestResult{
+			Status:       result.Status,
+			Pages2MB:     pages2MB,
+			Pages1GB:     pages1GB,
+			Persistent:   persistent,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.HugepagesCheck = []HugepagesTestResult{hugepagesResult}
+	}
+
+	// Process NUMA Affinity Check results
+	if result, exists := r.results["numa_affinity_check"]; exists {
+		var gpuAffinity interface{}
+		if affinityVal, ok := result.Details["gpu_affinity"]; ok {
+			gpuAffinity = affinityVal
+		}
+
+		numaAffinityResult := NUMAAffinityTestResult{
+			Status:       result.Status,
+			GPUAffinity:  gpuAffinity,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.NUMAAffinityCheck = []NUMAAffinityTestResult{numaAffinityResult}
+	}
+
+	// Process IB SM Check results
+	if result, exists := r.results["ib_sm_check"]; exists {
+		var ports interface{}
+		if portsVal, ok := result.Details["ports"]; ok {
+			ports = portsVal
+		}
+
+		ibSMResult := IBSMTestResult{
+			Status:       result.Status,
+			Ports:        ports,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.IBSMCheck = []IBSMTestResult{ibSMResult}
+	}
+
+	// Process Time Sync Check results
+	if result, exists := r.results["time_sync_check"]; exists {
+		timeSyncResult := TimeSyncTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if synchronized, ok := result.Details["synchronized"].(bool); ok {
+			timeSyncResult.Synchronized = synchronized
+		}
+		if offsetMs, ok := result.Details["offset_ms"].(float64); ok {
+			timeSyncResult.OffsetMs = offsetMs
+		}
+		if referenceServer, ok := result.Details["reference_server"].(string); ok {
+			timeSyncResult.ReferenceServer = referenceServer
+		}
+		if stratum, ok := result.Details["stratum"].(int); ok {
+			timeSyncResult.Stratum = stratum
+		}
+		report.Localhost.TimeSyncCheck = []TimeSyncTestResult{timeSyncResult}
+	}
+
+	// Process Kernel Module Check results
+	if result, exists := r.results["kernel_module_check"]; exists {
+		var modules interface{}
+		if modulesVal, ok := result.Details["modules"]; ok {
+			modules = modulesVal
+		}
+
+		kernelModuleResult := KernelModuleTestResult{
+			Status:       result.Status,
+			Modules:      modules,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.KernelModuleCheck = []KernelModuleTestResult{kernelModuleResult}
+	}
+
+	// Process Systemd Service Check results
+	if result, exists := r.results["systemd_service_check"]; exists {
+		var services interface{}
+		if servicesVal, ok := result.Details["services"]; ok {
+			services = servicesVal
+		}
+
+		systemdServiceResult := SystemdServiceTestResult{
+			Status:       result.Status,
+			Services:     services,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.SystemdServiceCheck = []SystemdServiceTestResult{systemdServiceResult}
+	}
+
+	// Process IOMMU Check results
+	if result, exists := r.results["iommu_check"]; exists {
+		iommuResult := IOMMUTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if enabled, ok := result.Details["enabled"].(bool); ok {
+			iommuResult.Enabled = enabled
+		}
+		if mode, ok := result.Details["mode"].(string); ok {
+			iommuResult.Mode = mode
+		}
+		if groups, ok := result.Details["gpu_iommu_groups"].(int); ok {
+			iommuResult.GPUIOMMUGroups = groups
+		}
+		report.Localhost.IOMMUCheck = []IOMMUTestResult{iommuResult}
+	}
+
+	// Process NVLink Topology Check results
+	if result, exists := r.results["nvlink_topology_check"]; exists {
+		nvlinkTopologyResult := NVLinkTopologyTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if matrix, ok := result.Details["matrix"].([][]string); ok {
+			nvlinkTopologyResult.Matrix = matrix
+		}
+		if missing, ok := result.Details["missing_connections"].([]string); ok {
+			nvlinkTopologyResult.MissingConnections = missing
+		}
+		report.Localhost.NVLinkTopologyCheck = []NVLinkTopologyTestResult{nvlinkTopologyResult}
+	}
+
+	// Process PCIe Replay Check results
+	if result, exists := r.results["pcie_replay_check"]; exists {
+		pcieReplayResult := PCIeReplayTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if gpus, ok := result.Details["gpus"]; ok {
+			pcieReplayResult.GPUs = gpus
+		}
+		report.Localhost.PCIeReplayCheck = []PCIeReplayTestResult{pcieReplayResult}
+	}
+
+	// Process RDMA Credit Check results
+	if result, exists := r.results["rdma_credit_check"]; exists {
+		rdmaCreditResult := RDMACreditTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if ports, ok := result.Details["ports"]; ok {
+			rdmaCreditResult.Ports = ports
+		}
+		report.Localhost.RDMACreditCheck = []RDMACreditTestResult{rdmaCreditResult}
+	}
+
+	// Process RDMA MTU Check results
+	if result, exists := r.results["rdma_mtu_check"]; exists {
+		rdmaMTUResult := RDMAMTUTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if interfaces, ok := result.Details["interfaces"]; ok {
+			rdmaMTUResult.Interfaces = interfaces
+		}
+		if failedInterfaces, ok := result.Details["failed_interfaces"].(string); ok {
+			rdmaMTUResult.FailedInterfaces = failedInterfaces
+		}
+		report.Localhost.RDMAMTUCheck = []RDMAMTUTestResult{rdmaMTUResult}
+	}
+
+	// Process RDMA Topology Check results
+	if result, exists := r.results["rdma_topology_check"]; exists {
+		rdmaTopologyResult := RDMATopologyTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if expected, ok := result.Details["expected_devices"].([]string); ok {
+			rdmaTopologyResult.ExpectedDevices = expected
+		}
+		if actual, ok := result.Details["actual_devices"].([]string); ok {
+			rdmaTopologyResult.ActualDevices = actual
+		}
+		if missing, ok := result.Details["missing_devices"].([]string); ok {
+			rdmaTopologyResult.MissingDevices = missing
+		}
+		if extra, ok := result.Details["extra_devices"].([]string); ok {
+			rdmaTopologyResult.ExtraDevices = extra
+		}
+		report.Localhost.RDMATopologyCheck = []RDMATopologyTestResult{rdmaTopologyResult}
+	}
+
+	// Process BIOS Settings Check results
+	if result, exists := r.results["bios_settings_check"]; exists {
+		biosSettingsResult := BIOSSettingsTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if biosVersion, ok := result.Details["bios_version"].(string); ok {
+			biosSettingsResult.BIOSVersion = biosVersion
+		}
+		if cpuModel, ok := result.Details["cpu_model"].(string); ok {
+			biosSettingsResult.CPUModel = cpuModel
+		}
+		if hyperthreading, ok := result.Details["hyperthreading_enabled"].(bool); ok {
+			biosSettingsResult.HyperthreadingEnabled = hyperthreading
+		}
+		if versionSupported, ok := result.Details["version_supported"].(bool); ok {
+			biosSettingsResult.VersionSupported = versionSupported
+		}
+		report.Localhost.BIOSSettingsCheck = []BIOSSettingsTestResult{biosSettingsResult}
+	}
+
+	// Process GPU InfoROM Check results
+	if result, exists := r.results["gpu_inforom_check"]; exists {
+		var inforomVersions interface{}
+		if inforomVal, ok := result.Details["inforom_versions"]; ok {
+			inforomVersions = inforomVal
+		}
+
+		gpuInfoROMResult := GPUInfoROMTestResult{
+			Status:          result.Status,
+			InfoROMVersions: inforomVersions,
+			TimestampUTC:    result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUInfoROMCheck = []GPUInfoROMTestResult{gpuInfoROMResult}
+	}
+
+	// Process GPU Row Remap Check results
+	if result, exists := r.results["gpu_row_remap_check"]; exists {
+		var gpuRowRemaps interface{}
+		if rowRemapVal, ok := result.Details["gpu_row_remaps"]; ok {
+			gpuRowRemaps = rowRemapVal
+		}
+
+		gpuRowRemapResult := RowRemapTestResult{
+			Status:       result.Status,
+			GPURowRemaps: gpuRowRemaps,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPURowRemapCheck = []RowRemapTestResult{gpuRowRemapResult}
+	}
+
+	// Process CPU Governor Check results
+	if result, exists := r.results["cpu_governor_check"]; exists {
+		cpuGovernorResult := CPUGovernorTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if detected, ok := result.Details["detected_governor"].(string); ok {
+			cpuGovernorResult.DetectedGovernor = detected
+		}
+		if expected, ok := result.Details["expected_governor"].(string); ok {
+			cpuGovernorResult.ExpectedGovernor = expected
+		}
+		if differing, ok := result.Details["differing_cpus"].([]string); ok {
+			cpuGovernorResult.DifferingCPUs = differing
+		}
+		report.Localhost.CPUGovernorCheck = []CPUGovernorTestResult{cpuGovernorResult}
+	}
+
+	// Process GPU Firmware Check results
+	if result, exists := r.results["gpu_firmware_check"]; exists {
+		var firmwareVersions interface{}
+		if firmwareVal, ok := result.Details["firmware_versions"]; ok {
+			firmwareVersions = firmwareVal
+		}
+
+		gpuFirmwareResult := GPUFirmwareTestResult{
+			Status:           result.Status,
+			FirmwareVersions: firmwareVersions,
+			TimestampUTC:     result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUFirmwareCheck = []GPUFirmwareTestResult{gpuFirmwareResult}
+	}
+
+	// Process GPU P2P Bandwidth Check results
+	if result, exists := r.results["gpu_p2p_bw_check"]; exists {
+		gpuP2PBWResult := GPUP2PBWTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if matrix, ok := result.Details["bandwidth_matrix"].([][]float64); ok {
+			gpuP2PBWResult.BandwidthMatrix = matrix
+		}
+		if lowPairs, ok := result.Details["low_bandwidth_pairs"].([]string); ok {
+			gpuP2PBWResult.LowBandwidthPairs = lowPairs
+		}
+		if minBandwidth, ok := result.Details["min_bandwidth_gbps"].(float64); ok {
+			gpuP2PBWResult.MinBandwidthGBps = minBandwidth
+		}
+		report.Localhost.GPUP2PBWCheck = []GPUP2PBWTestResult{gpuP2PBWResult}
+	}
+
+	// Process CPU Isolation Check results
+	if result, exists := r.results["cpu_isolation_check"]; exists {
+		cpuIsolationResult := CPUIsolationTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if count, ok := result.Details["isolated_cpu_count"].(int); ok {
+			cpuIsolationResult.IsolatedCPUCount = count
+		}
+		if expected, ok := result.Details["expected_isolated_cpu_count"].(int); ok {
+			cpuIsolationResult.ExpectedIsolatedCPUCount = expected
+		}
+		if matches, ok := result.Details["nohz_full_matches"].(bool); ok {
+			cpuIsolationResult.NohzFullMatches = matches
+		}
+		report.Localhost.CPUIsolationCheck = []CPUIsolationTestResult{cpuIsolationResult}
+	}
+
+	// Process PCIe Gen Check results
+	if result, exists := r.results["pcie_gen_check"]; exists {
+		var devices interface{}
+		if devicesVal, ok := result.Details["devices"]; ok {
+			devices = devicesVal
+		}
+
+		pcieGenResult := PCIeGenTestResult{
+			Status:       result.Status,
+			Devices:      devices,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.PCIeGenCheck = []PCIeGenTestResult{pcieGenResult}
+	}
+
+	// Process GPU C-State Check results
+	if result, exists := r.results["gpu_cstate_check"]; exists {
+		var gpus interface{}
+		if gpusVal, ok := result.Details["gpus"]; ok {
+			gpus = gpusVal
+		}
+
+		gpuCStateResult := GPUCStateTestResult{
+			Status:       result.Status,
+			GPUs:         gpus,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUCStateCheck = []GPUCStateTestResult{gpuCStateResult}
+	}
+
+	// Process NFS Mount Check results
+	if result, exists := r.results["nfs_mount_check"]; exists {
+		var mounts interface{}
+		if mountsVal, ok := result.Details["mounts"]; ok {
+			mounts = mountsVal
+		}
+
+		nfsMountResult := NFSMountTestResult{
+			Status:       result.Status,
+			Mounts:       mounts,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.NFSMountCheck = []NFSMountTestResult{nfsMountResult}
+	}
+
+	// Process BER Trend Check results
+	if result, exists := r.results["ber_trend_check"]; exists {
+		var interfaces interface{}
+		if interfacesVal, ok := result.Details["interfaces"]; ok {
+			interfaces = interfacesVal
+		}
+
+		berTrendResult := BERTrendTestResult{
+			Status:       result.Status,
+			Interfaces:   interfaces,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.BERTrendCheck = []BERTrendTestResult{berTrendResult}
+	}
+
+	// Process IB Cable Check results
+	if result, exists := r.results["ib_cable_check"]; exists {
+		var cables interface{}
+		if cablesVal, ok := result.Details["cables"]; ok {
+			cables = cablesVal
+		}
+
+		ibCableResult := IBCableTestResult{
+			Status:       result.Status,
+			Cables:       cables,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.IBCableCheck = []IBCableTestResult{ibCableResult}
+	}
+
+	// Process PCIe ReBAR Check results
+	if result, exists := r.results["pcie_rebar_check"]; exists {
+		var gpus interface{}
+		if gpusVal, ok := result.Details["gpus"]; ok {
+			gpus = gpusVal
+		}
+
+		pcieReBARResult := PCIeReBARTestResult{
+			Status:       result.Status,
+			GPUs:         gpus,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.PCIeReBARCheck = []PCIeReBARTestResult{pcieReBARResult}
+	}
+
+	// Process GPU Idle Check results
+	if result, exists := r.results["gpu_idle_check"]; exists {
+		var gpus interface{}
+		if gpusVal, ok := result.Details["gpus"]; ok {
+			gpus = gpusVal
+		}
+
+		gpuIdleResult := GPUIdleTestResult{
+			Status:       result.Status,
+			GPUs:         gpus,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUIdleCheck = []GPUIdleTestResult{gpuIdleResult}
+	}
+
+	// Process PCIe Device Count results
+	if result, exists := r.results["pcie_device_count_check"]; exists {
+		pcieDeviceCountResult := PCIeDeviceCountTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		pcieDeviceCountResult.ExpectedGPUCount, _ = result.Details["expected_gpu_count"].(int)
+		pcieDeviceCountResult.FoundGPUCount, _ = result.Details["found_gpu_count"].(int)
+		pcieDeviceCountResult.ExpectedNICCount, _ = result.Details["expected_nic_count"].(int)
+		pcieDeviceCountResult.FoundNICCount, _ = result.Details["found_nic_count"].(int)
+		pcieDeviceCountResult.MissingGPUBDFs, _ = result.Details["missing_gpu_bdfs"].([]string)
+		pcieDeviceCountResult.MissingNICBDFs, _ = result.Details["missing_nic_bdfs"].([]string)
+		report.Localhost.PCIeDeviceCountCheck = []PCIeDeviceCountTestResult{pcieDeviceCountResult}
+	}
+
+	// Process GPU Firmware Update results
+	if result, exists := r.results["gpu_firmware_update_check"]; exists {
+		gpuFirmwareUpdateResult := GPUFirmwareUpdateTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuFirmwareUpdateResult.CurrentVersion, _ = result.Details["current_version"].(string)
+		gpuFirmwareUpdateResult.LatestVersion, _ = result.Details["latest_version"].(string)
+		gpuFirmwareUpdateResult.UpdateRecommended, _ = result.Details["update_recommended"].(bool)
+		report.Localhost.GPUFirmwareUpdateCheck = []GPUFirmwareUpdateTestResult{gpuFirmwareUpdateResult}
+	}
+
+	// Process GPU Reset History results
+	if result, exists := r.results["gpu_reset_check"]; exists {
+		var gpus interface{}
+		if gpusVal, ok := result.Details["gpus"]; ok {
+			gpus = gpusVal
+		}
+
+		gpuResetResult := GPUResetTestResult{
+			Status:       result.Status,
+			GPUs:         gpus,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUResetCheck = []GPUResetTestResult{gpuResetResult}
+	}
+
+	// Process RDMA Interface Speed results
+	if result, exists := r.results["rdma_interface_speed_check"]; exists {
+		rdmaInterfaceSpeedResult := RDMAInterfaceSpeedTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if interfaces, ok := result.Details["interfaces"]; ok {
+			rdmaInterfaceSpeedResult.Interfaces = interfaces
+		}
+		if failedInterfaces, ok := result.Details["failed_interfaces"].(string); ok {
+			rdmaInterfaceSpeedResult.FailedInterfaces = failedInterfaces
+		}
+		report.Localhost.RDMAInterfaceSpeedCheck = []RDMAInterfaceSpeedTestResult{rdmaInterfaceSpeedResult}
+	}
+
+	// Process IB Port State results
+	if result, exists := r.results["ib_port_state_check"]; exists {
+		ibPortStateResult := IBPortStateTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if ports, ok := result.Details["ports"]; ok {
+			ibPortStateResult.Ports = ports
+		}
+		if failedPorts, ok := result.Details["failed_ports"].(string); ok {
+			ibPortStateResult.FailedPorts = failedPorts
+		}
+		report.Localhost.IBPortStateCheck = []IBPortStateTestResult{ibPortStateResult}
+	}
+
+	// Process NIC Firmware results
+	if result, exists := r.results["nic_firmware_check"]; exists {
+		var interfaces interface{}
+		if interfacesVal, ok := result.Details["interfaces"]; ok {
+			interfaces = interfacesVal
+		}
+
+		nicFirmwareResult := NICFirmwareTestResult{
+			Status:       result.Status,
+			Interfaces:   interfaces,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.NICFirmwareCheck = []NICFirmwareTestResult{nicFirmwareResult}
+	}
+
+	// Process RDMA PCI Mapping results
+	if result, exists := r.results["rdma_pci_mapping_check"]; exists {
+		rdmaPCIMappingResult := RDMAPCIMappingTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		rdmaPCIMappingResult.ExpectedMapping, _ = result.Details["expected_mapping"].(map[string]string)
+		rdmaPCIMappingResult.ActualMapping, _ = result.Details["actual_mapping"].(map[string]string)
+		rdmaPCIMappingResult.MismatchedBDFs, _ = result.Details["mismatched_bdfs"].([]string)
+		report.Localhost.RDMAPCIMappingCheck = []RDMAPCIMappingTestResult{rdmaPCIMappingResult}
+	}
+
+	// Process GPU PCIe Topology results
+	if result, exists := r.results["gpu_pcie_topo_check"]; exists {
+		gpuPCIeTopoResult := GPUPCIeTopoTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuPCIeTopoResult.Matrix, _ = result.Details["matrix"].([][]string)
+		gpuPCIeTopoResult.UnavailablePairs, _ = result.Details["unavailable_pairs"].([]string)
+		gpuPCIeTopoResult.NonNVLinkPairs, _ = result.Details["non_nvlink_pairs"].([]string)
+		report.Localhost.GPUPCIeTopoCheck = []GPUPCIeTopoTestResult{gpuPCIeTopoResult}
+	}
+
+	// Process MLXConfig results
+	if result, exists := r.results["mlxconfig_check"]; exists {
+		mlxConfigResult := MLXConfigTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		mlxConfigResult.Parameters, _ = result.Details["parameters"].([]MLXConfigParameterResult)
+		report.Localhost.MLXConfigCheck = []MLXConfigTestResult{mlxConfigResult}
+	}
+
+	// Process GPU Power Rail results
+	if result, exists := r.results["gpu_power_rail_check"]; exists {
+		gpuPowerRailResult := GPUPowerRailTestResult{
+			Status:       result.Status,
+			Readings:     result.Details["readings"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuPowerRailResult.TDPWatts, _ = result.Details["tdp_watts"].(float64)
+		report.Localhost.GPUPowerRailCheck = []GPUPowerRailTestResult{gpuPowerRailResult}
+	}
+
+	// Process GPU Temperature results
+	if result, exists := r.results["gpu_temperature_check"]; exists {
+		gpuTemperatureResult := GPUTemperatureTestResult{
+			Status:       result.Status,
+			GPUs:         result.Details["gpus"],
+			Thresholds:   result.Details["thresholds"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.GPUTemperatureCheck = []GPUTemperatureTestResult{gpuTemperatureResult}
+	}
+
+	// Process RoCE VLAN results
+	if result, exists := r.results["roce_vlan_check"]; exists {
+		roceVLANResult := RoCEVLANTestResult{
+			Status:       result.Status,
+			Interfaces:   result.Details["interfaces"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		report.Localhost.RoCEVLANCheck = []RoCEVLANTestResult{roceVLANResult}
+	}
+
+	// Process IB Switch Port results
+	if result, exists := r.results["ib_switch_port_check"]; exists {
+		ibSwitchPortResult := IBSwitchPortTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		ibSwitchPortResult.Ports, _ = result.Details["ports"].([]IBSwitchPortResult)
+		report.Localhost.IBSwitchPortCheck = []IBSwitchPortTestResult{ibSwitchPortResult}
+	}
+
+	// Process GPU-CPU Bandwidth results
+	if result, exists := r.results["gpu_cpu_bw_check"]; exists {
+		gpuCPUBWResult := GPUCPUBWTestResult{
+			Status:       result.Status,
+			GPUs:         result.Details["gpus"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuCPUBWResult.MinBandwidthGBps, _ = result.Details["min_bandwidth_gbps"].(float64)
+		report.Localhost.GPUCPUBWCheck = []GPUCPUBWTestResult{gpuCPUBWResult}
+	}
+
+	// Process Fabric Manager Log results
+	if result, exists := r.results["fabricmanager_log_check"]; exists {
+		fabricManagerLogResult := FabricManagerLogTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		fabricManagerLogResult.WarningCount, _ = result.Details["warning_count"].(int)
+		fabricManagerLogResult.ErrorCount, _ = result.Details["error_count"].(int)
+		fabricManagerLogResult.StartupWarningCount, _ = result.Details["startup_warning_count"].(int)
+		fabricManagerLogResult.LastError, _ = result.Details["last_error"].(string)
+		fabricManagerLogResult.RecentMessages, _ = result.Details["recent_messages"].([]string)
+		report.Localhost.FabricManagerLogCheck = []FabricManagerLogTestResult{fabricManagerLogResult}
+	}
+
+	// Process GPU Memory Temperature results
+	if result, exists := r.results["gpu_mem_temperature_check"]; exists {
+		gpuMemTemperatureResult := GPUMemTemperatureTestResult{
+			Status:       result.Status,
+			GPUs:         result.Details["gpus"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuMemTemperatureResult.WarningC, _ = result.Details["warning_c"].(int)
+		gpuMemTemperatureResult.CriticalC, _ = result.Details["critical_c"].(int)
+		report.Localhost.GPUMemTemperatureCheck = []GPUMemTemperatureTestResult{gpuMemTemperatureResult}
+	}
+
+	// Process PCIe Vendor results
+	if result, exists := r.results["pcie_vendor_check"]; exists {
+		pcieVendorResult := PCIeVendorTestResult{
+			Status:       result.Status,
+			Devices:      result.Details["devices"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		pcieVendorResult.ExpectedBDFs, _ = result.Details["expected_bdfs"].([]string)
+		pcieVendorResult.Mismatches, _ = result.Details["mismatches"].([]string)
+		report.Localhost.PCIeVendorCheck = []PCIeVendorTestResult{pcieVendorResult}
+	}
+
+	// Process RDMA Retry Counter results
+	if result, exists := r.results["rdma_retry_counter_check"]; exists {
+		rdmaRetryCounterResult := RDMARetryCounterTestResult{
+			Status:       result.Status,
+			Ports:        result.Details["ports"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		rdmaRetryCounterResult.MaxRetryRatePerSec, _ = result.Details["max_retry_rate_per_sec"].(float64)
+		report.Localhost.RDMARetryCounterCheck = []RDMARetryCounterTestResult{rdmaRetryCounterResult}
+	}
+
+	// Process NVLink Bandwidth results
+	if result, exists := r.results["nvlink_bw_check"]; exists {
+		nvlinkBWResult := NVLinkBWTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		nvlinkBWResult.BusBandwidthGBps, _ = result.Details["bus_bandwidth_gbps"].(float64)
+		nvlinkBWResult.ExpectedBusBandwidthGBps, _ = result.Details["expected_bus_bandwidth_gbps"].(float64)
+		nvlinkBWResult.Algorithm, _ = result.Details["algorithm"].(string)
+		report.Localhost.NVLinkBWCheck = []NVLinkBWTestResult{nvlinkBWResult}
+	}
+
+	// Process GPU MIG Profile results
+	if result, exists := r.results["gpu_mig_profile_check"]; exists {
+		gpuMIGProfileResult := GPUMIGProfileTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuMIGProfileResult.ExpectedProfiles, _ = result.Details["expected_profiles"].([]string)
+		gpuMIGProfileResult.GPUs = result.Details["gpus"]
+		gpuMIGProfileResult.MissingProfiles, _ = result.Details["missing_profiles"].([]string)
+		gpuMIGProfileResult.ExtraProfiles, _ = result.Details["extra_profiles"].([]string)
+		report.Localhost.GPUMIGProfileCheck = []GPUMIGProfileTestResult{gpuMIGProfileResult}
+	}
+
+	// Process Network Auth check results
+	if result, exists := r.results["network_auth_check"]; exists {
+		networkAuthResult := NetworkAuthCheckTestResult{
+			Status:       result.Status,
+			Interfaces:   result.Details["interfaces"],
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		networkAuthResult.FailedInterfaces, _ = result.Details["failed_interfaces"].([]string)
+		report.Localhost.NetworkAuthCheck = []NetworkAuthCheckTestResult{networkAuthResult}
+	}
+
+	// Process DCGM Field check results
+	if result, exists := r.results["dcgm_field_check"]; exists {
+		dcgmFieldResult := DCGMFieldTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		dcgmFieldResult.Fields, _ = result.Details["fields"].(map[string]interface{})
+		dcgmFieldResult.FailedFields, _ = result.Details["failed_fields"].([]string)
+		report.Localhost.DCGMFieldCheck = []DCGMFieldTestResult{dcgmFieldResult}
+	}
+
+	// Process NUMA Distance check results
+	if result, exists := r.results["numa_distance_check"]; exists {
+		numaDistanceResult := NUMADistanceTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		numaDistanceResult.ActualMatrix, _ = result.Details["actual_matrix"].([][]int)
+		numaDistanceResult.ExpectedMatrix, _ = result.Details["expected_matrix"].([][]int)
+		numaDistanceResult.Mismatches, _ = result.Details["mismatches"].([]string)
+		report.Localhost.NUMADistanceCheck = []NUMADistanceTestResult{numaDistanceResult}
+	}
+
+	// Process CPU Topology check results
+	if result, exists := r.results["cpu_topology_check"]; exists {
+		cpuTopologyResult := CPUTopologyTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		cpuTopologyResult.Sockets, _ = result.Details["sockets"].(int)
+		cpuTopologyResult.CoresPerSocket, _ = result.Details["cores_per_socket"].(int)
+		cpuTopologyResult.HyperThreading, _ = result.Details["hyper_threading"].(bool)
+		cpuTopologyResult.LogicalCPUs, _ = result.Details["logical_cpus"].(int)
+		report.Localhost.CPUTopologyCheck = []CPUTopologyTestResult{cpuTopologyResult}
+	}
+
+	// Process Memory check results
+	if result, exists := r.results["memory_check"]; exists {
+		memoryResult := MemoryTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		memoryResult.TotalMemoryGB, _ = result.Details["total_memory_gb"].(float64)
+		memoryResult.AvailableMemoryGB, _ = result.Details["available_memory_gb"].(float64)
+		memoryResult.DIMMCount, _ = result.Details["dimm_count"].(int)
+		memoryResult.SpeedMTs, _ = result.Details["speed_mts"].(int)
+		memoryResult.MemoryType, _ = result.Details["memory_type"].(string)
+		memoryResult.EmptySlots, _ = result.Details["empty_slots"].([]string)
+		memoryResult.ExpectedTotalMemoryGB, _ = result.Details["expected_total_memory_gb"].(float64)
+		memoryResult.MinSpeedMTs, _ = result.Details["min_speed_mts"].(int)
+		report.Localhost.MemoryCheck = []MemoryTestResult{memoryResult}
+	}
+
+	// Process GPU Firmware Consistency check results
+	if result, exists := r.results["gpu_firmware_consistency_check"]; exists {
+		gpuFirmwareConsistencyResult := GPUFirmwareConsistencyTestResult{
+			Status:       result.Status,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		gpuFirmwareConsistencyResult.FirmwareVersions, _ = result.Details["firmware_versions"].(map[string][]string)
+		report.Localhost.GPUFirmwareConsistencyCheck = []GPUFirmwareConsistencyTestResult{gpuFirmwareConsistencyResult}
+	}
+
+	// Process test timeouts
+	var timedOutTests []string
+	for name, result := range r.results {
+		if timedOut, ok := result.Details["timeout"].(bool); ok && timedOut {
+			timedOutTests = append(timedOutTests, name)
+		}
+	}
+	sort.Strings(timedOutTests)
+	for _, name := range timedOutTests {
+		result := r.results[name]
+		timeoutResult := TestTimeoutResult{
+			TestName:     name,
+			Status:       "TIMEOUT",
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if seconds, ok := result.Details["timeout_seconds"].(int); ok {
+			timeoutResult.TimeoutSeconds = seconds
+		}
+		report.Localhost.TestTimeouts = append(report.Localhost.TestTimeouts, timeoutResult)
+	}
+
+	// Process tests that needed retries
+	var retriedTests []string
+	for name, result := range r.results {
+		if result.RetryCount > 0 {
+			retriedTests = append(retriedTests, name)
+		}
+	}
+	sort.Strings(retriedTests)
+	for _, name := range retriedTests {
+		result := r.results[name]
+		report.Localhost.TestRetries = append(report.Localhost.TestRetries, TestRetryResult{
+			TestName:     name,
+			Status:       result.Status,
+			RetryCount:   result.RetryCount,
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		})
+	}
+
+	// Process tests skipped because of a failed dependency
+	var skippedTests []string
+	for name, result := range r.results {
+		if skipped, ok := result.Details["skipped"].(bool); ok && skipped {
+			skippedTests = append(skippedTests, name)
+		}
+	}
+	sort.Strings(skippedTests)
+	for _, name := range skippedTests {
+		result := r.results[name]
+		skippedResult := TestSkippedResult{
+			TestName:     name,
+			Status:       "SKIP",
+			TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if dependency, ok := result.Details["failed_dependency"].(string); ok {
+			skippedResult.FailedDependency = dependency
+		}
+		report.Localhost.SkippedTests = append(report.Localhost.SkippedTests, skippedResult)
+	}
+
+	var erroredTests []string
+	for name, result := range r.results {
+		if result.err != nil && result.ErrorType != "" && result.Details["timeout"] != true {
+			erroredTests = append(erroredTests, name)
+		}
+	}
+	sort.Strings(erroredTests)
+	for _, name := range erroredTests {
+		if errorResult, ok := newTestErrorResult(name, r.results[name]); ok {
+			report.Localhost.TestErrors = append(report.Localhost.TestErrors, errorResult)
+		}
+	}
+
+	// Cached results are replayed as they were reported when the test last ran
+	if len(r.cached) > 0 {
+		if err := mergeCachedSections(&report.Localhost, r.cached); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// newTestErrorResult describes the typed error of result; timeouts and disabled tests
+// are not described since they are reported elsewhere
+func newTestErrorResult(testName string, result TestResult) (TestErrorResult, bool) {
+	errorResult := TestErrorResult{
+		TestName:     testName,
+		Status:       result.Status,
+		ErrorType:    result.ErrorType,
+		TimestampUTC: result.Timestamp.UTC().Format(time.RFC3339),
+	}
+
+	var (
+		toolErr      *testerrors.TestToolNotFoundError
+		executionErr *testerrors.TestExecutionError
+		thresholdErr *testerrors.TestThresholdExceededError
+	)
+	switch {
+	case errors.As(result.err, &toolErr):
+		errorResult.Tool = toolErr.Tool
+		errorResult.Package = toolErr.Package
+	case errors.As(result.err, &executionErr):
+		errorResult.Command = executionErr.Cmd
+		errorResult.ExitCode = executionErr.ExitCode
+	case errors.As(result.err, &thresholdErr):
+		errorResult.Metric = thresholdErr.Metric
+		errorResult.Actual = thresholdErr.Actual
+		errorResult.Expected = thresholdErr.Expected
+	default:
+		return TestErrorResult{}, false
+	}
+	return errorResult, true
+}
+
+// WriteReport writes the report to the configured output
+func (r *Reporter) WriteReport() error {
+	// Use default format (json) for backward compatibility
+	return r.WriteReportWithFormat("json")
+}
+
+// WriteReportWithFormat writes the report with the specified format
+func (r *Reporter) WriteReportWithFormat(format string) error {
+	report, err := r.GenerateReport()
+	if err != nil {
+		return fmt.Errorf("failed to generate report: %w", err)
+	}
+	report.Tags = r.tags
+
+	var output string
+	switch format {
+	case "json":
+		output, err = r.formatJSON(report)
+	case "table":
+		output, err = r.formatTable(report)
+	case "friendly":
+		output, err = r.formatFriendly(report)
+	case "csv":
+		output, err = r.formatCSV(report)
+	case "yaml":
+		output, err = r.formatYAML(report)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to format report: %w", err)
+	}
+
+	// Write to file if configured
+	if r.outputFile != "" {
+		if r.appendMode && format == "json" {
+			err = r.appendToFile(report)
+		} else {
+			err = os.WriteFile(r.outputFile, []byte(output), 0644)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to write report to file %s: %w", r.outputFile, err)
+		}
+		logger.Infof("Report written to file: %s", r.outputFile)
+
+		// A failed archive does not fail the run, the report itself was written
+		if r.archiveDir != "" {
+			if err := ArchiveReport(r.outputFile, r.archiveDir, r.archiveMaxFiles, r.archiveMaxAgeDays); err != nil {
+				logger.Errorf("Failed to archive report: %v", err)
+			}
+		}
+	} else {
+		// Write to console if no file specified
+		fmt.Print(output)
+	}
+
+	return nil
+}
+
+// JSONReport returns the current test results as a JSON report, independent of the output format
+func (r *Reporter) JSONReport() ([]byte, error) {
+	report, err := r.GenerateReport()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate report: %w", err)
+	}
+	report.Tags = r.tags
+
+	output, err := r.formatJSON(report)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(output), nil
+}
+
+// generateRunID returns a unique run ID in the format run-<uuid>, using a random (version 4) UUID
+// so runs appended within the same second get different IDs
+func generateRunID() string {
+	var uuid [16]byte
+	if _, err := rand.Read(uuid[:]); err != nil {
+		logger.Errorf("Failed to generate run UUID, using the current time: %v", err)
+		return fmt.Sprintf("run-%d", time.Now().UnixNano())
+	}
+	uuid[6] = (uuid[6] & 0x0f) | 0x40 // version 4
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("run-%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
+}
+
+// appendToFile appends the current test results to an existing file
+func (r *Reporter) appendToFile(currentReport *ReportOutput) error {
+	var appendedReport AppendedReport
+
+	// Try to read existing file
+	if _, err := os.Stat(r.outputFile); err == nil {
+		// File exists, read it
+		existingData, err := os.ReadFile(r.outputFile)
+		if err != nil {
+			return fmt.Errorf("failed to read existing file: %w", err)
+		}
+
+		// Try to parse as AppendedReport first
+		if err := json.Unmarshal(existingData, &appendedReport); err != nil {
+			// If that fails, try to parse as single ReportOutput (backward compatibility)
+			var singleReport ReportOutput
+			if err := json.Unmarshal(existingData, &singleReport); err != nil {
+				return fmt.Errorf("failed to parse existing file as JSON: %w", err)
+			}
+
+			// Convert single report to appended format
+			appendedReport.TestRuns = []TestRun{
+				{
+					RunID:       generateRunID(),
+					Timestamp:   time.Now().UTC().Format(time.RFC3339),
+					TestResults: singleReport.Localhost,
+				},
+			}
+		}
+	}
+
+	// Add current test run
+	newRun := TestRun{
+		RunID:          generateRunID(),
+		Timestamp:      time.Now().UTC().Format(time.RFC3339),
+		DiagnosticNote: currentReport.DiagnosticNote,
+		Tags:           currentReport.Tags,
+		TestResults:    currentReport.Localhost,
+	}
+	appendedReport.TestRuns = append(appendedReport.TestRuns, newRun)
+
+	// Drop the oldest runs beyond the run limit
+	if r.maxRuns > 0 {
+		appendedReport.MaxRuns = r.maxRuns
+	}
+	if appendedReport.MaxRuns > 0 && len(appendedReport.TestRuns) > appendedReport.MaxRuns {
+		appendedReport.TestRuns = appendedReport.TestRuns[len(appendedReport.TestRuns)-appendedReport.MaxRuns:]
+	}
+
+	// Write back to file
+	jsonData, err := json.MarshalIndent(appendedReport, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal appended report: %w", err)
+	}
+
+	if err := os.WriteFile(r.outputFile, jsonData, 0644); err != nil {
+		return fmt.Errorf("failed to write appended report: %w", err)
+	}
+
+	logger.Infof("Test results appended to file: %s", r.outputFile)
+	return nil
+}
+
+// formatJSON formats the report as JSON
+func (r *Reporter) formatJSON(report *ReportOutput) (string, error) {
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report to JSON: %w", err)
+	}
+	return string(jsonData) + "\n", nil
+}
+
+// formatYAML formats the report as YAML with the structure of the JSON report and camelCase
+// keys, e.g. gpuCountCheck for gpu_count_check. The keys of instance tags are user data and
+// kept as is.
+func (r *Reporter) formatYAML(report *ReportOutput) (string, error) {
+	document, err := yamlDocument(report)
+	if err != nil {
+		return "", err
+	}
+
+	yamlData, err := yaml.Marshal(document)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report to YAML: %w", err)
+	}
+	return string(yamlData), nil
+}
+
+// yamlDocument returns value as a generic document with the structure of its JSON encoding
+// and camelCase keys
+func yamlDocument(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal report: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to decode report: %w", err)
+	}
+	return yamlKeys(document, ""), nil
+}
+
+// yamlKeys returns value with camelCase map keys and JSON numbers converted to YAML integers
+// or floats. parent is the JSON key of value, the keys of instance tag maps are not converted.
+func yamlKeys(value interface{}, parent string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if parent == "defined_tags" || parent == "freeform_tags" {
+				converted[key] = yamlKeys(item, "")
+				continue
+			}
+			converted[camelCase(key)] = yamlKeys(item, key)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = yamlKeys(item, parent)
+		}
+		return converted
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// camelCase converts a snake_case key such as gpu_count_check to gpuCountCheck
+func camelCase(key string) string {
+	parts := strings.Split(key, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// formatTable formats the report as a table
+func (r *Reporter) formatTable(report *ReportOutput) (string, error) {
+	var output strings.Builder
+
+	output.WriteString("┌─────────────────────────────────────────────────────────────────┐\n")
+	output.WriteString("│                    DIAGNOSTIC TEST RESULTS                      │\n")
+	if header := shapeHeader(report); header != "" {
+		output.WriteString(fmt.Sprintf("│ %-63s │\n", header))
+	}
+	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+	output.WriteString("│ TEST NAME              │ STATUS  │ DETAILS                      │\n")
+	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+
+	// GPU Tests
+	if len(report.Localhost.GPUCountCheck) > 0 {
+		for _, gpu := range report.Localhost.GPUCountCheck {
+			status := gpu.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s GPU Count: %s           │\n",
+				"GPU Count Check", statusSymbol, statusSymbol, gpu.Status))
+		}
+	}
+
+	// GPU Mode Tests
+	if len(report.Localhost.GPUModeCheck) > 0 {
+		for _, gpuMode := range report.Localhost.GPUModeCheck {
+			status := gpuMode.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "MIG Mode Disabled"
+			if len(gpuMode.EnabledGPUIndexes) > 0 {
+				details = fmt.Sprintf("MIG Enabled: %v", gpuMode.EnabledGPUIndexes)
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
+				"GPU Mode Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PCIe Tests
+	if len(report.Localhost.PCIeErrorCheck) > 0 {
+		for _, pcie := range report.Localhost.PCIeErrorCheck {
+			status := pcie.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s PCIe Status: %s         │\n",
+				"PCIe Error Check", statusSymbol, statusSymbol, pcie.Status))
+		}
+	}
+
+	// PCIe Width Tests
+	if len(report.Localhost.PCIeWidthMissingLanesCheck) > 0 {
+		for _, pcieWidth := range report.Localhost.PCIeWidthMissingLanesCheck {
+			status := pcieWidth.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "PCIe Width Check: " + status
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s    │\n",
+				"PCIe Width Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA Tests
+	if len(report.Localhost.RDMANicsCount) > 0 {
+		for _, rdma := range report.Localhost.RDMANicsCount {
+			status := rdma.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s RDMA NICs: %d             │\n",
+				"RDMA NIC Count", statusSymbol, statusSymbol, rdma.NumRDMANics))
+		}
+	}
+
+	// Network Tests
+	if len(report.Localhost.RXDiscardsCheck) > 0 {
+		for _, network := range report.Localhost.RXDiscardsCheck {
+			status := network.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("Interfaces: %d", network.InterfaceCount)
+			if network.FailedCount > 0 {
+				details = fmt.Sprintf("Failed: %d/%d", network.FailedCount, network.InterfaceCount)
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s            │\n",
+				"Network RX Discards", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GID Index Tests
+	if len(report.Localhost.GIDIndexCheck) > 0 {
+		for _, gid := range report.Localhost.GIDIndexCheck {
+			status := gid.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "All indexes valid"
+			if len(gid.InvalidIndexes) > 0 {
+				details = fmt.Sprintf("invalid Index: %v", gid.InvalidIndexes)
+			} else if len(gid.WrongTypeIndexes) > 0 {
+				details = fmt.Sprintf("wrong type: %v", gid.WrongTypeIndexes)
+			} else if len(gid.DuplicateGIDs) > 0 {
+				details = fmt.Sprintf("%d duplicate GIDs", len(gid.DuplicateGIDs))
+			} else if gid.ActualCount < gid.ExpectedCount {
+				details = fmt.Sprintf("%d/%d GIDs", gid.ActualCount, gid.ExpectedCount)
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
+				"GID Index Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Link Check Tests
+	if len(report.Localhost.LinkCheck) > 0 {
+		for _, link := range report.Localhost.LinkCheck {
+			status := link.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "RDMA Links Checked"
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
+				"RDMA Link Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Ethernet Link Check Tests
+	if len(report.Localhost.EthLinkCheck) > 0 {
+		for _, ethLink := range report.Localhost.EthLinkCheck {
+			status := ethLink.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Ethernet Links Checked"
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s    │\n",
+				"Ethernet Link Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// SRAM Tests
+	if len(report.Localhost.SRAMErrorCheck) > 0 {
+		for _, sram := range report.Localhost.SRAMErrorCheck {
+			status := sram.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("Uncorr: %d, Corr: %d", sram.MaxUncorrectable, sram.MaxCorrectable)
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
+				"SRAM Error Check", statusSymbol, statusSymbol, details))
+			// Break the counts down per GPU when more than one GPU has errors
+			if errorGPUs := sramErrorGPUs(sram.PerGPUErrors); len(errorGPUs) > 1 {
+				for _, index := range errorGPUs {
+					gpuErrors := sram.PerGPUErrors[index]
+					output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %-16s │\n",
+						fmt.Sprintf("  GPU %d", index), "", fmt.Sprintf("U: %d, C: %d", gpuErrors.Uncorrectable, gpuErrors.Correctable)))
+				}
+			}
+		}
+	}
+
+	// GPU Driver Check Tests
+	if len(report.Localhost.GPUDriverCheck) > 0 {
+		for _, driver := range report.Localhost.GPUDriverCheck {
+			status := driver.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("Version: %s", driver.DriverVersion)
+			if len(details) > 25 {
+				details = details[:22] + "..."
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
+				"GPU Driver Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Clock Check Tests
+	if len(report.Localhost.GPUClockCheck) > 0 {
+		for _, clock := range report.Localhost.GPUClockCheck {
+			status := clock.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Clock Speeds OK"
+			if status == "FAIL" {
+				details = "Clock Speed Issues"
+			} else if clock.Message != "" {
+				details = clock.Message
+				if len(details) > 25 {
+					details = details[:22] + "..."
+				}
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
+				"GPU Clock Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PeerMem Module Tests
+	if len(report.Localhost.PeerMemModuleCheck) > 0 {
+		for _, peerMem := range report.Localhost.PeerMemModuleCheck {
+			status := peerMem.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Module Loaded"
+			if !peerMem.ModuleLoaded {
+				details = "Module Not Loaded"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s             │\n",
+				"PeerMem Module Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NVLink Tests
+	if len(report.Localhost.NVLinkSpeedCheck) > 0 {
+		for _, nvlink := range report.Localhost.NVLinkSpeedCheck {
+			status := nvlink.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "NVLink Speed/Count OK"
+			if status == "FAIL" {
+				details = "NVLink Issues Found"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s     │\n",
+				"NVLink Speed Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Eth0 Presence Tests
+	if len(report.Localhost.Eth0PresenceCheck) > 0 {
+		for _, eth0 := range report.Localhost.Eth0PresenceCheck {
+			status := eth0.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "eth0 Interface Present"
+			if !eth0.Eth0Present {
+				details = "eth0 Interface Missing"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s    │\n",
+				"Eth0 Presence Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// CDFP Cable Check Tests
+	if len(report.Localhost.CDFPCableCheck) > 0 {
+		for _, cdfp := range report.Localhost.CDFPCableCheck {
+			status := cdfp.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+			}
+			details := "CDFP Cables OK"
+			if status == "FAIL" {
+				details = "CDFP Cable Issues"
+			} else if status == "SKIP" {
+				details = "CDFP Check Skipped"
+			}
+			// Calculate padding to align with 67-character table width
+			contentLength := 1 + 22 + 3 + 6 + 3 + 1 + len(statusSymbol) + 1 + len(details)
+			padding := 67 - contentLength - 1 // -1 for final │
+			if padding < 0 {
+				padding = 0
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s%s│\n",
+				"CDFP Cable Check", statusSymbol, statusSymbol, details, strings.Repeat(" ", padding)))
+		}
+	}
+
+	// Fabric Manager Check Tests
+	if len(report.Localhost.FabricManagerCheck) > 0 {
+		for _, fabric := range report.Localhost.FabricManagerCheck {
+			status := fabric.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+			}
+			details := "Service Running"
+			if status == "FAIL" {
+				details = "Service Issues"
+			} else if status == "SKIP" {
+				details = "Check Skipped"
+			}
+			// Calculate padding to align with 67-character table width
+			contentLength := 1 + 22 + 3 + 6 + 3 + 1 + len(statusSymbol) + 1 + len(details)
+			padding := 67 - contentLength - 1 // -1 for final │
+			if padding < 0 {
+				padding = 0
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s%s│\n",
+				"Fabric Manager Check", statusSymbol, statusSymbol, details, strings.Repeat(" ", padding)))
+		}
+	}
+
+	// HCA Error Check Tests
+	if len(report.Localhost.HCAErrorCheck) > 0 {
+		for _, hca := range report.Localhost.HCAErrorCheck {
+			status := hca.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No MLX5 Fatal Errors"
+			if status == "FAIL" {
+				details = "MLX5 Fatal Errors Found"
+			} else if status == "WARN" {
+				details = "MLX5 Warnings Found"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"HCA Error Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Missing Interface Check Tests
+	if len(report.Localhost.MissingInterfaceCheck) > 0 {
+		for _, missing := range report.Localhost.MissingInterfaceCheck {
+			status := missing.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No Missing Interfaces"
+			if status == "FAIL" && missing.MissingCount > 0 {
+				details = fmt.Sprintf("%d Missing Interface(s)", missing.MissingCount)
+			} else if status == "FAIL" || status == "WARN" {
+				details = fmt.Sprintf("%d/%d Interfaces", missing.FoundCount, missing.ExpectedCount)
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s     │\n",
+				"Missing Interface", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU XID Check Tests
+	if len(report.Localhost.GPUXIDCheck) > 0 {
+		for _, xid := range report.Localhost.GPUXIDCheck {
+			status := xid.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No GPU XID Errors"
+			if status == "FAIL" {
+				details = "GPU XID Errors Found"
+			} else if status == "WARN" {
+				details = "GPU XID Warnings Found"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
+				"GPU XID Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// MAX_ACC Configuration Check Tests
+	if len(report.Localhost.MaxAccCheck) > 0 {
+		for _, maxAcc := range report.Localhost.MaxAccCheck {
+			status := maxAcc.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "ConnectX-7 Config OK"
+			if status == "FAIL" {
+				if maxAcc.Message != "" {
+					// Truncate message if too long for table
+					if len(maxAcc.Message) > 25 {
+						details = maxAcc.Message[:22] + "..."
+					} else {
+						details = maxAcc.Message
+					}
+				} else {
+					details = "Config Issues Detected"
+				}
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"MAX_ACC Check", statusSymbol, statusSymbol, details))
+		}
+	}
+			
+	// Row Remap Error Check Tests
+	if len(report.Localhost.RowRemapErrorCheck) > 0 {
+		for _, rowRemap := range report.Localhost.RowRemapErrorCheck {
+			status := rowRemap.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No Row Remap Errors"
+			if status == "FAIL" {
+				details = fmt.Sprintf("%d GPU(s) with Failures", rowRemap.FailureCount)
+			} else if strings.Contains(status, "Not applicable") {
+				statusSymbol = "⏭️"
+				details = "Driver Version < 550"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s       │\n",
+				"Row Remap Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU VBIOS Check Tests
+	if len(report.Localhost.GPUVBIOSCheck) > 0 {
+		for _, vbios := range report.Localhost.GPUVBIOSCheck {
+			status := vbios.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "VBIOS Versions OK"
+			if status == "FAIL" {
+				details = "Blacklisted VBIOS Found"
+			} else if status == "WARN" {
+				details = "Unapproved VBIOS Found"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"GPU VBIOS Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Hugepages Check Tests
+	if len(report.Localhost.HugepagesCheck) > 0 {
+		for _, hugepages := range report.Localhost.HugepagesCheck {
+			status := hugepages.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("2MB: %d, 1GB: %d", hugepages.Pages2MB, hugepages.Pages1GB)
+			if !hugepages.Persistent {
+				details += " (not persistent)"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"Hugepages Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NUMA Affinity Check Tests
+	if len(report.Localhost.NUMAAffinityCheck) > 0 {
+		for _, numa := range report.Localhost.NUMAAffinityCheck {
+			status := numa.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "GPU NUMA Affinity OK"
+			if status == "FAIL" {
+				details = "Unexpected NUMA Node"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"NUMA Affinity Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// IB SM Check Tests
+	if len(report.Localhost.IBSMCheck) > 0 {
+		for _, ibSM := range report.Localhost.IBSMCheck {
+			status := ibSM.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Subnet Manager OK"
+			if status == "FAIL" {
+				details = "Subnet Manager Issue"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"IB SM Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Time Sync Check Tests
+	if len(report.Localhost.TimeSyncCheck) > 0 {
+		for _, timeSync := range report.Localhost.TimeSyncCheck {
+			status := timeSync.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("Offset: %.3fms", timeSync.OffsetMs)
+			if !timeSync.Synchronized {
+				details = "Not Synchronized"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"Time Sync Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Kernel Module Check Tests
+	if len(report.Localhost.KernelModuleCheck) > 0 {
+		for _, kernelModule := range report.Localhost.KernelModuleCheck {
+			status := kernelModule.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Module Versions Match"
+			if status == "FAIL" {
+				details = "NVIDIA Module Mismatch"
+			} else if status == "WARN" {
+				details = "MLX5 Module Mismatch"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"Kernel Module Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Systemd Service Check Tests
+	if len(report.Localhost.SystemdServiceCheck) > 0 {
+		for _, systemdService := range report.Localhost.SystemdServiceCheck {
+			status := systemdService.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Services OK"
+			if status == "FAIL" {
+				details = "Service State Mismatch"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"Systemd Service Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// IOMMU Check Tests
+	if len(report.Localhost.IOMMUCheck) > 0 {
+		for _, iommu := range report.Localhost.IOMMUCheck {
+			status := iommu.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("Mode: %s", iommu.Mode)
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"IOMMU Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NVLink Topology Check Tests
+	if len(report.Localhost.NVLinkTopologyCheck) > 0 {
+		for _, topology := range report.Localhost.NVLinkTopologyCheck {
+			status := topology.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "All NVLinks Connected"
+			if len(topology.MissingConnections) > 0 {
+				details = fmt.Sprintf("Missing: %d", len(topology.MissingConnections))
+			} else if status == "FAIL" {
+				details = "Topology Check Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"NVLink Topology Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PCIe Replay Check Tests
+	if len(report.Localhost.PCIeReplayCheck) > 0 {
+		for _, pcieReplay := range report.Localhost.PCIeReplayCheck {
+			status := pcieReplay.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Replay Rate OK"
+			if status == "WARN" {
+				details = "High Replay Rate"
+			} else if status == "FAIL" {
+				details = "Replay Check Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"PCIe Replay Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA Credit Check Tests
+	if len(report.Localhost.RDMACreditCheck) > 0 {
+		for _, rdmaCredit := range report.Localhost.RDMACreditCheck {
+			status := rdmaCredit.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No Credit Errors"
+			if status == "FAIL" {
+				details = "Credit Errors Found"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"RDMA Credit Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA MTU Check Tests
+	if len(report.Localhost.RDMAMTUCheck) > 0 {
+		for _, rdmaMTU := range report.Localhost.RDMAMTUCheck {
+			status := rdmaMTU.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "MTU OK"
+			if status == "FAIL" {
+				details = "MTU Too Low"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				"RDMA MTU Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA Topology Check Tests
+	if len(report.Localhost.RDMATopologyCheck) > 0 {
+		for _, rdmaTopology := range report.Localhost.RDMATopologyCheck {
+			status := rdmaTopology.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("%d/%d Devices", len(rdmaTopology.ExpectedDevices)-len(rdmaTopology.MissingDevices), len(rdmaTopology.ExpectedDevices))
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"RDMA Topology Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// BIOS Settings Check Tests
+	if len(report.Localhost.BIOSSettingsCheck) > 0 {
+		for _, biosSettings := range report.Localhost.BIOSSettingsCheck {
+			status := biosSettings.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "BIOS " + biosSettings.BIOSVersion
+			if biosSettings.BIOSVersion == "" {
+				details = "BIOS Unknown"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"BIOS Settings Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU InfoROM Check Tests
+	if len(report.Localhost.GPUInfoROMCheck) > 0 {
+		for _, inforom := range report.Localhost.GPUInfoROMCheck {
+			status := inforom.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := "InfoROM OK"
+			if status == "FAIL" {
+				details = "InfoROM Corrupted"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU InfoROM Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Row Remap Check Tests
+	if len(report.Localhost.GPURowRemapCheck) > 0 {
+		for _, rowRemap := range report.Localhost.GPURowRemapCheck {
+			status := rowRemap.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No Remap Issues"
+			if status == "FAIL" {
+				details = "Remap Failure"
+			} else if status == "WARN" {
+				details = "Remap Pending"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Row Remap Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// CPU Governor Check Tests
+	if len(report.Localhost.CPUGovernorCheck) > 0 {
+		for _, cpuGovernor := range report.Localhost.CPUGovernorCheck {
+			status := cpuGovernor.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := cpuGovernor.DetectedGovernor
+			if status == "WARN" {
+				details = fmt.Sprintf("%d CPUs Differ", len(cpuGovernor.DifferingCPUs))
+			} else if details == "" {
+				details = "Unknown"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"CPU Governor Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Firmware Check Tests
+	if len(report.Localhost.GPUFirmwareCheck) > 0 {
+		for _, firmware := range report.Localhost.GPUFirmwareCheck {
+			status := firmware.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := "GSP Firmware OK"
+			if status == "FAIL" {
+				details = "GSP Firmware Bad"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Firmware Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU P2P Bandwidth Check Tests
+	if len(report.Localhost.GPUP2PBWCheck) > 0 {
+		for _, p2p := range report.Localhost.GPUP2PBWCheck {
+			status := p2p.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Bandwidth OK"
+			if len(p2p.LowBandwidthPairs) > 0 {
+				details = fmt.Sprintf("%d Pairs Low", len(p2p.LowBandwidthPairs))
+			} else if status == "FAIL" {
+				details = "Test Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU P2P BW Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// CPU Isolation Check Tests
+	if len(report.Localhost.CPUIsolationCheck) > 0 {
+		for _, isolation := range report.Localhost.CPUIsolationCheck {
+			status := isolation.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("%d/%d Isolated", isolation.IsolatedCPUCount, isolation.ExpectedIsolatedCPUCount)
+			if status == "FAIL" {
+				details = "Read Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"CPU Isolation Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PCIe Gen Check Tests
+	if len(report.Localhost.PCIeGenCheck) > 0 {
+		for _, pcieGen := range report.Localhost.PCIeGenCheck {
+			status := pcieGen.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := "Links OK"
+			if status == "FAIL" {
+				details = "Link Downgraded"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"PCIe Gen Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU C-State Check Tests
+	if len(report.Localhost.GPUCStateCheck) > 0 {
+		for _, cstate := range report.Localhost.GPUCStateCheck {
+			status := cstate.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "All GPUs P0"
+			if status == "WARN" {
+				details = "GPU Not In P0"
+			} else if status == "FAIL" {
+				details = "Runtime PM On"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU C-State Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NFS Mount Check Tests
+	if len(report.Localhost.NFSMountCheck) > 0 {
+		for _, nfsMount := range report.Localhost.NFSMountCheck {
+			status := nfsMount.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Mounts OK"
+			if status == "WARN" {
+				details = "Slow Mount"
+			} else if status == "FAIL" {
+				details = "Mount Missing"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"NFS Mount Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// BER Trend Check Tests
+	if len(report.Localhost.BERTrendCheck) > 0 {
+		for _, berTrend := range report.Localhost.BERTrendCheck {
+			status := berTrend.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "BER Stable"
+			if status == "WARN" {
+				details = "BER Increasing"
+			} else if status == "FAIL" {
+				details = "History Error"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"BER Trend Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// IB Cable Check Tests
+	if len(report.Localhost.IBCableCheck) > 0 {
+		for _, ibCable := range report.Localhost.IBCableCheck {
+			status := ibCable.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Signal OK"
+			if status == "WARN" {
+				details = "Out of Nominal"
+			} else if status == "FAIL" {
+				details = "Out of Limits"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"IB Cable Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PCIe ReBAR Check Tests
+	if len(report.Localhost.PCIeReBARCheck) > 0 {
+		for _, pcieReBAR := range report.Localhost.PCIeReBARCheck {
+			status := pcieReBAR.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "BAR1 Full Size"
+			if status == "WARN" {
+				details = "BAR1 Reduced"
+			} else if status == "FAIL" {
+				details = "ReBAR Disabled"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"PCIe ReBAR Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Idle Check Tests
+	if len(report.Localhost.GPUIdleCheck) > 0 {
+		for _, gpuIdle := range report.Localhost.GPUIdleCheck {
+			status := gpuIdle.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "GPUs Idle"
+			if status == "WARN" {
+				details = "GPUs In Use"
+			} else if status == "FAIL" {
+				details = "Query Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Idle Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PCIe Device Count Tests
+	if len(report.Localhost.PCIeDeviceCountCheck) > 0 {
+		for _, pcieDeviceCount := range report.Localhost.PCIeDeviceCountCheck {
+			status := pcieDeviceCount.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := fmt.Sprintf("GPU %d/%d NIC %d/%d", pcieDeviceCount.FoundGPUCount, pcieDeviceCount.ExpectedGPUCount,
+				pcieDeviceCount.FoundNICCount, pcieDeviceCount.ExpectedNICCount)
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"PCIe Device Count", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Firmware Update Tests
+	if len(report.Localhost.GPUFirmwareUpdateCheck) > 0 {
+		for _, gpuFirmwareUpdate := range report.Localhost.GPUFirmwareUpdateCheck {
+			status := gpuFirmwareUpdate.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Up To Date"
+			if status == "WARN" {
+				details = "Update Available"
+			} else if status == "FAIL" {
+				details = "Check Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Firmware Update", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Reset History Tests
+	if len(report.Localhost.GPUResetCheck) > 0 {
+		for _, gpuReset := range report.Localhost.GPUResetCheck {
+			status := gpuReset.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "No Resets"
+			if status == "WARN" {
+				details = "Resets Found"
+			} else if status == "FAIL" {
+				details = "Too Many Resets"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Reset History", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA Interface Speed Tests
+	if len(report.Localhost.RDMAInterfaceSpeedCheck) > 0 {
+		for _, rdmaInterfaceSpeed := range report.Localhost.RDMAInterfaceSpeedCheck {
+			status := rdmaInterfaceSpeed.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Expected Speed"
+			if status == "WARN" {
+				details = "Expected Speed"
+			} else if status == "FAIL" {
+				details = "Speed Degraded"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"RDMA Interface Speed", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// IB Port State Tests
+	if len(report.Localhost.IBPortStateCheck) > 0 {
+		for _, ibPortState := range report.Localhost.IBPortStateCheck {
+			status := ibPortState.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "All Active"
+			if status == "WARN" {
+				details = "All Active"
+			} else if status == "FAIL" {
+				details = "Port Not Active"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"IB Port State", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NIC Firmware Tests
+	if len(report.Localhost.NICFirmwareCheck) > 0 {
+		for _, nicFirmware := range report.Localhost.NICFirmwareCheck {
+			status := nicFirmware.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "NIC Firmware OK"
+			if status == "WARN" {
+				details = "NIC Firmware OK"
+			} else if status == "FAIL" {
+				details = "NIC Firmware Bad"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"NIC Firmware", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA PCI Mapping Tests
+	if len(report.Localhost.RDMAPCIMappingCheck) > 0 {
+		for _, rdmaPCIMapping := range report.Localhost.RDMAPCIMappingCheck {
+			status := rdmaPCIMapping.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "Mapping OK"
+			if len(rdmaPCIMapping.MismatchedBDFs) > 0 {
+				details = fmt.Sprintf("%d mismatched", len(rdmaPCIMapping.MismatchedBDFs))
+			} else if status == "FAIL" {
+				details = "Check Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"RDMA PCI Mapping", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU PCIe Topology Tests
+	if len(report.Localhost.GPUPCIeTopoCheck) > 0 {
+		for _, gpuPCIeTopo := range report.Localhost.GPUPCIeTopoCheck {
+			status := gpuPCIeTopo.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			details := "All P2P OK"
+			if len(gpuPCIeTopo.UnavailablePairs) > 0 {
+				details = fmt.Sprintf("%d pairs no P2P", len(gpuPCIeTopo.UnavailablePairs))
+			} else if len(gpuPCIeTopo.NonNVLinkPairs) > 0 {
+				details = fmt.Sprintf("%d pairs PCIe", len(gpuPCIeTopo.NonNVLinkPairs))
+			} else if status == "FAIL" {
+				details = "Check Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU PCIe Topology", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// MLXConfig Tests
+	if len(report.Localhost.MLXConfigCheck) > 0 {
+		for _, mlxConfig := range report.Localhost.MLXConfigCheck {
+			status := mlxConfig.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			failed := 0
+			for _, parameter := range mlxConfig.Parameters {
+				if parameter.Status != "PASS" {
+					failed++
+				}
+			}
+			details := fmt.Sprintf("%d params OK", len(mlxConfig.Parameters))
+			if failed > 0 {
+				details = fmt.Sprintf("%d params bad", failed)
+			} else if status == "FAIL" {
+				details = "Check Failed"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"MLXConfig", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Power Rail Tests
+	if len(report.Localhost.GPUPowerRailCheck) > 0 {
+		for _, gpuPowerRail := range report.Localhost.GPUPowerRailCheck {
+			status := gpuPowerRail.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("TDP %.0fW OK", gpuPowerRail.TDPWatts)
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = "Out of bounds"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = "Near bounds"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Power Rail", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Temperature Tests
+	if len(report.Localhost.GPUTemperatureCheck) > 0 {
+		for _, gpuTemperature := range report.Localhost.GPUTemperatureCheck {
+			status := gpuTemperature.Status
+			statusSymbol := "✅"
+			details := "All GPUs cool"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = "Critical temp"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = "Running hot"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Temperature", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RoCE VLAN Tests
+	if len(report.Localhost.RoCEVLANCheck) > 0 {
+		for _, roceVLAN := range report.Localhost.RoCEVLANCheck {
+			status := roceVLAN.Status
+			statusSymbol := "✅"
+			details := "VLANs OK"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = "VLAN mismatch"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"RoCE VLAN", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// IB Switch Port Tests
+	if len(report.Localhost.IBSwitchPortCheck) > 0 {
+		for _, ibSwitchPort := range report.Localhost.IBSwitchPortCheck {
+			status := ibSwitchPort.Status
+			statusSymbol := "✅"
+			details := "Switch ports OK"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = "Switch errors"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = "State mismatch"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"IB Switch Port", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU-CPU Bandwidth Tests
+	if len(report.Localhost.GPUCPUBWCheck) > 0 {
+		for _, gpuCPUBW := range report.Localhost.GPUCPUBWCheck {
+			status := gpuCPUBW.Status
+			statusSymbol := "✅"
+			details := "Bandwidth OK"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = "Low bandwidth"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = "Degraded"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU-CPU Bandwidth", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Fabric Manager Log Tests
+	if len(report.Localhost.FabricManagerLogCheck) > 0 {
+		for _, fabricManagerLog := range report.Localhost.FabricManagerLogCheck {
+			status := fabricManagerLog.Status
+			statusSymbol := "✅"
+			details := "Log clean"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf("%d error(s)", fabricManagerLog.ErrorCount)
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = fmt.Sprintf("%d warning(s)", fabricManagerLog.WarningCount)
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"Fabric Manager Log", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Memory Temperature Tests
+	if len(report.Localhost.GPUMemTemperatureCheck) > 0 {
+		for _, gpuMemTemperature := range report.Localhost.GPUMemTemperatureCheck {
+			status := gpuMemTemperature.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("< %d°C", gpuMemTemperature.WarningC)
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf(">= %d°C", gpuMemTemperature.CriticalC)
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = fmt.Sprintf(">= %d°C", gpuMemTemperature.WarningC)
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+				details = "Not reported"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU Memory Temperature", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// PCIe Vendor Tests
+	if len(report.Localhost.PCIeVendorCheck) > 0 {
+		for _, pcieVendor := range report.Localhost.PCIeVendorCheck {
+			status := pcieVendor.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("%d BDFs", len(pcieVendor.ExpectedBDFs))
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf("%d mismatch(es)", len(pcieVendor.Mismatches))
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"PCIe Vendor", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// RDMA Retry Counter Tests
+	if len(report.Localhost.RDMARetryCounterCheck) > 0 {
+		for _, rdmaRetryCounter := range report.Localhost.RDMARetryCounterCheck {
+			status := rdmaRetryCounter.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("<= %.2f/s", rdmaRetryCounter.MaxRetryRatePerSec)
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = "Not sampled"
+			} else if status == "WARN" {
+				statusSymbol = "⚠️"
+				details = fmt.Sprintf("> %.2f/s", rdmaRetryCounter.MaxRetryRatePerSec)
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"RDMA Retry Counters", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NVLink Bandwidth Tests
+	if len(report.Localhost.NVLinkBWCheck) > 0 {
+		for _, nvlinkBW := range report.Localhost.NVLinkBWCheck {
+			status := nvlinkBW.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := fmt.Sprintf("%.0f GB/s", nvlinkBW.BusBandwidthGBps)
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"NVLink Bandwidth", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU MIG Profile Tests
+	if len(report.Localhost.GPUMIGProfileCheck) > 0 {
+		for _, gpuMIGProfile := range report.Localhost.GPUMIGProfileCheck {
+			status := gpuMIGProfile.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("%d profiles", len(gpuMIGProfile.ExpectedProfiles))
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf("%d mismatched", len(gpuMIGProfile.MissingProfiles)+len(gpuMIGProfile.ExtraProfiles))
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+				details = "Not required"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU MIG Profiles", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Network Auth Check Tests
+	if len(report.Localhost.NetworkAuthCheck) > 0 {
+		for _, networkAuth := range report.Localhost.NetworkAuthCheck {
+			status := networkAuth.Status
+			statusSymbol := "✅"
+			details := "Authenticated"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf("%d failed", len(networkAuth.FailedInterfaces))
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+				details = "No interfaces"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"Network Auth Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// DCGM Field Tests
+	if len(report.Localhost.DCGMFieldCheck) > 0 {
+		for _, dcgmField := range report.Localhost.DCGMFieldCheck {
+			status := dcgmField.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("%d fields", len(dcgmField.Fields))
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf("%d failed", len(dcgmField.FailedFields))
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+				details = "No DCGM"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"DCGM Field Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// NUMA Distance Tests
+	if len(report.Localhost.NUMADistanceCheck) > 0 {
+		for _, numaDistance := range report.Localhost.NUMADistanceCheck {
+			status := numaDistance.Status
+			statusSymbol := "✅"
+			details := fmt.Sprintf("%d nodes", len(numaDistance.ActualMatrix))
+			if status == "FAIL" {
+				statusSymbol = "❌"
+				details = fmt.Sprintf("%d mismatched", len(numaDistance.Mismatches))
+			} else if status == "SKIP" {
+				statusSymbol = "⏭️"
+				details = "No matrix"
+			}
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"NUMA Distance Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// CPU Topology Tests
+	if len(report.Localhost.CPUTopologyCheck) > 0 {
+		for _, cpuTopology := range report.Localhost.CPUTopologyCheck {
+			status := cpuTopology.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := fmt.Sprintf("%dx%d, %d CPUs", cpuTopology.Sockets, cpuTopology.CoresPerSocket, cpuTopology.LogicalCPUs)
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"CPU Topology Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Memory Tests
+	if len(report.Localhost.MemoryCheck) > 0 {
+		for _, memory := range report.Localhost.MemoryCheck {
+			status := memory.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := fmt.Sprintf("%.0f GB", memory.TotalMemoryGB)
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"Memory Check", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// GPU Firmware Consistency Tests
+	if len(report.Localhost.GPUFirmwareConsistencyCheck) > 0 {
+		for _, gpuFirmwareConsistency := range report.Localhost.GPUFirmwareConsistencyCheck {
+			status := gpuFirmwareConsistency.Status
+			statusSymbol := "✅"
+			if status == "FAIL" {
+				statusSymbol = "❌"
+			}
+			details := fmt.Sprintf("%d versions", len(gpuFirmwareConsistency.FirmwareVersions))
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %-13s │\n",
+				"GPU FW Consistency", statusSymbol, statusSymbol, details))
+		}
+	}
+
+	// Test Timeouts
+	for _, timeout := range report.Localhost.TestTimeouts {
+		details := fmt.Sprintf("Timed out after %ds", timeout.TimeoutSeconds)
+		output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+			timeout.TestName, "❌", "❌", details))
+	}
+
+	// Skipped Tests
+	for _, skipped := range report.Localhost.SkippedTests {
+		details := fmt.Sprintf("Skipped: %s failed", skipped.FailedDependency)
+		output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+			skipped.TestName, "⏭️", "⏭️", details))
+	}
+
+	// Test Errors (threshold errors are shown by their own test)
+	for _, testError := range report.Localhost.TestErrors {
+		if details := testErrorDetails(testError); details != "" {
+			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
+				testError.TestName, "❌", "❌", details))
+		}
+	}
+
+	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+	output.WriteString("│ ✅ PASS  │  ⚠️  WARN  │  ❌ FAIL                                    │\n")
+	output.WriteString("└─────────────────────────────────────────────────────────────────┘\n")
+	if report.DiagnosticNote != "" {
+		output.WriteString("Note: " + report.DiagnosticNote + "\n")
+	}
+	return output.String(), nil
+}
+
+// formatFriendly formats the report in a user-friendly format
+func (r *Reporter) formatFriendly(report *ReportOutput) (string, error) {
+	var output strings.Builder
+
+	output.WriteString("🔍 HPC Diagnostic Results\n")
+	if header := shapeHeader(report); header != "" {
+		output.WriteString(header + "\n")
+	}
+	if report.DiagnosticNote != "" {
+		output.WriteString("⚠️  Note: " + report.DiagnosticNote + "\n")
+	}
+	output.WriteString("=" + strings.Repeat("=", 50) + "\n\n")
+
+	totalTests := 0
+	passedTests := 0
+	warnedTests := 0
+	failedTests := 0
+
+	// GPU Tests
+	if len(report.Localhost.GPUCountCheck) > 0 {
+		output.WriteString("🖥️  GPU Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, gpu := range report.Localhost.GPUCountCheck {
+			totalTests++
+			if gpu.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ GPU Count: %d (PASSED)\n", gpu.GPUCount))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ GPU Count: %d (FAILED)\n", gpu.GPUCount))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// GPU Mode Tests
+	if len(report.Localhost.GPUModeCheck) > 0 {
+		output.WriteString("🖥️  GPU Mode Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, gpuMode := range report.Localhost.GPUModeCheck {
+			totalTests++
+			if gpuMode.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU Mode: MIG disabled on all GPUs (PASSED)\n")
+			} else {
+				failedTests++
+				if len(gpuMode.EnabledGPUIndexes) > 0 {
+					output.WriteString(fmt.Sprintf("   ❌ GPU Mode: MIG enabled on GPUs %v (FAILED)\n", gpuMode.EnabledGPUIndexes))
+				} else {
+					output.WriteString("   ❌ GPU Mode: Check failed (FAILED)\n")
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// PCIe Tests
+	if len(report.Localhost.PCIeErrorCheck) > 0 {
+		output.WriteString("🔗 PCIe Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcie := range report.Localhost.PCIeErrorCheck {
+			totalTests++
+			if pcie.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ PCIe Bus: No errors detected (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ PCIe Bus: Errors detected (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// PCIe Width Tests
+	if len(report.Localhost.PCIeWidthMissingLanesCheck) > 0 {
+		output.WriteString("📏 PCIe Width Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcieWidth := range report.Localhost.PCIeWidthMissingLanesCheck {
+			totalTests++
+			if pcieWidth.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ PCIe Link Width: All lanes operating at expected width (PASSED)\n")
+				if len(pcieWidth.GPUWidthCounts) > 0 {
+					output.WriteString("      GPU/NVSwitch widths: ")
+					for width, count := range pcieWidth.GPUWidthCounts {
+						output.WriteString(fmt.Sprintf("%dx %s ", count, width))
+					}
+					output.WriteString("\n")
+				}
+				if len(pcieWidth.RDMAWidthCounts) > 0 {
+					output.WriteString("      RDMA widths: ")
+					for width, count := range pcieWidth.RDMAWidthCounts {
+						output.WriteString(fmt.Sprintf("%dx %s ", count, width))
+					}
+					output.WriteString("\n")
+				}
+			} else {
+				failedTests++
+				output.WriteString("   ❌ PCIe Link Width: Missing lanes detected (FAILED)\n")
+				if len(pcieWidth.DowngradedDevices) > 0 {
+					output.WriteString(fmt.Sprintf("      Downgraded below link capability: %s\n", strings.Join(pcieWidth.DowngradedDevices, ", ")))
+				}
+				output.WriteString("      ⚠️  Please reboot the host and if the issue persists, send the node to OCI\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// RDMA Tests
+	if len(report.Localhost.RDMANicsCount) > 0 {
+		output.WriteString("🌐 RDMA Network Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, rdma := range report.Localhost.RDMANicsCount {
+			totalTests++
+			if rdma.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ RDMA NICs: %d detected (PASSED)\n", rdma.NumRDMANics))
+			} else if rdma.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ RDMA NICs: %d detected, unexpected RDMA NICs %s (WARNING)\n", rdma.NumRDMANics, strings.Join(rdma.ExtraDevices, ", ")))
+			} else if len(rdma.MissingDevices) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ RDMA NICs: %d detected, missing RDMA NICs %s (FAILED)\n", rdma.NumRDMANics, strings.Join(rdma.MissingDevices, ", ")))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ RDMA NICs: %d detected (FAILED)\n", rdma.NumRDMANics))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Network Tests
+	if len(report.Localhost.RXDiscardsCheck) > 0 {
+		output.WriteString("🌐 Network RX Discards Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, network := range report.Localhost.RXDiscardsCheck {
+			totalTests++
+			if network.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ Network Interfaces: %d checked, no RX discard issues (PASSED)\n", network.InterfaceCount))
+			} else {
+				failedTests++
+				if network.FailedCount > 0 {
+					output.WriteString(fmt.Sprintf("   ❌ Network Interfaces: %d failed out of %d checked (FAILED)\n", network.FailedCount, network.InterfaceCount))
+				} else {
+					output.WriteString(fmt.Sprintf("   ❌ Network Interfaces: RX discard check failed (FAILED)\n"))
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// GID Index Tests
+	if len(report.Localhost.GIDIndexCheck) > 0 {
+		output.WriteString("🔗 GID Index Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, gid := range report.Localhost.GIDIndexCheck {
+			totalTests++
+			if gid.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GID Indexes: All valid (PASSED)\n")
+			} else {
+				failedTests++
+				if len(gid.InvalidIndexes) > 0 {
+					output.WriteString(fmt.Sprintf("   ❌ GID Indexes: Invalid indexes found %v (FAILED)\n", gid.InvalidIndexes))
+				} else if len(gid.WrongTypeIndexes) > 0 {
+					output.WriteString(fmt.Sprintf("   ❌ GID Indexes: Unexpected GID type on indexes %v (FAILED)\n", gid.WrongTypeIndexes))
+				} else if len(gid.DuplicateGIDs) > 0 {
+					output.WriteString(fmt.Sprintf("   ❌ GID Indexes: GID values duplicated across ports %v (FAILED)\n", gid.DuplicateGIDs))
+				} else if gid.ActualCount < gid.ExpectedCount {
+					output.WriteString(fmt.Sprintf("   ❌ GID Indexes: Found %d of %d expected GID entries (FAILED)\n", gid.ActualCount, gid.ExpectedCount))
+				} else {
+					output.WriteString("   ❌ GID Indexes: Check failed (FAILED)\n")
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Link Check Tests
+	if len(report.Localhost.LinkCheck) > 0 {
+		output.WriteString("🌐 RDMA Link Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, link := range report.Localhost.LinkCheck {
+			totalTests++
+			if link.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ RDMA Links: All links healthy (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ RDMA Links: Link issues detected (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Ethernet Link Check Tests
+	if len(report.Localhost.EthLinkCheck) > 0 {
+		output.WriteString("🌐 Ethernet Link Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, ethLink := range report.Localhost.EthLinkCheck {
+			totalTests++
+			if ethLink.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Ethernet Links: All links healthy (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ Ethernet Links: Link issues detected (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// SRAM Tests
+	if len(report.Localhost.SRAMErrorCheck) > 0 {
+		output.WriteString("💾 SRAM Error Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, sram := range report.Localhost.SRAMErrorCheck {
+			totalTests++
+			if sram.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ SRAM Errors: Uncorrectable: %d, Correctable: %d (PASSED)\n",
+					sram.MaxUncorrectable, sram.MaxCorrectable))
+			} else if sram.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️  SRAM Errors: Uncorrectable: %d, Correctable: %d (WARNING)\n",
+					sram.MaxUncorrectable, sram.MaxCorrectable))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ SRAM Errors: Uncorrectable: %d, Correctable: %d (FAILED)\n",
+					sram.MaxUncorrectable, sram.MaxCorrectable))
+			}
+			if errorGPUs := sramErrorGPUs(sram.PerGPUErrors); len(errorGPUs) > 1 {
+				for _, index := range errorGPUs {
+					gpuErrors := sram.PerGPUErrors[index]
+					output.WriteString(fmt.Sprintf("      GPU %d: Uncorrectable: %d, Correctable: %d\n",
+						index, gpuErrors.Uncorrectable, gpuErrors.Correctable))
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// GPU Driver Check Tests
+	if len(report.Localhost.GPUDriverCheck) > 0 {
+		output.WriteString("🎮 GPU Driver Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, driver := range report.Localhost.GPUDriverCheck {
+			totalTests++
+			if driver.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ GPU Driver: Version %s (PASSED)\n", driver.DriverVersion))
+			} else if driver.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ GPU Driver: Version %s (WARNING - unsupported)\n", driver.DriverVersion))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ GPU Driver: Version %s (FAILED)\n", driver.DriverVersion))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// GPU Clock Check Tests
+	if len(report.Localhost.GPUClockCheck) > 0 {
+		output.WriteString("⏱️ GPU Clock Speed Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, clock := range report.Localhost.GPUClockCheck {
+			totalTests++
+			if clock.Status == "PASS" {
+				passedTests++
+				if clock.Message != "" {
+					output.WriteString(fmt.Sprintf("   ✅ GPU Clock Speeds: %s (PASSED)\n", clock.Message))
+				} else {
+					output.WriteString("   ✅ GPU Clock Speeds: All GPUs running at acceptable speeds (PASSED)\n")
+				}
+			} else {
+				failedTests++
+				if clock.Message != "" {
+					output.WriteString(fmt.Sprintf("   ❌ GPU Clock Speeds: %s (FAILED)\n", clock.Message))
+				} else {
+					output.WriteString("   ❌ GPU Clock Speeds: Some GPUs below acceptable speed threshold (FAILED)\n")
+				}
+			}
+			if clock.BoostClockSpeed > 0 {
+				if clock.BoostEnabled {
+					output.WriteString(fmt.Sprintf("      Boost clock: all GPUs reach %d MHz\n", clock.BoostClockSpeed))
+				} else {
+					output.WriteString(fmt.Sprintf("      Boost clock: some GPUs cannot reach %d MHz\n", clock.BoostClockSpeed))
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// PeerMem Module Tests
+	if len(report.Localhost.PeerMemModuleCheck) > 0 {
+		output.WriteString("🔧 PeerMem Module Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, peerMem := range report.Localhost.PeerMemModuleCheck {
+			totalTests++
+			if peerMem.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ PeerMem Module: nvidia_peermem loaded (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ PeerMem Module: nvidia_peermem not loaded (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// NVLink Tests
+	if len(report.Localhost.NVLinkSpeedCheck) > 0 {
+		output.WriteString("🔗 NVLink Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, nvlink := range report.Localhost.NVLinkSpeedCheck {
+			totalTests++
+			if nvlink.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ NVLink: All links meet speed and count requirements (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ NVLink: Speed or count issues detected (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Eth0 Presence Tests
+	if len(report.Localhost.Eth0PresenceCheck) > 0 {
+		output.WriteString("🌐 Eth0 Interface Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, eth0 := range report.Localhost.Eth0PresenceCheck {
+			totalTests++
+			if eth0.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Eth0 Interface: eth0 is present (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ Eth0 Interface: eth0 is missing (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// CDFP Cable Check Tests
+	if len(report.Localhost.CDFPCableCheck) > 0 {
+		output.WriteString("🔌 CDFP Cable Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, cdfp := range report.Localhost.CDFPCableCheck {
+			totalTests++
+			if cdfp.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ CDFP Cables: All GPU-to-module mappings correct (PASSED)\n")
+			} else if cdfp.Status == "SKIP" {
+				// Count skipped tests as neither passed nor failed
+				totalTests-- // Adjust total count as SKIP doesn't count
+				output.WriteString("   ⏭️ CDFP Cables: Check skipped (not applicable for this shape)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ CDFP Cables: GPU-to-module mapping issues detected (FAILED)\n")
+			}
+		}
+	}
+
+	// HCA Error Check Tests
+	if len(report.Localhost.HCAErrorCheck) > 0 {
+		output.WriteString("🔍 HCA Error Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, hca := range report.Localhost.HCAErrorCheck {
+			totalTests++
+			if hca.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ HCA Error Check: No MLX5 fatal errors detected (PASSED)\n")
+			} else if hca.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ HCA Error Check: %d MLX5 warning(s) detected (WARNING)\n", hca.WarningCount))
+			} else if hca.CriticalCount == 0 {
+				failedTests++
+				output.WriteString("   ❌ HCA Error Check: Could not check system logs for MLX5 errors (FAILED)\n")
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ HCA Error Check: %d critical MLX5 error(s) detected (FAILED)\n", hca.CriticalCount))
+				for _, message := range hca.CriticalErrors {
+					output.WriteString(fmt.Sprintf("      %s\n", message))
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Missing Interface Check Tests
+	if len(report.Localhost.MissingInterfaceCheck) > 0 {
+		output.WriteString("🔍 Missing Interface Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, missing := range report.Localhost.MissingInterfaceCheck {
+			totalTests++
+			if missing.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Missing Interface Check: No missing PCIe interfaces detected (PASSED)\n")
+			} else if missing.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ Missing Interface Check: Missing VCN interfaces %s (WARNING)\n", strings.Join(missing.MissingVCNInterfaces, ", ")))
+			} else if len(missing.MissingRDMAInterfaces) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ Missing Interface Check: Missing RDMA interfaces %s (FAILED)\n", strings.Join(missing.MissingRDMAInterfaces, ", ")))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ Missing Interface Check: %d missing PCIe interface(s) detected (FAILED)\n", missing.MissingCount))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Row Remap Error Check Tests
+	if len(report.Localhost.RowRemapErrorCheck) > 0 {
+		output.WriteString("🛠️ Row Remap Error Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, rowRemap := range report.Localhost.RowRemapErrorCheck {
+			totalTests++
+			if rowRemap.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Row Remap Error Check: No GPU row remap errors detected (PASSED)\n")
+			} else if strings.Contains(rowRemap.Status, "Not applicable") {
+				// Count skipped tests as neither passed nor failed
+				totalTests-- // Adjust total count as SKIP doesn't count
+				output.WriteString("   ⏭️ Row Remap Error Check: Check skipped (nvidia-smi driver version < 550)\n")
+			} else {
+				failedTests++
+				if rowRemap.FailureCount > 0 {
+					output.WriteString(fmt.Sprintf("   ❌ Row Remap Error Check: %d GPU(s) with row remap failures detected (FAILED)\n", rowRemap.FailureCount))
+				} else {
+					output.WriteString("   ❌ Row Remap Error Check: GPU row remap error check failed (FAILED)\n")
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Fabric Manager Check Tests
+	if len(report.Localhost.FabricManagerCheck) > 0 {
+		output.WriteString("🔧 Fabric Manager Service Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, fabric := range report.Localhost.FabricManagerCheck {
+			totalTests++
+			if fabric.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Fabric Manager: nvidia-fabricmanager service is running (PASSED)\n")
+			} else if fabric.Status == "SKIP" {
+				// Count skipped tests as neither passed nor failed
+				totalTests-- // Adjust total count as SKIP doesn't count
+				output.WriteString("   ⏭️ Fabric Manager: Check skipped (not applicable for this shape)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ Fabric Manager: nvidia-fabricmanager service issues (FAILED)\n")
+			}
 		}
+		output.WriteString("\n")
+	}
 
-		// Try to parse as AppendedReport first
-		if err := json.Unmarshal(existingData, &appendedReport); err != nil {
-			// If that fails, try to parse as single ReportOutput (backward compatibility)
-			var singleReport ReportOutput
-			if err := json.Unmarshal(existingData, &singleReport); err != nil {
-				return fmt.Errorf("failed to parse existing file as JSON: %w", err)
+	// GPU XID Check Tests
+	if len(report.Localhost.GPUXIDCheck) > 0 {
+		output.WriteString("🎮 GPU XID Error Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, xid := range report.Localhost.GPUXIDCheck {
+			totalTests++
+			if xid.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU XID Check: No XID errors detected in system logs (PASSED)\n")
+			} else if xid.Status == "WARN" {
+				warnedTests++
+				if xid.Message != "" {
+					output.WriteString(fmt.Sprintf("   ⚠️ GPU XID Check: %s (WARNING)\n", xid.Message))
+				} else {
+					output.WriteString("   ⚠️ GPU XID Check: Warning XID errors detected in system logs (WARNING)\n")
+				}
+			} else {
+				failedTests++
+				if xid.Message != "" {
+					output.WriteString(fmt.Sprintf("   ❌ GPU XID Check: %s (FAILED)\n", xid.Message))
+				} else {
+					output.WriteString("   ❌ GPU XID Check: Critical XID errors detected in system logs (FAILED)\n")
+				}
 			}
-
-			// Convert single report to appended format
-			appendedReport.TestRuns = []TestRun{
-				{
-					RunID:       fmt.Sprintf("run_%d", time.Now().Unix()),
-					Timestamp:   time.Now().UTC().Format(time.RFC3339),
-					TestResults: singleReport.Localhost,
-				},
+			for _, breakdown := range xid.XIDBreakdown {
+				output.WriteString(fmt.Sprintf("      XID %s: %d occurrence(s) (%s)\n", breakdown.XIDCode, breakdown.Count, breakdown.Severity))
 			}
 		}
+		output.WriteString("\n")
 	}
 
-	// Add current test run
-	newRun := TestRun{
-		RunID:       fmt.Sprintf("run_%d", time.Now().Unix()),
-		Timestamp:   time.Now().UTC().Format(time.RFC3339),
-		TestResults: currentReport.Localhost,
+	// MAX_ACC Configuration Check
+	if len(report.Localhost.MaxAccCheck) > 0 {
+		output.WriteString("🔧 MAX_ACC Configuration Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, maxAcc := range report.Localhost.MaxAccCheck {
+			totalTests++
+			if maxAcc.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ MAX_ACC Check: ConnectX-7 NICs properly configured for optimal RDMA performance (PASSED)\n")
+			} else {
+				failedTests++
+				if maxAcc.Message != "" {
+					output.WriteString(fmt.Sprintf("   ❌ MAX_ACC Check: %s (FAILED)\n", maxAcc.Message))
+				} else {
+					output.WriteString("   ❌ MAX_ACC Check: ConnectX-7 NIC configuration issues detected (FAILED)\n")
+				}
+			}
+		}
+		output.WriteString("\n")
 	}
-	appendedReport.TestRuns = append(appendedReport.TestRuns, newRun)
 
-	// Write back to file
-	jsonData, err := json.MarshalIndent(appendedReport, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal appended report: %w", err)
+	// GPU VBIOS Check Tests
+	if len(report.Localhost.GPUVBIOSCheck) > 0 {
+		output.WriteString("🎮 GPU VBIOS Version Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, vbios := range report.Localhost.GPUVBIOSCheck {
+			totalTests++
+			if vbios.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU VBIOS: All GPUs running approved VBIOS versions (PASSED)\n")
+			} else if vbios.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU VBIOS: VBIOS version not in approved list (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU VBIOS: Blacklisted or unreadable VBIOS version detected (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
 	}
 
-	if err := os.WriteFile(r.outputFile, jsonData, 0644); err != nil {
-		return fmt.Errorf("failed to write appended report: %w", err)
+	// Hugepages Check Tests
+	if len(report.Localhost.HugepagesCheck) > 0 {
+		output.WriteString("📄 Hugepages Configuration Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, hugepages := range report.Localhost.HugepagesCheck {
+			totalTests++
+			if hugepages.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ Hugepages: %d x 2MB, %d x 1GB reserved persistently (PASSED)\n",
+					hugepages.Pages2MB, hugepages.Pages1GB))
+			} else {
+				failedTests++
+				persistence := "persistent"
+				if !hugepages.Persistent {
+					persistence = "not persistent"
+				}
+				output.WriteString(fmt.Sprintf("   ❌ Hugepages: %d x 2MB, %d x 1GB reserved, %s (FAILED)\n",
+					hugepages.Pages2MB, hugepages.Pages1GB, persistence))
+			}
+		}
+		output.WriteString("\n")
 	}
 
-	logger.Infof("Test results appended to file: %s", r.outputFile)
-	return nil
-}
-
-// formatJSON formats the report as JSON
-func (r *Reporter) formatJSON(report *ReportOutput) (string, error) {
-	jsonData, err := json.MarshalIndent(report, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal report to JSON: %w", err)
+	// NUMA Affinity Check Tests
+	if len(report.Localhost.NUMAAffinityCheck) > 0 {
+		output.WriteString("🧭 GPU NUMA Affinity Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, numa := range report.Localhost.NUMAAffinityCheck {
+			totalTests++
+			if numa.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ NUMA Affinity: All GPUs on the expected NUMA node (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ NUMA Affinity: GPU(s) on an unexpected NUMA node (FAILED)\n")
+			}
+		}
+		output.WriteString("\n")
 	}
-	return string(jsonData) + "\n", nil
-}
-
-// formatTable formats the report as a table
-func (r *Reporter) formatTable(report *ReportOutput) (string, error) {
-	var output strings.Builder
 
-	output.WriteString("┌─────────────────────────────────────────────────────────────────┐\n")
-	output.WriteString("│                    DIAGNOSTIC TEST RESULTS                      │\n")
-	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
-	output.WriteString("│ TEST NAME              │ STATUS  │ DETAILS                      │\n")
-	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
-
-	// GPU Tests
-	if len(report.Localhost.GPUCountCheck) > 0 {
-		for _, gpu := range report.Localhost.GPUCountCheck {
-			status := gpu.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// IB SM Check Tests
+	if len(report.Localhost.IBSMCheck) > 0 {
+		output.WriteString("🕸️ InfiniBand Subnet Manager Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, ibSM := range report.Localhost.IBSMCheck {
+			totalTests++
+			if ibSM.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ IB SM: All ports registered with the master subnet manager (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ IB SM: Subnet manager unreachable, missing or conflicting (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s GPU Count: %s           │\n",
-				"GPU Count Check", statusSymbol, statusSymbol, gpu.Status))
 		}
+		output.WriteString("\n")
 	}
 
-	// GPU Mode Tests
-	if len(report.Localhost.GPUModeCheck) > 0 {
-		for _, gpuMode := range report.Localhost.GPUModeCheck {
-			status := gpuMode.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "MIG Mode Disabled"
-			if len(gpuMode.EnabledGPUIndexes) > 0 {
-				details = fmt.Sprintf("MIG Enabled: %v", gpuMode.EnabledGPUIndexes)
+	// Time Sync Check Tests
+	if len(report.Localhost.TimeSyncCheck) > 0 {
+		output.WriteString("🕐 Time Synchronization Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, timeSync := range report.Localhost.TimeSyncCheck {
+			totalTests++
+			if timeSync.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ Time Sync: Synchronized, offset %.3fms (PASSED)\n", timeSync.OffsetMs))
+			} else if timeSync.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ Time Sync: Offset %.3fms above threshold or still synchronizing (WARNING)\n", timeSync.OffsetMs))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ Time Sync: Time service not running or offset is extreme (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
-				"GPU Mode Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// PCIe Tests
-	if len(report.Localhost.PCIeErrorCheck) > 0 {
-		for _, pcie := range report.Localhost.PCIeErrorCheck {
-			status := pcie.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// Kernel Module Check Tests
+	if len(report.Localhost.KernelModuleCheck) > 0 {
+		output.WriteString("🧩 Kernel Module Version Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, kernelModule := range report.Localhost.KernelModuleCheck {
+			totalTests++
+			if kernelModule.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Kernel Modules: nvidia and mlx5_core match userspace versions (PASSED)\n")
+			} else if kernelModule.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ Kernel Modules: mlx5_core does not match installed OFED (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ Kernel Modules: nvidia module does not match driver, rebuild required (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s PCIe Status: %s         │\n",
-				"PCIe Error Check", statusSymbol, statusSymbol, pcie.Status))
 		}
+		output.WriteString("\n")
 	}
 
-	// PCIe Width Tests
-	if len(report.Localhost.PCIeWidthMissingLanesCheck) > 0 {
-		for _, pcieWidth := range report.Localhost.PCIeWidthMissingLanesCheck {
-			status := pcieWidth.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// Systemd Service Check Tests
+	if len(report.Localhost.SystemdServiceCheck) > 0 {
+		output.WriteString("⚙️ Systemd Service Health Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, systemdService := range report.Localhost.SystemdServiceCheck {
+			totalTests++
+			if systemdService.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ Systemd Services: All services in the expected state (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ Systemd Services: Required service down or conflicting service running (FAILED)\n")
 			}
-			details := "PCIe Width Check: " + status
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s    │\n",
-				"PCIe Width Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// RDMA Tests
-	if len(report.Localhost.RDMANicsCount) > 0 {
-		for _, rdma := range report.Localhost.RDMANicsCount {
-			status := rdma.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// IOMMU Check Tests
+	if len(report.Localhost.IOMMUCheck) > 0 {
+		output.WriteString("🛡️ IOMMU Configuration Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, iommu := range report.Localhost.IOMMUCheck {
+			totalTests++
+			if iommu.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ IOMMU: Mode %s, %d IOMMU group(s) with GPUs (PASSED)\n", iommu.Mode, iommu.GPUIOMMUGroups))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ IOMMU: Mode %s not allowed for this shape (FAILED)\n", iommu.Mode))
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s RDMA NICs: %d             │\n",
-				"RDMA NIC Count", statusSymbol, statusSymbol, rdma.NumRDMANics))
 		}
+		output.WriteString("\n")
 	}
 
-	// Network Tests
-	if len(report.Localhost.RXDiscardsCheck) > 0 {
-		for _, network := range report.Localhost.RXDiscardsCheck {
-			status := network.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := fmt.Sprintf("Interfaces: %d", network.InterfaceCount)
-			if network.FailedCount > 0 {
-				details = fmt.Sprintf("Failed: %d/%d", network.FailedCount, network.InterfaceCount)
+	// NVLink Topology Check Tests
+	if len(report.Localhost.NVLinkTopologyCheck) > 0 {
+		output.WriteString("🔗 NVLink Topology Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, topology := range report.Localhost.NVLinkTopologyCheck {
+			totalTests++
+			if topology.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ NVLink Topology: All %d GPUs fully connected over NVLink (PASSED)\n", len(topology.Matrix)))
+			} else if len(topology.MissingConnections) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ NVLink Topology: Missing connections %s (FAILED)\n", strings.Join(topology.MissingConnections, ", ")))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ NVLink Topology: Could not verify NVLink topology (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s            │\n",
-				"Network RX Discards", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// GID Index Tests
-	if len(report.Localhost.GIDIndexCheck) > 0 {
-		for _, gid := range report.Localhost.GIDIndexCheck {
-			status := gid.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// PCIe Replay Check Tests
+	if len(report.Localhost.PCIeReplayCheck) > 0 {
+		output.WriteString("🔗 PCIe Replay Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcieReplay := range report.Localhost.PCIeReplayCheck {
+			totalTests++
+			if pcieReplay.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ PCIe Replays: Replay rates within limits on all GPUs (PASSED)\n")
+			} else if pcieReplay.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ PCIe Replays: High replay rate on GPU PCIe link(s), possible link degradation (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ PCIe Replays: Could not read PCIe replay counters (FAILED)\n")
 			}
-			details := "All indexes valid"
-			if len(gid.InvalidIndexes) > 0 {
-				details = fmt.Sprintf("invalid Index: %v", gid.InvalidIndexes)
+		}
+		output.WriteString("\n")
+	}
+
+	// RDMA Credit Check Tests
+	if len(report.Localhost.RDMACreditCheck) > 0 {
+		output.WriteString("🔗 RDMA Credit Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, rdmaCredit := range report.Localhost.RDMACreditCheck {
+			totalTests++
+			if rdmaCredit.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ RDMA Credits: No credit errors or buffer overflows on RDMA ports (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ RDMA Credits: Credit errors or buffer overflows found on RDMA port(s) (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
-				"GID Index Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// Link Check Tests
-	if len(report.Localhost.LinkCheck) > 0 {
-		for _, link := range report.Localhost.LinkCheck {
-			status := link.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// RDMA MTU Check Tests
+	if len(report.Localhost.RDMAMTUCheck) > 0 {
+		output.WriteString("🔗 RDMA MTU Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, rdmaMTU := range report.Localhost.RDMAMTUCheck {
+			totalTests++
+			if rdmaMTU.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ RDMA MTU: All RDMA interfaces have the expected MTU (PASSED)\n")
+			} else if rdmaMTU.FailedInterfaces != "" {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ RDMA MTU: MTU below expected value on %s (FAILED)\n", rdmaMTU.FailedInterfaces))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ RDMA MTU: Could not read RDMA interface MTUs (FAILED)\n")
 			}
-			details := "RDMA Links Checked"
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
-				"RDMA Link Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// Ethernet Link Check Tests
-	if len(report.Localhost.EthLinkCheck) > 0 {
-		for _, ethLink := range report.Localhost.EthLinkCheck {
-			status := ethLink.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// RDMA Topology Check Tests
+	if len(report.Localhost.RDMATopologyCheck) > 0 {
+		output.WriteString("🔗 RDMA Topology Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, rdmaTopology := range report.Localhost.RDMATopologyCheck {
+			totalTests++
+			if rdmaTopology.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ RDMA Topology: All %d expected RDMA devices present (PASSED)\n", len(rdmaTopology.ExpectedDevices)))
+			} else if rdmaTopology.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ RDMA Topology: Unexpected RDMA devices %s (WARNING)\n", strings.Join(rdmaTopology.ExtraDevices, ", ")))
+			} else if len(rdmaTopology.MissingDevices) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ RDMA Topology: Missing RDMA devices %s (FAILED)\n", strings.Join(rdmaTopology.MissingDevices, ", ")))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ RDMA Topology: Could not discover RDMA devices (FAILED)\n")
 			}
-			details := "Ethernet Links Checked"
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s    │\n",
-				"Ethernet Link Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// Auth Check Tests
-	if len(report.Localhost.AuthCheck) > 0 {
-		for _, auth := range report.Localhost.AuthCheck {
-			status := auth.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// BIOS Settings Check Tests
+	if len(report.Localhost.BIOSSettingsCheck) > 0 {
+		output.WriteString("🧬 BIOS Settings Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, biosSettings := range report.Localhost.BIOSSettingsCheck {
+			totalTests++
+			hyperthreading := "disabled"
+			if biosSettings.HyperthreadingEnabled {
+				hyperthreading = "enabled"
+			}
+			if biosSettings.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ BIOS Settings: BIOS %s, hyperthreading %s (PASSED)\n", biosSettings.BIOSVersion, hyperthreading))
+			} else if biosSettings.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ BIOS Settings: Unexpected hyperthreading state, %s (WARNING)\n", hyperthreading))
+			} else if biosSettings.BIOSVersion != "" && !biosSettings.VersionSupported {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ BIOS Settings: BIOS %s is older than the minimum supported version (FAILED)\n", biosSettings.BIOSVersion))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ BIOS Settings: Could not read BIOS settings (FAILED)\n")
 			}
-			details := "RDMA Auth Checked"
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
-				"Authentication Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// SRAM Tests
-	if len(report.Localhost.SRAMErrorCheck) > 0 {
-		for _, sram := range report.Localhost.SRAMErrorCheck {
-			status := sram.Status
-			statusSymbol := "✅"
-			if status == "FAIL" || status == "WARN" {
-				statusSymbol = "❌"
+	// GPU InfoROM Check Tests
+	if len(report.Localhost.GPUInfoROMCheck) > 0 {
+		output.WriteString("🎮 GPU InfoROM Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, inforom := range report.Localhost.GPUInfoROMCheck {
+			totalTests++
+			if inforom.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU InfoROM: All GPU InfoROMs readable (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU InfoROM: Corrupted, missing or unreadable InfoROM detected (FAILED)\n")
 			}
-			details := fmt.Sprintf("Uncorr: %d, Corr: %d", sram.MaxUncorrectable, sram.MaxCorrectable)
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
-				"SRAM Error Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// GPU Driver Check Tests
-	if len(report.Localhost.GPUDriverCheck) > 0 {
-		for _, driver := range report.Localhost.GPUDriverCheck {
-			status := driver.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			} else if status == "WARN" {
-				statusSymbol = "⚠️"
-			}
-			details := fmt.Sprintf("Version: %s", driver.DriverVersion)
-			if len(details) > 25 {
-				details = details[:22] + "..."
+	// GPU Row Remap Check Tests
+	if len(report.Localhost.GPURowRemapCheck) > 0 {
+		output.WriteString("🎮 GPU Row Remap Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, rowRemap := range report.Localhost.GPURowRemapCheck {
+			totalTests++
+			if rowRemap.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU Row Remap: No row remap failures or pending remaps (PASSED)\n")
+			} else if rowRemap.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU Row Remap: Row remap pending, reboot required (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU Row Remap: Row remap failure or unreadable remapper state (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
-				"GPU Driver Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// GPU Clock Check Tests
-	if len(report.Localhost.GPUClockCheck) > 0 {
-		for _, clock := range report.Localhost.GPUClockCheck {
-			status := clock.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "Clock Speeds OK"
-			if status == "FAIL" {
-				details = "Clock Speed Issues"
-			} else if clock.Message != "" {
-				details = clock.Message
-				if len(details) > 25 {
-					details = details[:22] + "..."
-				}
+	// CPU Governor Check Tests
+	if len(report.Localhost.CPUGovernorCheck) > 0 {
+		output.WriteString("⚡ CPU Governor Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, cpuGovernor := range report.Localhost.CPUGovernorCheck {
+			totalTests++
+			if cpuGovernor.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ CPU Governor: All CPUs use the %s governor (PASSED)\n", cpuGovernor.DetectedGovernor))
+			} else if cpuGovernor.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ CPU Governor: %d CPU(s) not set to %s (WARNING)\n", len(cpuGovernor.DifferingCPUs), cpuGovernor.ExpectedGovernor))
+			} else if cpuGovernor.DetectedGovernor != "" {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ CPU Governor: Governor is %s, expected %s (FAILED)\n", cpuGovernor.DetectedGovernor, cpuGovernor.ExpectedGovernor))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ CPU Governor: Could not read CPU scaling governors (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s        │\n",
-				"GPU Clock Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// PeerMem Module Tests
-	if len(report.Localhost.PeerMemModuleCheck) > 0 {
-		for _, peerMem := range report.Localhost.PeerMemModuleCheck {
-			status := peerMem.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "Module Loaded"
-			if !peerMem.ModuleLoaded {
-				details = "Module Not Loaded"
+	// GPU Firmware Check Tests
+	if len(report.Localhost.GPUFirmwareCheck) > 0 {
+		output.WriteString("🎮 GPU Firmware Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, firmware := range report.Localhost.GPUFirmwareCheck {
+			totalTests++
+			if firmware.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU Firmware: All GPUs run the same supported GSP firmware (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU Firmware: Outdated, inconsistent or unreadable GSP firmware detected (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s             │\n",
-				"PeerMem Module Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// NVLink Tests
-	if len(report.Localhost.NVLinkSpeedCheck) > 0 {
-		for _, nvlink := range report.Localhost.NVLinkSpeedCheck {
-			status := nvlink.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "NVLink Speed/Count OK"
-			if status == "FAIL" {
-				details = "NVLink Issues Found"
+	// GPU P2P Bandwidth Check Tests
+	if len(report.Localhost.GPUP2PBWCheck) > 0 {
+		output.WriteString("🎮 GPU P2P Bandwidth Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, p2p := range report.Localhost.GPUP2PBWCheck {
+			totalTests++
+			if p2p.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ GPU P2P Bandwidth: All NVLink GPU pairs reach %.0f GB/s (PASSED)\n", p2p.MinBandwidthGBps))
+			} else if p2p.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ GPU P2P Bandwidth: Low bandwidth on %s (WARNING)\n", strings.Join(p2p.LowBandwidthPairs, ", ")))
+			} else if len(p2p.LowBandwidthPairs) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ GPU P2P Bandwidth: Low bandwidth on %s (FAILED)\n", strings.Join(p2p.LowBandwidthPairs, ", ")))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU P2P Bandwidth: Could not measure P2P bandwidth (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s     │\n",
-				"NVLink Speed Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// Eth0 Presence Tests
-	if len(report.Localhost.Eth0PresenceCheck) > 0 {
-		for _, eth0 := range report.Localhost.Eth0PresenceCheck {
-			status := eth0.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "eth0 Interface Present"
-			if !eth0.Eth0Present {
-				details = "eth0 Interface Missing"
+	// CPU Isolation Check Tests
+	if len(report.Localhost.CPUIsolationCheck) > 0 {
+		output.WriteString("⚡ CPU Isolation Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, isolation := range report.Localhost.CPUIsolationCheck {
+			totalTests++
+			if isolation.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ CPU Isolation: %d CPUs isolated (PASSED)\n", isolation.IsolatedCPUCount))
+			} else if isolation.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ CPU Isolation: %d of %d expected CPUs isolated, nohz_full matches: %t (WARNING)\n",
+					isolation.IsolatedCPUCount, isolation.ExpectedIsolatedCPUCount, isolation.NohzFullMatches))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ CPU Isolation: Could not read CPU isolation configuration (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s    │\n",
-				"Eth0 Presence Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// CDFP Cable Check Tests
-	if len(report.Localhost.CDFPCableCheck) > 0 {
-		for _, cdfp := range report.Localhost.CDFPCableCheck {
-			status := cdfp.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			} else if status == "SKIP" {
-				statusSymbol = "⏭️"
-			}
-			details := "CDFP Cables OK"
-			if status == "FAIL" {
-				details = "CDFP Cable Issues"
-			} else if status == "SKIP" {
-				details = "CDFP Check Skipped"
-			}
-			// Calculate padding to align with 67-character table width
-			contentLength := 1 + 22 + 3 + 6 + 3 + 1 + len(statusSymbol) + 1 + len(details)
-			padding := 67 - contentLength - 1 // -1 for final │
-			if padding < 0 {
-				padding = 0
+	// PCIe Gen Check Tests
+	if len(report.Localhost.PCIeGenCheck) > 0 {
+		output.WriteString("🔌 PCIe Gen Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcieGen := range report.Localhost.PCIeGenCheck {
+			totalTests++
+			if pcieGen.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ PCIe Gen: All GPU and NIC links run at full generation and width (PASSED)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ PCIe Gen: Downgraded or unreadable PCIe link detected (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s%s│\n",
-				"CDFP Cable Check", statusSymbol, statusSymbol, details, strings.Repeat(" ", padding)))
 		}
+		output.WriteString("\n")
 	}
 
-	// Fabric Manager Check Tests
-	if len(report.Localhost.FabricManagerCheck) > 0 {
-		for _, fabric := range report.Localhost.FabricManagerCheck {
-			status := fabric.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			} else if status == "SKIP" {
-				statusSymbol = "⏭️"
-			}
-			details := "Service Running"
-			if status == "FAIL" {
-				details = "Service Issues"
-			} else if status == "SKIP" {
-				details = "Check Skipped"
-			}
-			// Calculate padding to align with 67-character table width
-			contentLength := 1 + 22 + 3 + 6 + 3 + 1 + len(statusSymbol) + 1 + len(details)
-			padding := 67 - contentLength - 1 // -1 for final │
-			if padding < 0 {
-				padding = 0
+	// GPU C-State Check Tests
+	if len(report.Localhost.GPUCStateCheck) > 0 {
+		output.WriteString("💤 GPU C-State Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, cstate := range report.Localhost.GPUCStateCheck {
+			totalTests++
+			if cstate.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU C-State: All GPUs in P0 with runtime power management disabled (PASSED)\n")
+			} else if cstate.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU C-State: One or more GPUs not in power state P0 (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU C-State: Runtime power management enabled or power state unreadable (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s%s│\n",
-				"Fabric Manager Check", statusSymbol, statusSymbol, details, strings.Repeat(" ", padding)))
 		}
+		output.WriteString("\n")
 	}
 
-	// HCA Error Check Tests
-	if len(report.Localhost.HCAErrorCheck) > 0 {
-		for _, hca := range report.Localhost.HCAErrorCheck {
-			status := hca.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "No MLX5 Fatal Errors"
-			if status == "FAIL" {
-				details = "MLX5 Fatal Errors Found"
+	// NFS Mount Check Tests
+	if len(report.Localhost.NFSMountCheck) > 0 {
+		output.WriteString("📁 NFS Mount Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, nfsMount := range report.Localhost.NFSMountCheck {
+			totalTests++
+			if nfsMount.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ NFS Mounts: All expected NFS mounts are mounted and responsive (PASSED)\n")
+			} else if nfsMount.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ NFS Mounts: One or more NFS mounts respond slowly (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ NFS Mounts: Expected NFS mount missing or not responding (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
-				"HCA Error Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// Missing Interface Check Tests
-	if len(report.Localhost.MissingInterfaceCheck) > 0 {
-		for _, missing := range report.Localhost.MissingInterfaceCheck {
-			status := missing.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// BER Trend Check Tests
+	if len(report.Localhost.BERTrendCheck) > 0 {
+		output.WriteString("📈 BER Trend Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, berTrend := range report.Localhost.BERTrendCheck {
+			totalTests++
+			if berTrend.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ BER Trend: Effective physical BER is not increasing on any RDMA interface (PASSED)\n")
+			} else if berTrend.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ BER Trend: Effective physical BER increasing on one or more RDMA interfaces (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ BER Trend: Report history could not be read (FAILED)\n")
 			}
-			details := "No Missing Interfaces"
-			if status == "FAIL" {
-				details = fmt.Sprintf("%d Missing Interface(s)", missing.MissingCount)
+		}
+		output.WriteString("\n")
+	}
+
+	// IB Cable Check Tests
+	if len(report.Localhost.IBCableCheck) > 0 {
+		output.WriteString("📶 IB Cable Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, ibCable := range report.Localhost.IBCableCheck {
+			totalTests++
+			if ibCable.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ IB Cables: Optical signal quality within nominal range (PASSED)\n")
+			} else if ibCable.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ IB Cables: Optical signal quality outside nominal range (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ IB Cables: Optical signal quality outside absolute limits (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s     │\n",
-				"Missing Interface", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// GPU XID Check Tests
-	if len(report.Localhost.GPUXIDCheck) > 0 {
-		for _, xid := range report.Localhost.GPUXIDCheck {
-			status := xid.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			} else if status == "WARN" {
-				statusSymbol = "⚠️"
-			}
-			details := "No GPU XID Errors"
-			if status == "FAIL" {
-				details = "GPU XID Errors Found"
-			} else if status == "WARN" {
-				details = "GPU XID Warnings Found"
+	// PCIe ReBAR Check Tests
+	if len(report.Localhost.PCIeReBARCheck) > 0 {
+		output.WriteString("🗺️ PCIe ReBAR Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcieReBAR := range report.Localhost.PCIeReBARCheck {
+			totalTests++
+			if pcieReBAR.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU BAR1: Mapped at full size on all GPUs (PASSED)\n")
+			} else if pcieReBAR.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU BAR1: Mapped below the full expected size on one or more GPUs (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU BAR1: Resizable BAR disabled on one or more GPUs (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s         │\n",
-				"GPU XID Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	// MAX_ACC Configuration Check Tests
-	if len(report.Localhost.MaxAccCheck) > 0 {
-		for _, maxAcc := range report.Localhost.MaxAccCheck {
-			status := maxAcc.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
+	// GPU Idle Check Tests
+	if len(report.Localhost.GPUIdleCheck) > 0 {
+		output.WriteString("💤 GPU Idle Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, gpuIdle := range report.Localhost.GPUIdleCheck {
+			totalTests++
+			if gpuIdle.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU Workload: No GPU workload running during diagnostics (PASSED)\n")
+			} else if gpuIdle.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU Workload: GPU workload running, results may be inaccurate (WARNING)\n")
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU Workload: Could not query GPU utilization (FAILED)\n")
 			}
-			details := "ConnectX-7 Config OK"
-			if status == "FAIL" {
-				if maxAcc.Message != "" {
-					// Truncate message if too long for table
-					if len(maxAcc.Message) > 25 {
-						details = maxAcc.Message[:22] + "..."
-					} else {
-						details = maxAcc.Message
-					}
-				} else {
-					details = "Config Issues Detected"
+		}
+		output.WriteString("\n")
+	}
+
+	// PCIe Device Count Tests
+	if len(report.Localhost.PCIeDeviceCountCheck) > 0 {
+		output.WriteString("🧮 PCIe Device Count Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcieDeviceCount := range report.Localhost.PCIeDeviceCountCheck {
+			totalTests++
+			counts := fmt.Sprintf("%d/%d GPUs, %d/%d RDMA NICs", pcieDeviceCount.FoundGPUCount, pcieDeviceCount.ExpectedGPUCount,
+				pcieDeviceCount.FoundNICCount, pcieDeviceCount.ExpectedNICCount)
+			if pcieDeviceCount.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ PCIe Devices: %s enumerated (PASSED)\n", counts))
+			} else {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ PCIe Devices: %s enumerated (FAILED)\n", counts))
+				for _, bdf := range pcieDeviceCount.MissingGPUBDFs {
+					output.WriteString(fmt.Sprintf("      Missing GPU: %s\n", bdf))
+				}
+				for _, bdf := range pcieDeviceCount.MissingNICBDFs {
+					output.WriteString(fmt.Sprintf("      Missing RDMA NIC: %s\n", bdf))
 				}
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s      │\n",
-				"MAX_ACC Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
-			
-	// Row Remap Error Check Tests
-	if len(report.Localhost.RowRemapErrorCheck) > 0 {
-		for _, rowRemap := range report.Localhost.RowRemapErrorCheck {
-			status := rowRemap.Status
-			statusSymbol := "✅"
-			if status == "FAIL" {
-				statusSymbol = "❌"
-			}
-			details := "No Row Remap Errors"
-			if status == "FAIL" {
-				details = fmt.Sprintf("%d GPU(s) with Failures", rowRemap.FailureCount)
-			} else if strings.Contains(status, "Not applicable") {
-				statusSymbol = "⏭️"
-				details = "Driver Version < 550"
+
+	// GPU Firmware Update Tests
+	if len(report.Localhost.GPUFirmwareUpdateCheck) > 0 {
+		output.WriteString("🆙 GPU Firmware Update Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, gpuFirmwareUpdate := range report.Localhost.GPUFirmwareUpdateCheck {
+			totalTests++
+			if gpuFirmwareUpdate.Status == "PASS" {
+				passedTests++
+				output.WriteString("   ✅ GPU Firmware Update: GSP and VBIOS firmware match the latest recommended versions (PASSED)\n")
+			} else if gpuFirmwareUpdate.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU Firmware Update: Newer GSP or VBIOS firmware is available (WARNING)\n")
+				output.WriteString(fmt.Sprintf("      Current: %s\n", gpuFirmwareUpdate.CurrentVersion))
+				output.WriteString(fmt.Sprintf("      Latest: %s\n", gpuFirmwareUpdate.LatestVersion))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ GPU Firmware Update: GPU firmware versions could not be read (FAILED)\n")
 			}
-			output.WriteString(fmt.Sprintf("│ %-22s │ %-6s │ %s %s       │\n",
-				"Row Remap Check", statusSymbol, statusSymbol, details))
 		}
+		output.WriteString("\n")
 	}
 
-	output.WriteString("└─────────────────────────────────────────────────────────────────┘\n")
-	return output.String(), nil
-}
-
-// formatFriendly formats the report in a user-friendly format
-func (r *Reporter) formatFriendly(report *ReportOutput) (string, error) {
-	var output strings.Builder
-
-	output.WriteString("🔍 HPC Diagnostic Results\n")
-	output.WriteString("=" + strings.Repeat("=", 50) + "\n\n")
-
-	totalTests := 0
-	passedTests := 0
-	failedTests := 0
-
-	// GPU Tests
-	if len(report.Localhost.GPUCountCheck) > 0 {
-		output.WriteString("🖥️  GPU Health Check\n")
+	// GPU Reset History Tests
+	if len(report.Localhost.GPUResetCheck) > 0 {
+		output.WriteString("🔁 GPU Reset Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, gpu := range report.Localhost.GPUCountCheck {
+		for _, gpuReset := range report.Localhost.GPUResetCheck {
 			totalTests++
-			if gpu.Status == "PASS" {
+			if gpuReset.Status == "PASS" {
 				passedTests++
-				output.WriteString(fmt.Sprintf("   ✅ GPU Count: %d (PASSED)\n", gpu.GPUCount))
+				output.WriteString("   ✅ GPU Resets: GPU resets since boot within the threshold (PASSED)\n")
+			} else if gpuReset.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU Resets: GPU resets since boot within the threshold (WARNING)\n")
 			} else {
 				failedTests++
-				output.WriteString(fmt.Sprintf("   ❌ GPU Count: %d (FAILED)\n", gpu.GPUCount))
+				output.WriteString("   ❌ GPU Resets: GPU reset more often than the threshold since boot (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// GPU Mode Tests
-	if len(report.Localhost.GPUModeCheck) > 0 {
-		output.WriteString("🖥️  GPU Mode Check\n")
+	// RDMA Interface Speed Tests
+	if len(report.Localhost.RDMAInterfaceSpeedCheck) > 0 {
+		output.WriteString("🚀 RDMA Interface Speed Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, gpuMode := range report.Localhost.GPUModeCheck {
+		for _, rdmaInterfaceSpeed := range report.Localhost.RDMAInterfaceSpeedCheck {
 			totalTests++
-			if gpuMode.Status == "PASS" {
+			if rdmaInterfaceSpeed.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ GPU Mode: MIG disabled on all GPUs (PASSED)\n")
+				output.WriteString("   ✅ RDMA Speed: All RDMA interfaces run at the expected speed (PASSED)\n")
+			} else if rdmaInterfaceSpeed.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ RDMA Speed: All RDMA interfaces run at the expected speed (WARNING)\n")
 			} else {
 				failedTests++
-				if len(gpuMode.EnabledGPUIndexes) > 0 {
-					output.WriteString(fmt.Sprintf("   ❌ GPU Mode: MIG enabled on GPUs %v (FAILED)\n", gpuMode.EnabledGPUIndexes))
-				} else {
-					output.WriteString("   ❌ GPU Mode: Check failed (FAILED)\n")
+				output.WriteString("   ❌ RDMA Speed: RDMA interface(s) below the expected speed (FAILED)\n")
+				if rdmaInterfaceSpeed.FailedInterfaces != "" {
+					output.WriteString(fmt.Sprintf("      Interfaces: %s\n", rdmaInterfaceSpeed.FailedInterfaces))
 				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// PCIe Tests
-	if len(report.Localhost.PCIeErrorCheck) > 0 {
-		output.WriteString("🔗 PCIe Health Check\n")
+	// IB Port State Tests
+	if len(report.Localhost.IBPortStateCheck) > 0 {
+		output.WriteString("🔌 IB Port State Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, pcie := range report.Localhost.PCIeErrorCheck {
+		for _, ibPortState := range report.Localhost.IBPortStateCheck {
 			totalTests++
-			if pcie.Status == "PASS" {
+			if ibPortState.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ PCIe Bus: No errors detected (PASSED)\n")
+				output.WriteString("   ✅ IB Ports: All ports are Active with physical state LinkUp (PASSED)\n")
+			} else if ibPortState.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ IB Ports: All ports are Active with physical state LinkUp (WARNING)\n")
 			} else {
 				failedTests++
-				output.WriteString("   ❌ PCIe Bus: Errors detected (FAILED)\n")
+				output.WriteString("   ❌ IB Ports: Port(s) not in the expected state (FAILED)\n")
+				if ibPortState.FailedPorts != "" {
+					output.WriteString(fmt.Sprintf("      Ports: %s\n", ibPortState.FailedPorts))
+				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// PCIe Width Tests
-	if len(report.Localhost.PCIeWidthMissingLanesCheck) > 0 {
-		output.WriteString("📏 PCIe Width Check\n")
+	// NIC Firmware Tests
+	if len(report.Localhost.NICFirmwareCheck) > 0 {
+		output.WriteString("💾 NIC Firmware Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, pcieWidth := range report.Localhost.PCIeWidthMissingLanesCheck {
+		for _, nicFirmware := range report.Localhost.NICFirmwareCheck {
 			totalTests++
-			if pcieWidth.Status == "PASS" {
+			if nicFirmware.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ PCIe Link Width: All lanes operating at expected width (PASSED)\n")
-				if len(pcieWidth.GPUWidthCounts) > 0 {
-					output.WriteString("      GPU/NVSwitch widths: ")
-					for width, count := range pcieWidth.GPUWidthCounts {
-						output.WriteString(fmt.Sprintf("%dx %s ", count, width))
-					}
-					output.WriteString("\n")
-				}
-				if len(pcieWidth.RDMAWidthCounts) > 0 {
-					output.WriteString("      RDMA widths: ")
-					for width, count := range pcieWidth.RDMAWidthCounts {
-						output.WriteString(fmt.Sprintf("%dx %s ", count, width))
-					}
-					output.WriteString("\n")
-				}
+				output.WriteString("   ✅ NIC Firmware: All NICs run the same supported firmware (PASSED)\n")
+			} else if nicFirmware.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ NIC Firmware: All NICs run the same supported firmware (WARNING)\n")
 			} else {
 				failedTests++
-				output.WriteString("   ❌ PCIe Link Width: Missing lanes detected (FAILED)\n")
-				output.WriteString("      ⚠️  Please reboot the host and if the issue persists, send the node to OCI\n")
+				output.WriteString("   ❌ NIC Firmware: Outdated, inconsistent or unreadable NIC firmware detected (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// RDMA Tests
-	if len(report.Localhost.RDMANicsCount) > 0 {
-		output.WriteString("🌐 RDMA Network Check\n")
+	// RDMA PCI Mapping Tests
+	if len(report.Localhost.RDMAPCIMappingCheck) > 0 {
+		output.WriteString("🗺️ RDMA PCI Mapping Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, rdma := range report.Localhost.RDMANicsCount {
+		for _, rdmaPCIMapping := range report.Localhost.RDMAPCIMappingCheck {
 			totalTests++
-			if rdma.Status == "PASS" {
+			if rdmaPCIMapping.Status == "PASS" {
 				passedTests++
-				output.WriteString(fmt.Sprintf("   ✅ RDMA NICs: %d detected (PASSED)\n", rdma.NumRDMANics))
+				output.WriteString("   ✅ RDMA PCI Mapping: All RDMA devices at the expected PCI addresses (PASSED)\n")
+			} else if len(rdmaPCIMapping.MismatchedBDFs) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ RDMA PCI Mapping: Unexpected device at %s (FAILED)\n", strings.Join(rdmaPCIMapping.MismatchedBDFs, ", ")))
 			} else {
 				failedTests++
-				output.WriteString(fmt.Sprintf("   ❌ RDMA NICs: %d detected (FAILED)\n", rdma.NumRDMANics))
+				output.WriteString("   ❌ RDMA PCI Mapping: Check failed (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Network Tests
-	if len(report.Localhost.RXDiscardsCheck) > 0 {
-		output.WriteString("🌐 Network RX Discards Check\n")
+	// GPU PCIe Topology Tests
+	if len(report.Localhost.GPUPCIeTopoCheck) > 0 {
+		output.WriteString("🔀 GPU PCIe Topology Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, network := range report.Localhost.RXDiscardsCheck {
+		for _, gpuPCIeTopo := range report.Localhost.GPUPCIeTopoCheck {
 			totalTests++
-			if network.Status == "PASS" {
+			if gpuPCIeTopo.Status == "PASS" {
 				passedTests++
-				output.WriteString(fmt.Sprintf("   ✅ Network Interfaces: %d checked, no RX discard issues (PASSED)\n", network.InterfaceCount))
+				output.WriteString("   ✅ GPU P2P: All GPU pairs are peer-to-peer accessible (PASSED)\n")
+			} else if len(gpuPCIeTopo.UnavailablePairs) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ GPU P2P: No P2P read access for %s (FAILED)\n", strings.Join(gpuPCIeTopo.UnavailablePairs, ", ")))
+			} else if len(gpuPCIeTopo.NonNVLinkPairs) > 0 {
+				failedTests++
+				output.WriteString(fmt.Sprintf("   ❌ GPU P2P: Connected over PCIe instead of NVLink for %s (FAILED)\n", strings.Join(gpuPCIeTopo.NonNVLinkPairs, ", ")))
 			} else {
 				failedTests++
-				if network.FailedCount > 0 {
-					output.WriteString(fmt.Sprintf("   ❌ Network Interfaces: %d failed out of %d checked (FAILED)\n", network.FailedCount, network.InterfaceCount))
-				} else {
-					output.WriteString(fmt.Sprintf("   ❌ Network Interfaces: RX discard check failed (FAILED)\n"))
-				}
+				output.WriteString("   ❌ GPU P2P: Check failed (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// GID Index Tests
-	if len(report.Localhost.GIDIndexCheck) > 0 {
-		output.WriteString("🔗 GID Index Check\n")
+	// MLXConfig Tests
+	if len(report.Localhost.MLXConfigCheck) > 0 {
+		output.WriteString("🛠️ MLXConfig Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, gid := range report.Localhost.GIDIndexCheck {
+		for _, mlxConfig := range report.Localhost.MLXConfigCheck {
 			totalTests++
-			if gid.Status == "PASS" {
+			if mlxConfig.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ GID Indexes: All valid (PASSED)\n")
-			} else {
-				failedTests++
-				if len(gid.InvalidIndexes) > 0 {
-					output.WriteString(fmt.Sprintf("   ❌ GID Indexes: Invalid indexes found %v (FAILED)\n", gid.InvalidIndexes))
-				} else {
-					output.WriteString("   ❌ GID Indexes: Check failed (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ✅ MLXConfig: All %d parameters configured correctly (PASSED)\n", len(mlxConfig.Parameters)))
+				continue
+			}
+			failedTests++
+			if len(mlxConfig.Parameters) == 0 {
+				output.WriteString("   ❌ MLXConfig: Check failed (FAILED)\n")
+				continue
+			}
+			output.WriteString("   ❌ MLXConfig: Parameters not as expected (FAILED)\n")
+			for _, parameter := range mlxConfig.Parameters {
+				if parameter.Status == "PASS" {
+					continue
+				}
+				actual := parameter.Actual
+				if actual == "" {
+					actual = "missing"
 				}
+				output.WriteString(fmt.Sprintf("      %s %s: %s (expected %s)\n",
+					parameter.PCIBusID, parameter.Parameter, actual, strings.Join(parameter.Expected, " or ")))
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Link Check Tests
-	if len(report.Localhost.LinkCheck) > 0 {
-		output.WriteString("🌐 RDMA Link Health Check\n")
+	// GPU Power Rail Tests
+	if len(report.Localhost.GPUPowerRailCheck) > 0 {
+		output.WriteString("🔌 GPU Power Rail Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, link := range report.Localhost.LinkCheck {
+		for _, gpuPowerRail := range report.Localhost.GPUPowerRailCheck {
 			totalTests++
-			if link.Status == "PASS" {
+			if gpuPowerRail.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ RDMA Links: All links healthy (PASSED)\n")
+				output.WriteString(fmt.Sprintf("   ✅ GPU Power Rail: All readings within bounds of %.0f W TDP (PASSED)\n", gpuPowerRail.TDPWatts))
+			} else if gpuPowerRail.Status == "WARN" {
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ GPU Power Rail: Readings near bounds of %.0f W TDP (WARNING)\n", gpuPowerRail.TDPWatts))
 			} else {
 				failedTests++
-				output.WriteString("   ❌ RDMA Links: Link issues detected (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ GPU Power Rail: Readings out of bounds of %.0f W TDP or unreadable (FAILED)\n", gpuPowerRail.TDPWatts))
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Ethernet Link Check Tests
-	if len(report.Localhost.EthLinkCheck) > 0 {
-		output.WriteString("🌐 Ethernet Link Health Check\n")
+	// GPU Temperature Tests
+	if len(report.Localhost.GPUTemperatureCheck) > 0 {
+		output.WriteString("🌡️ GPU Temperature Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, ethLink := range report.Localhost.EthLinkCheck {
+		for _, gpuTemperature := range report.Localhost.GPUTemperatureCheck {
 			totalTests++
-			if ethLink.Status == "PASS" {
+			if gpuTemperature.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ Ethernet Links: All links healthy (PASSED)\n")
+				output.WriteString("   ✅ GPU Temperature: All GPU core and memory temperatures below warning thresholds (PASSED)\n")
+			} else if gpuTemperature.Status == "WARN" {
+				warnedTests++
+				output.WriteString("   ⚠️ GPU Temperature: One or more GPUs above warning threshold (WARNING)\n")
 			} else {
 				failedTests++
-				output.WriteString("   ❌ Ethernet Links: Link issues detected (FAILED)\n")
+				output.WriteString("   ❌ GPU Temperature: One or more GPUs above critical threshold or temperatures unreadable (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Auth Check Tests
-	if len(report.Localhost.AuthCheck) > 0 {
-		output.WriteString("🔐 Authentication Check\n")
+	// RoCE VLAN Tests
+	if len(report.Localhost.RoCEVLANCheck) > 0 {
+		output.WriteString("🏷️ RoCE VLAN Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, auth := range report.Localhost.AuthCheck {
+		for _, roceVLAN := range report.Localhost.RoCEVLANCheck {
 			totalTests++
-			if auth.Status == "PASS" {
+			if roceVLAN.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ Authentication: All RDMA interfaces authenticated (PASSED)\n")
+				output.WriteString("   ✅ RoCE VLAN: All RoCE interfaces have the expected VLAN tagging (PASSED)\n")
 			} else {
 				failedTests++
-				output.WriteString("   ❌ Authentication: RDMA interface authentication issues (FAILED)\n")
+				output.WriteString("   ❌ RoCE VLAN: Incorrect or missing VLAN tagging on RoCE interfaces (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// SRAM Tests
-	if len(report.Localhost.SRAMErrorCheck) > 0 {
-		output.WriteString("💾 SRAM Error Check\n")
+	// IB Switch Port Tests
+	if len(report.Localhost.IBSwitchPortCheck) > 0 {
+		output.WriteString("🔀 InfiniBand Switch Port Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, sram := range report.Localhost.SRAMErrorCheck {
+		for _, ibSwitchPort := range report.Localhost.IBSwitchPortCheck {
 			totalTests++
-			if sram.Status == "PASS" {
+			switch ibSwitchPort.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString(fmt.Sprintf("   ✅ SRAM Errors: Uncorrectable: %d, Correctable: %d (PASSED)\n",
-					sram.MaxUncorrectable, sram.MaxCorrectable))
-			} else {
+				output.WriteString("   ✅ IB Switch Ports: All switch ports match the NIC port state without errors (PASSED)\n")
+			case "WARN":
+				warnedTests++
+				output.WriteString("   ⚠️ IB Switch Ports: Switch port state differs from the NIC port state (WARNING)\n")
+			default:
 				failedTests++
-				if sram.Status == "WARN" {
-					output.WriteString(fmt.Sprintf("   ⚠️  SRAM Errors: Uncorrectable: %d, Correctable: %d (WARNING)\n",
-						sram.MaxUncorrectable, sram.MaxCorrectable))
-				} else {
-					output.WriteString(fmt.Sprintf("   ❌ SRAM Errors: Uncorrectable: %d, Correctable: %d (FAILED)\n",
-						sram.MaxUncorrectable, sram.MaxCorrectable))
+				output.WriteString("   ❌ IB Switch Ports: Switch ports report errors (FAILED)\n")
+			}
+			for _, port := range ibSwitchPort.Ports {
+				if port.Status != "PASS" {
+					output.WriteString(fmt.Sprintf("      %s/%d -> switch LID %d port %d: %s\n", port.Device, port.Port, port.SwitchLID, port.SwitchPort, port.Status))
 				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// GPU Driver Check Tests
-	if len(report.Localhost.GPUDriverCheck) > 0 {
-		output.WriteString("🎮 GPU Driver Health Check\n")
+	// GPU-CPU Bandwidth Tests
+	if len(report.Localhost.GPUCPUBWCheck) > 0 {
+		output.WriteString("🚚 GPU-CPU Bandwidth Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, driver := range report.Localhost.GPUDriverCheck {
+		for _, gpuCPUBW := range report.Localhost.GPUCPUBWCheck {
 			totalTests++
-			if driver.Status == "PASS" {
-				passedTests++
-				output.WriteString(fmt.Sprintf("   ✅ GPU Driver: Version %s (PASSED)\n", driver.DriverVersion))
-			} else if driver.Status == "WARN" {
-				// Count warnings as passed but note them
+			switch gpuCPUBW.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString(fmt.Sprintf("   ⚠️ GPU Driver: Version %s (WARNING - unsupported)\n", driver.DriverVersion))
-			} else {
+				output.WriteString(fmt.Sprintf("   ✅ GPU-CPU Bandwidth: All GPUs reach %.0f GB/s H2D and D2H (PASSED)\n", gpuCPUBW.MinBandwidthGBps))
+			case "WARN":
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ GPU-CPU Bandwidth: GPUs more than 10%% below %.0f GB/s (WARNING)\n", gpuCPUBW.MinBandwidthGBps))
+			default:
 				failedTests++
-				output.WriteString(fmt.Sprintf("   ❌ GPU Driver: Version %s (FAILED)\n", driver.DriverVersion))
+				output.WriteString(fmt.Sprintf("   ❌ GPU-CPU Bandwidth: GPUs more than 20%% below %.0f GB/s (FAILED)\n", gpuCPUBW.MinBandwidthGBps))
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// GPU Clock Check Tests
-	if len(report.Localhost.GPUClockCheck) > 0 {
-		output.WriteString("⏱️ GPU Clock Speed Check\n")
+	// Fabric Manager Log Tests
+	if len(report.Localhost.FabricManagerLogCheck) > 0 {
+		output.WriteString("📜 Fabric Manager Log Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, clock := range report.Localhost.GPUClockCheck {
+		for _, fabricManagerLog := range report.Localhost.FabricManagerLogCheck {
 			totalTests++
-			if clock.Status == "PASS" {
+			switch fabricManagerLog.Status {
+			case "PASS":
 				passedTests++
-				if clock.Message != "" {
-					output.WriteString(fmt.Sprintf("   ✅ GPU Clock Speeds: %s (PASSED)\n", clock.Message))
-				} else {
-					output.WriteString("   ✅ GPU Clock Speeds: All GPUs running at acceptable speeds (PASSED)\n")
-				}
-			} else {
+				output.WriteString(fmt.Sprintf("   ✅ Fabric Manager Log: No runtime warnings or errors, %d startup warning(s) (PASSED)\n", fabricManagerLog.StartupWarningCount))
+			case "WARN":
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ Fabric Manager Log: %d runtime warning(s) (WARNING)\n", fabricManagerLog.WarningCount))
+			default:
 				failedTests++
-				if clock.Message != "" {
-					output.WriteString(fmt.Sprintf("   ❌ GPU Clock Speeds: %s (FAILED)\n", clock.Message))
-				} else {
-					output.WriteString("   ❌ GPU Clock Speeds: Some GPUs below acceptable speed threshold (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ Fabric Manager Log: %d error(s), %d warning(s) (FAILED)\n", fabricManagerLog.ErrorCount, fabricManagerLog.WarningCount))
+				if fabricManagerLog.LastError != "" {
+					output.WriteString(fmt.Sprintf("      Last error: %s\n", fabricManagerLog.LastError))
 				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// PeerMem Module Tests
-	if len(report.Localhost.PeerMemModuleCheck) > 0 {
-		output.WriteString("🔧 PeerMem Module Check\n")
+	// GPU Memory Temperature Tests
+	if len(report.Localhost.GPUMemTemperatureCheck) > 0 {
+		output.WriteString("🌡️ GPU Memory Temperature Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, peerMem := range report.Localhost.PeerMemModuleCheck {
+		for _, gpuMemTemperature := range report.Localhost.GPUMemTemperatureCheck {
 			totalTests++
-			if peerMem.Status == "PASS" {
+			switch gpuMemTemperature.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString("   ✅ PeerMem Module: nvidia_peermem loaded (PASSED)\n")
-			} else {
+				output.WriteString(fmt.Sprintf("   ✅ GPU Memory Temperature: All GPUs below %d°C (PASSED)\n", gpuMemTemperature.WarningC))
+			case "SKIP":
+				// Count skipped tests as neither passed nor failed
+				totalTests--
+				output.WriteString("   ⏭️ GPU Memory Temperature: Check skipped (memory temperature not reported by these GPUs)\n")
+			case "WARN":
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ GPU Memory Temperature: GPUs at or above %d°C (WARNING)\n", gpuMemTemperature.WarningC))
+			default:
 				failedTests++
-				output.WriteString("   ❌ PeerMem Module: nvidia_peermem not loaded (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ GPU Memory Temperature: GPUs at or above %d°C (FAILED)\n", gpuMemTemperature.CriticalC))
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// NVLink Tests
-	if len(report.Localhost.NVLinkSpeedCheck) > 0 {
-		output.WriteString("🔗 NVLink Health Check\n")
+	// PCIe Vendor Tests
+	if len(report.Localhost.PCIeVendorCheck) > 0 {
+		output.WriteString("🏷️ PCIe Vendor Check\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, pcieVendor := range report.Localhost.PCIeVendorCheck {
+			totalTests++
+			if pcieVendor.Status == "PASS" {
+				passedTests++
+				output.WriteString(fmt.Sprintf("   ✅ PCIe Vendor: All %d expected BDFs show the expected vendor:device ID (PASSED)\n", len(pcieVendor.ExpectedBDFs)))
+			} else {
+				failedTests++
+				output.WriteString("   ❌ PCIe Vendor: Unexpected vendor:device IDs detected (FAILED)\n")
+				for _, mismatch := range pcieVendor.Mismatches {
+					output.WriteString(fmt.Sprintf("      %s\n", mismatch))
+				}
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// RDMA Retry Counter Tests
+	if len(report.Localhost.RDMARetryCounterCheck) > 0 {
+		output.WriteString("🔁 RDMA Retry Counter Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, nvlink := range report.Localhost.NVLinkSpeedCheck {
+		for _, rdmaRetryCounter := range report.Localhost.RDMARetryCounterCheck {
 			totalTests++
-			if nvlink.Status == "PASS" {
+			switch rdmaRetryCounter.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString("   ✅ NVLink: All links meet speed and count requirements (PASSED)\n")
-			} else {
+				output.WriteString(fmt.Sprintf("   ✅ RDMA Retry Counters: All ports within %.2f retries/s (PASSED)\n", rdmaRetryCounter.MaxRetryRatePerSec))
+			case "WARN":
+				warnedTests++
+				output.WriteString(fmt.Sprintf("   ⚠️ RDMA Retry Counters: Ports above %.2f retries/s (WARNING)\n", rdmaRetryCounter.MaxRetryRatePerSec))
+			default:
 				failedTests++
-				output.WriteString("   ❌ NVLink: Speed or count issues detected (FAILED)\n")
+				output.WriteString("   ❌ RDMA Retry Counters: Could not sample retry counters (FAILED)\n")
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Eth0 Presence Tests
-	if len(report.Localhost.Eth0PresenceCheck) > 0 {
-		output.WriteString("🌐 Eth0 Interface Check\n")
+	// NVLink Bandwidth Tests
+	if len(report.Localhost.NVLinkBWCheck) > 0 {
+		output.WriteString("🔗 NVLink Bandwidth Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, eth0 := range report.Localhost.Eth0PresenceCheck {
+		for _, nvlinkBW := range report.Localhost.NVLinkBWCheck {
 			totalTests++
-			if eth0.Status == "PASS" {
+			if nvlinkBW.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ Eth0 Interface: eth0 is present (PASSED)\n")
+				output.WriteString(fmt.Sprintf("   ✅ NVLink Bandwidth: %.2f GB/s all-reduce bus bandwidth, %s algorithm (PASSED)\n", nvlinkBW.BusBandwidthGBps, nvlinkBW.Algorithm))
 			} else {
 				failedTests++
-				output.WriteString("   ❌ Eth0 Interface: eth0 is missing (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ NVLink Bandwidth: %.2f GB/s all-reduce bus bandwidth, expected %.2f GB/s (FAILED)\n", nvlinkBW.BusBandwidthGBps, nvlinkBW.ExpectedBusBandwidthGBps))
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// CDFP Cable Check Tests
-	if len(report.Localhost.CDFPCableCheck) > 0 {
-		output.WriteString("🔌 CDFP Cable Health Check\n")
+	// GPU MIG Profile Tests
+	if len(report.Localhost.GPUMIGProfileCheck) > 0 {
+		output.WriteString("🧩 GPU MIG Profile Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, cdfp := range report.Localhost.CDFPCableCheck {
+		for _, gpuMIGProfile := range report.Localhost.GPUMIGProfileCheck {
 			totalTests++
-			if cdfp.Status == "PASS" {
+			switch gpuMIGProfile.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString("   ✅ CDFP Cables: All GPU-to-module mappings correct (PASSED)\n")
-			} else if cdfp.Status == "SKIP" {
+				output.WriteString(fmt.Sprintf("   ✅ GPU MIG Profiles: All GPUs have %s (PASSED)\n", strings.Join(gpuMIGProfile.ExpectedProfiles, ", ")))
+			case "SKIP":
 				// Count skipped tests as neither passed nor failed
-				totalTests-- // Adjust total count as SKIP doesn't count
-				output.WriteString("   ⏭️ CDFP Cables: Check skipped (not applicable for this shape)\n")
-			} else {
+				totalTests--
+				output.WriteString("   ⏭️ GPU MIG Profiles: Check skipped (MIG not required for this shape)\n")
+			default:
 				failedTests++
-				output.WriteString("   ❌ CDFP Cables: GPU-to-module mapping issues detected (FAILED)\n")
+				output.WriteString("   ❌ GPU MIG Profiles: MIG profiles do not match the expected profiles (FAILED)\n")
+				if len(gpuMIGProfile.MissingProfiles) > 0 {
+					output.WriteString(fmt.Sprintf("      Missing: %s\n", strings.Join(gpuMIGProfile.MissingProfiles, ", ")))
+				}
+				if len(gpuMIGProfile.ExtraProfiles) > 0 {
+					output.WriteString(fmt.Sprintf("      Unexpected: %s\n", strings.Join(gpuMIGProfile.ExtraProfiles, ", ")))
+				}
 			}
 		}
+		output.WriteString("\n")
 	}
 
-	// HCA Error Check Tests
-	if len(report.Localhost.HCAErrorCheck) > 0 {
-		output.WriteString("🔍 HCA Error Check\n")
+	// Network Auth Check Tests
+	if len(report.Localhost.NetworkAuthCheck) > 0 {
+		output.WriteString("🔐 Network Authentication Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, hca := range report.Localhost.HCAErrorCheck {
+		for _, networkAuth := range report.Localhost.NetworkAuthCheck {
 			totalTests++
-			if hca.Status == "PASS" {
+			switch networkAuth.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString("   ✅ HCA Error Check: No MLX5 fatal errors detected (PASSED)\n")
-			} else {
+				output.WriteString("   ✅ Network Authentication: All RDMA interfaces authenticated (PASSED)\n")
+			case "SKIP":
+				// Count skipped tests as neither passed nor failed
+				totalTests--
+				output.WriteString("   ⏭️ Network Authentication: Check skipped (no RDMA interfaces found)\n")
+			default:
 				failedTests++
-				output.WriteString("   ❌ HCA Error Check: MLX5 fatal errors detected (FAILED)\n")
+				output.WriteString("   ❌ Network Authentication: RDMA interface authentication issues (FAILED)\n")
+				if len(networkAuth.FailedInterfaces) > 0 {
+					output.WriteString(fmt.Sprintf("      Not authenticated: %s\n", strings.Join(networkAuth.FailedInterfaces, ", ")))
+				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Missing Interface Check Tests
-	if len(report.Localhost.MissingInterfaceCheck) > 0 {
-		output.WriteString("🔍 Missing Interface Check\n")
+	// DCGM Field Tests
+	if len(report.Localhost.DCGMFieldCheck) > 0 {
+		output.WriteString("📈 DCGM Field Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, missing := range report.Localhost.MissingInterfaceCheck {
+		for _, dcgmField := range report.Localhost.DCGMFieldCheck {
 			totalTests++
-			if missing.Status == "PASS" {
+			switch dcgmField.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString("   ✅ Missing Interface Check: No missing PCIe interfaces detected (PASSED)\n")
-			} else {
+				output.WriteString(fmt.Sprintf("   ✅ DCGM Fields: %d fields within thresholds (PASSED)\n", len(dcgmField.Fields)))
+			case "SKIP":
+				// Count skipped tests as neither passed nor failed
+				totalTests--
+				output.WriteString("   ⏭️ DCGM Fields: Check skipped (DCGM not installed)\n")
+			default:
 				failedTests++
-				output.WriteString(fmt.Sprintf("   ❌ Missing Interface Check: %d missing PCIe interface(s) detected (FAILED)\n", missing.MissingCount))
+				output.WriteString("   ❌ DCGM Fields: Field values outside thresholds (FAILED)\n")
+				if len(dcgmField.FailedFields) > 0 {
+					output.WriteString(fmt.Sprintf("      Failed fields: %s\n", strings.Join(dcgmField.FailedFields, ", ")))
+				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Row Remap Error Check Tests
-	if len(report.Localhost.RowRemapErrorCheck) > 0 {
-		output.WriteString("🛠️ Row Remap Error Check\n")
+	// NUMA Distance Tests
+	if len(report.Localhost.NUMADistanceCheck) > 0 {
+		output.WriteString("🗺️ NUMA Distance Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, rowRemap := range report.Localhost.RowRemapErrorCheck {
+		for _, numaDistance := range report.Localhost.NUMADistanceCheck {
 			totalTests++
-			if rowRemap.Status == "PASS" {
+			switch numaDistance.Status {
+			case "PASS":
 				passedTests++
-				output.WriteString("   ✅ Row Remap Error Check: No GPU row remap errors detected (PASSED)\n")
-			} else if strings.Contains(rowRemap.Status, "Not applicable") {
+				output.WriteString(fmt.Sprintf("   ✅ NUMA Distances: %d nodes match the expected matrix (PASSED)\n", len(numaDistance.ActualMatrix)))
+			case "SKIP":
 				// Count skipped tests as neither passed nor failed
-				totalTests-- // Adjust total count as SKIP doesn't count
-				output.WriteString("   ⏭️ Row Remap Error Check: Check skipped (nvidia-smi driver version < 550)\n")
-			} else {
+				totalTests--
+				output.WriteString("   ⏭️ NUMA Distances: Check skipped (no expected matrix for this shape)\n")
+			default:
 				failedTests++
-				if rowRemap.FailureCount > 0 {
-					output.WriteString(fmt.Sprintf("   ❌ Row Remap Error Check: %d GPU(s) with row remap failures detected (FAILED)\n", rowRemap.FailureCount))
-				} else {
-					output.WriteString("   ❌ Row Remap Error Check: GPU row remap error check failed (FAILED)\n")
+				output.WriteString("   ❌ NUMA Distances: Distance matrix differs from the expected matrix (FAILED)\n")
+				if len(numaDistance.Mismatches) > 0 {
+					output.WriteString(fmt.Sprintf("      Mismatches: %s\n", strings.Join(numaDistance.Mismatches, ", ")))
 				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// Fabric Manager Check Tests
-	if len(report.Localhost.FabricManagerCheck) > 0 {
-		output.WriteString("🔧 Fabric Manager Service Check\n")
+	// CPU Topology Tests
+	if len(report.Localhost.CPUTopologyCheck) > 0 {
+		output.WriteString("🧮 CPU Topology Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, fabric := range report.Localhost.FabricManagerCheck {
+		for _, cpuTopology := range report.Localhost.CPUTopologyCheck {
 			totalTests++
-			if fabric.Status == "PASS" {
+			hyperThreading := "off"
+			if cpuTopology.HyperThreading {
+				hyperThreading = "on"
+			}
+			if cpuTopology.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ Fabric Manager: nvidia-fabricmanager service is running (PASSED)\n")
-			} else if fabric.Status == "SKIP" {
-				// Count skipped tests as neither passed nor failed
-				totalTests-- // Adjust total count as SKIP doesn't count
-				output.WriteString("   ⏭️ Fabric Manager: Check skipped (not applicable for this shape)\n")
+				output.WriteString(fmt.Sprintf("   ✅ CPU Topology: %d sockets x %d cores, %d logical CPUs, hyper-threading %s (PASSED)\n",
+					cpuTopology.Sockets, cpuTopology.CoresPerSocket, cpuTopology.LogicalCPUs, hyperThreading))
 			} else {
 				failedTests++
-				output.WriteString("   ❌ Fabric Manager: nvidia-fabricmanager service issues (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ CPU Topology: %d sockets x %d cores, %d logical CPUs, hyper-threading %s (FAILED)\n",
+					cpuTopology.Sockets, cpuTopology.CoresPerSocket, cpuTopology.LogicalCPUs, hyperThreading))
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// GPU XID Check Tests
-	if len(report.Localhost.GPUXIDCheck) > 0 {
-		output.WriteString("🎮 GPU XID Error Check\n")
+	// Memory Tests
+	if len(report.Localhost.MemoryCheck) > 0 {
+		output.WriteString("🧠 Memory Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, xid := range report.Localhost.GPUXIDCheck {
+		for _, memory := range report.Localhost.MemoryCheck {
 			totalTests++
-			if xid.Status == "PASS" {
-				passedTests++
-				output.WriteString("   ✅ GPU XID Check: No XID errors detected in system logs (PASSED)\n")
-			} else if xid.Status == "WARN" {
-				// Count warnings as passed but note them
+			if memory.Status == "PASS" {
 				passedTests++
-				if xid.Message != "" {
-					output.WriteString(fmt.Sprintf("   ⚠️ GPU XID Check: %s (WARNING)\n", xid.Message))
-				} else {
-					output.WriteString("   ⚠️ GPU XID Check: Warning XID errors detected in system logs (WARNING)\n")
-				}
+				output.WriteString(fmt.Sprintf("   ✅ Memory: %.0f GB, %d %s DIMMs at %d MT/s (PASSED)\n",
+					memory.TotalMemoryGB, memory.DIMMCount, memory.MemoryType, memory.SpeedMTs))
 			} else {
 				failedTests++
-				if xid.Message != "" {
-					output.WriteString(fmt.Sprintf("   ❌ GPU XID Check: %s (FAILED)\n", xid.Message))
-				} else {
-					output.WriteString("   ❌ GPU XID Check: Critical XID errors detected in system logs (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ Memory: %.0f GB, %d DIMMs at %d MT/s, expected %.0f GB at %d MT/s (FAILED)\n",
+					memory.TotalMemoryGB, memory.DIMMCount, memory.SpeedMTs, memory.ExpectedTotalMemoryGB, memory.MinSpeedMTs))
+				if len(memory.EmptySlots) > 0 {
+					output.WriteString(fmt.Sprintf("      Empty slots: %s\n", strings.Join(memory.EmptySlots, ", ")))
 				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
-	// MAX_ACC Configuration Check
-	if len(report.Localhost.MaxAccCheck) > 0 {
-		output.WriteString("🔧 MAX_ACC Configuration Check\n")
+	// GPU Firmware Consistency Tests
+	if len(report.Localhost.GPUFirmwareConsistencyCheck) > 0 {
+		output.WriteString("🧬 GPU Firmware Consistency Check\n")
 		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
-		for _, maxAcc := range report.Localhost.MaxAccCheck {
+		for _, gpuFirmwareConsistency := range report.Localhost.GPUFirmwareConsistencyCheck {
 			totalTests++
-			if maxAcc.Status == "PASS" {
+			versions := make([]string, 0, len(gpuFirmwareConsistency.FirmwareVersions))
+			for version := range gpuFirmwareConsistency.FirmwareVersions {
+				versions = append(versions, version)
+			}
+			sort.Strings(versions)
+			if gpuFirmwareConsistency.Status == "PASS" {
 				passedTests++
-				output.WriteString("   ✅ MAX_ACC Check: ConnectX-7 NICs properly configured for optimal RDMA performance (PASSED)\n")
+				output.WriteString(fmt.Sprintf("   ✅ GPU Firmware Consistency: All GPUs run VBIOS %s (PASSED)\n", strings.Join(versions, ", ")))
 			} else {
 				failedTests++
-				if maxAcc.Message != "" {
-					output.WriteString(fmt.Sprintf("   ❌ MAX_ACC Check: %s (FAILED)\n", maxAcc.Message))
-				} else {
-					output.WriteString("   ❌ MAX_ACC Check: ConnectX-7 NIC configuration issues detected (FAILED)\n")
+				output.WriteString(fmt.Sprintf("   ❌ GPU Firmware Consistency: %d different VBIOS versions (FAILED)\n", len(versions)))
+				for _, version := range versions {
+					output.WriteString(fmt.Sprintf("      %s: GPU(s) %s\n", version, strings.Join(gpuFirmwareConsistency.FirmwareVersions[version], ",")))
 				}
 			}
 		}
 		output.WriteString("\n")
 	}
 
+	// Test Timeouts (already counted as failures in their own sections)
+	if len(report.Localhost.TestTimeouts) > 0 {
+		output.WriteString("⏱️ Test Timeouts\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, timeout := range report.Localhost.TestTimeouts {
+			output.WriteString(fmt.Sprintf("   ❌ %s: Did not finish within %d seconds (TIMEOUT)\n", timeout.TestName, timeout.TimeoutSeconds))
+		}
+		output.WriteString("\n")
+	}
+
+	// Test Retries (already counted in their own sections)
+	if len(report.Localhost.TestRetries) > 0 {
+		output.WriteString("🔁 Test Retries\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, retry := range report.Localhost.TestRetries {
+			output.WriteString(fmt.Sprintf("   ⚠️ %s: Needed %d retries after transient errors (%s)\n", retry.TestName, retry.RetryCount, retry.Status))
+		}
+		output.WriteString("\n")
+	}
+
+	// Skipped Tests (not counted, since they did not run)
+	if len(report.Localhost.SkippedTests) > 0 {
+		output.WriteString("⏭️ Skipped Tests\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, skipped := range report.Localhost.SkippedTests {
+			output.WriteString(fmt.Sprintf("   ⏭️ %s: Not run because %s failed (SKIP)\n", skipped.TestName, skipped.FailedDependency))
+		}
+		output.WriteString("\n")
+	}
+
+	// Test Errors (already counted as failures in their own sections)
+	var testErrorLines []string
+	for _, testError := range report.Localhost.TestErrors {
+		if details := testErrorDetails(testError); details != "" {
+			testErrorLines = append(testErrorLines, fmt.Sprintf("   ❌ %s: %s\n", testError.TestName, details))
+		}
+	}
+	if len(testErrorLines) > 0 {
+		output.WriteString("🔧 Test Errors\n")
+		output.WriteString("   " + strings.Repeat("-", 30) + "\n")
+		for _, line := range testErrorLines {
+			output.WriteString(line)
+		}
+		output.WriteString("\n")
+	}
+
 	// Summary
 	output.WriteString("📊 Summary\n")
 	output.WriteString("   " + strings.Repeat("-", 30) + "\n")
 	output.WriteString(fmt.Sprintf("   Total Tests: %d\n", totalTests))
 	output.WriteString(fmt.Sprintf("   Passed: %d\n", passedTests))
+	output.WriteString(fmt.Sprintf("   Warnings: %d\n", warnedTests))
 	output.WriteString(fmt.Sprintf("   Failed: %d\n", failedTests))
 
-	if failedTests == 0 {
+	if failedTests == 0 && warnedTests == 0 {
 		output.WriteString("\n   🎉 All tests passed! Your HPC environment is healthy.\n")
+	} else if failedTests == 0 {
+		output.WriteString(fmt.Sprintf("\n   ⚠️  No tests failed, but %d test(s) reported warnings. Please review the results above.\n", warnedTests))
 	} else {
 		output.WriteString(fmt.Sprintf("\n   ⚠️  %d test(s) failed. Please review the results above.\n", failedTests))
 	}
@@ -1988,6 +6860,297 @@ func (r *Reporter) formatFriendly(report *ReportOutput) (string, error) {
 	return output.String(), nil
 }
 
+// testErrorDetails describes a test that could not run because of a missing tool or a
+// failed command; other test errors are described by the result of the test itself
+func testErrorDetails(testError TestErrorResult) string {
+	switch testError.ErrorType {
+	case testerrors.ErrorTypeToolNotFound:
+		if testError.Package != "" {
+			return fmt.Sprintf("%s not found (install %s)", testError.Tool, testError.Package)
+		}
+		return fmt.Sprintf("%s not found", testError.Tool)
+	case testerrors.ErrorTypeExecution:
+		if testError.ExitCode >= 0 {
+			return fmt.Sprintf("%s exited with %d", testError.Command, testError.ExitCode)
+		}
+		return fmt.Sprintf("%s failed", testError.Command)
+	}
+	return ""
+}
+
+// csvHeader is the header row of the CSV report
+var csvHeader = []string{"test_name", "status", "detail_key", "detail_value", "timestamp_utc"}
+
+// flattenResults converts the typed results into generic field maps keyed by test name,
+// so every test type can be handled the same way. Numbers are kept as json.Number.
+func flattenResults(results HostResults) (map[string][]map[string]interface{}, error) {
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal results: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var tests map[string][]map[string]interface{}
+	if err := decoder.Decode(&tests); err != nil {
+		return nil, fmt.Errorf("failed to decode results: %w", err)
+	}
+	return tests, nil
+}
+
+// TestResults returns the results of every test in the report keyed by test name
+func (report *ReportOutput) TestResults() (map[string][]map[string]interface{}, error) {
+	return flattenResults(report.Localhost)
+}
+
+// formatCSV formats the report as CSV with one row per test detail
+func (r *Reporter) formatCSV(report *ReportOutput) (string, error) {
+	tests, err := flattenResults(report.Localhost)
+	if err != nil {
+		return "", fmt.Errorf("failed to flatten report for CSV: %w", err)
+	}
+
+	var output strings.Builder
+	writer := csv.NewWriter(&output)
+	writer.Write(csvHeader)
+	writeCSVRows(writer, nil, tests)
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return output.String(), nil
+}
+
+// writeCSVRows writes one row per test detail, prefixed with the given columns
+func writeCSVRows(writer *csv.Writer, prefix []string, tests map[string][]map[string]interface{}) {
+	testNames := make([]string, 0, len(tests))
+	for testName := range tests {
+		testNames = append(testNames, testName)
+	}
+	sort.Strings(testNames)
+
+	row := func(columns ...string) {
+		writer.Write(append(append([]string{}, prefix...), columns...))
+	}
+
+	for _, testName := range testNames {
+		for _, result := range tests[testName] {
+			status := csvValue(result["status"])
+			timestamp := csvValue(result["timestamp_utc"])
+
+			var keys []string
+			for key := range result {
+				if key != "status" && key != "timestamp_utc" {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+
+			// Tests without details still get a row so their status is reported
+			if len(keys) == 0 {
+				row(testName, status, "", "", timestamp)
+				continue
+			}
+			for _, key := range keys {
+				row(testName, status, key, csvValue(result[key]), timestamp)
+			}
+		}
+	}
+}
+
+// csvValue formats a detail value for a CSV cell, encoding lists and objects as JSON
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
+
+// ValidateMultiHostFormat returns an error when FormatMultiHostReport does not support format
+func ValidateMultiHostFormat(format string) error {
+	switch format {
+	case "json", "yaml", "table", "friendly", "csv":
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// FormatMultiHostReport formats a multi-host report as json, yaml, table, friendly or csv output
+func FormatMultiHostReport(report *MultiHostReport, format string) (string, error) {
+	if err := ValidateMultiHostFormat(format); err != nil {
+		return "", err
+	}
+
+	switch format {
+	case "json":
+		jsonData, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal multi-host report to JSON: %w", err)
+		}
+		return string(jsonData) + "\n", nil
+	case "yaml":
+		return formatMultiHostYAML(report)
+	case "table":
+		return formatMultiHostTable(report)
+	case "friendly":
+		return formatMultiHostFriendly(report)
+	default: // csv
+		return formatMultiHostCSV(report)
+	}
+}
+
+// formatMultiHostYAML formats the report as YAML with camelCase keys like the single host YAML
+// report. Host names are kept as is.
+func formatMultiHostYAML(report *MultiHostReport) (string, error) {
+	hosts := make(map[string]interface{}, len(report.Hosts))
+	for host, results := range report.Hosts {
+		document, err := yamlDocument(results)
+		if err != nil {
+			return "", err
+		}
+		hosts[host] = document
+	}
+
+	document := map[string]interface{}{"hosts": hosts}
+	if len(report.Errors) > 0 {
+		document["errors"] = report.Errors
+	}
+
+	yamlData, err := yaml.Marshal(document)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal multi-host report to YAML: %w", err)
+	}
+	return string(yamlData), nil
+}
+
+// formatMultiHostTable formats the report as a table with the tests of each host grouped under the host name
+func formatMultiHostTable(report *MultiHostReport) (string, error) {
+	var output strings.Builder
+
+	output.WriteString("┌─────────────────────────────────────────────────────────────────┐\n")
+	output.WriteString("│                MULTI-HOST DIAGNOSTIC TEST RESULTS               │\n")
+	output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+	output.WriteString(fmt.Sprintf("│ %-45s │ %-15s │\n", "TEST NAME", "STATUS"))
+
+	for _, host := range report.HostNames() {
+		output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+		output.WriteString(fmt.Sprintf("│ HOST: %-57s │\n", host))
+		output.WriteString("├─────────────────────────────────────────────────────────────────┤\n")
+
+		if hostErr, exists := report.Errors[host]; exists {
+			if len(hostErr) > 45 {
+				hostErr = hostErr[:42] + "..."
+			}
+			output.WriteString(fmt.Sprintf("│ %-45s │ %-15s │\n", hostErr, "ERROR"))
+			continue
+		}
+
+		tests, err := flattenResults(report.Hosts[host])
+		if err != nil {
+			return "", fmt.Errorf("failed to flatten results for %s: %w", host, err)
+		}
+		testNames := make([]string, 0, len(tests))
+		for testName := range tests {
+			testNames = append(testNames, testName)
+		}
+		sort.Strings(testNames)
+
+		for _, testName := range testNames {
+			output.WriteString(fmt.Sprintf("│ %-45s │ %-15s │\n", testName, resultStatus(tests[testName])))
+		}
+	}
+
+	output.WriteString("└─────────────────────────────────────────────────────────────────┘\n")
+	return output.String(), nil
+}
+
+// formatMultiHostFriendly formats the report in a user-friendly format, one section per host
+func formatMultiHostFriendly(report *MultiHostReport) (string, error) {
+	var output strings.Builder
+
+	output.WriteString("🔍 HPC Multi-Host Diagnostic Results\n")
+	output.WriteString("===================================================\n")
+
+	for _, host := range report.HostNames() {
+		output.WriteString(fmt.Sprintf("\n🖥️  %s\n", host))
+		output.WriteString("   ------------------------------\n")
+
+		if hostErr, exists := report.Errors[host]; exists {
+			output.WriteString(fmt.Sprintf("   ❌ Unreachable: %s\n", hostErr))
+			continue
+		}
+
+		tests, err := flattenResults(report.Hosts[host])
+		if err != nil {
+			return "", fmt.Errorf("failed to flatten results for %s: %w", host, err)
+		}
+		failures := countFailures(tests)
+		if failures == 0 {
+			output.WriteString(fmt.Sprintf("   ✅ All %d test(s) passed\n", len(tests)))
+			continue
+		}
+
+		testNames := make([]string, 0, len(tests))
+		for testName := range tests {
+			testNames = append(testNames, testName)
+		}
+		sort.Strings(testNames)
+		for _, testName := range testNames {
+			if status := resultStatus(tests[testName]); status != "PASS" {
+				output.WriteString(fmt.Sprintf("   ❌ %s: %s\n", testName, status))
+			}
+		}
+	}
+
+	failedHosts := report.FailedHosts()
+	output.WriteString("\n📊 Summary\n")
+	output.WriteString("   ------------------------------\n")
+	output.WriteString(fmt.Sprintf("   Total Hosts: %d\n", len(report.HostNames())))
+	output.WriteString(fmt.Sprintf("   Healthy: %d\n", len(report.HostNames())-len(failedHosts)))
+	output.WriteString(fmt.Sprintf("   Unreachable: %d\n", len(report.Errors)))
+	output.WriteString(fmt.Sprintf("   With Failures: %d\n", len(failedHosts)-len(report.Errors)))
+
+	return output.String(), nil
+}
+
+// formatMultiHostCSV formats the report as CSV with a host column; unreachable hosts get an ERROR row
+func formatMultiHostCSV(report *MultiHostReport) (string, error) {
+	var output strings.Builder
+	writer := csv.NewWriter(&output)
+	writer.Write(append([]string{"host"}, csvHeader...))
+
+	for _, host := range report.HostNames() {
+		if hostErr, exists := report.Errors[host]; exists {
+			writer.Write([]string{host, "", "ERROR", "error", hostErr, ""})
+			continue
+		}
+		tests, err := flattenResults(report.Hosts[host])
+		if err != nil {
+			return "", fmt.Errorf("failed to flatten results for %s: %w", host, err)
+		}
+		writeCSVRows(writer, []string{host}, tests)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return output.String(), nil
+}
+
 // GetResults returns all collected results
 func (r *Reporter) GetResults() map[string]TestResult {
 	r.mutex.RLock()
@@ -2000,11 +7163,14 @@ func (r *Reporter) GetResults() map[string]TestResult {
 	return results
 }
 
-// Clear clears all collected results
+// Clear clears all collected results and starts a new run generation
 func (r *Reporter) Clear() {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	r.generation++
 	r.results = make(map[string]TestResult)
+	r.cached = make(map[string]json.RawMessage)
+	r.note = ""
 }
 
 // GetResultsCount returns the number of collected results
@@ -2042,28 +7208,46 @@ func (r *Reporter) GetPassedTests() []string {
 	return passedTests
 }
 
-// PrintSummary prints a summary of test results
-func (r *Reporter) PrintSummary() {
+// GetWarnedTests returns a list of test names that passed with warnings
+func (r *Reporter) GetWarnedTests() []string {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	totalTests := len(r.results)
+	var warnedTests []string
+	for _, result := range r.results {
+		if result.Status == "WARN" {
+			warnedTests = append(warnedTests, result.Name)
+		}
+	}
+	return warnedTests
+}
+
+// PrintSummary prints a summary of test results
+func (r *Reporter) PrintSummary() {
+	totalTests := r.GetResultsCount()
 	passedTests := r.GetPassedTests()
+	warnedTests := r.GetWarnedTests()
 	failedTests := r.GetFailedTests()
 
 	fmt.Printf("\n=== Test Summary ===\n")
 	fmt.Printf("Total tests: %d\n", totalTests)
 	fmt.Printf("Passed: %d\n", len(passedTests))
+	fmt.Printf("Warnings: %d\n", len(warnedTests))
 	fmt.Printf("Failed: %d\n", len(failedTests))
 
+	if len(warnedTests) > 0 {
+		fmt.Printf("Warned tests: %v\n", warnedTests)
+	}
 	if len(failedTests) > 0 {
 		fmt.Printf("Failed tests: %v\n", failedTests)
 	}
 
-	if len(failedTests) == 0 {
-		fmt.Printf("✅ All tests passed!\n")
-	} else {
+	if len(failedTests) > 0 {
 		fmt.Printf("❌ %d test(s) failed\n", len(failedTests))
+	} else if len(warnedTests) > 0 {
+		fmt.Printf("⚠️  No tests failed, %d test(s) reported warnings\n", len(warnedTests))
+	} else {
+		fmt.Printf("✅ All tests passed!\n")
 	}
 }
 
@@ -1,20 +1,28 @@
 package reporter
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"gopkg.in/yaml.v3"
 )
 
 // Test helper functions
 
 func createTestReporter() *Reporter {
 	return &Reporter{
-		results: make(map[string]TestResult),
+		results:  make(map[string]TestResult),
+		timedOut: make(map[string]uint64),
 	}
 }
 
@@ -101,7 +109,7 @@ func TestReporter_ResultCounting(t *testing.T) {
 
 	reporter.AddGPUResult("PASS", 8, nil)
 	reporter.AddPCIeResult("FAIL", fmt.Errorf("error"))
-	reporter.AddRDMAResult("PASS", 16, nil)
+	reporter.AddRDMAResult("PASS", 16, nil, nil, nil)
 
 	passedTests := reporter.GetPassedTests()
 	failedTests := reporter.GetFailedTests()
@@ -114,8 +122,414 @@ func TestReporter_ResultCounting(t *testing.T) {
 	}
 }
 
+func TestReporter_WarnResults(t *testing.T) {
+	reporter := createTestReporter()
+
+	reporter.AddGPUResult("PASS", 8, nil)
+	reporter.AddSRAMErrorResult("WARN", 0, 1500, nil, nil)
+	reporter.AddPCIeResult("FAIL", fmt.Errorf("error"))
+
+	if warned := reporter.GetWarnedTests(); len(warned) != 1 || warned[0] != "sram_error_check" {
+		t.Errorf("Expected sram_error_check as the only warned test, got %v", warned)
+	}
+	if failed := reporter.GetFailedTests(); len(failed) != 1 || failed[0] != "pcie_error_check" {
+		t.Errorf("Expected pcie_error_check as the only failed test, got %v", failed)
+	}
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+
+	table, err := reporter.formatTable(report)
+	if err != nil {
+		t.Fatalf("Failed to format table: %v", err)
+	}
+	if !strings.Contains(table, "SRAM Error Check       │ ⚠️") {
+		t.Errorf("Expected WARN symbol for SRAM Error Check in table output:\n%s", table)
+	}
+
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil {
+		t.Fatalf("Failed to format friendly output: %v", err)
+	}
+	for _, expected := range []string{"Passed: 1", "Warnings: 1", "Failed: 1"} {
+		if !strings.Contains(friendly, expected) {
+			t.Errorf("Expected %q in friendly summary:\n%s", expected, friendly)
+		}
+	}
+}
+
+func TestReporter_RDMAMissingAndExtraDevices(t *testing.T) {
+	reporter := createTestReporter()
+	reporter.AddRDMAResult("FAIL", 15, []string{"0000:0c:00.1"}, []string{"0000:2b:00.0"}, fmt.Errorf("missing RDMA NICs: 0000:0c:00.1"))
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	rdma := report.Localhost.RDMANicsCount[0]
+	if len(rdma.MissingDevices) != 1 || rdma.MissingDevices[0] != "0000:0c:00.1" {
+		t.Errorf("Expected missing device 0000:0c:00.1, got %v", rdma.MissingDevices)
+	}
+	if len(rdma.ExtraDevices) != 1 || rdma.ExtraDevices[0] != "0000:2b:00.0" {
+		t.Errorf("Expected extra device 0000:2b:00.0, got %v", rdma.ExtraDevices)
+	}
+
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "missing RDMA NICs 0000:0c:00.1 (FAILED)") {
+		t.Errorf("Expected friendly output to name the missing RDMA NIC, got error %v:\n%s", err, friendly)
+	}
+
+	// Extra devices alone only warn
+	reporter.Clear()
+	reporter.AddRDMAResult("WARN", 16, nil, []string{"0000:2b:00.0"}, fmt.Errorf("unexpected RDMA NICs: 0000:2b:00.0"))
+	report, err = reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	friendly, err = reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "unexpected RDMA NICs 0000:2b:00.0 (WARNING)") {
+		t.Errorf("Expected friendly output to name the unexpected RDMA NIC, got error %v:\n%s", err, friendly)
+	}
+}
+
+func TestReporter_SRAMPerGPUErrors(t *testing.T) {
+	reporter := createTestReporter()
+	perGPUErrors := map[int]SRAMGPUErrors{0: {}, 3: {Uncorrectable: 12, Correctable: 40}, 5: {Correctable: 1500}}
+	reporter.AddSRAMErrorResult("FAIL", 12, 1500, perGPUErrors, fmt.Errorf("threshold exceeded"))
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+
+	data, err := reporter.formatJSON(report)
+	if err != nil {
+		t.Fatalf("Failed to format JSON: %v", err)
+	}
+	if !strings.Contains(data, `"3": {`) || !strings.Contains(data, `"uncorrectable": 12`) {
+		t.Errorf("Expected per-GPU errors in JSON output:\n%s", data)
+	}
+
+	table, err := reporter.formatTable(report)
+	if err != nil {
+		t.Fatalf("Failed to format table: %v", err)
+	}
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil {
+		t.Fatalf("Failed to format friendly output: %v", err)
+	}
+	for _, expected := range []string{"GPU 3", "GPU 5"} {
+		if !strings.Contains(table, expected) || !strings.Contains(friendly, expected) {
+			t.Errorf("Expected %q in the per-GPU breakdown:\n%s\n%s", expected, table, friendly)
+		}
+	}
+	if strings.Contains(friendly, "GPU 0:") {
+		t.Errorf("Expected GPUs without errors to be left out of the breakdown:\n%s", friendly)
+	}
+	if strings.Index(friendly, "GPU 3:") > strings.Index(friendly, "GPU 5:") {
+		t.Errorf("Expected the GPU with the most uncorrectable errors first:\n%s", friendly)
+	}
+
+	// No breakdown when a single GPU has errors
+	reporter.Clear()
+	reporter.AddSRAMErrorResult("FAIL", 12, 40, map[int]SRAMGPUErrors{0: {}, 3: {Uncorrectable: 12, Correctable: 40}}, fmt.Errorf("threshold exceeded"))
+	report, _ = reporter.GenerateReport()
+	if friendly, _ := reporter.formatFriendly(report); strings.Contains(friendly, "GPU 3:") {
+		t.Errorf("Expected no per-GPU breakdown for a single GPU with errors:\n%s", friendly)
+	}
+}
+
+func TestReporter_ShapeHeader(t *testing.T) {
+	reporter := createTestReporter()
+	reporter.AddGPUResult("PASS", 8, nil)
+	reporter.SetShape("BM.GPU.H100.8.test", "BM.GPU.H100.8")
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if report.Shape != "BM.GPU.H100.8.test" || report.ConfigShape != "BM.GPU.H100.8" {
+		t.Errorf("Expected shape BM.GPU.H100.8.test using BM.GPU.H100.8, got %q using %q", report.Shape, report.ConfigShape)
+	}
+
+	table, err := reporter.formatTable(report)
+	if err != nil {
+		t.Fatalf("Failed to format table: %v", err)
+	}
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil {
+		t.Fatalf("Failed to format friendly output: %v", err)
+	}
+	for _, output := range []string{table, friendly} {
+		if !strings.Contains(output, "Shape: BM.GPU.H100.8.test (using BM.GPU.H100.8 limits)") {
+			t.Errorf("Expected the shape in the report header:\n%s", output)
+		}
+	}
+
+	// No fallback note when the shape itself is configured
+	reporter.SetShape("BM.GPU.H100.8", "BM.GPU.H100.8")
+	report, _ = reporter.GenerateReport()
+	if header := shapeHeader(report); header != "Shape: BM.GPU.H100.8" {
+		t.Errorf("shapeHeader() = %q, want Shape: BM.GPU.H100.8", header)
+	}
+}
+
+func TestReporter_DiagnosticNote(t *testing.T) {
+	reporter := createTestReporter()
+	reporter.AddGPUResult("PASS", 8, nil)
+
+	report, _ := reporter.GenerateReport()
+	if data, _ := reporter.formatJSON(report); strings.Contains(data, "diagnostic_note") {
+		t.Errorf("Expected no diagnostic_note without a note:\n%s", data)
+	}
+
+	note := "Results may be inaccurate due to active GPU workload"
+	reporter.SetDiagnosticNote(note)
+	report, _ = reporter.GenerateReport()
+	data, err := reporter.formatJSON(report)
+	if err != nil {
+		t.Fatalf("Failed to format JSON: %v", err)
+	}
+	var root map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &root); err != nil {
+		t.Fatalf("Failed to parse JSON report: %v", err)
+	}
+	if root["diagnostic_note"] != note {
+		t.Errorf("diagnostic_note = %v, want %q", root["diagnostic_note"], note)
+	}
+	friendly, _ := reporter.formatFriendly(report)
+	if !strings.Contains(friendly, note) {
+		t.Errorf("Expected the note in friendly output:\n%s", friendly)
+	}
+
+	// The note belongs to the run and is cleared with its results
+	reporter.Clear()
+	if report, _ = reporter.GenerateReport(); report.DiagnosticNote != "" {
+		t.Errorf("Expected Clear() to clear the note, got %q", report.DiagnosticNote)
+	}
+}
+
+func TestReporter_TimeoutResult(t *testing.T) {
+	reporter := createTestReporter()
+
+	reporter.AddTimeoutResult("link_check", 120, fmt.Errorf("test link_check timed out after 2m0s"))
+	reporter.AddGPUResult("PASS", 8, nil)
+	assertResultExists(t, reporter, "link_check", "FAIL")
+
+	// A late result from the timed out test must not replace the timeout
+	reporter.AddLinkResult("PASS", []map[string]interface{}{}, nil)
+	assertResultExists(t, reporter, "link_check", "FAIL")
+
+	if failed := reporter.GetFailedTests(); len(failed) != 1 || failed[0] != "link_check" {
+		t.Errorf("Expected link_check as the only failed test, got %v", failed)
+	}
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if len(report.Localhost.LinkCheck) != 1 || report.Localhost.LinkCheck[0].Status != "FAIL" {
+		t.Errorf("Expected link_check to be reported as FAIL, got %+v", report.Localhost.LinkCheck)
+	}
+	if len(report.Localhost.TestTimeouts) != 1 {
+		t.Fatalf("Expected 1 test timeout, got %d", len(report.Localhost.TestTimeouts))
+	}
+	timeout := report.Localhost.TestTimeouts[0]
+	if timeout.TestName != "link_check" || timeout.Status != "TIMEOUT" || timeout.TimeoutSeconds != 120 {
+		t.Errorf("Unexpected test timeout result: %+v", timeout)
+	}
+
+	table, err := reporter.formatTable(report)
+	if err != nil || !strings.Contains(table, "Timed out after 120s") {
+		t.Errorf("Expected table output to include the timeout, got error %v", err)
+	}
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "link_check: Did not finish within 120 seconds (TIMEOUT)") {
+		t.Errorf("Expected friendly output to include the timeout, got error %v", err)
+	}
+}
+
+func TestReporter_RetryCount(t *testing.T) {
+	reporter := createTestReporter()
+
+	reporter.AddGPUResult("PASS", 8, nil)
+	reporter.AddResult("link_check", "FAIL", nil, fmt.Errorf("link down"))
+	reporter.SetRetryCount("gpu_count_check", 1)
+	reporter.SetRetryCount("link_check", 2)
+	reporter.SetRetryCount("unknown_check", 1)
+
+	// A test passing only after retries is reported as WARN
+	assertResultExists(t, reporter, "gpu_count_check", "WARN")
+	assertResultExists(t, reporter, "link_check", "FAIL")
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	expected := []TestRetryResult{
+		{TestName: "gpu_count_check", Status: "WARN", RetryCount: 1},
+		{TestName: "link_check", Status: "FAIL", RetryCount: 2},
+	}
+	if len(report.Localhost.TestRetries) != len(expected) {
+		t.Fatalf("Expected %d test retries, got %+v", len(expected), report.Localhost.TestRetries)
+	}
+	for i, retry := range report.Localhost.TestRetries {
+		retry.TimestampUTC = ""
+		if retry != expected[i] {
+			t.Errorf("Test retry %d = %+v, want %+v", i, retry, expected[i])
+		}
+	}
+
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "gpu_count_check: Needed 1 retries after transient errors (WARN)") {
+		t.Errorf("Expected friendly output to include the retries, got error %v", err)
+	}
+}
+
+func TestReporter_CachedResult(t *testing.T) {
+	previous := createTestReporter()
+	previous.AddGPUResult("PASS", 8, nil)
+	previous.AddRDMAResult("PASS", 16, nil, nil, nil)
+	sections, err := previous.ReportSections()
+	if err != nil {
+		t.Fatalf("Failed to get report sections: %v", err)
+	}
+	if _, ok := sections["rdma_nics_count"]; !ok {
+		t.Fatalf("Expected a rdma_nics_count section, got %v", sections)
+	}
+
+	reporter := createTestReporter()
+	reporter.AddCachedResult("gpu_count_check", sections["gpu_count_check"])
+	reporter.AddCachedResult("rdma_nics_count", sections["rdma_nics_count"])
+	reporter.AddResult("link_check", "PASS", nil, nil)
+	assertResultExists(t, reporter, "gpu_count_check", "PASS")
+
+	// Cached results are reported as they were when the tests last ran
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if len(report.Localhost.GPUCountCheck) != 1 || report.Localhost.GPUCountCheck[0].GPUCount != 8 {
+		t.Errorf("Expected the cached GPU count of 8, got %+v", report.Localhost.GPUCountCheck)
+	}
+	if len(report.Localhost.RDMANicsCount) != 1 || report.Localhost.RDMANicsCount[0].NumRDMANics != 16 {
+		t.Errorf("Expected the cached RDMA NIC count of 16, got %+v", report.Localhost.RDMANicsCount)
+	}
+	if len(report.Localhost.LinkCheck) != 1 {
+		t.Errorf("Expected the link_check result to be kept, got %+v", report.Localhost.LinkCheck)
+	}
+
+	reporter.Clear()
+	report, err = reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if len(report.Localhost.GPUCountCheck) != 0 {
+		t.Errorf("Expected cached results to be cleared, got %+v", report.Localhost.GPUCountCheck)
+	}
+}
+
+func TestReporter_GPUXIDBreakdown(t *testing.T) {
+	reporter := createTestReporter()
+
+	breakdown := []XIDCodeBreakdown{
+		{XIDCode: "79", Severity: "FAIL", Count: 2},
+		{XIDCode: "61", Severity: "WARN", Count: 1},
+	}
+	reporter.AddGPUXIDDetailsResult("FAIL", nil, breakdown, fmt.Errorf("Critical XID errors detected: 1 critical, 1 warnings"))
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if len(report.Localhost.GPUXIDCheck) != 1 || !reflect.DeepEqual(report.Localhost.GPUXIDCheck[0].XIDBreakdown, breakdown) {
+		t.Fatalf("Expected XID breakdown %+v, got %+v", breakdown, report.Localhost.GPUXIDCheck)
+	}
+
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "XID 79: 2 occurrence(s) (FAIL)") {
+		t.Errorf("Expected friendly output to include the XID breakdown, got error %v", err)
+	}
+}
+
+func TestReporter_SkippedResult(t *testing.T) {
+	reporter := createTestReporter()
+
+	reporter.AddRDMAResult("FAIL", 14, []string{"0000:0c:00.0", "0000:0c:00.1"}, nil, fmt.Errorf("missing RDMA NICs: 0000:0c:00.0, 0000:0c:00.1"))
+	reporter.AddSkippedResult("link_check", "rdma_nics_count", fmt.Errorf("test link_check skipped because its dependency rdma_nics_count failed"))
+	assertResultExists(t, reporter, "link_check", "SKIP")
+
+	if failed := reporter.GetFailedTests(); len(failed) != 1 || failed[0] != "rdma_nic_count" {
+		t.Errorf("Expected rdma_nic_count as the only failed test, got %v", failed)
+	}
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if len(report.Localhost.SkippedTests) != 1 {
+		t.Fatalf("Expected 1 skipped test, got %d", len(report.Localhost.SkippedTests))
+	}
+	skipped := report.Localhost.SkippedTests[0]
+	if skipped.TestName != "link_check" || skipped.Status != "SKIP" || skipped.FailedDependency != "rdma_nics_count" {
+		t.Errorf("Unexpected skipped test result: %+v", skipped)
+	}
+	if len(report.Localhost.TestErrors) != 0 {
+		t.Errorf("Expected skipped test not to be reported as a test error, got %+v", report.Localhost.TestErrors)
+	}
+
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "link_check: Not run because rdma_nics_count failed (SKIP)") {
+		t.Errorf("Expected friendly output to include the skipped test, got error %v", err)
+	}
+}
+
 // Test result types - using table-driven tests for extensibility
 
+func TestReporter_TestErrors(t *testing.T) {
+	reporter := createTestReporter()
+
+	reporter.AddMaxAccResult("FAIL", nil, testerrors.NewToolNotFoundError("max_acc_check", "mlxconfig", nil))
+	reporter.AddPCIeResult("FAIL", &testerrors.TestExecutionError{TestName: "pcie_error_check", Cmd: "sudo dmesg", ExitCode: 1})
+	reporter.AddGPUResult("FAIL", 7, &testerrors.TestThresholdExceededError{TestName: "gpu_count_check", Metric: "gpu_count", Actual: 7, Expected: 8})
+	reporter.AddHCAResult("FAIL", fmt.Errorf("untyped error"))
+
+	if errorType := reporter.GetResults()["max_acc_check"].ErrorType; errorType != testerrors.ErrorTypeToolNotFound {
+		t.Errorf("Expected error type %s, got %q", testerrors.ErrorTypeToolNotFound, errorType)
+	}
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	if len(report.Localhost.TestErrors) != 3 {
+		t.Fatalf("Expected 3 test errors, got %+v", report.Localhost.TestErrors)
+	}
+
+	// Sorted by test name
+	threshold, tool, execution := report.Localhost.TestErrors[0], report.Localhost.TestErrors[1], report.Localhost.TestErrors[2]
+	if threshold.TestName != "gpu_count_check" || threshold.Metric != "gpu_count" || threshold.Actual != 7 || threshold.Expected != 8 {
+		t.Errorf("Unexpected threshold error: %+v", threshold)
+	}
+	if tool.TestName != "max_acc_check" || tool.Status != "FAIL" || tool.Tool != "mlxconfig" || tool.Package != "mft" {
+		t.Errorf("Unexpected tool error: %+v", tool)
+	}
+	if execution.TestName != "pcie_error_check" || execution.Command != "sudo dmesg" || execution.ExitCode != 1 {
+		t.Errorf("Unexpected execution error: %+v", execution)
+	}
+
+	friendly, err := reporter.formatFriendly(report)
+	if err != nil || !strings.Contains(friendly, "max_acc_check: mlxconfig not found (install mft)") ||
+		!strings.Contains(friendly, "pcie_error_check: sudo dmesg exited with 1") {
+		t.Errorf("Expected friendly output to include the test errors, got error %v", err)
+	}
+	if strings.Contains(friendly, "gpu_count threshold") {
+		t.Error("Threshold errors should be shown by their own test only")
+	}
+}
+
 func TestReporter_AllResultTypes(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -142,114 +556,556 @@ func TestReporter_AllResultTypes(t *testing.T) {
 		{
 			name: "PCIe Result",
 			addFunc: func(r *Reporter) {
-				r.AddPCIeResult("PASS", nil)
+				r.AddPCIeResult("PASS", nil)
+			},
+			resultKey:  "pcie_error_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "RDMA Result",
+			addFunc: func(r *Reporter) {
+				r.AddRDMAResult("PASS", 16, nil, nil, nil)
+			},
+			resultKey:  "rdma_nic_count",
+			wantStatus: "PASS",
+		},
+		{
+			name: "Network Result",
+			addFunc: func(r *Reporter) {
+				r.AddRXDiscardsCheckResult("PASS", 16, []string{}, nil)
+			},
+			resultKey:  "rx_discards_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "GID Index Result",
+			addFunc: func(r *Reporter) {
+				r.AddGIDIndexResult("PASS", []int{}, nil)
+			},
+			resultKey:  "gid_index_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "Link Result",
+			addFunc: func(r *Reporter) {
+				r.AddLinkResult("PASS", []map[string]interface{}{{"device": "rdma0"}}, nil)
+			},
+			resultKey:  "link_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "Ethernet Link Result",
+			addFunc: func(r *Reporter) {
+				r.AddEthLinkResult("PASS", []map[string]interface{}{{"device": "eth0"}}, nil)
+			},
+			resultKey:  "eth_link_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "SRAM Error Result",
+			addFunc: func(r *Reporter) {
+				r.AddSRAMErrorResult("PASS", 0, 25, nil, nil)
+			},
+			resultKey:  "sram_error_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "GPU Driver Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUDriverResult("PASS", "550.54.15", nil)
+			},
+			resultKey:  "gpu_driver_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "PeerMem Result",
+			addFunc: func(r *Reporter) {
+				r.AddPeerMemResult("PASS", true, nil)
+			},
+			resultKey:  "peermem_module_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "NVLink Result",
+			addFunc: func(r *Reporter) {
+				r.AddNVLinkResult("PASS", map[string]interface{}{"speed": 26, "count": 18}, nil)
+			},
+			resultKey:  "nvlink_speed_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "Eth0 Presence Result",
+			addFunc: func(r *Reporter) {
+				r.AddEth0PresenceResult("PASS", true, nil)
+			},
+			resultKey:  "eth0_presence_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "HCA Error Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddHCAResult("PASS", nil)
+			},
+			resultKey:  "hca_error_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "HCA Error Check Warning Result",
+			addFunc: func(r *Reporter) {
+				r.AddHCAErrorResult("WARN", 0, 2, nil, fmt.Errorf("found MLX5 warnings: 2 warnings detected"))
+			},
+			resultKey:  "hca_error_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "Missing Interface Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddMissingInterfaceResult("PASS", 0, nil)
+			},
+			resultKey:  "missing_interface_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "Missing Interface Check Details Result",
+			addFunc: func(r *Reporter) {
+				r.AddMissingInterfaceDetailsResult("WARN", 0, 18, 17, nil, []string{"mlx5_11"}, nil, fmt.Errorf("missing VCN interfaces: mlx5_11"))
+			},
+			resultKey:  "missing_interface_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "Row Remap Error Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRowRemapResult("PASS", nil, 0)
+			},
+			resultKey:  "row_remap_error_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "GPU VBIOS Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUVBIOSResult("WARN", []map[string]interface{}{{"index": "0", "vbios_version": "96.00.89.00.01"}}, fmt.Errorf("unapproved"))
+			},
+			resultKey:  "gpu_vbios_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "Hugepages Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddHugepagesResult("FAIL", 512, 0, false, fmt.Errorf("2MB hugepages 512 below minimum 1024"))
+			},
+			resultKey:  "hugepages_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "NUMA Affinity Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddNUMAAffinityResult("PASS", []map[string]interface{}{{"gpu": 0, "expected_numa": 0, "actual_numa": 0}}, nil)
+			},
+			resultKey:  "numa_affinity_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "IB SM Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddIBSMResult("FAIL", []map[string]interface{}{{"device": "mlx5_0", "port": 1, "sm_lid": 0}}, fmt.Errorf("mlx5_0/1 has no SM lid"))
+			},
+			resultKey:  "ib_sm_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "Time Sync Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddTimeSyncResult("WARN", true, 25.5, "169.254.169.123", 3, fmt.Errorf("clock offset above threshold"))
+			},
+			resultKey:  "time_sync_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "Kernel Module Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddKernelModuleResult("FAIL", []map[string]interface{}{{"module": "nvidia", "kernel_version": "535.104.12", "userspace_version": "550.90.12"}}, fmt.Errorf("nvidia kernel module mismatch"))
+			},
+			resultKey:  "kernel_module_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "Systemd Service Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddSystemdServiceResult("PASS", []map[string]interface{}{{"name": "nvidia-fabricmanager", "active_state": "active", "exit_code": 0}}, nil)
+			},
+			resultKey:  "systemd_service_check",
+			wantStatus: "PASS",
+		},
+		{
+			name: "IOMMU Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddIOMMUResult("FAIL", true, "strict", 8, fmt.Errorf("IOMMU mode strict is not allowed"))
+			},
+			resultKey:  "iommu_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "NVLink Topology Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddNVLinkTopologyResult("FAIL", [][]string{{"X", "SYS"}, {"SYS", "X"}}, []string{"GPU0-GPU1"}, fmt.Errorf("missing NVLink connections: GPU0-GPU1"))
+			},
+			resultKey:  "nvlink_topology_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "PCIe Replay Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddPCIeReplayResult("WARN", []map[string]interface{}{{"bdf": "00000000:0F:00.0", "replay_delta": 50, "replay_rate": 10.0}}, fmt.Errorf("PCIe replay rate above 1.00/s"))
+			},
+			resultKey:  "pcie_replay_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "RDMA Credit Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRDMACreditResult("FAIL", []map[string]interface{}{{"device": "mlx5_0", "port": 1, "counters": map[string]int64{"PortRcvSwitchRelayErrors": 4}}}, fmt.Errorf("mlx5_0/1 PortRcvSwitchRelayErrors=4"))
+			},
+			resultKey:  "rdma_credit_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "RDMA MTU Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRDMAMTUResult("FAIL", []map[string]interface{}{{"interface": "rdma0", "current_mtu": 1500, "expected_mtu": 9000}}, []string{"rdma0"}, fmt.Errorf("MTU below expected value on interface(s): rdma0"))
+			},
+			resultKey:  "rdma_mtu_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "RDMA Topology Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRDMATopologyResult("FAIL", []string{"mlx5_0", "mlx5_1"}, []string{"mlx5_0"}, []string{"mlx5_1"}, nil, fmt.Errorf("missing RDMA devices: mlx5_1"))
+			},
+			resultKey:  "rdma_topology_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "BIOS Settings Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddBIOSSettingsResult("WARN", "2.1.3", "Intel(R) Xeon(R) Platinum 8480+", true, true, fmt.Errorf("hyperthreading is enabled, expected disabled"))
+			},
+			resultKey:  "bios_settings_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "GPU InfoROM Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUInfoROMResult("FAIL", []map[string]interface{}{{"index": "0", "image_version": "None", "status": "FAIL"}}, fmt.Errorf("corrupted or missing InfoROM found on GPU(s): 0"))
+			},
+			resultKey:  "gpu_inforom_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU Row Remap Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPURowRemapResult("WARN", []map[string]interface{}{{"index": "1", "pending": true, "failure": false}}, fmt.Errorf("row remap pending reboot on GPU(s): 1"))
+			},
+			resultKey:  "gpu_row_remap_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "CPU Governor Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddCPUGovernorResult("FAIL", "powersave", "performance", []string{"cpu0", "cpu1"}, fmt.Errorf("CPU scaling governor is powersave, expected performance on 2 of 2 CPUs"))
+			},
+			resultKey:  "cpu_governor_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU Firmware Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUFirmwareResult("FAIL", []map[string]string{{"index": "0", "gsp_firmware_version": "535.54.03", "status": "FAIL"}}, fmt.Errorf("inconsistent GSP firmware versions across GPUs: 535.54.03,550.54.15"))
+			},
+			resultKey:  "gpu_firmware_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU P2P Bandwidth Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUP2PBWResult("WARN", [][]float64{{0, 400}, {400, 0}}, []string{"GPU0-GPU1"}, 450, fmt.Errorf("P2P bandwidth more than 10%% below 450 GB/s on GPU pair(s): GPU0-GPU1"))
+			},
+			resultKey:  "gpu_p2p_bw_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "CPU Isolation Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddCPUIsolationResult("WARN", 0, 8, false, fmt.Errorf("suboptimal CPU isolation: 0 CPUs isolated, expected 8"))
+			},
+			resultKey:  "cpu_isolation_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "PCIe Gen Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddPCIeGenResult("FAIL", []map[string]interface{}{{"bdf": "0f:00.0", "cap_gen": 5, "actual_gen": 4}}, fmt.Errorf("PCIe link downgraded on 1 device(s): 0f:00.0 (gpu): Gen4, capable of Gen5"))
+			},
+			resultKey:  "pcie_gen_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU C-State Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUCStateResult("FAIL", []map[string]interface{}{{"index": "0", "power_state": "P0", "runtime_pm": "auto"}}, fmt.Errorf("runtime power management is enabled on GPU(s): 0 (auto)"))
+			},
+			resultKey:  "gpu_cstate_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "NFS Mount Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddNFSMountResult("FAIL", []map[string]interface{}{{"path": "/nfs/cluster", "mounted": false, "responsive": false}}, fmt.Errorf("NFS mount(s) missing: /nfs/cluster"))
+			},
+			resultKey:  "nfs_mount_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "BER Trend Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddBERTrendResult("WARN", []map[string]interface{}{{"device": "rdma0", "slope": 2e-13, "trend": "increasing"}}, fmt.Errorf("effective physical BER increasing by more than 10%% per run on: rdma0 (+25.0%%/run)"))
+			},
+			resultKey:  "ber_trend_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "IB Cable Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddIBCableResult("FAIL", []map[string]interface{}{{"device": "mlx5_0", "cable_type": "850 nm VCSEL", "rx_power_dbm": []float64{-13.2}, "status": "FAIL"}}, fmt.Errorf("cable signal quality outside absolute limits on: mlx5_0"))
+			},
+			resultKey:  "ib_cable_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "PCIe ReBAR Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddPCIeReBARResult("FAIL", []map[string]interface{}{{"bdf": "0000:0f:00.0", "expected_bar1_size_mb": 32768, "actual_bar1_size_mb": 128, "status": "FAIL"}}, fmt.Errorf("BAR1 mapped below 256MB, resizable BAR disabled on: 0000:0f:00.0 (128MB)"))
+			},
+			resultKey:  "pcie_rebar_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU Idle Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUIdleResult("WARN", []map[string]interface{}{{"index": "0", "gpu_utilization_percent": 87, "memory_utilization_percent": 45, "status": "WARN"}}, fmt.Errorf("GPU(s) in use above 5%% utilization: 0 (gpu 87%%, memory 45%%)"))
+			},
+			resultKey:  "gpu_idle_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "PCIe Device Count Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddPCIeDeviceCountResult("FAIL", 8, 7, 16, 16, []string{"0000:d8:00.0"}, nil, fmt.Errorf("expected PCIe devices missing from lspci: GPU 0000:d8:00.0"))
+			},
+			resultKey:  "pcie_device_count_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU Firmware Update Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUFirmwareUpdateResult("WARN", "gsp 550.54.15, vbios 96.00.89.00.01", "gsp 550.127.05, vbios 96.00.99.00.01", true, fmt.Errorf("newer GPU firmware available"))
+			},
+			resultKey:  "gpu_firmware_update_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "GPU Reset Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUResetResult("FAIL", []map[string]interface{}{{"index": "0", "reset_count": 3}}, fmt.Errorf("GPU(s) reset more than 2 times since boot: 0 (3 resets)"))
+			},
+			resultKey:  "gpu_reset_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "RDMA Interface Speed Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRDMAInterfaceSpeedResult("FAIL", []map[string]interface{}{{"interface": "rdma0", "current_speed": "50000Mb/s"}}, []string{"rdma0"}, fmt.Errorf("speed below 100000Mb/s on interface(s): rdma0"))
+			},
+			resultKey:  "rdma_interface_speed_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "IB Port State Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddIBPortStateResult("FAIL", []map[string]interface{}{{"device": "mlx5_1", "port": 1, "state": "Polling"}}, []string{"mlx5_1/1"}, fmt.Errorf("port(s) not Active/LinkUp: mlx5_1/1 (Polling/Polling)"))
+			},
+			resultKey:  "ib_port_state_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "NIC Firmware Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddNICFirmwareResult("FAIL", []map[string]interface{}{{"interface": "rdma0", "firmware_version": "28.36.1010", "status": "FAIL"}}, fmt.Errorf("inconsistent NIC firmware versions"))
+			},
+			resultKey:  "nic_firmware_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "RDMA PCI Mapping Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRDMAPCIMappingResult("FAIL", map[string]string{"0000:0c:00.0": "mlx5_0"}, map[string]string{"0000:0c:00.0": "mlx5_1"}, []string{"0000:0c:00.0"}, fmt.Errorf("RDMA device to PCI address mapping mismatch"))
+			},
+			resultKey:  "rdma_pci_mapping_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU PCIe Topology Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUPCIeTopoResult("FAIL", [][]string{{"X", "CNS"}, {"CNS", "X"}}, []string{"GPU0-GPU1 (CNS)"}, nil, fmt.Errorf("GPU pairs without P2P read access: GPU0-GPU1 (CNS)"))
+			},
+			resultKey:  "gpu_pcie_topo_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "MLXConfig Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddMLXConfigResult("FAIL", []MLXConfigParameterResult{
+					{PCIBusID: "0000:0c:00.0", Parameter: "MAX_ACC_OUT_READ", Expected: []string{"0", "44", "128"}, Actual: "32", Status: "FAIL"},
+				}, fmt.Errorf("mlxconfig parameters not as expected"))
+			},
+			resultKey:  "mlxconfig_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "GPU Power Rail Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUPowerRailResult("WARN", 700, []map[string]interface{}{{"gpu": "00000000:0F:00.0", "rail": "GPU Power Readings", "reading": "Current Power Limit", "power_w": 650.0, "status": "WARN"}}, fmt.Errorf("GPU power readings near 700 W TDP ±10%% bounds"))
+			},
+			resultKey:  "gpu_power_rail_check",
+			wantStatus: "WARN",
+		},
+		{
+			name: "GPU Temperature Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddGPUTemperatureResult("FAIL", []map[string]interface{}{{"index": "0", "gpu_temp_c": 92, "status": "FAIL"}}, map[string]int{"gpu_warning_c": 80, "gpu_critical_c": 90}, fmt.Errorf("GPU temperature at or above critical threshold on GPU(s): 0 (GPU 92°C)"))
+			},
+			resultKey:  "gpu_temperature_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "RoCE VLAN Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddRoCEVLANResult("FAIL", []map[string]interface{}{{"device": "mlx5_0", "interface": "rdma0", "status": "FAIL"}}, fmt.Errorf("incorrect VLAN tagging on RoCE interface(s): rdma0 missing VLAN 100 interface"))
+			},
+			resultKey:  "roce_vlan_check",
+			wantStatus: "FAIL",
+		},
+		{
+			name: "IB Switch Port Check Result",
+			addFunc: func(r *Reporter) {
+				r.AddIBSwitchPortResult("WARN", []IBSwitchPortResult{{Device: "mlx5_0", Port: 1, NICState: "Active", SwitchLID: 12, SwitchPort: 17, SwitchState: "Armed", Status: "WARN"}}, fmt.Errorf("switch port state differs from NIC port state: mlx5_0/1 is Active, switch LID 12 port 17 is Armed"))
 			},
-			resultKey:  "pcie_error_check",
-			wantStatus: "PASS",
+			resultKey:  "ib_switch_port_check",
+			wantStatus: "WARN",
 		},
 		{
-			name: "RDMA Result",
+			name: "GPU-CPU Bandwidth Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddRDMAResult("PASS", 16, nil)
+				r.AddGPUCPUBWResult("WARN", []map[string]interface{}{{"gpu": 3, "h2d_gbps": 55.1, "d2h_gbps": 57.9, "status": "WARN"}}, 64, fmt.Errorf("GPU-CPU bandwidth more than 10%% below 64 GB/s on: GPU3 (H2D 55.10 GB/s, D2H 57.90 GB/s)"))
 			},
-			resultKey:  "rdma_nic_count",
-			wantStatus: "PASS",
+			resultKey:  "gpu_cpu_bw_check",
+			wantStatus: "WARN",
 		},
 		{
-			name: "Network Result",
+			name: "Fabric Manager Log Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddRXDiscardsCheckResult("PASS", 16, []string{}, nil)
+				r.AddFabricManagerLogResult("FAIL", 1, 1, 2, "[ERROR] [tid 1510] NVSwitch fatal error detected", []string{"[WARNING] partial link activation", "[ERROR] [tid 1510] NVSwitch fatal error detected"}, fmt.Errorf("1 fabric manager error(s) in the last 1000 log lines"))
 			},
-			resultKey:  "rx_discards_check",
-			wantStatus: "PASS",
+			resultKey:  "fabricmanager_log_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "GID Index Result",
+			name: "GPU Memory Temperature Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddGIDIndexResult("PASS", []int{}, nil)
+				r.AddGPUMemTemperatureResult("WARN", []map[string]interface{}{{"index": "2", "bus_id": "0000:2a:00.0", "memory_temp_c": 91, "status": "WARN"}}, 90, 95, fmt.Errorf("GPU memory temperature at or above 90°C on GPU(s): 2 (91°C)"))
 			},
-			resultKey:  "gid_index_check",
-			wantStatus: "PASS",
+			resultKey:  "gpu_mem_temperature_check",
+			wantStatus: "WARN",
 		},
 		{
-			name: "Link Result",
+			name: "PCIe Vendor Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddLinkResult("PASS", []map[string]interface{}{{"device": "rdma0"}}, nil)
+				r.AddPCIeVendorResult("FAIL", []string{"0000:0f:00.0", "0000:0c:00.0"}, []map[string]interface{}{{"bdf": "0000:0f:00.0", "found_id": "10de:2324", "status": "FAIL"}}, []string{"GPU 0000:0f:00.0 (found 10de:2324, expected 10de:2330)"}, fmt.Errorf("unexpected PCIe vendor:device IDs: GPU 0000:0f:00.0 (found 10de:2324, expected 10de:2330)"))
 			},
-			resultKey:  "link_check",
-			wantStatus: "PASS",
+			resultKey:  "pcie_vendor_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "Ethernet Link Result",
+			name: "RDMA Retry Counter Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddEthLinkResult("PASS", []map[string]interface{}{{"device": "eth0"}}, nil)
+				r.AddRDMARetryCounterResult("WARN", []map[string]interface{}{{"device": "mlx5_0", "port": 1, "retry_rate_per_sec": 4.2, "cumulative_count": 1200, "status": "WARN"}}, 1, fmt.Errorf("RDMA retry rate above 1.00/s on: mlx5_0 port 1 (4.20/s: local_ack_timeout_err +42)"))
 			},
-			resultKey:  "eth_link_check",
-			wantStatus: "PASS",
+			resultKey:  "rdma_retry_counter_check",
+			wantStatus: "WARN",
 		},
 		{
-			name: "SRAM Error Result",
+			name: "NVLink Bandwidth Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddSRAMErrorResult("PASS", 0, 25, nil)
+				r.AddNVLinkBWResult("FAIL", 401.8, 900, "Ring", fmt.Errorf("NVLink all-reduce bus bandwidth 401.80 GB/s below the expected 900.00 GB/s"))
 			},
-			resultKey:  "sram_error_check",
-			wantStatus: "PASS",
+			resultKey:  "nvlink_bw_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "GPU Driver Result",
+			name: "GPU MIG Profile Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddGPUDriverResult("PASS", "550.54.15", nil)
+				r.AddGPUMIGProfileResult("FAIL", []string{"1g.10gb", "1g.10gb"}, nil, []string{"GPU 0: 1g.10gb"}, []string{"GPU 0: 2g.20gb"}, fmt.Errorf("MIG profiles do not match the expected profiles"))
 			},
-			resultKey:  "gpu_driver_check",
-			wantStatus: "PASS",
+			resultKey:  "gpu_mig_profile_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "PeerMem Result",
+			name: "Network Auth Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddPeerMemResult("PASS", true, nil)
+				r.AddNetworkAuthCheckResult("FAIL", nil, []string{"ib0"}, fmt.Errorf("RDMA interfaces not authenticated: ib0"))
 			},
-			resultKey:  "peermem_module_check",
-			wantStatus: "PASS",
+			resultKey:  "network_auth_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "NVLink Result",
+			name: "DCGM Field Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddNVLinkResult("PASS", map[string]interface{}{"speed": 26, "count": 18}, nil)
+				r.AddDCGMFieldResult("FAIL", map[string]interface{}{"gpu_temperature": map[string]interface{}{"max": 92.0, "status": "FAIL"}}, []string{"gpu_temperature"}, fmt.Errorf("DCGM field values outside thresholds"))
 			},
-			resultKey:  "nvlink_speed_check",
-			wantStatus: "PASS",
+			resultKey:  "dcgm_field_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "Eth0 Presence Result",
+			name: "NUMA Distance Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddEth0PresenceResult("PASS", true, nil)
+				r.AddNUMADistanceResult("FAIL", [][]int{{10, 32}, {32, 10}}, [][]int{{10, 21}, {21, 10}}, []string{"node0->node1: 32 (expected 21)"}, fmt.Errorf("NUMA distances differ from the expected distances"))
 			},
-			resultKey:  "eth0_presence_check",
-			wantStatus: "PASS",
+			resultKey:  "numa_distance_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "HCA Error Check Result",
+			name: "CPU Topology Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddHCAResult("PASS", nil)
+				r.AddCPUTopologyResult("FAIL", 1, 48, 96, true, fmt.Errorf("CPU topology does not match the expected topology"))
 			},
-			resultKey:  "hca_error_check",
-			wantStatus: "PASS",
+			resultKey:  "cpu_topology_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "Missing Interface Check Result",
+			name: "Memory Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddMissingInterfaceResult("PASS", 0, nil)
+				r.AddMemoryResult("FAIL", 1510, 1480, 24, 4800, "DDR5", []string{"CPU1_DIMM_A2"}, 2048, 4800, fmt.Errorf("memory does not meet the shape requirements"))
 			},
-			resultKey:  "missing_interface_check",
-			wantStatus: "PASS",
+			resultKey:  "memory_check",
+			wantStatus: "FAIL",
 		},
 		{
-			name: "Row Remap Error Check Result",
+			name: "GPU Firmware Consistency Check Result",
 			addFunc: func(r *Reporter) {
-				r.AddRowRemapResult("PASS", nil, 0)
+				r.AddGPUFirmwareConsistencyResult("FAIL", map[string][]string{"96.00.74.00.01": {"0", "1"}, "96.00.89.00.01": {"2"}}, fmt.Errorf("found 2 different VBIOS versions"))
 			},
-			resultKey:  "row_remap_error_check",
-			wantStatus: "PASS",
+			resultKey:  "gpu_firmware_consistency_check",
+			wantStatus: "FAIL",
 		},
 	}
 
@@ -274,7 +1130,7 @@ func TestReporter_ResultDetails(t *testing.T) {
 		{
 			name: "SRAM Error Details",
 			setupFunc: func(r *Reporter) {
-				r.AddSRAMErrorResult("PASS", 5, 100, nil)
+				r.AddSRAMErrorResult("PASS", 5, 100, nil, nil)
 			},
 			resultKey: "sram_error_check",
 			checkFunc: func(t *testing.T, result TestResult) {
@@ -340,6 +1196,47 @@ func TestReporter_ResultDetails(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "GID Index Count Details",
+			setupFunc: func(r *Reporter) {
+				r.AddGIDIndexDetailsResult("FAIL", []int{}, nil, nil, 64, 60, fmt.Errorf("GID table has 60 entries, expected at least 64"))
+			},
+			resultKey: "gid_index_check",
+			checkFunc: func(t *testing.T, result TestResult) {
+				if expected, ok := result.Details["expected_count"]; !ok || expected != 64 {
+					t.Errorf("Expected expected_count 64, got %v", expected)
+				}
+				if actual, ok := result.Details["actual_count"]; !ok || actual != 60 {
+					t.Errorf("Expected actual_count 60, got %v", actual)
+				}
+			},
+		},
+		{
+			name: "GID Index Wrong Type Details",
+			setupFunc: func(r *Reporter) {
+				r.AddGIDIndexDetailsResult("FAIL", []int{}, []int{0, 2}, nil, 0, 4, fmt.Errorf("GID indexes with unexpected type found: [0 2]"))
+			},
+			resultKey: "gid_index_check",
+			checkFunc: func(t *testing.T, result TestResult) {
+				wrongType, ok := result.Details["wrong_type_indexes"].([]int)
+				if !ok || len(wrongType) != 2 || wrongType[0] != 0 || wrongType[1] != 2 {
+					t.Errorf("Expected wrong_type_indexes [0 2], got %v", result.Details["wrong_type_indexes"])
+				}
+			},
+		},
+		{
+			name: "GID Index Duplicate Details",
+			setupFunc: func(r *Reporter) {
+				r.AddGIDIndexDetailsResult("FAIL", []int{}, nil, []string{"0000:0000:0000:0000:0000:ffff:0a00:0001"}, 0, 4, fmt.Errorf("duplicate GID values found across ports"))
+			},
+			resultKey: "gid_index_check",
+			checkFunc: func(t *testing.T, result TestResult) {
+				duplicates, ok := result.Details["duplicate_gids"].([]string)
+				if !ok || len(duplicates) != 1 {
+					t.Errorf("Expected one duplicate_gids entry, got %v", result.Details["duplicate_gids"])
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -364,7 +1261,7 @@ func TestReporter_GenerateReport(t *testing.T) {
 
 	// Add sample results
 	reporter.AddGPUResult("PASS", 8, nil)
-	reporter.AddSRAMErrorResult("PASS", 1, 75, nil)
+	reporter.AddSRAMErrorResult("PASS", 1, 75, nil, nil)
 	reporter.AddRXDiscardsCheckResult("FAIL", 16, []string{"rdma2"}, fmt.Errorf("error"))
 	reporter.AddNVLinkResult("PASS", map[string]interface{}{"speed": 26, "count": 18}, nil)
 	// Add CDFP cable check result
@@ -405,6 +1302,72 @@ func TestReporter_GenerateReport(t *testing.T) {
 	}
 }
 
+func TestReporter_JSONReport(t *testing.T) {
+	reporter := createTestReporter()
+	reporter.AddGPUResult("PASS", 8, nil)
+
+	data, err := reporter.JSONReport()
+	if err != nil {
+		t.Fatalf("JSONReport() error = %v", err)
+	}
+
+	report := assertJSONValid(t, data)
+	if len(report.Localhost.GPUCountCheck) != 1 || report.Localhost.GPUCountCheck[0].Status != "PASS" {
+		t.Errorf("Expected 1 passed GPU result, got %+v", report.Localhost.GPUCountCheck)
+	}
+}
+
+func TestReporter_InstanceTags(t *testing.T) {
+	reporter := createTestReporter()
+	outputFile := createTempFile(t, "test_report.json")
+	reporter.outputFile = outputFile
+	reporter.AddGPUResult("PASS", 8, nil)
+
+	// Tags are left out unless set
+	if err := reporter.WriteReport(); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read report file: %v", err)
+	}
+	if strings.Contains(string(data), `"tags"`) {
+		t.Errorf("Expected no tags in report, got %s", data)
+	}
+
+	reporter.SetInstanceTags(
+		map[string]interface{}{"Oracle-Tags": map[string]interface{}{"CreatedBy": "hpc-admin"}},
+		map[string]interface{}{"cluster": "gpu-cluster-1"},
+	)
+	if err := reporter.WriteReport(); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	data, err = os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read report file: %v", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if _, ok := raw["tags"]; !ok {
+		t.Fatalf("Expected tags at the root of the report, got %s", data)
+	}
+
+	report := assertJSONValid(t, data)
+	if report.Tags.FreeformTags["cluster"] != "gpu-cluster-1" {
+		t.Errorf("Expected freeform tag cluster=gpu-cluster-1, got %v", report.Tags.FreeformTags)
+	}
+	namespace, ok := report.Tags.DefinedTags["Oracle-Tags"].(map[string]interface{})
+	if !ok || namespace["CreatedBy"] != "hpc-admin" {
+		t.Errorf("Expected defined tag Oracle-Tags.CreatedBy=hpc-admin, got %v", report.Tags.DefinedTags)
+	}
+	if len(report.Localhost.GPUCountCheck) != 1 {
+		t.Error("Expected tags to be written alongside the test results")
+	}
+}
+
 func TestReporter_WriteReport(t *testing.T) {
 	reporter := createTestReporter()
 	outputFile := createTempFile(t, "test_report.json")
@@ -412,7 +1375,7 @@ func TestReporter_WriteReport(t *testing.T) {
 
 	// Add test data
 	reporter.AddGPUResult("PASS", 8, nil)
-	reporter.AddSRAMErrorResult("FAIL", 15, 200, fmt.Errorf("threshold exceeded"))
+	reporter.AddSRAMErrorResult("FAIL", 15, 200, nil, fmt.Errorf("threshold exceeded"))
 	reporter.AddNVLinkResult("FAIL", map[string]interface{}{"speed": 22, "count": 16}, fmt.Errorf("nvlink issues"))
 
 	// Write report
@@ -451,6 +1414,280 @@ func TestReporter_WriteReport(t *testing.T) {
 	}
 }
 
+func TestReporter_FormatCSV(t *testing.T) {
+	reporter := createTestReporter()
+	reporter.AddGPUResult("PASS", 8, nil)
+	reporter.AddPCIeResult("FAIL", fmt.Errorf("pcie errors"))
+	reporter.AddRXDiscardsCheckResult("FAIL", 16, []string{"rdma0", "rdma1"}, fmt.Errorf("rx discards"))
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+
+	output, err := reporter.formatCSV(report)
+	if err != nil {
+		t.Fatalf("formatCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("formatCSV() produced invalid CSV: %v", err)
+	}
+
+	expectedHeader := []string{"test_name", "status", "detail_key", "detail_value", "timestamp_utc"}
+	if strings.Join(records[0], ",") != strings.Join(expectedHeader, ",") {
+		t.Errorf("Expected header %v, got %v", expectedHeader, records[0])
+	}
+
+	rows := make(map[string][]string)
+	for _, record := range records[1:] {
+		if len(record) != len(expectedHeader) {
+			t.Fatalf("Expected %d columns, got %d: %v", len(expectedHeader), len(record), record)
+		}
+		if record[4] == "" {
+			t.Errorf("Expected timestamp for %s", record[0])
+		}
+		rows[record[0]+"/"+record[2]] = record
+	}
+
+	if row := rows["gpu_count_check/gpu_count"]; row == nil || row[1] != "PASS" || row[3] != "8" {
+		t.Errorf("Unexpected gpu_count row: %v", row)
+	}
+	// Tests without details get a single row with empty detail columns
+	if row := rows["pcie_error_check/"]; row == nil || row[1] != "FAIL" || row[3] != "" {
+		t.Errorf("Unexpected pcie_error_check row: %v", row)
+	}
+	if row := rows["rx_discards_check/failed_interfaces"]; row == nil || row[3] != "rdma0,rdma1" {
+		t.Errorf("Unexpected failed_interfaces row: %v", row)
+	}
+
+	// Values containing commas must be quoted in the raw output
+	if !strings.Contains(output, `"rdma0,rdma1"`) {
+		t.Errorf("Expected value with commas to be quoted, got:\n%s", output)
+	}
+}
+
+func TestReporter_FormatYAML(t *testing.T) {
+	reporter := createTestReporter()
+	reporter.AddGPUResult("PASS", 8, nil)
+	reporter.AddPCIeResult("FAIL", fmt.Errorf("pcie errors"))
+	reporter.AddRXDiscardsCheckResult("FAIL", 16, []string{"rdma0", "rdma1"}, fmt.Errorf("rx discards"))
+	reporter.SetShape("BM.GPU.H100.8", "BM.GPU.H100.8")
+
+	report, err := reporter.GenerateReport()
+	if err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	report.Tags = &InstanceTags{FreeformTags: map[string]interface{}{"cost_center": "hpc"}}
+
+	output, err := reporter.formatYAML(report)
+	if err != nil {
+		t.Fatalf("formatYAML() error = %v", err)
+	}
+
+	var document map[string]interface{}
+	if err := yaml.Unmarshal([]byte(output), &document); err != nil {
+		t.Fatalf("formatYAML() produced invalid YAML: %v", err)
+	}
+
+	// The YAML round-trips through unmarshal and marshal unchanged
+	remarshaled, err := yaml.Marshal(document)
+	if err != nil {
+		t.Fatalf("Failed to marshal YAML: %v", err)
+	}
+	if string(remarshaled) != output {
+		t.Errorf("YAML changed after round trip:\n%s\nwant:\n%s", remarshaled, output)
+	}
+
+	// Keys are camelCase, values keep their JSON types
+	if document["shape"] != "BM.GPU.H100.8" || document["configShape"] != "BM.GPU.H100.8" {
+		t.Errorf("Unexpected shape keys in %v", document)
+	}
+	localhost, ok := document["localhost"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected localhost map, got %v", document["localhost"])
+	}
+	gpuResults, ok := localhost["gpuCountCheck"].([]interface{})
+	if !ok || len(gpuResults) != 1 {
+		t.Fatalf("Expected gpuCountCheck results, got %v", localhost["gpuCountCheck"])
+	}
+	gpuResult := gpuResults[0].(map[string]interface{})
+	if gpuResult["status"] != "PASS" || gpuResult["gpuCount"] != 8 || gpuResult["timestampUtc"] == nil {
+		t.Errorf("Unexpected gpuCountCheck result %v", gpuResult)
+	}
+	if _, exists := localhost["gpu_count_check"]; exists {
+		t.Error("Expected no snake_case keys in YAML output")
+	}
+	rxResult := localhost["rxDiscardsCheck"].([]interface{})[0].(map[string]interface{})
+	if rxResult["failedInterfaces"] != "rdma0,rdma1" || rxResult["failedCount"] != 2 {
+		t.Errorf("Unexpected failedInterfaces %v", rxResult["failedInterfaces"])
+	}
+
+	// Instance tag keys are user data and not converted
+	tags := document["tags"].(map[string]interface{})["freeformTags"].(map[string]interface{})
+	if tags["cost_center"] != "hpc" {
+		t.Errorf("Expected tag keys to be kept as is, got %v", tags)
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	tests := map[string]string{
+		"gpu_count_check":  "gpuCountCheck",
+		"status":           "status",
+		"eth0_presence":    "eth0Presence",
+		"rx_power_dbm":     "rxPowerDbm",
+		"expected_bar1_mb": "expectedBar1Mb",
+	}
+	for key, expected := range tests {
+		if got := camelCase(key); got != expected {
+			t.Errorf("camelCase(%q) = %q, want %q", key, got, expected)
+		}
+	}
+}
+
+func TestCSVValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"Nil", nil, ""},
+		{"String", "PASS", "PASS"},
+		{"Number", json.Number("42"), "42"},
+		{"Bool", true, "true"},
+		{"List", []interface{}{"a", "b"}, `["a","b"]`},
+		{"Object", map[string]interface{}{"speed": json.Number("25")}, `{"speed":25}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := csvValue(tt.value); got != tt.expected {
+				t.Errorf("csvValue() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func createMultiHostReport() *MultiHostReport {
+	return &MultiHostReport{
+		Hosts: map[string]HostResults{
+			"node2": {
+				GPUCountCheck:  []GPUTestResult{{Status: "FAIL", GPUCount: 7, TimestampUTC: "2024-01-01T00:00:00Z"}},
+				PCIeErrorCheck: []PCIeTestResult{{Status: "PASS", TimestampUTC: "2024-01-01T00:00:00Z"}},
+			},
+			"node1": {
+				GPUCountCheck:  []GPUTestResult{{Status: "PASS", GPUCount: 8, TimestampUTC: "2024-01-01T00:00:00Z"}},
+				PCIeErrorCheck: []PCIeTestResult{{Status: "PASS", TimestampUTC: "2024-01-01T00:00:00Z"}},
+			},
+		},
+		Errors: map[string]string{"node3": "ssh failed: connection refused"},
+	}
+}
+
+func TestMultiHostReport_Hosts(t *testing.T) {
+	report := createMultiHostReport()
+
+	if hosts := strings.Join(report.HostNames(), ","); hosts != "node1,node2,node3" {
+		t.Errorf("HostNames() = %s, want node1,node2,node3", hosts)
+	}
+	if failed := strings.Join(report.FailedHosts(), ","); failed != "node2,node3" {
+		t.Errorf("FailedHosts() = %s, want node2,node3", failed)
+	}
+}
+
+func TestFormatMultiHostReport(t *testing.T) {
+	report := createMultiHostReport()
+
+	t.Run("JSON", func(t *testing.T) {
+		output, err := FormatMultiHostReport(report, "json")
+		if err != nil {
+			t.Fatalf("FormatMultiHostReport() error = %v", err)
+		}
+		var decoded MultiHostReport
+		if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+		if decoded.Hosts["node2"].GPUCountCheck[0].GPUCount != 7 || decoded.Errors["node3"] == "" {
+			t.Errorf("Unexpected decoded report: %+v", decoded)
+		}
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		output, err := FormatMultiHostReport(report, "yaml")
+		if err != nil {
+			t.Fatalf("FormatMultiHostReport() error = %v", err)
+		}
+		var decoded map[string]map[string]interface{}
+		if err := yaml.Unmarshal([]byte(output), &decoded); err != nil {
+			t.Fatalf("Failed to parse YAML: %v", err)
+		}
+		node2, ok := decoded["hosts"]["node2"].(map[string]interface{})
+		if !ok || node2["gpuCountCheck"] == nil || decoded["errors"]["node3"] == nil {
+			t.Errorf("Unexpected decoded report: %+v", decoded)
+		}
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		output, err := FormatMultiHostReport(report, "table")
+		if err != nil {
+			t.Fatalf("FormatMultiHostReport() error = %v", err)
+		}
+		// Hosts are row groups in sorted order with their tests listed below them
+		node1 := strings.Index(output, "HOST: node1")
+		node2 := strings.Index(output, "HOST: node2")
+		node3 := strings.Index(output, "HOST: node3")
+		if node1 < 0 || node2 < node1 || node3 < node2 {
+			t.Fatalf("Expected host groups in order, got:\n%s", output)
+		}
+		if !strings.Contains(output[node2:node3], "gpu_count_check") || !strings.Contains(output[node2:node3], "FAIL") {
+			t.Errorf("Expected node2 group to contain failed gpu_count_check, got:\n%s", output[node2:node3])
+		}
+		if !strings.Contains(output[node3:], "ERROR") {
+			t.Errorf("Expected node3 group to contain an error row")
+		}
+	})
+
+	t.Run("Friendly", func(t *testing.T) {
+		output, err := FormatMultiHostReport(report, "friendly")
+		if err != nil {
+			t.Fatalf("FormatMultiHostReport() error = %v", err)
+		}
+		for _, expected := range []string{"All 2 test(s) passed", "gpu_count_check: FAIL", "Unreachable: ssh failed", "Total Hosts: 3", "Healthy: 1"} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected friendly output to contain %q", expected)
+			}
+		}
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		output, err := FormatMultiHostReport(report, "csv")
+		if err != nil {
+			t.Fatalf("FormatMultiHostReport() error = %v", err)
+		}
+		records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+		if err != nil {
+			t.Fatalf("Invalid CSV: %v", err)
+		}
+		if records[0][0] != "host" {
+			t.Errorf("Expected host column first, got %v", records[0])
+		}
+		// Two rows per healthy or failing host plus one error row
+		if len(records) != 6 {
+			t.Errorf("Expected 6 records, got %d:\n%s", len(records), output)
+		}
+	})
+
+	t.Run("Unsupported Format", func(t *testing.T) {
+		if _, err := FormatMultiHostReport(report, "xml"); err == nil {
+			t.Error("Expected error for unsupported format")
+		}
+		if err := ValidateMultiHostFormat("xml"); err == nil {
+			t.Error("Expected ValidateMultiHostFormat() error for unsupported format")
+		}
+	})
+}
+
 // JSON serialization tests
 
 func TestReporter_JSONSerialization(t *testing.T) {
@@ -458,7 +1695,7 @@ func TestReporter_JSONSerialization(t *testing.T) {
 
 	// Add comprehensive test data
 	reporter.AddGPUResult("PASS", 8, nil)
-	reporter.AddSRAMErrorResult("PASS", 2, 150, nil)
+	reporter.AddSRAMErrorResult("PASS", 2, 150, nil, nil)
 	reporter.AddLinkResult("PASS", []map[string]interface{}{
 		{"device": "rdma0", "link_speed": "PASS"},
 	}, nil)
@@ -507,7 +1744,7 @@ func TestReporter_ErrorHandling(t *testing.T) {
 	nvlinkErr := fmt.Errorf("NVLink speed check failed")
 
 	reporter.AddGPUResult("FAIL", 6, gpuErr)
-	reporter.AddSRAMErrorResult("FAIL", 20, 300, sramErr)
+	reporter.AddSRAMErrorResult("FAIL", 20, 300, nil, sramErr)
 	reporter.AddNVLinkResult("FAIL", map[string]interface{}{"speed": 22, "count": 16}, nvlinkErr)
 
 	// Verify errors are stored
@@ -541,7 +1778,7 @@ func TestReporter_EdgeCases(t *testing.T) {
 			name: "Zero Values",
 			test: func(t *testing.T) {
 				reporter := createTestReporter()
-				reporter.AddSRAMErrorResult("PASS", 0, 0, nil)
+				reporter.AddSRAMErrorResult("PASS", 0, 0, nil, nil)
 				reporter.AddRXDiscardsCheckResult("PASS", 0, []string{}, nil)
 				reporter.AddNVLinkResult("PASS", map[string]interface{}{"speed": 0, "count": 0}, nil)
 				assertResultCount(t, reporter, 3)
@@ -551,7 +1788,7 @@ func TestReporter_EdgeCases(t *testing.T) {
 			name: "Large Values",
 			test: func(t *testing.T) {
 				reporter := createTestReporter()
-				reporter.AddSRAMErrorResult("FAIL", 999, 10000, fmt.Errorf("excessive"))
+				reporter.AddSRAMErrorResult("FAIL", 999, 10000, nil, fmt.Errorf("excessive"))
 				reporter.AddRXDiscardsCheckResult("PASS", 128, []string{}, nil)
 				reporter.AddNVLinkResult("PASS", map[string]interface{}{"speed": 100, "count": 50}, nil)
 				assertResultCount(t, reporter, 3)
@@ -567,6 +1804,22 @@ func TestReporter_EdgeCases(t *testing.T) {
 				assertResultCount(t, reporter, 3)
 			},
 		},
+		{
+			name: "Concurrent Results",
+			test: func(t *testing.T) {
+				reporter := createTestReporter()
+				var wg sync.WaitGroup
+				for i := 0; i < 20; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						reporter.AddResult(fmt.Sprintf("test_%d", i), "PASS", nil, nil)
+					}(i)
+				}
+				wg.Wait()
+				assertResultCount(t, reporter, 20)
+			},
+		},
 		{
 			name: "Configuration Methods",
 			test: func(t *testing.T) {
@@ -604,8 +1857,8 @@ func BenchmarkReporter_AddResults(b *testing.B) {
 func BenchmarkReporter_GenerateReport(b *testing.B) {
 	reporter := createTestReporter()
 	reporter.AddGPUResult("PASS", 8, nil)
-	reporter.AddSRAMErrorResult("PASS", 1, 50, nil)
-	reporter.AddRDMAResult("PASS", 16, nil)
+	reporter.AddSRAMErrorResult("PASS", 1, 50, nil, nil)
+	reporter.AddRDMAResult("PASS", 16, nil, nil, nil)
 	reporter.AddNVLinkResult("PASS", map[string]interface{}{"speed": 26, "count": 18}, nil)
 
 	b.ResetTimer()
@@ -700,3 +1953,52 @@ func TestCDFPCableCheckOutputFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRunID(t *testing.T) {
+	runIDFormat := regexp.MustCompile(`^run-[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		runID := generateRunID()
+		if !runIDFormat.MatchString(runID) {
+			t.Fatalf("Expected run ID in run-<uuid v4> format, got %q", runID)
+		}
+		if seen[runID] {
+			t.Fatalf("Expected unique run IDs, got %q twice", runID)
+		}
+		seen[runID] = true
+	}
+}
+
+func TestReporter_AppendedRunIDsUnique(t *testing.T) {
+	outputFile := createTempFile(t, "results.json")
+	reporter := createTestReporter()
+	reporter.SetAppendMode(true)
+	if err := reporter.Initialize(outputFile); err != nil {
+		t.Fatalf("Failed to initialize reporter: %v", err)
+	}
+
+	// Both runs are appended within the same second
+	for i := 0; i < 2; i++ {
+		reporter.Clear()
+		reporter.AddGPUResult("PASS", 8, nil)
+		if err := reporter.WriteReportWithFormat("json"); err != nil {
+			t.Fatalf("Failed to write report: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	var appended AppendedReport
+	if err := json.Unmarshal(data, &appended); err != nil {
+		t.Fatalf("Failed to parse output file: %v", err)
+	}
+	if len(appended.TestRuns) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(appended.TestRuns))
+	}
+	first, second := appended.TestRuns[0].RunID, appended.TestRuns[1].RunID
+	if !strings.HasPrefix(first, "run-") || first == second {
+		t.Errorf("Expected unique run-<uuid> run IDs, got %q and %q", first, second)
+	}
+}
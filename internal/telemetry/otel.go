@@ -0,0 +1,179 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// tracerName is the instrumentation scope of the spans created by oci-dr-hpc
+	tracerName = "github.com/oracle/oci-dr-hpc-v2"
+	// serviceName is the service.name resource attribute of exported spans
+	serviceName = "oci-dr-hpc"
+)
+
+// TracingProvider wraps test executions and IMDS requests in OpenTelemetry spans. Spans are
+// children of the span of the current run started with StartRun. Without an OTLP endpoint the
+// provider uses a no-op tracer and test functions are called directly.
+type TracingProvider struct {
+	tracer   trace.Tracer
+	enabled  bool
+	shutdown func(context.Context) error
+	attrs    []attribute.KeyValue
+
+	mutex  sync.RWMutex
+	runCtx context.Context
+}
+
+var (
+	globalMutex    sync.RWMutex
+	globalProvider = NewNoopTracingProvider()
+)
+
+// NewTracingProvider creates a provider exporting spans to the OTLP/HTTP endpoint, e.g.
+// http://collector:4318. An endpoint without a scheme uses HTTPS. An empty endpoint returns
+// a no-op provider.
+func NewTracingProvider(ctx context.Context, endpoint, shape, hostname string) (*TracingProvider, error) {
+	if endpoint == "" {
+		return NewNoopTracingProvider(), nil
+	}
+
+	option := otlptracehttp.WithEndpoint(endpoint)
+	if strings.Contains(endpoint, "://") {
+		option = otlptracehttp.WithEndpointURL(endpoint)
+	}
+	exporter, err := otlptracehttp.New(ctx, option)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter for %s: %w", endpoint, err)
+	}
+	return newTracingProvider(sdktrace.NewBatchSpanProcessor(exporter), shape, hostname), nil
+}
+
+// NewTracingProviderWithExporter creates a provider exporting every span to exporter as soon as
+// it ends, e.g. to an in-memory exporter in tests
+func NewTracingProviderWithExporter(exporter sdktrace.SpanExporter, shape, hostname string) *TracingProvider {
+	return newTracingProvider(sdktrace.NewSimpleSpanProcessor(exporter), shape, hostname)
+}
+
+func newTracingProvider(processor sdktrace.SpanProcessor, shape, hostname string) *TracingProvider {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	return &TracingProvider{
+		tracer:   provider.Tracer(tracerName),
+		enabled:  true,
+		shutdown: provider.Shutdown,
+		attrs:    []attribute.KeyValue{attribute.String("shape", shape), attribute.String("hostname", hostname)},
+		runCtx:   context.Background(),
+	}
+}
+
+// NewNoopTracingProvider creates a provider that records nothing
+func NewNoopTracingProvider() *TracingProvider {
+	return &TracingProvider{
+		tracer:   noop.NewTracerProvider().Tracer(tracerName),
+		shutdown: func(context.Context) error { return nil },
+		runCtx:   context.Background(),
+	}
+}
+
+// SetGlobal sets the provider used by Global
+func SetGlobal(provider *TracingProvider) {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+	globalProvider = provider
+}
+
+// Global returns the provider set with SetGlobal, a no-op provider by default
+func Global() *TracingProvider {
+	globalMutex.RLock()
+	defer globalMutex.RUnlock()
+	return globalProvider
+}
+
+// Enabled returns whether spans are exported
+func (p *TracingProvider) Enabled() bool {
+	return p.enabled
+}
+
+// StartRun starts the span of a diagnostic run, the parent of the spans created until the
+// returned function ends it
+func (p *TracingProvider) StartRun(name string) func() {
+	if !p.enabled {
+		return func() {}
+	}
+	ctx, span := p.tracer.Start(context.Background(), name, trace.WithAttributes(p.attrs...))
+
+	p.mutex.Lock()
+	p.runCtx = ctx
+	p.mutex.Unlock()
+
+	return func() {
+		span.End()
+		p.mutex.Lock()
+		p.runCtx = context.Background()
+		p.mutex.Unlock()
+	}
+}
+
+// StartSpan starts a span that is a child of the span in ctx, or of the current run span when
+// ctx holds no span
+func (p *TracingProvider) StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !p.enabled {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		p.mutex.RLock()
+		ctx = p.runCtx
+		p.mutex.RUnlock()
+	}
+	return p.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// TraceTest runs fn in a span named after the test. status returns the test status recorded
+// for the error returned by fn; the span is marked as an error when the status is FAIL.
+func (p *TracingProvider) TraceTest(testName string, fn func() error, status func(error) string) error {
+	if !p.enabled {
+		return fn()
+	}
+
+	_, span := p.StartSpan(context.Background(), testName, append([]attribute.KeyValue{attribute.String("test.name", testName)}, p.attrs...)...)
+	defer span.End()
+
+	start := time.Now()
+	err := fn()
+	testStatus := status(err)
+
+	span.SetAttributes(
+		attribute.String("test.status", testStatus),
+		attribute.Int64("test.duration_ms", time.Since(start).Milliseconds()),
+	)
+	if testStatus == "FAIL" {
+		description := testStatus
+		if err != nil {
+			description = err.Error()
+		}
+		span.SetStatus(codes.Error, description)
+	}
+	return err
+}
+
+// Shutdown exports the remaining spans and stops the provider
+func (p *TracingProvider) Shutdown(ctx context.Context) error {
+	if err := p.shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shut down tracing: %w", err)
+	}
+	return nil
+}
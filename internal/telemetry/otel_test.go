@@ -0,0 +1,130 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spanAttributes returns the attributes of a recorded span by key
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, attr := range span.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+func TestTraceTest(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewTracingProviderWithExporter(exporter, "BM.GPU.H100.8", "gpu-node-1")
+
+	endRun := provider.StartRun("level1")
+	if err := provider.TraceTest("gpu_count_check", func() error { return nil }, func(error) string { return "PASS" }); err != nil {
+		t.Fatalf("TraceTest() error = %v", err)
+	}
+	testErr := errors.New("link down")
+	if err := provider.TraceTest("link_check", func() error { return testErr }, func(error) string { return "FAIL" }); err != testErr {
+		t.Fatalf("TraceTest() error = %v, want the test error", err)
+	}
+	endRun()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+	gpuSpan, linkSpan, runSpan := spans[0], spans[1], spans[2]
+	if runSpan.Name != "level1" {
+		t.Fatalf("Expected the run span to end last, got %s", runSpan.Name)
+	}
+
+	for _, span := range []tracetest.SpanStub{gpuSpan, linkSpan} {
+		if span.Parent.SpanID() != runSpan.SpanContext.SpanID() {
+			t.Errorf("Expected %s to be a child of the run span", span.Name)
+		}
+		attrs := spanAttributes(span)
+		if attrs["test.name"].AsString() != span.Name {
+			t.Errorf("%s test.name = %q", span.Name, attrs["test.name"].AsString())
+		}
+		if attrs["shape"].AsString() != "BM.GPU.H100.8" || attrs["hostname"].AsString() != "gpu-node-1" {
+			t.Errorf("%s shape/hostname = %q/%q", span.Name, attrs["shape"].AsString(), attrs["hostname"].AsString())
+		}
+		if _, exists := attrs["test.duration_ms"]; !exists {
+			t.Errorf("%s has no test.duration_ms attribute", span.Name)
+		}
+	}
+
+	if status := spanAttributes(gpuSpan)["test.status"].AsString(); status != "PASS" || gpuSpan.Status.Code == codes.Error {
+		t.Errorf("gpu_count_check test.status = %s, span status %v", status, gpuSpan.Status.Code)
+	}
+	if status := spanAttributes(linkSpan)["test.status"].AsString(); status != "FAIL" || linkSpan.Status.Code != codes.Error || linkSpan.Status.Description != "link down" {
+		t.Errorf("link_check test.status = %s, span status %v %q", status, linkSpan.Status.Code, linkSpan.Status.Description)
+	}
+}
+
+func TestStartSpanParent(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewTracingProviderWithExporter(exporter, "BM.GPU.H100.8", "gpu-node-1")
+
+	endRun := provider.StartRun("level1")
+	ctx, parent := provider.StartSpan(context.Background(), "imds instance")
+	_, child := provider.StartSpan(ctx, "HTTP GET")
+	child.End()
+	parent.End()
+	endRun()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+	if spans[0].Parent.SpanID() != spans[1].SpanContext.SpanID() {
+		t.Error("Expected HTTP GET to be a child of the span in its context")
+	}
+	if spans[1].Parent.SpanID() != spans[2].SpanContext.SpanID() {
+		t.Error("Expected a span without a parent in its context to be a child of the run span")
+	}
+}
+
+func TestNoopTracingProvider(t *testing.T) {
+	provider, err := NewTracingProvider(context.Background(), "", "BM.GPU.H100.8", "gpu-node-1")
+	if err != nil {
+		t.Fatalf("NewTracingProvider() error = %v", err)
+	}
+	if provider.Enabled() {
+		t.Error("Expected a no-op provider without an endpoint")
+	}
+
+	called := false
+	statusCalled := false
+	err = provider.TraceTest("gpu_count_check", func() error { called = true; return nil }, func(error) string { statusCalled = true; return "PASS" })
+	if err != nil || !called {
+		t.Errorf("TraceTest() = %v, called = %v", err, called)
+	}
+	if statusCalled {
+		t.Error("Expected the no-op provider not to look up the test status")
+	}
+	if _, span := provider.StartSpan(context.Background(), "imds instance"); span.IsRecording() {
+		t.Error("Expected no-op spans not to record")
+	}
+	if err := provider.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
+
+func TestGlobalTracingProvider(t *testing.T) {
+	if Global().Enabled() {
+		t.Fatal("Expected a no-op global provider by default")
+	}
+
+	provider := NewTracingProviderWithExporter(tracetest.NewInMemoryExporter(), "BM.GPU.H100.8", "gpu-node-1")
+	SetGlobal(provider)
+	defer SetGlobal(NewNoopTracingProvider())
+
+	if Global() != provider {
+		t.Error("Expected Global() to return the provider set with SetGlobal")
+	}
+}
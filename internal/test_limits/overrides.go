@@ -0,0 +1,118 @@
+package test_limits
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// envOverridePrefix is the prefix of environment variables overriding test limits:
+// OCI_HPC_THRESHOLD_<SHAPE>_<TEST>_<FIELD>=<value>, with the shape and test names upper-cased
+// and dots replaced by underscores. FIELD is THRESHOLD for the whole threshold, ENABLED,
+// TIMEOUT_SECONDS, or a field of an object threshold.
+const envOverridePrefix = "OCI_HPC_THRESHOLD_"
+
+// ApplyEnvOverrides patches limits with the OCI_HPC_THRESHOLD_* environment variables
+func ApplyEnvOverrides(limits *TestLimits) {
+	applyEnvOverrides(limits, os.Environ())
+}
+
+// applyEnvOverrides patches limits with the overrides found in environ, a list of KEY=value entries.
+// Values are parsed as JSON, so 50, true and [0,1] keep their types; other values are strings.
+func applyEnvOverrides(limits *TestLimits, environ []string) {
+	for _, entry := range environ {
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok || !strings.HasPrefix(name, envOverridePrefix) {
+			continue
+		}
+
+		shape, testName, config, field := findOverrideTarget(limits, strings.TrimPrefix(name, envOverridePrefix))
+		if config == nil {
+			logger.Errorf("Ignoring %s: no matching shape and test in test limits", name)
+			continue
+		}
+
+		if err := applyOverride(config, testName, field, parseOverrideValue(value)); err != nil {
+			logger.Errorf("Ignoring %s: %v", name, err)
+			continue
+		}
+		logger.Warnf("%s overrides %s of %s on %s with %s, test limits differ from the config file",
+			name, strings.ToLower(field), testName, shape, value)
+	}
+}
+
+// findOverrideTarget returns the test config and field an override name (without prefix) refers to.
+// The longest matching shape and test names win, since names may share prefixes.
+func findOverrideTarget(limits *TestLimits, name string) (string, string, *TestConfig, string) {
+	var bestShape, bestTest, bestField string
+	var bestConfig *TestConfig
+	bestLen := 0
+	for shape, tests := range limits.TestLimits {
+		shapePrefix := envName(shape) + "_"
+		if !strings.HasPrefix(name, shapePrefix) {
+			continue
+		}
+		for testName, config := range tests {
+			prefix := shapePrefix + envName(testName) + "_"
+			if config != nil && len(prefix) > bestLen && len(name) > len(prefix) && strings.HasPrefix(name, prefix) {
+				bestShape, bestTest, bestConfig, bestField = shape, testName, config, strings.TrimPrefix(name, prefix)
+				bestLen = len(prefix)
+			}
+		}
+	}
+	return bestShape, bestTest, bestConfig, bestField
+}
+
+// applyOverride sets field of the config of testName to value
+func applyOverride(config *TestConfig, testName, field string, value interface{}) error {
+	switch field {
+	case "THRESHOLD":
+		if wanted, known := thresholdTypes[testName]; known && !containsString(wanted, jsonType(value)) {
+			return fmt.Errorf("threshold: expected %s, got %s", strings.Join(wanted, " or "), jsonType(value))
+		}
+		config.Threshold = value
+	case "ENABLED":
+		enabled, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("enabled: expected boolean, got %s", jsonType(value))
+		}
+		config.Enabled = enabled
+	case "TIMEOUT_SECONDS":
+		seconds, ok := value.(float64)
+		if !ok || seconds <= 0 || seconds != float64(int(seconds)) {
+			return fmt.Errorf("timeout_seconds: expected a positive integer, got %v", value)
+		}
+		config.TimeoutSeconds = int(seconds)
+	default:
+		threshold, ok := config.Threshold.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("threshold: expected object to override field %s, got %s", strings.ToLower(field), jsonType(config.Threshold))
+		}
+		key := strings.ToLower(field)
+		for existing := range threshold {
+			if strings.EqualFold(existing, field) {
+				key = existing
+				break
+			}
+		}
+		threshold[key] = value
+	}
+	return nil
+}
+
+// parseOverrideValue parses value as JSON, falling back to the raw string
+func parseOverrideValue(value string) interface{} {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return value
+	}
+	return parsed
+}
+
+// envName converts a shape or test name to its environment variable form, e.g. BM.GPU.H100.8 to BM_GPU_H100_8
+func envName(name string) string {
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(name))
+}
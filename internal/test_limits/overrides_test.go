@@ -0,0 +1,84 @@
+package test_limits
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newOverrideTestLimits() *TestLimits {
+	return &TestLimits{
+		TestLimits: map[string]ShapeTestConfig{
+			"BM.GPU.H100.8": {
+				"rx_discards_check": {Enabled: true, TestCategory: "LEVEL_1", TimeoutSeconds: 60, Threshold: float64(100)},
+				"link_check":        {Enabled: true, TestCategory: "LEVEL_1", Threshold: map[string]interface{}{"speed": "200G"}},
+				"eth_link_check":    {Enabled: true, TestCategory: "LEVEL_1", Threshold: map[string]interface{}{"speed": "100G"}},
+				"sram_error_check":  {Enabled: true, TestCategory: "LEVEL_1", Threshold: map[string]interface{}{"uncorrectable": float64(5)}},
+			},
+		},
+	}
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	limits := newOverrideTestLimits()
+	applyEnvOverrides(limits, []string{
+		"PATH=/usr/bin",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_THRESHOLD=50",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_TIMEOUT_SECONDS=120",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_LINK_CHECK_ENABLED=false",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_ETH_LINK_CHECK_SPEED=400G",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_SRAM_ERROR_CHECK_UNCORRECTABLE=10",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_SRAM_ERROR_CHECK_CORRECTABLE=1000",
+	})
+
+	shape := limits.TestLimits["BM.GPU.H100.8"]
+	if shape["rx_discards_check"].Threshold != float64(50) {
+		t.Errorf("rx_discards_check threshold = %v, want 50", shape["rx_discards_check"].Threshold)
+	}
+	if shape["rx_discards_check"].TimeoutSeconds != 120 {
+		t.Errorf("rx_discards_check timeout = %d, want 120", shape["rx_discards_check"].TimeoutSeconds)
+	}
+	if shape["link_check"].Enabled {
+		t.Error("link_check enabled = true, want false")
+	}
+	if speed := shape["link_check"].Threshold.(map[string]interface{})["speed"]; speed != "200G" {
+		t.Errorf("link_check speed = %v, want 200G unchanged", speed)
+	}
+	if speed := shape["eth_link_check"].Threshold.(map[string]interface{})["speed"]; speed != "400G" {
+		t.Errorf("eth_link_check speed = %v, want 400G", speed)
+	}
+	expectedSRAM := map[string]interface{}{"uncorrectable": float64(10), "correctable": float64(1000)}
+	if !reflect.DeepEqual(shape["sram_error_check"].Threshold, expectedSRAM) {
+		t.Errorf("sram_error_check threshold = %v, want %v", shape["sram_error_check"].Threshold, expectedSRAM)
+	}
+}
+
+func TestApplyEnvOverridesIgnoresInvalid(t *testing.T) {
+	limits := newOverrideTestLimits()
+	applyEnvOverrides(limits, []string{
+		"OCI_HPC_THRESHOLD_BM_GPU_B200_8_RX_DISCARDS_CHECK_THRESHOLD=50",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_THRESHOLD=high",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_ENABLED=maybe",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_TIMEOUT_SECONDS=-1",
+		"OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_LIMIT=5",
+	})
+
+	if !reflect.DeepEqual(limits, newOverrideTestLimits()) {
+		t.Errorf("applyEnvOverrides() changed limits for invalid overrides: %+v", limits.TestLimits["BM.GPU.H100.8"]["rx_discards_check"])
+	}
+}
+
+func TestLoadTestLimitsEnvOverride(t *testing.T) {
+	t.Setenv("OCI_HPC_THRESHOLD_BM_GPU_H100_8_RX_DISCARDS_CHECK_THRESHOLD", "50")
+
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+	threshold, err := limits.GetThresholdForTest("BM.GPU.H100.8", "rx_discards_check")
+	if err != nil {
+		t.Fatalf("Failed to get threshold: %v", err)
+	}
+	if threshold != float64(50) {
+		t.Errorf("rx_discards_check threshold = %v, want 50", threshold)
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"sort"
+	"sync"
+	"time"
 )
 
 // SRAMThreshold represents the threshold configuration for SRAM checks
@@ -17,20 +21,32 @@ type SRAMThreshold struct {
 
 // TestConfig represents a generic test configuration that can be extended
 type TestConfig struct {
-	Enabled      bool        `json:"enabled"`
-	TestCategory string      `json:"test_category"`
-	Threshold    interface{} `json:"threshold,omitempty"`
+	Enabled         bool        `json:"enabled"`
+	TestCategory    string      `json:"test_category"`
+	TimeoutSeconds  int         `json:"timeout_seconds,omitempty"`
+	RetryCount      int         `json:"retry_count,omitempty"`
+	RetryDelayMs    int         `json:"retry_delay_ms,omitempty"`
+	CacheTTLSeconds int         `json:"cache_ttl_seconds,omitempty"`
+	Threshold       interface{} `json:"threshold,omitempty"`
 }
 
 // ShapeTestConfig represents the test configuration for a specific shape
 // Uses map to allow dynamic addition of new test types
 type ShapeTestConfig map[string]*TestConfig
 
-// TestLimits represents the complete test limits configuration
+// TestLimits represents the complete test limits configuration.
+// ShapeFamilies maps a configured shape to a regex matching shapes of the same family,
+// whose configuration is used for shapes not listed in TestLimits.
 type TestLimits struct {
-	TestLimits map[string]ShapeTestConfig `json:"test_limits"`
+	TestLimits       map[string]ShapeTestConfig `json:"test_limits"`
+	TestDependencies map[string][]string        `json:"test_dependencies,omitempty"`
+	ShapeFamilies    map[string]string          `json:"shape_families,omitempty"`
 }
 
+// loggedFallbacks records the shapes whose family fallback was already logged, since test
+// limits are loaded by every test
+var loggedFallbacks sync.Map
+
 // getPackageDir returns the directory where this package resides
 func getPackageDir() (string, error) {
 	_, filename, _, ok := runtime.Caller(0)
@@ -44,22 +60,22 @@ func getPackageDir() (string, error) {
 func getDefaultConfigPath() (string, error) {
 	// Check multiple locations in order of priority
 	paths := []string{
-		"./test_limits.json",                                  // Current directory override
-		"/etc/oci-dr-hpc-test-limits.json",                   // System installation path
-		func() string {                                        // User config directory
+		"./test_limits.json",               // Current directory override
+		"/etc/oci-dr-hpc-test-limits.json", // System installation path
+		func() string { // User config directory
 			if home := os.Getenv("HOME"); home != "" {
 				return filepath.Join(home, ".config", "oci-dr-hpc", "test_limits.json")
 			}
 			return ""
 		}(),
 	}
-	
+
 	// Add development path as fallback
 	packageDir, err := getPackageDir()
 	if err == nil {
 		paths = append(paths, filepath.Join(packageDir, "test_limits.json"))
 	}
-	
+
 	// Try each path in order
 	for _, path := range paths {
 		if path != "" {
@@ -69,7 +85,7 @@ func getDefaultConfigPath() (string, error) {
 			}
 		}
 	}
-	
+
 	// No config file found
 	return "", fmt.Errorf("test_limits.json not found in any of the expected locations: %v", paths)
 }
@@ -92,26 +108,93 @@ func LoadTestLimitsFromFile(filePath string) (*TestLimits, error) {
 		return nil, fmt.Errorf("failed to read test limits file %s: %w", filePath, err)
 	}
 
+	// Validate the structure so a malformed file fails here rather than during test execution
+	if err := ValidateTestLimitsJSON(data); err != nil {
+		logger.Errorf("Invalid test limits file %s: %v", filePath, err)
+		return nil, err
+	}
+
 	// Parse the JSON
 	var testLimits TestLimits
 	if err := json.Unmarshal(data, &testLimits); err != nil {
 		logger.Errorf("failed to parse test limits JSON: %s", filePath)
 		return nil, fmt.Errorf("failed to parse test limits JSON: %w", err)
 	}
+
+	// Environment variables override the file, e.g. for one-off checks in CI pipelines
+	ApplyEnvOverrides(&testLimits)
 	logger.Infof("Test configs: %+v", testLimits)
 
 	return &testLimits, nil
 }
 
-// GetTestConfig returns the configuration for a specific test type and shape
-func (tl *TestLimits) GetTestConfig(shapeName, testType string) (*TestConfig, error) {
-	if shapeConfig, exists := tl.TestLimits[shapeName]; exists {
-		if testConfig, exists := shapeConfig[testType]; exists {
-			return testConfig, nil
+// ResolveShape returns the configured shape whose limits apply to shapeName: the shape itself
+// when it is configured, otherwise the first configured shape, in sorted order, whose
+// shape_families pattern matches it
+func (tl *TestLimits) ResolveShape(shapeName string) (string, error) {
+	if _, exists := tl.TestLimits[shapeName]; exists {
+		return shapeName, nil
+	}
+
+	families := make([]string, 0, len(tl.ShapeFamilies))
+	for family := range tl.ShapeFamilies {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	for _, family := range families {
+		if _, exists := tl.TestLimits[family]; !exists {
+			continue
+		}
+		pattern, err := regexp.Compile(tl.ShapeFamilies[family])
+		if err != nil {
+			logger.Errorf("Invalid shape family pattern for %s: %v", family, err)
+			continue
+		}
+		if pattern.MatchString(shapeName) {
+			if _, logged := loggedFallbacks.LoadOrStore(shapeName, true); !logged {
+				logger.Warnf("Shape %s not found in test limits, using %s configuration", shapeName, family)
+			}
+			return family, nil
 		}
-		return nil, fmt.Errorf("test type %s not found for shape %s", testType, shapeName)
 	}
-	return nil, fmt.Errorf("no test configuration found for shape: %s", shapeName)
+	return "", fmt.Errorf("no test configuration found for shape: %s", shapeName)
+}
+
+// testConfigNames maps test runner names to the names the tests are configured and reported
+// under, when they differ
+var testConfigNames = map[string]string{
+	"rdma_nics_count": "rdma_nic_count",
+}
+
+// ConfigName returns the name a test is configured under in test_limits.json and reported under.
+// It is the test runner name except for rdma_nics_count, which is configured as rdma_nic_count.
+func ConfigName(testName string) string {
+	if configName, exists := testConfigNames[testName]; exists {
+		return configName
+	}
+	return testName
+}
+
+// GetTestConfigWithFallback returns the configuration for a specific test type and shape, using
+// the configuration of the shape's family when the shape is not configured. It also returns the
+// configured shape that was used. Test runner names are accepted for tests configured under another name.
+func (tl *TestLimits) GetTestConfigWithFallback(shapeName, testType string) (*TestConfig, string, error) {
+	foundShape, err := tl.ResolveShape(shapeName)
+	if err != nil {
+		return nil, "", err
+	}
+	testType = ConfigName(testType)
+	if testConfig, exists := tl.TestLimits[foundShape][testType]; exists {
+		return testConfig, foundShape, nil
+	}
+	return nil, foundShape, fmt.Errorf("test type %s not found for shape %s", testType, foundShape)
+}
+
+// GetTestConfig returns the configuration for a specific test type and shape
+func (tl *TestLimits) GetTestConfig(shapeName, testType string) (*TestConfig, error) {
+	testConfig, _, err := tl.GetTestConfigWithFallback(shapeName, testType)
+	return testConfig, err
 }
 
 // IsTestEnabled returns whether a specific test is enabled for a shape
@@ -135,6 +218,35 @@ func (tl *TestLimits) GetThresholdForTest(shapeName, testType string) (interface
 	return testConfig.Threshold, nil
 }
 
+// GetTimeoutForTest returns the timeout for a test type, or zero if no timeout is configured
+func (tl *TestLimits) GetTimeoutForTest(shapeName, testType string) (time.Duration, error) {
+	testConfig, err := tl.GetTestConfig(shapeName, testType)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(testConfig.TimeoutSeconds) * time.Second, nil
+}
+
+// GetRetryPolicyForTest returns how many times a test failing with a transient error is retried
+// and the delay between attempts
+func (tl *TestLimits) GetRetryPolicyForTest(shapeName, testType string) (int, time.Duration, error) {
+	testConfig, err := tl.GetTestConfig(shapeName, testType)
+	if err != nil {
+		return 0, 0, err
+	}
+	return testConfig.RetryCount, time.Duration(testConfig.RetryDelayMs) * time.Millisecond, nil
+}
+
+// GetCacheTTLForTest returns how long a passing result of a test may be reused with --use-cache.
+// A TTL of 0 means the test is always run.
+func (tl *TestLimits) GetCacheTTLForTest(shapeName, testType string) (time.Duration, error) {
+	testConfig, err := tl.GetTestConfig(shapeName, testType)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(testConfig.CacheTTLSeconds) * time.Second, nil
+}
+
 // GetAvailableShapes returns a list of all available shape names
 func (tl *TestLimits) GetAvailableShapes() []string {
 	shapes := make([]string, 0, len(tl.TestLimits))
@@ -146,14 +258,20 @@ func (tl *TestLimits) GetAvailableShapes() []string {
 
 // GetEnabledTests returns a list of enabled test types for a specific shape
 func (tl *TestLimits) GetEnabledTests(shapeName string) ([]string, error) {
-	if shapeConfig, exists := tl.TestLimits[shapeName]; exists {
-		var enabledTests []string
-		for testType, testConfig := range shapeConfig {
-			if testConfig.Enabled {
-				enabledTests = append(enabledTests, testType)
-			}
+	foundShape, err := tl.ResolveShape(shapeName)
+	if err != nil {
+		return nil, err
+	}
+	var enabledTests []string
+	for testType, testConfig := range tl.TestLimits[foundShape] {
+		if testConfig.Enabled {
+			enabledTests = append(enabledTests, testType)
 		}
-		return enabledTests, nil
 	}
-	return nil, fmt.Errorf("no test configuration found for shape: %s", shapeName)
+	return enabledTests, nil
+}
+
+// GetTestDependencies returns the tests that must complete before the given test type runs
+func (tl *TestLimits) GetTestDependencies(testType string) []string {
+	return tl.TestDependencies[testType]
 }
@@ -3,6 +3,7 @@ package test_limits
 import (
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestLoadTestLimits(t *testing.T) {
@@ -149,7 +150,7 @@ func TestGetThresholdForTest(t *testing.T) {
 		t.Error("Expected threshold to be an object")
 	}
 
-	// Test GID index threshold (array)
+	// Test GID index threshold (object with expected indexes and entry count)
 	threshold, err = limits.GetThresholdForTest("BM.GPU.H100.8", "gid_index_check")
 	if err != nil {
 		t.Errorf("Failed to get GID index threshold: %v", err)
@@ -284,8 +285,8 @@ func TestGetEnabledTests(t *testing.T) {
 	if err != nil {
 		t.Errorf("Failed to get enabled tests: %v", err)
 	}
-	if len(enabledTests) != 23 {
-		t.Errorf("Expected 23 enabled tests for H100, got %d", len(enabledTests))
+	if len(enabledTests) != 71 {
+		t.Errorf("Expected 71 enabled tests for H100, got %d", len(enabledTests))
 	}
 
 	expectedTests := map[string]bool{
@@ -299,11 +300,52 @@ func TestGetEnabledTests(t *testing.T) {
 		"pcie_width_missing_lanes_check":   false,
 		"link_check":                       false,
 		"eth_link_check":                   false,
-		"auth_check":                       false,
 		"gpu_driver_check":                 false,
 		"gpu_clk_check":                    false,
 		"peermem_module_check":             false,
 		"nvlink_speed_check":               false,
+		"nvlink_topology_check":            false,
+		"pcie_replay_check":                false,
+		"rdma_credit_check":                false,
+		"rdma_mtu_check":                   false,
+		"rdma_topology_check":              false,
+		"bios_settings_check":              false,
+		"gpu_inforom_check":                false,
+		"gpu_row_remap_check":              false,
+		"cpu_governor_check":               false,
+		"gpu_firmware_check":               false,
+		"gpu_p2p_bw_check":                 false,
+		"cpu_isolation_check":              false,
+		"pcie_gen_check":                   false,
+		"gpu_cstate_check":                 false,
+		"nfs_mount_check":                  false,
+		"ber_trend_check":                  false,
+		"ib_cable_check":                   false,
+		"pcie_rebar_check":                 false,
+		"gpu_idle_check":                   false,
+		"pcie_device_count_check":          false,
+		"gpu_firmware_update_check":        false,
+		"gpu_reset_check":                  false,
+		"rdma_interface_speed_check":       false,
+		"ib_port_state_check":              false,
+		"nic_firmware_check":               false,
+		"rdma_pci_mapping_check":           false,
+		"gpu_pcie_topo_check":              false,
+		"gpu_power_rail_check":             false,
+		"gpu_temperature_check":            false,
+		"gpu_cpu_bw_check":                 false,
+		"fabricmanager_log_check":          false,
+		"gpu_mem_temperature_check":        false,
+		"pcie_vendor_check":                false,
+		"rdma_retry_counter_check":         false,
+		"nvlink_bw_check":                  false,
+		"gpu_mig_profile_check":            false,
+		"network_auth_check":               false,
+		"dcgm_field_check":                 false,
+		"numa_distance_check":              false,
+		"cpu_topology_check":               false,
+		"memory_check":                     false,
+		"gpu_firmware_consistency_check":   false,
 		"eth0_presence_check":              false,
 		"cdfp_cable_check":                 false,
 		"fabricmanager_check":              false,
@@ -312,6 +354,13 @@ func TestGetEnabledTests(t *testing.T) {
 		"gpu_xid_check":                    false,
 		"max_acc_check":                    false,
 		"row_remap_error_check":            false,
+		"gpu_vbios_check":                  false,
+		"hugepages_check":                  false,
+		"numa_affinity_check":              false,
+		"time_sync_check":                  false,
+		"kernel_module_check":              false,
+		"systemd_service_check":            false,
+		"iommu_check":                      false,
 	}
 
 	for _, test := range enabledTests {
@@ -338,6 +387,176 @@ func TestGetEnabledTests(t *testing.T) {
 	}
 }
 
+func TestGetTimeoutForTest(t *testing.T) {
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		shape       string
+		testType    string
+		expected    time.Duration
+		expectError bool
+	}{
+		{"H100 GPU count check", "BM.GPU.H100.8", "gpu_count_check", 60 * time.Second, false},
+		{"H100 link check", "BM.GPU.H100.8", "link_check", 120 * time.Second, false},
+		{"H100 RDMA NIC count by runner name", "BM.GPU.H100.8", "rdma_nics_count", 60 * time.Second, false},
+		{"Disabled test still has timeout", "BM.GPU.B200.8", "gpu_count_check", 60 * time.Second, false},
+		{"Unknown test", "BM.GPU.H100.8", "unknown_test", 0, true},
+		{"Unknown shape", "BM.GPU.UNKNOWN", "gpu_count_check", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout, err := limits.GetTimeoutForTest(tt.shape, tt.testType)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("GetTimeoutForTest() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if timeout != tt.expected {
+				t.Errorf("GetTimeoutForTest() = %v, want %v", timeout, tt.expected)
+			}
+		})
+	}
+
+	// Every configured test declares a timeout
+	for shape, shapeConfig := range limits.TestLimits {
+		for testType, testConfig := range shapeConfig {
+			if testConfig.TimeoutSeconds <= 0 {
+				t.Errorf("Expected timeout_seconds for %s on %s", testType, shape)
+			}
+		}
+	}
+}
+
+func TestGetRetryPolicyForTest(t *testing.T) {
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+
+	retries, delay, err := limits.GetRetryPolicyForTest("BM.GPU.H100.8", "link_check")
+	if err != nil || retries != 1 || delay != 2*time.Second {
+		t.Errorf("Expected 1 retry after 2s for link_check, got %d after %v (error %v)", retries, delay, err)
+	}
+
+	// Retries are disabled by default
+	retries, delay, err = limits.GetRetryPolicyForTest("BM.GPU.H100.8", "gpu_count_check")
+	if err != nil || retries != 0 || delay != 0 {
+		t.Errorf("Expected no retries for gpu_count_check, got %d after %v (error %v)", retries, delay, err)
+	}
+
+	if _, _, err := limits.GetRetryPolicyForTest("BM.GPU.H100.8", "unknown_check"); err == nil {
+		t.Error("Expected error for unknown test")
+	}
+}
+
+func TestGetCacheTTLForTest(t *testing.T) {
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+
+	ttl, err := limits.GetCacheTTLForTest("BM.GPU.H100.8", "gpu_vbios_check")
+	if err != nil || ttl != 24*time.Hour {
+		t.Errorf("Expected a 24h cache TTL for gpu_vbios_check, got %v (error %v)", ttl, err)
+	}
+
+	// Tests are not cached by default
+	ttl, err = limits.GetCacheTTLForTest("BM.GPU.H100.8", "link_check")
+	if err != nil || ttl != 0 {
+		t.Errorf("Expected no cache TTL for link_check, got %v (error %v)", ttl, err)
+	}
+
+	if _, err := limits.GetCacheTTLForTest("BM.GPU.H100.8", "unknown_check"); err == nil {
+		t.Error("Expected error for unknown test")
+	}
+}
+
+func TestGetTestConfigWithFallback(t *testing.T) {
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+
+	config, foundShape, err := limits.GetTestConfigWithFallback("BM.GPU.H100.8", "gpu_count_check")
+	if err != nil || config == nil || foundShape != "BM.GPU.H100.8" {
+		t.Errorf("Expected BM.GPU.H100.8 config for its own shape, got %q (error %v)", foundShape, err)
+	}
+
+	// A new shape of a configured family uses the family's configuration
+	config, foundShape, err = limits.GetTestConfigWithFallback("BM.GPU.H100.8.test", "gpu_count_check")
+	if err != nil || foundShape != "BM.GPU.H100.8" || config != limits.TestLimits["BM.GPU.H100.8"]["gpu_count_check"] {
+		t.Errorf("Expected BM.GPU.H100.8 config for BM.GPU.H100.8.test, got %q (error %v)", foundShape, err)
+	}
+	if enabled, err := limits.IsTestEnabled("BM.GPU.H100.8.test", "gpu_count_check"); err != nil || !enabled {
+		t.Errorf("Expected gpu_count_check enabled for BM.GPU.H100.8.test, got %v (error %v)", enabled, err)
+	}
+
+	// Family patterns must not match a different shape sharing a prefix
+	if _, _, err := limits.GetTestConfigWithFallback("BM.GPU.H100.80", "gpu_count_check"); err == nil {
+		t.Error("Expected error for BM.GPU.H100.80")
+	}
+	if _, _, err := limits.GetTestConfigWithFallback("BM.GPU.A100-v2.8", "gpu_count_check"); err == nil {
+		t.Error("Expected error for a shape without a family")
+	}
+	if _, foundShape, err := limits.GetTestConfigWithFallback("BM.GPU.H100.8.test", "unknown_check"); err == nil || foundShape != "BM.GPU.H100.8" {
+		t.Errorf("Expected error for unknown test using BM.GPU.H100.8, got %q (error %v)", foundShape, err)
+	}
+}
+
+func TestGetTestDependencies(t *testing.T) {
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+
+	for _, testType := range []string{"link_check", "eth_link_check", "network_auth_check", "gid_index_check", "ib_cable_check"} {
+		deps := limits.GetTestDependencies(testType)
+		if len(deps) != 1 || deps[0] != "rdma_nics_count" {
+			t.Errorf("Expected %s to depend on [rdma_nics_count], got %v", testType, deps)
+		}
+	}
+
+	// Tests without declared dependencies
+	if deps := limits.GetTestDependencies("gpu_count_check"); len(deps) != 0 {
+		t.Errorf("Expected no dependencies for gpu_count_check, got %v", deps)
+	}
+
+	// Dependencies are optional in the configuration
+	empty := &TestLimits{}
+	if deps := empty.GetTestDependencies("link_check"); len(deps) != 0 {
+		t.Errorf("Expected no dependencies without test_dependencies, got %v", deps)
+	}
+}
+
+// Test the hyperthreading state expected by bios_settings_check matches the CPU topology of cpu_topology_check
+func TestHyperthreadingExpectationsAgree(t *testing.T) {
+	limits, err := LoadTestLimits()
+	if err != nil {
+		t.Fatalf("Failed to load test limits: %v", err)
+	}
+
+	bios, err := limits.GetThresholdForTest("BM.GPU.H100.8", "bios_settings_check")
+	if err != nil {
+		t.Fatalf("Failed to get bios_settings_check threshold: %v", err)
+	}
+	topology, err := limits.GetThresholdForTest("BM.GPU.H100.8", "cpu_topology_check")
+	if err != nil {
+		t.Fatalf("Failed to get cpu_topology_check threshold: %v", err)
+	}
+
+	biosMap := bios.(map[string]interface{})
+	topologyMap := topology.(map[string]interface{})
+	cores := topologyMap["sockets"].(float64) * topologyMap["cores_per_socket"].(float64)
+	hyperthreading := topologyMap["logical_cpus"].(float64) > cores
+	if biosMap["hyperthreading_enabled"] != hyperthreading {
+		t.Errorf("bios_settings_check expects hyperthreading %v, cpu_topology_check expects %v logical CPUs on %v cores",
+			biosMap["hyperthreading_enabled"], topologyMap["logical_cpus"], cores)
+	}
+}
+
 func TestPackageHelperFunctions(t *testing.T) {
 	// Test getPackageDir
 	dir, err := getPackageDir()
@@ -0,0 +1,294 @@
+package test_limits
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// validTestCategories are the allowed test_category values
+var validTestCategories = []string{"LEVEL_1"}
+
+// thresholdTypes are the JSON types each test accepts for its threshold, matching the
+// definitions of configs/test_limits_schema.json. Thresholds of other tests are not checked.
+var thresholdTypes = map[string][]string{
+	"ber_trend_check":                {"object"},
+	"bios_settings_check":            {"object"},
+	"cpu_governor_check":             {"object"},
+	"cpu_isolation_check":            {"object"},
+	"cpu_topology_check":             {"object"},
+	"dcgm_field_check":               {"object"},
+	"eth_link_check":                 {"object"},
+	"fabricmanager_log_check":        {"object"},
+	"gid_index_check":                {"object", "array"},
+	"gpu_clk_check":                  {"object"},
+	"gpu_count_check":                {"number"},
+	"gpu_cpu_bw_check":               {"object"},
+	"gpu_driver_check":               {"object"},
+	"gpu_firmware_check":             {"object"},
+	"gpu_idle_check":                 {"object"},
+	"gpu_mem_temperature_check":      {"object"},
+	"gpu_mig_profile_check":          {"object"},
+	"gpu_mode_check":                 {"object"},
+	"gpu_p2p_bw_check":               {"object"},
+	"gpu_pcie_topo_check":            {"object"},
+	"gpu_power_rail_check":           {"object"},
+	"gpu_reset_check":                {"object"},
+	"gpu_row_remap_check":            {"object"},
+	"gpu_temperature_check":          {"object"},
+	"gpu_vbios_check":                {"object"},
+	"gpu_xid_check":                  {"object"},
+	"hugepages_check":                {"object"},
+	"ib_cable_check":                 {"object"},
+	"ib_port_state_check":            {"object"},
+	"ib_sm_check":                    {"object"},
+	"ib_switch_port_check":           {"object"},
+	"iommu_check":                    {"object"},
+	"link_check":                     {"object"},
+	"max_acc_check":                  {"object"},
+	"memory_check":                   {"object"},
+	"missing_interface_check":        {"number"},
+	"mlxconfig_check":                {"object"},
+	"network_auth_check":             {"object"},
+	"nfs_mount_check":                {"object"},
+	"nic_firmware_check":             {"object"},
+	"numa_affinity_check":            {"object"},
+	"numa_distance_check":            {"object"},
+	"nvlink_bw_check":                {"object"},
+	"nvlink_speed_check":             {"object"},
+	"nvlink_topology_check":          {"object"},
+	"pcie_device_count_check":        {"object"},
+	"pcie_gen_check":                 {"object"},
+	"pcie_rebar_check":               {"object"},
+	"pcie_replay_check":              {"object"},
+	"pcie_vendor_check":              {"object"},
+	"pcie_width_missing_lanes_check": {"object"},
+	"rdma_interface_speed_check":     {"object"},
+	"rdma_mtu_check":                 {"object"},
+	"rdma_nic_count":                 {"object"},
+	"rdma_pci_mapping_check":         {"object"},
+	"rdma_retry_counter_check":       {"object"},
+	"roce_vlan_check":                {"object"},
+	"row_remap_error_check":          {"object"},
+	"rx_discards_check":              {"number"},
+	"sram_error_check":               {"object"},
+	"systemd_service_check":          {"object"},
+	"time_sync_check":                {"object"},
+}
+
+// FieldError describes a test_limits.json field that failed validation
+type FieldError struct {
+	Path    string
+	Message string
+}
+
+// ValidationError lists every field of test_limits.json that failed validation
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fmt.Sprintf("%s: %s", fieldErr.Path, fieldErr.Message)
+	}
+	return "invalid test limits: " + strings.Join(messages, "; ")
+}
+
+// validator collects field errors while walking test_limits.json
+type validator struct {
+	errors []FieldError
+}
+
+func (v *validator) addError(path, format string, args ...interface{}) {
+	v.errors = append(v.errors, FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// expectType records an error and returns false when value is not of the wanted JSON type
+func (v *validator) expectType(path string, value interface{}, wanted string) bool {
+	if got := jsonType(value); got != wanted {
+		v.addError(path, "expected %s, got %s", wanted, got)
+		return false
+	}
+	return true
+}
+
+// ValidateTestLimitsJSON validates the structure of test_limits.json: every test config needs
+// enabled and test_category, test_category must be a known category, and thresholds must have the
+// type their test expects. It returns a *ValidationError listing every invalid field.
+func ValidateTestLimitsJSON(data []byte) error {
+	var root interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("failed to parse test limits JSON: %w", err)
+	}
+
+	v := &validator{}
+	v.validateRoot(root)
+	if len(v.errors) > 0 {
+		return &ValidationError{Errors: v.errors}
+	}
+	return nil
+}
+
+func (v *validator) validateRoot(root interface{}) {
+	if !v.expectType("(root)", root, "object") {
+		return
+	}
+	fields := root.(map[string]interface{})
+
+	testLimits, ok := fields["test_limits"]
+	if !ok {
+		v.addError("test_limits", "required field missing")
+	} else if v.expectType("test_limits", testLimits, "object") {
+		shapes := testLimits.(map[string]interface{})
+		for _, shape := range sortedKeys(shapes) {
+			v.validateShape("test_limits."+shape, shapes[shape])
+		}
+	}
+
+	if dependencies, ok := fields["test_dependencies"]; ok {
+		v.validateDependencies("test_dependencies", dependencies)
+	}
+
+	if families, ok := fields["shape_families"]; ok {
+		v.validateShapeFamilies("shape_families", families)
+	}
+
+	for _, key := range sortedKeys(fields) {
+		if key != "test_limits" && key != "test_dependencies" && key != "shape_families" {
+			v.addError(key, "unknown field")
+		}
+	}
+}
+
+func (v *validator) validateShape(path string, shape interface{}) {
+	if !v.expectType(path, shape, "object") {
+		return
+	}
+	tests := shape.(map[string]interface{})
+	for _, testName := range sortedKeys(tests) {
+		v.validateTestConfig(path+"."+testName, testName, tests[testName])
+	}
+}
+
+func (v *validator) validateTestConfig(path, testName string, config interface{}) {
+	if !v.expectType(path, config, "object") {
+		return
+	}
+	fields := config.(map[string]interface{})
+
+	if enabled, ok := fields["enabled"]; !ok {
+		v.addError(path+".enabled", "required field missing")
+	} else {
+		v.expectType(path+".enabled", enabled, "boolean")
+	}
+
+	if category, ok := fields["test_category"]; !ok {
+		v.addError(path+".test_category", "required field missing")
+	} else if v.expectType(path+".test_category", category, "string") && !containsString(validTestCategories, category.(string)) {
+		v.addError(path+".test_category", "invalid value %q, expected one of %v", category, validTestCategories)
+	}
+
+	if timeout, ok := fields["timeout_seconds"]; ok && v.expectType(path+".timeout_seconds", timeout, "number") {
+		if seconds := timeout.(float64); seconds <= 0 || seconds != float64(int(seconds)) {
+			v.addError(path+".timeout_seconds", "expected a positive integer, got %v", seconds)
+		}
+	}
+
+	for _, key := range []string{"retry_count", "retry_delay_ms", "cache_ttl_seconds"} {
+		if value, ok := fields[key]; ok && v.expectType(path+"."+key, value, "number") {
+			if number := value.(float64); number < 0 || number != float64(int(number)) {
+				v.addError(path+"."+key, "expected a non-negative integer, got %v", number)
+			}
+		}
+	}
+
+	if threshold, ok := fields["threshold"]; ok {
+		if wanted, known := thresholdTypes[testName]; known {
+			if got := jsonType(threshold); !containsString(wanted, got) {
+				v.addError(path+".threshold", "expected %s, got %s", strings.Join(wanted, " or "), got)
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(fields) {
+		switch key {
+		case "enabled", "test_category", "timeout_seconds", "retry_count", "retry_delay_ms", "cache_ttl_seconds", "threshold":
+		default:
+			v.addError(path+"."+key, "unknown field")
+		}
+	}
+}
+
+func (v *validator) validateDependencies(path string, dependencies interface{}) {
+	if !v.expectType(path, dependencies, "object") {
+		return
+	}
+	tests := dependencies.(map[string]interface{})
+	for _, testName := range sortedKeys(tests) {
+		testPath := path + "." + testName
+		if !v.expectType(testPath, tests[testName], "array") {
+			continue
+		}
+		for i, dep := range tests[testName].([]interface{}) {
+			v.expectType(fmt.Sprintf("%s[%d]", testPath, i), dep, "string")
+		}
+	}
+}
+
+func (v *validator) validateShapeFamilies(path string, families interface{}) {
+	if !v.expectType(path, families, "object") {
+		return
+	}
+	patterns := families.(map[string]interface{})
+	for _, shape := range sortedKeys(patterns) {
+		shapePath := path + "." + shape
+		if !v.expectType(shapePath, patterns[shape], "string") {
+			continue
+		}
+		if _, err := regexp.Compile(patterns[shape].(string)); err != nil {
+			v.addError(shapePath, fmt.Sprintf("invalid regex: %v", err))
+		}
+	}
+}
+
+// jsonType returns the JSON type name of a value decoded by encoding/json
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// sortedKeys returns the keys of m in sorted order so errors are reported deterministically
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
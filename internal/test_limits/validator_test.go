@@ -0,0 +1,173 @@
+package test_limits
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidateTestLimitsJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantErrors []FieldError
+	}{
+		{
+			name: "valid config",
+			data: `{"test_limits": {"BM.GPU.H100.8": {
+				"rx_discards_check": {"enabled": true, "test_category": "LEVEL_1", "timeout_seconds": 60, "threshold": 100},
+				"gid_index_check": {"enabled": true, "test_category": "LEVEL_1", "threshold": [0, 1, 2, 3]}}},
+				"test_dependencies": {"link_check": ["rdma_nics_count"]},
+				"shape_families": {"BM.GPU.H100.8": "^BM\\.GPU\\.H100\\.8\\."}}`,
+		},
+		{
+			name: "threshold of wrong type",
+			data: `{"test_limits": {"BM.GPU.H100.8": {"rx_discards_check": {"enabled": true, "test_category": "LEVEL_1", "threshold": "100"}}}}`,
+			wantErrors: []FieldError{
+				{Path: "test_limits.BM.GPU.H100.8.rx_discards_check.threshold", Message: "expected number, got string"},
+			},
+		},
+		{
+			name: "missing required fields",
+			data: `{"test_limits": {"BM.GPU.H100.8": {"gpu_count_check": {"threshold": 8}}}}`,
+			wantErrors: []FieldError{
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.enabled", Message: "required field missing"},
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.test_category", Message: "required field missing"},
+			},
+		},
+		{
+			name: "invalid field values",
+			data: `{"test_limits": {"BM.GPU.H100.8": {"gpu_count_check": {"enabled": "yes", "test_category": "LEVEL_9", "timeout_seconds": 0, "retry_count": -1, "treshold": 8}}}}`,
+			wantErrors: []FieldError{
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.enabled", Message: "expected boolean, got string"},
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.test_category", Message: `invalid value "LEVEL_9", expected one of [LEVEL_1]`},
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.timeout_seconds", Message: "expected a positive integer, got 0"},
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.retry_count", Message: "expected a non-negative integer, got -1"},
+				{Path: "test_limits.BM.GPU.H100.8.gpu_count_check.treshold", Message: "unknown field"},
+			},
+		},
+		{
+			name: "invalid structure",
+			data: `{"test_limits": {"BM.GPU.H100.8": []}, "test_dependencies": {"link_check": "rdma_nics_count"}, "shapes": {}}`,
+			wantErrors: []FieldError{
+				{Path: "test_limits.BM.GPU.H100.8", Message: "expected object, got array"},
+				{Path: "test_dependencies.link_check", Message: "expected array, got string"},
+				{Path: "shapes", Message: "unknown field"},
+			},
+		},
+		{
+			name: "invalid shape families",
+			data: `{"test_limits": {}, "shape_families": {"BM.GPU.H100.8": "(", "BM.GPU.B200.8": 8}}`,
+			wantErrors: []FieldError{
+				{Path: "shape_families.BM.GPU.B200.8", Message: "expected string, got number"},
+				{Path: "shape_families.BM.GPU.H100.8", Message: "invalid regex: error parsing regexp: missing closing ): `(`"},
+			},
+		},
+		{
+			name:       "missing test_limits",
+			data:       `{}`,
+			wantErrors: []FieldError{{Path: "test_limits", Message: "required field missing"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTestLimitsJSON([]byte(tt.data))
+			if tt.wantErrors == nil {
+				if err != nil {
+					t.Errorf("ValidateTestLimitsJSON() error = %v", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ValidateTestLimitsJSON() error = %v, want *ValidationError", err)
+			}
+			if !reflect.DeepEqual(validationErr.Errors, tt.wantErrors) {
+				t.Errorf("ValidateTestLimitsJSON() errors = %+v, want %+v", validationErr.Errors, tt.wantErrors)
+			}
+		})
+	}
+
+	if err := ValidateTestLimitsJSON([]byte(`{"test_limits": `)); err == nil {
+		t.Error("ValidateTestLimitsJSON() expected error for malformed JSON")
+	}
+}
+
+func TestLoadTestLimitsFromFileInvalid(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "test_limits.json")
+	data := `{"test_limits": {"BM.GPU.H100.8": {"rx_discards_check": {"enabled": true, "test_category": "LEVEL_1", "threshold": "100"}}}}`
+	if err := os.WriteFile(filePath, []byte(data), 0644); err != nil {
+		t.Fatalf("Failed to write test limits: %v", err)
+	}
+
+	_, err := LoadTestLimitsFromFile(filePath)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("LoadTestLimitsFromFile() error = %v, want *ValidationError", err)
+	}
+}
+
+// The bundled schema and the validator must accept the same threshold types
+func TestThresholdTypesMatchSchema(t *testing.T) {
+	packageDir, err := getPackageDir()
+	if err != nil {
+		t.Fatalf("Failed to get package directory: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(packageDir, "..", "..", "configs", "test_limits_schema.json"))
+	if err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+
+	var schema struct {
+		Definitions struct {
+			Shape struct {
+				Properties map[string]struct {
+					AllOf []struct {
+						Properties struct {
+							Threshold struct {
+								Type interface{} `json:"type"`
+							} `json:"threshold"`
+						} `json:"properties"`
+					} `json:"allOf"`
+				} `json:"properties"`
+			} `json:"shape"`
+		} `json:"definitions"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	schemaTypes := make(map[string][]string)
+	for testName, property := range schema.Definitions.Shape.Properties {
+		for _, part := range property.AllOf {
+			switch types := part.Properties.Threshold.Type.(type) {
+			case string:
+				schemaTypes[testName] = []string{types}
+			case []interface{}:
+				for _, thresholdType := range types {
+					schemaTypes[testName] = append(schemaTypes[testName], thresholdType.(string))
+				}
+			}
+		}
+	}
+
+	for _, types := range schemaTypes {
+		sort.Strings(types)
+	}
+	for testName, types := range thresholdTypes {
+		sorted := append([]string(nil), types...)
+		sort.Strings(sorted)
+		if !reflect.DeepEqual(schemaTypes[testName], sorted) {
+			t.Errorf("schema threshold types of %s = %v, want %v", testName, schemaTypes[testName], sorted)
+		}
+	}
+	if len(schemaTypes) != len(thresholdTypes) {
+		t.Errorf("schema defines thresholds for %d tests, validator for %d", len(schemaTypes), len(thresholdTypes))
+	}
+}
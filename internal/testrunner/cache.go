@@ -0,0 +1,93 @@
+package testrunner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// DefaultCachePath is where passing test results are cached with --use-cache
+const DefaultCachePath = "/var/cache/oci-dr-hpc/results_cache.json"
+
+// CachedResult is the report entry of a passing test and when it was recorded
+type CachedResult struct {
+	Result   json.RawMessage `json:"result"`
+	CachedAt time.Time       `json:"cached_at"`
+}
+
+// ResultCache stores passing test results between runs, so tests of hardware state that
+// rarely changes are not run on every monitoring cycle. Results are only valid for the
+// GPU driver version they were recorded with.
+type ResultCache struct {
+	DriverVersion string                  `json:"driver_version"`
+	Results       map[string]CachedResult `json:"results"`
+
+	path string
+}
+
+// LoadResultCache loads the cache at path. A missing or unreadable cache, or one recorded
+// with a different driver version, is replaced by an empty cache.
+func LoadResultCache(path, driverVersion string) *ResultCache {
+	cache := &ResultCache{DriverVersion: driverVersion, Results: make(map[string]CachedResult), path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Errorf("Failed to read result cache %s, starting with an empty cache: %v", path, err)
+		}
+		return cache
+	}
+
+	var stored ResultCache
+	if err := json.Unmarshal(data, &stored); err != nil {
+		logger.Errorf("Failed to parse result cache %s, starting with an empty cache: %v", path, err)
+		return cache
+	}
+	if stored.DriverVersion != driverVersion {
+		logger.Infof("Driver version changed from %q to %q, invalidating result cache", stored.DriverVersion, driverVersion)
+		return cache
+	}
+	if stored.Results != nil {
+		cache.Results = stored.Results
+	}
+	return cache
+}
+
+// Get returns the cached result of testName if it was recorded less than ttl ago
+func (c *ResultCache) Get(testName string, ttl time.Duration) (json.RawMessage, bool) {
+	cached, exists := c.Results[testName]
+	if !exists || ttl <= 0 || time.Since(cached.CachedAt) >= ttl {
+		return nil, false
+	}
+	return cached.Result, true
+}
+
+// Put caches the result of a passing test
+func (c *ResultCache) Put(testName string, result json.RawMessage) {
+	c.Results[testName] = CachedResult{Result: result, CachedAt: time.Now()}
+}
+
+// Invalidate removes all cached results
+func (c *ResultCache) Invalidate() {
+	c.Results = make(map[string]CachedResult)
+}
+
+// Save writes the cache to its path, creating the cache directory if needed
+func (c *ResultCache) Save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal result cache: %w", err)
+	}
+	if err := os.WriteFile(c.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write result cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package testrunner
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestResultCacheGet(t *testing.T) {
+	cache := LoadResultCache(filepath.Join(t.TempDir(), "results_cache.json"), "550.54.15")
+	cache.Put("gpu_count_check", json.RawMessage(`[{"status":"PASS"}]`))
+	cache.Results["gpu_vbios_check"] = CachedResult{Result: json.RawMessage(`[{"status":"PASS"}]`), CachedAt: time.Now().Add(-2 * time.Hour)}
+
+	if result, ok := cache.Get("gpu_count_check", time.Hour); !ok || string(result) != `[{"status":"PASS"}]` {
+		t.Errorf("Get(gpu_count_check) = %s, %v, want cached result", result, ok)
+	}
+	if _, ok := cache.Get("gpu_vbios_check", time.Hour); ok {
+		t.Error("Get(gpu_vbios_check) returned a result older than its TTL")
+	}
+	if _, ok := cache.Get("gpu_count_check", 0); ok {
+		t.Error("Get() returned a result for a test without a cache TTL")
+	}
+	if _, ok := cache.Get("link_check", time.Hour); ok {
+		t.Error("Get(link_check) returned a result that was never cached")
+	}
+
+	cache.Invalidate()
+	if _, ok := cache.Get("gpu_count_check", time.Hour); ok {
+		t.Error("Get() returned a result after Invalidate()")
+	}
+}
+
+func TestResultCacheSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "results_cache.json")
+
+	cache := LoadResultCache(path, "550.54.15")
+	cache.Put("gpu_count_check", json.RawMessage(`[{"status":"PASS","gpu_count":8}]`))
+	if err := cache.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded := LoadResultCache(path, "550.54.15")
+	if _, ok := loaded.Get("gpu_count_check", time.Hour); !ok {
+		t.Error("Expected the saved result to be loaded")
+	}
+
+	// A driver update may change the tested hardware state
+	loaded = LoadResultCache(path, "560.35.03")
+	if _, ok := loaded.Get("gpu_count_check", time.Hour); ok {
+		t.Error("Expected the cache to be invalidated after a driver version change")
+	}
+	if loaded.DriverVersion != "560.35.03" {
+		t.Errorf("DriverVersion = %q, want 560.35.03", loaded.DriverVersion)
+	}
+}
+
+func TestLoadResultCacheCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results_cache.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0644); err != nil {
+		t.Fatalf("Failed to write cache: %v", err)
+	}
+
+	cache := LoadResultCache(path, "550.54.15")
+	if len(cache.Results) != 0 {
+		t.Errorf("Expected an empty cache, got %v", cache.Results)
+	}
+	cache.Put("gpu_count_check", json.RawMessage(`[{"status":"PASS"}]`))
+	if err := cache.Save(); err != nil {
+		t.Errorf("Save() error = %v", err)
+	}
+}
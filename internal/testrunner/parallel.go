@@ -0,0 +1,121 @@
+package testrunner
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// DefaultWorkers is the worker count used when --parallel is given without a value
+const DefaultWorkers = 4
+
+// Test represents a named test function and the tests that must finish before it starts.
+// A zero Timeout lets the test run until it returns. A test failing with a transient error
+// is run up to Retries more times, RetryDelay apart.
+type Test struct {
+	Name       string
+	Fn         func() error
+	DependsOn  []string
+	Timeout    time.Duration
+	Retries    int
+	RetryDelay time.Duration
+}
+
+// Result represents the outcome of a single test run and the number of retries it needed
+type Result struct {
+	Name    string
+	Err     error
+	Retries int
+}
+
+// BuildLevels orders tests into levels so that every test only depends on tests in
+// earlier levels. Dependencies on tests that are not part of the run are ignored.
+// Tests keep their original relative order within a level.
+func BuildLevels(tests []Test) ([][]Test, error) {
+	position := make(map[string]int, len(tests))
+	for i, test := range tests {
+		if _, exists := position[test.Name]; exists {
+			return nil, fmt.Errorf("duplicate test: %s", test.Name)
+		}
+		position[test.Name] = i
+	}
+
+	// Kahn's algorithm over the dependencies that are part of this run
+	inDegree := make(map[string]int, len(tests))
+	dependents := make(map[string][]string)
+	for _, test := range tests {
+		inDegree[test.Name] = 0
+	}
+	for _, test := range tests {
+		for _, dep := range test.DependsOn {
+			if _, exists := position[dep]; !exists {
+				continue
+			}
+			inDegree[test.Name]++
+			dependents[dep] = append(dependents[dep], test.Name)
+		}
+	}
+
+	var current []string
+	for _, test := range tests {
+		if inDegree[test.Name] == 0 {
+			current = append(current, test.Name)
+		}
+	}
+
+	var levels [][]Test
+	scheduled := 0
+	for len(current) > 0 {
+		sort.Slice(current, func(i, j int) bool { return position[current[i]] < position[current[j]] })
+
+		level := make([]Test, 0, len(current))
+		var next []string
+		for _, name := range current {
+			level = append(level, tests[position[name]])
+			for _, dependent := range dependents[name] {
+				inDegree[dependent]--
+				if inDegree[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		levels = append(levels, level)
+		scheduled += len(level)
+		current = next
+	}
+
+	if scheduled != len(tests) {
+		var cyclic []string
+		for _, test := range tests {
+			if inDegree[test.Name] > 0 {
+				cyclic = append(cyclic, test.Name)
+			}
+		}
+		return nil, fmt.Errorf("dependency cycle detected between tests: %v", cyclic)
+	}
+
+	return levels, nil
+}
+
+// RunParallel runs tests concurrently on a pool of workers in the order of their
+// dependencies, skipping the dependents of failed tests. Results are returned in the
+// original test order.
+func RunParallel(tests []Test, workers int) ([]Result, error) {
+	scheduler, err := NewScheduler(tests)
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.Run(workers), nil
+}
+
+// RunSequential runs tests one at a time in the given order
+func RunSequential(tests []Test) []Result {
+	results := make([]Result, 0, len(tests))
+	for _, test := range tests {
+		logger.Info(fmt.Sprintf("Running test: %s", test.Name))
+		results = append(results, runTestWithRetries(test))
+	}
+	return results
+}
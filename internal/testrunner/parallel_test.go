@@ -0,0 +1,207 @@
+package testrunner
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func noop() error { return nil }
+
+func levelNames(levels [][]Test) [][]string {
+	var names [][]string
+	for _, level := range levels {
+		var levelNames []string
+		for _, test := range level {
+			levelNames = append(levelNames, test.Name)
+		}
+		names = append(names, levelNames)
+	}
+	return names
+}
+
+func TestBuildLevels(t *testing.T) {
+	tests := []struct {
+		name        string
+		tests       []Test
+		expected    [][]string
+		expectError bool
+	}{
+		{
+			name: "Independent tests",
+			tests: []Test{
+				{Name: "gpu_count_check", Fn: noop},
+				{Name: "pcie_error_check", Fn: noop},
+			},
+			expected: [][]string{{"gpu_count_check", "pcie_error_check"}},
+		},
+		{
+			name: "Dependency runs first",
+			tests: []Test{
+				{Name: "link_check", Fn: noop, DependsOn: []string{"rdma_nics_count"}},
+				{Name: "gpu_count_check", Fn: noop},
+				{Name: "rdma_nics_count", Fn: noop},
+			},
+			expected: [][]string{{"gpu_count_check", "rdma_nics_count"}, {"link_check"}},
+		},
+		{
+			name: "Chained dependencies",
+			tests: []Test{
+				{Name: "a", Fn: noop},
+				{Name: "b", Fn: noop, DependsOn: []string{"a"}},
+				{Name: "c", Fn: noop, DependsOn: []string{"b", "a"}},
+			},
+			expected: [][]string{{"a"}, {"b"}, {"c"}},
+		},
+		{
+			name: "Dependency not in run is ignored",
+			tests: []Test{
+				{Name: "link_check", Fn: noop, DependsOn: []string{"rdma_nics_count"}},
+			},
+			expected: [][]string{{"link_check"}},
+		},
+		{
+			name: "Cycle",
+			tests: []Test{
+				{Name: "a", Fn: noop, DependsOn: []string{"b"}},
+				{Name: "b", Fn: noop, DependsOn: []string{"a"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "Duplicate test",
+			tests: []Test{
+				{Name: "a", Fn: noop},
+				{Name: "a", Fn: noop},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels, err := BuildLevels(tt.tests)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("BuildLevels() error = %v, wantErr %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			got := levelNames(levels)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("BuildLevels() = %v, want %v", got, tt.expected)
+			}
+			for i := range got {
+				if len(got[i]) != len(tt.expected[i]) {
+					t.Fatalf("BuildLevels() = %v, want %v", got, tt.expected)
+				}
+				for j := range got[i] {
+					if got[i][j] != tt.expected[i][j] {
+						t.Errorf("BuildLevels() = %v, want %v", got, tt.expected)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunParallel(t *testing.T) {
+	var mu sync.Mutex
+	finished := map[string]bool{}
+	markDone := func(name string, err error) func() error {
+		return func() error {
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			finished[name] = true
+			mu.Unlock()
+			return err
+		}
+	}
+
+	dependencyDone := false
+	tests := []Test{
+		{Name: "rdma_nics_count", Fn: markDone("rdma_nics_count", nil)},
+		{Name: "gpu_count_check", Fn: markDone("gpu_count_check", errors.New("gpu missing"))},
+		{Name: "link_check", Fn: func() error {
+			mu.Lock()
+			dependencyDone = finished["rdma_nics_count"]
+			mu.Unlock()
+			return nil
+		}, DependsOn: []string{"rdma_nics_count"}},
+	}
+
+	results, err := RunParallel(tests, 4)
+	if err != nil {
+		t.Fatalf("RunParallel() unexpected error: %v", err)
+	}
+	if !dependencyDone {
+		t.Error("link_check started before rdma_nics_count finished")
+	}
+	if len(results) != len(tests) {
+		t.Fatalf("RunParallel() returned %d results, want %d", len(results), len(tests))
+	}
+	for i, result := range results {
+		if result.Name != tests[i].Name {
+			t.Errorf("result %d = %s, want %s", i, result.Name, tests[i].Name)
+		}
+	}
+	if results[1].Err == nil || results[0].Err != nil || results[2].Err != nil {
+		t.Errorf("RunParallel() unexpected errors: %+v", results)
+	}
+}
+
+func TestRunParallelWorkerLimit(t *testing.T) {
+	var running, maxRunning int32
+	fn := func() error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	var tests []Test
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		tests = append(tests, Test{Name: name, Fn: fn})
+	}
+
+	if _, err := RunParallel(tests, 2); err != nil {
+		t.Fatalf("RunParallel() unexpected error: %v", err)
+	}
+	if maxRunning > 2 {
+		t.Errorf("RunParallel() ran %d tests concurrently, want at most 2", maxRunning)
+	}
+}
+
+func TestRunParallelCycle(t *testing.T) {
+	tests := []Test{
+		{Name: "a", Fn: noop, DependsOn: []string{"b"}},
+		{Name: "b", Fn: noop, DependsOn: []string{"a"}},
+	}
+	if _, err := RunParallel(tests, 2); err == nil {
+		t.Error("RunParallel() expected error for dependency cycle")
+	}
+}
+
+func TestRunSequential(t *testing.T) {
+	var order []string
+	tests := []Test{
+		{Name: "a", Fn: func() error { order = append(order, "a"); return nil }},
+		{Name: "b", Fn: func() error { order = append(order, "b"); return errors.New("failed") }},
+	}
+
+	results := RunSequential(tests)
+	if len(order) != 2 || order[0] != "a" || order[1] != "b" {
+		t.Errorf("RunSequential() order = %v, want [a b]", order)
+	}
+	if results[0].Err != nil || results[1].Err == nil {
+		t.Errorf("RunSequential() unexpected errors: %+v", results)
+	}
+}
@@ -0,0 +1,53 @@
+package testrunner
+
+import (
+	"errors"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+)
+
+// RetryTest runs testFunc, running it up to maxRetries more times, delay apart, while it fails
+// with a transient error
+func RetryTest(testFunc func() error, maxRetries int, delay time.Duration) error {
+	_, err := retryTest(testFunc, maxRetries, delay)
+	return err
+}
+
+// retryTest runs testFunc like RetryTest and returns the number of retries it needed
+func retryTest(testFunc func() error, maxRetries int, delay time.Duration) (int, error) {
+	err := testFunc()
+	retries := 0
+	for retries < maxRetries && isTransient(err) {
+		retries++
+		logger.Debugf("Retry attempt %d of %d after transient error: %v", retries, maxRetries, err)
+		time.Sleep(delay)
+		err = testFunc()
+	}
+	return retries, err
+}
+
+// isTransient reports whether err is a failed command, such as mlxlink returning an error
+// once or nvidia-smi timing out, which may succeed when run again. Hardware failures,
+// missing tools and test timeouts are not retried.
+func isTransient(err error) bool {
+	var executionErr *testerrors.TestExecutionError
+	return errors.As(err, &executionErr)
+}
+
+// runTestWithRetries runs test, retrying it on transient errors as configured by its Retries and RetryDelay
+func runTestWithRetries(test Test) Result {
+	start := time.Now()
+	retries, err := retryTest(func() error {
+		return runTest(test).Err
+	}, test.Retries, test.RetryDelay)
+	if retries > 0 {
+		logger.Infof("Test %s needed %d retries", test.Name, retries)
+	}
+	logger.WithFields(logger.Fields{
+		"test_name":   test.Name,
+		"duration_ms": time.Since(start).Milliseconds(),
+	}).Infof("Test %s finished", test.Name)
+	return Result{Name: test.Name, Err: err, Retries: retries}
+}
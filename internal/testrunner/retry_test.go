@@ -0,0 +1,76 @@
+package testrunner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+)
+
+// failingTimes returns a test function failing with err the first n times it is called
+func failingTimes(n int, err error, calls *int) func() error {
+	return func() error {
+		*calls++
+		if *calls <= n {
+			return err
+		}
+		return nil
+	}
+}
+
+func TestRetryTest(t *testing.T) {
+	transient := &testerrors.TestExecutionError{TestName: "link_check", Cmd: "mlxlink -d mlx5_0", ExitCode: 1}
+	permanent := errors.New("expected 8 GPUs, found 7")
+
+	tests := []struct {
+		name            string
+		failures        int
+		err             error
+		maxRetries      int
+		expectedCalls   int
+		expectedRetries int
+		expectError     bool
+	}{
+		{"Succeeds first time", 0, transient, 2, 1, 0, false},
+		{"Succeeds after retry", 1, transient, 2, 2, 1, false},
+		{"Retries exhausted", 3, transient, 2, 3, 2, true},
+		{"Permanent failure not retried", 1, permanent, 2, 1, 0, true},
+		{"Retries disabled", 1, transient, 0, 1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			retries, err := retryTest(failingTimes(tt.failures, tt.err, &calls), tt.maxRetries, time.Millisecond)
+			if calls != tt.expectedCalls {
+				t.Errorf("retryTest() ran the test %d times, want %d", calls, tt.expectedCalls)
+			}
+			if retries != tt.expectedRetries {
+				t.Errorf("retryTest() retries = %d, want %d", retries, tt.expectedRetries)
+			}
+			if (err != nil) != tt.expectError {
+				t.Errorf("retryTest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+
+	calls := 0
+	if err := RetryTest(failingTimes(1, transient, &calls), 1, time.Millisecond); err != nil || calls != 2 {
+		t.Errorf("RetryTest() error = %v after %d calls, want success after 2 calls", err, calls)
+	}
+}
+
+func TestRunSequentialRetries(t *testing.T) {
+	calls := 0
+	results := RunSequential([]Test{{
+		Name:       "link_check",
+		Fn:         failingTimes(1, &testerrors.TestExecutionError{TestName: "link_check"}, &calls),
+		Retries:    2,
+		RetryDelay: time.Millisecond,
+	}})
+
+	if len(results) != 1 || results[0].Err != nil || results[0].Retries != 1 {
+		t.Errorf("RunSequential() = %+v, want success after 1 retry", results)
+	}
+}
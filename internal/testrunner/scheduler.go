@@ -0,0 +1,145 @@
+package testrunner
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// Scheduler runs tests in the order of their dependencies. Its execution plan is built once,
+// so dependency cycles are reported when the scheduler is created instead of when tests run.
+type Scheduler struct {
+	tests  []Test
+	levels [][]Test
+}
+
+// NewScheduler builds the execution plan of tests, returning an error on duplicate tests or
+// dependency cycles
+func NewScheduler(tests []Test) (*Scheduler, error) {
+	levels, err := BuildLevels(tests)
+	if err != nil {
+		return nil, err
+	}
+	return &Scheduler{tests: tests, levels: levels}, nil
+}
+
+// Levels returns the execution plan: tests of a level only depend on tests of earlier levels
+func (s *Scheduler) Levels() [][]Test {
+	return s.levels
+}
+
+// Run runs the tests level by level on a pool of workers, so tests without dependencies run
+// first and dependent tests only start once their dependencies finished. Tests whose
+// dependency failed, was skipped or timed out are not run and get a TestSkippedError.
+// Results are returned in the original test order.
+func (s *Scheduler) Run(workers int) []Result {
+	if workers < 1 {
+		workers = 1
+	}
+
+	errorsByName := make(map[string]error, len(s.tests))
+	retriesByName := make(map[string]int)
+	for i, level := range s.levels {
+		var runnable []Test
+		for _, test := range level {
+			if dependency := failedDependency(test, errorsByName); dependency != "" {
+				logger.Infof("Skipping test %s: dependency %s failed", test.Name, dependency)
+				errorsByName[test.Name] = &testerrors.TestSkippedError{TestName: test.Name, Dependency: dependency}
+				continue
+			}
+			runnable = append(runnable, test)
+		}
+		if len(runnable) == 0 {
+			continue
+		}
+
+		logger.Infof("Running test level %d with %d test(s) on %d worker(s)", i+1, len(runnable), workers)
+		for _, result := range runLevel(runnable, workers) {
+			errorsByName[result.Name] = result.Err
+			retriesByName[result.Name] = result.Retries
+		}
+	}
+
+	ordered := make([]Result, 0, len(s.tests))
+	for _, test := range s.tests {
+		ordered = append(ordered, Result{Name: test.Name, Err: errorsByName[test.Name], Retries: retriesByName[test.Name]})
+	}
+	return ordered
+}
+
+// failedDependency returns the first dependency of test that failed, or "" when none did.
+// Dependencies that are not part of the run, or are disabled for the shape, do not count as failed.
+// A dependency returning an error only counts as failed when its reported status is FAIL,
+// TIMEOUT or SKIP, so a dependency that passed with warnings still runs its dependents.
+func failedDependency(test Test, errorsByName map[string]error) string {
+	for _, dep := range test.DependsOn {
+		err, ran := errorsByName[dep]
+		if !ran || err == nil {
+			continue
+		}
+		var disabledErr *testerrors.TestDisabledError
+		if errors.As(err, &disabledErr) {
+			continue
+		}
+		if dependencyFailed(dep, err) {
+			return dep
+		}
+	}
+	return ""
+}
+
+// dependencyFailed reports whether the dependency dep, which returned err, failed. A dependency
+// that did not report a result failed.
+func dependencyFailed(dep string, err error) bool {
+	var timeoutErr *testerrors.TestTimeoutError
+	var skippedErr *testerrors.TestSkippedError
+	if errors.As(err, &timeoutErr) || errors.As(err, &skippedErr) {
+		return true
+	}
+
+	result, exists := reporter.GetReporter().GetResults()[test_limits.ConfigName(dep)]
+	if !exists {
+		return true
+	}
+	switch result.Status {
+	case "FAIL", "TIMEOUT", "SKIP":
+		return true
+	}
+	return false
+}
+
+// runLevel runs the tests of a level concurrently on at most workers goroutines
+func runLevel(level []Test, workers int) []Result {
+	jobs := make(chan Test)
+	results := make(chan Result, len(level))
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(level); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for test := range jobs {
+				logger.Info(fmt.Sprintf("Running test: %s", test.Name))
+				results <- runTestWithRetries(test)
+			}
+		}()
+	}
+
+	for _, test := range level {
+		jobs <- test
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	collected := make([]Result, 0, len(level))
+	for result := range results {
+		collected = append(collected, result)
+	}
+	return collected
+}
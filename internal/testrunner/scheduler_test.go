@@ -0,0 +1,127 @@
+package testrunner
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+func TestNewSchedulerCycle(t *testing.T) {
+	_, err := NewScheduler([]Test{
+		{Name: "a", Fn: noop, DependsOn: []string{"b"}},
+		{Name: "b", Fn: noop, DependsOn: []string{"a"}},
+	})
+	if err == nil {
+		t.Error("NewScheduler() expected error for dependency cycle")
+	}
+}
+
+func TestSchedulerLevels(t *testing.T) {
+	scheduler, err := NewScheduler([]Test{
+		{Name: "link_check", Fn: noop, DependsOn: []string{"rdma_nics_count"}},
+		{Name: "rdma_nics_count", Fn: noop},
+		{Name: "gpu_count_check", Fn: noop},
+	})
+	if err != nil {
+		t.Fatalf("NewScheduler() unexpected error: %v", err)
+	}
+
+	expected := [][]string{{"rdma_nics_count", "gpu_count_check"}, {"link_check"}}
+	if got := levelNames(scheduler.Levels()); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Levels() = %v, want %v", got, expected)
+	}
+}
+
+func TestSchedulerRunSkipsDependents(t *testing.T) {
+	rep := reporter.GetReporter()
+	rep.Clear()
+	defer rep.Clear()
+
+	var mu sync.Mutex
+	ran := map[string]bool{}
+	run := func(name string, err error) func() error {
+		return func() error {
+			mu.Lock()
+			ran[name] = true
+			mu.Unlock()
+			return err
+		}
+	}
+
+	tests := []Test{
+		{Name: "rdma_nics_count", Fn: run("rdma_nics_count", errors.New("missing NICs"))},
+		{Name: "pcie_error_check", Fn: run("pcie_error_check", &testerrors.TestDisabledError{TestName: "pcie_error_check"})},
+		{Name: "link_check", Fn: run("link_check", nil), DependsOn: []string{"rdma_nics_count"}},
+		{Name: "eth_link_check", Fn: run("eth_link_check", nil), DependsOn: []string{"link_check"}},
+		{Name: "pcie_gen_check", Fn: run("pcie_gen_check", nil), DependsOn: []string{"pcie_error_check", "not_in_run"}},
+	}
+
+	scheduler, err := NewScheduler(tests)
+	if err != nil {
+		t.Fatalf("NewScheduler() unexpected error: %v", err)
+	}
+
+	for _, workers := range []int{1, 4} {
+		ran = map[string]bool{}
+		results := scheduler.Run(workers)
+
+		if ran["link_check"] || ran["eth_link_check"] {
+			t.Errorf("Run(%d) ran dependents of a failed test: %v", workers, ran)
+		}
+		if !ran["pcie_gen_check"] {
+			t.Errorf("Run(%d) skipped a test whose dependency is disabled or not in the run", workers)
+		}
+
+		expectedSkips := map[string]string{"link_check": "rdma_nics_count", "eth_link_check": "link_check"}
+		for i, result := range results {
+			if result.Name != tests[i].Name {
+				t.Errorf("Run(%d) result %d = %s, want %s", workers, i, result.Name, tests[i].Name)
+			}
+			var skippedErr *testerrors.TestSkippedError
+			isSkipped := errors.As(result.Err, &skippedErr)
+			dependency, wantSkip := expectedSkips[result.Name]
+			if isSkipped != wantSkip || (isSkipped && skippedErr.Dependency != dependency) {
+				t.Errorf("Run(%d) result for %s = %v, want skipped %t by %q", workers, result.Name, result.Err, wantSkip, dependency)
+			}
+		}
+	}
+}
+
+func TestSchedulerRunWarnedDependency(t *testing.T) {
+	rep := reporter.GetReporter()
+	rep.Clear()
+	defer rep.Clear()
+
+	var mu sync.Mutex
+	ran := map[string]bool{}
+	tests := []Test{
+		{Name: "rdma_nics_count", Fn: func() error {
+			err := errors.New("unexpected RDMA NICs: mlx5_12")
+			rep.AddResult("rdma_nic_count", "WARN", nil, err)
+			return err
+		}},
+		{Name: "link_check", Fn: func() error {
+			mu.Lock()
+			ran["link_check"] = true
+			mu.Unlock()
+			return nil
+		}, DependsOn: []string{"rdma_nics_count"}},
+	}
+
+	scheduler, err := NewScheduler(tests)
+	if err != nil {
+		t.Fatalf("NewScheduler() unexpected error: %v", err)
+	}
+
+	results := scheduler.Run(1)
+	if !ran["link_check"] {
+		t.Error("Run() skipped a test whose dependency passed with warnings")
+	}
+	if results[1].Err != nil {
+		t.Errorf("Run() result for link_check = %v, want nil", results[1].Err)
+	}
+}
@@ -0,0 +1,50 @@
+package testrunner
+
+import (
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/logger"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+	"github.com/oracle/oci-dr-hpc-v2/internal/test_limits"
+)
+
+// runTest runs a single test, returning a TestTimeoutError if it does not finish within
+// its timeout. A timed out test keeps running in the background, so a hung command cannot
+// block the remaining tests. The timeout is reported as soon as it expires, so the reporter
+// ignores the result the test adds if it finishes later, also after the results of the run
+// were cleared. A test whose timed out run is still going is not started again.
+func runTest(test Test) Result {
+	if test.Timeout <= 0 {
+		return Result{Name: test.Name, Err: test.Fn()}
+	}
+
+	rep := reporter.GetReporter()
+	name := test_limits.ConfigName(test.Name)
+	timeoutErr := &testerrors.TestTimeoutError{TestName: test.Name, Timeout: test.Timeout}
+	if rep.IsTimedOutTestRunning(name) {
+		logger.Warnf("Test %s from an earlier run has not finished yet, not starting it again", test.Name)
+		rep.AddTimeoutResult(name, int(test.Timeout.Seconds()), timeoutErr)
+		return Result{Name: test.Name, Err: timeoutErr}
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- test.Fn()
+	}()
+
+	timer := time.NewTimer(test.Timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return Result{Name: test.Name, Err: err}
+	case <-timer.C:
+		rep.AddTimeoutResult(name, int(test.Timeout.Seconds()), timeoutErr)
+		go func() {
+			<-done
+			rep.TimedOutTestFinished(name)
+		}()
+		return Result{Name: test.Name, Err: timeoutErr}
+	}
+}
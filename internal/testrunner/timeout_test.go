@@ -0,0 +1,174 @@
+package testrunner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	testerrors "github.com/oracle/oci-dr-hpc-v2/internal/errors"
+	"github.com/oracle/oci-dr-hpc-v2/internal/reporter"
+)
+
+func TestRunTestTimeout(t *testing.T) {
+	hang := make(chan struct{})
+	defer close(hang)
+
+	test := Test{
+		Name:    "gpu_count_check",
+		Fn:      func() error { <-hang; return nil },
+		Timeout: 20 * time.Millisecond,
+	}
+
+	result := runTest(test)
+
+	var timeoutErr *testerrors.TestTimeoutError
+	if !errors.As(result.Err, &timeoutErr) {
+		t.Fatalf("runTest() error = %v, want TestTimeoutError", result.Err)
+	}
+	if timeoutErr.TestName != "gpu_count_check" || timeoutErr.Timeout != 20*time.Millisecond {
+		t.Errorf("runTest() TestTimeoutError = %+v", timeoutErr)
+	}
+	if !errors.Is(result.Err, context.DeadlineExceeded) {
+		t.Error("TestTimeoutError should match context.DeadlineExceeded")
+	}
+}
+
+func TestRunTestTimeoutKeepsTimeoutResult(t *testing.T) {
+	rep := reporter.GetReporter()
+	rep.Clear()
+	defer rep.Clear()
+
+	finish := make(chan struct{})
+	finished := make(chan struct{})
+	test := Test{
+		Name: "rdma_nics_count",
+		Fn: func() error {
+			<-finish
+			rep.AddResult("rdma_nic_count", "PASS", nil, nil)
+			close(finished)
+			return nil
+		},
+		Timeout: 20 * time.Millisecond,
+	}
+
+	runTest(test)
+
+	// The timeout is reported under the configured name as soon as it expires
+	result, exists := rep.GetResults()["rdma_nic_count"]
+	if !exists || result.Status != "FAIL" || result.Details["timeout"] != true {
+		t.Fatalf("Expected timeout result for rdma_nic_count, got %+v", result)
+	}
+
+	// A result added by the test after it timed out must not replace the timeout
+	close(finish)
+	<-finished
+	if result := rep.GetResults()["rdma_nic_count"]; result.Status != "FAIL" {
+		t.Errorf("Late result replaced the timeout: status = %s", result.Status)
+	}
+}
+
+func TestRunTestTimeoutDropsLateResultAfterClear(t *testing.T) {
+	rep := reporter.GetReporter()
+	rep.Clear()
+	defer rep.Clear()
+
+	finish := make(chan struct{})
+	finished := make(chan struct{})
+	started := 0
+	test := Test{
+		Name: "gpu_clk_check",
+		Fn: func() error {
+			started++
+			<-finish
+			rep.AddResult("gpu_clk_check", "PASS", nil, nil)
+			close(finished)
+			return nil
+		},
+		Timeout: 20 * time.Millisecond,
+	}
+
+	runTest(test)
+
+	// The next run must not start the test again while the timed out run is still going
+	rep.Clear()
+	var timeoutErr *testerrors.TestTimeoutError
+	if result := runTest(test); !errors.As(result.Err, &timeoutErr) {
+		t.Errorf("runTest() error = %v, want TestTimeoutError", result.Err)
+	}
+
+	// A result from the earlier run must not land in the report of a later run
+	rep.Clear()
+	close(finish)
+	<-finished
+	if started != 1 {
+		t.Errorf("Test started %d times, want 1", started)
+	}
+	if result, exists := rep.GetResults()["gpu_clk_check"]; exists {
+		t.Errorf("Late result from an earlier run was recorded: %+v", result)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for rep.IsTimedOutTestRunning("gpu_clk_check") {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out test still marked as running after it returned")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRunTestWithinTimeout(t *testing.T) {
+	testErr := errors.New("failed")
+
+	tests := []struct {
+		name    string
+		test    Test
+		wantErr error
+	}{
+		{
+			name:    "No timeout",
+			test:    Test{Name: "a", Fn: func() error { return testErr }},
+			wantErr: testErr,
+		},
+		{
+			name:    "Finishes before timeout",
+			test:    Test{Name: "b", Fn: func() error { return nil }, Timeout: time.Second},
+			wantErr: nil,
+		},
+		{
+			name:    "Fails before timeout",
+			test:    Test{Name: "c", Fn: func() error { return testErr }, Timeout: time.Second},
+			wantErr: testErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := runTest(tt.test)
+			if result.Err != tt.wantErr {
+				t.Errorf("runTest() error = %v, want %v", result.Err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunSequentialTimeoutDoesNotBlock(t *testing.T) {
+	hang := make(chan struct{})
+	defer close(hang)
+
+	ran := false
+	tests := []Test{
+		{Name: "link_check", Fn: func() error { <-hang; return nil }, Timeout: 20 * time.Millisecond},
+		{Name: "gpu_count_check", Fn: func() error { ran = true; return nil }},
+	}
+
+	results := RunSequential(tests)
+
+	var timeoutErr *testerrors.TestTimeoutError
+	if !errors.As(results[0].Err, &timeoutErr) {
+		t.Errorf("Expected TestTimeoutError for link_check, got %v", results[0].Err)
+	}
+	if !ran || results[1].Err != nil {
+		t.Error("Expected gpu_count_check to run after link_check timed out")
+	}
+}
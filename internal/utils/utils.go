@@ -1,9 +1,32 @@
 package utils
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // IsInt checks if a string represents a valid integer
 func IsInt(s string) bool {
 	_, err := strconv.Atoi(s)
 	return err == nil
 }
+
+// ParseNvidiaDriverVersion parses an NVIDIA driver version such as "535.104.12" into its
+// major, minor and patch numbers. The patch number is 0 for two part versions such as "535.54".
+func ParseNvidiaDriverVersion(ver string) (int, int, int, error) {
+	parts := strings.Split(strings.TrimSpace(ver), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, fmt.Errorf("invalid NVIDIA driver version %q", ver)
+	}
+
+	numbers := [3]int{}
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return 0, 0, 0, fmt.Errorf("invalid NVIDIA driver version %q", ver)
+		}
+		numbers[i] = number
+	}
+	return numbers[0], numbers[1], numbers[2], nil
+}
@@ -19,3 +19,29 @@ func TestIsInt(t *testing.T) {
 		}
 	}
 }
+
+func TestParseNvidiaDriverVersion(t *testing.T) {
+	tests := []struct {
+		version             string
+		major, minor, patch int
+	}{
+		{"535.104.12", 535, 104, 12},
+		{"450.142.0", 450, 142, 0},
+		{"535.54", 535, 54, 0},
+		{" 550.90.07\n", 550, 90, 7},
+	}
+	for _, tt := range tests {
+		major, minor, patch, err := ParseNvidiaDriverVersion(tt.version)
+		if err != nil || major != tt.major || minor != tt.minor || patch != tt.patch {
+			t.Errorf("ParseNvidiaDriverVersion(%q) = %d, %d, %d, %v, expected %d, %d, %d", tt.version, major, minor, patch, err, tt.major, tt.minor, tt.patch)
+		}
+	}
+
+	// Test invalid versions
+	invalidVersions := []string{"", "535", "535.104.12.1", "535.abc.12", "v535.104.12", "535..12", "535.-1.0"}
+	for _, version := range invalidVersions {
+		if _, _, _, err := ParseNvidiaDriverVersion(version); err == nil {
+			t.Errorf("ParseNvidiaDriverVersion(%q) expected error", version)
+		}
+	}
+}